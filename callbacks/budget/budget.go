@@ -0,0 +1,287 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"runtime/debug"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// ErrBudgetExceeded is returned, wrapped with details, once the token budget of a key is used up.
+var ErrBudgetExceeded = errors.New("token budget exceeded")
+
+type Config struct {
+	// MaxPromptTokens is the budget of prompt tokens per key, 0 means unlimited (Optional)
+	MaxPromptTokens int
+
+	// MaxCompletionTokens is the budget of completion tokens per key, 0 means unlimited (Optional)
+	MaxCompletionTokens int
+
+	// MaxTotalTokens is the budget of total tokens per key, 0 means unlimited (Optional)
+	// At least one of the three budgets is required.
+	MaxTotalTokens int
+
+	// KeyFunc returns the key usage is accounted to, e.g. a conversation or request id (Optional)
+	// Default: KeyFromContext, so keys are set with WithKey
+	KeyFunc func(ctx context.Context) string
+
+	// OnExceeded is called once per key when its budget is used up (Optional)
+	// Example: func(ctx context.Context, key string, usage Usage) { log.Printf("%s over budget: %+v", key, usage) }
+	OnExceeded func(ctx context.Context, key string, usage Usage)
+
+	// EstimatePromptTokens estimates the prompt tokens of a request, e.g. tokencount.Counter.CountMessages (Optional)
+	// If set, WrapChatModel fails a request whose prompt would exceed the remaining budget before sending it.
+	EstimatePromptTokens func(input []*schema.Message) int
+}
+
+// Usage is the cumulative token usage of a key.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+}
+
+type keyCtxKey struct{}
+
+// WithKey sets the key that token usage of runs under ctx is accounted to.
+// Runs without a key share the budget of the empty key.
+func WithKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, keyCtxKey{}, key)
+}
+
+// KeyFromContext returns the key set by WithKey.
+func KeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(keyCtxKey{}).(string)
+	return key
+}
+
+// Guard is a callbacks.Handler accumulating the token usage reported by ChatModel callbacks.
+// Register it with callbacks.AppendGlobalHandlers or compose.WithCallbacks, then either wrap
+// the ChatModel with WrapChatModel, or call Check in your own nodes, so that the run fails
+// with ErrBudgetExceeded instead of calling the model again.
+type Guard struct {
+	maxPromptTokens     int
+	maxCompletionTokens int
+	maxTotalTokens      int
+	keyFunc             func(ctx context.Context) string
+	onExceeded          func(ctx context.Context, key string, usage Usage)
+	estimatePrompt      func(input []*schema.Message) int
+
+	mu     sync.Mutex
+	usages map[string]*keyUsage
+}
+
+type keyUsage struct {
+	Usage
+	exceeded bool
+}
+
+func NewGuard(cfg *Config) (*Guard, error) {
+	if cfg == nil {
+		return nil, errors.New("config is nil")
+	}
+	if cfg.MaxPromptTokens < 0 || cfg.MaxCompletionTokens < 0 || cfg.MaxTotalTokens < 0 {
+		return nil, errors.New("token budgets must not be negative")
+	}
+	if cfg.MaxPromptTokens == 0 && cfg.MaxCompletionTokens == 0 && cfg.MaxTotalTokens == 0 {
+		return nil, errors.New("at least one token budget is required")
+	}
+	g := &Guard{
+		maxPromptTokens:     cfg.MaxPromptTokens,
+		maxCompletionTokens: cfg.MaxCompletionTokens,
+		maxTotalTokens:      cfg.MaxTotalTokens,
+		keyFunc:             cfg.KeyFunc,
+		onExceeded:          cfg.OnExceeded,
+		estimatePrompt:      cfg.EstimatePromptTokens,
+		usages:              make(map[string]*keyUsage),
+	}
+	if g.keyFunc == nil {
+		g.keyFunc = KeyFromContext
+	}
+	return g, nil
+}
+
+// Usage returns the token usage accumulated for key.
+func (g *Guard) Usage(key string) Usage {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if u, ok := g.usages[key]; ok {
+		return u.Usage
+	}
+	return Usage{}
+}
+
+// Reset forgets the usage of key, typically called when a conversation or request ends.
+func (g *Guard) Reset(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.usages, key)
+}
+
+// Check returns an error wrapping ErrBudgetExceeded if the budget of the key of ctx is used up.
+func (g *Guard) Check(ctx context.Context) error {
+	key := g.keyFunc(ctx)
+	usage := g.Usage(key)
+	if reason := g.exceeded(usage); len(reason) > 0 {
+		return fmt.Errorf("%w: key=%q, %s", ErrBudgetExceeded, key, reason)
+	}
+	return nil
+}
+
+// checkInput is Check, plus checking the estimated prompt tokens of input against the remaining budget.
+func (g *Guard) checkInput(ctx context.Context, input []*schema.Message) error {
+	if err := g.Check(ctx); err != nil || g.estimatePrompt == nil {
+		return err
+	}
+
+	key := g.keyFunc(ctx)
+	usage := g.Usage(key)
+	estimate := g.estimatePrompt(input)
+	var reason string
+	switch {
+	case g.maxPromptTokens > 0 && usage.PromptTokens+estimate > g.maxPromptTokens:
+		reason = fmt.Sprintf("estimated prompt tokens %d exceed remaining %d of prompt tokens",
+			estimate, g.maxPromptTokens-usage.PromptTokens)
+	case g.maxTotalTokens > 0 && usage.TotalTokens+estimate > g.maxTotalTokens:
+		reason = fmt.Sprintf("estimated prompt tokens %d exceed remaining %d of total tokens",
+			estimate, g.maxTotalTokens-usage.TotalTokens)
+	default:
+		return nil
+	}
+	return fmt.Errorf("%w: key=%q, %s", ErrBudgetExceeded, key, reason)
+}
+
+func (g *Guard) exceeded(u Usage) string {
+	switch {
+	case g.maxPromptTokens > 0 && u.PromptTokens >= g.maxPromptTokens:
+		return fmt.Sprintf("prompt tokens %d reached limit %d", u.PromptTokens, g.maxPromptTokens)
+	case g.maxCompletionTokens > 0 && u.CompletionTokens >= g.maxCompletionTokens:
+		return fmt.Sprintf("completion tokens %d reached limit %d", u.CompletionTokens, g.maxCompletionTokens)
+	case g.maxTotalTokens > 0 && u.TotalTokens >= g.maxTotalTokens:
+		return fmt.Sprintf("total tokens %d reached limit %d", u.TotalTokens, g.maxTotalTokens)
+	default:
+		return ""
+	}
+}
+
+func (g *Guard) add(ctx context.Context, tu *model.TokenUsage) {
+	if tu == nil {
+		return
+	}
+	total := tu.TotalTokens
+	if total == 0 {
+		total = tu.PromptTokens + tu.CompletionTokens
+	}
+
+	key := g.keyFunc(ctx)
+	g.mu.Lock()
+	u, ok := g.usages[key]
+	if !ok {
+		u = &keyUsage{}
+		g.usages[key] = u
+	}
+	u.PromptTokens += tu.PromptTokens
+	u.CompletionTokens += tu.CompletionTokens
+	u.TotalTokens += total
+	notify := !u.exceeded && len(g.exceeded(u.Usage)) > 0
+	if notify {
+		u.exceeded = true
+	}
+	usage := u.Usage
+	g.mu.Unlock()
+
+	if notify && g.onExceeded != nil {
+		g.onExceeded(ctx, key, usage)
+	}
+}
+
+func (g *Guard) OnStart(ctx context.Context, info *callbacks.RunInfo, input callbacks.CallbackInput) context.Context {
+	return ctx
+}
+
+func (g *Guard) OnEnd(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+	if info == nil || info.Component != components.ComponentOfChatModel {
+		return ctx
+	}
+	if out := model.ConvCallbackOutput(output); out != nil {
+		g.add(ctx, out.TokenUsage)
+	}
+	return ctx
+}
+
+func (g *Guard) OnError(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+	return ctx
+}
+
+func (g *Guard) OnStartWithStreamInput(ctx context.Context, info *callbacks.RunInfo, input *schema.StreamReader[callbacks.CallbackInput]) context.Context {
+	input.Close()
+	return ctx
+}
+
+func (g *Guard) OnEndWithStreamOutput(ctx context.Context, info *callbacks.RunInfo, output *schema.StreamReader[callbacks.CallbackOutput]) context.Context {
+	if info == nil || info.Component != components.ComponentOfChatModel {
+		output.Close()
+		return ctx
+	}
+
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				log.Printf("recover token budget panic: %v, runinfo: %+v, stack: %s", e, info, string(debug.Stack()))
+			}
+			output.Close()
+		}()
+		// usage is reported by the last chunk carrying it
+		var usage *model.TokenUsage
+		for {
+			chunk, err := output.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Printf("read stream output error: %v, runinfo: %+v", err, info)
+				break
+			}
+			if out := model.ConvCallbackOutput(chunk); out != nil && out.TokenUsage != nil {
+				usage = out.TokenUsage
+			}
+		}
+		g.add(ctx, usage)
+	}()
+
+	return ctx
+}
+
+// Needed skips everything but the end of ChatModel runs.
+func (g *Guard) Needed(ctx context.Context, info *callbacks.RunInfo, timing callbacks.CallbackTiming) bool {
+	if info == nil || info.Component != components.ComponentOfChatModel {
+		return false
+	}
+	return timing == callbacks.TimingOnEnd || timing == callbacks.TimingOnEndWithStreamOutput
+}
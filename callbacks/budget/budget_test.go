@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package budget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	calls int
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.calls++
+	return schema.AssistantMessage("ok", nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	m.calls++
+	return schema.StreamReaderFromArray([]*schema.Message{schema.AssistantMessage("ok", nil)}), nil
+}
+
+func (m *mockChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+func (m *mockChatModel) GetType() string {
+	return "Mock"
+}
+
+func reportUsage(ctx context.Context, guard *Guard, usage *model.TokenUsage) {
+	ctx = callbacks.InitCallbacks(ctx, &callbacks.RunInfo{Component: components.ComponentOfChatModel}, guard)
+	callbacks.OnEnd[callbacks.CallbackOutput](ctx, &model.CallbackOutput{
+		Message:    schema.AssistantMessage("ok", nil),
+		TokenUsage: usage,
+	})
+}
+
+func TestNewGuard(t *testing.T) {
+	_, err := NewGuard(nil)
+	assert.Error(t, err)
+	_, err = NewGuard(&Config{})
+	assert.Error(t, err)
+	_, err = NewGuard(&Config{MaxTotalTokens: -1})
+	assert.Error(t, err)
+	_, err = NewGuard(&Config{MaxCompletionTokens: 10})
+	assert.NoError(t, err)
+}
+
+func TestGuard(t *testing.T) {
+	var exceeded []string
+	guard, err := NewGuard(&Config{
+		MaxTotalTokens: 100,
+		OnExceeded: func(ctx context.Context, key string, usage Usage) {
+			exceeded = append(exceeded, key)
+		},
+	})
+	assert.NoError(t, err)
+
+	ctx1 := WithKey(context.Background(), "conversation-1")
+	ctx2 := WithKey(context.Background(), "conversation-2")
+	cm := &mockChatModel{}
+	guarded := guard.WrapChatModel(cm)
+
+	reportUsage(ctx1, guard, &model.TokenUsage{PromptTokens: 40, CompletionTokens: 20, TotalTokens: 60})
+	reportUsage(ctx2, guard, &model.TokenUsage{PromptTokens: 10, CompletionTokens: 10})
+	assert.Equal(t, Usage{PromptTokens: 40, CompletionTokens: 20, TotalTokens: 60}, guard.Usage("conversation-1"))
+	assert.Equal(t, Usage{PromptTokens: 10, CompletionTokens: 10, TotalTokens: 20}, guard.Usage("conversation-2"))
+	assert.NoError(t, guard.Check(ctx1))
+
+	_, err = guarded.Generate(ctx1, []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cm.calls)
+
+	reportUsage(ctx1, guard, &model.TokenUsage{PromptTokens: 30, CompletionTokens: 20, TotalTokens: 50})
+	reportUsage(ctx1, guard, &model.TokenUsage{PromptTokens: 1, CompletionTokens: 1, TotalTokens: 2})
+	assert.Equal(t, []string{"conversation-1"}, exceeded)
+
+	err = guard.Check(ctx1)
+	assert.True(t, errors.Is(err, ErrBudgetExceeded))
+	assert.EqualError(t, err, `token budget exceeded: key="conversation-1", total tokens 112 reached limit 100`)
+
+	_, err = guarded.Generate(ctx1, []*schema.Message{schema.UserMessage("hi")})
+	assert.True(t, errors.Is(err, ErrBudgetExceeded))
+	_, err = guarded.Stream(ctx1, []*schema.Message{schema.UserMessage("hi")})
+	assert.True(t, errors.Is(err, ErrBudgetExceeded))
+	assert.Equal(t, 1, cm.calls)
+
+	_, err = guarded.Generate(ctx2, []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cm.calls)
+
+	guard.Reset("conversation-1")
+	assert.NoError(t, guard.Check(ctx1))
+	assert.Equal(t, Usage{}, guard.Usage("conversation-1"))
+
+	withTools, err := guarded.WithTools(nil)
+	assert.NoError(t, err)
+	typ, _ := components.GetType(withTools)
+	assert.Equal(t, "Mock", typ)
+}
+
+func TestGuardEstimatePromptTokens(t *testing.T) {
+	guard, err := NewGuard(&Config{
+		MaxTotalTokens: 100,
+		EstimatePromptTokens: func(input []*schema.Message) int {
+			return 10 * len(input)
+		},
+	})
+	assert.NoError(t, err)
+
+	ctx := WithKey(context.Background(), "conversation")
+	cm := &mockChatModel{}
+	guarded := guard.WrapChatModel(cm)
+
+	reportUsage(ctx, guard, &model.TokenUsage{PromptTokens: 50, CompletionTokens: 20, TotalTokens: 70})
+	_, err = guarded.Generate(ctx, []*schema.Message{schema.UserMessage("hi"), schema.UserMessage("hi"), schema.UserMessage("hi")})
+	assert.NoError(t, err)
+
+	_, err = guarded.Stream(ctx, []*schema.Message{schema.UserMessage("hi"), schema.UserMessage("hi"), schema.UserMessage("hi"), schema.UserMessage("hi")})
+	assert.EqualError(t, err, `token budget exceeded: key="conversation", estimated prompt tokens 40 exceed remaining 30 of total tokens`)
+	assert.Equal(t, 1, cm.calls)
+
+	// Check doesn't estimate
+	assert.NoError(t, guard.Check(ctx))
+}
+
+func TestGuardStream(t *testing.T) {
+	guard, err := NewGuard(&Config{
+		MaxPromptTokens: 10,
+		KeyFunc: func(ctx context.Context) string {
+			return "fixed"
+		},
+	})
+	assert.NoError(t, err)
+
+	ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{Component: components.ComponentOfChatModel}, guard)
+	sr := schema.StreamReaderFromArray([]callbacks.CallbackOutput{
+		&model.CallbackOutput{Message: schema.AssistantMessage("o", nil)},
+		&model.CallbackOutput{Message: schema.AssistantMessage("k", nil), TokenUsage: &model.TokenUsage{PromptTokens: 12, CompletionTokens: 2}},
+	})
+	_, out := callbacks.OnEndWithStreamOutput(ctx, sr)
+	out.Close()
+
+	assert.Eventually(t, func() bool {
+		return guard.Usage("fixed") == Usage{PromptTokens: 12, CompletionTokens: 2, TotalTokens: 14}
+	}, time.Second, 10*time.Millisecond)
+	assert.ErrorIs(t, guard.Check(context.Background()), ErrBudgetExceeded)
+
+	assert.False(t, guard.Needed(ctx, &callbacks.RunInfo{Component: components.ComponentOfTool}, callbacks.TimingOnEnd))
+	assert.False(t, guard.Needed(ctx, &callbacks.RunInfo{Component: components.ComponentOfChatModel}, callbacks.TimingOnStart))
+	assert.True(t, guard.Needed(ctx, &callbacks.RunInfo{Component: components.ComponentOfChatModel}, callbacks.TimingOnEndWithStreamOutput))
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/cloudwego/eino-ext/callbacks/budget"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// fakeChatModel always answers with a fixed message and reports 60 tokens through callbacks.
+type fakeChatModel struct{}
+
+func (f *fakeChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	ctx = callbacks.EnsureRunInfo(ctx, f.GetType(), components.ComponentOfChatModel)
+	ctx = callbacks.OnStart(ctx, &model.CallbackInput{Messages: input})
+	msg := schema.AssistantMessage("let me think again...", nil)
+	callbacks.OnEnd(ctx, &model.CallbackOutput{
+		Message:    msg,
+		TokenUsage: &model.TokenUsage{PromptTokens: 50, CompletionTokens: 10, TotalTokens: 60},
+	})
+	return msg, nil
+}
+
+func (f *fakeChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := f.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (f *fakeChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return f, nil
+}
+
+func (f *fakeChatModel) GetType() string {
+	return "Fake"
+}
+
+func (f *fakeChatModel) IsCallbacksEnabled() bool {
+	return true
+}
+
+func main() {
+	guard, err := budget.NewGuard(&budget.Config{
+		MaxTotalTokens: 200,
+		OnExceeded: func(ctx context.Context, key string, usage budget.Usage) {
+			log.Printf("conversation %s is over budget: %+v", key, usage)
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Set guard as a global callback, so that it sees the token usage of every ChatModel
+	callbacks.AppendGlobalHandlers(guard)
+
+	cm := guard.WrapChatModel(&fakeChatModel{})
+
+	ctx := budget.WithKey(context.Background(), "conversation-1")
+	defer guard.Reset("conversation-1")
+
+	// a runaway loop, stopped by the guard after 4 calls
+	for i := 1; ; i++ {
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("are you sure?")})
+		if errors.Is(err, budget.ErrBudgetExceeded) {
+			log.Printf("stopped at call %d: %v", i, err)
+			return
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package budget
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// WrapChatModel returns a ChatModel failing with ErrBudgetExceeded, instead of calling cm,
+// once the budget of the key of the request context is used up.
+// Usage is only accounted when the guard is registered as a callback handler.
+// With Config.EstimatePromptTokens, requests whose prompt would exceed the remaining budget fail as well.
+func (g *Guard) WrapChatModel(cm model.ToolCallingChatModel) model.ToolCallingChatModel {
+	return &guardedChatModel{guard: g, cm: cm}
+}
+
+type guardedChatModel struct {
+	guard *Guard
+	cm    model.ToolCallingChatModel
+}
+
+func (m *guardedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	if err := m.guard.checkInput(ctx, input); err != nil {
+		return nil, err
+	}
+	return m.cm.Generate(ctx, input, opts...)
+}
+
+func (m *guardedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	if err := m.guard.checkInput(ctx, input); err != nil {
+		return nil, err
+	}
+	return m.cm.Stream(ctx, input, opts...)
+}
+
+func (m *guardedChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	cm, err := m.cm.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return m.guard.WrapChatModel(cm), nil
+}
+
+func (m *guardedChatModel) GetType() string {
+	typ, _ := components.GetType(m.cm)
+	return typ
+}
+
+// IsCallbacksEnabled follows the wrapped model, so that callbacks, and thus usage, are reported exactly once.
+func (m *guardedChatModel) IsCallbacksEnabled() bool {
+	return components.IsCallbacksEnabled(m.cm)
+}
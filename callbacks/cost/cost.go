@@ -0,0 +1,476 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cost
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+)
+
+type Config struct {
+	// Prices override and extend DefaultPrices, keyed by model name (Optional)
+	// A model is priced by its exact name, or else by the longest name it starts with.
+	// Example: map[string]Price{"ep-20250101-xxxxx": {PromptPerMillion: 0.11, CompletionPerMillion: 0.28}}
+	Prices map[string]Price
+
+	// SessionFunc returns the session cost is accounted to, e.g. a user or conversation id (Optional)
+	// Default: SessionFromContext, so sessions are set with WithSession
+	SessionFunc func(ctx context.Context) string
+
+	// OnRunEnd is called with the cost of each run when it ends, a run is the outermost graph or chain
+	// invocation (Optional)
+	OnRunEnd func(ctx context.Context, run *RunCost)
+
+	// Export is called periodically with the cost accumulated so far, e.g. to push it to a metrics system (Optional)
+	Export func(ctx context.Context, report *Report)
+
+	// ExportInterval is the interval Export is called at (Optional)
+	// Default: 1 minute
+	ExportInterval time.Duration
+}
+
+// Cost is the token usage and the cost of a set of ChatModel calls.
+type Cost struct {
+	Calls            int
+	PromptTokens     int
+	CompletionTokens int
+	// Amount is the cost in the currency of the prices, USD for DefaultPrices.
+	Amount float64
+	// UnpricedTokens are the tokens of models without a price, which are not in Amount.
+	UnpricedTokens int
+}
+
+func (c *Cost) add(o Cost) {
+	c.Calls += o.Calls
+	c.PromptTokens += o.PromptTokens
+	c.CompletionTokens += o.CompletionTokens
+	c.Amount += o.Amount
+	c.UnpricedTokens += o.UnpricedTokens
+}
+
+// RunCost is the cost of a single run.
+type RunCost struct {
+	// Graph is the name of the graph or chain of the run.
+	Graph     string
+	Session   string
+	StartTime time.Time
+	EndTime   time.Time
+	Cost
+	// Models is the cost per model name.
+	Models map[string]Cost
+}
+
+// Report is a snapshot of the cost accumulated by a Tracker.
+type Report struct {
+	Time     time.Time
+	Total    Cost
+	Sessions map[string]Cost
+	Graphs   map[string]Cost
+	Models   map[string]Cost
+}
+
+type sessionCtxKey struct{}
+
+// WithSession sets the session that the cost of runs under ctx is accounted to.
+func WithSession(ctx context.Context, session string) context.Context {
+	return context.WithValue(ctx, sessionCtxKey{}, session)
+}
+
+// SessionFromContext returns the session set by WithSession.
+func SessionFromContext(ctx context.Context) string {
+	session, _ := ctx.Value(sessionCtxKey{}).(string)
+	return session
+}
+
+// Tracker is a callbacks.Handler pricing the token usage reported by ChatModel callbacks
+// and aggregating the cost per run, per graph, per session and per model.
+// Register it with callbacks.AppendGlobalHandlers or compose.WithCallbacks.
+type Tracker struct {
+	pricing     *pricing
+	sessionFunc func(ctx context.Context) string
+	onRunEnd    func(ctx context.Context, run *RunCost)
+
+	mu       sync.Mutex
+	total    Cost
+	sessions map[string]*Cost
+	graphs   map[string]*Cost
+	models   map[string]*Cost
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	exported sync.WaitGroup
+}
+
+func NewTracker(cfg *Config) (*Tracker, error) {
+	if cfg == nil {
+		return nil, errors.New("config is nil")
+	}
+	if cfg.ExportInterval < 0 {
+		return nil, errors.New("export interval must not be negative")
+	}
+	t := &Tracker{
+		pricing:     newPricing(cfg.Prices),
+		sessionFunc: cfg.SessionFunc,
+		onRunEnd:    cfg.OnRunEnd,
+		sessions:    make(map[string]*Cost),
+		graphs:      make(map[string]*Cost),
+		models:      make(map[string]*Cost),
+		stop:        make(chan struct{}),
+	}
+	if t.sessionFunc == nil {
+		t.sessionFunc = SessionFromContext
+	}
+	if cfg.Export != nil {
+		interval := cfg.ExportInterval
+		if interval == 0 {
+			interval = time.Minute
+		}
+		t.exported.Add(1)
+		go t.export(cfg.Export, interval)
+	}
+	return t, nil
+}
+
+func (t *Tracker) export(export func(ctx context.Context, report *Report), interval time.Duration) {
+	defer t.exported.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	call := func() {
+		defer func() {
+			if e := recover(); e != nil {
+				log.Printf("recover cost export panic: %v, stack: %s", e, string(debug.Stack()))
+			}
+		}()
+		export(context.Background(), t.Report())
+	}
+	for {
+		select {
+		case <-ticker.C:
+			call()
+		case <-t.stop:
+			// export what's accumulated since the last tick
+			call()
+			return
+		}
+	}
+}
+
+// Close stops the periodic export after a final one.
+func (t *Tracker) Close() {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+	t.exported.Wait()
+}
+
+// Total returns the cost accumulated over all sessions.
+func (t *Tracker) Total() Cost {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.total
+}
+
+// Session returns the cost accumulated for session.
+func (t *Tracker) Session(session string) Cost {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if c, ok := t.sessions[session]; ok {
+		return *c
+	}
+	return Cost{}
+}
+
+// Graph returns the cost accumulated by runs of the graph or chain named graph.
+// ChatModel calls outside any graph are accounted to the empty name.
+func (t *Tracker) Graph(graph string) Cost {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if c, ok := t.graphs[graph]; ok {
+		return *c
+	}
+	return Cost{}
+}
+
+// ResetSession forgets the cost of session, typically called when a session ends.
+// The session's cost stays in the total.
+func (t *Tracker) ResetSession(session string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.sessions, session)
+}
+
+// Report returns a snapshot of all accumulated cost.
+func (t *Tracker) Report() *Report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return &Report{
+		Time:     time.Now(),
+		Total:    t.total,
+		Sessions: copyCosts(t.sessions),
+		Graphs:   copyCosts(t.graphs),
+		Models:   copyCosts(t.models),
+	}
+}
+
+func copyCosts(src map[string]*Cost) map[string]Cost {
+	dst := make(map[string]Cost, len(src))
+	for k, v := range src {
+		dst[k] = *v
+	}
+	return dst
+}
+
+func addTo(m map[string]*Cost, key string, c Cost) {
+	acc, ok := m[key]
+	if !ok {
+		acc = &Cost{}
+		m[key] = acc
+	}
+	acc.add(c)
+}
+
+type runCtxKey struct{}
+
+type runState struct {
+	info    *callbacks.RunInfo
+	mu      sync.Mutex
+	run     RunCost
+	pending sync.WaitGroup
+}
+
+type modelCtxKey struct{}
+
+func (t *Tracker) add(ctx context.Context, modelName string, tu *model.TokenUsage) {
+	if tu == nil {
+		return
+	}
+	c := Cost{
+		Calls:            1,
+		PromptTokens:     tu.PromptTokens,
+		CompletionTokens: tu.CompletionTokens,
+	}
+	if price, ok := t.pricing.lookup(modelName); ok {
+		c.Amount = price.cost(tu.PromptTokens, tu.CompletionTokens)
+	} else {
+		c.UnpricedTokens = tu.PromptTokens + tu.CompletionTokens
+	}
+
+	var graph string
+	if rs, ok := ctx.Value(runCtxKey{}).(*runState); ok {
+		graph = rs.run.Graph
+		rs.mu.Lock()
+		rs.run.add(c)
+		addModel(rs.run.Models, modelName, c)
+		rs.mu.Unlock()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.total.add(c)
+	addTo(t.sessions, t.sessionFunc(ctx), c)
+	addTo(t.graphs, graph, c)
+	addTo(t.models, modelName, c)
+}
+
+func addModel(m map[string]Cost, key string, c Cost) {
+	acc := m[key]
+	acc.add(c)
+	m[key] = acc
+}
+
+func isGraph(info *callbacks.RunInfo) bool {
+	switch info.Component {
+	case compose.ComponentOfGraph, compose.ComponentOfChain:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *Tracker) startRun(ctx context.Context, info *callbacks.RunInfo) context.Context {
+	if _, ok := ctx.Value(runCtxKey{}).(*runState); ok {
+		// nested graphs are accounted to the outermost run
+		return ctx
+	}
+	rs := &runState{
+		info: info,
+		run: RunCost{
+			Graph:     info.Name,
+			Session:   t.sessionFunc(ctx),
+			StartTime: time.Now(),
+			Models:    make(map[string]Cost),
+		},
+	}
+	return context.WithValue(ctx, runCtxKey{}, rs)
+}
+
+func (t *Tracker) endRun(ctx context.Context, info *callbacks.RunInfo) {
+	rs, ok := ctx.Value(runCtxKey{}).(*runState)
+	if !ok || rs.info != info {
+		return
+	}
+	// wait for the streams of models inside the run to be fully read
+	rs.pending.Wait()
+	if t.onRunEnd == nil {
+		return
+	}
+
+	rs.mu.Lock()
+	run := rs.run
+	run.EndTime = time.Now()
+	run.Models = make(map[string]Cost, len(rs.run.Models))
+	for k, v := range rs.run.Models {
+		run.Models[k] = v
+	}
+	rs.mu.Unlock()
+
+	t.onRunEnd(ctx, &run)
+}
+
+func (t *Tracker) OnStart(ctx context.Context, info *callbacks.RunInfo, input callbacks.CallbackInput) context.Context {
+	if info == nil {
+		return ctx
+	}
+	if isGraph(info) {
+		return t.startRun(ctx, info)
+	}
+	if in := model.ConvCallbackInput(input); in != nil && in.Config != nil && len(in.Config.Model) > 0 {
+		return context.WithValue(ctx, modelCtxKey{}, in.Config.Model)
+	}
+	return ctx
+}
+
+// modelName prefers the model reported at the end of the call, which is the actual model serving the request.
+func modelName(ctx context.Context, config *model.Config) string {
+	if config != nil && len(config.Model) > 0 {
+		return config.Model
+	}
+	name, _ := ctx.Value(modelCtxKey{}).(string)
+	return name
+}
+
+func (t *Tracker) OnEnd(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+	if info == nil {
+		return ctx
+	}
+	if isGraph(info) {
+		t.endRun(ctx, info)
+		return ctx
+	}
+	if out := model.ConvCallbackOutput(output); out != nil {
+		t.add(ctx, modelName(ctx, out.Config), out.TokenUsage)
+	}
+	return ctx
+}
+
+func (t *Tracker) OnError(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+	if info != nil && isGraph(info) {
+		t.endRun(ctx, info)
+	}
+	return ctx
+}
+
+func (t *Tracker) OnStartWithStreamInput(ctx context.Context, info *callbacks.RunInfo, input *schema.StreamReader[callbacks.CallbackInput]) context.Context {
+	input.Close()
+	if info != nil && isGraph(info) {
+		return t.startRun(ctx, info)
+	}
+	return ctx
+}
+
+func (t *Tracker) OnEndWithStreamOutput(ctx context.Context, info *callbacks.RunInfo, output *schema.StreamReader[callbacks.CallbackOutput]) context.Context {
+	if info == nil {
+		output.Close()
+		return ctx
+	}
+
+	graph := isGraph(info)
+	rs, _ := ctx.Value(runCtxKey{}).(*runState)
+	if !graph && rs != nil {
+		rs.pending.Add(1)
+	}
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				log.Printf("recover cost tracker panic: %v, runinfo: %+v, stack: %s", e, info, string(debug.Stack()))
+			}
+			output.Close()
+			if !graph && rs != nil {
+				rs.pending.Done()
+			}
+		}()
+		// usage is reported by the last chunk carrying it
+		var (
+			usage  *model.TokenUsage
+			config *model.Config
+		)
+		for {
+			chunk, err := output.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Printf("read stream output error: %v, runinfo: %+v", err, info)
+				break
+			}
+			if graph {
+				continue
+			}
+			if out := model.ConvCallbackOutput(chunk); out != nil {
+				if out.TokenUsage != nil {
+					usage = out.TokenUsage
+				}
+				if out.Config != nil {
+					config = out.Config
+				}
+			}
+		}
+		if graph {
+			t.endRun(ctx, info)
+			return
+		}
+		t.add(ctx, modelName(ctx, config), usage)
+	}()
+
+	return ctx
+}
+
+// Needed skips everything but ChatModel and graph runs.
+func (t *Tracker) Needed(ctx context.Context, info *callbacks.RunInfo, timing callbacks.CallbackTiming) bool {
+	if info == nil {
+		return false
+	}
+	return info.Component == components.ComponentOfChatModel || isGraph(info)
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cost
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func reportUsage(ctx context.Context, tracker *Tracker, modelName string, usage *model.TokenUsage) {
+	ctx = callbacks.InitCallbacks(ctx, &callbacks.RunInfo{Component: components.ComponentOfChatModel}, tracker)
+	ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, &model.CallbackInput{
+		Config: &model.Config{Model: modelName},
+	})
+	callbacks.OnEnd[callbacks.CallbackOutput](ctx, &model.CallbackOutput{
+		Message:    schema.AssistantMessage("ok", nil),
+		TokenUsage: usage,
+	})
+}
+
+func TestNewTracker(t *testing.T) {
+	_, err := NewTracker(nil)
+	assert.Error(t, err)
+	_, err = NewTracker(&Config{ExportInterval: -1})
+	assert.Error(t, err)
+	tracker, err := NewTracker(&Config{})
+	assert.NoError(t, err)
+	tracker.Close()
+}
+
+func TestPricing(t *testing.T) {
+	p := newPricing(map[string]Price{
+		"GPT-4o":         {PromptPerMillion: 1, CompletionPerMillion: 2},
+		"ep-20250101-ab": {PromptPerMillion: 3, CompletionPerMillion: 4},
+	})
+
+	price, ok := p.lookup("gpt-4o")
+	assert.True(t, ok)
+	assert.Equal(t, Price{PromptPerMillion: 1, CompletionPerMillion: 2}, price)
+	price, ok = p.lookup("gpt-4o-mini-2024-07-18")
+	assert.True(t, ok)
+	assert.Equal(t, DefaultPrices["gpt-4o-mini"], price)
+	price, ok = p.lookup("ep-20250101-ab")
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, price.PromptPerMillion)
+	_, ok = p.lookup("unknown-model")
+	assert.False(t, ok)
+
+	assert.InDelta(t, 0.003, Price{PromptPerMillion: 1, CompletionPerMillion: 2}.cost(1000, 1000), 1e-9)
+}
+
+func TestTracker(t *testing.T) {
+	var runs []*RunCost
+	tracker, err := NewTracker(&Config{
+		Prices: map[string]Price{"my-model": {PromptPerMillion: 1, CompletionPerMillion: 2}},
+		OnRunEnd: func(ctx context.Context, run *RunCost) {
+			runs = append(runs, run)
+		},
+	})
+	assert.NoError(t, err)
+	defer tracker.Close()
+
+	ctx := WithSession(context.Background(), "user-1")
+	graphCtx := callbacks.InitCallbacks(ctx, &callbacks.RunInfo{Name: "agent", Component: compose.ComponentOfGraph}, tracker)
+	graphCtx = callbacks.OnStart[callbacks.CallbackInput](graphCtx, "input")
+	reportUsage(graphCtx, tracker, "my-model", &model.TokenUsage{PromptTokens: 1000, CompletionTokens: 500})
+	reportUsage(graphCtx, tracker, "unknown-model", &model.TokenUsage{PromptTokens: 10, CompletionTokens: 5})
+	callbacks.OnEnd[callbacks.CallbackOutput](graphCtx, "output")
+
+	reportUsage(WithSession(context.Background(), "user-2"), tracker, "my-model", &model.TokenUsage{PromptTokens: 1000})
+
+	assert.Len(t, runs, 1)
+	assert.Equal(t, "agent", runs[0].Graph)
+	assert.Equal(t, "user-1", runs[0].Session)
+	assert.Equal(t, 2, runs[0].Calls)
+	assert.InDelta(t, 0.002, runs[0].Amount, 1e-9)
+	assert.Equal(t, 15, runs[0].UnpricedTokens)
+	assert.Equal(t, 1, runs[0].Models["my-model"].Calls)
+
+	assert.InDelta(t, 0.002, tracker.Session("user-1").Amount, 1e-9)
+	assert.InDelta(t, 0.001, tracker.Session("user-2").Amount, 1e-9)
+	assert.InDelta(t, 0.002, tracker.Graph("agent").Amount, 1e-9)
+	assert.InDelta(t, 0.001, tracker.Graph("").Amount, 1e-9)
+	assert.Equal(t, 3, tracker.Total().Calls)
+	assert.InDelta(t, 0.003, tracker.Total().Amount, 1e-9)
+
+	report := tracker.Report()
+	assert.Equal(t, 2, report.Models["my-model"].Calls)
+	assert.Len(t, report.Sessions, 2)
+
+	tracker.ResetSession("user-1")
+	assert.Equal(t, Cost{}, tracker.Session("user-1"))
+	assert.Equal(t, 3, tracker.Total().Calls)
+}
+
+func TestTrackerStream(t *testing.T) {
+	tracker, err := NewTracker(&Config{})
+	assert.NoError(t, err)
+	defer tracker.Close()
+
+	ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{Component: components.ComponentOfChatModel}, tracker)
+	sr := schema.StreamReaderFromArray([]callbacks.CallbackOutput{
+		&model.CallbackOutput{Message: schema.AssistantMessage("o", nil)},
+		&model.CallbackOutput{
+			Message:    schema.AssistantMessage("k", nil),
+			Config:     &model.Config{Model: "gpt-4o"},
+			TokenUsage: &model.TokenUsage{PromptTokens: 1000000, CompletionTokens: 100000},
+		},
+	})
+	_, out := callbacks.OnEndWithStreamOutput(ctx, sr)
+	out.Close()
+
+	assert.Eventually(t, func() bool {
+		return tracker.Total().Calls == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.InDelta(t, 3.5, tracker.Graph("").Amount, 1e-9)
+}
+
+func TestTrackerExport(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		reports []*Report
+	)
+	tracker, err := NewTracker(&Config{
+		ExportInterval: time.Hour,
+		Export: func(ctx context.Context, report *Report) {
+			mu.Lock()
+			defer mu.Unlock()
+			reports = append(reports, report)
+		},
+	})
+	assert.NoError(t, err)
+
+	reportUsage(context.Background(), tracker, "gpt-4o", &model.TokenUsage{PromptTokens: 100})
+	tracker.Close()
+	tracker.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, reports, 1)
+	assert.Equal(t, 1, reports[0].Total.Calls)
+}
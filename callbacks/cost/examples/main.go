@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino-ext/callbacks/cost"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+)
+
+// fakeChatModel always answers with a fixed message and reports its usage through callbacks.
+type fakeChatModel struct{}
+
+func (f *fakeChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	ctx = callbacks.EnsureRunInfo(ctx, f.GetType(), components.ComponentOfChatModel)
+	ctx = callbacks.OnStart(ctx, &model.CallbackInput{Messages: input, Config: &model.Config{Model: "gpt-4o-mini"}})
+	msg := schema.AssistantMessage("hello", nil)
+	callbacks.OnEnd(ctx, &model.CallbackOutput{
+		Message:    msg,
+		Config:     &model.Config{Model: "gpt-4o-mini-2024-07-18"},
+		TokenUsage: &model.TokenUsage{PromptTokens: 1200, CompletionTokens: 300, TotalTokens: 1500},
+	})
+	return msg, nil
+}
+
+func (f *fakeChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := f.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (f *fakeChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return f, nil
+}
+
+func (f *fakeChatModel) GetType() string {
+	return "Fake"
+}
+
+func (f *fakeChatModel) IsCallbacksEnabled() bool {
+	return true
+}
+
+func main() {
+	ctx := context.Background()
+
+	tracker, err := cost.NewTracker(&cost.Config{
+		OnRunEnd: func(ctx context.Context, run *cost.RunCost) {
+			log.Printf("run of %s for %s cost $%.6f in %d calls", run.Graph, run.Session, run.Amount, run.Calls)
+		},
+		Export: func(ctx context.Context, report *cost.Report) {
+			log.Printf("total cost so far: $%.6f", report.Total.Amount)
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer tracker.Close()
+	// Set tracker as a global callback, so that it sees the token usage of every ChatModel
+	callbacks.AppendGlobalHandlers(tracker)
+
+	chain := compose.NewChain[[]*schema.Message, *schema.Message]()
+	chain.AppendChatModel(&fakeChatModel{})
+	runner, err := chain.Compile(ctx, compose.WithGraphName("assistant"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, user := range []string{"alice", "bob", "alice"} {
+		_, err = runner.Invoke(cost.WithSession(ctx, user), []*schema.Message{schema.UserMessage("hi")})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	log.Printf("alice: $%.6f, bob: $%.6f", tracker.Session("alice").Amount, tracker.Session("bob").Amount)
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cost
+
+import (
+	"strings"
+)
+
+// Price is the price of a model per million tokens.
+type Price struct {
+	PromptPerMillion     float64
+	CompletionPerMillion float64
+}
+
+func (p Price) cost(promptTokens, completionTokens int) float64 {
+	return (float64(promptTokens)*p.PromptPerMillion + float64(completionTokens)*p.CompletionPerMillion) / 1e6
+}
+
+// DefaultPrices are list prices in USD per million tokens at the time of writing, keyed by model name.
+// Prices change, override them with Config.Prices where accuracy matters.
+var DefaultPrices = map[string]Price{
+	// openai
+	"gpt-4o":        {PromptPerMillion: 2.5, CompletionPerMillion: 10},
+	"gpt-4o-mini":   {PromptPerMillion: 0.15, CompletionPerMillion: 0.6},
+	"gpt-4.1":       {PromptPerMillion: 2, CompletionPerMillion: 8},
+	"gpt-4.1-mini":  {PromptPerMillion: 0.4, CompletionPerMillion: 1.6},
+	"gpt-4.1-nano":  {PromptPerMillion: 0.1, CompletionPerMillion: 0.4},
+	"gpt-4-turbo":   {PromptPerMillion: 10, CompletionPerMillion: 30},
+	"gpt-4":         {PromptPerMillion: 30, CompletionPerMillion: 60},
+	"gpt-3.5-turbo": {PromptPerMillion: 0.5, CompletionPerMillion: 1.5},
+	"o1":            {PromptPerMillion: 15, CompletionPerMillion: 60},
+	"o1-mini":       {PromptPerMillion: 1.1, CompletionPerMillion: 4.4},
+	"o3":            {PromptPerMillion: 2, CompletionPerMillion: 8},
+	"o3-mini":       {PromptPerMillion: 1.1, CompletionPerMillion: 4.4},
+	"o4-mini":       {PromptPerMillion: 1.1, CompletionPerMillion: 4.4},
+
+	// deepseek
+	"deepseek-chat":     {PromptPerMillion: 0.27, CompletionPerMillion: 1.1},
+	"deepseek-reasoner": {PromptPerMillion: 0.55, CompletionPerMillion: 2.19},
+
+	// ark, converted from CNY list prices
+	"doubao-1.5-pro-32k":  {PromptPerMillion: 0.11, CompletionPerMillion: 0.28},
+	"doubao-1.5-pro-256k": {PromptPerMillion: 0.69, CompletionPerMillion: 1.25},
+	"doubao-1.5-lite-32k": {PromptPerMillion: 0.042, CompletionPerMillion: 0.083},
+
+	// claude
+	"claude-3-7-sonnet": {PromptPerMillion: 3, CompletionPerMillion: 15},
+	"claude-3-5-sonnet": {PromptPerMillion: 3, CompletionPerMillion: 15},
+	"claude-3-5-haiku":  {PromptPerMillion: 0.8, CompletionPerMillion: 4},
+
+	// gemini
+	"gemini-2.0-flash": {PromptPerMillion: 0.1, CompletionPerMillion: 0.4},
+	"gemini-1.5-pro":   {PromptPerMillion: 1.25, CompletionPerMillion: 5},
+	"gemini-1.5-flash": {PromptPerMillion: 0.075, CompletionPerMillion: 0.3},
+}
+
+// pricing resolves model names to prices, falling back to the longest known prefix,
+// so that dated versions like "gpt-4o-2024-08-06" share the price of "gpt-4o".
+type pricing struct {
+	prices map[string]Price
+}
+
+func newPricing(overrides map[string]Price) *pricing {
+	prices := make(map[string]Price, len(DefaultPrices)+len(overrides))
+	for name, price := range DefaultPrices {
+		prices[strings.ToLower(name)] = price
+	}
+	for name, price := range overrides {
+		prices[strings.ToLower(name)] = price
+	}
+	return &pricing{prices: prices}
+}
+
+func (p *pricing) lookup(model string) (Price, bool) {
+	model = strings.ToLower(model)
+	if price, ok := p.prices[model]; ok {
+		return price, true
+	}
+
+	var (
+		matched string
+		price   Price
+	)
+	for name, pr := range p.prices {
+		if len(name) > len(matched) && strings.HasPrefix(model, name) {
+			matched, price = name, pr
+		}
+	}
+	return price, len(matched) > 0
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino-ext/callbacks/logging"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/compose"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// write json logs to ./logs/eino.log, rotated every 10MB with 3 backups kept
+	file, err := logging.NewRotatingFile("logs/eino.log", 10<<20, 3)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	// Set logging as a global callback
+	callbacks.AppendGlobalHandlers(logging.NewLoggingHandler(&logging.Config{
+		Writer:          file,
+		MaxStringLength: 256,
+	}))
+
+	chain := compose.NewChain[string, string]()
+	chain.AppendLambda(compose.InvokableLambda(func(ctx context.Context, input string) (string, error) {
+		return "hello, " + input, nil
+	}))
+	runner, err := chain.Compile(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	out, err := runner.Invoke(ctx, "eino")
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println(out)
+}
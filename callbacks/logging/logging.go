@@ -0,0 +1,290 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	EventStart = "start"
+	EventEnd   = "end"
+	EventError = "error"
+)
+
+type Config struct {
+	// Writer is where log records are written to, one JSON object per line (Optional)
+	// Default: os.Stdout
+	// Example: NewRotatingFile("eino.log", 100<<20, 3)
+	Writer io.Writer
+
+	// MaxStringLength truncates every string value inside input and output to this many bytes (Optional)
+	// Default: 1024, negative means no limit
+	MaxStringLength int
+
+	// MaxItems truncates every array inside input and output to this many elements,
+	// and caps the number of logged stream chunks (Optional)
+	// Default: 100, negative means no limit
+	MaxItems int
+
+	// RedactKeys are object keys whose values are replaced by "[REDACTED]", matched case-insensitively
+	// ignoring "_" and "-" (Optional)
+	// Default: DefaultRedactKeys
+	RedactKeys []string
+
+	// RedactPatterns are masked with "[REDACTED]" wherever they appear in string values (Optional)
+	// Default: DefaultRedactPatterns
+	RedactPatterns []*regexp.Regexp
+
+	// OmitInput and OmitOutput drop inputs and outputs from the records entirely (Optional)
+	// Default: false
+	OmitInput  bool
+	OmitOutput bool
+}
+
+// Record is a single log line.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Event     string    `json:"event"`
+	RunID     string    `json:"run_id"`
+	ParentID  string    `json:"parent_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Component string    `json:"component,omitempty"`
+	Stream    bool      `json:"stream,omitempty"`
+	// Duration is in milliseconds, set on end and error events.
+	Duration *float64 `json:"duration_ms,omitempty"`
+	Input    any      `json:"input,omitempty"`
+	Output   any      `json:"output,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// NewLoggingHandler creates a callback handler that writes structured JSON logs of every
+// component start, end and error, with secrets redacted and large values truncated.
+func NewLoggingHandler(cfg *Config) callbacks.Handler {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	h := &loggingHandler{
+		writer:          cfg.Writer,
+		maxStringLength: cfg.MaxStringLength,
+		maxItems:        cfg.MaxItems,
+		redactPatterns:  cfg.RedactPatterns,
+		omitInput:       cfg.OmitInput,
+		omitOutput:      cfg.OmitOutput,
+	}
+	if h.writer == nil {
+		h.writer = os.Stdout
+	}
+	if h.maxStringLength == 0 {
+		h.maxStringLength = 1024
+	}
+	if h.maxItems == 0 {
+		h.maxItems = 100
+	}
+	redactKeys := cfg.RedactKeys
+	if redactKeys == nil {
+		redactKeys = DefaultRedactKeys
+	}
+	h.redactKeys = make(map[string]bool, len(redactKeys))
+	for _, k := range redactKeys {
+		h.redactKeys[normalizeKey(k)] = true
+	}
+	if h.redactPatterns == nil {
+		h.redactPatterns = DefaultRedactPatterns
+	}
+	return h
+}
+
+type loggingHandler struct {
+	writer          io.Writer
+	maxStringLength int
+	maxItems        int
+	redactKeys      map[string]bool
+	redactPatterns  []*regexp.Regexp
+	omitInput       bool
+	omitOutput      bool
+
+	mu sync.Mutex
+}
+
+type loggingStateKey struct{}
+type loggingState struct {
+	runID     string
+	startTime time.Time
+}
+
+func (l *loggingHandler) OnStart(ctx context.Context, info *callbacks.RunInfo, input callbacks.CallbackInput) context.Context {
+	ctx, rec := l.start(ctx, info)
+	if !l.omitInput {
+		rec.Input = l.sanitize(input)
+	}
+	l.write(rec)
+	return ctx
+}
+
+func (l *loggingHandler) OnEnd(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+	rec := l.end(ctx, info, EventEnd)
+	if !l.omitOutput {
+		rec.Output = l.sanitize(output)
+	}
+	l.write(rec)
+	return ctx
+}
+
+func (l *loggingHandler) OnError(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+	rec := l.end(ctx, info, EventError)
+	if err != nil {
+		rec.Error = l.sanitizeString(err.Error())
+	}
+	l.write(rec)
+	return ctx
+}
+
+func (l *loggingHandler) OnStartWithStreamInput(ctx context.Context, info *callbacks.RunInfo, input *schema.StreamReader[callbacks.CallbackInput]) context.Context {
+	ctx, rec := l.start(ctx, info)
+	rec.Stream = true
+	if l.omitInput {
+		input.Close()
+		l.write(rec)
+		return ctx
+	}
+
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				log.Printf("recover log stream input panic: %v, runinfo: %+v, stack: %s", e, info, string(debug.Stack()))
+			}
+			input.Close()
+		}()
+		rec.Input = l.sanitize(collect(input, l.maxItems, info))
+		l.write(rec)
+	}()
+
+	return ctx
+}
+
+func (l *loggingHandler) OnEndWithStreamOutput(ctx context.Context, info *callbacks.RunInfo, output *schema.StreamReader[callbacks.CallbackOutput]) context.Context {
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				log.Printf("recover log stream output panic: %v, runinfo: %+v, stack: %s", e, info, string(debug.Stack()))
+			}
+			output.Close()
+		}()
+		chunks := collect(output, l.maxItems, info)
+		// the run ends when its output stream is drained, not when the stream is returned
+		rec := l.end(ctx, info, EventEnd)
+		rec.Stream = true
+		if !l.omitOutput {
+			rec.Output = l.sanitize(chunks)
+		}
+		l.write(rec)
+	}()
+
+	return ctx
+}
+
+func (l *loggingHandler) start(ctx context.Context, info *callbacks.RunInfo) (context.Context, *Record) {
+	state := &loggingState{
+		runID:     newRunID(),
+		startTime: time.Now(),
+	}
+	rec := newRecord(info, EventStart, state.runID)
+	rec.Time = state.startTime
+	if parent, ok := ctx.Value(loggingStateKey{}).(*loggingState); ok {
+		rec.ParentID = parent.runID
+	}
+	return context.WithValue(ctx, loggingStateKey{}, state), rec
+}
+
+func (l *loggingHandler) end(ctx context.Context, info *callbacks.RunInfo, event string) *Record {
+	state, ok := ctx.Value(loggingStateKey{}).(*loggingState)
+	if !ok {
+		return newRecord(info, event, "")
+	}
+	rec := newRecord(info, event, state.runID)
+	duration := float64(rec.Time.Sub(state.startTime).Microseconds()) / 1000
+	rec.Duration = &duration
+	return rec
+}
+
+func newRecord(info *callbacks.RunInfo, event, runID string) *Record {
+	rec := &Record{
+		Time:  time.Now(),
+		Event: event,
+		RunID: runID,
+	}
+	if info != nil {
+		rec.Name = info.Name
+		rec.Type = info.Type
+		rec.Component = string(info.Component)
+	}
+	return rec
+}
+
+// collect drains a stream copy, keeping at most maxItems chunks.
+func collect[T any](sr *schema.StreamReader[T], maxItems int, info *callbacks.RunInfo) []T {
+	var chunks []T
+	for {
+		chunk, err := sr.Recv()
+		if err == io.EOF {
+			return chunks
+		}
+		if err != nil {
+			log.Printf("read stream error: %v, runinfo: %+v", err, info)
+			return chunks
+		}
+		if maxItems < 0 || len(chunks) < maxItems {
+			chunks = append(chunks, chunk)
+		}
+	}
+}
+
+func (l *loggingHandler) write(rec *Record) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		log.Printf("marshal log record error: %v", err)
+		return
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err = l.writer.Write(b); err != nil {
+		log.Printf("write log record error: %v", err)
+	}
+}
+
+func newRunID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
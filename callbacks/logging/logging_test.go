@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) records(t *testing.T) []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var ret []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		var rec map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(line), &rec))
+		ret = append(ret, rec)
+	}
+	return ret
+}
+
+func TestLoggingHandler(t *testing.T) {
+	t.Run("start and end", func(t *testing.T) {
+		buf := &syncBuffer{}
+		handler := NewLoggingHandler(&Config{Writer: buf})
+
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{
+			Name:      "graph",
+			Type:      "Graph",
+			Component: "Graph",
+		}, handler)
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, map[string]any{"query": "hi"})
+
+		modelCtx := callbacks.ReuseHandlers(ctx, &callbacks.RunInfo{
+			Name:      "chat",
+			Type:      "OpenAI",
+			Component: components.ComponentOfChatModel,
+		})
+		modelCtx = callbacks.OnStart[callbacks.CallbackInput](modelCtx, &model.CallbackInput{
+			Messages: []*schema.Message{schema.UserMessage("my key is sk-abcdefghijklmnopqrstuvwxyz")},
+			Extra:    map[string]any{"apiKey": "xxx", "prompt_tokens": 3},
+		})
+		callbacks.OnEnd[callbacks.CallbackOutput](modelCtx, &model.CallbackOutput{
+			Message: schema.AssistantMessage("hello", nil),
+		})
+		callbacks.OnEnd[callbacks.CallbackOutput](ctx, "done")
+
+		recs := buf.records(t)
+		assert.Len(t, recs, 4)
+		assert.Equal(t, []any{EventStart, EventStart, EventEnd, EventEnd},
+			[]any{recs[0]["event"], recs[1]["event"], recs[2]["event"], recs[3]["event"]})
+
+		graphID, modelID := recs[0]["run_id"], recs[1]["run_id"]
+		assert.NotEqual(t, graphID, modelID)
+		assert.Equal(t, graphID, recs[1]["parent_id"])
+		assert.Equal(t, modelID, recs[2]["run_id"])
+		assert.Equal(t, graphID, recs[3]["run_id"])
+		assert.Equal(t, "ChatModel", recs[1]["component"])
+		assert.Contains(t, recs[2], "duration_ms")
+		assert.NotContains(t, recs[1], "duration_ms")
+
+		input := recs[1]["input"].(map[string]any)
+		msg := input["Messages"].([]any)[0].(map[string]any)
+		assert.Equal(t, "my key is [REDACTED]", msg["content"])
+		assert.Equal(t, map[string]any{"apiKey": "[REDACTED]", "prompt_tokens": float64(3)}, input["Extra"])
+		assert.Equal(t, "hello", recs[2]["output"].(map[string]any)["Message"].(map[string]any)["content"])
+		assert.Equal(t, "done", recs[3]["output"])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		buf := &syncBuffer{}
+		handler := NewLoggingHandler(&Config{Writer: buf, OmitInput: true})
+
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{Name: "tool"}, handler)
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, "secret input")
+		callbacks.OnError(ctx, errors.New("auth failed with Bearer abc.def"))
+
+		recs := buf.records(t)
+		assert.Len(t, recs, 2)
+		assert.NotContains(t, recs[0], "input")
+		assert.Equal(t, EventError, recs[1]["event"])
+		assert.Equal(t, "auth failed with [REDACTED]", recs[1]["error"])
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		buf := &syncBuffer{}
+		handler := NewLoggingHandler(&Config{Writer: buf, MaxItems: 2})
+
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{Name: "stream"}, handler)
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, "in")
+
+		sr, sw := schema.Pipe[callbacks.CallbackOutput](3)
+		for _, chunk := range []string{"a", "b", "c"} {
+			sw.Send(chunk, nil)
+		}
+		sw.Close()
+		_, out := callbacks.OnEndWithStreamOutput(ctx, sr)
+		out.Close()
+
+		assert.Eventually(t, func() bool { return len(buf.records(t)) == 2 }, time.Second, 10*time.Millisecond)
+		rec := buf.records(t)[1]
+		assert.Equal(t, true, rec["stream"])
+		assert.Equal(t, []any{"a", "b"}, rec["output"])
+	})
+}
+
+func TestSanitize(t *testing.T) {
+	h := NewLoggingHandler(&Config{MaxStringLength: 8, MaxItems: 2}).(*loggingHandler)
+
+	assert.Equal(t, "abc", h.sanitize("abc"))
+	assert.Equal(t, "abcdefgh...(truncated 2 bytes)", h.sanitize("abcdefghij"))
+	assert.Equal(t, "你好...(truncated 3 bytes)", h.sanitize("你好世"))
+	assert.Equal(t, []any{json.Number("1"), json.Number("2"), "...(2 more items)"}, h.sanitize([]int{1, 2, 3, 4}))
+	assert.Equal(t, map[string]any{
+		"Password":     "[REDACTED]",
+		"total_tokens": json.Number("10"),
+		"headers":      map[string]any{"Authorization": "[REDACTED]"},
+	}, h.sanitize(map[string]any{
+		"Password":     "p",
+		"total_tokens": 10,
+		"headers":      map[string]string{"Authorization": "Bearer x"},
+	}))
+	// values that can not be marshaled fall back to their fmt representation
+	_, ok := h.sanitize(make(chan int)).(string)
+	assert.True(t, ok)
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const redacted = "[REDACTED]"
+
+// DefaultRedactKeys are object keys whose values are always secrets.
+// Keys are compared after lower-casing and removing "_" and "-", so "apiKey" and "API-KEY" both match "api_key".
+var DefaultRedactKeys = []string{
+	"api_key", "access_key", "secret_key", "private_key", "secret",
+	"password", "passwd", "token", "access_token", "refresh_token",
+	"authorization", "cookie",
+}
+
+// DefaultRedactPatterns match well-known credential formats inside free text.
+var DefaultRedactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)\bbearer\s+[a-z0-9._~+/=-]+`),
+	regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{16,}`),
+	regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+}
+
+func normalizeKey(k string) string {
+	k = strings.ToLower(k)
+	k = strings.ReplaceAll(k, "_", "")
+	return strings.ReplaceAll(k, "-", "")
+}
+
+// sanitize converts v to its JSON form, then redacts secrets and truncates long strings and arrays.
+func (l *loggingHandler) sanitize(v any) any {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return l.sanitizeString(fmt.Sprintf("%+v", v))
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err = dec.Decode(&generic); err != nil {
+		return l.sanitizeString(string(b))
+	}
+	return l.sanitizeValue(generic)
+}
+
+func (l *loggingHandler) sanitizeValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if l.redactKeys[normalizeKey(k)] {
+				t[k] = redacted
+				continue
+			}
+			t[k] = l.sanitizeValue(val)
+		}
+		return t
+	case []any:
+		var omitted int
+		if l.maxItems >= 0 && len(t) > l.maxItems {
+			omitted = len(t) - l.maxItems
+			t = t[:l.maxItems]
+		}
+		for i := range t {
+			t[i] = l.sanitizeValue(t[i])
+		}
+		if omitted > 0 {
+			t = append(t, fmt.Sprintf("...(%d more items)", omitted))
+		}
+		return t
+	case string:
+		return l.sanitizeString(t)
+	default:
+		return v
+	}
+}
+
+func (l *loggingHandler) sanitizeString(s string) string {
+	for _, p := range l.redactPatterns {
+		s = p.ReplaceAllString(s, redacted)
+	}
+	if l.maxStringLength < 0 || len(s) <= l.maxStringLength {
+		return s
+	}
+	cut := l.maxStringLength
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(truncated %d bytes)", s[:cut], len(s)-cut)
+}
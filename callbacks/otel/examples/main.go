@@ -0,0 +1,52 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino-ext/callbacks/otel"
+	"github.com/cloudwego/eino/callbacks"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// init otel callback, spans and metrics are exported to any OTLP/gRPC endpoint,
+	// e.g. a local Jaeger started by: docker run -p 16686:16686 -p 4317:4317 jaegertracing/all-in-one
+	cbh, shutdown, err := otel.NewOtelHandler(&otel.Config{
+		Endpoint:       "localhost:4317",
+		Insecure:       true,
+		ServiceName:    "eino-app",
+		ServiceVersion: "v0.0.1",
+		Environment:    "dev",
+		ResourceAttributes: map[string]string{
+			"team": "search",
+		},
+		SampleRatio: 0.5,
+	})
+	if shutdown != nil {
+		defer shutdown(ctx)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Set otel as a global callback
+	callbacks.AppendGlobalHandlers(cbh)
+}
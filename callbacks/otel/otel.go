@@ -0,0 +1,593 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/eino-ext/libs/acl/opentelemetry"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const scopeName = "github.com/cloudwego/eino-ext/callbacks/otel"
+
+type Config struct {
+	// Endpoint is the OTLP/gRPC collector address, e.g. Jaeger, Tempo or the Datadog agent (Optional)
+	// Default: the OTEL_EXPORTER_OTLP_ENDPOINT environment variable, or "localhost:4317"
+	// Example: "otel-collector:4317"
+	Endpoint string
+
+	// Headers are sent with every export request, typically for authentication (Optional)
+	// Example: map[string]string{"authorization": "Bearer xxx"}
+	Headers map[string]string
+
+	// Insecure disables TLS for the exporter connection (Optional)
+	// Default: false
+	Insecure bool
+
+	// ServiceName is the `service.name` resource attribute (Required)
+	// Example: "my-app"
+	ServiceName string
+
+	// ServiceVersion is the `service.version` resource attribute (Optional)
+	// Example: "v1.2.3"
+	ServiceVersion string
+
+	// Environment is the `deployment.environment` resource attribute (Optional)
+	// Example: "production"
+	Environment string
+
+	// ResourceAttributes are extra resource attributes attached to all spans and metrics (Optional)
+	// Example: map[string]string{"team": "search"}
+	ResourceAttributes map[string]string
+
+	// SampleRatio is the fraction of root traces to sample, in (0, 1].
+	// Child spans follow their parent's sampling decision (Optional)
+	// Default: 1, sample everything
+	SampleRatio float64
+
+	// Sampler overrides SampleRatio with a custom sampler (Optional)
+	Sampler sdktrace.Sampler
+
+	// CaptureContent records prompt and completion contents as span events.
+	// Contents may carry sensitive data, so they are off by default (Optional)
+	// Default: false
+	CaptureContent bool
+
+	// TracerProvider and MeterProvider replace the OTLP exporters built from Endpoint,
+	// which is useful when the application already configured OpenTelemetry (Optional)
+	TracerProvider *sdktrace.TracerProvider
+	MeterProvider  *sdkmetric.MeterProvider
+}
+
+// NewOtelHandler creates a callback handler that reports eino executions as spans and metrics
+// following the OpenTelemetry GenAI semantic conventions, exported to any OTLP compatible backend.
+func NewOtelHandler(cfg *Config) (handler callbacks.Handler, shutdown func(ctx context.Context) error, err error) {
+	if cfg == nil {
+		return nil, nil, errors.New("config is nil")
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, nil, fmt.Errorf("sample ratio must be in (0, 1], got %v", cfg.SampleRatio)
+	}
+
+	opts := []opentelemetry.Option{
+		opentelemetry.WithServiceName(cfg.ServiceName),
+		opentelemetry.WithSampler(newSampler(cfg)),
+		opentelemetry.WithResourceAttributes(resourceAttributes(cfg)),
+	}
+	if len(cfg.Endpoint) > 0 {
+		opts = append(opts, opentelemetry.WithExportEndpoint(cfg.Endpoint))
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, opentelemetry.WithHeaders(cfg.Headers))
+	}
+	if cfg.Insecure {
+		opts = append(opts, opentelemetry.WithInsecure())
+	}
+	if len(cfg.Environment) > 0 {
+		opts = append(opts, opentelemetry.WithDeploymentEnvironment(cfg.Environment))
+	}
+	if cfg.TracerProvider != nil {
+		opts = append(opts, opentelemetry.WithSdkTracerProvider(cfg.TracerProvider))
+	}
+	if cfg.MeterProvider != nil {
+		opts = append(opts, opentelemetry.WithMeterProvider(cfg.MeterProvider))
+	}
+
+	p, err := opentelemetry.NewOpenTelemetryProvider(opts...)
+	if p == nil || err != nil {
+		return nil, nil, fmt.Errorf("init opentelemetry provider failed: %v", err)
+	}
+	if p.TracerProvider == nil || p.MeterProvider == nil {
+		return nil, p.Shutdown, errors.New("tracer provider or meter provider is nil")
+	}
+
+	meter := p.MeterProvider.Meter(scopeName)
+
+	tokenUsage, err := meter.Int64Histogram(
+		"gen_ai.client.token.usage",
+		metric.WithDescription("Measures number of input and output tokens used"),
+		metric.WithUnit("{token}"),
+		metric.WithExplicitBucketBoundaries(1, 4, 16, 64, 256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216, 67108864),
+	)
+	if err != nil {
+		return nil, p.Shutdown, err
+	}
+
+	operationDuration, err := meter.Float64Histogram(
+		"gen_ai.client.operation.duration",
+		metric.WithDescription("GenAI operation duration"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.01, 0.02, 0.04, 0.08, 0.16, 0.32, 0.64, 1.28, 2.56, 5.12, 10.24, 20.48, 40.96, 81.92),
+	)
+	if err != nil {
+		return nil, p.Shutdown, err
+	}
+
+	return &otelHandler{
+		tracer:            p.TracerProvider.Tracer(scopeName),
+		captureContent:    cfg.CaptureContent,
+		tokenUsage:        tokenUsage,
+		operationDuration: operationDuration,
+	}, p.Shutdown, nil
+}
+
+func newSampler(cfg *Config) sdktrace.Sampler {
+	if cfg.Sampler != nil {
+		return cfg.Sampler
+	}
+	if cfg.SampleRatio == 0 || cfg.SampleRatio == 1 {
+		return sdktrace.AlwaysSample()
+	}
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))
+}
+
+func resourceAttributes(cfg *Config) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(cfg.ResourceAttributes)+1)
+	if len(cfg.ServiceVersion) > 0 {
+		attrs = append(attrs, attribute.String("service.version", cfg.ServiceVersion))
+	}
+	for k, v := range cfg.ResourceAttributes {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+	return attrs
+}
+
+type otelHandler struct {
+	tracer         trace.Tracer
+	captureContent bool
+
+	tokenUsage        metric.Int64Histogram
+	operationDuration metric.Float64Histogram
+}
+
+type otelStateKey struct{}
+type otelState struct {
+	startTime time.Time
+	span      trace.Span
+	operation string
+	system    string
+	model     string
+
+	// inputDone is closed once the stream input has been consumed, nil for non-stream input.
+	inputDone chan struct{}
+}
+
+func (o *otelHandler) OnStart(ctx context.Context, info *callbacks.RunInfo, input callbacks.CallbackInput) context.Context {
+	if info == nil {
+		return ctx
+	}
+
+	ctx, state := o.startSpan(ctx, info)
+	switch info.Component {
+	case components.ComponentOfChatModel:
+		config, messages, _, err := extractModelInput(convModelCallbackInput([]callbacks.CallbackInput{input}))
+		if err != nil {
+			log.Printf("extract model input error: %v, runinfo: %+v", err, info)
+			break
+		}
+		o.setRequestAttributes(state, config, messages)
+	case components.ComponentOfEmbedding:
+		if in := embedding.ConvCallbackInput(input); in != nil && in.Config != nil {
+			state.model = in.Config.Model
+			state.span.SetAttributes(attribute.String("gen_ai.request.model", in.Config.Model))
+		}
+	case components.ComponentOfTool:
+		if in := tool.ConvCallbackInput(input); in != nil && o.captureContent {
+			state.span.AddEvent("gen_ai.tool.message", trace.WithAttributes(
+				attribute.String("gen_ai.system", state.system),
+				attribute.String("content", in.ArgumentsInJSON),
+			))
+		}
+	}
+	o.setSpanName(state)
+
+	return context.WithValue(ctx, otelStateKey{}, state)
+}
+
+func (o *otelHandler) OnEnd(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+	if info == nil {
+		return ctx
+	}
+
+	state, ok := ctx.Value(otelStateKey{}).(*otelState)
+	if !ok {
+		log.Printf("no state in context, runinfo: %+v", info)
+		return ctx
+	}
+	endTime := time.Now()
+	waitStreamInput(state)
+	defer state.span.End(trace.WithTimestamp(time.Now()))
+
+	switch info.Component {
+	case components.ComponentOfChatModel:
+		usage, messages, _, config, err := extractModelOutput(convModelCallbackOutput([]callbacks.CallbackOutput{output}))
+		if err != nil {
+			log.Printf("extract model output error: %v, runinfo: %+v", err, info)
+			break
+		}
+		o.setResponseAttributes(ctx, state, usage, config, messages)
+	case components.ComponentOfEmbedding:
+		if out := embedding.ConvCallbackOutput(output); out != nil {
+			var usage *model.TokenUsage
+			if out.TokenUsage != nil {
+				usage = &model.TokenUsage{PromptTokens: out.TokenUsage.PromptTokens}
+			}
+			var config *model.Config
+			if out.Config != nil {
+				config = &model.Config{Model: out.Config.Model}
+			}
+			o.setResponseAttributes(ctx, state, usage, config, nil)
+		}
+	case components.ComponentOfTool:
+		if out := tool.ConvCallbackOutput(output); out != nil && o.captureContent {
+			state.span.AddEvent("gen_ai.choice", trace.WithAttributes(
+				attribute.String("gen_ai.system", state.system),
+				attribute.String("content", out.Response),
+			))
+		}
+	}
+	o.recordDuration(ctx, state, endTime, "")
+
+	return ctx
+}
+
+func (o *otelHandler) OnError(ctx context.Context, info *callbacks.RunInfo, err error) context.Context {
+	if info == nil {
+		return ctx
+	}
+
+	state, ok := ctx.Value(otelStateKey{}).(*otelState)
+	if !ok {
+		log.Printf("no state in context, runinfo: %+v", info)
+		return ctx
+	}
+	waitStreamInput(state)
+	defer state.span.End(trace.WithTimestamp(time.Now()))
+
+	errorType := fmt.Sprintf("%T", err)
+	state.span.SetAttributes(attribute.String("error.type", errorType))
+	state.span.SetStatus(codes.Error, err.Error())
+	state.span.RecordError(err)
+	o.recordDuration(ctx, state, time.Now(), errorType)
+
+	return ctx
+}
+
+func (o *otelHandler) OnStartWithStreamInput(ctx context.Context, info *callbacks.RunInfo, input *schema.StreamReader[callbacks.CallbackInput]) context.Context {
+	if info == nil {
+		input.Close()
+		return ctx
+	}
+
+	ctx, state := o.startSpan(ctx, info)
+
+	state.inputDone = make(chan struct{})
+
+	go func() {
+		defer func() {
+			e := recover()
+			if e != nil {
+				log.Printf("recover update span panic: %v, runinfo: %+v, stack: %s", e, info, string(debug.Stack()))
+			}
+			input.Close()
+			close(state.inputDone)
+		}()
+		var ins []callbacks.CallbackInput
+		for {
+			chunk, err := input.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Printf("read stream input error: %v, runinfo: %+v", err, info)
+				return
+			}
+			ins = append(ins, chunk)
+		}
+		if info.Component != components.ComponentOfChatModel {
+			return
+		}
+		config, messages, _, err := extractModelInput(convModelCallbackInput(ins))
+		if err != nil {
+			log.Printf("extract stream model input error: %v, runinfo: %+v", err, info)
+			return
+		}
+		o.setRequestAttributes(state, config, messages)
+		o.setSpanName(state)
+	}()
+
+	return context.WithValue(ctx, otelStateKey{}, state)
+}
+
+func (o *otelHandler) OnEndWithStreamOutput(ctx context.Context, info *callbacks.RunInfo, output *schema.StreamReader[callbacks.CallbackOutput]) context.Context {
+	if info == nil {
+		output.Close()
+		return ctx
+	}
+
+	state, ok := ctx.Value(otelStateKey{}).(*otelState)
+	if !ok {
+		log.Printf("no state in context, runinfo: %+v", info)
+		output.Close()
+		return ctx
+	}
+
+	go func() {
+		defer func() {
+			e := recover()
+			if e != nil {
+				log.Printf("recover update span panic: %v, runinfo: %+v, stack: %s", e, info, string(debug.Stack()))
+			}
+			output.Close()
+			state.span.End(trace.WithTimestamp(time.Now()))
+		}()
+		var outs []callbacks.CallbackOutput
+		for {
+			chunk, err := output.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Printf("read stream output error: %v, runinfo: %+v", err, info)
+				break
+			}
+			outs = append(outs, chunk)
+		}
+		endTime := time.Now()
+		waitStreamInput(state)
+		if info.Component == components.ComponentOfChatModel {
+			usage, messages, _, config, err := extractModelOutput(convModelCallbackOutput(outs))
+			if err != nil {
+				log.Printf("extract stream model output error: %v, runinfo: %+v", err, info)
+			} else {
+				o.setResponseAttributes(ctx, state, usage, config, messages)
+			}
+		}
+		o.recordDuration(ctx, state, endTime, "")
+	}()
+
+	return ctx
+}
+
+// waitStreamInput blocks until the stream input of the run, if any, has been consumed,
+// so that request attributes are complete before the response is handled.
+func waitStreamInput(state *otelState) {
+	if state.inputDone != nil {
+		<-state.inputDone
+	}
+}
+
+func (o *otelHandler) startSpan(ctx context.Context, info *callbacks.RunInfo) (context.Context, *otelState) {
+	state := &otelState{
+		startTime: time.Now(),
+		operation: getOperation(info),
+		system:    getSystem(info),
+	}
+
+	kind := trace.SpanKindInternal
+	if info.Component == components.ComponentOfChatModel || info.Component == components.ComponentOfEmbedding {
+		kind = trace.SpanKindClient
+	}
+	ctx, state.span = o.tracer.Start(ctx, getName(info), trace.WithSpanKind(kind), trace.WithTimestamp(state.startTime))
+
+	state.span.SetAttributes(
+		attribute.String("gen_ai.operation.name", state.operation),
+		attribute.String("gen_ai.system", state.system),
+		attribute.String("eino.component", string(info.Component)),
+		attribute.String("eino.type", info.Type),
+	)
+	if info.Component == components.ComponentOfTool {
+		state.span.SetAttributes(attribute.String("gen_ai.tool.name", info.Name))
+	}
+
+	return ctx, state
+}
+
+// setSpanName renames model spans to "{gen_ai.operation.name} {gen_ai.request.model}" once the model is known.
+func (o *otelHandler) setSpanName(state *otelState) {
+	if len(state.model) > 0 {
+		state.span.SetName(state.operation + " " + state.model)
+	}
+}
+
+func (o *otelHandler) setRequestAttributes(state *otelState, config *model.Config, messages []*schema.Message) {
+	if config != nil {
+		state.model = config.Model
+		state.span.SetAttributes(attribute.String("gen_ai.request.model", config.Model))
+		if config.MaxTokens > 0 {
+			state.span.SetAttributes(attribute.Int("gen_ai.request.max_tokens", config.MaxTokens))
+		}
+		if config.Temperature > 0 {
+			state.span.SetAttributes(attribute.Float64("gen_ai.request.temperature", float64(config.Temperature)))
+		}
+		if config.TopP > 0 {
+			state.span.SetAttributes(attribute.Float64("gen_ai.request.top_p", float64(config.TopP)))
+		}
+		if len(config.Stop) > 0 {
+			state.span.SetAttributes(attribute.StringSlice("gen_ai.request.stop_sequences", config.Stop))
+		}
+	}
+
+	if !o.captureContent {
+		return
+	}
+	for _, msg := range messages {
+		if msg == nil {
+			continue
+		}
+		attrs := []attribute.KeyValue{
+			attribute.String("gen_ai.system", state.system),
+			attribute.String("content", msg.Content),
+		}
+		if len(msg.ToolCalls) > 0 {
+			if calls, err := sonic.MarshalString(msg.ToolCalls); err == nil {
+				attrs = append(attrs, attribute.String("tool_calls", calls))
+			}
+		}
+		if len(msg.ToolCallID) > 0 {
+			attrs = append(attrs, attribute.String("id", msg.ToolCallID))
+		}
+		state.span.AddEvent(fmt.Sprintf("gen_ai.%s.message", msg.Role), trace.WithAttributes(attrs...))
+	}
+}
+
+func (o *otelHandler) setResponseAttributes(ctx context.Context, state *otelState, usage *model.TokenUsage, config *model.Config, messages []*schema.Message) {
+	responseModel := state.model
+	if config != nil && len(config.Model) > 0 {
+		responseModel = config.Model
+		state.span.SetAttributes(attribute.String("gen_ai.response.model", config.Model))
+	}
+
+	var finishReasons []string
+	for i, msg := range messages {
+		if msg == nil {
+			continue
+		}
+		finishReason := ""
+		if msg.ResponseMeta != nil && len(msg.ResponseMeta.FinishReason) > 0 {
+			finishReason = msg.ResponseMeta.FinishReason
+			finishReasons = append(finishReasons, finishReason)
+		}
+		if o.captureContent {
+			attrs := []attribute.KeyValue{
+				attribute.String("gen_ai.system", state.system),
+				attribute.Int("index", i),
+				attribute.String("finish_reason", finishReason),
+				attribute.String("content", msg.Content),
+			}
+			if len(msg.ToolCalls) > 0 {
+				if calls, err := sonic.MarshalString(msg.ToolCalls); err == nil {
+					attrs = append(attrs, attribute.String("tool_calls", calls))
+				}
+			}
+			state.span.AddEvent("gen_ai.choice", trace.WithAttributes(attrs...))
+		}
+	}
+	if len(finishReasons) > 0 {
+		state.span.SetAttributes(attribute.StringSlice("gen_ai.response.finish_reasons", finishReasons))
+	}
+
+	if usage == nil {
+		return
+	}
+	state.span.SetAttributes(attribute.Int("gen_ai.usage.input_tokens", usage.PromptTokens))
+	if state.operation != operationEmbeddings {
+		state.span.SetAttributes(attribute.Int("gen_ai.usage.output_tokens", usage.CompletionTokens))
+	}
+
+	attrs := o.metricAttributes(state, responseModel)
+	o.tokenUsage.Record(ctx, int64(usage.PromptTokens), metric.WithAttributes(
+		append(attrs, attribute.String("gen_ai.token.type", "input"))...,
+	))
+	if state.operation != operationEmbeddings {
+		o.tokenUsage.Record(ctx, int64(usage.CompletionTokens), metric.WithAttributes(
+			append(attrs, attribute.String("gen_ai.token.type", "output"))...,
+		))
+	}
+}
+
+func (o *otelHandler) recordDuration(ctx context.Context, state *otelState, endTime time.Time, errorType string) {
+	if state.operation != operationChat && state.operation != operationEmbeddings {
+		return
+	}
+	attrs := o.metricAttributes(state, "")
+	if len(errorType) > 0 {
+		attrs = append(attrs, attribute.String("error.type", errorType))
+	}
+	o.operationDuration.Record(ctx, endTime.Sub(state.startTime).Seconds(), metric.WithAttributes(attrs...))
+}
+
+func (o *otelHandler) metricAttributes(state *otelState, responseModel string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("gen_ai.operation.name", state.operation),
+		attribute.String("gen_ai.system", state.system),
+	}
+	if len(state.model) > 0 {
+		attrs = append(attrs, attribute.String("gen_ai.request.model", state.model))
+	}
+	if len(responseModel) > 0 {
+		attrs = append(attrs, attribute.String("gen_ai.response.model", responseModel))
+	}
+	return attrs
+}
+
+const (
+	operationChat        = "chat"
+	operationEmbeddings  = "embeddings"
+	operationExecuteTool = "execute_tool"
+)
+
+func getOperation(info *callbacks.RunInfo) string {
+	switch info.Component {
+	case components.ComponentOfChatModel:
+		return operationChat
+	case components.ComponentOfEmbedding:
+		return operationEmbeddings
+	case components.ComponentOfTool:
+		return operationExecuteTool
+	default:
+		return strings.ToLower(string(info.Component))
+	}
+}
+
+// getSystem derives `gen_ai.system` from the component implementation type, e.g. "OpenAI" -> "openai".
+func getSystem(info *callbacks.RunInfo) string {
+	if len(info.Type) == 0 {
+		return "_OTHER"
+	}
+	return strings.ToLower(info.Type)
+}
@@ -0,0 +1,240 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestHandler(t *testing.T, captureContent bool) (callbacks.Handler, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	exporter := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	handler, shutdown, err := NewOtelHandler(&Config{
+		ServiceName:    "test",
+		CaptureContent: captureContent,
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	})
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = shutdown(context.Background()) })
+	return handler, exporter, reader
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Metrics {
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	ret := make(map[string]metricdata.Metrics)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			ret[m.Name] = m
+		}
+	}
+	return ret
+}
+
+func TestOtelHandler(t *testing.T) {
+	t.Run("chat model", func(t *testing.T) {
+		handler, exporter, reader := newTestHandler(t, true)
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{
+			Name:      "chat",
+			Type:      "OpenAI",
+			Component: components.ComponentOfChatModel,
+		}, handler)
+
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, &model.CallbackInput{
+			Messages: []*schema.Message{schema.SystemMessage("be brief"), schema.UserMessage("hi")},
+			Config:   &model.Config{Model: "gpt-4o", MaxTokens: 128, Temperature: 0.5},
+		})
+		callbacks.OnEnd[callbacks.CallbackOutput](ctx, &model.CallbackOutput{
+			Message: &schema.Message{
+				Role:         schema.Assistant,
+				Content:      "hello",
+				ResponseMeta: &schema.ResponseMeta{FinishReason: "stop"},
+			},
+			Config:     &model.Config{Model: "gpt-4o-2024-08-06"},
+			TokenUsage: &model.TokenUsage{PromptTokens: 10, CompletionTokens: 2, TotalTokens: 12},
+		})
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "chat gpt-4o", span.Name)
+		attrs := spanAttributes(span)
+		assert.Equal(t, "chat", attrs["gen_ai.operation.name"].AsString())
+		assert.Equal(t, "openai", attrs["gen_ai.system"].AsString())
+		assert.Equal(t, "gpt-4o", attrs["gen_ai.request.model"].AsString())
+		assert.Equal(t, int64(128), attrs["gen_ai.request.max_tokens"].AsInt64())
+		assert.Equal(t, "gpt-4o-2024-08-06", attrs["gen_ai.response.model"].AsString())
+		assert.Equal(t, []string{"stop"}, attrs["gen_ai.response.finish_reasons"].AsStringSlice())
+		assert.Equal(t, int64(10), attrs["gen_ai.usage.input_tokens"].AsInt64())
+		assert.Equal(t, int64(2), attrs["gen_ai.usage.output_tokens"].AsInt64())
+
+		var events []string
+		for _, e := range span.Events {
+			events = append(events, e.Name)
+		}
+		assert.Equal(t, []string{"gen_ai.system.message", "gen_ai.user.message", "gen_ai.choice"}, events)
+
+		metrics := collectMetrics(t, reader)
+		usage, ok := metrics["gen_ai.client.token.usage"].Data.(metricdata.Histogram[int64])
+		assert.True(t, ok)
+		assert.Len(t, usage.DataPoints, 2)
+		var total int64
+		for _, dp := range usage.DataPoints {
+			total += dp.Sum
+		}
+		assert.Equal(t, int64(12), total)
+		duration, ok := metrics["gen_ai.client.operation.duration"].Data.(metricdata.Histogram[float64])
+		assert.True(t, ok)
+		assert.Len(t, duration.DataPoints, 1)
+	})
+
+	t.Run("content not captured by default", func(t *testing.T) {
+		handler, exporter, _ := newTestHandler(t, false)
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{
+			Type:      "Ark",
+			Component: components.ComponentOfChatModel,
+		}, handler)
+
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, []*schema.Message{schema.UserMessage("secret")})
+		callbacks.OnEnd[callbacks.CallbackOutput](ctx, schema.AssistantMessage("secret", nil))
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "Ark ChatModel", spans[0].Name)
+		assert.Empty(t, spans[0].Events)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		handler, exporter, reader := newTestHandler(t, false)
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{
+			Type:      "OpenAI",
+			Component: components.ComponentOfChatModel,
+		}, handler)
+
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, &model.CallbackInput{Config: &model.Config{Model: "gpt-4o"}})
+		callbacks.OnError(ctx, errors.New("rate limited"))
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, "*errors.errorString", spanAttributes(spans[0])["error.type"].AsString())
+
+		duration, ok := collectMetrics(t, reader)["gen_ai.client.operation.duration"].Data.(metricdata.Histogram[float64])
+		assert.True(t, ok)
+		assert.Len(t, duration.DataPoints, 1)
+		errorType, ok := duration.DataPoints[0].Attributes.Value("error.type")
+		assert.True(t, ok)
+		assert.Equal(t, "*errors.errorString", errorType.AsString())
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		handler, exporter, reader := newTestHandler(t, false)
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{
+			Type:      "OpenAI",
+			Component: components.ComponentOfChatModel,
+		}, handler)
+
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, &model.CallbackInput{Config: &model.Config{Model: "gpt-4o"}})
+
+		sr, sw := schema.Pipe[callbacks.CallbackOutput](3)
+		go func() {
+			defer sw.Close()
+			sw.Send(&model.CallbackOutput{Message: schema.AssistantMessage("hel", nil)}, nil)
+			sw.Send(&model.CallbackOutput{Message: schema.AssistantMessage("lo", nil)}, nil)
+			sw.Send(&model.CallbackOutput{
+				Message:    &schema.Message{Role: schema.Assistant, ResponseMeta: &schema.ResponseMeta{FinishReason: "stop"}},
+				TokenUsage: &model.TokenUsage{PromptTokens: 3, CompletionTokens: 2, TotalTokens: 5},
+			}, nil)
+		}()
+		_, out := callbacks.OnEndWithStreamOutput(ctx, sr)
+		for {
+			if _, err := out.Recv(); err != nil {
+				break
+			}
+		}
+		out.Close()
+
+		assert.Eventually(t, func() bool { return len(exporter.GetSpans()) == 1 }, time.Second, 10*time.Millisecond)
+		attrs := spanAttributes(exporter.GetSpans()[0])
+		assert.Equal(t, []string{"stop"}, attrs["gen_ai.response.finish_reasons"].AsStringSlice())
+		assert.Equal(t, int64(2), attrs["gen_ai.usage.output_tokens"].AsInt64())
+
+		_, ok := collectMetrics(t, reader)["gen_ai.client.token.usage"]
+		assert.True(t, ok)
+	})
+
+	t.Run("tool", func(t *testing.T) {
+		handler, exporter, reader := newTestHandler(t, false)
+		ctx := callbacks.InitCallbacks(context.Background(), &callbacks.RunInfo{
+			Name:      "get_weather",
+			Type:      "InvokableTool",
+			Component: components.ComponentOfTool,
+		}, handler)
+
+		ctx = callbacks.OnStart[callbacks.CallbackInput](ctx, `{"city":"Beijing"}`)
+		callbacks.OnEnd[callbacks.CallbackOutput](ctx, "sunny")
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "get_weather", spans[0].Name)
+		attrs := spanAttributes(spans[0])
+		assert.Equal(t, "execute_tool", attrs["gen_ai.operation.name"].AsString())
+		assert.Equal(t, "get_weather", attrs["gen_ai.tool.name"].AsString())
+		assert.Empty(t, collectMetrics(t, reader))
+	})
+}
+
+func TestNewOtelHandler(t *testing.T) {
+	_, _, err := NewOtelHandler(&Config{ServiceName: "test", SampleRatio: 1.5})
+	assert.Error(t, err)
+
+	assert.Equal(t, sdktrace.AlwaysSample().Description(), newSampler(&Config{}).Description())
+	assert.Equal(t, sdktrace.NeverSample().Description(), newSampler(&Config{Sampler: sdktrace.NeverSample()}).Description())
+	assert.Equal(t,
+		sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0.1)).Description(),
+		newSampler(&Config{SampleRatio: 0.1}).Description())
+
+	attrs := resourceAttributes(&Config{ServiceVersion: "v1", ResourceAttributes: map[string]string{"team": "search"}})
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("service.version", "v1"),
+		attribute.String("team", "search"),
+	}, attrs)
+}
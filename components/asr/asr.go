@@ -0,0 +1,148 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/schema"
+)
+
+// ComponentOfASR is the component type of speech recognizers in callbacks.
+const ComponentOfASR components.Component = "ASR"
+
+// Recognizer transcribes speech to text.
+// Use RecognizeStream to recognize streaming audio, e.g. from a microphone.
+type Recognizer interface {
+	Recognize(ctx context.Context, audio *Audio, opts ...Option) (*Result, error)
+}
+
+// Audio is the speech to recognize, either Data or URL is required.
+type Audio struct {
+	// Data is the encoded audio.
+	Data []byte
+	// Format is the encoding of Data, e.g. "mp3", "wav", "m4a", "ogg".
+	Format string
+	// URL is the url of the audio, supported by some recognizers instead of Data.
+	URL string
+}
+
+// Segment is a piece of the recognized text with its time range in the audio.
+type Segment struct {
+	Text  string
+	Start time.Duration
+	End   time.Duration
+}
+
+// Result is the recognized text of the audio.
+type Result struct {
+	// Text is the full text of the audio.
+	Text string
+	// Language is the language of the audio, if detected by the recognizer.
+	Language string
+	// Duration is the duration of the audio, if reported by the recognizer.
+	Duration time.Duration
+	// Segments are the sentences of the text with timestamps.
+	Segments []*Segment
+	// Extra carries the implementation specific information.
+	Extra map[string]any
+}
+
+const (
+	// MetaKeyStart and MetaKeyEnd are the metadata keys of the time range in seconds of the documents of ToDocuments.
+	MetaKeyStart = "start"
+	MetaKeyEnd   = "end"
+	// MetaKeyLanguage is the metadata key of the language of the documents of ToDocuments.
+	MetaKeyLanguage = "language"
+)
+
+// ToDocuments converts the segments to documents, e.g. to index the transcript of a meeting.
+// A single document of the full text is returned if the result has no segments.
+func (r *Result) ToDocuments(idPrefix string) []*schema.Document {
+	if len(r.Segments) == 0 {
+		if r.Text == "" {
+			return nil
+		}
+		return []*schema.Document{{
+			ID:       idPrefix + "0",
+			Content:  r.Text,
+			MetaData: r.metaData(0, r.Duration),
+		}}
+	}
+
+	docs := make([]*schema.Document, 0, len(r.Segments))
+	for i, seg := range r.Segments {
+		docs = append(docs, &schema.Document{
+			ID:       fmt.Sprintf("%s%d", idPrefix, i),
+			Content:  seg.Text,
+			MetaData: r.metaData(seg.Start, seg.End),
+		})
+	}
+	return docs
+}
+
+func (r *Result) metaData(start, end time.Duration) map[string]any {
+	m := map[string]any{
+		MetaKeyStart: start.Seconds(),
+		MetaKeyEnd:   end.Seconds(),
+	}
+	if r.Language != "" {
+		m[MetaKeyLanguage] = r.Language
+	}
+	return m
+}
+
+// CallbackInput is the input of the ASR callback.
+type CallbackInput struct {
+	Audio    *Audio
+	Language string
+	Extra    map[string]any
+}
+
+// CallbackOutput is the output of the ASR callback.
+type CallbackOutput struct {
+	Result *Result
+	Extra  map[string]any
+}
+
+// ConvCallbackInput converts the callback input to the ASR callback input.
+func ConvCallbackInput(src callbacks.CallbackInput) *CallbackInput {
+	switch t := src.(type) {
+	case *CallbackInput:
+		return t
+	case *Audio:
+		return &CallbackInput{Audio: t}
+	default:
+		return nil
+	}
+}
+
+// ConvCallbackOutput converts the callback output to the ASR callback output.
+func ConvCallbackOutput(src callbacks.CallbackOutput) *CallbackOutput {
+	switch t := src.(type) {
+	case *CallbackOutput:
+		return t
+	case *Result:
+		return &CallbackOutput{Result: t}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asr
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRecognizer struct {
+	calls [][]byte
+}
+
+func (m *mockRecognizer) Recognize(ctx context.Context, audio *Audio, opts ...Option) (*Result, error) {
+	m.calls = append(m.calls, audio.Data)
+	if len(m.calls) == 1 {
+		return &Result{Text: "hello world", Segments: []*Segment{
+			{Text: "hello", Start: 0, End: 400 * time.Millisecond},
+			{Text: "world", Start: 500 * time.Millisecond, End: time.Second},
+		}}, nil
+	}
+	if len(m.calls) == 3 {
+		return nil, errors.New("recognize fail")
+	}
+	return &Result{Text: "bye"}, nil
+}
+
+func TestToDocuments(t *testing.T) {
+	r := &Result{
+		Text:     "hello world",
+		Language: "en",
+		Segments: []*Segment{
+			{Text: "hello", Start: 0, End: 500 * time.Millisecond},
+			{Text: "world", Start: 500 * time.Millisecond, End: 1500 * time.Millisecond},
+		},
+	}
+	docs := r.ToDocuments("meeting_")
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "meeting_1", docs[1].ID)
+	assert.Equal(t, "world", docs[1].Content)
+	assert.Equal(t, map[string]any{MetaKeyStart: 0.5, MetaKeyEnd: 1.5, MetaKeyLanguage: "en"}, docs[1].MetaData)
+
+	docs = (&Result{Text: "hello", Duration: 2 * time.Second}).ToDocuments("")
+	assert.Len(t, docs, 1)
+	assert.Equal(t, "hello", docs[0].Content)
+	assert.Equal(t, 2.0, docs[0].MetaData[MetaKeyEnd])
+
+	assert.Nil(t, (&Result{}).ToDocuments(""))
+}
+
+func TestRecognizeStream(t *testing.T) {
+	// 1s of 8kHz mono 16-bit pcm per chunk
+	second := make([]byte, 16000)
+	pcm := schema.StreamReaderFromArray([][]byte{second[:6000], second[:10000], second[:8000], second[:2000]})
+	r := &mockRecognizer{}
+
+	sr := RecognizeStream(context.Background(), r, pcm, &StreamConfig{SampleRate: 8000, ChunkDuration: time.Second})
+	defer sr.Close()
+
+	var segments []*Segment
+	for {
+		seg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.NoError(t, err)
+		segments = append(segments, seg)
+	}
+	assert.Equal(t, []*Segment{
+		{Text: "hello", Start: 0, End: 400 * time.Millisecond},
+		{Text: "world", Start: 500 * time.Millisecond, End: time.Second},
+		// the text without segments spans the last chunk of 10000 bytes
+		{Text: "bye", Start: time.Second, End: time.Second + 625*time.Millisecond},
+	}, segments)
+	assert.Len(t, r.calls, 2)
+	assert.Len(t, r.calls[0], 44+16000)
+
+	t.Run("error", func(t *testing.T) {
+		r := &mockRecognizer{calls: make([][]byte, 1)}
+		pcm := schema.StreamReaderFromArray([][]byte{second, second})
+		sr := RecognizeStream(context.Background(), r, pcm, &StreamConfig{SampleRate: 8000, ChunkDuration: time.Second})
+		defer sr.Close()
+
+		seg, err := sr.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "bye", seg.Text)
+		_, err = sr.Recv()
+		assert.EqualError(t, err, "recognize fail")
+	})
+}
+
+func TestWavOf(t *testing.T) {
+	wav := wavOf(make([]byte, 100), 16000, 2)
+	assert.Len(t, wav, 144)
+	assert.Equal(t, "RIFF", string(wav[:4]))
+	assert.Equal(t, uint32(136), binary.LittleEndian.Uint32(wav[4:8]))
+	assert.Equal(t, "WAVE", string(wav[8:12]))
+	assert.Equal(t, uint16(2), binary.LittleEndian.Uint16(wav[22:24]))
+	assert.Equal(t, uint32(16000), binary.LittleEndian.Uint32(wav[24:28]))
+	assert.Equal(t, uint32(64000), binary.LittleEndian.Uint32(wav[28:32]))
+	assert.Equal(t, "data", string(wav[36:40]))
+	assert.Equal(t, uint32(100), binary.LittleEndian.Uint32(wav[40:44]))
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+	"github.com/cloudwego/eino-ext/components/asr/openai"
+)
+
+func main() {
+	ctx := context.Background()
+
+	recognizer, err := openai.NewRecognizer(ctx, &openai.Config{
+		APIKey: os.Getenv("OPENAI_API_KEY"),
+	})
+	if err != nil {
+		log.Fatalf("NewRecognizer failed, err=%v", err)
+	}
+
+	data, err := os.ReadFile("hello.mp3")
+	if err != nil {
+		log.Fatalf("ReadFile failed, err=%v", err)
+	}
+
+	result, err := recognizer.Recognize(ctx, &asr.Audio{Data: data, Format: "mp3"})
+	if err != nil {
+		log.Fatalf("Recognize failed, err=%v", err)
+	}
+
+	fmt.Println(result.Text)
+	for _, seg := range result.Segments {
+		fmt.Printf("[%s - %s] %s\n", seg.Start, seg.End, seg.Text)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+	"github.com/cloudwego/eino-ext/components/asr/openai"
+	"github.com/cloudwego/eino-ext/components/asr/transcript"
+)
+
+func main() {
+	ctx := context.Background()
+
+	recognizer, err := openai.NewRecognizer(ctx, &openai.Config{
+		APIKey: os.Getenv("OPENAI_API_KEY"),
+	})
+	if err != nil {
+		log.Fatalf("NewRecognizer failed, err=%v", err)
+	}
+
+	loader, err := transcript.NewLoader(ctx, &transcript.LoaderConfig{
+		Recognizer: recognizer,
+		Window:     30 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("NewLoader failed, err=%v", err)
+	}
+
+	// the audio of the video is extracted by ffmpeg
+	docs, err := loader.Load(ctx, document.Source{URI: "meeting.mp4"}, transcript.WithASROptions(asr.WithLanguage("en")))
+	if err != nil {
+		log.Fatalf("Load failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("[%.0fs - %.0fs] %s\n", doc.MetaData[transcript.MetaKeyStart], doc.MetaData[transcript.MetaKeyEnd], doc.Content)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+	"github.com/cloudwego/eino-ext/components/asr/volc"
+)
+
+func main() {
+	ctx := context.Background()
+
+	recognizer, err := volc.NewRecognizer(ctx, &volc.Config{
+		AppID:       os.Getenv("VOLC_ASR_APP_ID"),
+		AccessToken: os.Getenv("VOLC_ASR_ACCESS_TOKEN"),
+		EnablePunc:  true,
+		EnableITN:   true,
+	})
+	if err != nil {
+		log.Fatalf("NewRecognizer failed, err=%v", err)
+	}
+
+	result, err := recognizer.Recognize(ctx, &asr.Audio{URL: os.Getenv("AUDIO_URL")})
+	if err != nil {
+		log.Fatalf("Recognize failed, err=%v", err)
+	}
+
+	for _, doc := range result.ToDocuments("audio_") {
+		fmt.Printf("%s [%.2fs - %.2fs] %s\n", doc.ID, doc.MetaData[asr.MetaKeyStart], doc.MetaData[asr.MetaKeyEnd], doc.Content)
+	}
+}
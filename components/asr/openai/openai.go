@@ -0,0 +1,240 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+const (
+	defaultBaseURL = "https://api.openai.com/v1"
+	defaultModel   = "whisper-1"
+
+	// maxFileBytes is the max size of the audio file of the transcriptions API.
+	maxFileBytes = 25 << 20
+)
+
+type Config struct {
+	// APIKey is the OpenAI API key.
+	// Required.
+	APIKey string
+	// BaseURL is the base url of the OpenAI compatible API.
+	// Optional. Default: "https://api.openai.com/v1".
+	BaseURL string
+	// Model is the transcription model, e.g. "whisper-1", "gpt-4o-transcribe".
+	// Only whisper-1 returns the segments with timestamps, other models return the text only.
+	// Optional. Default: "whisper-1".
+	Model string
+	// Language is the default language of the audio in ISO-639-1, e.g. "en", "zh".
+	// Optional. Default: detected by the model.
+	Language string
+	// Temperature is the sampling temperature from 0 to 1.
+	// Optional. Default: 0.
+	Temperature float32
+
+	// Timeout specifies the duration to wait before timing out a request.
+	// Optional. Default: 0 (no timeout).
+	Timeout time.Duration
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: &http.Client{Timeout: Timeout}.
+	HTTPClient *http.Client
+}
+
+var _ asr.Recognizer = (*Recognizer)(nil)
+
+// Recognizer transcribes speech with the OpenAI audio/transcriptions API.
+type Recognizer struct {
+	conf *Config
+	cli  *http.Client
+}
+
+func NewRecognizer(_ context.Context, config *Config) (*Recognizer, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+	if config.APIKey == "" {
+		return nil, errors.New("api key is required")
+	}
+
+	conf := *config
+	if conf.BaseURL == "" {
+		conf.BaseURL = defaultBaseURL
+	}
+	conf.BaseURL = strings.TrimSuffix(conf.BaseURL, "/")
+	if conf.Model == "" {
+		conf.Model = defaultModel
+	}
+
+	cli := conf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: conf.Timeout}
+	}
+
+	return &Recognizer{conf: &conf, cli: cli}, nil
+}
+
+type transcription struct {
+	Text     string  `json:"text"`
+	Language string  `json:"language"`
+	Duration float64 `json:"duration"`
+	Segments []struct {
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+		Text  string  `json:"text"`
+	} `json:"segments"`
+}
+
+func (r *Recognizer) Recognize(ctx context.Context, audio *asr.Audio, opts ...asr.Option) (result *asr.Result, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), asr.ComponentOfASR)
+
+	prompt := ""
+	o := asr.GetCommonOptions(&asr.Options{Language: &r.conf.Language, Prompt: &prompt}, opts...)
+
+	ctx = callbacks.OnStart(ctx, &asr.CallbackInput{Audio: audio, Language: *o.Language})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if audio == nil || len(audio.Data) == 0 {
+		return nil, errors.New("audio data is required")
+	}
+	if len(audio.Data) > maxFileBytes {
+		return nil, fmt.Errorf("audio is too large, %d bytes exceeds the limit %d", len(audio.Data), maxFileBytes)
+	}
+
+	body, contentType, err := r.buildForm(audio, o)
+	if err != nil {
+		return nil, fmt.Errorf("build request fail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.conf.BaseURL+"/audio/transcriptions", body)
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+r.conf.APIKey)
+
+	resp, err := r.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, data)
+	}
+
+	t := &transcription{}
+	if err = json.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("unmarshal response fail: %w", err)
+	}
+
+	result = &asr.Result{
+		Text:     t.Text,
+		Language: t.Language,
+		Duration: seconds(t.Duration),
+		Segments: make([]*asr.Segment, 0, len(t.Segments)),
+	}
+	for _, seg := range t.Segments {
+		result.Segments = append(result.Segments, &asr.Segment{
+			Text:  strings.TrimSpace(seg.Text),
+			Start: seconds(seg.Start),
+			End:   seconds(seg.End),
+		})
+	}
+	callbacks.OnEnd(ctx, &asr.CallbackOutput{Result: result})
+
+	return result, nil
+}
+
+func (r *Recognizer) buildForm(audio *asr.Audio, o *asr.Options) (io.Reader, string, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	format := audio.Format
+	if format == "" {
+		format = "wav"
+	}
+	fw, err := w.CreateFormFile("file", "audio."+format)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err = fw.Write(audio.Data); err != nil {
+		return nil, "", err
+	}
+
+	fields := [][2]string{
+		{"model", r.conf.Model},
+		{"temperature", fmt.Sprintf("%g", r.conf.Temperature)},
+	}
+	if r.conf.Model == defaultModel {
+		// only whisper-1 supports the verbose json with segments
+		fields = append(fields, [2]string{"response_format", "verbose_json"}, [2]string{"timestamp_granularities[]", "segment"})
+	} else {
+		fields = append(fields, [2]string{"response_format", "json"})
+	}
+	if *o.Language != "" {
+		fields = append(fields, [2]string{"language", *o.Language})
+	}
+	if *o.Prompt != "" {
+		fields = append(fields, [2]string{"prompt", *o.Prompt})
+	}
+	for _, f := range fields {
+		if err = w.WriteField(f[0], f[1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err = w.Close(); err != nil {
+		return nil, "", err
+	}
+	return &b, w.FormDataContentType(), nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+const typ = "OpenAI"
+
+func (r *Recognizer) GetType() string {
+	return typ
+}
+
+func (r *Recognizer) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+func TestRecognize(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/audio/transcriptions", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+
+		f, header, err := r.FormFile("file")
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(f)
+		assert.Equal(t, "audio", string(data))
+
+		switch r.FormValue("model") {
+		case "whisper-1":
+			assert.Equal(t, "audio.mp3", header.Filename)
+			assert.Equal(t, "verbose_json", r.FormValue("response_format"))
+			assert.Equal(t, "segment", r.FormValue("timestamp_granularities[]"))
+			assert.Equal(t, "zh", r.FormValue("language"))
+			assert.Equal(t, "eino", r.FormValue("prompt"))
+			_, _ = w.Write([]byte(`{"text":"你好 世界","language":"chinese","duration":2.5,"segments":[{"start":0,"end":1.2,"text":" 你好"},{"start":1.2,"end":2.5,"text":" 世界"}]}`))
+		default:
+			assert.Equal(t, "json", r.FormValue("response_format"))
+			assert.Equal(t, "", r.FormValue("language"))
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"invalid file"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r, err := NewRecognizer(ctx, &Config{APIKey: "test-key", BaseURL: ts.URL, Language: "zh"})
+	assert.NoError(t, err)
+
+	result, err := r.Recognize(ctx, &asr.Audio{Data: []byte("audio"), Format: "mp3"}, asr.WithPrompt("eino"))
+	assert.NoError(t, err)
+	assert.Equal(t, "你好 世界", result.Text)
+	assert.Equal(t, "chinese", result.Language)
+	assert.Equal(t, 2500*time.Millisecond, result.Duration)
+	assert.Equal(t, []*asr.Segment{
+		{Text: "你好", Start: 0, End: 1200 * time.Millisecond},
+		{Text: "世界", Start: 1200 * time.Millisecond, End: 2500 * time.Millisecond},
+	}, result.Segments)
+
+	r, err = NewRecognizer(ctx, &Config{APIKey: "test-key", BaseURL: ts.URL, Model: "gpt-4o-transcribe"})
+	assert.NoError(t, err)
+	_, err = r.Recognize(ctx, &asr.Audio{Data: []byte("audio")})
+	assert.EqualError(t, err, `request failed with status code 400: {"error":{"message":"invalid file"}}`)
+
+	_, err = r.Recognize(ctx, &asr.Audio{URL: "https://example.com/audio.mp3"})
+	assert.EqualError(t, err, "audio data is required")
+}
+
+func TestNewRecognizer(t *testing.T) {
+	_, err := NewRecognizer(context.Background(), nil)
+	assert.EqualError(t, err, "config is required")
+	_, err = NewRecognizer(context.Background(), &Config{})
+	assert.EqualError(t, err, "api key is required")
+}
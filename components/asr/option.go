@@ -0,0 +1,87 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asr
+
+// Options are the common options of recognizers.
+type Options struct {
+	// Language is the language of the audio, e.g. "en", "zh", detected by the recognizer if empty.
+	Language *string
+	// Prompt guides the recognition, e.g. the spelling of names or the text before the audio.
+	Prompt *string
+}
+
+// Option is the call option of recognizers.
+type Option struct {
+	apply func(opts *Options)
+
+	implSpecificOptFn any
+}
+
+// WithLanguage sets the language of the audio.
+func WithLanguage(language string) Option {
+	return Option{
+		apply: func(opts *Options) {
+			opts.Language = &language
+		},
+	}
+}
+
+// WithPrompt sets the prompt guiding the recognition.
+func WithPrompt(prompt string) Option {
+	return Option{
+		apply: func(opts *Options) {
+			opts.Prompt = &prompt
+		},
+	}
+}
+
+// GetCommonOptions extracts the common options from opts, with the default values in base.
+func GetCommonOptions(base *Options, opts ...Option) *Options {
+	if base == nil {
+		base = &Options{}
+	}
+
+	for i := range opts {
+		if opts[i].apply != nil {
+			opts[i].apply(base)
+		}
+	}
+
+	return base
+}
+
+// WrapImplSpecificOptFn wraps an implementation specific option function into an Option.
+func WrapImplSpecificOptFn[T any](optFn func(*T)) Option {
+	return Option{
+		implSpecificOptFn: optFn,
+	}
+}
+
+// GetImplSpecificOptions extracts the implementation specific options from opts, with the default values in base.
+func GetImplSpecificOptions[T any](base *T, opts ...Option) *T {
+	if base == nil {
+		base = new(T)
+	}
+
+	for i := range opts {
+		if optFn, ok := opts[i].implSpecificOptFn.(func(*T)); ok {
+			optFn(base)
+		}
+	}
+
+	return base
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package asr
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultSampleRate    = 16000
+	defaultChannels      = 1
+	defaultChunkDuration = 5 * time.Second
+)
+
+// StreamConfig describes the PCM audio of RecognizeStream.
+type StreamConfig struct {
+	// SampleRate is the sample rate of the audio.
+	// Optional. Default: 16000.
+	SampleRate int
+	// Channels is the number of channels of the audio.
+	// Optional. Default: 1.
+	Channels int
+	// ChunkDuration is the duration of audio recognized in a request.
+	// Shorter chunks have lower latency, but words across chunks may be recognized wrongly.
+	// Optional. Default: 5s.
+	ChunkDuration time.Duration
+}
+
+// RecognizeStream recognizes streaming 16-bit little-endian PCM audio, e.g. from a microphone, in chunks of
+// StreamConfig.ChunkDuration, so the text is available while the audio is being recorded.
+// Each chunk is sent to the recognizer as a wav file, and the returned segments are timed from the start of the stream.
+// Both streams are closed when done.
+func RecognizeStream(ctx context.Context, r Recognizer, pcm *schema.StreamReader[[]byte], conf *StreamConfig, opts ...Option) *schema.StreamReader[*Segment] {
+	c := StreamConfig{}
+	if conf != nil {
+		c = *conf
+	}
+	if c.SampleRate <= 0 {
+		c.SampleRate = defaultSampleRate
+	}
+	if c.Channels <= 0 {
+		c.Channels = defaultChannels
+	}
+	if c.ChunkDuration <= 0 {
+		c.ChunkDuration = defaultChunkDuration
+	}
+	bytesPerSecond := c.SampleRate * c.Channels * 2
+	chunkBytes := int(c.ChunkDuration.Seconds() * float64(bytesPerSecond))
+	// keep whole samples of all channels in a chunk
+	chunkBytes -= chunkBytes % (c.Channels * 2)
+	if chunkBytes <= 0 {
+		chunkBytes = c.Channels * 2
+	}
+
+	sr, sw := schema.Pipe[*Segment](1)
+
+	go func() {
+		defer pcm.Close()
+		defer sw.Close()
+
+		var offset time.Duration
+		recognize := func(data []byte) bool {
+			if len(data) == 0 {
+				return true
+			}
+			start := offset
+			duration := time.Duration(float64(len(data)) / float64(bytesPerSecond) * float64(time.Second))
+			offset += duration
+
+			result, err := r.Recognize(ctx, &Audio{Data: wavOf(data, c.SampleRate, c.Channels), Format: "wav"}, opts...)
+			if err != nil {
+				sw.Send(nil, err)
+				return false
+			}
+
+			segments := result.Segments
+			if len(segments) == 0 && result.Text != "" {
+				segments = []*Segment{{Text: result.Text, End: duration}}
+			}
+			for _, seg := range segments {
+				if sw.Send(&Segment{Text: seg.Text, Start: start + seg.Start, End: start + seg.End}, nil) {
+					return false
+				}
+			}
+			return true
+		}
+
+		var buf []byte
+		for {
+			chunk, err := pcm.Recv()
+			if errors.Is(err, io.EOF) {
+				recognize(buf)
+				return
+			}
+			if err != nil {
+				sw.Send(nil, err)
+				return
+			}
+
+			buf = append(buf, chunk...)
+			for len(buf) >= chunkBytes {
+				if !recognize(buf[:chunkBytes]) {
+					return
+				}
+				buf = append([]byte(nil), buf[chunkBytes:]...)
+			}
+		}
+	}()
+
+	return sr
+}
+
+// wavOf wraps 16-bit PCM data with a wav header.
+func wavOf(pcm []byte, sampleRate, channels int) []byte {
+	var b bytes.Buffer
+	b.Grow(44 + len(pcm))
+
+	write := func(v any) {
+		_ = binary.Write(&b, binary.LittleEndian, v)
+	}
+	b.WriteString("RIFF")
+	write(uint32(36 + len(pcm)))
+	b.WriteString("WAVE")
+	b.WriteString("fmt ")
+	write(uint32(16))                        // fmt chunk size
+	write(uint16(1))                         // PCM
+	write(uint16(channels))                  // channels
+	write(uint32(sampleRate))                // sample rate
+	write(uint32(sampleRate * channels * 2)) // byte rate
+	write(uint16(channels * 2))              // block align
+	write(uint16(16))                        // bits per sample
+	b.WriteString("data")
+	write(uint32(len(pcm)))
+	b.Write(pcm)
+
+	return b.Bytes()
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcript
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+// AudioExtractor extracts the audio track of a video or audio file.
+type AudioExtractor interface {
+	Extract(ctx context.Context, uri string) (*asr.Audio, error)
+}
+
+// FFmpegConfig is the configuration of the ffmpeg extractor.
+type FFmpegConfig struct {
+	// Binary is the path of ffmpeg.
+	// Optional. Default: "ffmpeg".
+	Binary string
+	// Format is the format of the extracted audio, "mp3" or "wav".
+	// Optional. Default: "mp3", which keeps an hour of speech within 25MB of the OpenAI transcription API.
+	Format string
+	// SampleRate is the sample rate of the extracted mono audio, 16000 is enough for speech.
+	// Optional. Default: 16000.
+	SampleRate int
+}
+
+// NewFFmpegExtractor creates an extractor of the audio of local files and urls with ffmpeg.
+func NewFFmpegExtractor(config *FFmpegConfig) AudioExtractor {
+	c := FFmpegConfig{}
+	if config != nil {
+		c = *config
+	}
+	if c.Binary == "" {
+		c.Binary = "ffmpeg"
+	}
+	if c.Format == "" {
+		c.Format = "mp3"
+	}
+	if c.SampleRate <= 0 {
+		c.SampleRate = 16000
+	}
+	return &ffmpeg{config: c}
+}
+
+type ffmpeg struct {
+	config FFmpegConfig
+}
+
+func (f *ffmpeg) Extract(ctx context.Context, uri string) (*asr.Audio, error) {
+	var stdout, stderr bytes.Buffer
+	// ffmpeg reads the input by uri rather than stdin, as the index of mp4 files may be at the end
+	cmd := exec.CommandContext(ctx, f.config.Binary,
+		"-nostdin", "-loglevel", "error",
+		"-i", uri,
+		"-vn", "-ac", "1", "-ar", strconv.Itoa(f.config.SampleRate),
+		"-f", f.config.Format, "pipe:1")
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("ffmpeg failed: %w: %s", err, stderr.String())
+	}
+	return &asr.Audio{Data: stdout.Bytes(), Format: f.config.Format}, nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcript
+
+import (
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+type options struct {
+	window     time.Duration
+	asrOptions []asr.Option
+}
+
+// WithWindow is a loader option that sets the duration of the transcript in a document.
+func WithWindow(window time.Duration) document.LoaderOption {
+	return document.WrapLoaderImplSpecificOptFn(func(o *options) {
+		if window > 0 {
+			o.window = window
+		}
+	})
+}
+
+// WithASROptions is a loader option that passes the options to the recognizer, e.g. asr.WithLanguage.
+func WithASROptions(opts ...asr.Option) document.LoaderOption {
+	return document.WrapLoaderImplSpecificOptFn(func(o *options) {
+		o.asrOptions = append(o.asrOptions, opts...)
+	})
+}
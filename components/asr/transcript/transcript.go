@@ -0,0 +1,256 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package transcript provides a document loader of the transcripts of audio and video files,
+// e.g. to build RAG over meeting recordings and podcasts.
+package transcript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+const defaultWindow = time.Minute
+
+const (
+	// MetaKeySource is the metadata key of the uri of the source.
+	MetaKeySource = "_source"
+	// MetaKeyStart and MetaKeyEnd are the metadata keys of the time range in seconds of the document in the source.
+	MetaKeyStart = asr.MetaKeyStart
+	MetaKeyEnd   = asr.MetaKeyEnd
+	// MetaKeyLanguage is the metadata key of the language detected by the recognizer.
+	MetaKeyLanguage = asr.MetaKeyLanguage
+)
+
+// audioFormats are the formats sent to the recognizer without extraction, by the extension of the source.
+var audioFormats = map[string]string{
+	".mp3":  "mp3",
+	".mpga": "mp3",
+	".wav":  "wav",
+	".m4a":  "m4a",
+	".ogg":  "ogg",
+	".oga":  "ogg",
+	".flac": "flac",
+	".aac":  "aac",
+	".opus": "opus",
+}
+
+var _ document.Loader = (*Loader)(nil)
+
+// LoaderConfig is the config for transcript Loader.
+type LoaderConfig struct {
+	// Recognizer transcribes the audio, e.g. asr/openai or asr/volc.
+	// Required.
+	Recognizer asr.Recognizer
+	// Extractor extracts the audio of video files and audio the recognizer may not support.
+	// Optional. Default: NewFFmpegExtractor(nil), which requires ffmpeg.
+	Extractor AudioExtractor
+	// Window is the duration of the transcript in a document, segments are grouped by their start time.
+	// Optional. Default: 1 minute.
+	Window time.Duration
+	// Client downloads remote audio files.
+	// Optional. Default: http.DefaultClient.
+	Client *http.Client
+}
+
+// NewLoader creates a new loader of the transcripts of audio and video files.
+// The source uri is a local path or a http(s) url.
+func NewLoader(ctx context.Context, conf *LoaderConfig) (*Loader, error) {
+	if conf == nil || conf.Recognizer == nil {
+		return nil, errors.New("recognizer is required")
+	}
+
+	c := *conf
+	if c.Extractor == nil {
+		c.Extractor = NewFFmpegExtractor(nil)
+	}
+	if c.Window <= 0 {
+		c.Window = defaultWindow
+	}
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+
+	return &Loader{conf: &c}, nil
+}
+
+// Loader loads the transcript of an audio or video file into documents of time windows.
+type Loader struct {
+	conf *LoaderConfig
+}
+
+func (l *Loader) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, l.GetType(), components.ComponentOfLoader)
+	ctx = callbacks.OnStart(ctx, &document.LoaderCallbackInput{
+		Source: src,
+	})
+	defer func() {
+		if err != nil {
+			_ = callbacks.OnError(ctx, err)
+		}
+	}()
+
+	options := document.GetLoaderImplSpecificOptions(&options{
+		window: l.conf.Window,
+	}, opts...)
+
+	audio, err := l.audioOf(ctx, src.URI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audio from uri [%s]: %w", src.URI, err)
+	}
+
+	result, err := l.conf.Recognizer.Recognize(ctx, audio, options.asrOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recognize audio from uri [%s]: %w", src.URI, err)
+	}
+
+	docs = toDocuments(result, options.window, src.URI)
+
+	_ = callbacks.OnEnd(ctx, &document.LoaderCallbackOutput{
+		Source: src,
+		Docs:   docs,
+	})
+
+	return docs, nil
+}
+
+// audioOf reads the audio files, and extracts the audio of other files.
+func (l *Loader) audioOf(ctx context.Context, uri string) (*asr.Audio, error) {
+	format, ok := audioFormats[strings.ToLower(path.Ext(uriPath(uri)))]
+	if !ok {
+		return l.conf.Extractor.Extract(ctx, uri)
+	}
+
+	if !isRemote(uri) {
+		data, err := os.ReadFile(uri)
+		if err != nil {
+			return nil, err
+		}
+		return &asr.Audio{Data: data, Format: format}, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := l.conf.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &asr.Audio{Data: data, Format: format}, nil
+}
+
+func (l *Loader) GetType() string {
+	return "TranscriptLoader"
+}
+
+func (l *Loader) IsCallbacksEnabled() bool {
+	return true
+}
+
+// toDocuments groups the segments into documents by the window of their start time.
+// A single document of the full text is returned if the result has no segments.
+func toDocuments(result *asr.Result, window time.Duration, source string) []*schema.Document {
+	if len(result.Segments) == 0 {
+		if strings.TrimSpace(result.Text) == "" {
+			return nil
+		}
+		return []*schema.Document{{
+			Content:  result.Text,
+			MetaData: metaOf(result, source, 0, result.Duration),
+		}}
+	}
+
+	var (
+		docs  []*schema.Document
+		texts []string
+		start time.Duration
+		end   time.Duration
+		index = int64(-1)
+	)
+	flush := func() {
+		if len(texts) > 0 {
+			docs = append(docs, &schema.Document{
+				Content:  strings.Join(texts, " "),
+				MetaData: metaOf(result, source, start, end),
+			})
+		}
+		texts = nil
+	}
+	for _, seg := range result.Segments {
+		text := strings.TrimSpace(seg.Text)
+		if text == "" {
+			continue
+		}
+		if i := int64(seg.Start / window); i != index {
+			flush()
+			index, start = i, seg.Start
+		}
+		texts = append(texts, text)
+		end = seg.End
+	}
+	flush()
+
+	return docs
+}
+
+func metaOf(result *asr.Result, source string, start, end time.Duration) map[string]any {
+	meta := map[string]any{
+		MetaKeySource: source,
+		MetaKeyStart:  start.Seconds(),
+		MetaKeyEnd:    end.Seconds(),
+	}
+	if result.Language != "" {
+		meta[MetaKeyLanguage] = result.Language
+	}
+	return meta
+}
+
+func isRemote(uri string) bool {
+	return strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://")
+}
+
+// uriPath strips the query and the fragment of remote uris, so that the extension can be detected.
+func uriPath(uri string) string {
+	if isRemote(uri) {
+		if i := strings.IndexAny(uri, "?#"); i >= 0 {
+			return uri[:i]
+		}
+	}
+	return uri
+}
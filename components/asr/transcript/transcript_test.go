@@ -0,0 +1,140 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transcript
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+type mockRecognizer struct {
+	audio    *asr.Audio
+	language string
+	result   *asr.Result
+}
+
+func (m *mockRecognizer) Recognize(ctx context.Context, audio *asr.Audio, opts ...asr.Option) (*asr.Result, error) {
+	m.audio = audio
+	if l := asr.GetCommonOptions(nil, opts...).Language; l != nil {
+		m.language = *l
+	}
+	return m.result, nil
+}
+
+type mockExtractor struct {
+	uri string
+}
+
+func (m *mockExtractor) Extract(ctx context.Context, uri string) (*asr.Audio, error) {
+	m.uri = uri
+	return &asr.Audio{Data: []byte("extracted"), Format: "mp3"}, nil
+}
+
+func TestLoader_Load(t *testing.T) {
+	ctx := context.Background()
+	result := &asr.Result{
+		Language: "en",
+		Segments: []*asr.Segment{
+			{Text: "Welcome to the meeting.", Start: 0, End: 4 * time.Second},
+			{Text: "Agenda first.", Start: 4 * time.Second, End: 9 * time.Second},
+			{Text: " ", Start: 9 * time.Second, End: 10 * time.Second},
+			{Text: "Budget review.", Start: 12 * time.Second, End: 20 * time.Second},
+			{Text: "Wrap up.", Start: 35 * time.Second, End: 38 * time.Second},
+		},
+	}
+
+	t.Run("recognizer required", func(t *testing.T) {
+		_, err := NewLoader(ctx, &LoaderConfig{})
+		assert.EqualError(t, err, "recognizer is required")
+	})
+
+	t.Run("local audio", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "podcast.MP3")
+		assert.NoError(t, os.WriteFile(file, []byte("audio"), 0644))
+
+		r := &mockRecognizer{result: result}
+		extractor := &mockExtractor{}
+		l, err := NewLoader(ctx, &LoaderConfig{Recognizer: r, Extractor: extractor, Window: 10 * time.Second})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: file}, WithASROptions(asr.WithLanguage("en")))
+		assert.NoError(t, err)
+		assert.Equal(t, &asr.Audio{Data: []byte("audio"), Format: "mp3"}, r.audio)
+		assert.Equal(t, "en", r.language)
+		assert.Equal(t, "", extractor.uri)
+
+		assert.Equal(t, 3, len(docs))
+		assert.Equal(t, "Welcome to the meeting. Agenda first.", docs[0].Content)
+		assert.Equal(t, map[string]any{MetaKeySource: file, MetaKeyStart: 0.0, MetaKeyEnd: 9.0, MetaKeyLanguage: "en"}, docs[0].MetaData)
+		assert.Equal(t, "Budget review.", docs[1].Content)
+		assert.Equal(t, 12.0, docs[1].MetaData[MetaKeyStart])
+		assert.Equal(t, "Wrap up.", docs[2].Content)
+		assert.Equal(t, 38.0, docs[2].MetaData[MetaKeyEnd])
+
+		docs, err = l.Load(ctx, document.Source{URI: file}, WithWindow(time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(docs))
+		assert.Equal(t, "Welcome to the meeting. Agenda first. Budget review. Wrap up.", docs[0].Content)
+	})
+
+	t.Run("video", func(t *testing.T) {
+		r := &mockRecognizer{result: &asr.Result{Text: "no segments", Duration: 3 * time.Second}}
+		extractor := &mockExtractor{}
+		l, err := NewLoader(ctx, &LoaderConfig{Recognizer: r, Extractor: extractor})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "https://example.com/meeting.mp4"})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/meeting.mp4", extractor.uri)
+		assert.Equal(t, []byte("extracted"), r.audio.Data)
+		assert.Equal(t, 1, len(docs))
+		assert.Equal(t, "no segments", docs[0].Content)
+		assert.Equal(t, 3.0, docs[0].MetaData[MetaKeyEnd])
+	})
+
+	t.Run("remote audio", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/episode.m4a" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("remote"))
+		}))
+		defer srv.Close()
+
+		r := &mockRecognizer{result: result}
+		l, err := NewLoader(ctx, &LoaderConfig{Recognizer: r, Extractor: &mockExtractor{}})
+		assert.NoError(t, err)
+
+		_, err = l.Load(ctx, document.Source{URI: srv.URL + "/episode.m4a?token=x"})
+		assert.NoError(t, err)
+		assert.Equal(t, &asr.Audio{Data: []byte("remote"), Format: "m4a"}, r.audio)
+
+		_, err = l.Load(ctx, document.Source{URI: srv.URL + "/missing.mp3"})
+		assert.ErrorContains(t, err, "request failed with status code 404")
+	})
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+const (
+	defaultBaseURL    = "https://openspeech.bytedance.com/api/v3/auc/bigmodel/recognize/flash"
+	defaultResourceID = "volc.bigasr.auc_turbo"
+	defaultModelName  = "bigmodel"
+
+	// statusSuccess is the X-Api-Status-Code of a successful recognition.
+	statusSuccess = "20000000"
+)
+
+type Config struct {
+	// AppID is the app id of the Volcengine speech service.
+	// Required.
+	AppID string
+	// AccessToken is the access token of the app.
+	// Required.
+	AccessToken string
+	// ResourceID is the resource of the recognition service.
+	// Optional. Default: "volc.bigasr.auc_turbo".
+	ResourceID string
+	// BaseURL is the url of the flash recognition API.
+	// Optional. Default: "https://openspeech.bytedance.com/api/v3/auc/bigmodel/recognize/flash".
+	BaseURL string
+
+	// EnableITN converts the spoken numbers to digits, e.g. "一百" to "100".
+	// Optional. Default: false.
+	EnableITN bool
+	// EnablePunc adds the punctuations to the text.
+	// Optional. Default: false.
+	EnablePunc bool
+	// EnableDDC removes the disfluencies, e.g. "嗯" and repeated words.
+	// Optional. Default: false.
+	EnableDDC bool
+
+	// Timeout specifies the duration to wait before timing out a request.
+	// Optional. Default: 0 (no timeout).
+	Timeout time.Duration
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: &http.Client{Timeout: Timeout}.
+	HTTPClient *http.Client
+}
+
+var _ asr.Recognizer = (*Recognizer)(nil)
+
+// Recognizer transcribes speech with the Volcengine flash recognition API of the big model.
+// The audio is given by Data or URL, the language and the prompt options are not supported.
+type Recognizer struct {
+	conf *Config
+	cli  *http.Client
+}
+
+func NewRecognizer(_ context.Context, config *Config) (*Recognizer, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+	if config.AppID == "" || config.AccessToken == "" {
+		return nil, errors.New("app id and access token are required")
+	}
+
+	conf := *config
+	if conf.ResourceID == "" {
+		conf.ResourceID = defaultResourceID
+	}
+	if conf.BaseURL == "" {
+		conf.BaseURL = defaultBaseURL
+	}
+
+	cli := conf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: conf.Timeout}
+	}
+
+	return &Recognizer{conf: &conf, cli: cli}, nil
+}
+
+type recognizeRequest struct {
+	User struct {
+		UID string `json:"uid"`
+	} `json:"user"`
+	Audio struct {
+		URL  string `json:"url,omitempty"`
+		Data string `json:"data,omitempty"`
+	} `json:"audio"`
+	Request struct {
+		ModelName  string `json:"model_name"`
+		EnableITN  bool   `json:"enable_itn"`
+		EnablePunc bool   `json:"enable_punc"`
+		EnableDDC  bool   `json:"enable_ddc"`
+	} `json:"request"`
+}
+
+type recognizeResponse struct {
+	AudioInfo struct {
+		Duration int64 `json:"duration"`
+	} `json:"audio_info"`
+	Result struct {
+		Text       string `json:"text"`
+		Utterances []struct {
+			Text      string `json:"text"`
+			StartTime int64  `json:"start_time"`
+			EndTime   int64  `json:"end_time"`
+		} `json:"utterances"`
+	} `json:"result"`
+}
+
+func (r *Recognizer) Recognize(ctx context.Context, audio *asr.Audio, opts ...asr.Option) (result *asr.Result, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), asr.ComponentOfASR)
+	ctx = callbacks.OnStart(ctx, &asr.CallbackInput{Audio: audio})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if audio == nil || (len(audio.Data) == 0 && audio.URL == "") {
+		return nil, errors.New("audio data or url is required")
+	}
+
+	reqID, err := newReqID()
+	if err != nil {
+		return nil, fmt.Errorf("generate request id fail: %w", err)
+	}
+
+	rr := &recognizeRequest{}
+	rr.User.UID = r.conf.AppID
+	if len(audio.Data) > 0 {
+		rr.Audio.Data = base64.StdEncoding.EncodeToString(audio.Data)
+	} else {
+		rr.Audio.URL = audio.URL
+	}
+	rr.Request.ModelName = defaultModelName
+	rr.Request.EnableITN = r.conf.EnableITN
+	rr.Request.EnablePunc = r.conf.EnablePunc
+	rr.Request.EnableDDC = r.conf.EnableDDC
+
+	body, err := json.Marshal(rr)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.conf.BaseURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-App-Key", r.conf.AppID)
+	req.Header.Set("X-Api-Access-Key", r.conf.AccessToken)
+	req.Header.Set("X-Api-Resource-Id", r.conf.ResourceID)
+	req.Header.Set("X-Api-Request-Id", reqID)
+	req.Header.Set("X-Api-Sequence", "-1")
+
+	resp, err := r.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+	// the status of the recognition is in the headers
+	if code := resp.Header.Get("X-Api-Status-Code"); code != statusSuccess {
+		return nil, fmt.Errorf("recognize fail, status code: %s, message: %s, http status: %d, logid: %s",
+			code, resp.Header.Get("X-Api-Message"), resp.StatusCode, resp.Header.Get("X-Tt-Logid"))
+	}
+
+	rs := &recognizeResponse{}
+	if err = json.Unmarshal(data, rs); err != nil {
+		return nil, fmt.Errorf("unmarshal response fail: %w", err)
+	}
+
+	result = &asr.Result{
+		Text:     rs.Result.Text,
+		Duration: time.Duration(rs.AudioInfo.Duration) * time.Millisecond,
+		Segments: make([]*asr.Segment, 0, len(rs.Result.Utterances)),
+		Extra:    map[string]any{"request_id": reqID},
+	}
+	for _, u := range rs.Result.Utterances {
+		result.Segments = append(result.Segments, &asr.Segment{
+			Text:  u.Text,
+			Start: time.Duration(u.StartTime) * time.Millisecond,
+			End:   time.Duration(u.EndTime) * time.Millisecond,
+		})
+	}
+	callbacks.OnEnd(ctx, &asr.CallbackOutput{Result: result})
+
+	return result, nil
+}
+
+func newReqID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+const typ = "Volcengine"
+
+func (r *Recognizer) GetType() string {
+	return typ
+}
+
+func (r *Recognizer) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/components/asr"
+)
+
+func TestRecognize(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "app", r.Header.Get("X-Api-App-Key"))
+		assert.Equal(t, "test-token", r.Header.Get("X-Api-Access-Key"))
+		assert.Equal(t, "volc.bigasr.auc_turbo", r.Header.Get("X-Api-Resource-Id"))
+		assert.Len(t, r.Header.Get("X-Api-Request-Id"), 32)
+
+		req := &recognizeRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(req))
+		assert.True(t, req.Request.EnablePunc)
+		if req.Audio.URL != "" {
+			w.Header().Set("X-Api-Status-Code", "45000001")
+			w.Header().Set("X-Api-Message", "invalid audio url")
+			return
+		}
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("audio")), req.Audio.Data)
+
+		w.Header().Set("X-Api-Status-Code", "20000000")
+		_, _ = w.Write([]byte(`{"audio_info":{"duration":2500},"result":{"text":"你好，世界。","utterances":[{"text":"你好，","start_time":0,"end_time":1200},{"text":"世界。","start_time":1200,"end_time":2500}]}}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r, err := NewRecognizer(ctx, &Config{AppID: "app", AccessToken: "test-token", BaseURL: ts.URL, EnablePunc: true})
+	assert.NoError(t, err)
+
+	result, err := r.Recognize(ctx, &asr.Audio{Data: []byte("audio"), Format: "wav"})
+	assert.NoError(t, err)
+	assert.Equal(t, "你好，世界。", result.Text)
+	assert.Equal(t, 2500*time.Millisecond, result.Duration)
+	assert.Equal(t, []*asr.Segment{
+		{Text: "你好，", Start: 0, End: 1200 * time.Millisecond},
+		{Text: "世界。", Start: 1200 * time.Millisecond, End: 2500 * time.Millisecond},
+	}, result.Segments)
+
+	_, err = r.Recognize(ctx, &asr.Audio{URL: "https://example.com/audio.mp3"})
+	assert.ErrorContains(t, err, "recognize fail, status code: 45000001, message: invalid audio url")
+
+	_, err = r.Recognize(ctx, &asr.Audio{})
+	assert.EqualError(t, err, "audio data or url is required")
+}
+
+func TestNewRecognizer(t *testing.T) {
+	_, err := NewRecognizer(context.Background(), nil)
+	assert.EqualError(t, err, "config is required")
+	_, err = NewRecognizer(context.Background(), &Config{AppID: "app"})
+	assert.EqualError(t, err, "app id and access token are required")
+}
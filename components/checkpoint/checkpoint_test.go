@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewFileStore(ctx, &FileConfig{})
+	assert.Error(t, err)
+
+	s, err := NewFileStore(ctx, &FileConfig{Dir: t.TempDir()})
+	assert.NoError(t, err)
+
+	_, ok, err := s.Get(ctx, "run-1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Error(t, s.Set(ctx, "", []byte("data")))
+
+	for _, id := range []string{"run-1", "../escape/run", ".."} {
+		assert.NoError(t, s.Set(ctx, id, []byte("v1 of "+id)))
+		assert.NoError(t, s.Set(ctx, id, []byte("v2 of "+id)))
+		data, ok, err := s.Get(ctx, id)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "v2 of "+id, string(data))
+	}
+
+	assert.NoError(t, s.Delete(ctx, "run-1"))
+	assert.NoError(t, s.Delete(ctx, "run-1"))
+	_, ok, err = s.Get(ctx, "run-1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestStoreConfig(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewRedisStore(ctx, &RedisConfig{})
+	assert.Error(t, err)
+	_, err = NewPostgresStore(ctx, &PostgresConfig{})
+	assert.Error(t, err)
+}
+
+type state struct {
+	History []*schema.Message
+	Values  map[string]any
+}
+
+func TestCodec(t *testing.T) {
+	in := &state{
+		History: []*schema.Message{
+			schema.UserMessage("what's the weather?"),
+			schema.AssistantMessage("", []schema.ToolCall{{ID: "call-1", Function: schema.FunctionCall{Name: "weather", Arguments: "{}"}}}),
+		},
+		Values: map[string]any{
+			"last":  schema.ToolMessage("sunny", "call-1"),
+			"docs":  []*schema.Document{{ID: "1", Content: "doc", MetaData: map[string]any{"score": 0.5}}},
+			"count": 3,
+		},
+	}
+
+	data, err := Marshal(in)
+	assert.NoError(t, err)
+	out := &state{}
+	assert.NoError(t, Unmarshal(data, out))
+	assert.Equal(t, in, out)
+
+	assert.Error(t, Unmarshal([]byte("invalid"), out))
+}
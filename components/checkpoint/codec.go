@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checkpoint
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"sync"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+var registerOnce sync.Once
+
+// RegisterSchemaTypes registers the common types of eino schema with encoding/gob, so that they round-trip
+// through Marshal and Unmarshal when held in interface fields, e.g. a map[string]any of graph state.
+// It's called by Marshal and Unmarshal, and is safe to call multiple times.
+func RegisterSchemaTypes() {
+	registerOnce.Do(func() {
+		gob.Register(&schema.Message{})
+		gob.Register([]*schema.Message{})
+		gob.Register(&schema.Document{})
+		gob.Register([]*schema.Document{})
+		gob.Register(schema.ToolCall{})
+		gob.Register([]schema.ToolCall{})
+		gob.Register(map[string]any{})
+		gob.Register([]any{})
+	})
+}
+
+// Marshal encodes v with encoding/gob, e.g. the custom state of a graph to persist along with its checkpoint.
+// Types held in interface fields other than the eino schema types must be registered with gob.Register.
+func Marshal(v any) ([]byte, error) {
+	RegisterSchemaTypes()
+	buf := &bytes.Buffer{}
+	if err := gob.NewEncoder(buf).Encode(v); err != nil {
+		return nil, fmt.Errorf("[Marshal] encode %T failed: %w", v, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes data encoded by Marshal into v, which must be a pointer.
+func Unmarshal(data []byte, v any) error {
+	RegisterSchemaTypes()
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(v); err != nil {
+		return fmt.Errorf("[Unmarshal] decode %T failed: %w", v, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/compose"
+
+	"github.com/cloudwego/eino-ext/components/checkpoint"
+)
+
+func main() {
+	ctx := context.Background()
+
+	dir, err := os.MkdirTemp("", "eino-checkpoints")
+	if err != nil {
+		log.Fatalf("MkdirTemp failed, err=%v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a FileStore survives process restarts, use a RedisStore or PostgresStore to share checkpoints across instances
+	store, err := checkpoint.NewFileStore(ctx, &checkpoint.FileConfig{Dir: dir})
+	if err != nil {
+		log.Fatalf("NewFileStore failed, err=%v", err)
+	}
+
+	g := compose.NewGraph[string, string]()
+	_ = g.AddLambdaNode("draft", compose.InvokableLambda(func(ctx context.Context, input string) (string, error) {
+		return "draft of " + input, nil
+	}))
+	_ = g.AddLambdaNode("publish", compose.InvokableLambda(func(ctx context.Context, input string) (string, error) {
+		return "published " + input, nil
+	}))
+	_ = g.AddEdge(compose.START, "draft")
+	_ = g.AddEdge("draft", "publish")
+	_ = g.AddEdge("publish", compose.END)
+
+	runner, err := g.Compile(ctx,
+		compose.WithCheckPointStore(store),
+		// let a human review the draft before publishing
+		compose.WithInterruptBeforeNodes([]string{"publish"}))
+	if err != nil {
+		log.Fatalf("Compile failed, err=%v", err)
+	}
+
+	const checkPointID = "article-1"
+	_, err = runner.Invoke(ctx, "an article", compose.WithCheckPointID(checkPointID))
+	if info, ok := compose.ExtractInterruptInfo(err); ok {
+		log.Printf("interrupted before %v, the checkpoint is persisted", info.BeforeNodes)
+	} else if err != nil {
+		log.Fatalf("Invoke failed, err=%v", err)
+	}
+
+	// after the review, possibly in another process, resume from the checkpoint
+	out, err := runner.Invoke(ctx, "", compose.WithCheckPointID(checkPointID))
+	if err != nil {
+		log.Fatalf("resume failed, err=%v", err)
+	}
+	log.Printf("result: %s", out)
+
+	if err = store.Delete(ctx, checkPointID); err != nil {
+		log.Fatalf("Delete failed, err=%v", err)
+	}
+}
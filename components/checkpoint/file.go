@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+type FileConfig struct {
+	// Dir is the directory checkpoints are written to, one file per checkpoint, created if it doesn't exist.
+	// Required.
+	Dir string
+}
+
+// FileStore is a compose.CheckPointStore keeping each checkpoint in a file, e.g. for local tools and tests.
+// Checkpoints are written to a temporary file and renamed, so that a crash never leaves a partial checkpoint.
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(ctx context.Context, config *FileConfig) (*FileStore, error) {
+	if config == nil || len(config.Dir) == 0 {
+		return nil, errors.New("[NewFileStore] dir not provided")
+	}
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("[NewFileStore] create dir failed: %w", err)
+	}
+	return &FileStore{dir: config.Dir}, nil
+}
+
+// path escapes the id, so that any id maps to a file right inside dir.
+func (s *FileStore) path(checkPointID string) string {
+	return filepath.Join(s.dir, url.PathEscape(checkPointID)+".ckpt")
+}
+
+func (s *FileStore) Get(ctx context.Context, checkPointID string) ([]byte, bool, error) {
+	data, err := os.ReadFile(s.path(checkPointID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("[FileStore] read checkpoint failed: %w", err)
+	}
+	return data, true, nil
+}
+
+func (s *FileStore) Set(ctx context.Context, checkPointID string, checkPoint []byte) error {
+	if len(checkPointID) == 0 {
+		return errEmptyID
+	}
+
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("[FileStore] create temp file failed: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(checkPoint); err != nil {
+		f.Close()
+		return fmt.Errorf("[FileStore] write checkpoint failed: %w", err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("[FileStore] sync checkpoint failed: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("[FileStore] close checkpoint failed: %w", err)
+	}
+	if err = os.Rename(f.Name(), s.path(checkPointID)); err != nil {
+		return fmt.Errorf("[FileStore] rename checkpoint failed: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the checkpoint, typically called when the interrupted run is finished or abandoned.
+func (s *FileStore) Delete(ctx context.Context, checkPointID string) error {
+	if err := os.Remove(s.path(checkPointID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("[FileStore] delete checkpoint failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checkpoint
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var tableNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type PostgresConfig struct {
+	// DB is the database, opened with a postgres driver, e.g. github.com/jackc/pgx/v5/stdlib or github.com/lib/pq.
+	// Required.
+	DB *sql.DB
+	// Table is the table storing checkpoints, with columns id, data and updated_at.
+	// Optional. Default: "eino_checkpoints"
+	Table string
+	// CreateTable creates the table if it doesn't exist.
+	// Optional. Default: false
+	CreateTable bool
+}
+
+// PostgresStore is a compose.CheckPointStore keeping each checkpoint in a row of a postgres table.
+type PostgresStore struct {
+	db    *sql.DB
+	table string
+}
+
+func NewPostgresStore(ctx context.Context, config *PostgresConfig) (*PostgresStore, error) {
+	if config == nil || config.DB == nil {
+		return nil, errors.New("[NewPostgresStore] db not provided")
+	}
+	table := config.Table
+	if len(table) == 0 {
+		table = "eino_checkpoints"
+	}
+	if !tableNameRegexp.MatchString(table) {
+		return nil, fmt.Errorf("[NewPostgresStore] invalid table name: %q", table)
+	}
+
+	s := &PostgresStore{db: config.DB, table: table}
+	if config.CreateTable {
+		_, err := s.db.ExecContext(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s "+
+			"(id TEXT PRIMARY KEY, data BYTEA NOT NULL, updated_at TIMESTAMPTZ NOT NULL DEFAULT now())", table))
+		if err != nil {
+			return nil, fmt.Errorf("[NewPostgresStore] create table failed: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func (s *PostgresStore) Get(ctx context.Context, checkPointID string) ([]byte, bool, error) {
+	var data []byte
+	err := s.db.QueryRowContext(ctx, fmt.Sprintf("SELECT data FROM %s WHERE id = $1", s.table), checkPointID).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("[PostgresStore] get checkpoint failed: %w", err)
+	}
+	return data, true, nil
+}
+
+func (s *PostgresStore) Set(ctx context.Context, checkPointID string, checkPoint []byte) error {
+	if len(checkPointID) == 0 {
+		return errEmptyID
+	}
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (id, data, updated_at) VALUES ($1, $2, now()) "+
+		"ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data, updated_at = EXCLUDED.updated_at", s.table),
+		checkPointID, checkPoint)
+	if err != nil {
+		return fmt.Errorf("[PostgresStore] set checkpoint failed: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the checkpoint, typically called when the interrupted run is finished or abandoned.
+func (s *PostgresStore) Delete(ctx context.Context, checkPointID string) error {
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", s.table), checkPointID); err != nil {
+		return fmt.Errorf("[PostgresStore] delete checkpoint failed: %w", err)
+	}
+	return nil
+}
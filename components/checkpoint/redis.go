@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisConfig struct {
+	// Client is the redis client, e.g. *redis.Client or *redis.ClusterClient.
+	// Required.
+	Client redis.UniversalClient
+	// KeyPrefix is prepended to checkpoint ids to form the redis keys.
+	// Optional. Default: "eino:checkpoint:"
+	KeyPrefix string
+	// TTL expires a checkpoint after it's not written for this long, e.g. the time a human has to answer an interrupt.
+	// Optional. Default: 0, never expire
+	TTL time.Duration
+}
+
+// RedisStore is a compose.CheckPointStore keeping each checkpoint in a redis string.
+type RedisStore struct {
+	client    redis.UniversalClient
+	keyPrefix string
+	ttl       time.Duration
+}
+
+func NewRedisStore(ctx context.Context, config *RedisConfig) (*RedisStore, error) {
+	if config == nil || config.Client == nil {
+		return nil, errors.New("[NewRedisStore] redis client not provided")
+	}
+	s := &RedisStore{
+		client:    config.Client,
+		keyPrefix: config.KeyPrefix,
+		ttl:       config.TTL,
+	}
+	if len(s.keyPrefix) == 0 {
+		s.keyPrefix = "eino:checkpoint:"
+	}
+	return s, nil
+}
+
+func (s *RedisStore) Get(ctx context.Context, checkPointID string) ([]byte, bool, error) {
+	data, err := s.client.Get(ctx, s.keyPrefix+checkPointID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("[RedisStore] get checkpoint failed: %w", err)
+	}
+	return data, true, nil
+}
+
+func (s *RedisStore) Set(ctx context.Context, checkPointID string, checkPoint []byte) error {
+	if len(checkPointID) == 0 {
+		return errEmptyID
+	}
+	if err := s.client.Set(ctx, s.keyPrefix+checkPointID, checkPoint, s.ttl).Err(); err != nil {
+		return fmt.Errorf("[RedisStore] set checkpoint failed: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the checkpoint, typically called when the interrupted run is finished or abandoned.
+func (s *RedisStore) Delete(ctx context.Context, checkPointID string) error {
+	if err := s.client.Del(ctx, s.keyPrefix+checkPointID).Err(); err != nil {
+		return fmt.Errorf("[RedisStore] delete checkpoint failed: %w", err)
+	}
+	return nil
+}
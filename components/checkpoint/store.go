@@ -0,0 +1,31 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checkpoint
+
+import (
+	"errors"
+
+	"github.com/cloudwego/eino/compose"
+)
+
+var (
+	_ compose.CheckPointStore = (*RedisStore)(nil)
+	_ compose.CheckPointStore = (*PostgresStore)(nil)
+	_ compose.CheckPointStore = (*FileStore)(nil)
+)
+
+var errEmptyID = errors.New("checkpoint id is empty")
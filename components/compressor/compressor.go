@@ -0,0 +1,221 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compressor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/schema"
+)
+
+// ComponentOfCompressor is the component name in the RunInfo of compressor callbacks.
+const ComponentOfCompressor components.Component = "Compressor"
+
+// ErrContextTooLong is returned, wrapped with details, when the messages that are never dropped,
+// i.e. the leading system messages and the last turn, exceed the max tokens on their own.
+var ErrContextTooLong = errors.New("messages exceed max tokens")
+
+// Turn is a user message with the messages following it until the next user message, e.g. the assistant
+// messages, tool calls and tool results answering it. Turns are kept, dropped or summarized as a whole,
+// so that tool calls are never separated from their results.
+type Turn struct {
+	Messages []*schema.Message
+}
+
+// Request is the history a Strategy compresses.
+type Request struct {
+	// History are the turns that can be dropped or summarized, oldest first.
+	// The leading system messages and the last turn are kept by Compressor and not included.
+	History []*Turn
+	// CountTokens counts the prompt tokens of messages.
+	CountTokens func(input []*schema.Message) int
+	// Budget is the tokens left for History by the messages that are always kept.
+	Budget int
+}
+
+// Fits tells whether turns fit in the budget.
+func (r *Request) Fits(turns []*Turn) bool {
+	return r.CountTokens(flatten(turns)) <= r.Budget
+}
+
+// Strategy compresses the history of a conversation to fit in the budget of Request.
+type Strategy interface {
+	Compress(ctx context.Context, req *Request) ([]*Turn, error)
+}
+
+type Config struct {
+	// MaxTokens is the budget of prompt tokens of the compressed messages, leave room for the completion
+	// when setting it from the context window of the model.
+	// Required.
+	MaxTokens int
+	// CountTokens counts the prompt tokens of messages, e.g. tokencount.Counter.CountMessages.
+	// Optional. Default: about 4 characters per token of message contents
+	CountTokens func(input []*schema.Message) int
+	// Strategy compresses the history when the messages exceed MaxTokens.
+	// Optional. Default: NewSlidingWindow()
+	Strategy Strategy
+}
+
+// Compressor fits a conversation into the context window of a model. Messages fitting in MaxTokens are
+// returned as is, otherwise older turns are compressed by the strategy, while the leading system messages
+// and the last turn are always kept.
+// Compress can be added to a graph before a ChatModel node, with compose.InvokableLambda(c.Compress).
+type Compressor struct {
+	maxTokens   int
+	countTokens func(input []*schema.Message) int
+	strategy    Strategy
+}
+
+func NewCompressor(_ context.Context, config *Config) (*Compressor, error) {
+	if config == nil || config.MaxTokens <= 0 {
+		return nil, errors.New("[NewCompressor] max tokens not provided")
+	}
+
+	c := &Compressor{
+		maxTokens:   config.MaxTokens,
+		countTokens: config.CountTokens,
+		strategy:    config.Strategy,
+	}
+	if c.countTokens == nil {
+		c.countTokens = estimateTokens
+	}
+	if c.strategy == nil {
+		c.strategy = NewSlidingWindow()
+	}
+	return c, nil
+}
+
+// CallbackInput is the input of compressor callbacks.
+type CallbackInput struct {
+	Messages  []*schema.Message
+	MaxTokens int
+}
+
+// CallbackOutput is the output of compressor callbacks.
+type CallbackOutput struct {
+	Messages []*schema.Message
+	// Compressed tells whether the history is compressed, false if the messages fit as is.
+	Compressed bool
+}
+
+// ConvCallbackInput converts the callback input to the compressor callback input.
+func ConvCallbackInput(src callbacks.CallbackInput) *CallbackInput {
+	t, _ := src.(*CallbackInput)
+	return t
+}
+
+// ConvCallbackOutput converts the callback output to the compressor callback output.
+func ConvCallbackOutput(src callbacks.CallbackOutput) *CallbackOutput {
+	t, _ := src.(*CallbackOutput)
+	return t
+}
+
+// Compress returns the messages fitting in MaxTokens. The input messages are not modified.
+func (c *Compressor) Compress(ctx context.Context, input []*schema.Message) (output []*schema.Message, err error) {
+	ctx = callbacks.ReuseHandlers(ctx, &callbacks.RunInfo{
+		Name:      string(ComponentOfCompressor),
+		Component: ComponentOfCompressor,
+	})
+	ctx = callbacks.OnStart(ctx, &CallbackInput{Messages: input, MaxTokens: c.maxTokens})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if c.countTokens(input) <= c.maxTokens {
+		callbacks.OnEnd(ctx, &CallbackOutput{Messages: input})
+		return input, nil
+	}
+
+	head, turns := splitTurns(input)
+	if len(turns) == 0 {
+		return nil, fmt.Errorf("%w: %d system messages exceed %d tokens", ErrContextTooLong, len(head), c.maxTokens)
+	}
+	last := turns[len(turns)-1]
+	pinned := append(append([]*schema.Message{}, head...), last.Messages...)
+	budget := c.maxTokens - c.countTokens(pinned)
+	if budget < 0 {
+		return nil, fmt.Errorf("%w: system messages and the last turn take %d tokens, more than %d",
+			ErrContextTooLong, c.maxTokens-budget, c.maxTokens)
+	}
+
+	history, err := c.strategy.Compress(ctx, &Request{
+		History:     turns[:len(turns)-1],
+		CountTokens: c.countTokens,
+		Budget:      budget,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[Compressor] compress history failed: %w", err)
+	}
+
+	output = make([]*schema.Message, 0, len(input))
+	output = append(output, head...)
+	output = append(output, flatten(history)...)
+	output = append(output, last.Messages...)
+
+	callbacks.OnEnd(ctx, &CallbackOutput{Messages: output, Compressed: true})
+	return output, nil
+}
+
+// splitTurns splits input into the leading system messages and the turns after them.
+// A summary of an earlier compression starts the turns, so that it can be summarized again.
+func splitTurns(input []*schema.Message) (head []*schema.Message, turns []*Turn) {
+	i := 0
+	for ; i < len(input) && input[i].Role == schema.System && !IsSummary(input[i]); i++ {
+		head = append(head, input[i])
+	}
+
+	for ; i < len(input); i++ {
+		if len(turns) == 0 || input[i].Role == schema.User {
+			turns = append(turns, &Turn{})
+		}
+		t := turns[len(turns)-1]
+		t.Messages = append(t.Messages, input[i])
+	}
+	return head, turns
+}
+
+func flatten(turns []*Turn) []*schema.Message {
+	var msgs []*schema.Message
+	for _, t := range turns {
+		msgs = append(msgs, t.Messages...)
+	}
+	return msgs
+}
+
+func estimateTokens(input []*schema.Message) int {
+	var chars int
+	for _, msg := range input {
+		if msg == nil {
+			continue
+		}
+		chars += utf8.RuneCountInString(msg.Content)
+		for _, part := range msg.MultiContent {
+			chars += utf8.RuneCountInString(part.Text)
+		}
+		for _, call := range msg.ToolCalls {
+			chars += utf8.RuneCountInString(call.Function.Name) + utf8.RuneCountInString(call.Function.Arguments)
+		}
+	}
+	return (chars + 3) / 4
+}
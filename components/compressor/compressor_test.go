@@ -0,0 +1,181 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compressor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// countMessages counts a token per message, to keep budgets readable.
+func countMessages(input []*schema.Message) int {
+	return len(input)
+}
+
+type mockChatModel struct {
+	input []*schema.Message
+	resp  string
+	err   error
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.input = input
+	if m.err != nil {
+		return nil, m.err
+	}
+	return schema.AssistantMessage(m.resp, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, errors.New("not implemented")
+}
+
+func conversation() []*schema.Message {
+	return []*schema.Message{
+		schema.SystemMessage("you are a helpful assistant"),
+		schema.UserMessage("q1"),
+		schema.AssistantMessage("a1", nil),
+		schema.UserMessage("q2"),
+		schema.AssistantMessage("", []schema.ToolCall{{ID: "call-1", Function: schema.FunctionCall{Name: "search", Arguments: "{}"}}}),
+		schema.ToolMessage("result", "call-1"),
+		schema.AssistantMessage("a2", nil),
+		schema.UserMessage("q3"),
+		schema.AssistantMessage("a3", nil),
+		schema.UserMessage("q4"),
+	}
+}
+
+func contents(msgs []*schema.Message) []string {
+	var ret []string
+	for _, msg := range msgs {
+		ret = append(ret, msg.Content)
+	}
+	return ret
+}
+
+func TestSplitTurns(t *testing.T) {
+	head, turns := splitTurns(conversation())
+	assert.Len(t, head, 1)
+	assert.Len(t, turns, 4)
+	assert.Len(t, turns[1].Messages, 4)
+
+	summary := &schema.Message{Role: schema.System, Content: "summary", Extra: map[string]any{extraKeySummary: true}}
+	head, turns = splitTurns([]*schema.Message{schema.SystemMessage("sys"), summary, schema.UserMessage("q")})
+	assert.Len(t, head, 1)
+	assert.Len(t, turns, 2)
+	assert.True(t, IsSummary(turns[0].Messages[0]))
+}
+
+func TestCompressor(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewCompressor(ctx, &Config{})
+	assert.Error(t, err)
+
+	c, err := NewCompressor(ctx, &Config{MaxTokens: 20, CountTokens: countMessages})
+	assert.NoError(t, err)
+	out, err := c.Compress(ctx, conversation())
+	assert.NoError(t, err)
+	assert.Equal(t, conversation(), out)
+
+	c, err = NewCompressor(ctx, &Config{MaxTokens: 4, CountTokens: countMessages})
+	assert.NoError(t, err)
+	out, err = c.Compress(ctx, conversation())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"you are a helpful assistant", "q3", "a3", "q4"}, contents(out))
+
+	c, err = NewCompressor(ctx, &Config{MaxTokens: 1, CountTokens: countMessages})
+	assert.NoError(t, err)
+	_, err = c.Compress(ctx, conversation())
+	assert.ErrorIs(t, err, ErrContextTooLong)
+
+	// the default estimation counts about 4 characters per token
+	assert.Equal(t, 3, estimateTokens([]*schema.Message{schema.UserMessage("hello world")}))
+}
+
+func TestImportanceWeighted(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewCompressor(ctx, &Config{
+		MaxTokens:   6,
+		CountTokens: countMessages,
+		Strategy:    NewImportanceWeighted(nil),
+	})
+	assert.NoError(t, err)
+
+	// the turn with tool calls outscores the newer one
+	out, err := c.Compress(ctx, conversation())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"you are a helpful assistant", "q2", "", "result", "a2", "q4"}, contents(out))
+
+	c, err = NewCompressor(ctx, &Config{
+		MaxTokens:   5,
+		CountTokens: countMessages,
+		Strategy: NewImportanceWeighted(&ImportanceConfig{
+			Score: func(ctx context.Context, turn *Turn, index int, history []*Turn) float64 {
+				if turn.Messages[0].Content == "q1" {
+					return 1
+				}
+				return 0
+			},
+		}),
+	})
+	assert.NoError(t, err)
+	out, err = c.Compress(ctx, conversation())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"you are a helpful assistant", "q1", "a1", "q4"}, contents(out))
+}
+
+func TestSummarization(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewSummarization(nil)
+	assert.Error(t, err)
+	_, err = NewSummarization(&SummarizationConfig{ChatModel: &mockChatModel{}, SummaryRatio: 1})
+	assert.Error(t, err)
+
+	cm := &mockChatModel{resp: " user asked q1 and q2 "}
+	strategy, err := NewSummarization(&SummarizationConfig{ChatModel: cm, SummaryRatio: 0.3})
+	assert.NoError(t, err)
+	c, err := NewCompressor(ctx, &Config{MaxTokens: 6, CountTokens: countMessages, Strategy: strategy})
+	assert.NoError(t, err)
+
+	out, err := c.Compress(ctx, conversation())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"you are a helpful assistant",
+		defaultSummaryPrefix + "user asked q1 and q2",
+		"q3", "a3", "q4",
+	}, contents(out))
+	assert.True(t, IsSummary(out[1]))
+	assert.Contains(t, cm.input[1].Content, "[user]: q1")
+	assert.Contains(t, cm.input[1].Content, "[call call-1 to tool search]: {}")
+
+	// the summary is summarized again with the next dropped turns
+	out = append(out, schema.AssistantMessage("a4", nil), schema.UserMessage("q5"))
+	cm.resp = "user asked q1 to q3"
+	out, err = c.Compress(ctx, out)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"you are a helpful assistant", defaultSummaryPrefix + "user asked q1 to q3", "q4", "a4", "q5"}, contents(out))
+	assert.Contains(t, cm.input[1].Content, "[earlier summary]: ")
+
+	cm.err = errors.New("unavailable")
+	_, err = c.Compress(ctx, conversation())
+	assert.ErrorIs(t, err, cm.err)
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/compressor"
+)
+
+// fakeSummarizer stands for a real ChatModel, e.g. a cheap model of openai or ark.
+type fakeSummarizer struct{}
+
+func (f *fakeSummarizer) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	lines := strings.Count(input[len(input)-1].Content, "\n")
+	return schema.AssistantMessage(fmt.Sprintf("%d messages about the weather of several cities", lines), nil), nil
+}
+
+func (f *fakeSummarizer) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := f.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func main() {
+	ctx := context.Background()
+
+	history := []*schema.Message{schema.SystemMessage("You are a weather assistant.")}
+	for _, city := range []string{"Beijing", "Shanghai", "Shenzhen", "Hangzhou", "Chengdu"} {
+		history = append(history,
+			schema.UserMessage("How is the weather in "+city+" today?"),
+			schema.AssistantMessage("It's sunny in "+city+", with a high of 25 degrees and a gentle breeze.", nil))
+	}
+	history = append(history, schema.UserMessage("And tomorrow?"))
+
+	summarization, err := compressor.NewSummarization(&compressor.SummarizationConfig{
+		ChatModel: &fakeSummarizer{},
+	})
+	if err != nil {
+		log.Fatalf("NewSummarization failed, err=%v", err)
+	}
+
+	strategies := map[string]compressor.Strategy{
+		"sliding window":      compressor.NewSlidingWindow(),
+		"importance weighted": compressor.NewImportanceWeighted(nil),
+		"summarization":       summarization,
+	}
+	for name, strategy := range strategies {
+		c, err := compressor.NewCompressor(ctx, &compressor.Config{
+			MaxTokens: 80,
+			Strategy:  strategy,
+		})
+		if err != nil {
+			log.Fatalf("NewCompressor failed, err=%v", err)
+		}
+
+		// run the compressor as a lambda node before the ChatModel node
+		chain := compose.NewChain[[]*schema.Message, []*schema.Message]()
+		chain.AppendLambda(compose.InvokableLambda(c.Compress))
+		runner, err := chain.Compile(ctx)
+		if err != nil {
+			log.Fatalf("Compile failed, err=%v", err)
+		}
+
+		out, err := runner.Invoke(ctx, history)
+		if err != nil {
+			log.Fatalf("Compress failed, err=%v", err)
+		}
+		log.Printf("%s keeps %d of %d messages:", name, len(out), len(history))
+		for _, msg := range out {
+			log.Printf("  %s: %s", msg.Role, msg.Content)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compressor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// NewSlidingWindow returns a Strategy keeping the newest turns that fit in the budget, dropping older ones.
+func NewSlidingWindow() Strategy {
+	return &slidingWindow{}
+}
+
+type slidingWindow struct{}
+
+func (s *slidingWindow) Compress(ctx context.Context, req *Request) ([]*Turn, error) {
+	return newestFitting(req, req.History), nil
+}
+
+// newestFitting returns the longest suffix of turns that fits in the budget.
+func newestFitting(req *Request, turns []*Turn) []*Turn {
+	i := len(turns)
+	for i > 0 && req.Fits(turns[i-1:]) {
+		i--
+	}
+	return turns[i:]
+}
+
+// ScoreFunc scores the importance of the turn at index of history, turns with lower scores are dropped first.
+type ScoreFunc func(ctx context.Context, turn *Turn, index int, history []*Turn) float64
+
+// DefaultScore prefers newer turns, and turns with tool calls, whose results the model may rely on later.
+func DefaultScore(ctx context.Context, turn *Turn, index int, history []*Turn) float64 {
+	score := float64(index+1) / float64(len(history))
+	for _, msg := range turn.Messages {
+		if len(msg.ToolCalls) > 0 {
+			score += 0.5
+			break
+		}
+	}
+	return score
+}
+
+type ImportanceConfig struct {
+	// Score scores the importance of each turn of the history.
+	// Optional. Default: DefaultScore
+	Score ScoreFunc
+}
+
+// NewImportanceWeighted returns a Strategy dropping the least important turns first until the rest fits
+// in the budget, keeping the order of the remaining turns.
+func NewImportanceWeighted(config *ImportanceConfig) Strategy {
+	s := &importanceWeighted{score: DefaultScore}
+	if config != nil && config.Score != nil {
+		s.score = config.Score
+	}
+	return s
+}
+
+type importanceWeighted struct {
+	score ScoreFunc
+}
+
+func (s *importanceWeighted) Compress(ctx context.Context, req *Request) ([]*Turn, error) {
+	scores := make([]float64, len(req.History))
+	order := make([]int, len(req.History))
+	for i, turn := range req.History {
+		scores[i] = s.score(ctx, turn, i, req.History)
+		order[i] = i
+	}
+	// drop the lowest scores first, the older of equal scores
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] < scores[order[j]]
+	})
+
+	dropped := make([]bool, len(req.History))
+	kept := req.History
+	for _, i := range order {
+		if req.Fits(kept) {
+			break
+		}
+		dropped[i] = true
+		kept = make([]*Turn, 0, len(req.History))
+		for j, turn := range req.History {
+			if !dropped[j] {
+				kept = append(kept, turn)
+			}
+		}
+	}
+	return kept, nil
+}
+
+const (
+	extraKeySummary = "_eino_ext_compressor_summary"
+
+	defaultSummaryInstruction = "Summarize the conversation below for the assistant to continue it. " +
+		"Keep the facts, decisions, user preferences and results of tool calls that may matter later, " +
+		"and drop small talk. Reply with the summary only."
+	defaultSummaryPrefix = "Summary of the earlier conversation:\n"
+)
+
+// IsSummary tells whether msg is a summary generated by the summarization strategy.
+func IsSummary(msg *schema.Message) bool {
+	if msg == nil {
+		return false
+	}
+	v, _ := msg.Extra[extraKeySummary].(bool)
+	return v
+}
+
+type SummarizationConfig struct {
+	// ChatModel summarizes the older turns.
+	// Required.
+	ChatModel model.BaseChatModel
+	// Instruction is the system prompt of the summarization request.
+	// Optional. Default: an instruction to keep facts, decisions, preferences and tool results.
+	Instruction string
+	// SummaryPrefix is prepended to the summary in the returned system message.
+	// Optional. Default: "Summary of the earlier conversation:\n"
+	SummaryPrefix string
+	// SummaryRatio is the share of the budget reserved for the summary, the newest turns fitting in the rest are kept.
+	// Optional. Default: 0.25
+	SummaryRatio float64
+}
+
+// NewSummarization returns a Strategy keeping the newest turns that fit in the budget, and replacing the older
+// ones with a summary generated by a ChatModel. The summary is returned as a system message, recognized by
+// IsSummary, and is summarized again along with the turns dropped by later compressions.
+func NewSummarization(config *SummarizationConfig) (Strategy, error) {
+	if config == nil || config.ChatModel == nil {
+		return nil, errors.New("[NewSummarization] chat model not provided")
+	}
+	if config.SummaryRatio < 0 || config.SummaryRatio >= 1 {
+		return nil, errors.New("[NewSummarization] summary ratio must be in [0, 1)")
+	}
+
+	s := &summarization{
+		cm:            config.ChatModel,
+		instruction:   config.Instruction,
+		summaryPrefix: config.SummaryPrefix,
+		summaryRatio:  config.SummaryRatio,
+	}
+	if len(s.instruction) == 0 {
+		s.instruction = defaultSummaryInstruction
+	}
+	if len(s.summaryPrefix) == 0 {
+		s.summaryPrefix = defaultSummaryPrefix
+	}
+	if s.summaryRatio == 0 {
+		s.summaryRatio = 0.25
+	}
+	return s, nil
+}
+
+type summarization struct {
+	cm            model.BaseChatModel
+	instruction   string
+	summaryPrefix string
+	summaryRatio  float64
+}
+
+func (s *summarization) Compress(ctx context.Context, req *Request) ([]*Turn, error) {
+	reserved := int(float64(req.Budget) * s.summaryRatio)
+	kept := newestFitting(&Request{
+		History:     req.History,
+		CountTokens: req.CountTokens,
+		Budget:      req.Budget - reserved,
+	}, req.History)
+	older := req.History[:len(req.History)-len(kept)]
+	if len(older) == 0 {
+		return kept, nil
+	}
+
+	resp, err := s.cm.Generate(ctx, []*schema.Message{
+		schema.SystemMessage(s.instruction),
+		schema.UserMessage(transcript(older)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("summarize %d turns failed: %w", len(older), err)
+	}
+
+	summary := &schema.Message{
+		Role:    schema.System,
+		Content: s.summaryPrefix + strings.TrimSpace(resp.Content),
+		Extra:   map[string]any{extraKeySummary: true},
+	}
+	turns := append([]*Turn{{Messages: []*schema.Message{summary}}}, kept...)
+	// the summary may come out longer than reserved, make room by dropping the oldest kept turns
+	for len(turns) > 1 && !req.Fits(turns) {
+		turns = append(turns[:1], turns[2:]...)
+	}
+	if !req.Fits(turns) {
+		// not even the summary fits, leave nothing of the history
+		return nil, nil
+	}
+	return turns, nil
+}
+
+// transcript renders turns as plain text for the summarization request.
+func transcript(turns []*Turn) string {
+	sb := strings.Builder{}
+	for _, turn := range turns {
+		for _, msg := range turn.Messages {
+			switch {
+			case IsSummary(msg):
+				sb.WriteString("[earlier summary]: ")
+			case msg.Role == schema.Tool:
+				sb.WriteString(fmt.Sprintf("[tool result of %s]: ", msg.ToolCallID))
+			default:
+				sb.WriteString(fmt.Sprintf("[%s]: ", msg.Role))
+			}
+			sb.WriteString(msg.Content)
+			for _, part := range msg.MultiContent {
+				if part.Type == schema.ChatMessagePartTypeText {
+					sb.WriteString(part.Text)
+				}
+			}
+			for _, call := range msg.ToolCalls {
+				sb.WriteString(fmt.Sprintf("\n[call %s to tool %s]: %s", call.ID, call.Function.Name, call.Function.Arguments))
+			}
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultPageSize = 50
+
+// Auth is the credential of the atlassian site, either the email and API token of atlassian cloud,
+// or the personal access token of confluence and jira data center.
+type Auth struct {
+	// Username and APIToken authenticate with basic auth.
+	Username string
+	APIToken string
+	// Token is the personal access token, which authenticates with bearer auth.
+	Token string
+}
+
+type client struct {
+	baseURL string
+	auth    Auth
+	cli     *http.Client
+}
+
+func newClient(baseURL string, auth Auth, cli *http.Client) (*client, error) {
+	if baseURL == "" {
+		return nil, errors.New("base url is required")
+	}
+	if auth.Token == "" && (auth.Username == "" || auth.APIToken == "") {
+		return nil, errors.New("token, or username and api token are required")
+	}
+	if cli == nil {
+		cli = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &client{baseURL: strings.TrimSuffix(baseURL, "/"), auth: auth, cli: cli}, nil
+}
+
+// get gets the path of the base url, and decodes the json response into out.
+func (c *client) get(ctx context.Context, path string, out any) error {
+	body, err := c.open(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return json.NewDecoder(body).Decode(out)
+}
+
+// open gets the path of the base url, the caller must close the body.
+func (c *client) open(ctx context.Context, path string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.auth.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.auth.Token)
+	} else {
+		req.SetBasicAuth(c.auth.Username, c.auth.APIToken)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, body)
+	}
+	return resp.Body, nil
+}
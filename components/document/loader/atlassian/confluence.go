@@ -0,0 +1,304 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultMaxAttachmentSize = 10 << 20
+
+const (
+	// MetaKeySource is the metadata key of the uri of the source.
+	MetaKeySource = "_source"
+	// MetaKeyTitle is the metadata key of the title of the page or the attachment.
+	MetaKeyTitle = "title"
+	// MetaKeyURL is the metadata key of the url of the page or the issue.
+	MetaKeyURL = "url"
+	// MetaKeyUpdated is the metadata key of the ISO 8601 time of the last update.
+	MetaKeyUpdated = "updated"
+	// MetaKeyAuthor is the metadata key of the display name of the last editor of the page.
+	MetaKeyAuthor = "author"
+	// MetaKeySpace is the metadata key of the key of the confluence space.
+	MetaKeySpace = "space"
+	// MetaKeyAncestors is the metadata key of the titles of the ancestors of the page, from the root.
+	MetaKeyAncestors = "ancestors"
+	// MetaKeyVersion is the metadata key of the version number of the page.
+	MetaKeyVersion = "version"
+	// MetaKeyPageID is the metadata key of the id of the page of the attachment.
+	MetaKeyPageID = "page_id"
+	// MetaKeyMediaType is the metadata key of the media type of the attachment.
+	MetaKeyMediaType = "media_type"
+)
+
+var _ document.Loader = (*ConfluenceLoader)(nil)
+
+// ConfluenceLoaderConfig is the config for confluence Loader.
+type ConfluenceLoaderConfig struct {
+	// BaseURL is the url of confluence, e.g. "https://your-domain.atlassian.net/wiki".
+	// Required.
+	BaseURL string
+	// Auth is the credential of confluence.
+	// Required.
+	Auth Auth
+	// PageSize is the number of pages of a search request.
+	// Optional. Default: 50.
+	PageSize int
+	// AttachmentParser parses the attachments of the pages into documents, e.g. a parser.ExtParser of pdf and docx.
+	// Optional. Default: nil, the attachments are skipped.
+	AttachmentParser parser.Parser
+	// MaxAttachmentSize skips the larger attachments.
+	// Optional. Default: 10MB.
+	MaxAttachmentSize int64
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default: &http.Client{Timeout: 30 * time.Second}.
+	HTTPClient *http.Client
+}
+
+// NewConfluenceLoader creates a new confluence loader.
+func NewConfluenceLoader(ctx context.Context, conf *ConfluenceLoaderConfig) (*ConfluenceLoader, error) {
+	if conf == nil {
+		conf = &ConfluenceLoaderConfig{}
+	}
+	cli, err := newClient(conf.BaseURL, conf.Auth, conf.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *conf
+	if c.PageSize <= 0 {
+		c.PageSize = defaultPageSize
+	}
+	if c.MaxAttachmentSize <= 0 {
+		c.MaxAttachmentSize = defaultMaxAttachmentSize
+	}
+	return &ConfluenceLoader{cli: cli, conf: &c}, nil
+}
+
+// ConfluenceLoader loads confluence pages into markdown documents, a document per page.
+// The source uri is one of:
+//   - confluence://space/<key>, the pages of the space
+//   - confluence://page/<id>, the page
+//   - cql:<query>, the pages and blog posts matching the CQL, e.g. `cql:space = DEV and label = "runbook"`
+type ConfluenceLoader struct {
+	cli  *client
+	conf *ConfluenceLoaderConfig
+}
+
+type confluenceContent struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	Space struct {
+		Key string `json:"key"`
+	} `json:"space"`
+	Version struct {
+		Number int    `json:"number"`
+		When   string `json:"when"`
+		By     struct {
+			DisplayName string `json:"displayName"`
+		} `json:"by"`
+	} `json:"version"`
+	Ancestors []struct {
+		Title string `json:"title"`
+	} `json:"ancestors"`
+	Body struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	} `json:"body"`
+	Extensions struct {
+		MediaType string `json:"mediaType"`
+		FileSize  int64  `json:"fileSize"`
+	} `json:"extensions"`
+	Links struct {
+		WebUI    string `json:"webui"`
+		Download string `json:"download"`
+	} `json:"_links"`
+}
+
+type confluenceList struct {
+	Results []*confluenceContent `json:"results"`
+	Links   struct {
+		Next string `json:"next"`
+	} `json:"_links"`
+}
+
+func (l *ConfluenceLoader) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, l.GetType(), components.ComponentOfLoader)
+	ctx = callbacks.OnStart(ctx, &document.LoaderCallbackInput{
+		Source: src,
+	})
+	defer func() {
+		if err != nil {
+			_ = callbacks.OnError(ctx, err)
+		}
+	}()
+
+	cql, err := confluenceCQL(src.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/rest/api/content/search?cql=%s&limit=%d&expand=%s",
+		url.QueryEscape(cql), l.conf.PageSize, url.QueryEscape("body.storage,version,space,ancestors"))
+	err = l.list(ctx, path, func(c *confluenceContent) error {
+		pageDocs, err := l.toDocuments(ctx, src.URI, c)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, pageDocs...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load confluence [%s]: %w", src.URI, err)
+	}
+
+	_ = callbacks.OnEnd(ctx, &document.LoaderCallbackOutput{
+		Source: src,
+		Docs:   docs,
+	})
+
+	return docs, nil
+}
+
+// list lists the contents of all the result pages, following the next links.
+func (l *ConfluenceLoader) list(ctx context.Context, path string, fn func(c *confluenceContent) error) error {
+	for path != "" {
+		resp := &confluenceList{}
+		if err := l.cli.get(ctx, path, resp); err != nil {
+			return err
+		}
+		for _, c := range resp.Results {
+			if err := fn(c); err != nil {
+				return err
+			}
+		}
+		// the next link is relative to the base url
+		path = resp.Links.Next
+	}
+	return nil
+}
+
+func (l *ConfluenceLoader) toDocuments(ctx context.Context, source string, c *confluenceContent) ([]*schema.Document, error) {
+	md, err := htmlToMarkdown(c.Body.Storage.Value)
+	if err != nil {
+		return nil, fmt.Errorf("convert page %s failed: %w", c.ID, err)
+	}
+	content := "# " + c.Title
+	if md != "" {
+		content += "\n\n" + md
+	}
+
+	ancestors := make([]string, 0, len(c.Ancestors))
+	for _, a := range c.Ancestors {
+		ancestors = append(ancestors, a.Title)
+	}
+	docs := []*schema.Document{{
+		ID:      c.ID,
+		Content: content,
+		MetaData: map[string]any{
+			MetaKeySource:    source,
+			MetaKeyTitle:     c.Title,
+			MetaKeyURL:       l.cli.baseURL + c.Links.WebUI,
+			MetaKeySpace:     c.Space.Key,
+			MetaKeyAncestors: ancestors,
+			MetaKeyVersion:   c.Version.Number,
+			MetaKeyAuthor:    c.Version.By.DisplayName,
+			MetaKeyUpdated:   c.Version.When,
+		},
+	}}
+
+	if l.conf.AttachmentParser == nil {
+		return docs, nil
+	}
+	path := fmt.Sprintf("/rest/api/content/%s/child/attachment?limit=%d", c.ID, l.conf.PageSize)
+	err = l.list(ctx, path, func(a *confluenceContent) error {
+		if a.Extensions.FileSize > l.conf.MaxAttachmentSize || a.Links.Download == "" {
+			return nil
+		}
+		attachmentDocs, err := l.parseAttachment(ctx, source, c, a)
+		if err != nil {
+			return fmt.Errorf("parse attachment %s of page %s failed: %w", a.Title, c.ID, err)
+		}
+		docs = append(docs, attachmentDocs...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (l *ConfluenceLoader) parseAttachment(ctx context.Context, source string, page, a *confluenceContent) ([]*schema.Document, error) {
+	body, err := l.cli.open(ctx, a.Links.Download)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	docs, err := l.conf.AttachmentParser.Parse(ctx, body,
+		// the parser.ExtParser selects the parser by the extension of the uri
+		parser.WithURI(a.Title),
+		parser.WithExtraMeta(map[string]any{
+			MetaKeySource:    source,
+			MetaKeyTitle:     a.Title,
+			MetaKeyURL:       l.cli.baseURL + a.Links.Download,
+			MetaKeySpace:     page.Space.Key,
+			MetaKeyPageID:    page.ID,
+			MetaKeyMediaType: a.Extensions.MediaType,
+		}))
+	if err != nil {
+		return nil, err
+	}
+	for i, doc := range docs {
+		if doc.ID == "" {
+			doc.ID = fmt.Sprintf("%s_%d", a.ID, i)
+		}
+	}
+	return docs, nil
+}
+
+func (l *ConfluenceLoader) GetType() string {
+	return "ConfluenceLoader"
+}
+
+func (l *ConfluenceLoader) IsCallbacksEnabled() bool {
+	return true
+}
+
+func confluenceCQL(uri string) (string, error) {
+	switch {
+	case strings.HasPrefix(uri, "confluence://space/"):
+		return fmt.Sprintf(`space = "%s" and type = page`, strings.TrimPrefix(uri, "confluence://space/")), nil
+	case strings.HasPrefix(uri, "confluence://page/"):
+		return "id = " + strings.TrimPrefix(uri, "confluence://page/"), nil
+	case strings.HasPrefix(uri, "cql:"):
+		return strings.TrimPrefix(uri, "cql:"), nil
+	}
+	return "", fmt.Errorf("invalid confluence source [%s]", uri)
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockParser struct{}
+
+func (m *mockParser) Parse(ctx context.Context, reader io.Reader, opts ...parser.Option) ([]*schema.Document, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.Document{{Content: string(data), MetaData: parser.GetCommonOptions(nil, opts...).ExtraMeta}}, nil
+}
+
+func TestConfluenceLoader(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/wiki/rest/api/content/search", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "me@example.com", user)
+		assert.Equal(t, "token", pass)
+		if r.URL.Query().Get("cursor") == "" {
+			assert.Equal(t, `space = "DEV" and type = page`, r.URL.Query().Get("cql"))
+			_, _ = w.Write([]byte(`{"results":[{"id":"1","type":"page","title":"Home","space":{"key":"DEV"},
+				"version":{"number":3,"when":"2025-01-01T00:00:00.000Z","by":{"displayName":"Alice"}},
+				"body":{"storage":{"value":"<p>Welcome</p>"}},"_links":{"webui":"/spaces/DEV/pages/1"}}],
+				"_links":{"next":"/rest/api/content/search?cql=x&cursor=abc"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"results":[{"id":"2","type":"page","title":"Runbook","space":{"key":"DEV"},
+			"ancestors":[{"title":"Home"}],"body":{"storage":{"value":""}},"_links":{"webui":"/spaces/DEV/pages/2"}}],"_links":{}}`))
+	})
+	mux.HandleFunc("/wiki/rest/api/content/1/child/attachment", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[
+			{"id":"att1","title":"notes.txt","extensions":{"mediaType":"text/plain","fileSize":5},"_links":{"download":"/download/attachments/1/notes.txt"}},
+			{"id":"att2","title":"video.mp4","extensions":{"mediaType":"video/mp4","fileSize":104857600},"_links":{"download":"/download/attachments/1/video.mp4"}}
+		],"_links":{}}`))
+	})
+	mux.HandleFunc("/wiki/rest/api/content/2/child/attachment", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[],"_links":{}}`))
+	})
+	mux.HandleFunc("/wiki/download/attachments/1/notes.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("notes"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	_, err := NewConfluenceLoader(ctx, &ConfluenceLoaderConfig{BaseURL: srv.URL})
+	assert.EqualError(t, err, "token, or username and api token are required")
+
+	auth := Auth{Username: "me@example.com", APIToken: "token"}
+
+	t.Run("skip attachments", func(t *testing.T) {
+		l, err := NewConfluenceLoader(ctx, &ConfluenceLoaderConfig{BaseURL: srv.URL + "/wiki", Auth: auth})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "confluence://space/DEV"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "1", docs[0].ID)
+		assert.Equal(t, "# Home\n\nWelcome", docs[0].Content)
+		assert.Equal(t, srv.URL+"/wiki/spaces/DEV/pages/1", docs[0].MetaData[MetaKeyURL])
+		assert.Equal(t, "Alice", docs[0].MetaData[MetaKeyAuthor])
+		assert.Equal(t, 3, docs[0].MetaData[MetaKeyVersion])
+		assert.Equal(t, "# Runbook", docs[1].Content)
+		assert.Equal(t, []string{"Home"}, docs[1].MetaData[MetaKeyAncestors])
+	})
+
+	t.Run("download attachments", func(t *testing.T) {
+		l, err := NewConfluenceLoader(ctx, &ConfluenceLoaderConfig{BaseURL: srv.URL + "/wiki", Auth: auth, AttachmentParser: &mockParser{}})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "confluence://space/DEV"})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(docs))
+		assert.Equal(t, "att1_0", docs[1].ID)
+		assert.Equal(t, "notes", docs[1].Content)
+		assert.Equal(t, "1", docs[1].MetaData[MetaKeyPageID])
+		assert.Equal(t, "text/plain", docs[1].MetaData[MetaKeyMediaType])
+	})
+
+	t.Run("invalid source", func(t *testing.T) {
+		l, err := NewConfluenceLoader(ctx, &ConfluenceLoaderConfig{BaseURL: srv.URL + "/wiki", Auth: auth})
+		assert.NoError(t, err)
+
+		_, err = l.Load(ctx, document.Source{URI: "https://example.com"})
+		assert.EqualError(t, err, "invalid confluence source [https://example.com]")
+	})
+}
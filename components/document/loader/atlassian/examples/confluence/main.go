@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+
+	"github.com/cloudwego/eino-ext/components/document/loader/atlassian"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// parse the text attachments, other attachments are parsed by the registered parsers, e.g. pdf
+	attachmentParser, err := parser.NewExtParser(ctx, &parser.ExtParserConfig{
+		Parsers: map[string]parser.Parser{".txt": parser.TextParser{}, ".md": parser.TextParser{}},
+	})
+	if err != nil {
+		log.Fatalf("parser.NewExtParser failed, err=%v", err)
+	}
+
+	loader, err := atlassian.NewConfluenceLoader(ctx, &atlassian.ConfluenceLoaderConfig{
+		BaseURL: os.Getenv("CONFLUENCE_URL"), // e.g. https://your-domain.atlassian.net/wiki
+		Auth: atlassian.Auth{
+			Username: os.Getenv("ATLASSIAN_EMAIL"),
+			APIToken: os.Getenv("ATLASSIAN_API_TOKEN"),
+		},
+		AttachmentParser: attachmentParser,
+	})
+	if err != nil {
+		log.Fatalf("atlassian.NewConfluenceLoader failed, err=%v", err)
+	}
+
+	docs, err := loader.Load(ctx, document.Source{URI: "confluence://space/" + os.Getenv("CONFLUENCE_SPACE")})
+	if err != nil {
+		log.Fatalf("loader.Load failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id: %s, title: %v, ancestors: %v", doc.ID, doc.MetaData[atlassian.MetaKeyTitle], doc.MetaData[atlassian.MetaKeyAncestors])
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/document"
+
+	"github.com/cloudwego/eino-ext/components/document/loader/atlassian"
+)
+
+func main() {
+	ctx := context.Background()
+
+	loader, err := atlassian.NewJiraLoader(ctx, &atlassian.JiraLoaderConfig{
+		BaseURL: os.Getenv("JIRA_URL"), // e.g. https://your-domain.atlassian.net
+		Auth: atlassian.Auth{
+			Username: os.Getenv("ATLASSIAN_EMAIL"),
+			APIToken: os.Getenv("ATLASSIAN_API_TOKEN"),
+		},
+		SearchPath:      "/rest/api/2/search/jql",
+		IncludeComments: true,
+	})
+	if err != nil {
+		log.Fatalf("atlassian.NewJiraLoader failed, err=%v", err)
+	}
+
+	docs, err := loader.Load(ctx, document.Source{URI: "jql:project = " + os.Getenv("JIRA_PROJECT") + " and updated >= -7d"})
+	if err != nil {
+		log.Fatalf("loader.Load failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("%s [%v]\n%s", doc.ID, doc.MetaData[atlassian.MetaKeyStatus], doc.Content)
+	}
+}
@@ -0,0 +1,389 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var spaces = regexp.MustCompile(`[\s\x{00a0}]+`)
+
+// voidElements are the html elements without end tags, xml.HTMLAutoClose is not used as it
+// matches the local names, and closes <ac:link> of confluence as <link>.
+var voidElements = []string{"br", "hr", "img", "input", "area", "col", "base", "meta", "wbr", "source", "embed"}
+
+type node struct {
+	// name is the local name of the element, with the prefix of confluence elements, e.g. "ac:structured-macro".
+	name     string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+func (n *node) isText() bool {
+	return n.name == ""
+}
+
+// child returns the first child element of the name.
+func (n *node) child(name string) *node {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// param returns the value of the macro parameter.
+func (n *node) param(name string) string {
+	for _, c := range n.children {
+		if c.name == "ac:parameter" && c.attrs["ac:name"] == name {
+			return c.textContent()
+		}
+	}
+	return ""
+}
+
+func (n *node) textContent() string {
+	if n.isText() {
+		return n.text
+	}
+	var sb strings.Builder
+	for _, c := range n.children {
+		sb.WriteString(c.textContent())
+	}
+	return sb.String()
+}
+
+// parseHTML parses html and the xhtml storage format of confluence leniently.
+func parseHTML(s string) (*node, error) {
+	dec := xml.NewDecoder(strings.NewReader("<root>" + s + "</root>"))
+	dec.Strict = false
+	dec.AutoClose = voidElements
+	dec.Entity = xml.HTMLEntity
+
+	doc := &node{}
+	stack := []*node{doc}
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			if len(doc.children) == 0 {
+				return doc, nil
+			}
+			// the root element wrapping s
+			return doc.children[0], nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse html failed: %w", err)
+		}
+
+		top := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{name: nameOf(t.Name), attrs: make(map[string]string, len(t.Attr))}
+			for _, a := range t.Attr {
+				n.attrs[nameOf(a.Name)] = a.Value
+			}
+			top.children = append(top.children, n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			// close the unclosed elements, e.g. <p> before </div>
+			name := nameOf(t.Name)
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].name == name {
+					stack = stack[:i]
+					break
+				}
+			}
+		case xml.CharData:
+			top.children = append(top.children, &node{text: string(t)})
+		}
+	}
+}
+
+func nameOf(n xml.Name) string {
+	local := strings.ToLower(n.Local)
+	if n.Space == "" {
+		return local
+	}
+	return n.Space + ":" + local
+}
+
+// htmlToMarkdown converts html and the xhtml storage format of confluence to markdown.
+func htmlToMarkdown(s string) (string, error) {
+	root, err := parseHTML(s)
+	if err != nil {
+		return "", err
+	}
+	return renderBlocks(root.children, "\n\n"), nil
+}
+
+var blockElements = map[string]bool{
+	"p": true, "div": true, "section": true, "article": true, "main": true, "header": true, "footer": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "pre": true, "blockquote": true, "table": true, "hr": true,
+	"ac:structured-macro": true, "ac:task-list": true, "ac:layout": true, "ac:layout-section": true,
+	"ac:layout-cell": true, "ac:rich-text-body": true, "ac:adf-extension": true,
+}
+
+var skippedElements = map[string]bool{
+	"script": true, "style": true, "head": true,
+	"ac:parameter": true, "ac:placeholder": true, "ac:emoticon": true,
+}
+
+// renderBlocks renders the nodes in blocks joined by sep, the inline nodes between blocks are a paragraph.
+func renderBlocks(nodes []*node, sep string) string {
+	var (
+		blocks []string
+		inline []*node
+	)
+	flush := func() {
+		if s := strings.TrimSpace(renderInline(inline)); s != "" {
+			blocks = append(blocks, s)
+		}
+		inline = nil
+	}
+	for _, n := range nodes {
+		if n.isText() || !blockElements[n.name] {
+			inline = append(inline, n)
+			continue
+		}
+		flush()
+		if s := renderBlock(n); s != "" {
+			blocks = append(blocks, s)
+		}
+	}
+	flush()
+	return strings.Join(blocks, sep)
+}
+
+func renderBlock(n *node) string {
+	switch n.name {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		text := strings.TrimSpace(strings.ReplaceAll(renderInline(n.children), "\n", " "))
+		if text == "" {
+			return ""
+		}
+		return strings.Repeat("#", int(n.name[1]-'0')) + " " + text
+	case "ul", "ol":
+		return renderList(n)
+	case "pre":
+		return codeBlock("", n.textContent())
+	case "blockquote":
+		return quote(renderBlocks(n.children, "\n\n"))
+	case "table":
+		return renderTable(n)
+	case "hr":
+		return "---"
+	case "ac:task-list":
+		var items []string
+		for _, task := range n.children {
+			if task.name != "ac:task" {
+				continue
+			}
+			marker := "- [ ] "
+			if status := task.child("ac:task-status"); status != nil && strings.TrimSpace(status.textContent()) == "complete" {
+				marker = "- [x] "
+			}
+			body := ""
+			if b := task.child("ac:task-body"); b != nil {
+				body = strings.TrimSpace(renderInline(b.children))
+			}
+			items = append(items, marker+body)
+		}
+		return strings.Join(items, "\n")
+	case "ac:structured-macro":
+		return renderMacro(n)
+	default:
+		return renderBlocks(n.children, "\n\n")
+	}
+}
+
+// renderMacro renders the code macros in code blocks, and the bodies of other macros, e.g. info, note and expand.
+func renderMacro(n *node) string {
+	switch n.attrs["ac:name"] {
+	case "code", "noformat":
+		body := n.child("ac:plain-text-body")
+		if body == nil {
+			return ""
+		}
+		return codeBlock(n.param("language"), body.textContent())
+	case "info", "note", "tip", "warning", "panel":
+		if body := n.child("ac:rich-text-body"); body != nil {
+			return quote(renderBlocks(body.children, "\n\n"))
+		}
+	default:
+		if body := n.child("ac:rich-text-body"); body != nil {
+			return renderBlocks(body.children, "\n\n")
+		}
+	}
+	return ""
+}
+
+func renderList(n *node) string {
+	var items []string
+	number := 0
+	for _, li := range n.children {
+		if li.name != "li" {
+			continue
+		}
+		marker := "- "
+		if n.name == "ol" {
+			number++
+			marker = fmt.Sprintf("%d. ", number)
+		}
+		content := renderBlocks(li.children, "\n")
+		indent := strings.Repeat(" ", len(marker))
+		lines := strings.Split(content, "\n")
+		for i := 1; i < len(lines); i++ {
+			if lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+	return strings.Join(items, "\n")
+}
+
+func renderTable(n *node) string {
+	var rows [][]string
+	var collect func(n *node)
+	collect = func(n *node) {
+		for _, c := range n.children {
+			switch c.name {
+			case "thead", "tbody", "tfoot":
+				collect(c)
+			case "tr":
+				var cells []string
+				for _, cell := range c.children {
+					if cell.name == "td" || cell.name == "th" {
+						text := renderBlocks(cell.children, " ")
+						cells = append(cells, strings.ReplaceAll(strings.ReplaceAll(text, "\n", " "), "|", "\\|"))
+					}
+				}
+				rows = append(rows, cells)
+			}
+		}
+	}
+	collect(n)
+
+	lines := make([]string, 0, len(rows)+1)
+	for i, cells := range rows {
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", len(cells)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func renderInline(nodes []*node) string {
+	var sb strings.Builder
+	for _, n := range nodes {
+		if n.isText() {
+			sb.WriteString(spaces.ReplaceAllString(n.text, " "))
+			continue
+		}
+		if skippedElements[n.name] {
+			continue
+		}
+
+		switch n.name {
+		case "br":
+			sb.WriteString("\n")
+		case "strong", "b":
+			sb.WriteString(wrap(renderInline(n.children), "**"))
+		case "em", "i":
+			sb.WriteString(wrap(renderInline(n.children), "_"))
+		case "s", "del", "strike":
+			sb.WriteString(wrap(renderInline(n.children), "~~"))
+		case "code":
+			sb.WriteString(wrap(n.textContent(), "`"))
+		case "a":
+			text := renderInline(n.children)
+			if href := n.attrs["href"]; href != "" && !strings.HasPrefix(href, "#") {
+				text = "[" + strings.TrimSpace(text) + "](" + href + ")"
+			}
+			sb.WriteString(text)
+		case "img":
+			sb.WriteString("![" + n.attrs["alt"] + "](" + n.attrs["src"] + ")")
+		case "ac:image":
+			if u := n.child("ri:url"); u != nil {
+				sb.WriteString("![](" + u.attrs["ri:value"] + ")")
+			} else if a := n.child("ri:attachment"); a != nil {
+				sb.WriteString("![" + a.attrs["ri:filename"] + "](" + a.attrs["ri:filename"] + ")")
+			}
+		case "ac:link":
+			sb.WriteString(renderLink(n))
+		default:
+			if blockElements[n.name] {
+				sb.WriteString(" " + renderBlock(n) + " ")
+			} else {
+				sb.WriteString(renderInline(n.children))
+			}
+		}
+	}
+	return sb.String()
+}
+
+// renderLink renders the links of confluence to pages, attachments and users by their text.
+func renderLink(n *node) string {
+	for _, name := range []string{"ac:plain-text-link-body", "ac:link-body"} {
+		if body := n.child(name); body != nil {
+			if text := strings.TrimSpace(body.textContent()); text != "" {
+				return text
+			}
+		}
+	}
+	if p := n.child("ri:page"); p != nil {
+		return p.attrs["ri:content-title"]
+	}
+	if a := n.child("ri:attachment"); a != nil {
+		return a.attrs["ri:filename"]
+	}
+	return ""
+}
+
+// wrap wraps the text with the markers, keeping the spaces outside the markers.
+func wrap(s, marker string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	lead := s[:strings.Index(s, trimmed)]
+	trail := s[len(lead)+len(trimmed):]
+	return lead + marker + trimmed + marker + trail
+}
+
+func codeBlock(language, code string) string {
+	return "```" + language + "\n" + strings.Trim(code, "\n") + "\n```"
+}
+
+func quote(s string) string {
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLToMarkdown(t *testing.T) {
+	storage := `<h1>Title &amp; more</h1><p>Hello <strong>bold </strong>and <a href="https://example.com">link</a>&nbsp;end<br/>next</p>
+<ul><li>a<ul><li>b</li></ul></li><li><p>c</p></li></ul>
+<ol><li>one</li><li>two</li></ol>
+<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter><ac:plain-text-body><![CDATA[fmt.Println("<x>")]]></ac:plain-text-body></ac:structured-macro>
+<ac:structured-macro ac:name="info"><ac:rich-text-body><p>Note this</p></ac:rich-text-body></ac:structured-macro>
+<ac:structured-macro ac:name="toc"><ac:parameter ac:name="maxLevel">2</ac:parameter></ac:structured-macro>
+<table><tbody><tr><th>k</th><th>v</th></tr><tr><td><p>a|b</p></td><td>1</td></tr></tbody></table>
+<ac:task-list><ac:task><ac:task-id>1</ac:task-id><ac:task-status>complete</ac:task-status><ac:task-body>done</ac:task-body></ac:task></ac:task-list>
+<p>See <ac:link><ri:page ri:content-title="Other Page"/></ac:link> and <ac:image><ri:attachment ri:filename="a.png"/></ac:image></p>
+<hr/><blockquote><p>q1</p><p>q2</p></blockquote>`
+
+	md, err := htmlToMarkdown(storage)
+	assert.NoError(t, err)
+	assert.Equal(t, "# Title & more\n\n"+
+		"Hello **bold** and [link](https://example.com) end\nnext\n\n"+
+		"- a\n  - b\n- c\n\n"+
+		"1. one\n2. two\n\n"+
+		"```go\nfmt.Println(\"<x>\")\n```\n\n"+
+		"> Note this\n\n"+
+		"| k | v |\n| --- | --- |\n| a\\|b | 1 |\n\n"+
+		"- [x] done\n\n"+
+		"See Other Page and ![a.png](a.png)\n\n"+
+		"---\n\n"+
+		"> q1\n>\n> q2", md)
+
+	md, err = htmlToMarkdown("<p>unclosed <b>tags<p>")
+	assert.NoError(t, err)
+	assert.Equal(t, "unclosed **tags**", md)
+}
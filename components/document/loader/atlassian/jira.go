@@ -0,0 +1,323 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultJiraSearchPath = "/rest/api/2/search"
+	jiraFields            = "summary,description,status,issuetype,priority,assignee,reporter,labels,created,updated,project,comment"
+)
+
+const (
+	// MetaKeyKey is the metadata key of the key of the jira issue, e.g. "PROJ-1".
+	MetaKeyKey = "key"
+	// MetaKeyProject is the metadata key of the key of the jira project.
+	MetaKeyProject = "project"
+	// MetaKeyStatus, MetaKeyIssueType and MetaKeyPriority are the metadata keys of the names of the fields of the issue.
+	MetaKeyStatus    = "status"
+	MetaKeyIssueType = "issue_type"
+	MetaKeyPriority  = "priority"
+	// MetaKeyAssignee and MetaKeyReporter are the metadata keys of the display names of the users of the issue.
+	MetaKeyAssignee = "assignee"
+	MetaKeyReporter = "reporter"
+	// MetaKeyLabels is the metadata key of the []string of the labels of the issue.
+	MetaKeyLabels = "labels"
+	// MetaKeyCreated is the metadata key of the ISO 8601 time of the creation of the issue.
+	MetaKeyCreated = "created"
+)
+
+var _ document.Loader = (*JiraLoader)(nil)
+
+// JiraLoaderConfig is the config for jira Loader.
+type JiraLoaderConfig struct {
+	// BaseURL is the url of jira, e.g. "https://your-domain.atlassian.net".
+	// Required.
+	BaseURL string
+	// Auth is the credential of jira.
+	// Required.
+	Auth Auth
+	// PageSize is the number of issues of a search request.
+	// Optional. Default: 50.
+	PageSize int
+	// SearchPath is the path of the search API, e.g. "/rest/api/2/search/jql" of jira cloud.
+	// Optional. Default: "/rest/api/2/search".
+	SearchPath string
+	// IncludeComments appends the comments to the documents of the issues.
+	// Optional. Default: false.
+	IncludeComments bool
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default: &http.Client{Timeout: 30 * time.Second}.
+	HTTPClient *http.Client
+}
+
+// NewJiraLoader creates a new jira loader.
+func NewJiraLoader(ctx context.Context, conf *JiraLoaderConfig) (*JiraLoader, error) {
+	if conf == nil {
+		conf = &JiraLoaderConfig{}
+	}
+	cli, err := newClient(conf.BaseURL, conf.Auth, conf.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+
+	c := *conf
+	if c.PageSize <= 0 {
+		c.PageSize = defaultPageSize
+	}
+	if c.SearchPath == "" {
+		c.SearchPath = defaultJiraSearchPath
+	}
+	return &JiraLoader{cli: cli, conf: &c}, nil
+}
+
+// JiraLoader loads jira issues into markdown documents, a document per issue.
+// The source uri is one of:
+//   - jira://project/<key>, the issues of the project
+//   - jira://issue/<key>, the issue
+//   - jql:<query>, the issues matching the JQL, e.g. `jql:project = PROJ and updated >= -7d`
+type JiraLoader struct {
+	cli  *client
+	conf *JiraLoaderConfig
+}
+
+type jiraName struct {
+	Name string `json:"name"`
+}
+
+type jiraUser struct {
+	DisplayName string `json:"displayName"`
+}
+
+type jiraIssue struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+		// Description is the wiki markup of API v2, used if the rendered html is absent.
+		Description any       `json:"description"`
+		Status      *jiraName `json:"status"`
+		IssueType   *jiraName `json:"issuetype"`
+		Priority    *jiraName `json:"priority"`
+		Assignee    *jiraUser `json:"assignee"`
+		Reporter    *jiraUser `json:"reporter"`
+		Labels      []string  `json:"labels"`
+		Created     string    `json:"created"`
+		Updated     string    `json:"updated"`
+		Project     struct {
+			Key string `json:"key"`
+		} `json:"project"`
+		Comment struct {
+			Comments []struct {
+				Author  *jiraUser `json:"author"`
+				Body    any       `json:"body"`
+				Created string    `json:"created"`
+			} `json:"comments"`
+		} `json:"comment"`
+	} `json:"fields"`
+	RenderedFields struct {
+		Description string `json:"description"`
+		Comment     struct {
+			Comments []struct {
+				Body string `json:"body"`
+			} `json:"comments"`
+		} `json:"comment"`
+	} `json:"renderedFields"`
+}
+
+type jiraSearchResult struct {
+	StartAt       int          `json:"startAt"`
+	Total         int          `json:"total"`
+	IsLast        bool         `json:"isLast"`
+	NextPageToken string       `json:"nextPageToken"`
+	Issues        []*jiraIssue `json:"issues"`
+}
+
+func (l *JiraLoader) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, l.GetType(), components.ComponentOfLoader)
+	ctx = callbacks.OnStart(ctx, &document.LoaderCallbackInput{
+		Source: src,
+	})
+	defer func() {
+		if err != nil {
+			_ = callbacks.OnError(ctx, err)
+		}
+	}()
+
+	jql, err := jiraJQL(src.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = l.search(ctx, jql, func(issue *jiraIssue) error {
+		doc, err := l.toDocument(src.URI, issue)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to load jira [%s]: %w", src.URI, err)
+	}
+
+	_ = callbacks.OnEnd(ctx, &document.LoaderCallbackOutput{
+		Source: src,
+		Docs:   docs,
+	})
+
+	return docs, nil
+}
+
+// search lists the issues of all the result pages, paginated by startAt, or nextPageToken of the jql search API of jira cloud.
+func (l *JiraLoader) search(ctx context.Context, jql string, fn func(issue *jiraIssue) error) error {
+	query := url.Values{}
+	query.Set("jql", jql)
+	query.Set("maxResults", fmt.Sprint(l.conf.PageSize))
+	query.Set("fields", jiraFields)
+	query.Set("expand", "renderedFields")
+
+	for {
+		resp := &jiraSearchResult{}
+		if err := l.cli.get(ctx, l.conf.SearchPath+"?"+query.Encode(), resp); err != nil {
+			return err
+		}
+		for _, issue := range resp.Issues {
+			if err := fn(issue); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case resp.NextPageToken != "":
+			query.Set("nextPageToken", resp.NextPageToken)
+		case resp.IsLast || len(resp.Issues) == 0:
+			return nil
+		default:
+			startAt := resp.StartAt + len(resp.Issues)
+			if startAt >= resp.Total {
+				return nil
+			}
+			query.Set("startAt", fmt.Sprint(startAt))
+		}
+	}
+}
+
+func (l *JiraLoader) toDocument(source string, issue *jiraIssue) (*schema.Document, error) {
+	f := &issue.Fields
+	content := fmt.Sprintf("# %s: %s", issue.Key, f.Summary)
+
+	description, err := l.render(issue.RenderedFields.Description, f.Description)
+	if err != nil {
+		return nil, fmt.Errorf("convert description of issue %s failed: %w", issue.Key, err)
+	}
+	if description != "" {
+		content += "\n\n" + description
+	}
+
+	if l.conf.IncludeComments && len(f.Comment.Comments) > 0 {
+		comments := make([]string, 0, len(f.Comment.Comments))
+		rendered := issue.RenderedFields.Comment.Comments
+		for i, c := range f.Comment.Comments {
+			html := ""
+			if i < len(rendered) {
+				html = rendered[i].Body
+			}
+			body, err := l.render(html, c.Body)
+			if err != nil {
+				return nil, fmt.Errorf("convert comment of issue %s failed: %w", issue.Key, err)
+			}
+			comments = append(comments, fmt.Sprintf("**%s** (%s):\n\n%s", displayName(c.Author), c.Created, body))
+		}
+		content += "\n\n## Comments\n\n" + strings.Join(comments, "\n\n")
+	}
+
+	return &schema.Document{
+		ID:      issue.Key,
+		Content: content,
+		MetaData: map[string]any{
+			MetaKeySource:    source,
+			MetaKeyKey:       issue.Key,
+			MetaKeyTitle:     f.Summary,
+			MetaKeyURL:       l.cli.baseURL + "/browse/" + issue.Key,
+			MetaKeyProject:   f.Project.Key,
+			MetaKeyStatus:    nameOrEmpty(f.Status),
+			MetaKeyIssueType: nameOrEmpty(f.IssueType),
+			MetaKeyPriority:  nameOrEmpty(f.Priority),
+			MetaKeyAssignee:  displayName(f.Assignee),
+			MetaKeyReporter:  displayName(f.Reporter),
+			MetaKeyLabels:    f.Labels,
+			MetaKeyCreated:   f.Created,
+			MetaKeyUpdated:   f.Updated,
+		},
+	}, nil
+}
+
+// render converts the rendered html to markdown, or returns the raw text of the field.
+func (l *JiraLoader) render(html string, raw any) (string, error) {
+	if html != "" {
+		return htmlToMarkdown(html)
+	}
+	if s, ok := raw.(string); ok {
+		return strings.TrimSpace(s), nil
+	}
+	return "", nil
+}
+
+func (l *JiraLoader) GetType() string {
+	return "JiraLoader"
+}
+
+func (l *JiraLoader) IsCallbacksEnabled() bool {
+	return true
+}
+
+func jiraJQL(uri string) (string, error) {
+	switch {
+	case strings.HasPrefix(uri, "jira://project/"):
+		return fmt.Sprintf(`project = "%s" order by updated desc`, strings.TrimPrefix(uri, "jira://project/")), nil
+	case strings.HasPrefix(uri, "jira://issue/"):
+		return "key = " + strings.TrimPrefix(uri, "jira://issue/"), nil
+	case strings.HasPrefix(uri, "jql:"):
+		return strings.TrimPrefix(uri, "jql:"), nil
+	}
+	return "", fmt.Errorf("invalid jira source [%s]", uri)
+}
+
+func nameOrEmpty(n *jiraName) string {
+	if n == nil {
+		return ""
+	}
+	return n.Name
+}
+
+func displayName(u *jiraUser) string {
+	if u == nil {
+		return ""
+	}
+	return u.DisplayName
+}
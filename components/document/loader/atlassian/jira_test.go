@@ -0,0 +1,87 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atlassian
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJiraLoader(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer pat", r.Header.Get("Authorization"))
+		q := r.URL.Query()
+		assert.Equal(t, `project = "PROJ" order by updated desc`, q.Get("jql"))
+		assert.Equal(t, "renderedFields", q.Get("expand"))
+		switch r.URL.Path {
+		case "/rest/api/2/search":
+			if q.Get("startAt") == "" {
+				_, _ = w.Write([]byte(`{"startAt":0,"total":2,"issues":[{"id":"10","key":"PROJ-1","fields":{
+					"summary":"Login fails","status":{"name":"Open"},"issuetype":{"name":"Bug"},"priority":{"name":"High"},
+					"assignee":{"displayName":"Bob"},"labels":["auth"],"project":{"key":"PROJ"},
+					"comment":{"comments":[{"author":{"displayName":"Carol"},"body":"raw","created":"2025-01-02"}]}},
+					"renderedFields":{"description":"<p>Steps: <em>click</em></p>","comment":{"comments":[{"body":"<p>Reproduced</p>"}]}}}]}`))
+				return
+			}
+			assert.Equal(t, "1", q.Get("startAt"))
+			_, _ = w.Write([]byte(`{"startAt":1,"total":2,"issues":[{"id":"11","key":"PROJ-2","fields":{"summary":"Docs","description":"wiki *markup*"}}]}`))
+		case "/rest/api/2/search/jql":
+			if q.Get("nextPageToken") == "" {
+				_, _ = w.Write([]byte(`{"nextPageToken":"n1","issues":[{"id":"10","key":"PROJ-1","fields":{"summary":"a"}}]}`))
+				return
+			}
+			assert.Equal(t, "n1", q.Get("nextPageToken"))
+			_, _ = w.Write([]byte(`{"isLast":true,"issues":[{"id":"11","key":"PROJ-2","fields":{"summary":"b"}}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	auth := Auth{Token: "pat"}
+
+	t.Run("start at", func(t *testing.T) {
+		l, err := NewJiraLoader(ctx, &JiraLoaderConfig{BaseURL: srv.URL, Auth: auth, IncludeComments: true})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "jira://project/PROJ"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "PROJ-1", docs[0].ID)
+		assert.Equal(t, "# PROJ-1: Login fails\n\nSteps: _click_\n\n## Comments\n\n**Carol** (2025-01-02):\n\nReproduced", docs[0].Content)
+		assert.Equal(t, "Bug", docs[0].MetaData[MetaKeyIssueType])
+		assert.Equal(t, "Bob", docs[0].MetaData[MetaKeyAssignee])
+		assert.Equal(t, []string{"auth"}, docs[0].MetaData[MetaKeyLabels])
+		assert.Equal(t, srv.URL+"/browse/PROJ-1", docs[0].MetaData[MetaKeyURL])
+		assert.Equal(t, "# PROJ-2: Docs\n\nwiki *markup*", docs[1].Content)
+	})
+
+	t.Run("next page token", func(t *testing.T) {
+		l, err := NewJiraLoader(ctx, &JiraLoaderConfig{BaseURL: srv.URL, Auth: auth, SearchPath: "/rest/api/2/search/jql"})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "jira://project/PROJ"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "PROJ-2", docs[1].ID)
+	})
+}
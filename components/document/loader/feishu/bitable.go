@@ -0,0 +1,138 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feishu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// field types of bitable whose values are unix milliseconds.
+const (
+	fieldDateTime     = 5
+	fieldCreatedTime  = 1001
+	fieldModifiedTime = 1002
+)
+
+type bitableTable struct {
+	TableID string `json:"table_id"`
+	Name    string `json:"name"`
+}
+
+type bitableField struct {
+	FieldName string `json:"field_name"`
+	Type      int    `json:"type"`
+}
+
+type bitableRecord struct {
+	RecordID string         `json:"record_id"`
+	Fields   map[string]any `json:"fields"`
+}
+
+// loadBitable loads the records of the table, or all the tables of the app if tableID is empty.
+// Each record is a document of the lines of "field: value", in the order of the fields of the table.
+func (l *Loader) loadBitable(ctx context.Context, st *loadState, appToken, tableID string, path []string) error {
+	base := "/open-apis/bitable/v1/apps/" + appToken + "/tables"
+	tables, err := listAll[*bitableTable](ctx, l.cli, base+"?page_size=100")
+	if err != nil {
+		return fmt.Errorf("list tables of bitable %s failed: %w", appToken, err)
+	}
+
+	for _, table := range tables {
+		if tableID != "" && table.TableID != tableID {
+			continue
+		}
+
+		fields, err := listAll[*bitableField](ctx, l.cli, base+"/"+table.TableID+"/fields?page_size=100")
+		if err != nil {
+			return fmt.Errorf("list fields of table %s failed: %w", table.TableID, err)
+		}
+		records, err := listAll[*bitableRecord](ctx, l.cli, base+"/"+table.TableID+"/records?page_size=500")
+		if err != nil {
+			return fmt.Errorf("list records of table %s failed: %w", table.TableID, err)
+		}
+
+		tablePath := appendPath(path, table.Name)
+		for _, r := range records {
+			lines := make([]string, 0, len(fields))
+			for _, f := range fields {
+				if v := fieldValue(r.Fields[f.FieldName], f.Type); v != "" {
+					lines = append(lines, f.FieldName+": "+v)
+				}
+			}
+			st.docs = append(st.docs, &schema.Document{
+				ID:      r.RecordID,
+				Content: strings.Join(lines, "\n"),
+				MetaData: map[string]any{
+					MetaKeySource:  st.source,
+					MetaKeyTitle:   table.Name,
+					MetaKeyType:    "bitable",
+					MetaKeyToken:   appToken,
+					MetaKeyTableID: table.TableID,
+					MetaKeyPath:    tablePath,
+					MetaKeyFields:  r.Fields,
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// fieldValue renders the value of the field in text, e.g. the text of rich texts, the names of users and options.
+func fieldValue(v any, fieldType int) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		if fieldType == fieldDateTime || fieldType == fieldCreatedTime || fieldType == fieldModifiedTime {
+			return time.UnixMilli(int64(t)).UTC().Format(time.RFC3339)
+		}
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case []any:
+		values := make([]string, 0, len(t))
+		for _, e := range t {
+			if s := fieldValue(e, fieldType); s != "" {
+				values = append(values, s)
+			}
+		}
+		// the rich texts are split into segments
+		if len(t) > 0 {
+			if m, ok := t[0].(map[string]any); ok && m["type"] == "text" {
+				return strings.Join(values, "")
+			}
+		}
+		return strings.Join(values, ", ")
+	case map[string]any:
+		for _, key := range []string{"text", "name", "link", "value"} {
+			if s := fieldValue(t[key], fieldType); s != "" {
+				return s
+			}
+		}
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
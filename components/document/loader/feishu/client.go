@@ -0,0 +1,175 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feishu
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	maxRetries = 3
+	// codeRateLimited is the code of the response when the request frequency exceeds the limit.
+	codeRateLimited = 99991400
+)
+
+type response struct {
+	Code int             `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+type list[T any] struct {
+	Items     []T    `json:"items"`
+	HasMore   bool   `json:"has_more"`
+	PageToken string `json:"page_token"`
+}
+
+type apiClient struct {
+	baseURL   string
+	appID     string
+	appSecret string
+	cli       *http.Client
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// tenantToken returns the cached tenant access token, and refreshes it a minute before it expires.
+func (c *apiClient) tenantToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.expiresAt) {
+		return c.token, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"app_id": c.appID, "app_secret": c.appSecret})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/open-apis/auth/v3/tenant_access_token/internal", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get tenant access token failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &struct {
+		Code              int    `json:"code"`
+		Msg               string `json:"msg"`
+		TenantAccessToken string `json:"tenant_access_token"`
+		Expire            int    `json:"expire"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return "", fmt.Errorf("decode tenant access token failed: %w", err)
+	}
+	if result.Code != 0 {
+		return "", fmt.Errorf("get tenant access token failed, code: %d, msg: %s", result.Code, result.Msg)
+	}
+
+	c.token = result.TenantAccessToken
+	c.expiresAt = time.Now().Add(time.Duration(result.Expire)*time.Second - time.Minute)
+	return c.token, nil
+}
+
+// get gets the path of the open API, and decodes the data of the response into out.
+// The request is retried after the reset seconds of the rate limit when rate limited.
+func (c *apiClient) get(ctx context.Context, path string, out any) error {
+	token, err := c.tenantToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	for retry := 0; ; retry++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := c.cli.Do(req)
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		result := &response{}
+		if err = json.Unmarshal(data, result); err != nil {
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, data)
+			}
+			return fmt.Errorf("unmarshal response failed: %w", err)
+		}
+
+		if (resp.StatusCode == http.StatusTooManyRequests || result.Code == codeRateLimited) && retry < maxRetries {
+			wait := time.Second
+			if s, err := strconv.Atoi(resp.Header.Get("x-ogw-ratelimit-reset")); err == nil {
+				wait = time.Duration(s) * time.Second
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+		if result.Code != 0 {
+			return fmt.Errorf("feishu api failed, code: %d, msg: %s", result.Code, result.Msg)
+		}
+		return json.Unmarshal(result.Data, out)
+	}
+}
+
+// listAll gets all the pages of the list API.
+func listAll[T any](ctx context.Context, c *apiClient, path string) ([]T, error) {
+	var items []T
+	for pageToken := ""; ; {
+		p := path
+		if pageToken != "" {
+			p += "&page_token=" + url.QueryEscape(pageToken)
+		}
+
+		l := &list[T]{}
+		if err := c.get(ctx, p, l); err != nil {
+			return nil, err
+		}
+		items = append(items, l.Items...)
+		if !l.HasMore || l.PageToken == "" {
+			return items, nil
+		}
+		pageToken = l.PageToken
+	}
+}
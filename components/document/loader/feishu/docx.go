@@ -0,0 +1,380 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feishu
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// block types of docx, see https://open.feishu.cn/document/server-docs/docs/docs/docx-v1/data-structure/block
+const (
+	blockPage       = 1
+	blockText       = 2
+	blockHeading1   = 3
+	blockHeading9   = 11
+	blockBullet     = 12
+	blockOrdered    = 13
+	blockCode       = 14
+	blockQuote      = 15
+	blockTodo       = 17
+	blockCallout    = 19
+	blockDivider    = 22
+	blockFile       = 23
+	blockImage      = 27
+	blockTable      = 31
+	blockQuoteGroup = 34
+)
+
+// codeLanguages are the common languages of code blocks.
+var codeLanguages = map[int]string{
+	7: "bash", 8: "csharp", 9: "cpp", 10: "c", 12: "css", 18: "dockerfile", 22: "go", 24: "html", 28: "json",
+	29: "java", 30: "javascript", 32: "kotlin", 36: "lua", 38: "makefile", 39: "markdown", 43: "php",
+	49: "python", 52: "ruby", 53: "rust", 56: "sql", 57: "scala", 60: "shell", 61: "swift", 63: "typescript",
+	66: "xml", 67: "yaml", 75: "toml",
+}
+
+type textElement struct {
+	TextRun *struct {
+		Content          string `json:"content"`
+		TextElementStyle struct {
+			Bold          bool `json:"bold"`
+			Italic        bool `json:"italic"`
+			Strikethrough bool `json:"strikethrough"`
+			InlineCode    bool `json:"inline_code"`
+			Link          *struct {
+				URL string `json:"url"`
+			} `json:"link"`
+		} `json:"text_element_style"`
+	} `json:"text_run"`
+	MentionDoc *struct {
+		Title string `json:"title"`
+		URL   string `json:"url"`
+	} `json:"mention_doc"`
+	Equation *struct {
+		Content string `json:"content"`
+	} `json:"equation"`
+}
+
+type text struct {
+	Elements []textElement `json:"elements"`
+	Style    struct {
+		Done     bool `json:"done"`
+		Language int  `json:"language"`
+	} `json:"style"`
+}
+
+type block struct {
+	BlockID   string   `json:"block_id"`
+	BlockType int      `json:"block_type"`
+	Children  []string `json:"children"`
+
+	// text is the content of the text, heading, list, code, quote and todo blocks, whose field names vary by type.
+	text  *text
+	Image *struct {
+		Token string `json:"token"`
+	} `json:"image"`
+	File *struct {
+		Name string `json:"name"`
+	} `json:"file"`
+	Table *struct {
+		Cells    []string `json:"cells"`
+		Property struct {
+			ColumnSize int `json:"column_size"`
+		} `json:"property"`
+	} `json:"table"`
+}
+
+var textFields = func() map[int]string {
+	m := map[int]string{
+		blockPage: "page", blockText: "text", blockBullet: "bullet", blockOrdered: "ordered",
+		blockCode: "code", blockQuote: "quote", blockTodo: "todo",
+	}
+	for t := blockHeading1; t <= blockHeading9; t++ {
+		m[t] = fmt.Sprintf("heading%d", t-blockHeading1+1)
+	}
+	return m
+}()
+
+func (b *block) UnmarshalJSON(data []byte) error {
+	type plain block
+	if err := json.Unmarshal(data, (*plain)(b)); err != nil {
+		return err
+	}
+	field, ok := textFields[b.BlockType]
+	if !ok {
+		return nil
+	}
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if c, ok := raw[field]; ok {
+		b.text = &text{}
+		return json.Unmarshal(c, b.text)
+	}
+	return nil
+}
+
+type docxRenderer struct {
+	blocks map[string]*block
+}
+
+// docxToMarkdown renders the blocks of the document in markdown, and returns the title of the page block.
+func docxToMarkdown(blocks []*block) (title, md string) {
+	r := &docxRenderer{blocks: make(map[string]*block, len(blocks))}
+	var page *block
+	for _, b := range blocks {
+		r.blocks[b.BlockID] = b
+		if b.BlockType == blockPage && page == nil {
+			page = b
+		}
+	}
+	if page == nil {
+		return "", ""
+	}
+	if page.text != nil {
+		title = plainText(page.text.Elements)
+	}
+	return title, r.renderChildren(page.Children, "")
+}
+
+func (r *docxRenderer) renderChildren(ids []string, indent string) string {
+	var (
+		sb       strings.Builder
+		number   int
+		prevList bool
+	)
+	for _, id := range ids {
+		b, ok := r.blocks[id]
+		if !ok {
+			continue
+		}
+		if b.BlockType != blockOrdered {
+			number = 0
+		}
+		s := r.renderBlock(b, indent, &number)
+		if s == "" {
+			continue
+		}
+
+		isList := b.BlockType == blockBullet || b.BlockType == blockOrdered || b.BlockType == blockTodo
+		if sb.Len() > 0 {
+			if isList && prevList {
+				sb.WriteString("\n")
+			} else {
+				sb.WriteString("\n\n")
+			}
+		}
+		sb.WriteString(s)
+		prevList = isList
+	}
+	return sb.String()
+}
+
+func (r *docxRenderer) renderBlock(b *block, indent string, number *int) string {
+	content := ""
+	if b.text != nil {
+		content = renderText(b.text.Elements)
+	}
+
+	switch t := b.BlockType; {
+	case t == blockBullet:
+		return r.renderListItem(b, indent, "- ", content)
+	case t == blockOrdered:
+		*number++
+		return r.renderListItem(b, indent, fmt.Sprintf("%d. ", *number), content)
+	case t == blockTodo:
+		marker := "- [ ] "
+		if b.text != nil && b.text.Style.Done {
+			marker = "- [x] "
+		}
+		return r.renderListItem(b, indent, marker, content)
+	case t == blockQuote:
+		return indentLines(content, indent+"> ")
+	case t == blockCallout || t == blockQuoteGroup:
+		return indentLines(r.renderChildren(b.Children, ""), indent+"> ")
+	case t == blockTable:
+		return indentLines(r.renderTable(b), indent)
+	}
+
+	var s string
+	switch t := b.BlockType; {
+	case t == blockText:
+		s = content
+	case t >= blockHeading1 && t <= blockHeading9:
+		level := t - blockHeading1 + 1
+		if level > 6 {
+			level = 6
+		}
+		s = strings.Repeat("#", level) + " " + content
+	case t == blockCode:
+		if b.text != nil {
+			s = "```" + codeLanguages[b.text.Style.Language] + "\n" + plainText(b.text.Elements) + "\n```"
+		}
+	case t == blockDivider:
+		s = "---"
+	case t == blockImage:
+		if b.Image != nil {
+			s = "![image](feishu://image/" + b.Image.Token + ")"
+		}
+	case t == blockFile:
+		if b.File != nil {
+			s = b.File.Name
+		}
+	}
+	s = indentLines(s, indent)
+
+	// e.g. the children of grids and text blocks
+	if children := r.renderChildren(b.Children, indent); children != "" {
+		if s == "" {
+			return children
+		}
+		s += "\n\n" + children
+	}
+	return s
+}
+
+func (r *docxRenderer) renderListItem(b *block, indent, marker, content string) string {
+	s := indent + marker + content
+	if children := r.renderChildren(b.Children, indent+strings.Repeat(" ", len(marker))); children != "" {
+		s += "\n" + children
+	}
+	return s
+}
+
+// renderTable renders the table, whose cells are in row-major order with the content in the children of the cells.
+func (r *docxRenderer) renderTable(b *block) string {
+	if b.Table == nil || b.Table.Property.ColumnSize <= 0 {
+		return ""
+	}
+	columns := b.Table.Property.ColumnSize
+
+	var lines []string
+	for start := 0; start < len(b.Table.Cells); start += columns {
+		end := start + columns
+		if end > len(b.Table.Cells) {
+			end = len(b.Table.Cells)
+		}
+		cells := make([]string, 0, columns)
+		for _, id := range b.Table.Cells[start:end] {
+			cell := ""
+			if c, ok := r.blocks[id]; ok {
+				cell = strings.ReplaceAll(r.renderChildren(c.Children, ""), "\n", " ")
+			}
+			cells = append(cells, strings.ReplaceAll(cell, "|", "\\|"))
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if start == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", len(cells)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderText renders the styles and links of the text elements in markdown.
+func renderText(elements []textElement) string {
+	var sb strings.Builder
+	for _, e := range elements {
+		switch {
+		case e.TextRun != nil:
+			s := e.TextRun.Content
+			style := e.TextRun.TextElementStyle
+			if style.Link != nil && style.Link.URL != "" {
+				// the url of links is url encoded
+				u, err := url.QueryUnescape(style.Link.URL)
+				if err != nil {
+					u = style.Link.URL
+				}
+				s = link(s, u)
+			}
+			switch {
+			case style.InlineCode:
+				s = wrap(s, "`")
+			default:
+				if style.Bold {
+					s = wrap(s, "**")
+				}
+				if style.Italic {
+					s = wrap(s, "_")
+				}
+				if style.Strikethrough {
+					s = wrap(s, "~~")
+				}
+			}
+			sb.WriteString(s)
+		case e.MentionDoc != nil:
+			sb.WriteString("[" + e.MentionDoc.Title + "](" + e.MentionDoc.URL + ")")
+		case e.Equation != nil:
+			sb.WriteString("$" + strings.TrimSpace(e.Equation.Content) + "$")
+		}
+	}
+	return sb.String()
+}
+
+func plainText(elements []textElement) string {
+	var sb strings.Builder
+	for _, e := range elements {
+		switch {
+		case e.TextRun != nil:
+			sb.WriteString(e.TextRun.Content)
+		case e.MentionDoc != nil:
+			sb.WriteString(e.MentionDoc.Title)
+		case e.Equation != nil:
+			sb.WriteString(e.Equation.Content)
+		}
+	}
+	return sb.String()
+}
+
+// link links the text to the url, keeping the spaces outside the link.
+func link(s, href string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	lead := s[:strings.Index(s, trimmed)]
+	trail := s[len(lead)+len(trimmed):]
+	return lead + "[" + trimmed + "](" + href + ")" + trail
+}
+
+// wrap wraps the text with the markers, keeping the spaces outside the markers.
+func wrap(s, marker string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	lead := s[:strings.Index(s, trimmed)]
+	trail := s[len(lead)+len(trimmed):]
+	return lead + marker + trimmed + marker + trail
+}
+
+func indentLines(s, indent string) string {
+	if indent == "" || s == "" {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line == "" && !strings.Contains(indent, ">") {
+			continue
+		}
+		lines[i] = strings.TrimRight(indent+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
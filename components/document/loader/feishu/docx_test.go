@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feishu
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocxToMarkdown(t *testing.T) {
+	var blocks []*block
+	err := json.Unmarshal([]byte(`[
+		{"block_id":"doc","block_type":1,"page":{"elements":[{"text_run":{"content":"Design"}}]},"children":["h","p","b1","b2","o1","o2","todo","code","quote","callout","table","div"]},
+		{"block_id":"h","block_type":4,"heading2":{"elements":[{"text_run":{"content":"Overview"}}]}},
+		{"block_id":"p","block_type":2,"text":{"elements":[
+			{"text_run":{"content":"See "}},
+			{"text_run":{"content":"docs ","text_element_style":{"bold":true,"link":{"url":"https%3A%2F%2Fexample.com"}}}},
+			{"mention_doc":{"title":"Spec","url":"https://sample.feishu.cn/docx/spec"}},
+			{"equation":{"content":"E=mc^2 "}}
+		]}},
+		{"block_id":"b1","block_type":12,"bullet":{"elements":[{"text_run":{"content":"a"}}]},"children":["b1c"]},
+		{"block_id":"b1c","block_type":12,"bullet":{"elements":[{"text_run":{"content":"nested"}}]}},
+		{"block_id":"b2","block_type":12,"bullet":{"elements":[{"text_run":{"content":"b"}}]}},
+		{"block_id":"o1","block_type":13,"ordered":{"elements":[{"text_run":{"content":"one"}}]}},
+		{"block_id":"o2","block_type":13,"ordered":{"elements":[{"text_run":{"content":"two"}}]}},
+		{"block_id":"todo","block_type":17,"todo":{"elements":[{"text_run":{"content":"ship"}}],"style":{"done":true}}},
+		{"block_id":"code","block_type":14,"code":{"elements":[{"text_run":{"content":"fmt.Println(1)"}}],"style":{"language":22}}},
+		{"block_id":"quote","block_type":15,"quote":{"elements":[{"text_run":{"content":"quoted"}}]}},
+		{"block_id":"callout","block_type":19,"callout":{},"children":["ct"]},
+		{"block_id":"ct","block_type":2,"text":{"elements":[{"text_run":{"content":"careful"}}]}},
+		{"block_id":"table","block_type":31,"table":{"cells":["c1","c2","c3","c4"],"property":{"row_size":2,"column_size":2}}},
+		{"block_id":"c1","block_type":32,"table_cell":{},"children":["t1"]},
+		{"block_id":"c2","block_type":32,"table_cell":{},"children":["t2"]},
+		{"block_id":"c3","block_type":32,"table_cell":{},"children":["t3"]},
+		{"block_id":"c4","block_type":32,"table_cell":{},"children":[]},
+		{"block_id":"t1","block_type":2,"text":{"elements":[{"text_run":{"content":"k"}}]}},
+		{"block_id":"t2","block_type":2,"text":{"elements":[{"text_run":{"content":"v"}}]}},
+		{"block_id":"t3","block_type":2,"text":{"elements":[{"text_run":{"content":"x|y"}}]}},
+		{"block_id":"div","block_type":22,"divider":{}}
+	]`), &blocks)
+	assert.NoError(t, err)
+
+	title, md := docxToMarkdown(blocks)
+	assert.Equal(t, "Design", title)
+	assert.Equal(t, "## Overview\n\n"+
+		"See **[docs](https://example.com)** [Spec](https://sample.feishu.cn/docx/spec)$E=mc^2$\n\n"+
+		"- a\n  - nested\n- b\n"+
+		"1. one\n2. two\n"+
+		"- [x] ship\n\n"+
+		"```go\nfmt.Println(1)\n```\n\n"+
+		"> quoted\n\n"+
+		"> careful\n\n"+
+		"| k | v |\n| --- | --- |\n| x\\|y |  |\n\n"+
+		"---", md)
+}
+
+func TestFieldValue(t *testing.T) {
+	assert.Equal(t, "hello world", fieldValue([]any{
+		map[string]any{"type": "text", "text": "hello "},
+		map[string]any{"type": "text", "text": "world"},
+	}, 1))
+	assert.Equal(t, "a, b", fieldValue([]any{"a", "b"}, 4))
+	assert.Equal(t, "Alice", fieldValue([]any{map[string]any{"name": "Alice", "id": "ou_1"}}, 11))
+	assert.Equal(t, "2.5", fieldValue(2.5, 2))
+	assert.Equal(t, "2025-01-01T00:00:00Z", fieldValue(float64(1735689600000), fieldDateTime))
+	assert.Equal(t, "true", fieldValue(true, 7))
+	assert.Equal(t, "", fieldValue(nil, 1))
+}
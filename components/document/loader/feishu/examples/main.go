@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/document"
+
+	"github.com/cloudwego/eino-ext/components/document/loader/feishu"
+)
+
+func main() {
+	ctx := context.Background()
+
+	loader, err := feishu.NewLoader(ctx, &feishu.LoaderConfig{
+		AppID:     os.Getenv("FEISHU_APP_ID"),
+		AppSecret: os.Getenv("FEISHU_APP_SECRET"),
+		Recursive: true,
+	})
+	if err != nil {
+		log.Fatalf("feishu.NewLoader failed, err=%v", err)
+	}
+
+	// e.g. feishu://wiki/<space_id>, or the url of a docx, a folder, a wiki node or a bitable
+	docs, err := loader.Load(ctx, document.Source{URI: os.Getenv("FEISHU_SOURCE")})
+	if err != nil {
+		log.Fatalf("loader.Load failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id: %s, type: %v, path: %v\n%s", doc.ID, doc.MetaData[feishu.MetaKeyType], doc.MetaData[feishu.MetaKeyPath], doc.Content)
+	}
+}
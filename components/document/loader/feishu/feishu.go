@@ -0,0 +1,350 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feishu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	// BaseURLFeishu and BaseURLLark are the base urls of the open API of feishu and lark.
+	BaseURLFeishu = "https://open.feishu.cn"
+	BaseURLLark   = "https://open.larksuite.com"
+)
+
+const (
+	// MetaKeySource is the metadata key of the uri of the source.
+	MetaKeySource = "_source"
+	// MetaKeyTitle is the metadata key of the title of the document, or the name of the table of bitable records.
+	MetaKeyTitle = "title"
+	// MetaKeyType is the metadata key of the type of the file, "docx" or "bitable".
+	MetaKeyType = "type"
+	// MetaKeyToken is the metadata key of the token of the docx or the bitable app.
+	MetaKeyToken = "token"
+	// MetaKeyURL is the metadata key of the url of the file, set if listed in a folder.
+	MetaKeyURL = "url"
+	// MetaKeyUpdated is the metadata key of the unix seconds of the last modification, set if listed in a folder or a wiki space.
+	MetaKeyUpdated = "updated"
+	// MetaKeyPath is the metadata key of the names of the folders or the titles of the wiki nodes, from the loaded source to the document.
+	MetaKeyPath = "path"
+	// MetaKeyTableID is the metadata key of the id of the table of bitable records.
+	MetaKeyTableID = "table_id"
+	// MetaKeyFields is the metadata key of the raw fields of bitable records.
+	MetaKeyFields = "fields"
+)
+
+var _ document.Loader = (*Loader)(nil)
+
+// LoaderConfig is the config for feishu Loader.
+type LoaderConfig struct {
+	// AppID and AppSecret are the credentials of the custom app, which authenticate with the tenant access token.
+	// The documents must be shared with the app, and the app requires the read permissions of docx, drive, wiki and bitable.
+	// Required.
+	AppID     string
+	AppSecret string
+	// BaseURL is the url of the open API, BaseURLFeishu or BaseURLLark.
+	// Optional. Default: BaseURLFeishu.
+	BaseURL string
+	// Recursive loads the subfolders of folders, and the child nodes of wiki nodes.
+	// Optional. Default: false.
+	Recursive bool
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default: &http.Client{Timeout: 30 * time.Second}.
+	HTTPClient *http.Client
+}
+
+// NewLoader creates a new feishu loader.
+func NewLoader(ctx context.Context, conf *LoaderConfig) (*Loader, error) {
+	if conf == nil || conf.AppID == "" || conf.AppSecret == "" {
+		return nil, errors.New("app id and app secret are required")
+	}
+
+	cli := &apiClient{
+		baseURL:   strings.TrimSuffix(conf.BaseURL, "/"),
+		appID:     conf.AppID,
+		appSecret: conf.AppSecret,
+		cli:       conf.HTTPClient,
+	}
+	if cli.baseURL == "" {
+		cli.baseURL = BaseURLFeishu
+	}
+	if cli.cli == nil {
+		cli.cli = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &Loader{cli: cli, recursive: conf.Recursive}, nil
+}
+
+// Loader loads feishu docx into markdown documents, and bitable records into documents of fields.
+// The source uri is one of:
+//   - feishu://docx/<document_id>
+//   - feishu://folder/<folder_token>, the docx and bitable files of the folder
+//   - feishu://wiki/<space_id>, the docx and bitable nodes of the wiki space
+//   - feishu://wiki_node/<node_token>, the wiki node
+//   - feishu://bitable/<app_token> or feishu://bitable/<app_token>/<table_id>, the records of the tables
+//   - the url of a docx, a folder, a wiki node or a bitable, e.g. https://sample.feishu.cn/docx/<document_id>
+type Loader struct {
+	cli       *apiClient
+	recursive bool
+}
+
+type source struct {
+	kind  string
+	token string
+	// table is the table id of bitable sources.
+	table string
+}
+
+type loadState struct {
+	source string
+	docs   []*schema.Document
+}
+
+// fileInfo is the information of the file listed in a folder or a wiki space.
+type fileInfo struct {
+	url     string
+	updated string
+}
+
+type driveFile struct {
+	Token        string `json:"token"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	URL          string `json:"url"`
+	ModifiedTime string `json:"modified_time"`
+}
+
+type wikiNode struct {
+	SpaceID     string `json:"space_id"`
+	NodeToken   string `json:"node_token"`
+	ObjToken    string `json:"obj_token"`
+	ObjType     string `json:"obj_type"`
+	Title       string `json:"title"`
+	HasChild    bool   `json:"has_child"`
+	ObjEditTime string `json:"obj_edit_time"`
+}
+
+func (l *Loader) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, l.GetType(), components.ComponentOfLoader)
+	ctx = callbacks.OnStart(ctx, &document.LoaderCallbackInput{
+		Source: src,
+	})
+	defer func() {
+		if err != nil {
+			_ = callbacks.OnError(ctx, err)
+		}
+	}()
+
+	s, err := parseSource(src.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &loadState{source: src.URI}
+	switch s.kind {
+	case "docx":
+		err = l.loadDocx(ctx, st, s.token, nil, fileInfo{})
+	case "folder":
+		err = l.loadFolder(ctx, st, s.token, nil)
+	case "wiki":
+		err = l.loadWikiNodes(ctx, st, s.token, "", nil)
+	case "wiki_node":
+		node := &struct {
+			Node *wikiNode `json:"node"`
+		}{}
+		if err = l.cli.get(ctx, "/open-apis/wiki/v2/spaces/get_node?token="+url.QueryEscape(s.token), node); err == nil {
+			err = l.loadWikiNode(ctx, st, node.Node, nil)
+		}
+	case "bitable":
+		err = l.loadBitable(ctx, st, s.token, s.table, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feishu [%s]: %w", src.URI, err)
+	}
+	docs = st.docs
+
+	_ = callbacks.OnEnd(ctx, &document.LoaderCallbackOutput{
+		Source: src,
+		Docs:   docs,
+	})
+
+	return docs, nil
+}
+
+func (l *Loader) loadDocx(ctx context.Context, st *loadState, id string, path []string, info fileInfo) error {
+	blocks, err := listAll[*block](ctx, l.cli, "/open-apis/docx/v1/documents/"+id+"/blocks?page_size=500&document_revision_id=-1")
+	if err != nil {
+		return fmt.Errorf("get blocks of docx %s failed: %w", id, err)
+	}
+
+	title, md := docxToMarkdown(blocks)
+	content := "# " + title
+	if md != "" {
+		content += "\n\n" + md
+	}
+
+	meta := map[string]any{
+		MetaKeySource: st.source,
+		MetaKeyTitle:  title,
+		MetaKeyType:   "docx",
+		MetaKeyToken:  id,
+		MetaKeyPath:   appendPath(path, title),
+	}
+	if info.url != "" {
+		meta[MetaKeyURL] = info.url
+	}
+	if info.updated != "" {
+		meta[MetaKeyUpdated] = info.updated
+	}
+	st.docs = append(st.docs, &schema.Document{ID: id, Content: content, MetaData: meta})
+	return nil
+}
+
+func (l *Loader) loadFolder(ctx context.Context, st *loadState, token string, path []string) error {
+	var files []*driveFile
+	for pageToken := ""; ; {
+		p := "/open-apis/drive/v1/files?page_size=200&folder_token=" + url.QueryEscape(token)
+		if pageToken != "" {
+			p += "&page_token=" + url.QueryEscape(pageToken)
+		}
+
+		resp := &struct {
+			Files         []*driveFile `json:"files"`
+			HasMore       bool         `json:"has_more"`
+			NextPageToken string       `json:"next_page_token"`
+		}{}
+		if err := l.cli.get(ctx, p, resp); err != nil {
+			return fmt.Errorf("list folder %s failed: %w", token, err)
+		}
+		files = append(files, resp.Files...)
+		if !resp.HasMore || resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	for _, f := range files {
+		var err error
+		switch f.Type {
+		case "docx":
+			err = l.loadDocx(ctx, st, f.Token, path, fileInfo{url: f.URL, updated: f.ModifiedTime})
+		case "bitable":
+			err = l.loadBitable(ctx, st, f.Token, "", path)
+		case "folder":
+			if l.recursive {
+				err = l.loadFolder(ctx, st, f.Token, appendPath(path, f.Name))
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadWikiNodes loads the child nodes of the parent node, or the root nodes of the space if parent is empty.
+func (l *Loader) loadWikiNodes(ctx context.Context, st *loadState, spaceID, parent string, path []string) error {
+	p := "/open-apis/wiki/v2/spaces/" + spaceID + "/nodes?page_size=50"
+	if parent != "" {
+		p += "&parent_node_token=" + url.QueryEscape(parent)
+	}
+	nodes, err := listAll[*wikiNode](ctx, l.cli, p)
+	if err != nil {
+		return fmt.Errorf("list nodes of wiki space %s failed: %w", spaceID, err)
+	}
+
+	for _, node := range nodes {
+		if err = l.loadWikiNode(ctx, st, node, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Loader) loadWikiNode(ctx context.Context, st *loadState, node *wikiNode, path []string) error {
+	var err error
+	switch node.ObjType {
+	case "docx":
+		err = l.loadDocx(ctx, st, node.ObjToken, path, fileInfo{updated: node.ObjEditTime})
+	case "bitable":
+		err = l.loadBitable(ctx, st, node.ObjToken, "", path)
+	}
+	if err != nil {
+		return err
+	}
+
+	if node.HasChild && l.recursive {
+		return l.loadWikiNodes(ctx, st, node.SpaceID, node.NodeToken, appendPath(path, node.Title))
+	}
+	return nil
+}
+
+func (l *Loader) GetType() string {
+	return "FeishuLoader"
+}
+
+func (l *Loader) IsCallbacksEnabled() bool {
+	return true
+}
+
+// urlKinds are the kinds of the sources by the path of the urls.
+var urlKinds = map[string]string{
+	"docx":         "docx",
+	"wiki":         "wiki_node",
+	"drive/folder": "folder",
+	"base":         "bitable",
+}
+
+func parseSource(uri string) (*source, error) {
+	if strings.HasPrefix(uri, "feishu://") {
+		parts := strings.Split(strings.TrimPrefix(uri, "feishu://"), "/")
+		if len(parts) >= 2 && parts[1] != "" {
+			s := &source{kind: parts[0], token: parts[1]}
+			if len(parts) > 2 {
+				s.table = parts[2]
+			}
+			switch s.kind {
+			case "docx", "folder", "wiki", "wiki_node", "bitable":
+				return s, nil
+			}
+		}
+	} else if u, err := url.Parse(uri); err == nil && (u.Scheme == "https" || u.Scheme == "http") {
+		p := strings.Trim(u.Path, "/")
+		if i := strings.LastIndex(p, "/"); i > 0 {
+			if kind, ok := urlKinds[p[:i]]; ok {
+				return &source{kind: kind, token: p[i+1:], table: u.Query().Get("table")}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid feishu source [%s]", uri)
+}
+
+func appendPath(path []string, title string) []string {
+	ret := make([]string, 0, len(path)+1)
+	return append(append(ret, path...), title)
+}
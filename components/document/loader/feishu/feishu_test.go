@@ -0,0 +1,181 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package feishu
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func docxBlocks(title, text string) string {
+	return `{"code":0,"data":{"items":[
+		{"block_id":"root","block_type":1,"page":{"elements":[{"text_run":{"content":"` + title + `"}}]},"children":["t"]},
+		{"block_id":"t","block_type":2,"text":{"elements":[{"text_run":{"content":"` + text + `"}}]}}
+	],"has_more":false}}`
+}
+
+func newServer(t *testing.T) (*httptest.Server, *int) {
+	tokens := 0
+	limited := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/open-apis/auth/v3/tenant_access_token/internal", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "app", body["app_id"])
+		tokens++
+		_, _ = w.Write([]byte(`{"code":0,"tenant_access_token":"t-1","expire":7200}`))
+	})
+	mux.HandleFunc("/open-apis/docx/v1/documents/doc1/blocks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer t-1", r.Header.Get("Authorization"))
+		if !limited {
+			limited = true
+			w.Header().Set("x-ogw-ratelimit-reset", "0")
+			_, _ = w.Write([]byte(`{"code":99991400,"msg":"request trigger frequency limit"}`))
+			return
+		}
+		_, _ = w.Write([]byte(docxBlocks("Doc 1", "hello")))
+	})
+	mux.HandleFunc("/open-apis/docx/v1/documents/doc2/blocks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(docxBlocks("Doc 2", "nested")))
+	})
+	mux.HandleFunc("/open-apis/drive/v1/files", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("folder_token") {
+		case "fld":
+			if r.URL.Query().Get("page_token") == "" {
+				_, _ = w.Write([]byte(`{"code":0,"data":{"files":[
+					{"token":"doc1","name":"Doc 1","type":"docx","url":"https://sample.feishu.cn/docx/doc1","modified_time":"1735689600"},
+					{"token":"sheet1","name":"Sheet","type":"sheet"}
+				],"has_more":true,"next_page_token":"p2"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"code":0,"data":{"files":[
+				{"token":"sub","name":"Sub","type":"folder"},
+				{"token":"app1","name":"Base","type":"bitable"}
+			],"has_more":false}}`))
+		case "sub":
+			_, _ = w.Write([]byte(`{"code":0,"data":{"files":[{"token":"doc2","name":"Doc 2","type":"docx"}],"has_more":false}}`))
+		}
+	})
+	mux.HandleFunc("/open-apis/bitable/v1/apps/app1/tables", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":0,"data":{"items":[{"table_id":"tbl1","name":"Tasks"}],"has_more":false}}`))
+	})
+	mux.HandleFunc("/open-apis/bitable/v1/apps/app1/tables/tbl1/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":0,"data":{"items":[{"field_name":"Name","type":1},{"field_name":"Owner","type":11},{"field_name":"Done","type":7}],"has_more":false}}`))
+	})
+	mux.HandleFunc("/open-apis/bitable/v1/apps/app1/tables/tbl1/records", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":0,"data":{"items":[{"record_id":"rec1","fields":{"Name":"Fix bug","Owner":[{"name":"Alice"}],"Done":true}}],"has_more":false}}`))
+	})
+	mux.HandleFunc("/open-apis/wiki/v2/spaces/sp1/nodes", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("parent_node_token") == "" {
+			_, _ = w.Write([]byte(`{"code":0,"data":{"items":[{"space_id":"sp1","node_token":"n1","obj_token":"doc1","obj_type":"docx","title":"Home","has_child":true,"obj_edit_time":"1735689600"}],"has_more":false}}`))
+			return
+		}
+		assert.Equal(t, "n1", r.URL.Query().Get("parent_node_token"))
+		_, _ = w.Write([]byte(`{"code":0,"data":{"items":[{"space_id":"sp1","node_token":"n2","obj_token":"doc2","obj_type":"docx","title":"Child"}],"has_more":false}}`))
+	})
+	mux.HandleFunc("/open-apis/docx/v1/documents/missing/blocks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":1770002,"msg":"not found"}`))
+	})
+	return httptest.NewServer(mux), &tokens
+}
+
+func TestLoader_Load(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewLoader(ctx, &LoaderConfig{AppID: "app"})
+	assert.EqualError(t, err, "app id and app secret are required")
+
+	t.Run("folder", func(t *testing.T) {
+		srv, tokens := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{AppID: "app", AppSecret: "secret", BaseURL: srv.URL, Recursive: true})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "feishu://folder/fld"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, *tokens)
+		assert.Equal(t, 3, len(docs))
+
+		assert.Equal(t, "doc1", docs[0].ID)
+		assert.Equal(t, "# Doc 1\n\nhello", docs[0].Content)
+		assert.Equal(t, "https://sample.feishu.cn/docx/doc1", docs[0].MetaData[MetaKeyURL])
+		assert.Equal(t, "1735689600", docs[0].MetaData[MetaKeyUpdated])
+
+		assert.Equal(t, "doc2", docs[1].ID)
+		assert.Equal(t, []string{"Sub", "Doc 2"}, docs[1].MetaData[MetaKeyPath])
+
+		assert.Equal(t, "rec1", docs[2].ID)
+		assert.Equal(t, "Name: Fix bug\nOwner: Alice\nDone: true", docs[2].Content)
+		assert.Equal(t, "tbl1", docs[2].MetaData[MetaKeyTableID])
+		assert.Equal(t, []string{"Tasks"}, docs[2].MetaData[MetaKeyPath])
+	})
+
+	t.Run("wiki", func(t *testing.T) {
+		srv, _ := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{AppID: "app", AppSecret: "secret", BaseURL: srv.URL})
+		assert.NoError(t, err)
+		docs, err := l.Load(ctx, document.Source{URI: "feishu://wiki/sp1"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(docs))
+
+		l, err = NewLoader(ctx, &LoaderConfig{AppID: "app", AppSecret: "secret", BaseURL: srv.URL, Recursive: true})
+		assert.NoError(t, err)
+		docs, err = l.Load(ctx, document.Source{URI: "feishu://wiki/sp1"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, []string{"Home", "Doc 2"}, docs[1].MetaData[MetaKeyPath])
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		srv, _ := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{AppID: "app", AppSecret: "secret", BaseURL: srv.URL})
+		assert.NoError(t, err)
+		_, err = l.Load(ctx, document.Source{URI: "feishu://docx/missing"})
+		assert.ErrorContains(t, err, "feishu api failed, code: 1770002, msg: not found")
+	})
+}
+
+func TestParseSource(t *testing.T) {
+	cases := map[string]*source{
+		"feishu://docx/doc1":                             {kind: "docx", token: "doc1"},
+		"feishu://bitable/app1/tbl1":                     {kind: "bitable", token: "app1", table: "tbl1"},
+		"https://sample.feishu.cn/docx/doc1":             {kind: "docx", token: "doc1"},
+		"https://sample.feishu.cn/wiki/node1":            {kind: "wiki_node", token: "node1"},
+		"https://sample.feishu.cn/drive/folder/fld1":     {kind: "folder", token: "fld1"},
+		"https://sample.feishu.cn/base/app1?table=tbl1":  {kind: "bitable", token: "app1", table: "tbl1"},
+		"https://sample.larksuite.com/docx/doc1?from=im": {kind: "docx", token: "doc1"},
+	}
+	for uri, expected := range cases {
+		s, err := parseSource(uri)
+		assert.NoError(t, err, uri)
+		assert.Equal(t, expected, s, uri)
+	}
+
+	_, err := parseSource("feishu://sheet/s1")
+	assert.EqualError(t, err, "invalid feishu source [feishu://sheet/s1]")
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	pageSize   = 100
+	maxRetries = 3
+)
+
+type richText struct {
+	PlainText   string  `json:"plain_text"`
+	Href        *string `json:"href"`
+	Annotations struct {
+		Bold          bool `json:"bold"`
+		Italic        bool `json:"italic"`
+		Strikethrough bool `json:"strikethrough"`
+		Code          bool `json:"code"`
+	} `json:"annotations"`
+}
+
+type parent struct {
+	Type       string `json:"type"`
+	PageID     string `json:"page_id"`
+	DatabaseID string `json:"database_id"`
+	BlockID    string `json:"block_id"`
+}
+
+type property struct {
+	Type        string     `json:"type"`
+	Title       []richText `json:"title"`
+	RichText    []richText `json:"rich_text"`
+	Number      *float64   `json:"number"`
+	Select      *option    `json:"select"`
+	Status      *option    `json:"status"`
+	MultiSelect []option   `json:"multi_select"`
+	Date        *struct {
+		Start string  `json:"start"`
+		End   *string `json:"end"`
+	} `json:"date"`
+	Checkbox    bool    `json:"checkbox"`
+	URL         *string `json:"url"`
+	Email       *string `json:"email"`
+	PhoneNumber *string `json:"phone_number"`
+	People      []struct {
+		Name string `json:"name"`
+	} `json:"people"`
+}
+
+type option struct {
+	Name string `json:"name"`
+}
+
+type page struct {
+	ID             string               `json:"id"`
+	URL            string               `json:"url"`
+	CreatedTime    string               `json:"created_time"`
+	LastEditedTime string               `json:"last_edited_time"`
+	Archived       bool                 `json:"archived"`
+	Parent         parent               `json:"parent"`
+	Properties     map[string]*property `json:"properties"`
+}
+
+type database struct {
+	ID             string     `json:"id"`
+	URL            string     `json:"url"`
+	Title          []richText `json:"title"`
+	LastEditedTime string     `json:"last_edited_time"`
+}
+
+// blockContent is the union of the contents of the block types.
+type blockContent struct {
+	RichText []richText `json:"rich_text"`
+	Caption  []richText `json:"caption"`
+	Checked  bool       `json:"checked"`
+	Language string     `json:"language"`
+	// Title is the title of child_page and child_database.
+	Title      string `json:"title"`
+	Expression string `json:"expression"`
+	// URL is the url of bookmark, embed and link_preview.
+	URL string `json:"url"`
+	// Type is "external" or "file" for image, video, audio, file and pdf.
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	External struct {
+		URL string `json:"url"`
+	} `json:"external"`
+	File struct {
+		URL string `json:"url"`
+	} `json:"file"`
+	// Cells are the cells of table_row.
+	Cells [][]richText `json:"cells"`
+}
+
+type block struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	HasChildren bool   `json:"has_children"`
+
+	content  blockContent
+	children []*block
+}
+
+func (b *block) UnmarshalJSON(data []byte) error {
+	type plain block
+	if err := json.Unmarshal(data, (*plain)(b)); err != nil {
+		return err
+	}
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if c, ok := raw[b.Type]; ok {
+		return json.Unmarshal(c, &b.content)
+	}
+	return nil
+}
+
+type list[T any] struct {
+	Results    []T     `json:"results"`
+	HasMore    bool    `json:"has_more"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+type apiClient struct {
+	baseURL string
+	token   string
+	version string
+	cli     *http.Client
+}
+
+func (c *apiClient) getPage(ctx context.Context, id string) (*page, error) {
+	p := &page{}
+	return p, c.do(ctx, http.MethodGet, "/pages/"+id, nil, p)
+}
+
+func (c *apiClient) getDatabase(ctx context.Context, id string) (*database, error) {
+	d := &database{}
+	return d, c.do(ctx, http.MethodGet, "/databases/"+id, nil, d)
+}
+
+// queryDatabase lists the pages of the database, edited after the time if not zero.
+func (c *apiClient) queryDatabase(ctx context.Context, id string, editedAfter time.Time) ([]*page, error) {
+	var pages []*page
+	var cursor *string
+	for {
+		body := map[string]any{"page_size": pageSize}
+		if cursor != nil {
+			body["start_cursor"] = *cursor
+		}
+		if !editedAfter.IsZero() {
+			body["filter"] = map[string]any{
+				"timestamp":        "last_edited_time",
+				"last_edited_time": map[string]any{"after": editedAfter.Format(time.RFC3339)},
+			}
+		}
+
+		l := &list[*page]{}
+		if err := c.do(ctx, http.MethodPost, "/databases/"+id+"/query", body, l); err != nil {
+			return nil, err
+		}
+		pages = append(pages, l.Results...)
+		if !l.HasMore || l.NextCursor == nil {
+			return pages, nil
+		}
+		cursor = l.NextCursor
+	}
+}
+
+// getChildren lists the child blocks of the block or page.
+func (c *apiClient) getChildren(ctx context.Context, id string) ([]*block, error) {
+	var blocks []*block
+	path := fmt.Sprintf("/blocks/%s/children?page_size=%d", id, pageSize)
+	for cursor := ""; ; {
+		p := path
+		if cursor != "" {
+			p += "&start_cursor=" + cursor
+		}
+
+		l := &list[*block]{}
+		if err := c.do(ctx, http.MethodGet, p, nil, l); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, l.Results...)
+		if !l.HasMore || l.NextCursor == nil {
+			return blocks, nil
+		}
+		cursor = *l.NextCursor
+	}
+}
+
+// do sends the request, and retries after the Retry-After seconds when rate limited.
+func (c *apiClient) do(ctx context.Context, method, path string, body, out any) error {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	for retry := 0; ; retry++ {
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		req.Header.Set("Notion-Version", c.version)
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := c.cli.Do(req)
+		if err != nil {
+			return err
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && retry < maxRetries {
+			wait := time.Second
+			if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+				wait = time.Duration(s) * time.Second
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, respBody)
+		}
+		return json.Unmarshal(respBody, out)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+
+	"github.com/cloudwego/eino-ext/components/document/loader/notion"
+)
+
+func main() {
+	ctx := context.Background()
+
+	loader, err := notion.NewLoader(ctx, &notion.LoaderConfig{
+		Token:     os.Getenv("NOTION_TOKEN"),
+		Recursive: true,
+	})
+	if err != nil {
+		log.Fatalf("notion.NewLoader failed, err=%v", err)
+	}
+
+	// load the pages edited in the last day, e.g. in a daily sync job
+	docs, err := loader.Load(ctx, document.Source{URI: "notion://page/" + os.Getenv("NOTION_PAGE_ID")},
+		notion.WithEditedAfter(time.Now().Add(-24*time.Hour)))
+	if err != nil {
+		log.Fatalf("loader.Load failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id: %s, path: %v\n%s", doc.ID, doc.MetaData[notion.MetaKeyPath], doc.Content)
+	}
+}
@@ -0,0 +1,245 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notion
+
+import (
+	"fmt"
+	"strings"
+)
+
+// containerTypes are the blocks whose children are rendered in place of the block.
+var containerTypes = map[string]bool{
+	"column_list":  true,
+	"column":       true,
+	"synced_block": true,
+	"template":     true,
+}
+
+var listTypes = map[string]bool{
+	"bulleted_list_item": true,
+	"numbered_list_item": true,
+	"to_do":              true,
+	"toggle":             true,
+}
+
+// toMarkdown renders the block tree in markdown.
+func toMarkdown(blocks []*block) string {
+	return renderBlocks(blocks, "")
+}
+
+func renderBlocks(blocks []*block, indent string) string {
+	var (
+		sb       strings.Builder
+		number   int
+		prevList bool
+	)
+	for _, b := range blocks {
+		if b.Type != "numbered_list_item" {
+			number = 0
+		}
+		s := renderBlock(b, indent, &number)
+		if s == "" {
+			continue
+		}
+
+		isList := listTypes[b.Type]
+		if sb.Len() > 0 {
+			if isList && prevList {
+				sb.WriteString("\n")
+			} else {
+				sb.WriteString("\n\n")
+			}
+		}
+		sb.WriteString(s)
+		prevList = isList
+	}
+	return sb.String()
+}
+
+func renderBlock(b *block, indent string, number *int) string {
+	c := &b.content
+	text := renderRichText(c.RichText)
+
+	if containerTypes[b.Type] {
+		return renderBlocks(b.children, indent)
+	}
+
+	switch b.Type {
+	case "bulleted_list_item", "toggle":
+		return renderListItem(b, indent, "- ", text)
+	case "numbered_list_item":
+		*number++
+		return renderListItem(b, indent, fmt.Sprintf("%d. ", *number), text)
+	case "to_do":
+		marker := "- [ ] "
+		if c.Checked {
+			marker = "- [x] "
+		}
+		return renderListItem(b, indent, marker, text)
+	case "quote", "callout":
+		if children := renderBlocks(b.children, ""); children != "" {
+			text += "\n\n" + children
+		}
+		return indentLines(text, indent+"> ")
+	case "table":
+		return indentLines(renderTable(b.children), indent)
+	}
+
+	var s string
+	switch b.Type {
+	case "paragraph":
+		s = text
+	case "heading_1", "heading_2", "heading_3":
+		s = strings.Repeat("#", int(b.Type[len(b.Type)-1]-'0')) + " " + text
+	case "code":
+		s = "```" + c.Language + "\n" + plainText(c.RichText) + "\n```"
+	case "equation":
+		s = "$$\n" + c.Expression + "\n$$"
+	case "divider":
+		s = "---"
+	case "image":
+		s = fmt.Sprintf("![%s](%s)", plainText(c.Caption), c.fileURL())
+	case "video", "audio", "file", "pdf":
+		s = link(firstNonEmpty(plainText(c.Caption), c.Name, c.fileURL()), c.fileURL())
+	case "bookmark", "embed", "link_preview":
+		s = link(firstNonEmpty(plainText(c.Caption), c.URL), c.URL)
+	case "child_page", "child_database":
+		s = link(c.Title, pageURL(b.ID))
+	default:
+		s = text
+	}
+	s = indentLines(s, indent)
+
+	// e.g. the children of toggleable headings and paragraphs
+	if children := renderBlocks(b.children, indent); children != "" {
+		if s == "" {
+			return children
+		}
+		s += "\n\n" + children
+	}
+	return s
+}
+
+func renderListItem(b *block, indent, marker, text string) string {
+	s := indent + marker + text
+	if children := renderBlocks(b.children, indent+strings.Repeat(" ", len(marker))); children != "" {
+		s += "\n" + children
+	}
+	return s
+}
+
+func renderTable(rows []*block) string {
+	lines := make([]string, 0, len(rows)+1)
+	for i, row := range rows {
+		cells := make([]string, 0, len(row.content.Cells))
+		for _, cell := range row.content.Cells {
+			cells = append(cells, strings.ReplaceAll(renderRichText(cell), "|", "\\|"))
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", len(cells)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderRichText renders the annotations and links of the rich text in markdown.
+func renderRichText(texts []richText) string {
+	var sb strings.Builder
+	for _, t := range texts {
+		s := t.PlainText
+		trimmed := strings.TrimSpace(s)
+		if trimmed == "" {
+			sb.WriteString(s)
+			continue
+		}
+		// the markers must be next to the text, so keep the spaces outside
+		lead := s[:strings.Index(s, trimmed)]
+		trail := s[len(lead)+len(trimmed):]
+
+		a := t.Annotations
+		switch {
+		case a.Code:
+			trimmed = "`" + trimmed + "`"
+		default:
+			if a.Bold {
+				trimmed = "**" + trimmed + "**"
+			}
+			if a.Italic {
+				trimmed = "_" + trimmed + "_"
+			}
+			if a.Strikethrough {
+				trimmed = "~~" + trimmed + "~~"
+			}
+		}
+		if t.Href != nil && *t.Href != "" {
+			trimmed = link(trimmed, *t.Href)
+		}
+		sb.WriteString(lead + trimmed + trail)
+	}
+	return sb.String()
+}
+
+func plainText(texts []richText) string {
+	var sb strings.Builder
+	for _, t := range texts {
+		sb.WriteString(t.PlainText)
+	}
+	return sb.String()
+}
+
+func (c *blockContent) fileURL() string {
+	if c.Type == "external" {
+		return c.External.URL
+	}
+	return c.File.URL
+}
+
+func link(text, url string) string {
+	if url == "" {
+		return text
+	}
+	return fmt.Sprintf("[%s](%s)", text, url)
+}
+
+func indentLines(s, indent string) string {
+	if indent == "" || s == "" {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line == "" && !strings.Contains(indent, ">") {
+			continue
+		}
+		lines[i] = strings.TrimRight(indent+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func firstNonEmpty(s ...string) string {
+	for _, v := range s {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// pageURL returns the url of the page or database by its id.
+func pageURL(id string) string {
+	return "https://www.notion.so/" + strings.ReplaceAll(id, "-", "")
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMarkdown(t *testing.T) {
+	var blocks []*block
+	err := json.Unmarshal([]byte(`[
+		{"id":"1","type":"heading_1","heading_1":{"rich_text":[{"plain_text":"Title"}]}},
+		{"id":"2","type":"paragraph","paragraph":{"rich_text":[
+			{"plain_text":"Hello "},
+			{"plain_text":"bold ","annotations":{"bold":true}},
+			{"plain_text":"code","annotations":{"code":true}},
+			{"plain_text":" and "},
+			{"plain_text":"link","href":"https://example.com"}
+		]}},
+		{"id":"3","type":"bulleted_list_item","bulleted_list_item":{"rich_text":[{"plain_text":"a"}]}},
+		{"id":"4","type":"bulleted_list_item","bulleted_list_item":{"rich_text":[{"plain_text":"b"}]}},
+		{"id":"5","type":"numbered_list_item","numbered_list_item":{"rich_text":[{"plain_text":"one"}]}},
+		{"id":"6","type":"numbered_list_item","numbered_list_item":{"rich_text":[{"plain_text":"two"}]}},
+		{"id":"7","type":"to_do","to_do":{"rich_text":[{"plain_text":"done"}],"checked":true}},
+		{"id":"8","type":"code","code":{"rich_text":[{"plain_text":"fmt.Println(1)"}],"language":"go"}},
+		{"id":"9","type":"quote","quote":{"rich_text":[{"plain_text":"wise\nwords"}]}},
+		{"id":"10","type":"divider","divider":{}},
+		{"id":"11","type":"image","image":{"type":"external","external":{"url":"https://example.com/a.png"},"caption":[{"plain_text":"cap"}]}},
+		{"id":"12","type":"child_page","child_page":{"title":"Child"}},
+		{"id":"13","type":"unsupported","unsupported":{}}
+	]`), &blocks)
+	assert.NoError(t, err)
+
+	// children are fetched by the loader
+	blocks[2].children = []*block{
+		{Type: "paragraph", content: blockContent{RichText: []richText{{PlainText: "nested"}}}},
+		{Type: "numbered_list_item", content: blockContent{RichText: []richText{{PlainText: "deep"}}}},
+	}
+
+	assert.Equal(t, "# Title\n\n"+
+		"Hello **bold** `code` and [link](https://example.com)\n\n"+
+		"- a\n  nested\n\n  1. deep\n- b\n"+
+		"1. one\n2. two\n"+
+		"- [x] done\n\n"+
+		"```go\nfmt.Println(1)\n```\n\n"+
+		"> wise\n> words\n\n"+
+		"---\n\n"+
+		"![cap](https://example.com/a.png)\n\n"+
+		"[Child](https://www.notion.so/12)", toMarkdown(blocks))
+}
+
+func TestRenderTable(t *testing.T) {
+	table := &block{Type: "table", children: []*block{
+		{Type: "table_row", content: blockContent{Cells: [][]richText{{{PlainText: "name"}}, {{PlainText: "a|b"}}}}},
+		{Type: "table_row", content: blockContent{Cells: [][]richText{{{PlainText: "x"}}, {{PlainText: "1"}}}}},
+	}}
+	assert.Equal(t, "| name | a\\|b |\n| --- | --- |\n| x | 1 |", toMarkdown([]*block{table}))
+}
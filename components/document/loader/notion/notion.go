@@ -0,0 +1,385 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultBaseURL       = "https://api.notion.com/v1"
+	defaultNotionVersion = "2022-06-28"
+)
+
+const (
+	// MetaKeySource is the metadata key of the uri of the source.
+	MetaKeySource = "_source"
+	// MetaKeyTitle is the metadata key of the title of the page.
+	MetaKeyTitle = "title"
+	// MetaKeyURL is the metadata key of the url of the page.
+	MetaKeyURL = "url"
+	// MetaKeyParentID is the metadata key of the id of the parent page, database or block.
+	MetaKeyParentID = "parent_id"
+	// MetaKeyPath is the metadata key of the titles of the ancestors and the page, from the loaded source to the page.
+	MetaKeyPath = "path"
+	// MetaKeyCreatedTime and MetaKeyLastEditedTime are the metadata keys of the ISO 8601 times of the page.
+	MetaKeyCreatedTime    = "created_time"
+	MetaKeyLastEditedTime = "last_edited_time"
+	// MetaKeyProperties is the metadata key of the properties of database pages except the title,
+	// e.g. a select property is its name, and a multi_select property is the []string of names.
+	MetaKeyProperties = "properties"
+)
+
+// idPattern matches the id of notion urls, e.g. https://www.notion.so/workspace/Title-0123456789abcdef0123456789abcdef.
+var idPattern = regexp.MustCompile(`[0-9a-f]{32}$|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+var _ document.Loader = (*Loader)(nil)
+
+// LoaderConfig is the config for notion Loader.
+type LoaderConfig struct {
+	// Token is the secret of the notion integration, the pages and databases must be shared with the integration.
+	// Required.
+	Token string
+	// Recursive loads the child pages and child databases of the pages.
+	// Optional. Default: false.
+	Recursive bool
+	// BaseURL is the url of the notion API.
+	// Optional. Default: "https://api.notion.com/v1".
+	BaseURL string
+	// NotionVersion is the version of the notion API.
+	// Optional. Default: "2022-06-28".
+	NotionVersion string
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default: &http.Client{Timeout: 30 * time.Second}.
+	HTTPClient *http.Client
+}
+
+// NewLoader creates a new notion loader.
+func NewLoader(ctx context.Context, conf *LoaderConfig) (*Loader, error) {
+	if conf == nil || conf.Token == "" {
+		return nil, errors.New("notion token is required")
+	}
+
+	cli := &apiClient{
+		baseURL: strings.TrimSuffix(conf.BaseURL, "/"),
+		token:   conf.Token,
+		version: conf.NotionVersion,
+		cli:     conf.HTTPClient,
+	}
+	if cli.baseURL == "" {
+		cli.baseURL = defaultBaseURL
+	}
+	if cli.version == "" {
+		cli.version = defaultNotionVersion
+	}
+	if cli.cli == nil {
+		cli.cli = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &Loader{cli: cli, recursive: conf.Recursive}, nil
+}
+
+// Loader loads notion pages and databases into markdown documents, a document per page.
+// The source uri is one of:
+//   - notion://page/<id> or notion://database/<id>
+//   - the url of the page, or the url of the database with the view parameter "v"
+//   - the id of a page
+type Loader struct {
+	cli       *apiClient
+	recursive bool
+}
+
+type loadState struct {
+	source      string
+	editedAfter time.Time
+	docs        []*schema.Document
+}
+
+func (l *Loader) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, l.GetType(), components.ComponentOfLoader)
+	ctx = callbacks.OnStart(ctx, &document.LoaderCallbackInput{
+		Source: src,
+	})
+	defer func() {
+		if err != nil {
+			_ = callbacks.OnError(ctx, err)
+		}
+	}()
+
+	options := document.GetLoaderImplSpecificOptions(&options{}, opts...)
+
+	isDatabase, id, err := parseSource(src.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &loadState{source: src.URI, editedAfter: options.editedAfter}
+	if isDatabase {
+		err = l.loadDatabase(ctx, st, id, nil)
+	} else {
+		var p *page
+		p, err = l.cli.getPage(ctx, id)
+		if err == nil {
+			err = l.loadPage(ctx, st, p, nil)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notion [%s]: %w", src.URI, err)
+	}
+	docs = st.docs
+
+	_ = callbacks.OnEnd(ctx, &document.LoaderCallbackOutput{
+		Source: src,
+		Docs:   docs,
+	})
+
+	return docs, nil
+}
+
+func (l *Loader) loadDatabase(ctx context.Context, st *loadState, id string, path []string) error {
+	db, err := l.cli.getDatabase(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get database %s failed: %w", id, err)
+	}
+	path = appendPath(path, plainText(db.Title))
+
+	pages, err := l.cli.queryDatabase(ctx, id, st.editedAfter)
+	if err != nil {
+		return fmt.Errorf("query database %s failed: %w", id, err)
+	}
+	for _, p := range pages {
+		if err = l.loadPage(ctx, st, p, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadPage loads the page if edited after st.editedAfter, and its descendants if recursive.
+func (l *Loader) loadPage(ctx context.Context, st *loadState, p *page, path []string) error {
+	if p.Archived {
+		return nil
+	}
+	edited := editedAfter(p.LastEditedTime, st.editedAfter)
+	if !edited && !l.recursive {
+		return nil
+	}
+
+	title := titleOf(p)
+	path = appendPath(path, title)
+
+	blocks, err := l.getBlocks(ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("get blocks of page %s failed: %w", p.ID, err)
+	}
+
+	if edited {
+		content := "# " + title
+		if md := toMarkdown(blocks); md != "" {
+			content += "\n\n" + md
+		}
+		meta := map[string]any{
+			MetaKeySource:         st.source,
+			MetaKeyTitle:          title,
+			MetaKeyURL:            p.URL,
+			MetaKeyParentID:       firstNonEmpty(p.Parent.PageID, p.Parent.DatabaseID, p.Parent.BlockID),
+			MetaKeyPath:           path,
+			MetaKeyCreatedTime:    p.CreatedTime,
+			MetaKeyLastEditedTime: p.LastEditedTime,
+		}
+		if props := propertiesOf(p); len(props) > 0 {
+			meta[MetaKeyProperties] = props
+		}
+		st.docs = append(st.docs, &schema.Document{ID: p.ID, Content: content, MetaData: meta})
+	}
+
+	if !l.recursive {
+		return nil
+	}
+	return walk(blocks, func(b *block) error {
+		switch b.Type {
+		case "child_page":
+			child, err := l.cli.getPage(ctx, b.ID)
+			if err != nil {
+				return fmt.Errorf("get page %s failed: %w", b.ID, err)
+			}
+			return l.loadPage(ctx, st, child, path)
+		case "child_database":
+			return l.loadDatabase(ctx, st, b.ID, path)
+		}
+		return nil
+	})
+}
+
+// getBlocks gets the block tree of the page, except the blocks of child pages and child databases.
+func (l *Loader) getBlocks(ctx context.Context, id string) ([]*block, error) {
+	blocks, err := l.cli.getChildren(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range blocks {
+		if !b.HasChildren || b.Type == "child_page" || b.Type == "child_database" {
+			continue
+		}
+		if b.children, err = l.getBlocks(ctx, b.ID); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (l *Loader) GetType() string {
+	return "NotionLoader"
+}
+
+func (l *Loader) IsCallbacksEnabled() bool {
+	return true
+}
+
+// parseSource returns the id of the page or database of the uri.
+func parseSource(uri string) (isDatabase bool, id string, err error) {
+	switch {
+	case strings.HasPrefix(uri, "notion://page/"):
+		id = strings.TrimPrefix(uri, "notion://page/")
+	case strings.HasPrefix(uri, "notion://database/"):
+		isDatabase, id = true, strings.TrimPrefix(uri, "notion://database/")
+	case strings.HasPrefix(uri, "https://") || strings.HasPrefix(uri, "http://"):
+		u, err := url.Parse(uri)
+		if err != nil {
+			return false, "", fmt.Errorf("invalid notion url [%s]: %w", uri, err)
+		}
+		isDatabase = u.Query().Has("v")
+		id = idPattern.FindString(strings.TrimSuffix(u.Path, "/"))
+	default:
+		id = idPattern.FindString(uri)
+		if id != uri {
+			id = ""
+		}
+	}
+	if id == "" {
+		return false, "", fmt.Errorf("invalid notion source [%s]", uri)
+	}
+	return isDatabase, id, nil
+}
+
+func walk(blocks []*block, fn func(b *block) error) error {
+	for _, b := range blocks {
+		if err := fn(b); err != nil {
+			return err
+		}
+		if err := walk(b.children, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func editedAfter(lastEditedTime string, after time.Time) bool {
+	if after.IsZero() {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339, lastEditedTime)
+	return err != nil || t.After(after)
+}
+
+func titleOf(p *page) string {
+	for _, prop := range p.Properties {
+		if prop.Type == "title" {
+			return plainText(prop.Title)
+		}
+	}
+	return ""
+}
+
+// propertiesOf returns the simplified values of the properties except the title.
+func propertiesOf(p *page) map[string]any {
+	props := make(map[string]any)
+	for name, prop := range p.Properties {
+		var v any
+		switch prop.Type {
+		case "rich_text":
+			v = plainText(prop.RichText)
+		case "number":
+			if prop.Number != nil {
+				v = *prop.Number
+			}
+		case "select":
+			if prop.Select != nil {
+				v = prop.Select.Name
+			}
+		case "status":
+			if prop.Status != nil {
+				v = prop.Status.Name
+			}
+		case "multi_select":
+			names := make([]string, 0, len(prop.MultiSelect))
+			for _, o := range prop.MultiSelect {
+				names = append(names, o.Name)
+			}
+			v = names
+		case "date":
+			if prop.Date != nil {
+				v = prop.Date.Start
+				if prop.Date.End != nil {
+					v = prop.Date.Start + "/" + *prop.Date.End
+				}
+			}
+		case "checkbox":
+			v = prop.Checkbox
+		case "url":
+			v = derefString(prop.URL)
+		case "email":
+			v = derefString(prop.Email)
+		case "phone_number":
+			v = derefString(prop.PhoneNumber)
+		case "people":
+			names := make([]string, 0, len(prop.People))
+			for _, person := range prop.People {
+				names = append(names, person.Name)
+			}
+			v = names
+		}
+		if v != nil {
+			props[name] = v
+		}
+	}
+	return props
+}
+
+func appendPath(path []string, title string) []string {
+	ret := make([]string, 0, len(path)+1)
+	return append(append(ret, path...), title)
+}
+
+func derefString(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func pageJSON(id, title, parentID, edited string) string {
+	return `{"id":"` + id + `","url":"https://www.notion.so/` + id + `","created_time":"2025-01-01T00:00:00.000Z","last_edited_time":"` + edited + `",` +
+		`"parent":{"type":"page_id","page_id":"` + parentID + `"},` +
+		`"properties":{"Name":{"type":"title","title":[{"plain_text":"` + title + `"}]},"Tags":{"type":"multi_select","multi_select":[{"name":"go"}]}}}`
+}
+
+func newServer(t *testing.T) (*httptest.Server, *[]map[string]any) {
+	var queries []map[string]any
+	limited := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pages/root", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, defaultNotionVersion, r.Header.Get("Notion-Version"))
+		_, _ = w.Write([]byte(pageJSON("root", "Root", "", "2025-01-02T00:00:00.000Z")))
+	})
+	mux.HandleFunc("/pages/child", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(pageJSON("child", "Child", "root", "2025-02-01T00:00:00.000Z")))
+	})
+	mux.HandleFunc("/blocks/root/children", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start_cursor") == "" {
+			// rate limited once
+			if !limited {
+				limited = true
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{"results":[{"id":"p1","type":"paragraph","has_children":false,"paragraph":{"rich_text":[{"plain_text":"intro"}]}}],"has_more":true,"next_cursor":"c1"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"results":[
+			{"id":"col","type":"column_list","has_children":true,"column_list":{}},
+			{"id":"db","type":"child_database","has_children":false,"child_database":{"title":"Tasks"}}
+		],"has_more":false}`))
+	})
+	mux.HandleFunc("/blocks/col/children", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[{"id":"child","type":"child_page","has_children":true,"child_page":{"title":"Child"}}],"has_more":false}`))
+	})
+	mux.HandleFunc("/blocks/child/children", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[{"id":"c1","type":"paragraph","has_children":false,"paragraph":{"rich_text":[{"plain_text":"child text"}]}}],"has_more":false}`))
+	})
+	mux.HandleFunc("/blocks/task/children", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[],"has_more":false}`))
+	})
+	mux.HandleFunc("/databases/db", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"db","title":[{"plain_text":"Tasks"}]}`))
+	})
+	mux.HandleFunc("/databases/db/query", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body := map[string]any{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		queries = append(queries, body)
+		_, _ = w.Write([]byte(`{"results":[` + pageJSON("task", "Task", "", "2025-03-01T00:00:00.000Z") + `],"has_more":false}`))
+	})
+	return httptest.NewServer(mux), &queries
+}
+
+func TestLoader_Load(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewLoader(ctx, &LoaderConfig{})
+	assert.EqualError(t, err, "notion token is required")
+
+	t.Run("page", func(t *testing.T) {
+		srv, _ := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{Token: "secret", BaseURL: srv.URL})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "notion://page/root"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(docs))
+		assert.Equal(t, "root", docs[0].ID)
+		assert.Equal(t, "# Root\n\nintro\n\n[Child](https://www.notion.so/child)\n\n[Tasks](https://www.notion.so/db)", docs[0].Content)
+		assert.Equal(t, []string{"Root"}, docs[0].MetaData[MetaKeyPath])
+		assert.Equal(t, map[string]any{"Tags": []string{"go"}}, docs[0].MetaData[MetaKeyProperties])
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		srv, _ := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{Token: "secret", BaseURL: srv.URL, Recursive: true})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "notion://page/root"})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(docs))
+		assert.Equal(t, "# Child\n\nchild text", docs[1].Content)
+		assert.Equal(t, []string{"Root", "Child"}, docs[1].MetaData[MetaKeyPath])
+		assert.Equal(t, "root", docs[1].MetaData[MetaKeyParentID])
+		assert.Equal(t, "# Task", docs[2].Content)
+		assert.Equal(t, []string{"Root", "Tasks", "Task"}, docs[2].MetaData[MetaKeyPath])
+	})
+
+	t.Run("edited after", func(t *testing.T) {
+		srv, queries := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{Token: "secret", BaseURL: srv.URL, Recursive: true})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "notion://page/root"}, WithEditedAfter(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "child", docs[0].ID)
+		assert.Equal(t, "task", docs[1].ID)
+		assert.Equal(t, map[string]any{
+			"timestamp":        "last_edited_time",
+			"last_edited_time": map[string]any{"after": "2025-01-15T00:00:00Z"},
+		}, (*queries)[0]["filter"])
+	})
+
+	t.Run("database", func(t *testing.T) {
+		srv, _ := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{Token: "secret", BaseURL: srv.URL})
+		assert.NoError(t, err)
+
+		docs, err := l.Load(ctx, document.Source{URI: "notion://database/db"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(docs))
+		assert.Equal(t, []string{"Tasks", "Task"}, docs[0].MetaData[MetaKeyPath])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		srv, _ := newServer(t)
+		defer srv.Close()
+
+		l, err := NewLoader(ctx, &LoaderConfig{Token: "secret", BaseURL: srv.URL})
+		assert.NoError(t, err)
+
+		_, err = l.Load(ctx, document.Source{URI: "notion://page/missing"})
+		assert.ErrorContains(t, err, "request failed with status code 404")
+	})
+}
+
+func TestParseSource(t *testing.T) {
+	id := "0123456789abcdef0123456789abcdef"
+	cases := []struct {
+		uri        string
+		isDatabase bool
+		id         string
+	}{
+		{uri: "notion://page/abc", id: "abc"},
+		{uri: "notion://database/abc", isDatabase: true, id: "abc"},
+		{uri: "https://www.notion.so/workspace/My-Page-" + id, id: id},
+		{uri: "https://www.notion.so/" + id + "?v=fedcba", isDatabase: true, id: id},
+		{uri: "01234567-89ab-cdef-0123-456789abcdef", id: "01234567-89ab-cdef-0123-456789abcdef"},
+	}
+	for _, c := range cases {
+		isDatabase, got, err := parseSource(c.uri)
+		assert.NoError(t, err, c.uri)
+		assert.Equal(t, c.isDatabase, isDatabase, c.uri)
+		assert.Equal(t, c.id, got, c.uri)
+	}
+
+	_, _, err := parseSource("not a notion page")
+	assert.EqualError(t, err, "invalid notion source [not a notion page]")
+}
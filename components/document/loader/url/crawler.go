@@ -0,0 +1,569 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package url
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/cloudwego/eino-ext/components/document/parser/html"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultCrawlConcurrency = 4
+	defaultCrawlMaxPages    = 100
+	// maxSitemapNesting limits the nesting of sitemap indexes.
+	maxSitemapNesting = 3
+)
+
+// MetaKeyDepth is the metadata key of the number of links from the seed url or the sitemap to the crawled page.
+const MetaKeyDepth = "_depth"
+
+// skippedExtensions are the extensions of the links not crawled, e.g. images and scripts.
+var skippedExtensions = map[string]bool{
+	".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".svg": true, ".webp": true, ".ico": true,
+	".css": true, ".js": true, ".woff": true, ".woff2": true, ".ttf": true,
+	".zip": true, ".gz": true, ".tar": true, ".mp3": true, ".mp4": true, ".avi": true, ".mov": true,
+}
+
+var _ document.Loader = (*Crawler)(nil)
+
+// CrawlerConfig is the config for url Crawler.
+type CrawlerConfig struct {
+	// Parser parses the pages.
+	// Optional. Default: parser/html of the <body>.
+	Parser parser.Parser
+	// Client fetches the pages.
+	// Optional. Default: http.DefaultClient.
+	Client *http.Client
+	// UserAgent is the User-Agent header of the requests.
+	// Optional.
+	UserAgent string
+
+	// MaxDepth is the number of links followed from the seed url or the pages of the sitemap.
+	// Optional. Default: 0, only the seed url or the pages of the sitemap.
+	MaxDepth int
+	// MaxPages is the maximum number of pages fetched.
+	// Optional. Default: 100.
+	MaxPages int
+	// AllowedHosts are the hosts of the links followed.
+	// Optional. Default: the host of the source.
+	AllowedHosts []string
+	// Include are the regular expressions of the urls crawled, a url is crawled if it matches any of them.
+	// Optional. Default: all the urls of the allowed hosts.
+	Include []string
+	// Exclude are the regular expressions of the urls not crawled, e.g. `/login`, `\?page=`.
+	// Optional.
+	Exclude []string
+
+	// Concurrency is the number of pages fetched concurrently.
+	// Optional. Default: 4.
+	Concurrency int
+	// Delay is the minimum interval between requests, to be polite to the site.
+	// Optional. Default: 0.
+	Delay time.Duration
+
+	// ErrorHandler is called with the errors of the pages, which are skipped.
+	// The errors of the source, e.g. the sitemap, fail the crawling.
+	// Optional.
+	ErrorHandler func(url string, err error)
+}
+
+// NewCrawler creates a crawler, which crawls a site from a seed url, or the pages listed in a sitemap.
+func NewCrawler(ctx context.Context, conf *CrawlerConfig) (*Crawler, error) {
+	c := &CrawlerConfig{}
+	if conf != nil {
+		*c = *conf
+	}
+
+	if c.Parser == nil {
+		p, err := html.NewParser(ctx, &html.Config{
+			Selector: &html.BodySelector,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create default HTML parser: %w", err)
+		}
+		c.Parser = p
+	}
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	if c.MaxPages <= 0 {
+		c.MaxPages = defaultCrawlMaxPages
+	}
+	if c.Concurrency <= 0 {
+		c.Concurrency = defaultCrawlConcurrency
+	}
+
+	include, err := compilePatterns(c.Include)
+	if err != nil {
+		return nil, fmt.Errorf("invalid include pattern: %w", err)
+	}
+	exclude, err := compilePatterns(c.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude pattern: %w", err)
+	}
+
+	return &Crawler{conf: c, include: include, exclude: exclude}, nil
+}
+
+// Crawler crawls the pages of a site, and deduplicates them by the canonical url.
+// The source uri is a seed url, or the url of a sitemap ending with .xml or .xml.gz, which may be a sitemap index.
+type Crawler struct {
+	conf    *CrawlerConfig
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// Load crawls the source, and returns the documents of all the pages.
+func (c *Crawler) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, c.GetType(), components.ComponentOfLoader)
+	ctx = callbacks.OnStart(ctx, &document.LoaderCallbackInput{
+		Source: src,
+	})
+	defer func() {
+		if err != nil {
+			_ = callbacks.OnError(ctx, err)
+		}
+	}()
+
+	sr := c.Crawl(ctx, src)
+	defer sr.Close()
+	for {
+		doc, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	_ = callbacks.OnEnd(ctx, &document.LoaderCallbackOutput{
+		Source: src,
+		Docs:   docs,
+	})
+
+	return docs, nil
+}
+
+// Crawl crawls the source, and streams the documents as the pages are parsed.
+// Closing the stream stops the crawling.
+func (c *Crawler) Crawl(ctx context.Context, src document.Source) *schema.StreamReader[*schema.Document] {
+	sr, sw := schema.Pipe[*schema.Document](c.conf.Concurrency)
+
+	go func() {
+		defer sw.Close()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		r := &crawl{Crawler: c, seen: make(map[string]bool)}
+		emit := func(doc *schema.Document) bool {
+			r.sendMu.Lock()
+			defer r.sendMu.Unlock()
+			if r.stopped || sw.Send(doc, nil) {
+				// closed by the reader
+				r.stopped = true
+				cancel()
+			}
+			return !r.stopped
+		}
+		if err := r.run(ctx, src.URI, emit); err != nil && ctx.Err() == nil {
+			sw.Send(nil, fmt.Errorf("failed to crawl [%s]: %w", src.URI, err))
+		}
+	}()
+
+	return sr
+}
+
+func (c *Crawler) GetType() string {
+	return "URLCrawler"
+}
+
+func (c *Crawler) IsCallbacksEnabled() bool {
+	return true
+}
+
+// crawl is the state of a crawling.
+type crawl struct {
+	*Crawler
+	hosts map[string]bool
+
+	mu    sync.Mutex
+	seen  map[string]bool
+	pages int
+
+	throttleMu  sync.Mutex
+	lastRequest time.Time
+
+	sendMu  sync.Mutex
+	stopped bool
+}
+
+func (r *crawl) run(ctx context.Context, uri string, emit func(doc *schema.Document) bool) error {
+	seed, err := url.Parse(uri)
+	if err != nil || (seed.Scheme != "http" && seed.Scheme != "https") {
+		return fmt.Errorf("invalid url")
+	}
+
+	r.hosts = make(map[string]bool)
+	for _, h := range r.conf.AllowedHosts {
+		r.hosts[strings.ToLower(h)] = true
+	}
+	if len(r.hosts) == 0 {
+		r.hosts[strings.ToLower(seed.Hostname())] = true
+	}
+
+	var level []string
+	if p := strings.ToLower(seed.Path); strings.HasSuffix(p, ".xml") || strings.HasSuffix(p, ".xml.gz") {
+		locs, err := r.sitemap(ctx, uri, 0)
+		if err != nil {
+			return err
+		}
+		for _, loc := range locs {
+			if u, ok := r.normalize(loc, seed); ok && r.markSeen(u) {
+				level = append(level, u)
+			}
+		}
+	} else if u, ok := normalizeURL(seed); ok && r.markSeen(u) {
+		level = append(level, u)
+	}
+
+	for depth := 0; len(level) > 0 && depth <= r.conf.MaxDepth; depth++ {
+		level = r.crawlLevel(ctx, level, depth, emit)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// crawlLevel fetches the pages of the same depth concurrently, and returns the new links of the pages.
+func (r *crawl) crawlLevel(ctx context.Context, urls []string, depth int, emit func(doc *schema.Document) bool) []string {
+	var (
+		wg     sync.WaitGroup
+		nextMu sync.Mutex
+		next   []string
+		sem    = make(chan struct{}, r.conf.Concurrency)
+	)
+	followLinks := depth < r.conf.MaxDepth
+
+	for _, u := range urls {
+		if ctx.Err() != nil || !r.reservePage() {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(u string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			docs, links, err := r.fetch(ctx, u, followLinks)
+			if err != nil {
+				if r.conf.ErrorHandler != nil && ctx.Err() == nil {
+					r.conf.ErrorHandler(u, err)
+				}
+				return
+			}
+			for _, doc := range docs {
+				if doc.MetaData == nil {
+					doc.MetaData = make(map[string]any)
+				}
+				doc.MetaData[MetaKeyDepth] = depth
+				if !emit(doc) {
+					return
+				}
+			}
+
+			nextMu.Lock()
+			defer nextMu.Unlock()
+			for _, link := range links {
+				if r.markSeen(link) {
+					next = append(next, link)
+				}
+			}
+		}(u)
+	}
+	wg.Wait()
+	return next
+}
+
+// fetch fetches and parses the page, and returns the links in the page if followLinks.
+// The page and its links are skipped if its canonical url has been crawled.
+func (r *crawl) fetch(ctx context.Context, pageURL string, followLinks bool) ([]*schema.Document, []string, error) {
+	data, resp, err := r.get(ctx, pageURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	canonical := pageURL
+	var links []string
+	if isHTML(resp.Header.Get("Content-Type"), data) {
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		base := resp.Request.URL
+		if href, ok := doc.Find("base[href]").Attr("href"); ok {
+			if u, err := base.Parse(href); err == nil {
+				base = u
+			}
+		}
+		if href, ok := doc.Find(`link[rel="canonical"]`).Attr("href"); ok {
+			if u, ok := r.normalize(href, base); ok {
+				canonical = u
+			}
+		} else if u, ok := normalizeURL(resp.Request.URL); ok {
+			// redirected
+			canonical = u
+		}
+
+		if followLinks {
+			doc.Find("a[href]").Each(func(_ int, s *goquery.Selection) {
+				if u, ok := r.normalize(s.AttrOr("href", ""), base); ok {
+					links = append(links, u)
+				}
+			})
+		}
+	}
+	if canonical != pageURL && !r.markSeen(canonical) {
+		return nil, nil, nil
+	}
+
+	docs, err := r.conf.Parser.Parse(ctx, bytes.NewReader(data), parser.WithURI(canonical))
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse failed: %w", err)
+	}
+	return docs, links, nil
+}
+
+// sitemap returns the page urls of the sitemap, and the sitemaps of the sitemap index.
+func (r *crawl) sitemap(ctx context.Context, uri string, nesting int) ([]string, error) {
+	data, _, err := r.get(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(gr); err != nil {
+			return nil, err
+		}
+	}
+
+	sm := &struct {
+		URLs []struct {
+			Loc string `xml:"loc"`
+		} `xml:"url"`
+		Sitemaps []struct {
+			Loc string `xml:"loc"`
+		} `xml:"sitemap"`
+	}{}
+	if err = xml.Unmarshal(data, sm); err != nil {
+		return nil, fmt.Errorf("invalid sitemap [%s]: %w", uri, err)
+	}
+
+	locs := make([]string, 0, len(sm.URLs))
+	for _, u := range sm.URLs {
+		locs = append(locs, strings.TrimSpace(u.Loc))
+	}
+	if nesting >= maxSitemapNesting {
+		return locs, nil
+	}
+	for _, s := range sm.Sitemaps {
+		children, err := r.sitemap(ctx, strings.TrimSpace(s.Loc), nesting+1)
+		if err != nil {
+			return nil, err
+		}
+		locs = append(locs, children...)
+	}
+	return locs, nil
+}
+
+func (r *crawl) get(ctx context.Context, uri string) ([]byte, *http.Response, error) {
+	if err := r.throttle(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.conf.UserAgent != "" {
+		req.Header.Set("User-Agent", r.conf.UserAgent)
+	}
+
+	resp, err := r.conf.Client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("request failed with status code %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, resp, nil
+}
+
+// throttle waits until Delay has passed since the last request.
+func (r *crawl) throttle(ctx context.Context) error {
+	if r.conf.Delay <= 0 {
+		return nil
+	}
+
+	r.throttleMu.Lock()
+	wait := time.Until(r.lastRequest.Add(r.conf.Delay))
+	if wait < 0 {
+		wait = 0
+	}
+	r.lastRequest = time.Now().Add(wait)
+	r.throttleMu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+// reservePage returns false if MaxPages pages have been fetched.
+func (r *crawl) reservePage() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pages >= r.conf.MaxPages {
+		return false
+	}
+	r.pages++
+	return true
+}
+
+// markSeen marks the url as seen, and returns false if it has been seen.
+func (r *crawl) markSeen(u string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.seen[u] {
+		return false
+	}
+	r.seen[u] = true
+	return true
+}
+
+// normalize resolves the reference against the base, and returns the normalized url if it should be crawled.
+func (r *crawl) normalize(ref string, base *url.URL) (string, bool) {
+	u, err := base.Parse(strings.TrimSpace(ref))
+	if err != nil || !r.hosts[strings.ToLower(u.Hostname())] || skippedExtensions[strings.ToLower(path.Ext(u.Path))] {
+		return "", false
+	}
+
+	s, ok := normalizeURL(u)
+	if !ok {
+		return "", false
+	}
+	if len(r.include) > 0 && !matchAny(r.include, s) {
+		return "", false
+	}
+	if matchAny(r.exclude, s) {
+		return "", false
+	}
+	return s, true
+}
+
+// normalizeURL drops the fragment and the default port, and lowercases the scheme and the host.
+func normalizeURL(u *url.URL) (string, bool) {
+	n := *u
+	n.Scheme = strings.ToLower(n.Scheme)
+	if n.Scheme != "http" && n.Scheme != "https" {
+		return "", false
+	}
+	n.Fragment = ""
+	n.RawFragment = ""
+	host, port := strings.ToLower(n.Hostname()), n.Port()
+	if (n.Scheme == "http" && port == "80") || (n.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	n.Host = host
+	if port != "" {
+		n.Host = host + ":" + port
+	}
+	if n.Path == "" {
+		n.Path = "/"
+	}
+	return n.String(), true
+}
+
+func isHTML(contentType string, data []byte) bool {
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	return strings.Contains(contentType, "text/html") || strings.Contains(contentType, "application/xhtml")
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	ret := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, re)
+	}
+	return ret, nil
+}
+
+func matchAny(patterns []*regexp.Regexp, s string) bool {
+	for _, re := range patterns {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
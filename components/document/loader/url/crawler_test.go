@@ -0,0 +1,189 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package url
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+type uriParser struct{}
+
+func (p *uriParser) Parse(ctx context.Context, reader io.Reader, opts ...parser.Option) ([]*schema.Document, error) {
+	o := parser.GetCommonOptions(nil, opts...)
+	return []*schema.Document{{Content: o.URI}}, nil
+}
+
+func newSite(t *testing.T) *httptest.Server {
+	pages := map[string]string{
+		"/":  `<html><body><a href="/a">a</a> <a href="b#top">b</a> <a href="https://other.com/x">x</a> <a href="/logo.png">logo</a></body></html>`,
+		"/a": `<html><body><a href="/c">c</a> <a href="/">home</a></body></html>`,
+		"/b": `<html><head><link rel="canonical" href="/a"></head><body><a href="/d">d</a></body></html>`,
+		"/c": `<html><body><a href="/e">e</a></body></html>`,
+		"/d": `<html><body>d</body></html>`,
+		"/e": `<html><body>e</body></html>`,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sitemap.xml", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <sitemap><loc>http://%s/pages.xml.gz</loc></sitemap>
+</sitemapindex>`, r.Host)
+	})
+	mux.HandleFunc("/pages.xml.gz", func(w http.ResponseWriter, r *http.Request) {
+		buf := &bytes.Buffer{}
+		gw := gzip.NewWriter(buf)
+		_, _ = fmt.Fprintf(gw, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url><loc>http://%[1]s/c</loc></url>
+  <url><loc>http://%[1]s/d</loc></url>
+  <url><loc>http://%[1]s/missing</loc></url>
+  <url><loc>https://other.com/c</loc></url>
+</urlset>`, r.Host)
+		_ = gw.Close()
+		_, _ = w.Write(buf.Bytes())
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(page))
+	})
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func crawled(docs []*schema.Document, base string) []string {
+	ret := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ret = append(ret, fmt.Sprintf("%s@%d", doc.Content[len(base):], doc.MetaData[MetaKeyDepth]))
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func TestCrawler(t *testing.T) {
+	ctx := context.Background()
+	s := newSite(t)
+
+	t.Run("seed url", func(t *testing.T) {
+		c, err := NewCrawler(ctx, &CrawlerConfig{Parser: &uriParser{}, MaxDepth: 2})
+		assert.NoError(t, err)
+
+		docs, err := c.Load(ctx, document.Source{URI: s.URL})
+		assert.NoError(t, err)
+		// /b is skipped as its canonical url is /a, and /d is only linked from /b
+		assert.Equal(t, []string{"/@0", "/a@1", "/c@2"}, crawled(docs, s.URL))
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		c, err := NewCrawler(ctx, &CrawlerConfig{Parser: &uriParser{}})
+		assert.NoError(t, err)
+
+		docs, err := c.Load(ctx, document.Source{URI: s.URL + "/a"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"/a@0"}, crawled(docs, s.URL))
+	})
+
+	t.Run("include and exclude", func(t *testing.T) {
+		c, err := NewCrawler(ctx, &CrawlerConfig{
+			Parser:   &uriParser{},
+			MaxDepth: 3,
+			Include:  []string{`/$`, `/[a-c]$`},
+			Exclude:  []string{`/b$`},
+		})
+		assert.NoError(t, err)
+
+		docs, err := c.Load(ctx, document.Source{URI: s.URL})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"/@0", "/a@1", "/c@2"}, crawled(docs, s.URL))
+
+		_, err = NewCrawler(ctx, &CrawlerConfig{Include: []string{"("}})
+		assert.Error(t, err)
+	})
+
+	t.Run("max pages", func(t *testing.T) {
+		c, err := NewCrawler(ctx, &CrawlerConfig{Parser: &uriParser{}, MaxDepth: 3, MaxPages: 2, Concurrency: 1})
+		assert.NoError(t, err)
+
+		docs, err := c.Load(ctx, document.Source{URI: s.URL})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+	})
+
+	t.Run("sitemap", func(t *testing.T) {
+		var (
+			mu     sync.Mutex
+			failed []string
+		)
+		c, err := NewCrawler(ctx, &CrawlerConfig{
+			Parser:   &uriParser{},
+			MaxDepth: 1,
+			ErrorHandler: func(url string, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				failed = append(failed, url[len(s.URL):]+": "+err.Error())
+			},
+		})
+		assert.NoError(t, err)
+
+		docs, err := c.Load(ctx, document.Source{URI: s.URL + "/sitemap.xml"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"/c@0", "/d@0", "/e@1"}, crawled(docs, s.URL))
+		assert.Equal(t, []string{"/missing: request failed with status code 404"}, failed)
+
+		_, err = c.Load(ctx, document.Source{URI: s.URL + "/missing.xml"})
+		assert.ErrorContains(t, err, "request failed with status code 404")
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		c, err := NewCrawler(ctx, &CrawlerConfig{Parser: &uriParser{}, MaxDepth: 2})
+		assert.NoError(t, err)
+
+		sr := c.Crawl(ctx, document.Source{URI: s.URL})
+		doc, err := sr.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, s.URL+"/", doc.Content)
+		sr.Close()
+
+		sr = c.Crawl(ctx, document.Source{URI: "ftp://example.com"})
+		_, err = sr.Recv()
+		assert.ErrorContains(t, err, "invalid url")
+		_, err = sr.Recv()
+		assert.True(t, errors.Is(err, io.EOF))
+	})
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/document/loader/url"
+	"github.com/cloudwego/eino/components/document"
+)
+
+func main() {
+	ctx := context.Background()
+	crawler, err := url.NewCrawler(ctx, &url.CrawlerConfig{
+		MaxDepth:    1,
+		MaxPages:    20,
+		Include:     []string{`/docs/eino/`},
+		Concurrency: 2,
+		Delay:       500 * time.Millisecond,
+		ErrorHandler: func(uri string, err error) {
+			log.Printf("crawl %s failed, err=%v", uri, err)
+		},
+	})
+	if err != nil {
+		log.Fatalf("NewCrawler failed, err=%v", err)
+	}
+
+	// the source can also be a seed url, e.g. https://www.cloudwego.io/docs/eino/
+	sr := crawler.Crawl(ctx, document.Source{
+		URI: "https://www.cloudwego.io/sitemap.xml",
+	})
+	defer sr.Close()
+
+	for {
+		doc, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Crawl failed, err=%v", err)
+		}
+		fmt.Printf("%v (depth %v): %d chars\n", doc.MetaData["_source"], doc.MetaData[url.MetaKeyDepth], len(doc.Content))
+	}
+}
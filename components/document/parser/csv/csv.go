@@ -0,0 +1,399 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package csv
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	MetaKeySource = "_source"
+	// MetaKeyRow is the 1-based number of the first data row of the document, the header excluded.
+	MetaKeyRow = "_row"
+)
+
+var _ parser.Parser = (*Parser)(nil)
+
+// Config is the config of csv Parser.
+type Config struct {
+	// Delimiter is the field delimiter, e.g. ',' for csv and '\t' for tsv.
+	// Optional. Default: detected from ',', '\t', ';' and '|'.
+	Delimiter rune
+	// NoHeader means the first row is data rather than the column names.
+	// The columns are named col1, col2, ... if NoHeader.
+	NoHeader bool
+	// Decoder converts the content which is neither utf-8 nor utf-16 with BOM to utf-8,
+	// e.g. simplifiedchinese.GBK.NewDecoder().Reader of golang.org/x/text.
+	// Optional. Default: the content is decoded as latin-1 (ISO-8859-1).
+	Decoder func(r io.Reader) io.Reader
+
+	// ContentTemplate renders a row to the content of the document, where {column} is replaced by the value of the column,
+	// and {{ and }} are the escaped { and }, e.g. "{title}\n\n{body}".
+	// Optional. Default: "column: value" lines of ContentColumns.
+	ContentTemplate string
+	// ContentColumns are the columns in the content if ContentTemplate is not set.
+	// Optional. Default: all the columns.
+	ContentColumns []string
+	// MetaColumns are the columns put in the metadata of the document, keyed by the column names.
+	// Optional. Default: the columns not in the content.
+	MetaColumns []string
+
+	// RowsPerDocument is the number of rows grouped into a document, whose contents are joined by a blank line.
+	// The metadata of the columns are []string of the rows if it is greater than 1.
+	// Optional. Default: 1.
+	RowsPerDocument int
+	// IDPrefix is the prefix of the document ids, which are the 1-based numbers of the documents.
+	// Optional. Default: no id.
+	IDPrefix string
+}
+
+// NewParser creates a csv parser, which converts each row or group of rows to a document.
+func NewParser(ctx context.Context, conf *Config) (*Parser, error) {
+	c := &Config{}
+	if conf != nil {
+		*c = *conf
+	}
+	if c.RowsPerDocument <= 0 {
+		c.RowsPerDocument = 1
+	}
+
+	p := &Parser{conf: c}
+	if c.ContentTemplate != "" {
+		tpl, err := parseTemplate(c.ContentTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid content template: %w", err)
+		}
+		p.tpl = tpl
+	}
+	return p, nil
+}
+
+// Parser parses csv or tsv content to documents.
+type Parser struct {
+	conf *Config
+	tpl  []segment
+}
+
+func (p *Parser) Parse(ctx context.Context, reader io.Reader, opts ...parser.Option) ([]*schema.Document, error) {
+	option := parser.GetCommonOptions(&parser.Options{}, opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = decode(data, p.conf.Decoder); err != nil {
+		return nil, fmt.Errorf("decode failed: %w", err)
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = p.conf.Delimiter
+	if r.Comma == 0 {
+		r.Comma = detectDelimiter(data, option.URI)
+	}
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	var headers []string
+	if !p.conf.NoHeader {
+		if headers, err = r.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for i := range headers {
+			headers[i] = strings.TrimSpace(headers[i])
+		}
+	}
+
+	m, err := p.newMapping(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		docs  []*schema.Document
+		group [][]string
+		first int
+	)
+	flush := func() {
+		if len(group) > 0 {
+			docs = append(docs, p.toDocument(m, group, first, len(docs)+1, option))
+			group = group[:0]
+		}
+	}
+
+	for n := 1; ; n++ {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isBlank(row) {
+			continue
+		}
+		if p.conf.NoHeader {
+			m.grow(len(row))
+		}
+
+		if len(group) == 0 {
+			first = n
+		}
+		group = append(group, row)
+		if len(group) == p.conf.RowsPerDocument {
+			flush()
+		}
+	}
+	flush()
+
+	return docs, nil
+}
+
+// mapping maps the columns to the content and the metadata.
+type mapping struct {
+	index    map[string]int
+	headers  []string
+	noHeader bool
+	content  []int
+	meta     []int
+
+	contentColumns []string
+	metaColumns    []string
+	tplColumns     []string
+}
+
+func (p *Parser) newMapping(headers []string) (*mapping, error) {
+	m := &mapping{
+		index:          make(map[string]int, len(headers)),
+		noHeader:       p.conf.NoHeader,
+		contentColumns: p.conf.ContentColumns,
+		metaColumns:    p.conf.MetaColumns,
+	}
+	for _, seg := range p.tpl {
+		if seg.column {
+			m.tplColumns = append(m.tplColumns, seg.text)
+		}
+	}
+	for _, h := range headers {
+		m.add(h)
+	}
+	if err := m.resolve(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *mapping) add(header string) {
+	if _, ok := m.index[header]; !ok {
+		m.index[header] = len(m.headers)
+	}
+	m.headers = append(m.headers, header)
+}
+
+// grow names the new columns col1, col2, ... for the csv without header.
+func (m *mapping) grow(n int) {
+	if len(m.headers) >= n {
+		return
+	}
+	for i := len(m.headers); i < n; i++ {
+		m.add("col" + strconv.Itoa(i+1))
+	}
+	_ = m.resolve()
+}
+
+// resolve resolves the content and meta columns.
+// The unknown columns are an error with header, and are ignored until they appear without header.
+func (m *mapping) resolve() error {
+	lookup := func(columns []string) ([]int, error) {
+		ret := make([]int, 0, len(columns))
+		for _, c := range columns {
+			i, ok := m.index[c]
+			if !ok {
+				if m.noHeader {
+					continue
+				}
+				return nil, fmt.Errorf("unknown column %q", c)
+			}
+			ret = append(ret, i)
+		}
+		return ret, nil
+	}
+
+	var err error
+	switch {
+	case len(m.tplColumns) > 0:
+		m.content, err = lookup(m.tplColumns)
+	case len(m.contentColumns) > 0:
+		m.content, err = lookup(m.contentColumns)
+	default:
+		m.content = make([]int, len(m.headers))
+		for i := range m.headers {
+			m.content[i] = i
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(m.metaColumns) > 0 {
+		m.meta, err = lookup(m.metaColumns)
+		return err
+	}
+	inContent := make(map[int]bool, len(m.content))
+	for _, i := range m.content {
+		inContent[i] = true
+	}
+	m.meta = m.meta[:0]
+	for i := range m.headers {
+		if !inContent[i] && m.index[m.headers[i]] == i {
+			m.meta = append(m.meta, i)
+		}
+	}
+	return nil
+}
+
+func (p *Parser) toDocument(m *mapping, rows [][]string, first, id int, option *parser.Options) *schema.Document {
+	contents := make([]string, 0, len(rows))
+	for _, row := range rows {
+		contents = append(contents, p.render(m, row))
+	}
+
+	meta := make(map[string]any, len(m.meta)+len(option.ExtraMeta)+2)
+	for _, i := range m.meta {
+		if p.conf.RowsPerDocument == 1 {
+			meta[m.headers[i]] = cell(rows[0], i)
+			continue
+		}
+		values := make([]string, 0, len(rows))
+		for _, row := range rows {
+			values = append(values, cell(row, i))
+		}
+		meta[m.headers[i]] = values
+	}
+	for k, v := range option.ExtraMeta {
+		meta[k] = v
+	}
+	meta[MetaKeySource] = option.URI
+	meta[MetaKeyRow] = first
+
+	doc := &schema.Document{
+		Content:  strings.Join(contents, "\n\n"),
+		MetaData: meta,
+	}
+	if p.conf.IDPrefix != "" {
+		doc.ID = p.conf.IDPrefix + strconv.Itoa(id)
+	}
+	return doc
+}
+
+func (p *Parser) render(m *mapping, row []string) string {
+	sb := strings.Builder{}
+	if p.tpl != nil {
+		for _, seg := range p.tpl {
+			if !seg.column {
+				sb.WriteString(seg.text)
+			} else if i, ok := m.index[seg.text]; ok {
+				sb.WriteString(cell(row, i))
+			}
+		}
+		return sb.String()
+	}
+
+	for _, i := range m.content {
+		v := cell(row, i)
+		if v == "" {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(m.headers[i])
+		sb.WriteString(": ")
+		sb.WriteString(v)
+	}
+	return sb.String()
+}
+
+func cell(row []string, i int) string {
+	if i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+func isBlank(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// segment is a literal text or a {column} of the content template.
+type segment struct {
+	text   string
+	column bool
+}
+
+func parseTemplate(tpl string) ([]segment, error) {
+	var (
+		segs []segment
+		sb   strings.Builder
+	)
+	for i := 0; i < len(tpl); i++ {
+		switch c := tpl[i]; {
+		case c == '{' && i+1 < len(tpl) && tpl[i+1] == '{':
+			sb.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(tpl) && tpl[i+1] == '}':
+			sb.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(tpl[i+1:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed { at %d", i)
+			}
+			if sb.Len() > 0 {
+				segs = append(segs, segment{text: sb.String()})
+				sb.Reset()
+			}
+			segs = append(segs, segment{text: strings.TrimSpace(tpl[i+1 : i+1+end]), column: true})
+			i += end + 1
+		case c == '}':
+			return nil, fmt.Errorf("unexpected } at %d", i)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	if sb.Len() > 0 {
+		segs = append(segs, segment{text: sb.String()})
+	}
+	return segs, nil
+}
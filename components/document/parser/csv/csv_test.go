@@ -0,0 +1,162 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package csv
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/document/parser"
+)
+
+func TestParser(t *testing.T) {
+	ctx := context.Background()
+	data := "title,body,author\n" +
+		"Eino,\"An LLM framework, in Go\",cloudwego\n" +
+		"\n" +
+		"Hertz,An HTTP framework,cloudwego\n" +
+		"Kitex,An RPC framework,\n"
+
+	t.Run("default", func(t *testing.T) {
+		p, err := NewParser(ctx, nil)
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(data), parser.WithURI("a.csv"),
+			parser.WithExtraMeta(map[string]any{"k": "v"}))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(docs))
+		assert.Equal(t, "title: Eino\nbody: An LLM framework, in Go\nauthor: cloudwego", docs[0].Content)
+		assert.Equal(t, map[string]any{MetaKeySource: "a.csv", MetaKeyRow: 1, "k": "v"}, docs[0].MetaData)
+		assert.Equal(t, "title: Kitex\nbody: An RPC framework", docs[2].Content)
+		assert.Equal(t, 3, docs[2].MetaData[MetaKeyRow])
+		assert.Equal(t, "", docs[0].ID)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{
+			ContentTemplate: "# {title}\n\n{body} {{by {author}}}",
+			IDPrefix:        "row_",
+		})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(docs))
+		assert.Equal(t, "# Eino\n\nAn LLM framework, in Go {by cloudwego}", docs[0].Content)
+		assert.Equal(t, map[string]any{MetaKeySource: "", MetaKeyRow: 1}, docs[0].MetaData)
+		assert.Equal(t, "row_3", docs[2].ID)
+
+		p, err = NewParser(ctx, &Config{ContentTemplate: "{missing}"})
+		assert.NoError(t, err)
+		_, err = p.Parse(ctx, strings.NewReader(data))
+		assert.EqualError(t, err, `unknown column "missing"`)
+
+		_, err = NewParser(ctx, &Config{ContentTemplate: "{title"})
+		assert.EqualError(t, err, "invalid content template: unclosed { at 0")
+	})
+
+	t.Run("content and meta columns", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{ContentColumns: []string{"body"}})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, "body: An LLM framework, in Go", docs[0].Content)
+		assert.Equal(t, map[string]any{MetaKeySource: "", MetaKeyRow: 1, "title": "Eino", "author": "cloudwego"}, docs[0].MetaData)
+
+		p, err = NewParser(ctx, &Config{ContentColumns: []string{"body"}, MetaColumns: []string{"title"}})
+		assert.NoError(t, err)
+
+		docs, err = p.Parse(ctx, strings.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{MetaKeySource: "", MetaKeyRow: 1, "title": "Eino"}, docs[0].MetaData)
+	})
+
+	t.Run("rows per document", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{ContentTemplate: "{title}: {body}", RowsPerDocument: 2})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "Eino: An LLM framework, in Go\n\nHertz: An HTTP framework", docs[0].Content)
+		assert.Equal(t, []string{"cloudwego", "cloudwego"}, docs[0].MetaData["author"])
+		assert.Equal(t, "Kitex: An RPC framework", docs[1].Content)
+		assert.Equal(t, []string{""}, docs[1].MetaData["author"])
+		assert.Equal(t, 3, docs[1].MetaData[MetaKeyRow])
+	})
+
+	t.Run("no header", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{NoHeader: true, ContentTemplate: "{col1} - {col3}"})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader("a|b|c\nd|e|f\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "a - c", docs[0].Content)
+		assert.Equal(t, "e", docs[1].MetaData["col2"])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		p, err := NewParser(ctx, nil)
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(""))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(docs))
+	})
+}
+
+func TestDetect(t *testing.T) {
+	t.Run("delimiter", func(t *testing.T) {
+		assert.Equal(t, ',', detectDelimiter([]byte("a,b\n1,2\n"), ""))
+		assert.Equal(t, '\t', detectDelimiter([]byte("a\tb\tc,d\n1\t2\t3,4\n"), ""))
+		assert.Equal(t, ';', detectDelimiter([]byte("a;b;c\n\"1;x\";2;3\n"), ""))
+		assert.Equal(t, '|', detectDelimiter([]byte("a|b\n1|2\n"), ""))
+		assert.Equal(t, '\t', detectDelimiter([]byte("a,b\n1,2\n"), "data.TSV"))
+		assert.Equal(t, ',', detectDelimiter([]byte("a\n"), ""))
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		data, err := decode([]byte("\xef\xbb\xbfa,b"), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "a,b", string(data))
+
+		data, err = decode([]byte{0xff, 0xfe, 'a', 0, ',', 0, 0x2d, 0x4e}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "a,中", string(data))
+
+		data, err = decode([]byte{0xfe, 0xff, 0, 'a', 0x4e, 0x2d}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "a中", string(data))
+
+		data, err = decode([]byte("caf\xe9"), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "café", string(data))
+
+		data, err = decode([]byte("\xd6\xd0"), func(r io.Reader) io.Reader {
+			return bytes.NewReader([]byte("中"))
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "中", string(data))
+	})
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package csv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// sampleRows is the number of rows sampled to detect the delimiter.
+const sampleRows = 20
+
+var (
+	bomUTF8    = []byte{0xef, 0xbb, 0xbf}
+	bomUTF16LE = []byte{0xff, 0xfe}
+	bomUTF16BE = []byte{0xfe, 0xff}
+
+	candidateDelimiters = []rune{',', '\t', ';', '|'}
+)
+
+// decode converts the content to utf-8 by the BOM, the decoder or latin-1.
+func decode(data []byte, decoder func(r io.Reader) io.Reader) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		return data[len(bomUTF8):], nil
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return decodeUTF16(data[len(bomUTF16LE):], false), nil
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return decodeUTF16(data[len(bomUTF16BE):], true), nil
+	case utf8.Valid(data):
+		return data, nil
+	case decoder != nil:
+		return io.ReadAll(decoder(bytes.NewReader(data)))
+	}
+
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return []byte(string(runes)), nil
+}
+
+func decodeUTF16(data []byte, bigEndian bool) []byte {
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+		} else {
+			units[i] = uint16(data[2*i+1])<<8 | uint16(data[2*i])
+		}
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+// detectDelimiter returns the delimiter that splits the sampled rows into the same number of fields,
+// preferring more fields. The .tsv files are delimited by tab.
+func detectDelimiter(data []byte, uri string) rune {
+	if strings.EqualFold(path.Ext(uri), ".tsv") {
+		return '\t'
+	}
+
+	best, bestFields := candidateDelimiters[0], 0
+	for _, d := range candidateDelimiters {
+		if fields := consistentFields(data, d); fields > bestFields {
+			best, bestFields = d, fields
+		}
+	}
+	return best
+}
+
+// consistentFields returns the number of fields of the sampled rows if they are all the same and greater than 1, or 0.
+func consistentFields(data []byte, delimiter rune) int {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = delimiter
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	fields := 0
+	for i := 0; i < sampleRows; i++ {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil || (fields != 0 && len(row) != fields) {
+			return 0
+		}
+		fields = len(row)
+	}
+	if fields < 2 {
+		return 0
+	}
+	return fields
+}
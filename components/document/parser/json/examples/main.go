@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/document/parser"
+
+	"github.com/cloudwego/eino-ext/components/document/parser/json"
+)
+
+func main() {
+	ctx := context.Background()
+
+	p, err := json.NewParser(ctx, &json.Config{
+		ContentPath: "$.body",
+		IDPath:      "$.id",
+		MetaPaths: map[string]string{
+			"title":  "$.title",
+			"labels": "$.labels[*]",
+			"author": "$.user.login",
+		},
+	})
+	if err != nil {
+		log.Fatalf("json.NewParser failed, err=%v", err)
+	}
+
+	f, err := os.Open("./testdata/issues.jsonl")
+	if err != nil {
+		log.Fatalf("os.Open failed, err=%v", err)
+	}
+	defer f.Close()
+
+	docs, err := p.Parse(ctx, f, parser.WithURI("issues.jsonl"))
+	if err != nil {
+		log.Fatalf("Parse failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("%s: %s\n%v\n\n", doc.ID, doc.Content, doc.MetaData)
+	}
+}
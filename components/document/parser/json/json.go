@@ -0,0 +1,211 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	MetaKeySource = "_source"
+	// MetaKeyIndex is the 0-based index of the record in the file.
+	MetaKeyIndex = "_index"
+)
+
+var _ parser.Parser = (*Parser)(nil)
+
+// Config is the config of json Parser.
+// The paths are JSONPath, e.g. $.data.items[*], $..id, $['a key'][0], see Path for the supported syntax.
+type Config struct {
+	// RecordsPath selects the records in each JSON value of the file, and the selected arrays are expanded to their elements.
+	// Optional. Default: "$", the JSON value itself, or its elements if it is an array.
+	RecordsPath string
+	// ContentPath selects the content in a record, the selected strings are used as is,
+	// other values are encoded as JSON, and multiple values are joined by a newline.
+	// The records without content are skipped.
+	// Optional. Default: the record encoded as JSON.
+	ContentPath string
+	// MetaPaths selects the metadata in a record, keyed by the metadata key.
+	// The value is the selected value, or the []any of the values if multiple are selected, and the key is absent if none.
+	// Optional.
+	MetaPaths map[string]string
+	// IDPath selects the id of the document in a record.
+	// Optional.
+	IDPath string
+}
+
+// NewParser creates a json parser, which parses JSON and JSONL (newline delimited JSON) content,
+// and converts each record to a document.
+func NewParser(ctx context.Context, conf *Config) (*Parser, error) {
+	if conf == nil {
+		conf = &Config{}
+	}
+
+	p := &Parser{metaPaths: make(map[string]*Path, len(conf.MetaPaths))}
+	var err error
+	if p.recordsPath, err = CompilePath(conf.RecordsPath); err != nil {
+		return nil, err
+	}
+	if conf.ContentPath != "" {
+		if p.contentPath, err = CompilePath(conf.ContentPath); err != nil {
+			return nil, err
+		}
+	}
+	if conf.IDPath != "" {
+		if p.idPath, err = CompilePath(conf.IDPath); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range conf.MetaPaths {
+		if p.metaPaths[k], err = CompilePath(v); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Parser parses JSON or JSONL content to documents.
+// The content is a sequence of JSON values, so a JSON file is a single value, and each line of a JSONL file is a value.
+type Parser struct {
+	recordsPath *Path
+	contentPath *Path
+	idPath      *Path
+	metaPaths   map[string]*Path
+}
+
+func (p *Parser) Parse(ctx context.Context, reader io.Reader, opts ...parser.Option) ([]*schema.Document, error) {
+	option := parser.GetCommonOptions(&parser.Options{}, opts...)
+
+	dec := json.NewDecoder(reader)
+	dec.UseNumber()
+
+	var (
+		docs  []*schema.Document
+		index int
+	)
+	for n := 1; ; n++ {
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("invalid json value %d: %w", n, err)
+		}
+
+		for _, node := range p.recordsPath.Select(v) {
+			records := []any{node}
+			if arr, ok := node.([]any); ok {
+				records = arr
+			}
+			for _, record := range records {
+				doc, ok, err := p.toDocument(record, index, option)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					docs = append(docs, doc)
+				}
+				index++
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+func (p *Parser) toDocument(record any, index int, option *parser.Options) (*schema.Document, bool, error) {
+	values := []any{record}
+	if p.contentPath != nil {
+		if values = p.contentPath.Select(record); len(values) == 0 {
+			return nil, false, nil
+		}
+	}
+
+	texts := make([]string, 0, len(values))
+	for _, v := range values {
+		text, err := toText(v)
+		if err != nil {
+			return nil, false, err
+		}
+		texts = append(texts, text)
+	}
+
+	meta := make(map[string]any, len(p.metaPaths)+len(option.ExtraMeta)+2)
+	for k, path := range p.metaPaths {
+		switch values := path.Select(record); len(values) {
+		case 0:
+		case 1:
+			meta[k] = values[0]
+		default:
+			meta[k] = values
+		}
+	}
+	for k, v := range option.ExtraMeta {
+		meta[k] = v
+	}
+	meta[MetaKeySource] = option.URI
+	meta[MetaKeyIndex] = index
+
+	doc := &schema.Document{
+		Content:  strings.Join(texts, "\n"),
+		MetaData: meta,
+	}
+	if p.idPath != nil {
+		if ids := p.idPath.Select(record); len(ids) > 0 {
+			id, err := toText(ids[0])
+			if err != nil {
+				return nil, false, err
+			}
+			doc.ID = id
+		}
+	}
+	return doc, true, nil
+}
+
+// toText returns the string as is, and encodes other values as JSON.
+func toText(v any) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/document/parser"
+)
+
+func TestParser(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("array", func(t *testing.T) {
+		p, err := NewParser(ctx, nil)
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(`[{"b":"<x>","a":1}, "text"]`),
+			parser.WithURI("a.json"), parser.WithExtraMeta(map[string]any{"k": "v"}))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, `{"a":1,"b":"<x>"}`, docs[0].Content)
+		assert.Equal(t, map[string]any{MetaKeySource: "a.json", MetaKeyIndex: 0, "k": "v"}, docs[0].MetaData)
+		assert.Equal(t, "text", docs[1].Content)
+		assert.Equal(t, 1, docs[1].MetaData[MetaKeyIndex])
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{
+			RecordsPath: "$.data.items",
+			ContentPath: "$.body",
+			IDPath:      "id",
+			MetaPaths: map[string]string{
+				"title": "$.title",
+				"tags":  "$.tags[*]",
+				"first": "tags.0",
+				"none":  "$.missing",
+			},
+		})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader(`{"data":{"items":[
+			{"id":7,"title":"a","body":"hello","tags":["x","y"]},
+			{"id":"8","title":"b"},
+			{"id":"9","body":{"k":1.50},"tags":["z"]}
+		]}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+
+		assert.Equal(t, "7", docs[0].ID)
+		assert.Equal(t, "hello", docs[0].Content)
+		assert.Equal(t, map[string]any{
+			MetaKeySource: "",
+			MetaKeyIndex:  0,
+			"title":       "a",
+			"tags":        []any{"x", "y"},
+			"first":       "x",
+		}, docs[0].MetaData)
+
+		assert.Equal(t, "9", docs[1].ID)
+		assert.Equal(t, `{"k":1.50}`, docs[1].Content)
+		assert.Equal(t, map[string]any{MetaKeySource: "", MetaKeyIndex: 2, "tags": "z", "first": "z"}, docs[1].MetaData)
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{ContentPath: "msg", MetaPaths: map[string]string{"level": "level"}})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, strings.NewReader("{\"level\":\"info\",\"msg\":\"started\"}\n\n{\"level\":\"error\",\"msg\":\"failed\"}\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "failed", docs[1].Content)
+		assert.Equal(t, "error", docs[1].MetaData["level"])
+		assert.Equal(t, 1, docs[1].MetaData[MetaKeyIndex])
+
+		_, err = p.Parse(ctx, strings.NewReader("{\"msg\":\"a\"}\n{\"msg\":"))
+		assert.ErrorContains(t, err, "invalid json value 2")
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		_, err := NewParser(ctx, &Config{ContentPath: "$.a[1"})
+		assert.EqualError(t, err, `invalid path "$.a[1": unclosed [ at 2`)
+	})
+}
+
+func TestPath(t *testing.T) {
+	var v any
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"store": {
+			"book": [
+				{"title": "a", "price": 8, "meta": {"id": 1}},
+				{"title": "b", "price": 12, "meta": {"id": 2}}
+			],
+			"the.key": {"id": 3},
+			"x]": 4
+		}
+	}`), &v))
+
+	cases := []struct {
+		path string
+		want []any
+	}{
+		{"$", []any{v}},
+		{"$.store.book[0].title", []any{"a"}},
+		{"store.book.1.title", []any{"b"}},
+		{"$.store.book[-1].price", []any{float64(12)}},
+		{"$.store.book[*].title", []any{"a", "b"}},
+		{"$.store.book.*.meta.id", []any{float64(1), float64(2)}},
+		{"$['store'][\"the.key\"].id", []any{float64(3)}},
+		{"$.store[ 'x]' ]", []any{float64(4)}},
+		{"$..id", []any{float64(1), float64(2), float64(3)}},
+		{"$.store..book[0].meta.id", []any{float64(1)}},
+		{"$.store.book[5]", nil},
+		{"$.store.book.title", nil},
+	}
+	for _, c := range cases {
+		p, err := CompilePath(c.path)
+		assert.NoError(t, err, c.path)
+		assert.Equal(t, c.want, p.Select(v), c.path)
+	}
+
+	for _, path := range []string{"$.a[x]", "$..", "$.a.", "$.a['b]", "$a"} {
+		_, err := CompilePath(path)
+		assert.Error(t, err, path)
+	}
+}
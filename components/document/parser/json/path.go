@@ -0,0 +1,212 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type stepKind int
+
+const (
+	stepKey stepKind = iota
+	stepIndex
+	stepWildcard
+	stepDescendant
+)
+
+// step is a step of a path, which selects the children of the nodes.
+type step struct {
+	kind  stepKind
+	key   string
+	index int
+}
+
+// Path is a compiled JSONPath, e.g. $.data.items[*].title, $..id, $['a key'][0].
+// The leading $ is optional, and a number in the dot notation also selects the element of an array, e.g. items.0.title as gjson does.
+type Path struct {
+	raw   string
+	steps []step
+}
+
+// CompilePath compiles a JSONPath.
+func CompilePath(path string) (*Path, error) {
+	p := &Path{raw: path}
+	s := strings.TrimSpace(path)
+	root := strings.HasPrefix(s, "$")
+	s = strings.TrimPrefix(s, "$")
+
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], ".."):
+			name, n := readName(s[i+2:])
+			if name == "" {
+				return nil, fmt.Errorf("invalid path %q: missing name after .. at %d", path, i)
+			}
+			p.steps = append(p.steps, step{kind: stepDescendant, key: name})
+			i += 2 + n
+		case s[i] == '[':
+			st, n, err := readBracket(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w at %d", path, err, i)
+			}
+			p.steps = append(p.steps, st)
+			i += n
+		default:
+			if s[i] == '.' {
+				i++
+			} else if i != 0 || root {
+				return nil, fmt.Errorf("invalid path %q: unexpected %q at %d", path, s[i], i)
+			}
+			name, n := readName(s[i:])
+			if name == "" {
+				return nil, fmt.Errorf("invalid path %q: missing name at %d", path, i)
+			}
+			if name == "*" {
+				p.steps = append(p.steps, step{kind: stepWildcard})
+			} else {
+				p.steps = append(p.steps, step{kind: stepKey, key: name, index: -1})
+				if idx, err := strconv.Atoi(name); err == nil && idx >= 0 {
+					p.steps[len(p.steps)-1].index = idx
+				}
+			}
+			i += n
+		}
+	}
+	return p, nil
+}
+
+// String returns the path.
+func (p *Path) String() string {
+	return p.raw
+}
+
+// Select returns the nodes selected by the path from the value decoded by encoding/json.
+func (p *Path) Select(v any) []any {
+	nodes := []any{v}
+	for _, st := range p.steps {
+		var next []any
+		for _, node := range nodes {
+			next = st.apply(node, next)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+func (st step) apply(node any, ret []any) []any {
+	switch st.kind {
+	case stepKey:
+		switch n := node.(type) {
+		case map[string]any:
+			if v, ok := n[st.key]; ok {
+				ret = append(ret, v)
+			}
+		case []any:
+			if st.index >= 0 && st.index < len(n) {
+				ret = append(ret, n[st.index])
+			}
+		}
+	case stepIndex:
+		if n, ok := node.([]any); ok {
+			i := st.index
+			if i < 0 {
+				i += len(n)
+			}
+			if i >= 0 && i < len(n) {
+				ret = append(ret, n[i])
+			}
+		}
+	case stepWildcard:
+		switch n := node.(type) {
+		case map[string]any:
+			for _, k := range sortedKeys(n) {
+				ret = append(ret, n[k])
+			}
+		case []any:
+			ret = append(ret, n...)
+		}
+	case stepDescendant:
+		switch n := node.(type) {
+		case map[string]any:
+			if v, ok := n[st.key]; ok {
+				ret = append(ret, v)
+			}
+			for _, k := range sortedKeys(n) {
+				ret = st.apply(n[k], ret)
+			}
+		case []any:
+			for _, v := range n {
+				ret = st.apply(v, ret)
+			}
+		}
+	}
+	return ret
+}
+
+// readName reads a name of the dot notation, which ends at . or [.
+func readName(s string) (string, int) {
+	n := strings.IndexAny(s, ".[")
+	if n < 0 {
+		n = len(s)
+	}
+	return s[:n], n
+}
+
+// readBracket reads a step of the bracket notation, e.g. [0], [-1], [*], ['key'], ["key"].
+func readBracket(s string) (step, int, error) {
+	i := skipSpaces(s, 1)
+	if i < len(s) && (s[i] == '\'' || s[i] == '"') {
+		// the quoted key may contain ] and .
+		closing := strings.IndexByte(s[i+1:], s[i])
+		if closing < 0 {
+			return step{}, 0, fmt.Errorf("unclosed quote")
+		}
+		key := s[i+1 : i+1+closing]
+		i = skipSpaces(s, i+closing+2)
+		if i >= len(s) || s[i] != ']' {
+			return step{}, 0, fmt.Errorf("unclosed [")
+		}
+		return step{kind: stepKey, key: key, index: -1}, i + 1, nil
+	}
+
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return step{}, 0, fmt.Errorf("unclosed [")
+	}
+	inner := strings.TrimSpace(s[1:end])
+	if inner == "*" {
+		return step{kind: stepWildcard}, end + 1, nil
+	}
+	idx, err := strconv.Atoi(inner)
+	if err != nil {
+		return step{}, 0, fmt.Errorf("invalid index %q", inner)
+	}
+	return step{kind: stepIndex, index: idx}, end + 1, nil
+}
+
+func skipSpaces(s string, i int) int {
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaddleOCREngine(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := map[string][]string{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{base64.StdEncoding.EncodeToString([]byte("img"))}, req["images"])
+		if r.URL.Path == "/fail" {
+			_, _ = w.Write([]byte(`{"status":"101","msg":"bad image","results":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"000","msg":"","results":[[{"text":"hello","confidence":0.98,"text_region":[[10,12],[110,10],[112,30],[8,32]]}]]}`))
+	}))
+	defer srv.Close()
+
+	_, err := NewPaddleOCREngine(nil)
+	assert.EqualError(t, err, "paddle ocr url is required")
+
+	engine, err := NewPaddleOCREngine(&PaddleOCRConfig{URL: srv.URL + "/predict/ocr_system"})
+	assert.NoError(t, err)
+	blocks, err := engine.Recognize(ctx, []byte("img"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Block{{Text: "hello", BBox: BBox{X: 8, Y: 10, Width: 104, Height: 22}, Confidence: 0.98}}, blocks)
+
+	engine, err = NewPaddleOCREngine(&PaddleOCRConfig{URL: srv.URL + "/fail"})
+	assert.NoError(t, err)
+	_, err = engine.Recognize(ctx, []byte("img"))
+	assert.EqualError(t, err, "paddle ocr failed, status: 101, msg: bad image")
+}
+
+func TestVolcOCREngine(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "OCRNormal", r.URL.Query().Get("Action"))
+		assert.Equal(t, "2020-08-26", r.URL.Query().Get("Version"))
+		assert.Equal(t, "20250102T030405Z", r.Header.Get("X-Date"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"),
+			"HMAC-SHA256 Credential=ak/20250102/cn-north-1/cv/request, SignedHeaders=content-type;host;x-content-sha256;x-date, Signature="))
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("img")), r.PostForm.Get("image_base64"))
+		_, _ = w.Write([]byte(`{"code":10000,"message":"Success","data":{"line_texts":["hello","world"],"line_probs":[0.9,0.8],"line_rects":[{"x":1,"y":2,"width":30,"height":10},{"x":1,"y":20,"width":40,"height":10}]}}`))
+	}))
+	defer srv.Close()
+
+	_, err := NewVolcOCREngine(&VolcOCRConfig{AccessKey: "ak"})
+	assert.EqualError(t, err, "volc ocr access key and secret key are required")
+
+	engine, err := NewVolcOCREngine(&VolcOCRConfig{AccessKey: "ak", SecretKey: "sk", BaseURL: srv.URL})
+	assert.NoError(t, err)
+	engine.(*volcOCR).now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	blocks, err := engine.Recognize(ctx, []byte("img"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Block{
+		{Text: "hello", BBox: BBox{X: 1, Y: 2, Width: 30, Height: 10}, Confidence: 0.9},
+		{Text: "world", BBox: BBox{X: 1, Y: 20, Width: 40, Height: 10}, Confidence: 0.8},
+	}, blocks)
+}
+
+func TestParseTesseractTSV(t *testing.T) {
+	tsv := "level\tpage_num\tblock_num\tpar_num\tline_num\tword_num\tleft\ttop\twidth\theight\tconf\ttext\n" +
+		"1\t1\t0\t0\t0\t0\t0\t0\t640\t480\t-1\t\n" +
+		"4\t1\t1\t1\t1\t0\t10\t10\t200\t30\t-1\t\n" +
+		"5\t1\t1\t1\t1\t1\t10\t12\t80\t26\t96\tHello\n" +
+		"5\t1\t1\t1\t1\t2\t100\t10\t110\t30\t90\tworld\n" +
+		"5\t1\t1\t1\t2\t1\t10\t50\t60\t20\t80\tBye\n" +
+		"5\t1\t1\t1\t2\t2\t80\t50\t20\t20\t95\t \n"
+
+	blocks, err := parseTesseractTSV(tsv)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Block{
+		{Text: "Hello world", BBox: BBox{X: 10, Y: 10, Width: 200, Height: 30}, Confidence: 0.93},
+		{Text: "Bye", BBox: BBox{X: 10, Y: 50, Width: 60, Height: 20}, Confidence: 0.8},
+	}, blocks)
+
+	_, err = parseTesseractTSV("header\n5\t1\t1\t1\t1\t1\tx\t0\t0\t0\t0\tbad\n")
+	assert.Error(t, err)
+}
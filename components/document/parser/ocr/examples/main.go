@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/document/parser/ocr"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Start a PaddleOCR server with `hub serving start -m ocr_system`,
+	// or use ocr.NewTesseractEngine / ocr.NewVolcOCREngine instead.
+	engine, err := ocr.NewPaddleOCREngine(&ocr.PaddleOCRConfig{
+		URL: os.Getenv("PADDLE_OCR_URL"),
+	})
+	if err != nil {
+		log.Fatalf("ocr.NewPaddleOCREngine failed, err=%v", err)
+	}
+
+	parser, err := ocr.NewParser(ctx, &ocr.Config{
+		Engine:        engine,
+		MinConfidence: 0.5,
+	})
+	if err != nil {
+		log.Fatalf("ocr.NewParser failed, err=%v", err)
+	}
+
+	f, err := os.Open(os.Getenv("OCR_FILE"))
+	if err != nil {
+		log.Fatalf("os.Open failed, err=%v", err)
+	}
+	defer f.Close()
+
+	docs, err := parser.Parse(ctx, f, ocr.WithToBlocks(true))
+	if err != nil {
+		log.Fatalf("parser.Parse failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("page %v bbox %+v: %s", doc.MetaData[ocr.MetaKeyPage], doc.MetaData[ocr.MetaKeyBBox], doc.Content)
+	}
+}
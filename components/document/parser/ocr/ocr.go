@@ -0,0 +1,188 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	// MetaKeyPage is the metadata key of the 1-based page number of the document.
+	MetaKeyPage = "page"
+	// MetaKeyBlocks is the metadata key of the []*Block of a page document.
+	MetaKeyBlocks = "ocr_blocks"
+	// MetaKeyBBox is the metadata key of the BBox of a block document.
+	MetaKeyBBox = "bbox"
+	// MetaKeyConfidence is the metadata key of the confidence of a block document.
+	MetaKeyConfidence = "confidence"
+)
+
+// BBox is the bounding box of a block in pixels of the image, with the origin at the top left.
+type BBox struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Block is a piece of text recognized in an image, usually a line.
+type Block struct {
+	Text string `json:"text"`
+	BBox BBox   `json:"bbox"`
+	// Confidence is the confidence of the recognition from 0 to 1.
+	Confidence float64 `json:"confidence"`
+}
+
+// Engine recognizes the text blocks in an image, e.g. PaddleOCR, Volcengine OCR or Tesseract.
+type Engine interface {
+	Recognize(ctx context.Context, image []byte) ([]*Block, error)
+}
+
+// Rasterizer renders the pages of a PDF to images.
+type Rasterizer interface {
+	Rasterize(ctx context.Context, pdf []byte) ([][]byte, error)
+}
+
+// Config is the configuration for OCR parser.
+type Config struct {
+	// Engine recognizes the text in images.
+	// Required.
+	Engine Engine
+	// Rasterizer renders the pages of scanned PDFs to images.
+	// Optional. Default: NewPDFToPPMRasterizer with 200 DPI, which requires pdftoppm of poppler-utils.
+	Rasterizer Rasterizer
+	// ToBlocks parses each block into a document with its bounding box, instead of a document per page.
+	// Optional. Default: false.
+	ToBlocks bool
+	// MinConfidence drops the blocks with lower confidence, e.g. noise of stamps and signatures.
+	// Optional. Default: 0, keep all blocks.
+	MinConfidence float64
+}
+
+// Parser parses images and scanned PDFs with OCR.
+// Each page is parsed into a document with the text of the blocks joined by lines,
+// and the blocks with bounding boxes in the metadata MetaKeyBlocks.
+type Parser struct {
+	engine        Engine
+	rasterizer    Rasterizer
+	toBlocks      bool
+	minConfidence float64
+}
+
+// NewParser creates a new OCR parser.
+func NewParser(ctx context.Context, config *Config) (*Parser, error) {
+	if config == nil || config.Engine == nil {
+		return nil, errors.New("ocr engine is required")
+	}
+
+	rasterizer := config.Rasterizer
+	if rasterizer == nil {
+		rasterizer = NewPDFToPPMRasterizer(nil)
+	}
+
+	return &Parser{
+		engine:        config.Engine,
+		rasterizer:    rasterizer,
+		toBlocks:      config.ToBlocks,
+		minConfidence: config.MinConfidence,
+	}, nil
+}
+
+// Parse parses the image or the PDF from io.Reader.
+func (p *Parser) Parse(ctx context.Context, reader io.Reader, opts ...parser.Option) (docs []*schema.Document, err error) {
+	commonOpts := parser.GetCommonOptions(nil, opts...)
+	specificOpts := parser.GetImplSpecificOptions(&options{
+		toBlocks: &p.toBlocks,
+	}, opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("ocr parser read all from reader failed: %w", err)
+	}
+
+	var images [][]byte
+	switch {
+	case bytes.HasPrefix(data, []byte("%PDF-")):
+		images, err = p.rasterizer.Rasterize(ctx, data)
+		if err != nil {
+			return nil, fmt.Errorf("rasterize pdf failed: %w", err)
+		}
+	case strings.HasPrefix(http.DetectContentType(data), "image/"):
+		images = [][]byte{data}
+	default:
+		return nil, fmt.Errorf("unsupported content type: %s", http.DetectContentType(data))
+	}
+
+	for i, image := range images {
+		page := i + 1
+		blocks, err := p.engine.Recognize(ctx, image)
+		if err != nil {
+			return nil, fmt.Errorf("recognize page failed: %w, page= %d", err, page)
+		}
+		blocks = p.filter(blocks)
+
+		if *specificOpts.toBlocks {
+			for _, b := range blocks {
+				meta := metaOf(commonOpts.ExtraMeta, page)
+				meta[MetaKeyBBox] = b.BBox
+				meta[MetaKeyConfidence] = b.Confidence
+				docs = append(docs, &schema.Document{Content: b.Text, MetaData: meta})
+			}
+			continue
+		}
+
+		lines := make([]string, 0, len(blocks))
+		for _, b := range blocks {
+			lines = append(lines, b.Text)
+		}
+		meta := metaOf(commonOpts.ExtraMeta, page)
+		meta[MetaKeyBlocks] = blocks
+		docs = append(docs, &schema.Document{Content: strings.Join(lines, "\n"), MetaData: meta})
+	}
+
+	return docs, nil
+}
+
+func (p *Parser) filter(blocks []*Block) []*Block {
+	ret := make([]*Block, 0, len(blocks))
+	for _, b := range blocks {
+		if strings.TrimSpace(b.Text) == "" || b.Confidence < p.minConfidence {
+			continue
+		}
+		ret = append(ret, b)
+	}
+	return ret
+}
+
+// metaOf copies the extra metadata, so that the documents don't share the map.
+func metaOf(extra map[string]any, page int) map[string]any {
+	meta := make(map[string]any, len(extra)+2)
+	for k, v := range extra {
+		meta[k] = v
+	}
+	meta[MetaKeyPage] = page
+	return meta
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+var pngHeader = []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR")
+
+type mockEngine struct {
+	blocks map[string][]*Block
+	err    error
+}
+
+func (m *mockEngine) Recognize(ctx context.Context, image []byte) ([]*Block, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.blocks[string(image)], nil
+}
+
+type mockRasterizer struct {
+	pages [][]byte
+}
+
+func (m *mockRasterizer) Rasterize(ctx context.Context, pdf []byte) ([][]byte, error) {
+	return m.pages, nil
+}
+
+func TestParser_Parse(t *testing.T) {
+	ctx := context.Background()
+	page1 := append(append([]byte{}, pngHeader...), '1')
+	page2 := append(append([]byte{}, pngHeader...), '2')
+	engine := &mockEngine{blocks: map[string][]*Block{
+		string(page1): {
+			{Text: "Invoice", BBox: BBox{X: 10, Y: 10, Width: 100, Height: 20}, Confidence: 0.99},
+			{Text: "~", BBox: BBox{X: 300, Y: 300, Width: 5, Height: 5}, Confidence: 0.2},
+			{Text: "Total: 42", BBox: BBox{X: 10, Y: 40, Width: 80, Height: 20}, Confidence: 0.95},
+		},
+		string(page2): {
+			{Text: " ", Confidence: 0.9},
+			{Text: "Thanks", BBox: BBox{X: 10, Y: 10, Width: 60, Height: 20}, Confidence: 0.9},
+		},
+	}}
+
+	t.Run("engine required", func(t *testing.T) {
+		_, err := NewParser(ctx, &Config{})
+		assert.EqualError(t, err, "ocr engine is required")
+	})
+
+	t.Run("image", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{Engine: engine, MinConfidence: 0.5})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, bytes.NewReader(page1), parser.WithExtraMeta(map[string]any{"source": "scan"}))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(docs))
+		assert.Equal(t, "Invoice\nTotal: 42", docs[0].Content)
+		assert.Equal(t, "scan", docs[0].MetaData["source"])
+		assert.Equal(t, 1, docs[0].MetaData[MetaKeyPage])
+		assert.Equal(t, 2, len(docs[0].MetaData[MetaKeyBlocks].([]*Block)))
+	})
+
+	t.Run("pdf to blocks", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{
+			Engine:        engine,
+			Rasterizer:    &mockRasterizer{pages: [][]byte{page1, page2}},
+			MinConfidence: 0.5,
+		})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, bytes.NewReader([]byte("%PDF-1.4 scanned")), WithToBlocks(true))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(docs))
+		assert.Equal(t, "Total: 42", docs[1].Content)
+		assert.Equal(t, BBox{X: 10, Y: 40, Width: 80, Height: 20}, docs[1].MetaData[MetaKeyBBox])
+		assert.Equal(t, 0.95, docs[1].MetaData[MetaKeyConfidence])
+		assert.Equal(t, "Thanks", docs[2].Content)
+		assert.Equal(t, 2, docs[2].MetaData[MetaKeyPage])
+	})
+
+	t.Run("unsupported content", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{Engine: engine})
+		assert.NoError(t, err)
+
+		_, err = p.Parse(ctx, bytes.NewReader([]byte("plain text")))
+		assert.EqualError(t, err, "unsupported content type: text/plain; charset=utf-8")
+	})
+
+	t.Run("engine error", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{Engine: &mockEngine{err: errors.New("boom")}})
+		assert.NoError(t, err)
+
+		_, err = p.Parse(ctx, bytes.NewReader(page1))
+		assert.EqualError(t, err, "recognize page failed: boom, page= 1")
+	})
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"time"
+)
+
+// PaddleOCRConfig is the configuration of the PaddleOCR engine.
+type PaddleOCRConfig struct {
+	// URL is the prediction url of the PaddleOCR hub serving, e.g. "http://127.0.0.1:8868/predict/ocr_system".
+	// Required.
+	URL string
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default: &http.Client{Timeout: 60 * time.Second}.
+	HTTPClient *http.Client
+}
+
+// NewPaddleOCREngine creates an engine recognizing text with a PaddleOCR server started by `hub serving start -m ocr_system`.
+func NewPaddleOCREngine(config *PaddleOCRConfig) (Engine, error) {
+	if config == nil || config.URL == "" {
+		return nil, errors.New("paddle ocr url is required")
+	}
+	cli := config.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &paddleOCR{url: config.URL, cli: cli}, nil
+}
+
+type paddleOCR struct {
+	url string
+	cli *http.Client
+}
+
+type paddleResponse struct {
+	Msg     string `json:"msg"`
+	Status  string `json:"status"`
+	Results [][]struct {
+		Text       string       `json:"text"`
+		Confidence float64      `json:"confidence"`
+		TextRegion [][2]float64 `json:"text_region"`
+	} `json:"results"`
+}
+
+func (p *paddleOCR) Recognize(ctx context.Context, image []byte) ([]*Block, error) {
+	body, err := json.Marshal(map[string][]string{"images": {base64.StdEncoding.EncodeToString(image)}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, data)
+	}
+
+	result := &paddleResponse{}
+	if err = json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	if result.Status != "000" {
+		return nil, fmt.Errorf("paddle ocr failed, status: %s, msg: %s", result.Status, result.Msg)
+	}
+
+	var blocks []*Block
+	for _, image := range result.Results {
+		for _, r := range image {
+			blocks = append(blocks, &Block{
+				Text:       r.Text,
+				BBox:       bboxOf(r.TextRegion),
+				Confidence: r.Confidence,
+			})
+		}
+	}
+	return blocks, nil
+}
+
+// bboxOf returns the bounding box of the polygon.
+func bboxOf(points [][2]float64) BBox {
+	if len(points) == 0 {
+		return BBox{}
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, pt := range points {
+		minX, maxX = math.Min(minX, pt[0]), math.Max(maxX, pt[0])
+		minY, maxY = math.Min(minY, pt[1]), math.Max(maxY, pt[1])
+	}
+	return BBox{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// PDFToPPMConfig is the configuration of the pdftoppm rasterizer.
+type PDFToPPMConfig struct {
+	// Binary is the path of pdftoppm.
+	// Optional. Default: "pdftoppm".
+	Binary string
+	// DPI is the resolution of the images, higher DPI improves the recognition of small text but is slower.
+	// Optional. Default: 200.
+	DPI int
+}
+
+// NewPDFToPPMRasterizer creates a rasterizer rendering PDF pages to PNG images with pdftoppm of poppler-utils.
+func NewPDFToPPMRasterizer(config *PDFToPPMConfig) Rasterizer {
+	c := PDFToPPMConfig{}
+	if config != nil {
+		c = *config
+	}
+	if c.Binary == "" {
+		c.Binary = "pdftoppm"
+	}
+	if c.DPI <= 0 {
+		c.DPI = 200
+	}
+	return &pdfToPPM{config: c}
+}
+
+type pdfToPPM struct {
+	config PDFToPPMConfig
+}
+
+func (p *pdfToPPM) Rasterize(ctx context.Context, pdf []byte) ([][]byte, error) {
+	dir, err := os.MkdirTemp("", "eino-ocr-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.config.Binary, "-png", "-r", strconv.Itoa(p.config.DPI), "-", filepath.Join(dir, "page"))
+	cmd.Stdin = bytes.NewReader(pdf)
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pdftoppm failed: %w: %s", err, stderr.String())
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "page-*.png"))
+	if err != nil {
+		return nil, err
+	}
+	// the page numbers are zero padded to the same width, e.g. page-01.png to page-12.png
+	sort.Strings(files)
+
+	images := make([][]byte, 0, len(files))
+	for _, f := range files {
+		image, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// TesseractConfig is the configuration of the Tesseract engine.
+type TesseractConfig struct {
+	// Binary is the path of tesseract.
+	// Optional. Default: "tesseract".
+	Binary string
+	// Languages are the languages of the text, e.g. "eng", "chi_sim", the traineddata must be installed.
+	// Optional. Default: "eng".
+	Languages []string
+}
+
+// NewTesseractEngine creates an engine recognizing text with the tesseract command line, version 4 or later.
+func NewTesseractEngine(config *TesseractConfig) Engine {
+	c := TesseractConfig{}
+	if config != nil {
+		c = *config
+	}
+	if c.Binary == "" {
+		c.Binary = "tesseract"
+	}
+	if len(c.Languages) == 0 {
+		c.Languages = []string{"eng"}
+	}
+	return &tesseract{config: c}
+}
+
+type tesseract struct {
+	config TesseractConfig
+}
+
+func (t *tesseract) Recognize(ctx context.Context, image []byte) ([]*Block, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.config.Binary, "stdin", "stdout", "-l", strings.Join(t.config.Languages, "+"), "tsv")
+	cmd.Stdin = bytes.NewReader(image)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("tesseract failed: %w: %s", err, stderr.String())
+	}
+	return parseTesseractTSV(stdout.String())
+}
+
+// parseTesseractTSV merges the words of the tsv output of tesseract into line blocks.
+// The columns are: level page_num block_num par_num line_num word_num left top width height conf text.
+func parseTesseractTSV(tsv string) ([]*Block, error) {
+	type line struct {
+		block      *Block
+		words      []string
+		confidence float64
+		right      float64
+		bottom     float64
+	}
+
+	var (
+		lines []*line
+		index = make(map[string]*line)
+	)
+	scanner := bufio.NewScanner(strings.NewReader(tsv))
+	for first := true; scanner.Scan(); first = false {
+		if first {
+			continue // header
+		}
+		cols := strings.SplitN(scanner.Text(), "\t", 12)
+		// level 5 rows are words
+		if len(cols) < 12 || cols[0] != "5" || strings.TrimSpace(cols[11]) == "" {
+			continue
+		}
+
+		nums := make([]float64, 5)
+		for i, col := range []string{cols[6], cols[7], cols[8], cols[9], cols[10]} {
+			v, err := strconv.ParseFloat(col, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid tesseract tsv row %q: %w", scanner.Text(), err)
+			}
+			nums[i] = v
+		}
+		left, top, width, height, conf := nums[0], nums[1], nums[2], nums[3], nums[4]
+
+		key := strings.Join(cols[1:5], ".")
+		l, ok := index[key]
+		if !ok {
+			l = &line{block: &Block{BBox: BBox{X: left, Y: top}}}
+			index[key] = l
+			lines = append(lines, l)
+		}
+		l.words = append(l.words, cols[11])
+		l.confidence += conf
+		if left < l.block.BBox.X {
+			l.block.BBox.X = left
+		}
+		if top < l.block.BBox.Y {
+			l.block.BBox.Y = top
+		}
+		if left+width > l.right {
+			l.right = left + width
+		}
+		if top+height > l.bottom {
+			l.bottom = top + height
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*Block, 0, len(lines))
+	for _, l := range lines {
+		l.block.Text = strings.Join(l.words, " ")
+		// tesseract confidence is from 0 to 100
+		l.block.Confidence = l.confidence / float64(len(l.words)) / 100
+		l.block.BBox.Width = l.right - l.block.BBox.X
+		l.block.BBox.Height = l.bottom - l.block.BBox.Y
+		blocks = append(blocks, l.block)
+	}
+	return blocks, nil
+}
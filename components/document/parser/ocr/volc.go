@@ -0,0 +1,200 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ocr
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	volcOCRDefaultBaseURL = "https://visual.volcengineapi.com"
+	volcOCRRegion         = "cn-north-1"
+	volcOCRService        = "cv"
+	volcOCRVersion        = "2020-08-26"
+
+	// volcOCRSuccess is the code of a successful recognition.
+	volcOCRSuccess = 10000
+)
+
+// VolcOCRConfig is the configuration of the Volcengine OCR engine.
+type VolcOCRConfig struct {
+	// AccessKey and SecretKey are the credentials of the Volcengine account.
+	// Required.
+	AccessKey string
+	SecretKey string
+	// Action is the OCR API.
+	// Optional. Default: "OCRNormal", the general text recognition.
+	Action string
+	// BaseURL is the url of the visual API.
+	// Optional. Default: "https://visual.volcengineapi.com".
+	BaseURL string
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default: &http.Client{Timeout: 60 * time.Second}.
+	HTTPClient *http.Client
+}
+
+// NewVolcOCREngine creates an engine recognizing text with the Volcengine OCR API.
+func NewVolcOCREngine(config *VolcOCRConfig) (Engine, error) {
+	if config == nil || config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("volc ocr access key and secret key are required")
+	}
+
+	c := *config
+	if c.Action == "" {
+		c.Action = "OCRNormal"
+	}
+	if c.BaseURL == "" {
+		c.BaseURL = volcOCRDefaultBaseURL
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &volcOCR{config: c, now: time.Now}, nil
+}
+
+type volcOCR struct {
+	config VolcOCRConfig
+	now    func() time.Time
+}
+
+type volcOCRResponse struct {
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id"`
+	Data      struct {
+		LineTexts []string  `json:"line_texts"`
+		LineProbs []float64 `json:"line_probs"`
+		LineRects []BBox    `json:"line_rects"`
+	} `json:"data"`
+}
+
+func (v *volcOCR) Recognize(ctx context.Context, image []byte) ([]*Block, error) {
+	query := url.Values{}
+	query.Set("Action", v.config.Action)
+	query.Set("Version", volcOCRVersion)
+	form := url.Values{}
+	form.Set("image_base64", base64.StdEncoding.EncodeToString(image))
+	body := form.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.config.BaseURL+"/?"+query.Encode(), strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	v.sign(req, []byte(body))
+
+	resp, err := v.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response failed: %w", err)
+	}
+
+	result := &volcOCRResponse{}
+	if err = json.Unmarshal(data, result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, data)
+		}
+		return nil, fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	if result.Code != volcOCRSuccess {
+		return nil, fmt.Errorf("volc ocr failed, code: %d, message: %s, request_id: %s", result.Code, result.Message, result.RequestID)
+	}
+
+	blocks := make([]*Block, 0, len(result.Data.LineTexts))
+	for i, text := range result.Data.LineTexts {
+		b := &Block{Text: text, Confidence: 1}
+		if i < len(result.Data.LineRects) {
+			b.BBox = result.Data.LineRects[i]
+		}
+		if i < len(result.Data.LineProbs) {
+			b.Confidence = result.Data.LineProbs[i]
+		}
+		blocks = append(blocks, b)
+	}
+	return blocks, nil
+}
+
+// sign signs the request with the HMAC-SHA256 signature of Volcengine OpenAPI.
+func (v *volcOCR) sign(req *http.Request, body []byte) {
+	now := v.now().UTC()
+	xDate := now.Format("20060102T150405Z")
+	date := xDate[:8]
+	payloadHash := hashHex(body)
+
+	req.Header.Set("X-Date", xDate)
+	req.Header.Set("X-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-content-sha256;x-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-content-sha256:" + payloadHash + "\n" +
+		"x-date:" + xDate + "\n"
+	path := req.URL.Path
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		// url.Values.Encode sorts the query by key
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + volcOCRRegion + "/" + volcOCRService + "/request"
+	stringToSign := "HMAC-SHA256\n" + xDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte(v.config.SecretKey), date)
+	key = hmacSHA256(key, volcOCRRegion)
+	key = hmacSHA256(key, volcOCRService)
+	key = hmacSHA256(key, "request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		v.config.AccessKey, scope, signedHeaders, signature))
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
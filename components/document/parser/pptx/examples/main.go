@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/document/parser"
+
+	"github.com/cloudwego/eino-ext/components/document/parser/pptx"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalf("usage: %s <file.pptx>", os.Args[0])
+	}
+	ctx := context.Background()
+
+	p, err := pptx.NewParser(ctx, &pptx.Config{
+		SkipHidden: true,
+	})
+	if err != nil {
+		log.Fatalf("pptx.NewParser failed, err=%v", err)
+	}
+
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		log.Fatalf("os.Open failed, err=%v", err)
+	}
+	defer f.Close()
+
+	docs, err := p.Parse(ctx, f, parser.WithExtraMeta(map[string]any{"file": os.Args[1]}))
+	if err != nil {
+		log.Fatalf("Parse failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("slide %v: %v\n%s\nnotes: %v\n\n",
+			doc.MetaData[pptx.MetaKeySlide], doc.MetaData[pptx.MetaKeyTitle], doc.Content, doc.MetaData[pptx.MetaKeyNotes])
+	}
+}
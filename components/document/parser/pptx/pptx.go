@@ -0,0 +1,241 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	// MetaKeySlide is the metadata key of the 1-based slide number of the document.
+	MetaKeySlide = "slide"
+	// MetaKeyTitle is the metadata key of the title of the slide, absent if the slide has no title.
+	MetaKeyTitle = "title"
+	// MetaKeyNotes is the metadata key of the speaker notes of the slide, absent if the slide has no notes.
+	MetaKeyNotes = "notes"
+	// MetaKeyHidden is the metadata key of whether the slide is hidden in the slide show.
+	MetaKeyHidden = "hidden"
+)
+
+const (
+	relTypeNotesSlide = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/notesSlide"
+)
+
+var slidePathRegexp = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+
+var _ parser.Parser = (*Parser)(nil)
+
+// Config is the config of pptx Parser.
+type Config struct {
+	// SkipHidden skips the slides hidden in the slide show.
+	SkipHidden bool
+	// NotesInContent appends the speaker notes to the content, in addition to the metadata.
+	NotesInContent bool
+}
+
+// NewParser creates a pptx parser.
+func NewParser(ctx context.Context, conf *Config) (*Parser, error) {
+	if conf == nil {
+		conf = &Config{}
+	}
+	return &Parser{conf: conf}, nil
+}
+
+// Parser parses PowerPoint .pptx files, and emits a document per slide.
+// The content is the text of the slide, with the title as a markdown heading and the tables as markdown tables.
+type Parser struct {
+	conf *Config
+}
+
+func (p *Parser) Parse(ctx context.Context, reader io.Reader, opts ...parser.Option) ([]*schema.Document, error) {
+	commonOpts := parser.GetCommonOptions(nil, opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pptx: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	slides, err := slidePaths(files)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]*schema.Document, 0, len(slides))
+	for i, name := range slides {
+		s, err := parseSlide(files, name)
+		if err != nil {
+			return nil, fmt.Errorf("parse slide %d failed: %w", i+1, err)
+		}
+		if s.hidden && p.conf.SkipHidden {
+			continue
+		}
+
+		sb := &strings.Builder{}
+		if s.title != "" {
+			sb.WriteString("# " + s.title)
+		}
+		for _, b := range s.body {
+			if sb.Len() > 0 {
+				sb.WriteString("\n\n")
+			}
+			sb.WriteString(b)
+		}
+		if p.conf.NotesInContent && s.notes != "" {
+			if sb.Len() > 0 {
+				sb.WriteString("\n\n")
+			}
+			sb.WriteString("Notes:\n" + s.notes)
+		}
+
+		meta := make(map[string]any, len(commonOpts.ExtraMeta)+4)
+		for k, v := range commonOpts.ExtraMeta {
+			meta[k] = v
+		}
+		meta[MetaKeySlide] = i + 1
+		meta[MetaKeyHidden] = s.hidden
+		if s.title != "" {
+			meta[MetaKeyTitle] = s.title
+		}
+		if s.notes != "" {
+			meta[MetaKeyNotes] = s.notes
+		}
+
+		docs = append(docs, &schema.Document{
+			Content:  sb.String(),
+			MetaData: meta,
+		})
+	}
+
+	return docs, nil
+}
+
+// slidePaths returns the paths of the slides in the order of the presentation,
+// or in the order of the slide numbers of the file names if the presentation has no slide list.
+func slidePaths(files map[string]*zip.File) ([]string, error) {
+	pres := &struct {
+		SlideIDs []struct {
+			RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sldIdLst>sldId"`
+	}{}
+	if err := readXML(files, "ppt/presentation.xml", pres); err != nil {
+		return nil, err
+	}
+	rels, err := readRels(files, "ppt/presentation.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	for _, id := range pres.SlideIDs {
+		if target, ok := rels[id.RID]; ok {
+			if _, ok = files[target.path]; ok {
+				ret = append(ret, target.path)
+			}
+		}
+	}
+	if len(ret) > 0 {
+		return ret, nil
+	}
+
+	nums := make(map[string]int)
+	for name := range files {
+		if m := slidePathRegexp.FindStringSubmatch(name); m != nil {
+			nums[name], _ = strconv.Atoi(m[1])
+			ret = append(ret, name)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return nums[ret[i]] < nums[ret[j]]
+	})
+	return ret, nil
+}
+
+type relationship struct {
+	typ  string
+	path string
+}
+
+// readRels reads the relationships of the part, keyed by the relationship id.
+// The targets are resolved to the paths in the package.
+func readRels(files map[string]*zip.File, part string) (map[string]relationship, error) {
+	rels := &struct {
+		Relationships []struct {
+			ID         string `xml:"Id,attr"`
+			Type       string `xml:"Type,attr"`
+			Target     string `xml:"Target,attr"`
+			TargetMode string `xml:"TargetMode,attr"`
+		} `xml:"Relationship"`
+	}{}
+	dir, base := path.Split(part)
+	if err := readXML(files, dir+"_rels/"+base+".rels", rels); err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]relationship, len(rels.Relationships))
+	for _, r := range rels.Relationships {
+		if r.TargetMode == "External" {
+			continue
+		}
+		p := r.Target
+		if strings.HasPrefix(p, "/") {
+			p = strings.TrimPrefix(p, "/")
+		} else {
+			p = path.Join(dir, p)
+		}
+		ret[r.ID] = relationship{typ: r.Type, path: p}
+	}
+	return ret, nil
+}
+
+// readXML decodes the part into v, and leaves v unchanged if the part does not exist.
+func readXML(files map[string]*zip.File, name string, v any) error {
+	f, ok := files[name]
+	if !ok {
+		return nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/document/parser"
+)
+
+const (
+	nsDecl = `xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" ` +
+		`xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main"`
+
+	presentation = `<p:presentation ` + nsDecl + `><p:sldIdLst>
+<p:sldId id="256" r:id="rId3"/><p:sldId id="257" r:id="rId2"/><p:sldId id="258" r:id="rId4"/>
+</p:sldIdLst></p:presentation>`
+
+	presentationRels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide" Target="slides/slide1.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide" Target="/ppt/slides/slide2.xml"/>
+<Relationship Id="rId4" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide" Target="slides/slide3.xml"/>
+</Relationships>`
+
+	// slide2 is the first slide in the presentation
+	slide2 = `<p:sld ` + nsDecl + `><p:cSld><p:spTree>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="ctrTitle"/></p:nvPr></p:nvSpPr>
+<p:txBody><a:p><a:r><a:t>Eino </a:t></a:r><a:r><a:t>Overview</a:t></a:r></a:p></p:txBody></p:sp>
+<p:sp><p:nvSpPr><p:nvPr><p:ph idx="1"/></p:nvPr></p:nvSpPr>
+<p:txBody><a:p><a:r><a:t>Components</a:t></a:r><a:br/><a:r><a:t>and graphs</a:t></a:r></a:p><a:p></a:p>
+<a:p><a:r><a:t>Tools</a:t></a:r></a:p></p:txBody></p:sp>
+<p:grpSp><p:sp><p:nvSpPr><p:nvPr/></p:nvSpPr><p:txBody><a:p><a:r><a:t>Grouped</a:t></a:r></a:p></p:txBody></p:sp></p:grpSp>
+<p:graphicFrame><a:graphic><a:graphicData><a:tbl>
+<a:tr><a:tc><a:txBody><a:p><a:r><a:t>Name</a:t></a:r></a:p></a:txBody></a:tc><a:tc><a:txBody><a:p><a:r><a:t>Kind</a:t></a:r></a:p></a:txBody></a:tc></a:tr>
+<a:tr><a:tc><a:txBody><a:p><a:r><a:t>ChatModel</a:t></a:r></a:p></a:txBody></a:tc><a:tc><a:txBody><a:p><a:r><a:t>a|b</a:t></a:r></a:p></a:txBody></a:tc></a:tr>
+</a:tbl></a:graphicData></a:graphic></p:graphicFrame>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="sldNum"/></p:nvPr></p:nvSpPr><p:txBody><a:p><a:fld><a:t>1</a:t></a:fld></a:p></p:txBody></p:sp>
+</p:spTree></p:cSld></p:sld>`
+
+	slide2Rels = `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/notesSlide" Target="../notesSlides/notesSlide1.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="https://example.com" TargetMode="External"/>
+</Relationships>`
+
+	notesSlide1 = `<p:notes ` + nsDecl + `><p:cSld><p:spTree>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="sldImg"/></p:nvPr></p:nvSpPr></p:sp>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="body" idx="1"/></p:nvPr></p:nvSpPr>
+<p:txBody><a:p><a:r><a:t>Say hello.</a:t></a:r></a:p><a:p><a:r><a:t>Then demo.</a:t></a:r></a:p></p:txBody></p:sp>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="sldNum" idx="5"/></p:nvPr></p:nvSpPr><p:txBody><a:p><a:r><a:t>1</a:t></a:r></a:p></p:txBody></p:sp>
+</p:spTree></p:cSld></p:notes>`
+
+	slide1 = `<p:sld ` + nsDecl + ` show="0"><p:cSld><p:spTree>
+<p:sp><p:nvSpPr><p:nvPr/></p:nvSpPr><p:txBody><a:p><a:r><a:t>Hidden</a:t></a:r></a:p></p:txBody></p:sp>
+</p:spTree></p:cSld></p:sld>`
+
+	slide3 = `<p:sld ` + nsDecl + `><p:cSld><p:spTree>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="title"/></p:nvPr></p:nvSpPr><p:txBody><a:p><a:r><a:t>Thanks</a:t></a:r></a:p></p:txBody></p:sp>
+</p:spTree></p:cSld></p:sld>`
+)
+
+func newPPTX(t *testing.T, parts map[string]string) *bytes.Reader {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestParser(t *testing.T) {
+	ctx := context.Background()
+	parts := map[string]string{
+		"ppt/presentation.xml":                  presentation,
+		"ppt/_rels/presentation.xml.rels":       presentationRels,
+		"ppt/slides/slide1.xml":                 slide1,
+		"ppt/slides/slide2.xml":                 slide2,
+		"ppt/slides/_rels/slide2.xml.rels":      slide2Rels,
+		"ppt/slides/slide3.xml":                 slide3,
+		"ppt/notesSlides/notesSlide1.xml":       notesSlide1,
+		"ppt/notesSlides/_rels/notesSlide1.xml": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"/>`,
+	}
+
+	t.Run("default", func(t *testing.T) {
+		p, err := NewParser(ctx, nil)
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, newPPTX(t, parts), parser.WithExtraMeta(map[string]any{"k": "v"}))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(docs))
+
+		assert.Equal(t, "# Eino Overview\n\nComponents\nand graphs\n\nTools\n\nGrouped\n\n"+
+			"| Name | Kind |\n| --- | --- |\n| ChatModel | a\\|b |", docs[0].Content)
+		assert.Equal(t, map[string]any{
+			MetaKeySlide:  1,
+			MetaKeyTitle:  "Eino Overview",
+			MetaKeyNotes:  "Say hello.\nThen demo.",
+			MetaKeyHidden: false,
+			"k":           "v",
+		}, docs[0].MetaData)
+
+		assert.Equal(t, "Hidden", docs[1].Content)
+		assert.Equal(t, map[string]any{MetaKeySlide: 2, MetaKeyHidden: true, "k": "v"}, docs[1].MetaData)
+
+		assert.Equal(t, "# Thanks", docs[2].Content)
+		assert.Equal(t, 3, docs[2].MetaData[MetaKeySlide])
+	})
+
+	t.Run("skip hidden and notes in content", func(t *testing.T) {
+		p, err := NewParser(ctx, &Config{SkipHidden: true, NotesInContent: true})
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, newPPTX(t, parts))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "Tools\n\nGrouped\n\n| Name | Kind |\n| --- | --- |\n| ChatModel | a\\|b |\n\nNotes:\nSay hello.\nThen demo.",
+			docs[0].Content[len("# Eino Overview\n\nComponents\nand graphs\n\n"):])
+		assert.Equal(t, 3, docs[1].MetaData[MetaKeySlide])
+	})
+
+	t.Run("without presentation", func(t *testing.T) {
+		p, err := NewParser(ctx, nil)
+		assert.NoError(t, err)
+
+		docs, err := p.Parse(ctx, newPPTX(t, map[string]string{
+			"ppt/slides/slide10.xml": slide3,
+			"ppt/slides/slide2.xml":  slide1,
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, "Hidden", docs[0].Content)
+		assert.Equal(t, "# Thanks", docs[1].Content)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		p, err := NewParser(ctx, nil)
+		assert.NoError(t, err)
+
+		_, err = p.Parse(ctx, bytes.NewReader([]byte("not a zip")))
+		assert.ErrorContains(t, err, "invalid pptx")
+
+		_, err = p.Parse(ctx, newPPTX(t, map[string]string{"ppt/slides/slide1.xml": "<p:sld><a:p>"}))
+		assert.ErrorContains(t, err, "parse slide 1 failed")
+	})
+}
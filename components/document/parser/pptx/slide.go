@@ -0,0 +1,242 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pptx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const nsDrawingML = "http://schemas.openxmlformats.org/drawingml/2006/main"
+
+// skippedPlaceholders are the placeholders not in the content, e.g. the slide number and the footer.
+var skippedPlaceholders = map[string]bool{
+	"sldNum": true,
+	"dt":     true,
+	"ftr":    true,
+	"hdr":    true,
+	"sldImg": true,
+}
+
+type slide struct {
+	title  string
+	body   []string
+	notes  string
+	hidden bool
+}
+
+// block is a paragraph of a shape, or a table rendered as markdown.
+type block struct {
+	// placeholder is the placeholder type of the shape, empty if the shape is not a placeholder,
+	// or is a placeholder of the default type.
+	placeholder string
+	text        string
+}
+
+func parseSlide(files map[string]*zip.File, name string) (*slide, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", name)
+	}
+	blocks, hidden, err := parseBlocks(f)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &slide{hidden: hidden}
+	var titles []string
+	for _, b := range blocks {
+		switch {
+		case b.placeholder == "title" || b.placeholder == "ctrTitle":
+			titles = append(titles, b.text)
+		case !skippedPlaceholders[b.placeholder]:
+			s.body = append(s.body, b.text)
+		}
+	}
+	s.title = strings.Join(titles, " ")
+
+	rels, err := readRels(files, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rels {
+		if r.typ != relTypeNotesSlide {
+			continue
+		}
+		nf, ok := files[r.path]
+		if !ok {
+			continue
+		}
+		blocks, _, err = parseBlocks(nf)
+		if err != nil {
+			return nil, err
+		}
+		var notes []string
+		for _, b := range blocks {
+			if b.placeholder == "body" {
+				notes = append(notes, b.text)
+			}
+		}
+		s.notes = strings.Join(notes, "\n")
+	}
+
+	return s, nil
+}
+
+// parseBlocks returns the text blocks of a slide or a notes slide in the document order,
+// and whether the slide is hidden.
+func parseBlocks(f *zip.File) (blocks []*block, hidden bool, err error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, false, err
+	}
+	defer rc.Close()
+
+	var (
+		placeholder string
+		para        strings.Builder
+		// the rows of the table being parsed
+		table [][]string
+		inTbl bool
+		row   []string
+		cell  []string
+	)
+
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid %s: %w", f.Name, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "sld":
+				hidden = attr(t, "show") == "0"
+			case "sp", "graphicFrame":
+				placeholder = ""
+			case "ph":
+				placeholder = attr(t, "type")
+				if placeholder == "" {
+					placeholder = "body"
+				}
+			}
+			if t.Name.Space != nsDrawingML {
+				continue
+			}
+			switch t.Name.Local {
+			case "tbl":
+				inTbl, table = true, nil
+			case "tr":
+				row = nil
+			case "tc":
+				cell = nil
+			case "p":
+				para.Reset()
+			case "t":
+				var s string
+				if err = dec.DecodeElement(&s, &t); err != nil {
+					return nil, false, fmt.Errorf("invalid %s: %w", f.Name, err)
+				}
+				para.WriteString(s)
+			case "br":
+				para.WriteString("\n")
+			case "tab":
+				para.WriteString("\t")
+			}
+		case xml.EndElement:
+			if t.Name.Space != nsDrawingML {
+				continue
+			}
+			switch t.Name.Local {
+			case "p":
+				text := strings.TrimSpace(para.String())
+				if text == "" {
+					continue
+				}
+				if inTbl {
+					cell = append(cell, text)
+				} else {
+					blocks = append(blocks, &block{placeholder: placeholder, text: text})
+				}
+			case "tc":
+				row = append(row, strings.Join(cell, " "))
+			case "tr":
+				table = append(table, row)
+			case "tbl":
+				inTbl = false
+				if text := markdownTable(table); text != "" {
+					blocks = append(blocks, &block{placeholder: placeholder, text: text})
+				}
+			}
+		}
+	}
+
+	return blocks, hidden, nil
+}
+
+// markdownTable renders the rows as a markdown table with the first row as the header.
+func markdownTable(rows [][]string) string {
+	cols := 0
+	for _, r := range rows {
+		if len(r) > cols {
+			cols = len(r)
+		}
+	}
+	if cols == 0 {
+		return ""
+	}
+
+	sb := &strings.Builder{}
+	writeRow := func(r []string) {
+		sb.WriteString("|")
+		for i := 0; i < cols; i++ {
+			v := ""
+			if i < len(r) {
+				v = strings.ReplaceAll(strings.ReplaceAll(r[i], "|", `\|`), "\n", " ")
+			}
+			sb.WriteString(" " + v + " |")
+		}
+	}
+	for i, r := range rows {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		writeRow(r)
+		if i == 0 {
+			sb.WriteString("\n|" + strings.Repeat(" --- |", cols))
+		}
+	}
+	return sb.String()
+}
+
+func attr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dedup
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+// Method is the method to detect the near-duplicate documents.
+type Method string
+
+const (
+	// MethodMinHash estimates the jaccard similarity of the shingles, which suits chunks of any length.
+	MethodMinHash Method = "minhash"
+	// MethodSimHash compares the hamming distance of the 64-bit simhash, which is cheaper but coarser for short chunks.
+	MethodSimHash Method = "simhash"
+)
+
+// Strategy is the way to handle the duplicates.
+type Strategy string
+
+const (
+	// StrategyKeepFirst keeps the first document of the duplicates, and drops the others.
+	StrategyKeepFirst Strategy = "keep_first"
+	// StrategyMergeMetadata keeps the first document of the duplicates, with the metadata of the others merged,
+	// the keys already in the first document are kept, and the ids of the others are in MetaKeyDuplicateIDs.
+	StrategyMergeMetadata Strategy = "merge_metadata"
+)
+
+// MetaKeyDuplicateIDs is the metadata key of the ids of the dropped duplicates with StrategyMergeMetadata.
+const MetaKeyDuplicateIDs = "_duplicate_ids"
+
+const (
+	defaultThreshold   = 0.9
+	defaultShingleSize = 3
+	defaultNumHashes   = 128
+)
+
+type Config struct {
+	// Method detects the near-duplicate documents.
+	// Optional. Default: MethodMinHash.
+	Method Method
+	// Threshold is the similarity from 0 to 1, at or above which the documents are duplicates, 1 only drops the documents of the same shingles.
+	// Optional. Default: 0.9.
+	Threshold float64
+	// ShingleSize is the number of words of a shingle, each CJK character is a word.
+	// Optional. Default: 3.
+	ShingleSize int
+	// NumHashes is the number of hash functions of MethodMinHash, more is more accurate but slower.
+	// Optional. Default: 128.
+	NumHashes int
+	// Strategy handles the duplicates.
+	// Optional. Default: StrategyKeepFirst.
+	Strategy Strategy
+}
+
+// NewDeduplicator creates a transformer which removes the near-duplicate documents,
+// e.g. the repeated headers, footers and boilerplates of the chunks, before they pollute the vector stores.
+func NewDeduplicator(ctx context.Context, config *Config) (*Deduplicator, error) {
+	conf := &Config{}
+	if config != nil {
+		*conf = *config
+	}
+	if conf.Method == "" {
+		conf.Method = MethodMinHash
+	}
+	if conf.Method != MethodMinHash && conf.Method != MethodSimHash {
+		return nil, fmt.Errorf("unknown method: %s", conf.Method)
+	}
+	if conf.Threshold == 0 {
+		conf.Threshold = defaultThreshold
+	}
+	if conf.Threshold < 0 || conf.Threshold > 1 {
+		return nil, fmt.Errorf("threshold should be in [0, 1], got %v", conf.Threshold)
+	}
+	if conf.ShingleSize <= 0 {
+		conf.ShingleSize = defaultShingleSize
+	}
+	if conf.NumHashes <= 0 {
+		conf.NumHashes = defaultNumHashes
+	}
+	if conf.Strategy == "" {
+		conf.Strategy = StrategyKeepFirst
+	}
+	if conf.Strategy != StrategyKeepFirst && conf.Strategy != StrategyMergeMetadata {
+		return nil, fmt.Errorf("unknown strategy: %s", conf.Strategy)
+	}
+
+	return &Deduplicator{
+		conf: conf,
+		rows: lshBands(conf.NumHashes, conf.Threshold),
+	}, nil
+}
+
+// Deduplicator removes the near-duplicate documents, and keeps the order of the remaining ones.
+type Deduplicator struct {
+	conf *Config
+	// rows is the number of rows per band of the minhash signature.
+	rows int
+}
+
+func (d *Deduplicator) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	var (
+		ret []*schema.Document
+		// the signatures of the kept documents
+		minhashes [][]uint64
+		simhashes []uint64
+		// the kept documents of the bands of the minhash signatures
+		buckets = make(map[uint64][]int)
+	)
+
+	for _, doc := range src {
+		sh := shingles(doc.Content, d.conf.ShingleSize)
+
+		dup := -1
+		switch d.conf.Method {
+		case MethodMinHash:
+			sig := minhash(sh, d.conf.NumHashes)
+			keys := d.bandKeys(sig)
+			best := 0.0
+			for _, key := range keys {
+				for _, i := range buckets[key] {
+					if s := minhashSimilarity(sig, minhashes[i]); s >= d.conf.Threshold && s > best {
+						dup, best = i, s
+					}
+				}
+			}
+			if dup < 0 {
+				for _, key := range keys {
+					buckets[key] = append(buckets[key], len(ret))
+				}
+				minhashes = append(minhashes, sig)
+			}
+		case MethodSimHash:
+			sig := simhash(sh)
+			best := 0.0
+			for i, kept := range simhashes {
+				if s := simhashSimilarity(sig, kept); s >= d.conf.Threshold && s > best {
+					dup, best = i, s
+				}
+			}
+			if dup < 0 {
+				simhashes = append(simhashes, sig)
+			}
+		}
+
+		switch {
+		case dup < 0 && d.conf.Strategy == StrategyMergeMetadata:
+			// copied, as the metadata may be merged
+			nDoc := *doc
+			nDoc.MetaData = make(map[string]any, len(doc.MetaData))
+			for k, v := range doc.MetaData {
+				nDoc.MetaData[k] = v
+			}
+			ret = append(ret, &nDoc)
+		case dup < 0:
+			ret = append(ret, doc)
+		case d.conf.Strategy == StrategyMergeMetadata:
+			mergeMetadata(ret[dup], doc)
+		}
+	}
+
+	return ret, nil
+}
+
+func (d *Deduplicator) GetType() string {
+	return "Deduplicator"
+}
+
+// bandKeys returns the hashes of the bands of the minhash signature.
+func (d *Deduplicator) bandKeys(sig []uint64) []uint64 {
+	keys := make([]uint64, 0, len(sig)/d.rows)
+	buf := make([]byte, 8)
+	for band := 0; band*d.rows < len(sig); band++ {
+		h := fnv.New64a()
+		binary.LittleEndian.PutUint64(buf, uint64(band))
+		_, _ = h.Write(buf)
+		for _, v := range sig[band*d.rows : (band+1)*d.rows] {
+			binary.LittleEndian.PutUint64(buf, v)
+			_, _ = h.Write(buf)
+		}
+		keys = append(keys, h.Sum64())
+	}
+	return keys
+}
+
+func mergeMetadata(kept, dup *schema.Document) {
+	for k, v := range dup.MetaData {
+		if _, ok := kept.MetaData[k]; !ok && k != MetaKeyDuplicateIDs {
+			kept.MetaData[k] = v
+		}
+	}
+
+	ids, _ := kept.MetaData[MetaKeyDuplicateIDs].([]string)
+	ids = append(ids[:len(ids):len(ids)], dup.ID)
+	if dupIDs, ok := dup.MetaData[MetaKeyDuplicateIDs].([]string); ok {
+		ids = append(ids, dupIDs...)
+	}
+	kept.MetaData[MetaKeyDuplicateIDs] = ids
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dedup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	text1 = "Eino is the ultimate LLM application development framework in Go, drawing inspiration from many excellent " +
+		"LLM application development frameworks in the open-source community such as LangChain and LlamaIndex."
+	// text2 is text1 with a different punctuation, case and a typo
+	text2 = "Eino is the ultimate LLM application development framwork in Go; drawing inspiration from many excellent " +
+		"LLM application development frameworks in the open-source community such as Langchain and LlamaIndex!"
+	text3 = "Hertz is a high-performance and strong-extensibility Go HTTP framework that helps developers build micro-services."
+	text4 = "Eino 是基于 Go 的终极大模型应用开发框架，借鉴了开源社区中诸多优秀的大模型应用开发框架。"
+	text5 = "Eino 是基于 Go 的终极大模型应用开发框架，借鉴了开源社区中诸多优秀的大模型应用开发框架！"
+)
+
+func TestDeduplicator(t *testing.T) {
+	ctx := context.Background()
+	docs := func() []*schema.Document {
+		return []*schema.Document{
+			{ID: "1", Content: text1, MetaData: map[string]any{"a": 1}},
+			{ID: "2", Content: text3},
+			{ID: "3", Content: text2, MetaData: map[string]any{"a": 2, "b": 3}},
+			{ID: "4", Content: text4},
+			{ID: "5", Content: text5, MetaData: map[string]any{MetaKeyDuplicateIDs: []string{"6"}}},
+			{ID: "7", Content: text1},
+		}
+	}
+	ids := func(docs []*schema.Document) []string {
+		ret := make([]string, 0, len(docs))
+		for _, doc := range docs {
+			ret = append(ret, doc.ID)
+		}
+		return ret
+	}
+
+	for _, method := range []Method{MethodMinHash, MethodSimHash} {
+		t.Run(string(method), func(t *testing.T) {
+			d, err := NewDeduplicator(ctx, &Config{Method: method, Threshold: 0.8, ShingleSize: 2})
+			assert.NoError(t, err)
+
+			src := docs()
+			ret, err := d.Transform(ctx, src)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"1", "2", "4"}, ids(ret))
+			assert.True(t, ret[0] == src[0])
+			assert.Equal(t, map[string]any{"a": 1}, ret[0].MetaData)
+		})
+	}
+
+	t.Run("exact", func(t *testing.T) {
+		d, err := NewDeduplicator(ctx, &Config{Threshold: 1})
+		assert.NoError(t, err)
+
+		ret, err := d.Transform(ctx, docs())
+		assert.NoError(t, err)
+		// text4 and text5 differ only in the punctuation
+		assert.Equal(t, []string{"1", "2", "3", "4"}, ids(ret))
+	})
+
+	t.Run("merge metadata", func(t *testing.T) {
+		d, err := NewDeduplicator(ctx, &Config{Threshold: 0.8, ShingleSize: 2, Strategy: StrategyMergeMetadata})
+		assert.NoError(t, err)
+
+		src := docs()
+		ret, err := d.Transform(ctx, src)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "4"}, ids(ret))
+		assert.Equal(t, map[string]any{"a": 1, "b": 3, MetaKeyDuplicateIDs: []string{"3", "7"}}, ret[0].MetaData)
+		assert.Equal(t, map[string]any{MetaKeyDuplicateIDs: []string{"5", "6"}}, ret[2].MetaData)
+		// the source documents are not modified
+		assert.Equal(t, map[string]any{"a": 1}, src[0].MetaData)
+		assert.Equal(t, []string{"6"}, src[4].MetaData[MetaKeyDuplicateIDs])
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewDeduplicator(ctx, &Config{Method: "md5"})
+		assert.EqualError(t, err, "unknown method: md5")
+		_, err = NewDeduplicator(ctx, &Config{Threshold: 1.5})
+		assert.EqualError(t, err, "threshold should be in [0, 1], got 1.5")
+		_, err = NewDeduplicator(ctx, &Config{Strategy: "merge"})
+		assert.EqualError(t, err, "unknown strategy: merge")
+	})
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, shingles("Hello, World! 你好", 2), shingles("hello world 你 好", 2))
+	assert.Equal(t, 3, len(shingles("a b c d", 2)))
+	assert.Equal(t, 1, len(shingles("a b", 3)))
+	assert.Equal(t, 0, len(shingles("...", 3)))
+
+	a, b := shingles(text1, 1), shingles(text3, 1)
+	assert.True(t, minhashSimilarity(minhash(a, 128), minhash(a, 128)) == 1)
+	assert.True(t, minhashSimilarity(minhash(a, 128), minhash(b, 128)) < 0.3)
+	assert.True(t, simhashSimilarity(simhash(a), simhash(b)) < 0.9)
+
+	assert.Equal(t, 8, lshBands(128, 0.9))
+	assert.Equal(t, 4, lshBands(128, 0.5))
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/document/transformer/dedup"
+)
+
+const license = "Licensed under the Apache License, Version 2.0; you may not use this file except in compliance with the License."
+
+func main() {
+	ctx := context.Background()
+
+	d, err := dedup.NewDeduplicator(ctx, &dedup.Config{
+		Method:      dedup.MethodMinHash,
+		Threshold:   0.8,
+		ShingleSize: 2,
+		Strategy:    dedup.StrategyMergeMetadata,
+	})
+	if err != nil {
+		log.Fatalf("NewDeduplicator failed, err=%v", err)
+	}
+
+	docs, err := d.Transform(ctx, []*schema.Document{
+		{ID: "a#1", Content: "Copyright 2024 CloudWeGo Authors. " + license, MetaData: map[string]any{"file": "a.md"}},
+		{ID: "a#2", Content: "Eino is the ultimate LLM application development framework in Go."},
+		{ID: "b#1", Content: "Copyright 2025 CloudWeGo Authors. " + license, MetaData: map[string]any{"file": "b.md"}},
+	})
+	if err != nil {
+		log.Fatalf("Transform failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("%s: %s\n%v\n", doc.ID, doc.Content, doc.MetaData)
+	}
+}
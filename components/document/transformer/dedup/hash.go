@@ -0,0 +1,152 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dedup
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// shingles returns the hashes of the shingles of the text, with their counts.
+// The text is lowercased and split into words, and each CJK character is a word, the punctuations are ignored.
+func shingles(text string, size int) map[uint64]int {
+	var (
+		words []string
+		sb    strings.Builder
+	)
+	flush := func() {
+		if sb.Len() > 0 {
+			words = append(words, sb.String())
+			sb.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flush()
+			words = append(words, string(r))
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			sb.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	if len(words) < size {
+		size = len(words)
+	}
+	ret := make(map[uint64]int)
+	for i := 0; i+size <= len(words) && size > 0; i++ {
+		h := fnv.New64a()
+		for j, w := range words[i : i+size] {
+			if j > 0 {
+				_, _ = h.Write([]byte{0})
+			}
+			_, _ = h.Write([]byte(w))
+		}
+		ret[h.Sum64()]++
+	}
+	return ret
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// mix64 is the finalizer of splitmix64, which derives the independent hash functions of minhash from the seeds.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// minhash returns the minhash signature of the shingles, whose matching ratio estimates the jaccard similarity.
+func minhash(shingles map[uint64]int, numHashes int) []uint64 {
+	sig := make([]uint64, numHashes)
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	for s := range shingles {
+		for i := range sig {
+			if h := mix64(s ^ mix64(uint64(i)+1)); h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+func minhashSimilarity(a, b []uint64) float64 {
+	same := 0
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a))
+}
+
+// lshBands returns the number of rows per band of the minhash signature,
+// which makes the documents of the threshold similarity likely to share a band.
+func lshBands(numHashes int, threshold float64) int {
+	best := 1
+	for rows := 1; rows <= numHashes; rows++ {
+		if numHashes%rows != 0 {
+			continue
+		}
+		// the similarity at which the probability of sharing a band rises steepest
+		t := math.Pow(1/float64(numHashes/rows), 1/float64(rows))
+		if t <= threshold*0.9 {
+			best = rows
+		}
+	}
+	return best
+}
+
+// simhash returns the 64-bit simhash of the shingles weighted by their counts.
+func simhash(shingles map[uint64]int) uint64 {
+	var weights [64]int
+	for s, n := range shingles {
+		h := mix64(s)
+		for i := 0; i < 64; i++ {
+			if h&(1<<uint(i)) != 0 {
+				weights[i] += n
+			} else {
+				weights[i] -= n
+			}
+		}
+	}
+
+	var ret uint64
+	for i, w := range weights {
+		if w > 0 {
+			ret |= 1 << uint(i)
+		}
+	}
+	return ret
+}
+
+func simhashSimilarity(a, b uint64) float64 {
+	return 1 - float64(bits.OnesCount64(a^b))/64
+}
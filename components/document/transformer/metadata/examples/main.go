@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/document/transformer/metadata"
+)
+
+// echoChatModel replies with the first line of the text, replace it with a real chat model, e.g. eino-ext/components/model/openai.
+type echoChatModel struct{}
+
+func (m *echoChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	prompt := input[len(input)-1].Content
+	text := prompt[strings.Index(prompt, "Text:\n")+len("Text:\n"):]
+	return schema.AssistantMessage(strings.SplitN(text, "\n", 2)[0], nil), nil
+}
+
+func (m *echoChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := m.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func main() {
+	ctx := context.Background()
+
+	extractor, err := metadata.NewExtractor(ctx, &metadata.Config{
+		ChatModel:    &echoChatModel{},
+		Fields:       []metadata.Field{metadata.FieldTitle, metadata.FieldKeywords, metadata.FieldQuestions},
+		QuestionsNum: 2,
+	})
+	if err != nil {
+		log.Fatalf("NewExtractor failed, err=%v", err)
+	}
+
+	docs, err := extractor.Transform(ctx, []*schema.Document{
+		{
+			ID:      "1",
+			Content: "Eino, components and orchestration\nEino is the ultimate LLM application development framework in Go.",
+		},
+	})
+	if err != nil {
+		log.Fatalf("Transform failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("title: %v\nkeywords: %v\nquestions: %v\n",
+			doc.MetaData["title"], doc.MetaData["keywords"], doc.MetaData["questions"])
+	}
+}
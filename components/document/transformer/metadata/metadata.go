@@ -0,0 +1,275 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// Field is a metadata field generated by the chat model.
+type Field string
+
+const (
+	// FieldTitle is a concise title of the document, stored as string.
+	FieldTitle Field = "title"
+	// FieldSummary is a summary of the document, stored as string.
+	FieldSummary Field = "summary"
+	// FieldKeywords are the keywords of the document, stored as []string.
+	FieldKeywords Field = "keywords"
+	// FieldQuestions are the hypothetical questions the document can answer, stored as []string.
+	FieldQuestions Field = "questions"
+)
+
+const (
+	defaultConcurrency  = 4
+	defaultKeywordsNum  = 5
+	defaultQuestionsNum = 3
+)
+
+// DefaultPrompts are the default prompt templates of the fields.
+var DefaultPrompts = map[Field]string{
+	FieldTitle: "Give a concise title for the following text. Reply with the title only.\n\n" +
+		"Text:\n{content}",
+	FieldSummary: "Summarize the following text in a few sentences, keeping the key entities and facts. Reply with the summary only.\n\n" +
+		"Text:\n{content}",
+	FieldKeywords: "Extract {num} keywords from the following text. Reply with the keywords only, separated by commas.\n\n" +
+		"Text:\n{content}",
+	FieldQuestions: "Write {num} questions that the following text can answer, and that are unlikely to be answered by other texts. " +
+		"Reply with the questions only, one per line.\n\n" +
+		"Text:\n{content}",
+}
+
+// listItemPrefix matches the bullets and numbers of the list items, e.g. "- ", "1. ", "2) ".
+var listItemPrefix = regexp.MustCompile(`^\s*(?:[-*•]|\d+[.)、])\s*`)
+
+// Config is the config of metadata Extractor.
+type Config struct {
+	// ChatModel generates the metadata.
+	// Required.
+	ChatModel model.BaseChatModel
+	// Fields are the metadata fields generated, each field is generated by a chat model call.
+	// Optional. Default: all the fields.
+	Fields []Field
+	// Prompts are the prompt templates of the fields in FString format, with the variables {content} of the document,
+	// and {num} of KeywordsNum or QuestionsNum. Use {{ and }} for the literal braces.
+	// Optional. Default: DefaultPrompts for the fields not set.
+	Prompts map[Field]string
+	// MetaKeys are the metadata keys of the fields.
+	// Optional. Default: the field names, e.g. "title".
+	MetaKeys map[Field]string
+	// KeywordsNum is the number of keywords.
+	// Optional. Default: 5.
+	KeywordsNum int
+	// QuestionsNum is the number of questions.
+	// Optional. Default: 3.
+	QuestionsNum int
+	// Overwrite regenerates the fields that already exist in the metadata, which are kept by default.
+	Overwrite bool
+	// Concurrency is the number of concurrent chat model calls.
+	// Optional. Default: 4.
+	Concurrency int
+}
+
+// NewExtractor creates a metadata extractor, which enriches the metadata of the documents with the fields generated by a chat model,
+// e.g. to embed the summary or the questions along with the content, or to filter by the keywords.
+func NewExtractor(ctx context.Context, config *Config) (*Extractor, error) {
+	if config == nil || config.ChatModel == nil {
+		return nil, errors.New("chat model is required")
+	}
+
+	conf := *config
+	if len(conf.Fields) == 0 {
+		conf.Fields = []Field{FieldTitle, FieldSummary, FieldKeywords, FieldQuestions}
+	}
+	if conf.KeywordsNum <= 0 {
+		conf.KeywordsNum = defaultKeywordsNum
+	}
+	if conf.QuestionsNum <= 0 {
+		conf.QuestionsNum = defaultQuestionsNum
+	}
+	if conf.Concurrency <= 0 {
+		conf.Concurrency = defaultConcurrency
+	}
+
+	e := &Extractor{
+		conf:     &conf,
+		prompts:  make(map[Field]string, len(conf.Fields)),
+		metaKeys: make(map[Field]string, len(conf.Fields)),
+	}
+	for _, f := range conf.Fields {
+		prompt, ok := conf.Prompts[f]
+		if !ok {
+			if prompt, ok = DefaultPrompts[f]; !ok {
+				return nil, fmt.Errorf("prompt of field %q is required", f)
+			}
+		}
+		e.prompts[f] = prompt
+
+		e.metaKeys[f] = string(f)
+		if k, ok := conf.MetaKeys[f]; ok {
+			e.metaKeys[f] = k
+		}
+	}
+	return e, nil
+}
+
+// Extractor is a document transformer which generates the metadata of each document with a chat model.
+// The documents are returned in the same order, with the metadata copied and enriched.
+type Extractor struct {
+	conf     *Config
+	prompts  map[Field]string
+	metaKeys map[Field]string
+}
+
+func (e *Extractor) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	ret := make([]*schema.Document, len(src))
+	for i, doc := range src {
+		meta := make(map[string]any, len(doc.MetaData)+len(e.conf.Fields))
+		for k, v := range doc.MetaData {
+			meta[k] = v
+		}
+		d := *doc
+		d.MetaData = meta
+		ret[i] = &d
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, e.conf.Concurrency)
+	)
+loop:
+	for _, doc := range ret {
+		// decide the fields before the metadata is written concurrently
+		var fields []Field
+		for _, f := range e.conf.Fields {
+			if _, ok := doc.MetaData[e.metaKeys[f]]; !ok || e.conf.Overwrite {
+				fields = append(fields, f)
+			}
+		}
+
+		for _, f := range fields {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				break loop
+			}
+
+			wg.Add(1)
+			go func(doc *schema.Document, f Field) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				v, err := e.generate(ctx, doc, f)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = fmt.Errorf("generate %s of document[%s] failed: %w", f, doc.ID, err)
+						cancel()
+					}
+					return
+				}
+				doc.MetaData[e.metaKeys[f]] = v
+			}(doc, f)
+		}
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (e *Extractor) GetType() string {
+	return "MetadataExtractor"
+}
+
+// generate calls the chat model, and returns the string of the title and the summary,
+// or the []string of the keywords and the questions.
+func (e *Extractor) generate(ctx context.Context, doc *schema.Document, f Field) (any, error) {
+	num := 0
+	switch f {
+	case FieldKeywords:
+		num = e.conf.KeywordsNum
+	case FieldQuestions:
+		num = e.conf.QuestionsNum
+	}
+
+	msgs, err := schema.UserMessage(e.prompts[f]).Format(ctx, map[string]any{
+		"content": doc.Content,
+		"num":     num,
+	}, schema.FString)
+	if err != nil {
+		return nil, fmt.Errorf("format prompt failed: %w", err)
+	}
+
+	out, err := e.conf.ChatModel.Generate(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+	content := strings.TrimSpace(out.Content)
+
+	switch f {
+	case FieldKeywords:
+		return parseList(content, true), nil
+	case FieldQuestions:
+		return parseList(content, false), nil
+	default:
+		return strings.Trim(content, "\"'“”"), nil
+	}
+}
+
+// parseList parses the lines of the list, and the comma separated items in a line if splitComma.
+func parseList(content string, splitComma bool) []string {
+	items := make([]string, 0)
+	for _, line := range strings.Split(content, "\n") {
+		line = listItemPrefix.ReplaceAllString(line, "")
+		parts := []string{line}
+		if splitComma {
+			parts = strings.FieldsFunc(line, func(r rune) bool {
+				return r == ',' || r == '，' || r == '、' || r == ';'
+			})
+		}
+		for _, p := range parts {
+			if p = strings.TrimSpace(p); p != "" {
+				items = append(items, p)
+			}
+		}
+	}
+	return items
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+type mockChatModel struct {
+	calls    int32
+	generate func(prompt string) (string, error)
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	atomic.AddInt32(&m.calls, 1)
+	content, err := m.generate(input[len(input)-1].Content)
+	if err != nil {
+		return nil, err
+	}
+	return schema.AssistantMessage(content, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestExtractor(t *testing.T) {
+	ctx := context.Background()
+	cm := &mockChatModel{
+		generate: func(prompt string) (string, error) {
+			text := prompt[strings.LastIndex(prompt, "\n")+1:]
+			switch {
+			case strings.HasPrefix(prompt, "Give a concise title"):
+				return `"Title of ` + text + `"`, nil
+			case strings.HasPrefix(prompt, "Summarize"):
+				return "Summary of " + text, nil
+			case strings.HasPrefix(prompt, "Extract 2 keywords"):
+				return "eino, golang，llm", nil
+			case strings.HasPrefix(prompt, "Write 3 questions"):
+				return "1. What is " + text + "?\n\n2) Why " + text + "?\n- How?", nil
+			}
+			return "", errors.New("unexpected prompt: " + prompt)
+		},
+	}
+
+	_, err := NewExtractor(ctx, &Config{})
+	assert.EqualError(t, err, "chat model is required")
+	_, err = NewExtractor(ctx, &Config{ChatModel: cm, Fields: []Field{"custom"}})
+	assert.EqualError(t, err, `prompt of field "custom" is required`)
+
+	t.Run("all fields", func(t *testing.T) {
+		e, err := NewExtractor(ctx, &Config{ChatModel: cm, KeywordsNum: 2, Concurrency: 2})
+		assert.NoError(t, err)
+
+		src := []*schema.Document{
+			{ID: "1", Content: "eino", MetaData: map[string]any{"k": "v"}},
+			{ID: "2", Content: "hertz"},
+		}
+		docs, err := e.Transform(ctx, src)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+		assert.Equal(t, map[string]any{
+			"k":        "v",
+			"title":    "Title of eino",
+			"summary":  "Summary of eino",
+			"keywords": []string{"eino", "golang", "llm"},
+			"questions": []string{
+				"What is eino?",
+				"Why eino?",
+				"How?",
+			},
+		}, docs[0].MetaData)
+		assert.Equal(t, "Title of hertz", docs[1].MetaData[string(FieldTitle)])
+		assert.Equal(t, "hertz", docs[1].Content)
+		assert.Equal(t, "2", docs[1].ID)
+		// the source documents are not modified
+		assert.Equal(t, map[string]any{"k": "v"}, src[0].MetaData)
+	})
+
+	t.Run("custom prompts and keys", func(t *testing.T) {
+		cm.calls = 0
+		e, err := NewExtractor(ctx, &Config{
+			ChatModel: cm,
+			Fields:    []Field{FieldTitle, FieldSummary, "topic"},
+			Prompts: map[Field]string{
+				FieldSummary: "Summarize {{briefly}}:\n{content}",
+				"topic":      "Give a concise title of the topic:\n{content}",
+			},
+			MetaKeys: map[Field]string{FieldSummary: "_summary"},
+		})
+		assert.NoError(t, err)
+
+		docs, err := e.Transform(ctx, []*schema.Document{
+			{Content: "eino", MetaData: map[string]any{"title": "Eino"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"title":    "Eino",
+			"_summary": "Summary of eino",
+			"topic":    "Title of eino",
+		}, docs[0].MetaData)
+		assert.Equal(t, int32(2), cm.calls)
+	})
+
+	t.Run("overwrite", func(t *testing.T) {
+		e, err := NewExtractor(ctx, &Config{ChatModel: cm, Fields: []Field{FieldTitle}, Overwrite: true})
+		assert.NoError(t, err)
+
+		docs, err := e.Transform(ctx, []*schema.Document{
+			{Content: "eino", MetaData: map[string]any{"title": "Eino"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Title of eino", docs[0].MetaData["title"])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		e, err := NewExtractor(ctx, &Config{
+			ChatModel: &mockChatModel{generate: func(prompt string) (string, error) {
+				return "", errors.New("rate limited")
+			}},
+			Fields: []Field{FieldSummary},
+		})
+		assert.NoError(t, err)
+
+		_, err = e.Transform(ctx, []*schema.Document{{ID: "1", Content: "eino"}})
+		assert.EqualError(t, err, "generate summary of document[1] failed: rate limited")
+
+		e, err = NewExtractor(ctx, &Config{
+			ChatModel: cm,
+			Fields:    []Field{FieldTitle},
+			Prompts:   map[Field]string{FieldTitle: "{unknown}"},
+		})
+		assert.NoError(t, err)
+		_, err = e.Transform(ctx, []*schema.Document{{ID: "1", Content: "eino"}})
+		assert.ErrorContains(t, err, "format prompt failed")
+	})
+}
+
+func TestParseList(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c", "d"}, parseList("a, b\n- c;d", true))
+	assert.Equal(t, []string{"What is a, b?", "How?"}, parseList("1. What is a, b?\n  * How?\n", false))
+	assert.Equal(t, []string{}, parseList("", true))
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pii
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The types of the built-in detectors.
+const (
+	TypeEmail      = "email"
+	TypePhone      = "phone"
+	TypeIDCard     = "id_card"
+	TypeSSN        = "ssn"
+	TypeCreditCard = "credit_card"
+)
+
+// Match is a PII found in the text.
+type Match struct {
+	// Type is the type of the PII, e.g. TypeEmail.
+	Type string
+	// Start and End are the byte offsets of the PII in the text.
+	Start int
+	End   int
+}
+
+// Detector detects the PII in the text, e.g. by regular expressions, or by a NER model or service.
+type Detector interface {
+	Detect(ctx context.Context, text string) ([]Match, error)
+}
+
+// NewRegexDetector creates a detector of the matches of the pattern, which are validated by validate if it is not nil.
+// The matches adjacent to letters or digits are ignored, e.g. the digits in a longer number.
+func NewRegexDetector(typ, pattern string, validate func(s string) bool) (Detector, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern of %s: %w", typ, err)
+	}
+	return &regexDetector{typ: typ, re: re, validate: validate}, nil
+}
+
+// DefaultDetectors returns the built-in detectors of emails, phone numbers, Chinese resident ID numbers,
+// US social security numbers and credit card numbers.
+func DefaultDetectors() []Detector {
+	return []Detector{
+		&regexDetector{
+			typ: TypeEmail,
+			re:  regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`),
+		},
+		&regexDetector{
+			typ:      TypeIDCard,
+			re:       regexp.MustCompile(`\d{17}[\dXx]`),
+			validate: validIDCard,
+		},
+		&regexDetector{
+			typ:      TypeSSN,
+			re:       regexp.MustCompile(`\d{3}-\d{2}-\d{4}`),
+			validate: validSSN,
+		},
+		&regexDetector{
+			typ:      TypeCreditCard,
+			re:       regexp.MustCompile(`\d(?:[ -]?\d){12,18}`),
+			validate: validCreditCard,
+		},
+		&regexDetector{
+			typ: TypePhone,
+			re: regexp.MustCompile(strings.Join([]string{
+				// Chinese mobile phone numbers
+				`(?:\+86[ -]?)?1[3-9]\d(?:[ -]?\d{4}){2}`,
+				// international numbers with the country code
+				`\+\d{1,3}[ -]?(?:\(\d{1,4}\)[ -]?)?\d{1,4}(?:[ -]?\d{2,4}){1,4}`,
+				// North American numbers, e.g. (555) 123-4567, 555-123-4567
+				`\(\d{3}\) ?\d{3}[ .-]\d{4}`,
+				`\d{3}[.-]\d{3}[.-]\d{4}`,
+			}, "|")),
+			validate: validPhone,
+		},
+	}
+}
+
+type regexDetector struct {
+	typ      string
+	re       *regexp.Regexp
+	validate func(s string) bool
+}
+
+func (d *regexDetector) Detect(ctx context.Context, text string) ([]Match, error) {
+	var ret []Match
+	for _, loc := range d.re.FindAllStringIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if r, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(r) {
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(r) {
+			continue
+		}
+		if d.validate != nil && !d.validate(text[start:end]) {
+			continue
+		}
+		ret = append(ret, Match{Type: d.typ, Start: start, End: end})
+	}
+	return ret, nil
+}
+
+func isWordRune(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func digitsOf(s string) []int {
+	ret := make([]int, 0, len(s))
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			ret = append(ret, int(r-'0'))
+		}
+	}
+	return ret
+}
+
+// validIDCard validates the checksum of the 18-digit Chinese resident ID number, see GB 11643-1999.
+func validIDCard(s string) bool {
+	weights := []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	sum := 0
+	for i, w := range weights {
+		sum += int(s[i]-'0') * w
+	}
+	check := "10X98765432"[sum%11]
+	return strings.ToUpper(s[17:]) == string(check)
+}
+
+// validSSN rejects the never assigned social security numbers.
+func validSSN(s string) bool {
+	area, group, serial := s[:3], s[4:6], s[7:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// validCreditCard validates the length and the Luhn checksum of the card number.
+func validCreditCard(s string) bool {
+	digits := digitsOf(s)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func validPhone(s string) bool {
+	n := len(digitsOf(s))
+	return n >= 7 && n <= 15
+}
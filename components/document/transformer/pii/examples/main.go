@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/document/transformer/pii"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// detect the internal employee ids besides the built-in PII
+	employeeID, err := pii.NewRegexDetector("employee_id", `EMP-\d{6}`, nil)
+	if err != nil {
+		log.Fatalf("NewRegexDetector failed, err=%v", err)
+	}
+
+	scrubber, err := pii.NewScrubber(ctx, &pii.Config{
+		Detectors: append(pii.DefaultDetectors(), employeeID),
+	})
+	if err != nil {
+		log.Fatalf("NewScrubber failed, err=%v", err)
+	}
+
+	docs, err := scrubber.Transform(ctx, []*schema.Document{
+		{
+			ID:      "1",
+			Content: "Contact Alice (EMP-004211) at alice@example.com or 138-1234-5678, card 4111 1111 1111 1111.",
+		},
+	})
+	if err != nil {
+		log.Fatalf("Transform failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("%s\n%+v\n", doc.Content, doc.MetaData[pii.MetaKeyRedactions])
+	}
+}
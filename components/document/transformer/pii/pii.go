@@ -0,0 +1,161 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pii
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+// MetaKeyRedactions is the metadata key of the []Redaction of the document, absent if nothing is redacted.
+const MetaKeyRedactions = "_pii_redactions"
+
+// Redaction records a redacted PII without its value.
+type Redaction struct {
+	// Type is the type of the PII.
+	Type string `json:"type"`
+	// Hash is the first 16 hex digits of the sha256 of the PII, to find the documents of the same PII without the value.
+	Hash string `json:"hash"`
+}
+
+type Config struct {
+	// Detectors detect the PII. The overlapped matches are resolved by the earliest, the longest, then the first detector.
+	// Optional. Default: DefaultDetectors(), append custom detectors to them to detect more.
+	Detectors []Detector
+	// Mask returns the replacement of the PII.
+	// Optional. Default: the upper-case type in brackets, e.g. [EMAIL].
+	Mask func(typ, value string) string
+}
+
+// NewScrubber creates a transformer which masks the PII in the content of the documents,
+// and records the redactions in the metadata.
+func NewScrubber(ctx context.Context, config *Config) (*Scrubber, error) {
+	conf := &Config{}
+	if config != nil {
+		*conf = *config
+	}
+	if len(conf.Detectors) == 0 {
+		conf.Detectors = DefaultDetectors()
+	}
+	if conf.Mask == nil {
+		conf.Mask = func(typ, value string) string {
+			return "[" + strings.ToUpper(typ) + "]"
+		}
+	}
+	return &Scrubber{conf: conf}, nil
+}
+
+// Scrubber masks the PII in the documents, so that the documents can be indexed while meeting compliance requirements.
+// The documents with PII are copied, and the others are returned as is.
+type Scrubber struct {
+	conf *Config
+}
+
+func (s *Scrubber) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	ret := make([]*schema.Document, 0, len(src))
+	for _, doc := range src {
+		content, redactions, err := s.Scrub(ctx, doc.Content)
+		if err != nil {
+			return nil, fmt.Errorf("scrub document[%s] failed: %w", doc.ID, err)
+		}
+		if len(redactions) == 0 {
+			ret = append(ret, doc)
+			continue
+		}
+
+		nDoc := *doc
+		nDoc.Content = content
+		nDoc.MetaData = make(map[string]any, len(doc.MetaData)+1)
+		for k, v := range doc.MetaData {
+			nDoc.MetaData[k] = v
+		}
+		if prev, ok := doc.MetaData[MetaKeyRedactions].([]Redaction); ok {
+			redactions = append(prev[:len(prev):len(prev)], redactions...)
+		}
+		nDoc.MetaData[MetaKeyRedactions] = redactions
+		ret = append(ret, &nDoc)
+	}
+	return ret, nil
+}
+
+func (s *Scrubber) GetType() string {
+	return "PIIScrubber"
+}
+
+// Scrub masks the PII in the text, and returns the redactions in the order of the text.
+func (s *Scrubber) Scrub(ctx context.Context, text string) (string, []Redaction, error) {
+	type match struct {
+		Match
+		order int
+	}
+	var matches []match
+	for i, d := range s.conf.Detectors {
+		ms, err := d.Detect(ctx, text)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, m := range ms {
+			if m.Start < 0 || m.End > len(text) || m.Start >= m.End {
+				return "", nil, fmt.Errorf("invalid match of %s: [%d, %d)", m.Type, m.Start, m.End)
+			}
+			matches = append(matches, match{Match: m, order: i})
+		}
+	}
+	if len(matches) == 0 {
+		return text, nil, nil
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		if a.End != b.End {
+			return a.End > b.End
+		}
+		return a.order < b.order
+	})
+
+	var (
+		sb         strings.Builder
+		redactions []Redaction
+		pos        int
+	)
+	for _, m := range matches {
+		if m.Start < pos {
+			// overlapped
+			continue
+		}
+		value := text[m.Start:m.End]
+		sum := sha256.Sum256([]byte(value))
+
+		sb.WriteString(text[pos:m.Start])
+		sb.WriteString(s.conf.Mask(m.Type, value))
+		redactions = append(redactions, Redaction{Type: m.Type, Hash: hex.EncodeToString(sum[:8])})
+		pos = m.End
+	}
+	sb.WriteString(text[pos:])
+
+	return sb.String(), redactions, nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pii
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+func TestScrubber(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default detectors", func(t *testing.T) {
+		s, err := NewScrubber(ctx, nil)
+		assert.NoError(t, err)
+
+		cases := []struct {
+			text string
+			want string
+		}{
+			{"mail john.doe+tag@mail.example.com.", "mail [EMAIL]."},
+			{"电话13812345678，或 +86 138-1234-5678", "电话[PHONE]，或 [PHONE]"},
+			{"call +1 (415) 555-2671 or (415) 555-2671 or 415.555.2671", "call [PHONE] or [PHONE] or [PHONE]"},
+			{"身份证 11010519491231002X 和 11010519491231002Y", "身份证 [ID_CARD] 和 11010519491231002Y"},
+			{"SSN 123-45-6789, not 000-12-3456", "SSN [SSN], not 000-12-3456"},
+			{"card 4111 1111 1111 1111, 4111-1111-1111-1112", "card [CREDIT_CARD], 4111-1111-1111-1112"},
+			{"order 20240115123456789 on 2024-01-15, v1.2.3", "order 20240115123456789 on 2024-01-15, v1.2.3"},
+			{"id abc13812345678", "id abc13812345678"},
+		}
+		for _, c := range cases {
+			got, _, err := s.Scrub(ctx, c.text)
+			assert.NoError(t, err)
+			assert.Equal(t, c.want, got, c.text)
+		}
+	})
+
+	t.Run("transform", func(t *testing.T) {
+		s, err := NewScrubber(ctx, nil)
+		assert.NoError(t, err)
+
+		src := []*schema.Document{
+			{ID: "1", Content: "contact a@b.io or a@b.io", MetaData: map[string]any{
+				"k":               "v",
+				MetaKeyRedactions: []Redaction{{Type: "name", Hash: "0"}},
+			}},
+			{ID: "2", Content: "nothing here"},
+		}
+		docs, err := s.Transform(ctx, src)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(docs))
+
+		assert.Equal(t, "contact [EMAIL] or [EMAIL]", docs[0].Content)
+		assert.Equal(t, "1", docs[0].ID)
+		redactions := docs[0].MetaData[MetaKeyRedactions].([]Redaction)
+		assert.Equal(t, 3, len(redactions))
+		assert.Equal(t, Redaction{Type: TypeEmail, Hash: "0f3306f460edf229"}, redactions[1])
+		assert.Equal(t, redactions[1], redactions[2])
+		assert.Equal(t, "v", docs[0].MetaData["k"])
+
+		assert.True(t, docs[1] == src[1])
+		assert.Equal(t, "contact a@b.io or a@b.io", src[0].Content)
+		assert.Equal(t, 1, len(src[0].MetaData[MetaKeyRedactions].([]Redaction)))
+	})
+
+	t.Run("custom detectors and mask", func(t *testing.T) {
+		employee, err := NewRegexDetector("employee_id", `EMP-\d{6}`, nil)
+		assert.NoError(t, err)
+		_, err = NewRegexDetector("bad", `(`, nil)
+		assert.ErrorContains(t, err, "invalid pattern of bad")
+
+		s, err := NewScrubber(ctx, &Config{
+			Detectors: append(DefaultDetectors(), employee),
+			Mask: func(typ, value string) string {
+				return strings.Repeat("*", len(value))
+			},
+		})
+		assert.NoError(t, err)
+
+		got, redactions, err := s.Scrub(ctx, "EMP-123456 a@b.io")
+		assert.NoError(t, err)
+		assert.Equal(t, "********** ******", got)
+		assert.Equal(t, "employee_id", redactions[0].Type)
+		assert.Equal(t, TypeEmail, redactions[1].Type)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		s, err := NewScrubber(ctx, &Config{Detectors: []Detector{
+			detectorFunc(func(text string) ([]Match, error) {
+				return []Match{{Type: "short", Start: 0, End: 3}, {Type: "inner", Start: 6, End: 8}}, nil
+			}),
+			detectorFunc(func(text string) ([]Match, error) {
+				return []Match{{Type: "long", Start: 0, End: 5}, {Type: "outer", Start: 5, End: 10}}, nil
+			}),
+		}})
+		assert.NoError(t, err)
+
+		got, _, err := s.Scrub(ctx, "0123456789ab")
+		assert.NoError(t, err)
+		assert.Equal(t, "[LONG][OUTER]ab", got)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		s, err := NewScrubber(ctx, &Config{Detectors: []Detector{
+			detectorFunc(func(text string) ([]Match, error) {
+				return nil, errors.New("ner service unavailable")
+			}),
+		}})
+		assert.NoError(t, err)
+		_, err = s.Transform(ctx, []*schema.Document{{ID: "1", Content: "a"}})
+		assert.EqualError(t, err, "scrub document[1] failed: ner service unavailable")
+
+		s, err = NewScrubber(ctx, &Config{Detectors: []Detector{
+			detectorFunc(func(text string) ([]Match, error) {
+				return []Match{{Type: "x", Start: 0, End: 10}}, nil
+			}),
+		}})
+		assert.NoError(t, err)
+		_, _, err = s.Scrub(ctx, "a")
+		assert.EqualError(t, err, "invalid match of x: [0, 10)")
+	})
+}
+
+type detectorFunc func(text string) ([]Match, error)
+
+func (f detectorFunc) Detect(ctx context.Context, text string) ([]Match, error) {
+	return f(text)
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cohere
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultBaseURL = "https://api.cohere.com"
+	defaultModel   = "rerank-v3.5"
+	rerankPath     = "/v2/rerank"
+)
+
+type Config struct {
+	// APIKey is your Cohere API key
+	// Required
+	APIKey string
+
+	// BaseURL is the Cohere API endpoint
+	// Optional. Default: "https://api.cohere.com"
+	BaseURL string
+
+	// Model specifies the rerank model
+	// Optional. Default: "rerank-v3.5"
+	Model string
+
+	// TopN limits the number of documents returned, documents are sorted by relevance score
+	// Optional. Default: 0, return all documents
+	TopN int
+
+	// MaxTokensPerDoc truncates long documents to the specified number of tokens
+	// Optional. Default: 4096 by Cohere
+	MaxTokensPerDoc int
+
+	// Timeout specifies the maximum duration to wait for API responses
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: no timeout
+	Timeout time.Duration
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client
+}
+
+// Reranker reorders documents by relevance to query with Cohere Rerank API,
+// the relevance score is set as document score.
+// see: https://docs.cohere.com/reference/rerank
+type Reranker struct {
+	config *Config
+	cli    *http.Client
+}
+
+func NewReranker(_ context.Context, config *Config) (*Reranker, error) {
+	if config == nil || config.APIKey == "" {
+		return nil, errors.New("[NewReranker] api key not provided")
+	}
+
+	nConf := *config
+	if nConf.BaseURL == "" {
+		nConf.BaseURL = defaultBaseURL
+	}
+	if nConf.Model == "" {
+		nConf.Model = defaultModel
+	}
+
+	cli := nConf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: nConf.Timeout}
+	}
+
+	return &Reranker{config: &nConf, cli: cli}, nil
+}
+
+// Transform reranks src by query provided with WithQuery.
+func (r *Reranker) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	options := document.GetTransformerImplSpecificOptions(&options{}, opts...)
+	if options.Query == "" {
+		return nil, errors.New("[cohere reranker] query not provided, use WithQuery to set query")
+	}
+
+	return r.Rerank(ctx, options.Query, src)
+}
+
+// Rerank reorders docs by relevance to query.
+func (r *Reranker) Rerank(ctx context.Context, query string, docs []*schema.Document) ([]*schema.Document, error) {
+	if len(docs) == 0 {
+		return docs, nil
+	}
+
+	req := &rerankRequest{
+		Model:           r.config.Model,
+		Query:           query,
+		Documents:       make([]string, len(docs)),
+		TopN:            r.config.TopN,
+		MaxTokensPerDoc: r.config.MaxTokensPerDoc,
+	}
+	for i, doc := range docs {
+		req.Documents[i] = doc.Content
+	}
+
+	resp, err := r.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(resp.Results, func(i, j int) bool {
+		return resp.Results[i].RelevanceScore > resp.Results[j].RelevanceScore
+	})
+
+	result := make([]*schema.Document, 0, len(resp.Results))
+	for _, item := range resp.Results {
+		if item.Index < 0 || item.Index >= len(docs) {
+			return nil, fmt.Errorf("[cohere reranker] invalid result index=%d", item.Index)
+		}
+		result = append(result, docs[item.Index].WithScore(item.RelevanceScore))
+	}
+
+	return result, nil
+}
+
+func (r *Reranker) doRequest(ctx context.Context, rr *rerankRequest) (*rerankResponse, error) {
+	body, err := json.Marshal(rr)
+	if err != nil {
+		return nil, fmt.Errorf("[cohere reranker] marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(r.config.BaseURL, "/")+rerankPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("[cohere reranker] create request fail: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+r.config.APIKey)
+
+	resp, err := r.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("[cohere reranker] do request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("[cohere reranker] read response fail: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("[cohere reranker] request fail, status code: %d, body: %s", resp.StatusCode, string(respBody))
+	}
+
+	rerankResp := &rerankResponse{}
+	if err = json.Unmarshal(respBody, rerankResp); err != nil {
+		return nil, fmt.Errorf("[cohere reranker] unmarshal response fail: %w", err)
+	}
+
+	return rerankResp, nil
+}
+
+func (r *Reranker) GetType() string {
+	return "CohereReranker"
+}
+
+type rerankRequest struct {
+	Model           string   `json:"model"`
+	Query           string   `json:"query"`
+	Documents       []string `json:"documents"`
+	TopN            int      `json:"top_n,omitempty"`
+	MaxTokensPerDoc int      `json:"max_tokens_per_doc,omitempty"`
+}
+
+type rerankResponse struct {
+	ID      string         `json:"id"`
+	Results []rerankResult `json:"results"`
+}
+
+type rerankResult struct {
+	Index          int     `json:"index"`
+	RelevanceScore float64 `json:"relevance_score"`
+}
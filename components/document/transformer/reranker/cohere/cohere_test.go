@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cohere
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReranker(t *testing.T) {
+	ctx := context.Background()
+
+	var gotReq rerankRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, rerankPath, r.URL.Path)
+		assert.Equal(t, "Bearer mock_key", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotReq))
+		_ = json.NewEncoder(w).Encode(&rerankResponse{
+			ID: "id",
+			Results: []rerankResult{
+				{Index: 2, RelevanceScore: 0.3},
+				{Index: 0, RelevanceScore: 0.9},
+			},
+		})
+	}))
+	defer server.Close()
+
+	_, err := NewReranker(ctx, &Config{})
+	assert.Error(t, err)
+
+	r, err := NewReranker(ctx, &Config{APIKey: "mock_key", BaseURL: server.URL, TopN: 2})
+	assert.NoError(t, err)
+
+	docs := []*schema.Document{
+		{ID: "1", Content: "eino is a llm framework"},
+		{ID: "2", Content: "the weather is good"},
+		{ID: "3", Content: "golang"},
+	}
+
+	_, err = r.Transform(ctx, docs)
+	assert.Error(t, err)
+
+	result, err := r.Transform(ctx, docs, WithQuery("what is eino"))
+	assert.NoError(t, err)
+	assert.Equal(t, defaultModel, gotReq.Model)
+	assert.Equal(t, "what is eino", gotReq.Query)
+	assert.Equal(t, 2, gotReq.TopN)
+	assert.Equal(t, []string{"eino is a llm framework", "the weather is good", "golang"}, gotReq.Documents)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "1", result[0].ID)
+	assert.Equal(t, 0.9, result[0].Score())
+	assert.Equal(t, "3", result[1].ID)
+
+	errServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer errServer.Close()
+
+	r, err = NewReranker(ctx, &Config{APIKey: "mock_key", BaseURL: errServer.URL})
+	assert.NoError(t, err)
+	_, err = r.Rerank(ctx, "what is eino", docs)
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/document/transformer/reranker/volc"
+)
+
+func main() {
+	ctx := context.Background()
+
+	reranker, err := volc.NewReranker(ctx, &volc.Config{
+		AK:        os.Getenv("VOLC_AK"),
+		SK:        os.Getenv("VOLC_SK"),
+		AccountID: os.Getenv("VOLC_ACCOUNT_ID"),
+		TopN:      2,
+	})
+	if err != nil {
+		log.Fatalf("NewReranker failed, err=%v", err)
+	}
+
+	docs := []*schema.Document{
+		{ID: "1", Content: "Eino is a LLM application development framework in Golang."},
+		{ID: "2", Content: "The weather is sunny today."},
+		{ID: "3", Content: "Eino provides components, orchestration and flows."},
+	}
+
+	result, err := reranker.Transform(ctx, docs, volc.WithQuery("what is eino"))
+	if err != nil {
+		log.Fatalf("Transform failed, err=%v", err)
+	}
+
+	for _, doc := range result {
+		log.Printf("id=%s, score=%v, content=%s", doc.ID, doc.Score(), doc.Content)
+	}
+}
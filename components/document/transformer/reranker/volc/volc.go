@@ -0,0 +1,255 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+	"github.com/volcengine/volc-sdk-golang/base"
+)
+
+const (
+	path           = "/api/knowledge/service/rerank"
+	defaultBaseURL = "api-knowledgebase.mlp.cn-beijing.volces.com"
+	defaultModel   = "base-multilingual-rerank"
+)
+
+type Config struct {
+	// Timeout specifies the duration to wait before timing out a request
+	// Optional. Default: 0 (no timeout)
+	Timeout time.Duration
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client
+
+	// BaseURL is the base URL for the knowledge API
+	// Optional. Default: "api-knowledgebase.mlp.cn-beijing.volces.com"
+	BaseURL string
+
+	// AK specifies the access key for authentication
+	// Required
+	AK string
+
+	// SK specifies the secret key for authentication
+	// Required
+	SK string
+
+	// AccountID specifies the unique identifier for your Volcengine account
+	// Required
+	AccountID string
+
+	// The following fields are from the Volcengine Knowledge rerank openapi request body.
+	// For more details, please refer to: https://www.volcengine.com/docs/84313/1254605
+
+	// Model specifies the rerank model
+	// Optional. Default: "base-multilingual-rerank"
+	Model string
+
+	// Instruction specifies the rerank instruction, only supported by some models
+	// Optional.
+	Instruction string
+
+	// TitleKey specifies the metadata key of document title, which is sent to rerank model with content
+	// Optional.
+	TitleKey string
+
+	// TopN limits the number of documents returned, documents are sorted by relevance score
+	// Optional. Default: 0, return all documents
+	TopN int
+}
+
+// Reranker reorders documents by relevance to query with Volcengine Knowledge rerank API,
+// the relevance score is set as document score.
+type Reranker struct {
+	config     *Config
+	cli        *http.Client
+	credential *base.Credentials
+}
+
+func NewReranker(_ context.Context, config *Config) (*Reranker, error) {
+	if config == nil || config.AK == "" || config.SK == "" {
+		return nil, errors.New("[NewReranker] ak or sk not provided")
+	}
+
+	nConf := *config
+	if nConf.BaseURL == "" {
+		nConf.BaseURL = defaultBaseURL
+	}
+	if nConf.Model == "" {
+		nConf.Model = defaultModel
+	}
+
+	cli := nConf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: nConf.Timeout}
+	}
+
+	return &Reranker{
+		config: &nConf,
+		cli:    cli,
+		credential: &base.Credentials{
+			AccessKeyID:     nConf.AK,
+			SecretAccessKey: nConf.SK,
+			Service:         "air",
+			Region:          "cn-north-1",
+		},
+	}, nil
+}
+
+// Transform reranks src by query provided with WithQuery.
+func (r *Reranker) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	options := document.GetTransformerImplSpecificOptions(&options{}, opts...)
+	if options.Query == "" {
+		return nil, errors.New("[volc reranker] query not provided, use WithQuery to set query")
+	}
+
+	return r.Rerank(ctx, options.Query, src)
+}
+
+// Rerank reorders docs by relevance to query.
+func (r *Reranker) Rerank(ctx context.Context, query string, docs []*schema.Document) ([]*schema.Document, error) {
+	if len(docs) == 0 {
+		return docs, nil
+	}
+
+	req := &request{
+		Datas:             make([]rerankData, len(docs)),
+		RerankModel:       r.config.Model,
+		RerankInstruction: r.config.Instruction,
+	}
+	for i, doc := range docs {
+		req.Datas[i] = rerankData{
+			Query:   query,
+			Content: doc.Content,
+		}
+		if r.config.TitleKey != "" {
+			if title, ok := doc.MetaData[r.config.TitleKey].(string); ok {
+				req.Datas[i].Title = title
+			}
+		}
+	}
+
+	resp, err := r.doRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data.Scores) != len(docs) {
+		return nil, fmt.Errorf("[volc reranker] invalid scores length, expected=%d, got=%d", len(docs), len(resp.Data.Scores))
+	}
+
+	result := make([]*schema.Document, len(docs))
+	for i, doc := range docs {
+		result[i] = doc.WithScore(resp.Data.Scores[i])
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score() > result[j].Score()
+	})
+
+	if r.config.TopN > 0 && len(result) > r.config.TopN {
+		result = result[:r.config.TopN]
+	}
+
+	return result, nil
+}
+
+func (r *Reranker) doRequest(ctx context.Context, rr *request) (*response, error) {
+	body, err := sonic.Marshal(rr)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, (&url.URL{
+		Scheme: "https",
+		Host:   r.config.BaseURL,
+		Path:   path,
+	}).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+
+	resp, err := r.cli.Do(r.prepareRequest(req).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("do request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+
+	origResp := &response{}
+	if err = sonic.Unmarshal(respBody, origResp); err != nil {
+		return nil, fmt.Errorf("unmarshal response fail: %w", err)
+	}
+	if origResp.Code != 0 {
+		return nil, fmt.Errorf("request fail, code: %d, msg: %s, request id: %s", origResp.Code, origResp.Message, origResp.RequestID)
+	}
+
+	return origResp, nil
+}
+
+func (r *Reranker) prepareRequest(req *http.Request) *http.Request {
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Host", r.config.BaseURL)
+	req.Header.Set("V-Account-Id", r.config.AccountID)
+	return r.credential.Sign(req)
+}
+
+func (r *Reranker) GetType() string {
+	return "VolcReranker"
+}
+
+type request struct {
+	Datas             []rerankData `json:"datas"`
+	RerankModel       string       `json:"rerank_model,omitempty"`
+	RerankInstruction string       `json:"rerank_instruction,omitempty"`
+}
+
+type rerankData struct {
+	Query   string `json:"query"`
+	Content string `json:"content"`
+	Title   string `json:"title,omitempty"`
+}
+
+type response struct {
+	Code      int64        `json:"code"`
+	Message   string       `json:"message,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+	Data      responseData `json:"data,omitempty"`
+}
+
+type responseData struct {
+	Scores     []float64 `json:"scores"`
+	TokenUsage int64     `json:"token_usage"`
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestReranker(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewReranker(ctx, &Config{})
+	assert.Error(t, err)
+
+	var gotReq request
+	r, err := NewReranker(ctx, &Config{
+		AK:        "ak",
+		SK:        "sk",
+		AccountID: "account",
+		TitleKey:  "title",
+		TopN:      2,
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, path, req.URL.Path)
+			assert.Equal(t, "account", req.Header.Get("V-Account-Id"))
+			assert.NotEmpty(t, req.Header.Get("Authorization"))
+			body, _ := io.ReadAll(req.Body)
+			assert.NoError(t, sonic.Unmarshal(body, &gotReq))
+			respBody, _ := sonic.Marshal(&response{Data: responseData{Scores: []float64{0.1, 0.9, 0.5}}})
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(respBody))}, nil
+		})},
+	})
+	assert.NoError(t, err)
+
+	docs := []*schema.Document{
+		{ID: "1", Content: "the weather is good"},
+		{ID: "2", Content: "eino is a llm framework", MetaData: map[string]any{"title": "eino"}},
+		{ID: "3", Content: "golang"},
+	}
+
+	_, err = r.Transform(ctx, docs)
+	assert.Error(t, err)
+
+	result, err := r.Transform(ctx, docs, WithQuery("what is eino"))
+	assert.NoError(t, err)
+	assert.Equal(t, defaultModel, gotReq.RerankModel)
+	assert.Len(t, gotReq.Datas, 3)
+	assert.Equal(t, "what is eino", gotReq.Datas[0].Query)
+	assert.Equal(t, "eino", gotReq.Datas[1].Title)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "2", result[0].ID)
+	assert.Equal(t, 0.9, result[0].Score())
+	assert.Equal(t, "3", result[1].ID)
+}
+
+func TestRerankerError(t *testing.T) {
+	ctx := context.Background()
+	r, err := NewReranker(ctx, &Config{
+		AK: "ak",
+		SK: "sk",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			respBody, _ := sonic.Marshal(&response{Code: 1000001, Message: "unauthorized"})
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(respBody))}, nil
+		})},
+	})
+	assert.NoError(t, err)
+
+	_, err = r.Rerank(ctx, "what is eino", []*schema.Document{{ID: "1", Content: "eino"}})
+	assert.Error(t, err)
+}
@@ -3,6 +3,8 @@ module github.com/cloudwego/eino-ext/components/document/transformer/splitter/ht
 go 1.18
 
 require (
+	github.com/PuerkitoBio/goquery v1.8.1
+	github.com/andybalholm/cascadia v1.3.1
 	github.com/cloudwego/eino v0.3.27
 	golang.org/x/net v0.33.0
 )
@@ -0,0 +1,258 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package html
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	// indentMark and spaceMark keep the indents of the nested lists and the spaces of the preformatted text,
+	// when the lines are trimmed.
+	indentMark = "\x00"
+	spaceMark  = "\x01"
+)
+
+var skippedTags = map[string]bool{
+	"script": true, "style": true, "noscript": true, "template": true,
+	"head": true, "svg": true, "iframe": true, "canvas": true, "button": true,
+}
+
+var blockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "dd": true, "details": true,
+	"div": true, "dl": true, "dt": true, "fieldset": true, "figcaption": true, "figure": true,
+	"footer": true, "form": true, "header": true, "main": true, "nav": true, "p": true,
+	"section": true, "summary": true, "caption": true, "body": true, "html": true,
+}
+
+// toMarkdown converts the node to markdown, with the headings, lists, preformatted text and tables kept.
+func toMarkdown(n *html.Node) string {
+	sb := &strings.Builder{}
+	renderNode(sb, n, 0)
+
+	lines := strings.Split(sb.String(), "\n")
+	ret := make([]string, 0, len(lines))
+	blank := true
+	for _, line := range lines {
+		// the marks are not spaces, so they are kept
+		line = strings.Join(strings.Fields(line), " ")
+		line = strings.ReplaceAll(line, indentMark, "  ")
+		line = strings.ReplaceAll(line, spaceMark, " ")
+		if strings.TrimSpace(line) == "" {
+			if !blank {
+				ret = append(ret, "")
+			}
+			blank = true
+			continue
+		}
+		ret = append(ret, line)
+		blank = false
+	}
+	return strings.TrimSpace(strings.Join(ret, "\n"))
+}
+
+func renderNode(sb *strings.Builder, n *html.Node, depth int) {
+	switch n.Type {
+	case html.TextNode:
+		sb.WriteString(collapseSpaces(n.Data))
+		return
+	case html.ElementNode:
+	case html.DocumentNode:
+		renderChildren(sb, n, depth)
+		return
+	default:
+		return
+	}
+
+	tag := n.Data
+	switch {
+	case skippedTags[tag]:
+	case tag == "br":
+		sb.WriteString("\n")
+	case tag == "hr":
+		sb.WriteString("\n\n---\n\n")
+	case len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6':
+		level, _ := strconv.Atoi(tag[1:])
+		sb.WriteString("\n\n" + strings.Repeat("#", level) + " " + inlineText(n) + "\n\n")
+	case tag == "pre":
+		text := strings.Trim(rawText(n), "\n")
+		text = strings.NewReplacer(" ", spaceMark, "\t", spaceMark+spaceMark+spaceMark+spaceMark).Replace(text)
+		sb.WriteString("\n\n```\n" + text + "\n```\n\n")
+	case tag == "table":
+		sb.WriteString("\n\n" + tableMarkdown(n) + "\n\n")
+	case tag == "ul" || tag == "ol":
+		// the nested lists are not separated by blank lines
+		sep := ""
+		if depth == 0 {
+			sep = "\n\n"
+		}
+		sb.WriteString(sep)
+		num := 0
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode || c.Data != "li" {
+				renderNode(sb, c, depth)
+				continue
+			}
+			num++
+			marker := "- "
+			if tag == "ol" {
+				marker = strconv.Itoa(num) + ". "
+			}
+			sb.WriteString("\n" + strings.Repeat(indentMark, depth) + marker)
+			renderChildren(sb, c, depth+1)
+		}
+		sb.WriteString(sep)
+	case tag == "li":
+		sb.WriteString("\n" + strings.Repeat(indentMark, depth) + "- ")
+		renderChildren(sb, n, depth+1)
+		sb.WriteString("\n")
+	case blockTags[tag]:
+		sb.WriteString("\n\n")
+		renderChildren(sb, n, depth)
+		sb.WriteString("\n\n")
+	case tag == "td" || tag == "th":
+		renderChildren(sb, n, depth)
+		sb.WriteString(" ")
+	default:
+		renderChildren(sb, n, depth)
+	}
+}
+
+func renderChildren(sb *strings.Builder, n *html.Node, depth int) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		renderNode(sb, c, depth)
+	}
+}
+
+// inlineText renders the node in a line.
+func inlineText(n *html.Node) string {
+	sb := &strings.Builder{}
+	renderChildren(sb, n, 0)
+	return strings.Join(strings.Fields(strings.NewReplacer(indentMark, "", spaceMark, " ").Replace(sb.String())), " ")
+}
+
+// rawText returns the text of the node with the spaces kept.
+func rawText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	sb := &strings.Builder{}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == "br" {
+			sb.WriteString("\n")
+			continue
+		}
+		sb.WriteString(rawText(c))
+	}
+	return sb.String()
+}
+
+func collapseSpaces(s string) string {
+	sb := &strings.Builder{}
+	space := false
+	for _, r := range s {
+		switch r {
+		case ' ', '\t', '\n', '\r', '\f':
+			space = true
+		default:
+			if space {
+				sb.WriteByte(' ')
+				space = false
+			}
+			sb.WriteRune(r)
+		}
+	}
+	if space {
+		sb.WriteByte(' ')
+	}
+	return sb.String()
+}
+
+// tableMarkdown renders the rows of the table as a markdown table with the first row as the header,
+// and the cells spanning multiple columns are repeated as empty cells.
+func tableMarkdown(table *html.Node) string {
+	var rows [][]string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			switch c.Data {
+			case "thead", "tbody", "tfoot":
+				walk(c)
+			case "tr":
+				var row []string
+				for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.Type != html.ElementNode || (cell.Data != "td" && cell.Data != "th") {
+						continue
+					}
+					row = append(row, strings.ReplaceAll(inlineText(cell), "|", `\|`))
+					span, _ := strconv.Atoi(attr(cell, "colspan"))
+					for i := 1; i < span && i < 100; i++ {
+						row = append(row, "")
+					}
+				}
+				if len(row) > 0 {
+					rows = append(rows, row)
+				}
+			}
+		}
+	}
+	walk(table)
+
+	cols := 0
+	for _, r := range rows {
+		if len(r) > cols {
+			cols = len(r)
+		}
+	}
+	if cols == 0 {
+		return ""
+	}
+
+	sb := &strings.Builder{}
+	for i, r := range rows {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("|")
+		for j := 0; j < cols; j++ {
+			v := ""
+			if j < len(r) {
+				v = r[j]
+			}
+			sb.WriteString(" " + v + " |")
+		}
+		if i == 0 {
+			sb.WriteString("\n|" + strings.Repeat(" --- |", cols))
+		}
+	}
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
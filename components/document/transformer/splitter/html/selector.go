@@ -0,0 +1,170 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package html
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+// MetaKeySelector is the metadata key of the CSS selector which selected the node of the document.
+const MetaKeySelector = "_selector"
+
+// SelectorConfig configures how the nodes are selected from the HTML documents.
+type SelectorConfig struct {
+	// Selectors are the CSS selectors of the nodes, each selected node is converted to a document.
+	// The nodes are in the order of the selectors, then in the document order.
+	// Example: []string{"article.post", "table.pricing"}
+	Selectors []string
+	// MetaSelectors are the CSS selectors of the metadata in the selected node, keyed by the metadata key.
+	// The value is the text of the first matched node, or its attribute if the selector ends with @attribute.
+	// Example: {"title": "h2", "link": "a.permalink@href"}
+	MetaSelectors map[string]string
+	// MinLength drops the documents whose content is shorter than MinLength bytes, e.g. the empty cells of a layout.
+	MinLength int
+}
+
+// NewSelectorSplitter creates a transformer that extracts the nodes matched by CSS selectors from HTML content,
+// and emits one document per node, with the content converted to markdown, in which tables are markdown tables.
+// It is useful for scraping structured pages into RAG stores, e.g. the documents loaded by the url loader.
+//
+// Example:
+//
+//	Input HTML:
+//	  <div class="item"><h2>Eino</h2><p>LLM framework</p></div>
+//	  <div class="item"><h2>Hertz</h2><p>HTTP framework</p></div>
+//
+//	With config Selectors: []string{"div.item"}, MetaSelectors: {"name": "h2"}
+//
+//	Will produce two documents:
+//	1. {
+//	     Content: "## Eino\n\nLLM framework",
+//	     Metadata: {"_selector": "div.item", "name": "Eino"}
+//	   }
+//	2. {
+//	     Content: "## Hertz\n\nHTTP framework",
+//	     Metadata: {"_selector": "div.item", "name": "Hertz"}
+//	   }
+func NewSelectorSplitter(ctx context.Context, config *SelectorConfig) (document.Transformer, error) {
+	if config == nil || len(config.Selectors) == 0 {
+		return nil, fmt.Errorf("selectors are required")
+	}
+
+	metaSelectors := make(map[string]metaSelector, len(config.MetaSelectors))
+	for k, v := range config.MetaSelectors {
+		ms := metaSelector{selector: v}
+		if i := strings.LastIndex(v, "@"); i >= 0 && !strings.ContainsAny(v[i:], "]) ") {
+			ms.selector, ms.attr = v[:i], v[i+1:]
+		}
+		metaSelectors[k] = ms
+	}
+
+	for _, sel := range config.Selectors {
+		if _, err := cascadia.Compile(sel); err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", sel, err)
+		}
+	}
+	for k, ms := range metaSelectors {
+		if _, err := cascadia.Compile(ms.selector); err != nil {
+			return nil, fmt.Errorf("invalid selector %q of metadata %s: %w", ms.selector, k, err)
+		}
+	}
+
+	return &selectorSplitter{
+		selectors:     config.Selectors,
+		metaSelectors: metaSelectors,
+		minLength:     config.MinLength,
+	}, nil
+}
+
+type metaSelector struct {
+	selector string
+	// attr is the attribute of the node as the value, or the text of the node if empty.
+	attr string
+}
+
+type selectorSplitter struct {
+	selectors     []string
+	metaSelectors map[string]metaSelector
+	minLength     int
+}
+
+func (s *selectorSplitter) Transform(ctx context.Context, docs []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	var ret []*schema.Document
+	for _, doc := range docs {
+		root, err := goquery.NewDocumentFromReader(strings.NewReader(doc.Content))
+		if err != nil {
+			return nil, fmt.Errorf("parse document[%s] fail: %w", doc.ID, err)
+		}
+
+		for _, sel := range s.selectors {
+			root.Find(sel).Each(func(_ int, node *goquery.Selection) {
+				content := toMarkdown(node.Nodes[0])
+				if len(content) == 0 || len(content) < s.minLength {
+					return
+				}
+
+				nDoc := &schema.Document{
+					ID:       doc.ID,
+					Content:  content,
+					MetaData: deepCopyAnyMap(doc.MetaData),
+				}
+				if nDoc.MetaData == nil {
+					nDoc.MetaData = make(map[string]any, len(s.metaSelectors)+1)
+				}
+				nDoc.MetaData[MetaKeySelector] = sel
+				for k, ms := range s.metaSelectors {
+					if v, ok := ms.value(node); ok {
+						nDoc.MetaData[k] = v
+					}
+				}
+				ret = append(ret, nDoc)
+			})
+		}
+	}
+	return ret, nil
+}
+
+func (s *selectorSplitter) GetType() string {
+	return "HTMLSelectorSplitter"
+}
+
+// value returns the text or the attribute of the first matched node in the selected node, including the selected node itself.
+func (ms metaSelector) value(node *goquery.Selection) (string, bool) {
+	matched := node.Filter(ms.selector)
+	if matched.Length() == 0 {
+		matched = node.Find(ms.selector)
+	}
+	if matched.Length() == 0 {
+		return "", false
+	}
+	matched = matched.First()
+
+	if ms.attr != "" {
+		v, ok := matched.Attr(ms.attr)
+		return strings.TrimSpace(v), ok
+	}
+	v := strings.Join(strings.Fields(matched.Text()), " ")
+	return v, v != ""
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package html
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+var selectorHTML = `<!DOCTYPE html>
+<html>
+<head><title>Frameworks</title><style>.item { color: red; }</style></head>
+<body>
+    <div class="item">
+        <h2>Eino</h2>
+        <p>The ultimate LLM application
+           development framework.</p>
+        <a class="link" href="https://github.com/cloudwego/eino">GitHub</a>
+    </div>
+    <div class="item">
+        <h2>Hertz</h2>
+        <p>HTTP framework.</p>
+    </div>
+    <div class="item"> </div>
+    <table class="pricing">
+        <thead><tr><th>Plan</th><th>Price</th></tr></thead>
+        <tbody>
+            <tr><td>Free</td><td>$0</td></tr>
+            <tr><td colspan="2">Contact us | sales</td></tr>
+        </tbody>
+    </table>
+</body>
+</html>`
+
+func TestHTMLSelectorSplitter(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *SelectorConfig
+		input   []*schema.Document
+		want    []*schema.Document
+		wantErr string
+	}{
+		{
+			name: "success",
+			config: &SelectorConfig{
+				Selectors: []string{"table.pricing", "div.item"},
+				MetaSelectors: map[string]string{
+					"name": "h2",
+					"link": "a.link@href",
+				},
+			},
+			input: []*schema.Document{{
+				ID:       "id",
+				Content:  selectorHTML,
+				MetaData: map[string]any{"source": "a.html"},
+			}},
+			want: []*schema.Document{{
+				ID:      "id",
+				Content: "| Plan | Price |\n| --- | --- |\n| Free | $0 |\n| Contact us \\| sales | |",
+				MetaData: map[string]any{
+					"source":        "a.html",
+					MetaKeySelector: "table.pricing",
+				},
+			}, {
+				ID:      "id",
+				Content: "## Eino\n\nThe ultimate LLM application development framework.\n\nGitHub",
+				MetaData: map[string]any{
+					"source":        "a.html",
+					MetaKeySelector: "div.item",
+					"name":          "Eino",
+					"link":          "https://github.com/cloudwego/eino",
+				},
+			}, {
+				ID:      "id",
+				Content: "## Hertz\n\nHTTP framework.",
+				MetaData: map[string]any{
+					"source":        "a.html",
+					MetaKeySelector: "div.item",
+					"name":          "Hertz",
+				},
+			}},
+		},
+		{
+			name: "min length",
+			config: &SelectorConfig{
+				Selectors: []string{"div.item p"},
+				MinLength: 20,
+			},
+			input: []*schema.Document{{
+				ID:      "id",
+				Content: selectorHTML,
+			}},
+			want: []*schema.Document{{
+				ID:       "id",
+				Content:  "The ultimate LLM application development framework.",
+				MetaData: map[string]any{MetaKeySelector: "div.item p"},
+			}},
+		},
+		{
+			name:    "invalid selector",
+			config:  &SelectorConfig{Selectors: []string{"div["}},
+			wantErr: `invalid selector "div["`,
+		},
+		{
+			name:    "no selector",
+			config:  &SelectorConfig{},
+			wantErr: "selectors are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			splitter, err := NewSelectorSplitter(ctx, tt.config)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NewSelectorSplitter() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := splitter.Transform(ctx, tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				for i := range got {
+					t.Logf("got[%d]: %q %v", i, got[i].Content, got[i].MetaData)
+				}
+				t.Errorf("Transform() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "<div><h1>Title</h1><p>Hello <b>world</b>,<br>bye.</p><script>alert(1)</script></div>",
+			want:  "# Title\n\nHello world,\nbye.",
+		},
+		{
+			input: "<ul><li>a<ul><li>a.1</li><li>a.2</li></ul></li><li> b </li></ul><ol><li>x</li><li>y</li></ol>",
+			want:  "- a\n  - a.1\n  - a.2\n- b\n\n1. x\n2. y",
+		},
+		{
+			input: "<div><p>code:</p><pre>func main() {\n\tfmt.Println(\"hi\")\n}</pre><hr/><p>end</p></div>",
+			want:  "code:\n\n```\nfunc main() {\n    fmt.Println(\"hi\")\n}\n```\n\n---\n\nend",
+		},
+		{
+			input: "<table><tr><td>a</td><td>b</td><td>c</td></tr><tr><td>1</td></tr></table>",
+			want:  "| a | b | c |\n| --- | --- | --- |\n| 1 | | |",
+		},
+	}
+
+	for _, tt := range tests {
+		node, err := html.Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := toMarkdown(node); got != tt.want {
+			t.Errorf("toMarkdown(%s) got = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/document/transformer/splitter/token"
+)
+
+func main() {
+	ctx := context.Background()
+
+	splitter, err := token.NewSplitter(ctx, &token.Config{
+		ChunkSize:   64,
+		OverlapSize: 16,
+		Model:       "gpt-4o",
+	})
+	if err != nil {
+		log.Fatalf("NewSplitter of token splitter failed, err=%v", err)
+	}
+
+	content := strings.Repeat("Eino is an LLM application development framework in Golang.\n", 20)
+	docs, err := splitter.Transform(ctx, []*schema.Document{
+		{
+			Content: content,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Transform of token splitter failed, err=%v", err)
+	}
+
+	for idx, doc := range docs {
+		fmt.Printf("====== %02d ======\n", idx)
+		fmt.Println(doc.Content)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+	"github.com/pkoukk/tiktoken-go"
+
+	"github.com/cloudwego/eino-ext/components/document/transformer/splitter/recursive"
+)
+
+// TokenizerFunc returns the number of tokens of text.
+// It can be used to plug in tokenizers other than tiktoken, such as sentencepiece.
+type TokenizerFunc func(text string) int
+
+type Config struct {
+	// ChunkSize is the maximum number of tokens of each chunk.
+	ChunkSize int
+	// OverlapSize is the maximum allowed overlapping tokens between chunks, it shares the same semantics as recursive splitter.
+	OverlapSize int
+	// Separators are sequentially used to split text. ["\n", ".", "?", "!"] by default.
+	Separators []string
+	// KeepType specifies if separator will be kept in split chunks. Discard separator by default.
+	KeepType recursive.KeepType
+
+	// Model is used to choose tiktoken encoding, e.g. "gpt-4o", "gpt-3.5-turbo".
+	// Only one of Model, Encoding and Tokenizer should be set.
+	Model string
+	// Encoding is the tiktoken encoding name, e.g. "cl100k_base", "o200k_base".
+	Encoding string
+	// Tokenizer is a custom function used to count tokens.
+	// If none of Model, Encoding and Tokenizer is set, "cl100k_base" encoding is used.
+	Tokenizer TokenizerFunc
+}
+
+// NewSplitter create a splitter which measures chunk size in tokens.
+func NewSplitter(ctx context.Context, config *Config) (document.Transformer, error) {
+	if config == nil {
+		return nil, fmt.Errorf("config should not be nil")
+	}
+
+	tokenizer, err := getTokenizer(config)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := recursive.NewSplitter(ctx, &recursive.Config{
+		ChunkSize:   config.ChunkSize,
+		OverlapSize: config.OverlapSize,
+		Separators:  config.Separators,
+		LenFunc:     tokenizer,
+		KeepType:    config.KeepType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &splitter{rs: rs}, nil
+}
+
+type splitter struct {
+	rs document.Transformer
+}
+
+func (s *splitter) Transform(ctx context.Context, docs []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	return s.rs.Transform(ctx, docs, opts...)
+}
+
+func (s *splitter) GetType() string {
+	return "TokenSplitter"
+}
+
+const defaultEncoding = "cl100k_base"
+
+func getTokenizer(config *Config) (TokenizerFunc, error) {
+	set := 0
+	for _, b := range []bool{len(config.Model) > 0, len(config.Encoding) > 0, config.Tokenizer != nil} {
+		if b {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("only one of model, encoding and tokenizer can be set")
+	}
+
+	if config.Tokenizer != nil {
+		return config.Tokenizer, nil
+	}
+
+	var (
+		tkm *tiktoken.Tiktoken
+		err error
+	)
+	switch {
+	case len(config.Model) > 0:
+		tkm, err = tiktoken.EncodingForModel(config.Model)
+		if err != nil {
+			return nil, fmt.Errorf("get encoding for model[%s] failed: %w", config.Model, err)
+		}
+	default:
+		encoding := config.Encoding
+		if len(encoding) == 0 {
+			encoding = defaultEncoding
+		}
+		tkm, err = tiktoken.GetEncoding(encoding)
+		if err != nil {
+			return nil, fmt.Errorf("get encoding[%s] failed: %w", encoding, err)
+		}
+	}
+
+	return func(text string) int {
+		return len(tkm.Encode(text, nil, nil))
+	}, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package token
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/pkoukk/tiktoken-go"
+)
+
+// wordCount treats every whitespace separated word as one token.
+func wordCount(text string) int {
+	return len(strings.Fields(text))
+}
+
+// byteLoader loads encodings without merges, so that every byte is a token, to use tiktoken without downloading encodings.
+type byteLoader struct {
+	files []string
+}
+
+func (l *byteLoader) LoadTiktokenBpe(file string) (map[string]int, error) {
+	l.files = append(l.files, file)
+	ranks := make(map[string]int, 256)
+	for i := 0; i < 256; i++ {
+		ranks[string([]byte{byte(i)})] = i
+	}
+	return ranks, nil
+}
+
+func TestTokenSplitter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewSplitter(ctx, &Config{
+			ChunkSize: 10,
+			Model:     "gpt-4o",
+			Tokenizer: wordCount,
+		})
+		if err == nil {
+			t.Fatal("expect error when both model and tokenizer are set")
+		}
+
+		_, err = NewSplitter(ctx, &Config{Tokenizer: wordCount})
+		if err == nil {
+			t.Fatal("expect error when chunk size is zero")
+		}
+
+		_, err = NewSplitter(ctx, nil)
+		if err == nil {
+			t.Fatal("expect error when config is nil")
+		}
+	})
+
+	t.Run("tiktoken", func(t *testing.T) {
+		loader := &byteLoader{}
+		tiktoken.SetBpeLoader(loader)
+		defer tiktoken.SetBpeLoader(tiktoken.NewDefaultBpeLoader())
+
+		for _, config := range []*Config{
+			{ChunkSize: 5, Separators: []string{"\n"}, Model: "gpt-4o"},
+			{ChunkSize: 5, Separators: []string{"\n"}, Encoding: "cl100k_base"},
+		} {
+			s, err := NewSplitter(ctx, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := s.Transform(ctx, []*schema.Document{{ID: "1", Content: "ab\ncd\nef"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := []*schema.Document{
+				{ID: "1", Content: "ab\ncd"},
+				{ID: "1", Content: "ef"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Transform() got = %v, want %v", got, want)
+			}
+		}
+
+		if len(loader.files) != 2 || !strings.Contains(loader.files[0], "o200k_base") || !strings.Contains(loader.files[1], "cl100k_base") {
+			t.Errorf("unexpected loaded encodings: %v", loader.files)
+		}
+
+		_, err := NewSplitter(ctx, &Config{ChunkSize: 5, Model: "unknown-model"})
+		if err == nil {
+			t.Fatal("expect error when model is unknown")
+		}
+	})
+
+	t.Run("custom tokenizer", func(t *testing.T) {
+		s, err := NewSplitter(ctx, &Config{
+			ChunkSize:   4,
+			OverlapSize: 1,
+			Separators:  []string{"\n"},
+			Tokenizer:   wordCount,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := s.Transform(ctx, []*schema.Document{
+			{ID: "1", Content: "a b\nc d\ne f", MetaData: map[string]any{"k": "v"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []*schema.Document{
+			{ID: "1", Content: "a b\nc d", MetaData: map[string]any{"k": "v"}},
+			{ID: "1", Content: "e f", MetaData: map[string]any{"k": "v"}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Transform() got = %v, want %v", got, want)
+		}
+	})
+}
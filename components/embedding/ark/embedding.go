@@ -28,6 +28,9 @@ import (
 
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components/embedding"
+
+	"github.com/cloudwego/eino-ext/libs/acl/retry"
+	"github.com/cloudwego/eino-ext/libs/embedproc"
 )
 
 var (
@@ -53,6 +56,11 @@ type EmbeddingConfig struct {
 	// Optional. Default: 2
 	RetryTimes *int `json:"retry_times"`
 
+	// Retry retries failed requests by the retry layer shared by the models and embedders, with jittered exponential
+	// backoff honoring Retry-After. If Retry is set, RetryTimes will not be used.
+	// Optional. Default: nil, requests are retried by the sdk with RetryTimes
+	Retry *retry.Config `json:"retry,omitempty"`
+
 	// BaseURL specifies the base URL for Ark service
 	// Optional. Default: "https://ark.cn-beijing.volces.com/api/v3"
 	BaseURL string `json:"base_url"`
@@ -70,11 +78,30 @@ type EmbeddingConfig struct {
 	// Model specifies the ID of endpoint on ark platform
 	// Required
 	Model string `json:"model"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
+
+	// QueryInstruction is prepended to the texts embedded as queries, see WithInputType.
+	// Newer Doubao embedding models are trained with instructions for asymmetric retrieval, e.g. DefaultQueryInstruction.
+	// Optional. Default: "", texts are embedded as is.
+	QueryInstruction string `json:"query_instruction,omitempty"`
+	// DocumentInstruction is prepended to the texts embedded as documents, which is the default input type.
+	// Optional. Default: "", texts are embedded as is.
+	DocumentInstruction string `json:"document_instruction,omitempty"`
+
+	// HybridModel specifies the ID of endpoint used by EmbedStringsHybrid,
+	// which must support sparse embedding, e.g. doubao-embedding-vision.
+	// Optional. Default: Model
+	HybridModel string `json:"hybrid_model,omitempty"`
 }
 
 type Embedder struct {
-	client *arkruntime.Client
-	conf   *EmbeddingConfig
+	client     *arkruntime.Client
+	httpClient *http.Client
+	conf       *EmbeddingConfig
 }
 
 func buildClient(config *EmbeddingConfig) *arkruntime.Client {
@@ -91,14 +118,22 @@ func buildClient(config *EmbeddingConfig) *arkruntime.Client {
 		config.RetryTimes = &defaultRetryTimes
 	}
 
+	retryTimes, httpClient := *config.RetryTimes, config.HTTPClient
+	if config.Retry != nil {
+		if httpClient == nil {
+			httpClient = &http.Client{Timeout: *config.Timeout}
+		}
+		retryTimes, httpClient = 0, retry.WrapHTTPClient(httpClient, config.Retry)
+	}
+
 	opts := []arkruntime.ConfigOption{
-		arkruntime.WithRetryTimes(*config.RetryTimes),
+		arkruntime.WithRetryTimes(retryTimes),
 		arkruntime.WithBaseUrl(config.BaseURL),
 		arkruntime.WithRegion(config.Region),
 		arkruntime.WithTimeout(*config.Timeout),
 	}
-	if config.HTTPClient != nil {
-		opts = append(opts, arkruntime.WithHTTPClient(config.HTTPClient))
+	if httpClient != nil {
+		opts = append(opts, arkruntime.WithHTTPClient(httpClient))
 	}
 
 	if len(config.APIKey) > 0 {
@@ -112,9 +147,22 @@ func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error
 
 	client := buildClient(config)
 
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		timeout := defaultTimeout
+		if config.Timeout != nil {
+			timeout = *config.Timeout
+		}
+		httpClient = &http.Client{Timeout: timeout}
+	}
+	if config.Retry != nil {
+		httpClient = retry.WrapHTTPClient(httpClient, config.Retry)
+	}
+
 	return &Embedder{
-		client: client,
-		conf:   config,
+		client:     client,
+		httpClient: httpClient,
+		conf:       config,
 	}, nil
 }
 
@@ -155,6 +203,10 @@ func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...emb
 		embeddings[i] = toFloat64(d.Embedding)
 	}
 
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, fmt.Errorf("[Ark]EmbedStrings error: %w", err)
+	}
+
 	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
 		Embeddings: embeddings,
 		Config:     conf,
@@ -181,7 +233,7 @@ func (e *Embedder) genRequest(texts []string, opts ...embedding.Option) (
 	options = embedding.GetCommonOptions(options, opts...)
 
 	req = model.EmbeddingRequestStrings{
-		Input:          texts,
+		Input:          e.withInstruction(texts, opts...),
 		Model:          dereferenceOrZero(options.Model),
 		EncodingFormat: model.EmbeddingEncodingFormatFloat, // only support Float for now?
 	}
@@ -81,5 +81,16 @@ func Test_EmbedStrings(t *testing.T) {
 			convey.So(err, convey.ShouldBeNil)
 			convey.So(len(vector), convey.ShouldEqual, 1)
 		})
+
+		PatchConvey("test embedding instruction", func() {
+			embedder.conf.QueryInstruction = "query: "
+			embedder.conf.DocumentInstruction = "passage: "
+
+			req := embedder.genRequest([]string{"asd"})
+			convey.So(req.Input, convey.ShouldResemble, []string{"passage: asd"})
+
+			req = embedder.genRequest([]string{"asd"}, WithInputType(InputTypeQuery))
+			convey.So(req.Input, convey.ShouldResemble, []string{"query: asd"})
+		})
 	})
 }
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/embedding/ark"
+)
+
+func main() {
+	ctx := context.Background()
+
+	embedder, err := ark.NewEmbedder(ctx, &ark.EmbeddingConfig{
+		APIKey: os.Getenv("ARK_API_KEY"),
+		Model:  os.Getenv("ARK_MODEL"),
+		// attention: model must support sparse embedding, for example: doubao-embedding-vision
+		HybridModel:      os.Getenv("ARK_HYBRID_MODEL"),
+		QueryInstruction: ark.DefaultQueryInstruction,
+	})
+	if err != nil {
+		log.Printf("new embedder error: %v\n", err)
+		return
+	}
+
+	docs := []*schema.Document{
+		{ID: "1", Content: "Eino is a LLM application development framework in Golang"},
+		{ID: "2", Content: "CloudWeGo is a set of middleware for building microservices"},
+	}
+	texts := make([]string, len(docs))
+	for i, doc := range docs {
+		texts[i] = doc.Content
+	}
+
+	embeddings, err := embedder.EmbedStringsHybrid(ctx, texts)
+	if err != nil {
+		log.Printf("embedding error: %v\n", err)
+		return
+	}
+	for i, doc := range docs {
+		// store the documents with dense and sparse vectors, e.g. by es8 or milvus indexer
+		doc.WithDenseVector(embeddings[i].Dense).WithSparseVector(embeddings[i].Sparse)
+		log.Printf("id: %s, dense dimensions: %d, sparse: %v\n", doc.ID, len(embeddings[i].Dense), embeddings[i].Sparse)
+	}
+
+	query, err := embedder.EmbedStringsHybrid(ctx, []string{"what is eino"}, ark.WithInputType(ark.InputTypeQuery))
+	if err != nil {
+		log.Printf("embedding error: %v\n", err)
+		return
+	}
+
+	log.Printf("query sparse: %v\n", query[0].Sparse)
+}
@@ -5,6 +5,8 @@ go 1.18
 require (
 	github.com/bytedance/mockey v1.2.12
 	github.com/cloudwego/eino v0.3.27
+	github.com/cloudwego/eino-ext/libs/acl/retry v0.0.0-20261016112401-7f62d5de0f0a
+	github.com/cloudwego/eino-ext/libs/embedproc v0.0.0-20261016103348-1f45c6cadc76
 	github.com/smartystreets/goconvey v1.8.1
 	github.com/volcengine/volcengine-go-sdk v1.0.181
 )
@@ -47,3 +49,6 @@ require (
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// TODO: drop the replace once libs/acl/retry is tagged, the required version is not on the main branch yet
+replace github.com/cloudwego/eino-ext/libs/acl/retry => ../../../libs/acl/retry
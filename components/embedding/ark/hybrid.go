@@ -0,0 +1,198 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+// CallbackExtraKeySparse is the key of the sparse embeddings ([]map[int]float64) in embedding.CallbackOutput.Extra
+// of EmbedStringsHybrid.
+const CallbackExtraKeySparse = "sparse_embeddings"
+
+// HybridEmbedding is the dense and sparse embedding of a text, for hybrid search.
+type HybridEmbedding struct {
+	Dense []float64
+	// Sparse maps token ids to weights, which can be set by schema.Document.WithSparseVector.
+	Sparse map[int]float64
+}
+
+type multimodalInput struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type sparseEmbeddingInput struct {
+	Type string `json:"type"`
+}
+
+type multimodalEmbeddingRequest struct {
+	Model           string                `json:"model"`
+	Input           []multimodalInput     `json:"input"`
+	EncodingFormat  string                `json:"encoding_format"`
+	SparseEmbedding *sparseEmbeddingInput `json:"sparse_embedding,omitempty"`
+}
+
+type sparseEmbedding struct {
+	Index int     `json:"index"`
+	Value float64 `json:"value"`
+}
+
+type multimodalEmbeddingResponse struct {
+	Data struct {
+		Embedding       []float64         `json:"embedding"`
+		SparseEmbedding []sparseEmbedding `json:"sparse_embedding"`
+	} `json:"data"`
+	Usage struct {
+		PromptTokens int `json:"prompt_tokens"`
+		TotalTokens  int `json:"total_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// EmbedStringsHybrid embeds texts into dense and sparse vectors by the multimodal embedding API of HybridModel,
+// so that hybrid search pipelines don't depend on the builtin embedding of vikingdb.
+// Options are the same as EmbedStrings, and PostProcess only applies to the dense vectors.
+// Only APIKey authentication is supported.
+func (e *Embedder) EmbedStringsHybrid(ctx context.Context, texts []string, opts ...embedding.Option) (
+	embeddings []*HybridEmbedding, err error) {
+	modelName := e.conf.HybridModel
+	if len(modelName) == 0 {
+		modelName = e.conf.Model
+	}
+	options := embedding.GetCommonOptions(&embedding.Options{Model: &modelName}, opts...)
+	conf := &embedding.Config{
+		Model:          dereferenceOrZero(options.Model),
+		EncodingFormat: "float",
+	}
+
+	ctx = callbacks.EnsureRunInfo(ctx, e.GetType(), components.ComponentOfEmbedding)
+	ctx = callbacks.OnStart(ctx, &embedding.CallbackInput{
+		Texts:  texts,
+		Config: conf,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if len(e.conf.APIKey) == 0 {
+		return nil, fmt.Errorf("[Ark]EmbedStringsHybrid error: APIKey is required")
+	}
+
+	usage := &embedding.TokenUsage{}
+	dense := make([][]float64, len(texts))
+	sparse := make([]map[int]float64, len(texts))
+	// the multimodal embedding API fuses all the inputs of a request into one embedding, so texts are embedded one by one
+	for i, text := range e.withInstruction(texts, opts...) {
+		resp, err := e.createMultimodalEmbedding(ctx, &multimodalEmbeddingRequest{
+			Model:           conf.Model,
+			Input:           []multimodalInput{{Type: "text", Text: text}},
+			EncodingFormat:  conf.EncodingFormat,
+			SparseEmbedding: &sparseEmbeddingInput{Type: "enabled"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[Ark]EmbedStringsHybrid error: %w", err)
+		}
+
+		dense[i] = resp.Data.Embedding
+		sparse[i] = make(map[int]float64, len(resp.Data.SparseEmbedding))
+		for _, s := range resp.Data.SparseEmbedding {
+			sparse[i][s.Index] = s.Value
+		}
+		usage.PromptTokens += resp.Usage.PromptTokens
+		usage.TotalTokens += resp.Usage.TotalTokens
+	}
+
+	if dense, err = embedproc.Apply(dense, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, fmt.Errorf("[Ark]EmbedStringsHybrid error: %w", err)
+	}
+
+	embeddings = make([]*HybridEmbedding, len(texts))
+	for i := range texts {
+		embeddings[i] = &HybridEmbedding{Dense: dense[i], Sparse: sparse[i]}
+	}
+
+	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
+		Embeddings: dense,
+		Config:     conf,
+		TokenUsage: usage,
+		Extra:      map[string]any{CallbackExtraKeySparse: sparse},
+	})
+
+	return embeddings, nil
+}
+
+func (e *Embedder) createMultimodalEmbedding(ctx context.Context, req *multimodalEmbeddingRequest) (
+	*multimodalEmbeddingResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request failed: %w", err)
+	}
+
+	baseURL := e.conf.BaseURL
+	if len(baseURL) == 0 {
+		baseURL = defaultBaseURL
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(baseURL, "/")+"/embeddings/multimodal", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("new request failed: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+e.conf.APIKey)
+
+	httpResp, err := e.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response failed: %w", err)
+	}
+
+	resp := &multimodalEmbeddingResponse{}
+	if err = json.Unmarshal(respBody, resp); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed, status=%d, body=%s: %w", httpResp.StatusCode, respBody, err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("status=%d, code=%s, message=%s", httpResp.StatusCode, resp.Error.Code, resp.Error.Message)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status=%d, body=%s", httpResp.StatusCode, respBody)
+	}
+
+	return resp, nil
+}
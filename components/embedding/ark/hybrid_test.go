@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ark
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+func TestEmbedStringsHybrid(t *testing.T) {
+	PatchConvey("test EmbedStringsHybrid", t, func() {
+		ctx := context.Background()
+
+		var reqs []*multimodalEmbeddingRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/embeddings/multimodal" || r.Header.Get("Authorization") != "Bearer mock" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"code":"AuthenticationError","message":"invalid api key"}}`))
+				return
+			}
+
+			req := &multimodalEmbeddingRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			reqs = append(reqs, req)
+			_, _ = w.Write([]byte(`{"data":{"embedding":[3,4],"sparse_embedding":[{"index":7,"value":0.5},{"index":42,"value":0.25}]},"usage":{"prompt_tokens":2,"total_tokens":2}}`))
+		}))
+		defer srv.Close()
+
+		embedder, err := NewEmbedder(ctx, &EmbeddingConfig{
+			APIKey:           "mock",
+			BaseURL:          srv.URL,
+			Model:            "text-model",
+			HybridModel:      "vision-model",
+			QueryInstruction: "query: ",
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		PatchConvey("test success", func() {
+			embeddings, err := embedder.EmbedStringsHybrid(ctx, []string{"asd", "qwe"}, WithInputType(InputTypeQuery),
+				embedproc.WithConfig(&embedproc.Config{Normalize: true}))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(embeddings), convey.ShouldEqual, 2)
+			convey.So(embeddings[1].Dense, convey.ShouldResemble, []float64{0.6, 0.8})
+			convey.So(embeddings[1].Sparse, convey.ShouldResemble, map[int]float64{7: 0.5, 42: 0.25})
+
+			convey.So(len(reqs), convey.ShouldEqual, 2)
+			convey.So(reqs[0].Model, convey.ShouldEqual, "vision-model")
+			convey.So(reqs[0].Input, convey.ShouldResemble, []multimodalInput{{Type: "text", Text: "query: asd"}})
+			convey.So(reqs[0].SparseEmbedding.Type, convey.ShouldEqual, "enabled")
+		})
+
+		PatchConvey("test api error", func() {
+			embedder.conf.APIKey = "invalid"
+
+			embeddings, err := embedder.EmbedStringsHybrid(ctx, []string{"asd"})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "invalid api key")
+			convey.So(embeddings, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test api key required", func() {
+			embedder.conf.APIKey = ""
+
+			_, err := embedder.EmbedStringsHybrid(ctx, []string{"asd"})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ark
+
+import (
+	"github.com/cloudwego/eino/components/embedding"
+)
+
+// InputType is the role of the embedded texts in retrieval, which selects the instruction prepended to them.
+type InputType string
+
+const (
+	// InputTypeDocument embeds texts as documents with EmbeddingConfig.DocumentInstruction.
+	InputTypeDocument InputType = "document"
+	// InputTypeQuery embeds texts as queries with EmbeddingConfig.QueryInstruction.
+	InputTypeQuery InputType = "query"
+)
+
+// DefaultQueryInstruction is the query instruction recommended by Doubao embedding models for web search,
+// documents are embedded without instruction.
+const DefaultQueryInstruction = "Instruct: Given a web search query, retrieve relevant passages that answer the query\nQuery: "
+
+type options struct {
+	inputType InputType
+}
+
+// WithInputType sets the input type of the texts, default InputTypeDocument.
+// Use InputTypeQuery for the embedder of retrievers.
+func WithInputType(inputType InputType) embedding.Option {
+	return embedding.WrapImplSpecificOptFn(func(o *options) {
+		o.inputType = inputType
+	})
+}
+
+func (e *Embedder) withInstruction(texts []string, opts ...embedding.Option) []string {
+	o := embedding.GetImplSpecificOptions(&options{inputType: InputTypeDocument}, opts...)
+
+	instruction := e.conf.DocumentInstruction
+	if o.inputType == InputTypeQuery {
+		instruction = e.conf.QueryInstruction
+	}
+	if len(instruction) == 0 {
+		return texts
+	}
+
+	input := make([]string, len(texts))
+	for i, text := range texts {
+		input[i] = instruction + text
+	}
+	return input
+}
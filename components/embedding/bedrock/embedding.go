@@ -0,0 +1,222 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bedrock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+const defaultModel = "amazon.titan-embed-text-v2:0"
+
+// EmbeddingConfig contains the configuration options for the Bedrock embedder.
+// Credentials are resolved by the standard AWS credential chain, e.g. environment variables, shared config files
+// and IAM roles, unless AccessKey and SecretAccessKey are set.
+type EmbeddingConfig struct {
+	// Region is the AWS region of Bedrock runtime, e.g. "us-east-1"
+	// Optional. Default: the region of the AWS credential chain, e.g. AWS_REGION
+	Region string `json:"region"`
+
+	// AccessKey and SecretAccessKey are static credentials, which take precedence over the credential chain
+	// Optional
+	AccessKey       string `json:"access_key"`
+	SecretAccessKey string `json:"secret_access_key"`
+	// SessionToken is the session token of temporary static credentials
+	// Optional
+	SessionToken string `json:"session_token"`
+
+	// Profile is the profile of the shared config files used by the credential chain
+	// Optional. Default: AWS_PROFILE or "default"
+	Profile string `json:"profile"`
+
+	// HTTPClient specifies the client to send HTTP requests
+	// Optional. Default: the http client of AWS SDK
+	HTTPClient *http.Client `json:"http_client"`
+
+	// Model is the id of the Titan text embeddings model
+	// Optional. Default: "amazon.titan-embed-text-v2:0"
+	Model string `json:"model"`
+
+	// Dimensions is the number of dimensions of the embeddings, 256, 512 or 1024, only supported by Titan v2
+	// Optional. Default: 1024
+	Dimensions *int `json:"dimensions,omitempty"`
+
+	// Normalize specifies whether the embeddings are normalized, only supported by Titan v2
+	// Optional. Default: true
+	Normalize *bool `json:"normalize,omitempty"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
+}
+
+// invokeClient is the part of bedrockruntime.Client used by Embedder.
+type invokeClient interface {
+	InvokeModel(ctx context.Context, params *bedrockruntime.InvokeModelInput, optFns ...func(*bedrockruntime.Options)) (*bedrockruntime.InvokeModelOutput, error)
+}
+
+var _ embedding.Embedder = (*Embedder)(nil)
+
+type Embedder struct {
+	conf *EmbeddingConfig
+	cli  invokeClient
+}
+
+func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[bedrock embedding] config is nil")
+	}
+
+	conf := *config
+	if len(conf.Model) == 0 {
+		conf.Model = defaultModel
+	}
+
+	awsCfg, err := loadAWSConfig(ctx, &conf)
+	if err != nil {
+		return nil, fmt.Errorf("[bedrock embedding] load aws config failed: %w", err)
+	}
+
+	return &Embedder{
+		conf: &conf,
+		cli:  bedrockruntime.NewFromConfig(awsCfg),
+	}, nil
+}
+
+func loadAWSConfig(ctx context.Context, config *EmbeddingConfig) (aws.Config, error) {
+	var opts []func(*awsConfig.LoadOptions) error
+	if config.Region != "" {
+		opts = append(opts, awsConfig.WithRegion(config.Region))
+	}
+	if config.SecretAccessKey != "" && config.AccessKey != "" {
+		opts = append(opts, awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			config.AccessKey,
+			config.SecretAccessKey,
+			config.SessionToken,
+		)))
+	} else if config.Profile != "" {
+		opts = append(opts, awsConfig.WithSharedConfigProfile(config.Profile))
+	}
+	if config.HTTPClient != nil {
+		opts = append(opts, awsConfig.WithHTTPClient(config.HTTPClient))
+	}
+
+	return awsConfig.LoadDefaultConfig(ctx, opts...)
+}
+
+type titanRequest struct {
+	InputText  string `json:"inputText"`
+	Dimensions *int   `json:"dimensions,omitempty"`
+	Normalize  *bool  `json:"normalize,omitempty"`
+}
+
+type titanResponse struct {
+	Embedding           []float64 `json:"embedding"`
+	InputTextTokenCount int       `json:"inputTextTokenCount"`
+}
+
+// EmbedStrings embeds the texts one by one, as Titan text embeddings models accept one text per request.
+func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) (
+	embeddings [][]float64, err error) {
+	options := embedding.GetCommonOptions(&embedding.Options{Model: &e.conf.Model}, opts...)
+
+	conf := &embedding.Config{
+		Model:          *options.Model,
+		EncodingFormat: "float",
+	}
+
+	ctx = callbacks.EnsureRunInfo(ctx, e.GetType(), components.ComponentOfEmbedding)
+	ctx = callbacks.OnStart(ctx, &embedding.CallbackInput{
+		Texts:  texts,
+		Config: conf,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	usage := &embedding.TokenUsage{}
+	embeddings = make([][]float64, 0, len(texts))
+	for i, text := range texts {
+		body, err := json.Marshal(&titanRequest{
+			InputText:  text,
+			Dimensions: e.conf.Dimensions,
+			Normalize:  e.conf.Normalize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[bedrock embedding] marshal request failed: %w", err)
+		}
+
+		out, err := e.cli.InvokeModel(ctx, &bedrockruntime.InvokeModelInput{
+			ModelId:     options.Model,
+			Body:        body,
+			Accept:      aws.String("application/json"),
+			ContentType: aws.String("application/json"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[bedrock embedding] invoke model for text %d failed: %w", i, err)
+		}
+
+		resp := &titanResponse{}
+		if err = json.Unmarshal(out.Body, resp); err != nil {
+			return nil, fmt.Errorf("[bedrock embedding] unmarshal response failed: %w", err)
+		}
+		if len(resp.Embedding) == 0 {
+			return nil, fmt.Errorf("[bedrock embedding] empty embedding of text %d", i)
+		}
+
+		embeddings = append(embeddings, resp.Embedding)
+		usage.PromptTokens += resp.InputTextTokenCount
+		usage.TotalTokens += resp.InputTextTokenCount
+	}
+
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, fmt.Errorf("[bedrock embedding] post process failed: %w", err)
+	}
+
+	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
+		Embeddings: embeddings,
+		Config:     conf,
+		TokenUsage: usage,
+	})
+
+	return embeddings, nil
+}
+
+const typ = "Bedrock"
+
+func (e *Embedder) GetType() string {
+	return typ
+}
+
+func (e *Embedder) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bedrock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+type mockClient struct {
+	models []string
+	reqs   []*titanRequest
+}
+
+func (m *mockClient) InvokeModel(ctx context.Context, params *bedrockruntime.InvokeModelInput, optFns ...func(*bedrockruntime.Options)) (*bedrockruntime.InvokeModelOutput, error) {
+	req := &titanRequest{}
+	if err := json.Unmarshal(params.Body, req); err != nil {
+		return nil, err
+	}
+	if req.InputText == "" {
+		return nil, errors.New("ValidationException: inputText is empty")
+	}
+	m.models = append(m.models, aws.ToString(params.ModelId))
+	m.reqs = append(m.reqs, req)
+
+	body, _ := json.Marshal(&titanResponse{
+		Embedding:           []float64{float64(len(req.InputText)), 0},
+		InputTextTokenCount: len(req.InputText),
+	})
+	return &bedrockruntime.InvokeModelOutput{Body: body}, nil
+}
+
+func TestEmbedStrings(t *testing.T) {
+	convey.Convey("test EmbedStrings", t, func() {
+		ctx := context.Background()
+
+		_, err := NewEmbedder(ctx, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		embedder, err := NewEmbedder(ctx, &EmbeddingConfig{
+			Region:          "us-east-1",
+			AccessKey:       "ak",
+			SecretAccessKey: "sk",
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(embedder.conf.Model, convey.ShouldEqual, defaultModel)
+
+		cli := &mockClient{}
+		dimensions := 256
+		embedder.cli = cli
+		embedder.conf.Dimensions = &dimensions
+
+		convey.Convey("test success", func() {
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a", "bb"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1, 0}, {2, 0}})
+			convey.So(cli.models, convey.ShouldResemble, []string{defaultModel, defaultModel})
+			convey.So(cli.reqs[0].InputText, convey.ShouldEqual, "a")
+			convey.So(*cli.reqs[0].Dimensions, convey.ShouldEqual, 256)
+			convey.So(cli.reqs[0].Normalize, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test post process", func() {
+			vectors, err := embedder.EmbedStrings(ctx, []string{"bb"}, embedproc.WithConfig(&embedproc.Config{Normalize: true}))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1, 0}})
+		})
+
+		convey.Convey("test invoke error", func() {
+			_, err := embedder.EmbedStrings(ctx, []string{"a", ""})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "text 1")
+		})
+	})
+}
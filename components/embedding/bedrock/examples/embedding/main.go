@@ -0,0 +1,49 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino-ext/components/embedding/bedrock"
+)
+
+func main() {
+	ctx := context.Background()
+
+	dimensions := 512
+	// credentials are resolved by the AWS credential chain, e.g. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	embedder, err := bedrock.NewEmbedder(ctx, &bedrock.EmbeddingConfig{
+		Region:     "us-east-1",
+		Model:      "amazon.titan-embed-text-v2:0",
+		Dimensions: &dimensions,
+	})
+	if err != nil {
+		log.Fatalf("NewEmbedder of bedrock failed, err=%v", err)
+	}
+
+	vectors, err := embedder.EmbedStrings(ctx, []string{"hello", "how are you"})
+	if err != nil {
+		log.Fatalf("EmbedStrings of bedrock failed, err=%v", err)
+	}
+
+	for i, vector := range vectors {
+		fmt.Printf("vector %d: dimensions=%d\n", i, len(vector))
+	}
+}
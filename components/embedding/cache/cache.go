@@ -0,0 +1,143 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/embedding"
+)
+
+const defaultLRUCapacity = 10000
+
+// Cacher is the storage backend of cached embeddings.
+type Cacher interface {
+	// Get returns the vector of key, found is false if key is not cached.
+	Get(ctx context.Context, key string) (vector []float64, found bool, err error)
+	// Set caches vector of key.
+	Set(ctx context.Context, key string, vector []float64) error
+}
+
+type Config struct {
+	// Embedder is the underlying embedder, whose results are cached.
+	// Required.
+	Embedder embedding.Embedder
+	// Cacher is the cache backend, see NewLRUCacher and NewRedisCacher.
+	// Optional. Default: in-memory lru cache with 10000 entries.
+	Cacher Cacher
+	// Model is the model name of Embedder, which is part of cache key,
+	// so that vectors from different models won't be mixed up.
+	// Could be overridden by embedding.WithModel.
+	// Required if Embedder is shared by different models.
+	Model string
+	// IgnoreCacheError continues embedding without cache when Cacher returns error.
+	// Optional. Default: false
+	IgnoreCacheError bool
+}
+
+var _ embedding.Embedder = (*Embedder)(nil)
+
+// Embedder wraps an embedding.Embedder, texts embedded before are read from cache,
+// and only missed texts are sent to the underlying embedder.
+type Embedder struct {
+	config *Config
+}
+
+func NewEmbedder(_ context.Context, config *Config) (*Embedder, error) {
+	if config == nil || config.Embedder == nil {
+		return nil, errors.New("[NewEmbedder] embedder not provided")
+	}
+
+	if config.Cacher == nil {
+		config.Cacher = NewLRUCacher(defaultLRUCapacity)
+	}
+
+	return &Embedder{config: config}, nil
+}
+
+func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	options := embedding.GetCommonOptions(&embedding.Options{
+		Model: &e.config.Model,
+	}, opts...)
+
+	var (
+		embeddings  = make([][]float64, len(texts))
+		keys        = make([]string, len(texts))
+		missTexts   []string
+		missIndexes []int
+	)
+	for i, text := range texts {
+		keys[i] = cacheKey(*options.Model, text)
+
+		vector, found, err := e.config.Cacher.Get(ctx, keys[i])
+		if err != nil && !e.config.IgnoreCacheError {
+			return nil, fmt.Errorf("[cache embedder] get cache failed: %w", err)
+		}
+		if found {
+			embeddings[i] = vector
+			continue
+		}
+
+		missTexts = append(missTexts, text)
+		missIndexes = append(missIndexes, i)
+	}
+
+	if len(missTexts) == 0 {
+		return embeddings, nil
+	}
+
+	vectors, err := e.config.Embedder.EmbedStrings(ctx, missTexts, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vectors) != len(missTexts) {
+		return nil, fmt.Errorf("[cache embedder] invalid embedding length, expected=%d, got=%d", len(missTexts), len(vectors))
+	}
+
+	for i, idx := range missIndexes {
+		embeddings[idx] = vectors[i]
+		if err = e.config.Cacher.Set(ctx, keys[idx], vectors[i]); err != nil && !e.config.IgnoreCacheError {
+			return nil, fmt.Errorf("[cache embedder] set cache failed: %w", err)
+		}
+	}
+
+	return embeddings, nil
+}
+
+func cacheKey(model, text string) string {
+	h := sha256.New()
+	h.Write([]byte(model))
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+const typ = "Cache"
+
+func (e *Embedder) GetType() string {
+	return typ
+}
+
+// IsCallbacksEnabled returns true, callbacks are triggered by the underlying embedder when cache is missed.
+func (e *Embedder) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type mockEmbedder struct {
+	calls [][]string
+	err   error
+}
+
+func (m *mockEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	m.calls = append(m.calls, texts)
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float64{float64(len(text))}
+	}
+	return vectors, nil
+}
+
+type errCacher struct{}
+
+func (errCacher) Get(ctx context.Context, key string) ([]float64, bool, error) {
+	return nil, false, fmt.Errorf("mock err")
+}
+
+func (errCacher) Set(ctx context.Context, key string, vector []float64) error {
+	return fmt.Errorf("mock err")
+}
+
+func TestEmbedStrings(t *testing.T) {
+	PatchConvey("test EmbedStrings", t, func() {
+		ctx := context.Background()
+
+		PatchConvey("test embedder not provided", func() {
+			e, err := NewEmbedder(ctx, &Config{})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewEmbedder] embedder not provided"))
+			convey.So(e, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test cache hit and miss", func() {
+			inner := &mockEmbedder{}
+			e, err := NewEmbedder(ctx, &Config{Embedder: inner, Model: "m1"})
+			convey.So(err, convey.ShouldBeNil)
+
+			vectors, err := e.EmbedStrings(ctx, []string{"a", "bb"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1}, {2}})
+
+			vectors, err = e.EmbedStrings(ctx, []string{"bb", "ccc", "a"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{2}, {3}, {1}})
+			convey.So(inner.calls, convey.ShouldResemble, [][]string{{"a", "bb"}, {"ccc"}})
+
+			// different model doesn't share cache
+			_, err = e.EmbedStrings(ctx, []string{"a"}, embedding.WithModel("m2"))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(inner.calls[len(inner.calls)-1], convey.ShouldResemble, []string{"a"})
+		})
+
+		PatchConvey("test embedder error", func() {
+			inner := &mockEmbedder{err: fmt.Errorf("mock err")}
+			e, err := NewEmbedder(ctx, &Config{Embedder: inner})
+			convey.So(err, convey.ShouldBeNil)
+
+			_, err = e.EmbedStrings(ctx, []string{"a"})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("mock err"))
+		})
+
+		PatchConvey("test cache error", func() {
+			inner := &mockEmbedder{}
+			e, err := NewEmbedder(ctx, &Config{Embedder: inner, Cacher: errCacher{}})
+			convey.So(err, convey.ShouldBeNil)
+			_, err = e.EmbedStrings(ctx, []string{"a"})
+			convey.So(err, convey.ShouldNotBeNil)
+
+			e, err = NewEmbedder(ctx, &Config{Embedder: inner, Cacher: errCacher{}, IgnoreCacheError: true})
+			convey.So(err, convey.ShouldBeNil)
+			vectors, err := e.EmbedStrings(ctx, []string{"a"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1}})
+		})
+	})
+}
+
+func TestLRUCacher(t *testing.T) {
+	PatchConvey("test LRUCacher", t, func() {
+		ctx := context.Background()
+		c := NewLRUCacher(2)
+		convey.So(c.Set(ctx, "a", []float64{1}), convey.ShouldBeNil)
+		convey.So(c.Set(ctx, "b", []float64{2}), convey.ShouldBeNil)
+
+		_, found, _ := c.Get(ctx, "a")
+		convey.So(found, convey.ShouldBeTrue)
+
+		// b is least recently used
+		convey.So(c.Set(ctx, "c", []float64{3}), convey.ShouldBeNil)
+		convey.So(c.Len(), convey.ShouldEqual, 2)
+		_, found, _ = c.Get(ctx, "b")
+		convey.So(found, convey.ShouldBeFalse)
+		v, found, _ := c.Get(ctx, "c")
+		convey.So(found, convey.ShouldBeTrue)
+		convey.So(v, convey.ShouldResemble, []float64{3})
+	})
+}
+
+func TestVectorBytes(t *testing.T) {
+	PatchConvey("test vector bytes", t, func() {
+		v := []float64{0.1, -2.5, 3}
+		got, err := bytes2Vector(vector2Bytes(v))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(got, convey.ShouldResemble, v)
+
+		_, err = bytes2Vector([]byte{1, 2, 3})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
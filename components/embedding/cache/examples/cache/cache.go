@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/cloudwego/eino-ext/components/embedding/cache"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// replace with any embedding.Embedder, e.g. openai / ark embedder
+	model := "text-embedding-3-small"
+	inner := &lengthEmbedder{}
+
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+
+	embedder, err := cache.NewEmbedder(ctx, &cache.Config{
+		Embedder: inner,
+		Cacher:   cache.NewRedisCacher(client, "eino_emb:", 24*time.Hour),
+		Model:    model,
+	})
+	if err != nil {
+		log.Fatalf("NewEmbedder of cache failed, err=%v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		// the second round is served from redis
+		vectors, err := embedder.EmbedStrings(ctx, []string{"hello world", "bye world"})
+		if err != nil {
+			log.Fatalf("EmbedStrings failed, err=%v", err)
+		}
+		log.Printf("round=%d, vectors=%d", i, len(vectors))
+	}
+}
+
+type lengthEmbedder struct{}
+
+func (l *lengthEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	log.Printf("embedding %d texts", len(texts))
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float64{float64(len(text))}
+	}
+	return vectors, nil
+}
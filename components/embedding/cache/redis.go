@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCacher is a Cacher storing vectors in redis as little-endian float64 bytes.
+type RedisCacher struct {
+	client    redis.UniversalClient
+	keyPrefix string
+	ttl       time.Duration
+}
+
+// NewRedisCacher creates a redis Cacher, key is keyPrefix+hash, ttl <= 0 means no expiration.
+func NewRedisCacher(client redis.UniversalClient, keyPrefix string, ttl time.Duration) *RedisCacher {
+	return &RedisCacher{
+		client:    client,
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+	}
+}
+
+func (c *RedisCacher) Get(ctx context.Context, key string) ([]float64, bool, error) {
+	b, err := c.client.Get(ctx, c.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	vector, err := bytes2Vector(b)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return vector, true, nil
+}
+
+func (c *RedisCacher) Set(ctx context.Context, key string, vector []float64) error {
+	return c.client.Set(ctx, c.keyPrefix+key, vector2Bytes(vector), c.ttl).Err()
+}
+
+func vector2Bytes(vector []float64) []byte {
+	b := make([]byte, len(vector)*8)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint64(b[i*8:], math.Float64bits(v))
+	}
+	return b
+}
+
+func bytes2Vector(b []byte) ([]float64, error) {
+	if len(b)%8 != 0 {
+		return nil, fmt.Errorf("invalid cached vector length=%d", len(b))
+	}
+
+	vector := make([]float64, len(b)/8)
+	for i := range vector {
+		vector[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*8:]))
+	}
+	return vector, nil
+}
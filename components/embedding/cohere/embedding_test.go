@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cohere
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmbedStrings(t *testing.T) {
+	convey.Convey("test EmbedStrings", t, func() {
+		ctx := context.Background()
+
+		var reqs []*embedRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/embed" || r.Header.Get("Authorization") != "Bearer mock" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"message":"invalid api key"}`))
+				return
+			}
+
+			req := &embedRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			reqs = append(reqs, req)
+
+			resp := &embedResponse{}
+			for _, text := range req.Texts {
+				resp.Embeddings.Float = append(resp.Embeddings.Float, []float64{float64(len(text))})
+			}
+			resp.Meta.BilledUnits.InputTokens = len(req.Texts)
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer srv.Close()
+
+		dimensions := 256
+		embedder, err := NewEmbedder(ctx, &EmbeddingConfig{
+			APIKey:       "mock",
+			Model:        "embed-v4.0",
+			BaseURL:      srv.URL,
+			Dimensions:   &dimensions,
+			MaxBatchSize: 2,
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("test success", func() {
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a", "bb", "ccc"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1}, {2}, {3}})
+
+			convey.So(len(reqs), convey.ShouldEqual, 2)
+			convey.So(reqs[0].Texts, convey.ShouldResemble, []string{"a", "bb"})
+			convey.So(reqs[1].Texts, convey.ShouldResemble, []string{"ccc"})
+			convey.So(reqs[0].Model, convey.ShouldEqual, "embed-v4.0")
+			convey.So(reqs[0].InputType, convey.ShouldEqual, InputTypeDocument)
+			convey.So(reqs[0].EmbeddingTypes, convey.ShouldResemble, []string{"float"})
+			convey.So(*reqs[0].OutputDimension, convey.ShouldEqual, 256)
+		})
+
+		convey.Convey("test options", func() {
+			_, err := embedder.EmbedStrings(ctx, []string{"a"}, WithInputType(InputTypeQuery), embedding.WithModel("embed-multilingual-v3.0"))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(reqs[0].InputType, convey.ShouldEqual, InputTypeQuery)
+			convey.So(reqs[0].Model, convey.ShouldEqual, "embed-multilingual-v3.0")
+		})
+
+		convey.Convey("test api error", func() {
+			embedder.conf.APIKey = "invalid"
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a"})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "invalid api key")
+			convey.So(vectors, convey.ShouldBeNil)
+		})
+	})
+
+	convey.Convey("test NewEmbedder", t, func() {
+		_, err := NewEmbedder(context.Background(), &EmbeddingConfig{Model: "embed-v4.0"})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
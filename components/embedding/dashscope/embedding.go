@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino-ext/libs/embedproc"
 	"github.com/cloudwego/eino/components/embedding"
 )
 
@@ -52,9 +53,15 @@ type EmbeddingConfig struct {
 	// Only applicable to text-embedding-v3 model, can only be selected between three values: 1024, 768, and 512.
 	// The default value is 1024.
 	Dimensions *int `json:"dimensions,omitempty"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
 }
 type Embedder struct {
-	cli *openai.EmbeddingClient
+	cli         *openai.EmbeddingClient
+	postProcess *embedproc.Config
 }
 
 func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error) {
@@ -87,11 +94,16 @@ func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error
 		return nil, err
 	}
 
-	return &Embedder{cli: cli}, nil
+	return &Embedder{cli: cli, postProcess: config.PostProcess}, nil
 }
 
 func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
-	return e.cli.EmbedStrings(ctx, texts, opts...)
+	embeddings, err := e.cli.EmbedStrings(ctx, texts, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return embedproc.Apply(embeddings, embedproc.GetConfig(e.postProcess, opts...))
 }
 
 const typ = "DashScope"
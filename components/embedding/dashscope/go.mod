@@ -7,15 +7,18 @@ toolchain go1.24.0
 require (
 	github.com/bytedance/mockey v1.2.14
 	github.com/cloudwego/eino v0.3.27
-	github.com/cloudwego/eino-ext/libs/acl/openai v0.0.0-20250331101427-906b8d194a99
-	github.com/meguminnnnnnnnn/go-openai v0.0.0-20250402131905-e1ff67830216
+	github.com/cloudwego/eino-ext/libs/acl/openai v0.0.0-20261016112000-141dfb2eb598
+	github.com/cloudwego/eino-ext/libs/embedproc v0.0.0-20261016103348-1f45c6cadc76
+	github.com/meguminnnnnnnnn/go-openai v0.1.2
 )
 
 require (
-	github.com/bytedance/sonic v1.13.2 // indirect
-	github.com/bytedance/sonic/loader v0.2.4 // indirect
+	github.com/bytedance/sonic v1.14.0 // indirect
+	github.com/bytedance/sonic/loader v0.3.0 // indirect
 	github.com/cloudwego/base64x v0.1.5 // indirect
+	github.com/cloudwego/eino-ext/libs/acl/toolargs v0.0.0-20261016103348-1f45c6cadc76 // indirect
 	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/evanphx/json-patch v0.5.2 // indirect
 	github.com/getkin/kin-openapi v0.118.0 // indirect
 	github.com/go-openapi/jsonpointer v0.21.1 // indirect
 	github.com/go-openapi/swag v0.23.1 // indirect
@@ -35,7 +38,6 @@ require (
 	github.com/perimeterx/marshmallow v1.1.5 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
 	github.com/rogpeppe/go-internal v1.14.1 // indirect
-	github.com/sashabaranov/go-openai v1.38.1 // indirect
 	github.com/sirupsen/logrus v1.9.3 // indirect
 	github.com/slongfield/pyfmt v0.0.0-20220222012616-ea85ff4c361f // indirect
 	github.com/smarty/assertions v1.16.0 // indirect
@@ -47,3 +49,6 @@ require (
 	golang.org/x/sys v0.31.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// TODO: drop the replace once libs/acl/toolargs is tagged, the required version is not on the main branch yet
+replace github.com/cloudwego/eino-ext/libs/acl/toolargs => ../../../libs/acl/toolargs
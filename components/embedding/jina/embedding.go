@@ -0,0 +1,267 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jina
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+const (
+	defaultBaseURL      = "https://api.jina.ai/v1"
+	defaultMaxBatchSize = 2048
+)
+
+// InputType is the downstream task of the embeddings, the task parameter of Jina embeddings API.
+// Only supported in jina-embeddings-v3 and later models.
+type InputType string
+
+const (
+	// InputTypeQuery embeds queries in retrieval.
+	InputTypeQuery InputType = "retrieval.query"
+	// InputTypeDocument embeds documents in retrieval.
+	InputTypeDocument InputType = "retrieval.passage"
+	// InputTypeTextMatching embeds texts for semantic similarity.
+	InputTypeTextMatching InputType = "text-matching"
+	// InputTypeClassification embeds texts for classification.
+	InputTypeClassification InputType = "classification"
+	// InputTypeSeparation embeds texts for clustering.
+	InputTypeSeparation InputType = "separation"
+)
+
+type EmbeddingConfig struct {
+	// APIKey is your Jina AI API key
+	// Required
+	APIKey string `json:"api_key"`
+
+	// Model specifies the ID of the model to use for embedding generation, e.g. jina-embeddings-v3
+	// Required
+	Model string `json:"model"`
+
+	// BaseURL specifies the base URL of Jina AI API
+	// Optional. Default: "https://api.jina.ai/v1"
+	BaseURL string `json:"base_url"`
+
+	// Timeout specifies the maximum duration to wait for API responses
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: no timeout
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"http_client"`
+
+	// InputType specifies the downstream task of the texts, which can be overridden per call by WithInputType.
+	// Use InputTypeDocument for indexers, and InputTypeQuery for retrievers.
+	// Optional. Default: "", not sent
+	InputType InputType `json:"input_type,omitempty"`
+
+	// Dimensions specifies the number of dimensions of the output embeddings
+	// Optional. Only supported in jina-embeddings-v3 and later models
+	Dimensions *int `json:"dimensions,omitempty"`
+
+	// Truncate truncates texts exceeding the max input length of the model, instead of returning an error
+	// Optional. Default: false
+	Truncate *bool `json:"truncate,omitempty"`
+
+	// MaxBatchSize limits the number of texts sent in one request, texts exceeding it are split into batches
+	// Optional. Default: 2048, the max input array length of Jina embeddings API
+	MaxBatchSize int `json:"max_batch_size,omitempty"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
+}
+
+var _ embedding.Embedder = (*Embedder)(nil)
+
+type Embedder struct {
+	conf *EmbeddingConfig
+	cli  *http.Client
+}
+
+func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[jina embedding] config is nil")
+	}
+	if len(config.APIKey) == 0 {
+		return nil, fmt.Errorf("[jina embedding] api key is required")
+	}
+
+	conf := *config
+	if len(conf.BaseURL) == 0 {
+		conf.BaseURL = defaultBaseURL
+	}
+	if conf.MaxBatchSize <= 0 {
+		conf.MaxBatchSize = defaultMaxBatchSize
+	}
+
+	cli := conf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: conf.Timeout}
+	}
+
+	return &Embedder{conf: &conf, cli: cli}, nil
+}
+
+type embeddingRequest struct {
+	Model         string    `json:"model"`
+	Input         []string  `json:"input"`
+	Task          InputType `json:"task,omitempty"`
+	Dimensions    *int      `json:"dimensions,omitempty"`
+	Truncate      *bool     `json:"truncate,omitempty"`
+	EmbeddingType string    `json:"embedding_type"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float64 `json:"embedding"`
+	} `json:"data"`
+	Usage struct {
+		PromptTokens int `json:"prompt_tokens"`
+		TotalTokens  int `json:"total_tokens"`
+	} `json:"usage"`
+}
+
+func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) (
+	embeddings [][]float64, err error) {
+	options := embedding.GetCommonOptions(&embedding.Options{Model: &e.conf.Model}, opts...)
+	implOpts := embedding.GetImplSpecificOptions(&implOptions{inputType: e.conf.InputType}, opts...)
+
+	conf := &embedding.Config{
+		Model:          *options.Model,
+		EncodingFormat: "float",
+	}
+
+	ctx = callbacks.EnsureRunInfo(ctx, e.GetType(), components.ComponentOfEmbedding)
+	ctx = callbacks.OnStart(ctx, &embedding.CallbackInput{
+		Texts:  texts,
+		Config: conf,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	usage := &embedding.TokenUsage{}
+	embeddings = make([][]float64, 0, len(texts))
+	for start := 0; start < len(texts); start += e.conf.MaxBatchSize {
+		end := start + e.conf.MaxBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		resp := &embeddingResponse{}
+		err = e.post(ctx, "/embeddings", &embeddingRequest{
+			Model:         conf.Model,
+			Input:         texts[start:end],
+			Task:          implOpts.inputType,
+			Dimensions:    e.conf.Dimensions,
+			Truncate:      e.conf.Truncate,
+			EmbeddingType: "float",
+		}, resp)
+		if err != nil {
+			return nil, fmt.Errorf("[jina embedding] embed batch [%d, %d) failed: %w", start, end, err)
+		}
+		if len(resp.Data) != end-start {
+			return nil, fmt.Errorf("[jina embedding] invalid embedding length, expected=%d, got=%d", end-start, len(resp.Data))
+		}
+
+		batch := make([][]float64, end-start)
+		for _, d := range resp.Data {
+			if d.Index < 0 || d.Index >= len(batch) {
+				return nil, fmt.Errorf("[jina embedding] invalid embedding index: %d", d.Index)
+			}
+			batch[d.Index] = d.Embedding
+		}
+		embeddings = append(embeddings, batch...)
+
+		usage.PromptTokens += resp.Usage.PromptTokens
+		usage.TotalTokens += resp.Usage.TotalTokens
+	}
+
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, fmt.Errorf("[jina embedding] post process failed: %w", err)
+	}
+
+	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
+		Embeddings: embeddings,
+		Config:     conf,
+		TokenUsage: usage,
+	})
+
+	return embeddings, nil
+}
+
+func (e *Embedder) post(ctx context.Context, path string, req, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request failed: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(e.conf.BaseURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request failed: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+e.conf.APIKey)
+
+	httpResp, err := e.cli.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("read response failed: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status=%d, body=%s", httpResp.StatusCode, respBody)
+	}
+
+	if err = json.Unmarshal(respBody, resp); err != nil {
+		return fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	return nil
+}
+
+const typ = "Jina"
+
+func (e *Embedder) GetType() string {
+	return typ
+}
+
+func (e *Embedder) IsCallbacksEnabled() bool {
+	return true
+}
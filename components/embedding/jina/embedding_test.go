@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jina
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmbedStrings(t *testing.T) {
+	convey.Convey("test EmbedStrings", t, func() {
+		ctx := context.Background()
+
+		var reqs []*embeddingRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/embeddings" || r.Header.Get("Authorization") != "Bearer mock" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"detail":"invalid api key"}`))
+				return
+			}
+
+			req := &embeddingRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			reqs = append(reqs, req)
+
+			resp := &embeddingResponse{}
+			for i := len(req.Input) - 1; i >= 0; i-- {
+				resp.Data = append(resp.Data, struct {
+					Index     int       `json:"index"`
+					Embedding []float64 `json:"embedding"`
+				}{Index: i, Embedding: []float64{float64(len(req.Input[i]))}})
+			}
+			resp.Usage.PromptTokens = len(req.Input)
+			resp.Usage.TotalTokens = len(req.Input)
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer srv.Close()
+
+		dimensions := 256
+		embedder, err := NewEmbedder(ctx, &EmbeddingConfig{
+			APIKey:       "mock",
+			Model:        "jina-embeddings-v3",
+			BaseURL:      srv.URL,
+			InputType:    InputTypeDocument,
+			Dimensions:   &dimensions,
+			MaxBatchSize: 2,
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("test success", func() {
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a", "bb", "ccc"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1}, {2}, {3}})
+
+			convey.So(len(reqs), convey.ShouldEqual, 2)
+			convey.So(reqs[0].Input, convey.ShouldResemble, []string{"a", "bb"})
+			convey.So(reqs[1].Input, convey.ShouldResemble, []string{"ccc"})
+			convey.So(reqs[0].Model, convey.ShouldEqual, "jina-embeddings-v3")
+			convey.So(reqs[0].Task, convey.ShouldEqual, InputTypeDocument)
+			convey.So(*reqs[0].Dimensions, convey.ShouldEqual, 256)
+		})
+
+		convey.Convey("test options", func() {
+			_, err := embedder.EmbedStrings(ctx, []string{"a"}, WithInputType(InputTypeQuery), embedding.WithModel("jina-clip-v2"))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(reqs[0].Task, convey.ShouldEqual, InputTypeQuery)
+			convey.So(reqs[0].Model, convey.ShouldEqual, "jina-clip-v2")
+		})
+
+		convey.Convey("test api error", func() {
+			embedder.conf.APIKey = "invalid"
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a"})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "invalid api key")
+			convey.So(vectors, convey.ShouldBeNil)
+		})
+	})
+
+	convey.Convey("test NewEmbedder", t, func() {
+		_, err := NewEmbedder(context.Background(), &EmbeddingConfig{Model: "jina-embeddings-v3"})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
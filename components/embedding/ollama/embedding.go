@@ -0,0 +1,167 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ollama
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/ollama/ollama/api"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+var _ embedding.Embedder = (*Embedder)(nil)
+
+type EmbeddingConfig struct {
+	// BaseURL ollama server address, e.g. http://localhost:11434
+	BaseURL string `json:"base_url"`
+	// Timeout request timeout for http client
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"http_client"`
+
+	// Model embedding model name, e.g. nomic-embed-text
+	Model string `json:"model"`
+	// KeepAlive controls how long the model will stay loaded in memory following the request
+	KeepAlive *time.Duration `json:"keep_alive"`
+	// Truncate truncates the end of each input to fit within context length, returns error if false and context length is exceeded.
+	// Optional. Default true by ollama server.
+	Truncate *bool `json:"truncate"`
+
+	// Options model parameters like NumCtx, see api.Options
+	Options *api.Options `json:"options"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
+}
+
+type Embedder struct {
+	cli  *api.Client
+	conf *EmbeddingConfig
+}
+
+func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error) {
+	if config == nil {
+		return nil, errors.New("config must not be nil")
+	}
+
+	var httpClient *http.Client
+	if config.HTTPClient != nil {
+		httpClient = config.HTTPClient
+	} else {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+
+	baseURL, err := url.Parse(config.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	return &Embedder{
+		cli:  api.NewClient(baseURL, httpClient),
+		conf: config,
+	}, nil
+}
+
+func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) (embeddings [][]float64, err error) {
+	options := embedding.GetCommonOptions(&embedding.Options{
+		Model: &e.conf.Model,
+	}, opts...)
+
+	conf := &embedding.Config{Model: *options.Model}
+
+	ctx = callbacks.EnsureRunInfo(ctx, e.GetType(), components.ComponentOfEmbedding)
+	ctx = callbacks.OnStart(ctx, &embedding.CallbackInput{
+		Texts:  texts,
+		Config: conf,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	req := &api.EmbedRequest{
+		Model:    conf.Model,
+		Input:    texts,
+		Truncate: e.conf.Truncate,
+	}
+	if e.conf.KeepAlive != nil {
+		req.KeepAlive = &api.Duration{Duration: *e.conf.KeepAlive}
+	}
+	if e.conf.Options != nil {
+		if req.Options, err = toRequestOptions(e.conf.Options); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := e.cli.Embed(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("[ollama embedding] embed failed: %w", err)
+	}
+
+	if len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("[ollama embedding] invalid embedding length, expected=%d, got=%d", len(texts), len(resp.Embeddings))
+	}
+
+	embeddings = make([][]float64, len(resp.Embeddings))
+	for i, d := range resp.Embeddings {
+		res := make([]float64, len(d))
+		for j, emb := range d {
+			res[j] = float64(emb)
+		}
+		embeddings[i] = res
+	}
+
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, fmt.Errorf("[ollama embedding] post process failed: %w", err)
+	}
+
+	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
+		Embeddings: embeddings,
+		Config:     conf,
+		TokenUsage: &embedding.TokenUsage{
+			PromptTokens: resp.PromptEvalCount,
+			TotalTokens:  resp.PromptEvalCount,
+		},
+	})
+
+	return embeddings, nil
+}
+
+const typ = "Ollama"
+
+func (e *Embedder) GetType() string {
+	return typ
+}
+
+func (e *Embedder) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ollama
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/bytedance/mockey"
+	"github.com/ollama/ollama/api"
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+func TestEmbedStrings(t *testing.T) {
+	PatchConvey("test EmbedStrings", t, func() {
+		ctx := context.Background()
+		keepAlive := time.Minute
+		embedder, err := NewEmbedder(ctx, &EmbeddingConfig{
+			BaseURL:   "http://localhost:11434",
+			Model:     "nomic-embed-text",
+			KeepAlive: &keepAlive,
+			Options:   &api.Options{Runner: api.Runner{NumCtx: 2048}},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		PatchConvey("test embed error", func() {
+			Mock(GetMethod(embedder.cli, "Embed")).Return(nil, fmt.Errorf("mock err")).Build()
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"asd"})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(vectors, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test embedding length mismatch", func() {
+			Mock(GetMethod(embedder.cli, "Embed")).Return(&api.EmbedResponse{
+				Embeddings: [][]float32{{1, 2}},
+			}, nil).Build()
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"asd", "qwe"})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[ollama embedding] invalid embedding length, expected=2, got=1"))
+			convey.So(vectors, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test success", func() {
+			var got *api.EmbedRequest
+			Mock(GetMethod(embedder.cli, "Embed")).To(func(ctx context.Context, req *api.EmbedRequest) (*api.EmbedResponse, error) {
+				got = req
+				return &api.EmbedResponse{
+					Embeddings:      [][]float32{{1, 2}, {3, 4}},
+					PromptEvalCount: 5,
+				}, nil
+			}).Build()
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"asd", "qwe"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1, 2}, {3, 4}})
+			convey.So(got.Model, convey.ShouldEqual, "nomic-embed-text")
+			convey.So(got.Input, convey.ShouldResemble, []string{"asd", "qwe"})
+			convey.So(got.KeepAlive.Duration, convey.ShouldEqual, time.Minute)
+			convey.So(got.Options["num_ctx"], convey.ShouldEqual, float64(2048))
+		})
+
+		PatchConvey("test post process", func() {
+			Mock(GetMethod(embedder.cli, "Embed")).Return(&api.EmbedResponse{
+				Embeddings: [][]float32{{3, 4, 12}},
+			}, nil).Build()
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"asd"}, embedproc.WithConfig(&embedproc.Config{
+				Dimensions: 2,
+				Normalize:  true,
+			}))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{0.6, 0.8}})
+
+			_, err = embedder.EmbedStrings(ctx, []string{"asd"}, embedproc.WithConfig(&embedproc.Config{Dimensions: 4}))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/embedding/ollama"
+)
+
+func main() {
+	ctx := context.Background()
+
+	embedder, err := ollama.NewEmbedder(ctx, &ollama.EmbeddingConfig{
+		BaseURL: "http://localhost:11434",
+		Model:   "nomic-embed-text",
+		Timeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("NewEmbedder failed, err=%v", err)
+	}
+
+	embeddings, err := embedder.EmbedStrings(ctx, []string{"hello world", "bye world"})
+	if err != nil {
+		log.Fatalf("EmbedStrings failed, err=%v", err)
+	}
+
+	log.Printf("embeddings count=%d, dims=%d", len(embeddings), len(embeddings[0]))
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ollama
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ollama/ollama/api"
+)
+
+func toRequestOptions(options *api.Options) (map[string]any, error) {
+	optBytes, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("error marshal options: %w", err)
+	}
+
+	reqOptions := make(map[string]any)
+	if err = json.Unmarshal(optBytes, &reqOptions); err != nil {
+		return nil, fmt.Errorf("error unmarshal options: %w", err)
+	}
+
+	return reqOptions, nil
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino-ext/libs/acl/retry"
+	"github.com/cloudwego/eino/components/embedding"
+)
+
+const (
+	defaultMaxBatchSize   = 2048
+	defaultMaxConcurrency = 1
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = time.Second
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+type batchConfig struct {
+	maxBatchSize      int
+	maxTokensPerBatch int
+	tokenCounter      func(text string) int
+	maxConcurrency    int
+	retry             *retry.Config
+}
+
+func newBatchConfig(config *EmbeddingConfig) *batchConfig {
+	bc := &batchConfig{
+		maxBatchSize:   defaultMaxBatchSize,
+		tokenCounter:   estimateTokens,
+		maxConcurrency: defaultMaxConcurrency,
+		retry: &retry.Config{
+			MaxAttempts: defaultMaxRetries + 1,
+			BaseDelay:   defaultRetryBaseDelay,
+			MaxDelay:    defaultRetryMaxDelay,
+		},
+	}
+	if config == nil {
+		return bc
+	}
+
+	if config.MaxBatchSize > 0 {
+		bc.maxBatchSize = config.MaxBatchSize
+	}
+	if config.MaxTokensPerBatch > 0 {
+		bc.maxTokensPerBatch = config.MaxTokensPerBatch
+	}
+	if config.TokenCounter != nil {
+		bc.tokenCounter = config.TokenCounter
+	}
+	if config.MaxConcurrency > 0 {
+		bc.maxConcurrency = config.MaxConcurrency
+	}
+	if config.MaxRetries != nil {
+		bc.retry.MaxAttempts = *config.MaxRetries + 1
+	}
+	if config.RetryBaseDelay > 0 {
+		bc.retry.BaseDelay = config.RetryBaseDelay
+	}
+	if config.RetryMaxDelay > 0 {
+		bc.retry.MaxDelay = config.RetryMaxDelay
+	}
+
+	return bc
+}
+
+// estimateTokens roughly estimates tokens as 4 bytes per token for english text.
+func estimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+type batch struct {
+	start, end int
+}
+
+// splitBatches splits texts by max batch size and max tokens per batch.
+// A single text exceeding max tokens per batch is sent alone.
+func (e *Embedder) splitBatches(texts []string) []batch {
+	var (
+		batches []batch
+		start   int
+		tokens  int
+	)
+	for i, text := range texts {
+		t := 0
+		if e.conf.maxTokensPerBatch > 0 {
+			t = e.conf.tokenCounter(text)
+		}
+
+		if i > start && (i-start >= e.conf.maxBatchSize ||
+			(e.conf.maxTokensPerBatch > 0 && tokens+t > e.conf.maxTokensPerBatch)) {
+			batches = append(batches, batch{start: start, end: i})
+			start, tokens = i, 0
+		}
+		tokens += t
+	}
+
+	if start < len(texts) {
+		batches = append(batches, batch{start: start, end: len(texts)})
+	}
+
+	return batches
+}
+
+func (e *Embedder) embedInBatches(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	batches := e.splitBatches(texts)
+	if len(batches) <= 1 {
+		return e.embedWithRetry(ctx, texts, opts...)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		embeddings = make([][]float64, len(texts))
+		sem        = make(chan struct{}, e.conf.maxConcurrency)
+		wg         sync.WaitGroup
+		errOnce    sync.Once
+		firstErr   error
+	)
+
+	for _, b := range batches {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(b batch) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			vectors, err := e.embedWithRetry(ctx, texts[b.start:b.end], opts...)
+			if err == nil && len(vectors) != b.end-b.start {
+				err = fmt.Errorf("invalid embedding length, expected=%d, got=%d", b.end-b.start, len(vectors))
+			}
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("embed batch [%d, %d) failed: %w", b.start, b.end, err)
+					cancel()
+				})
+				return
+			}
+
+			copy(embeddings[b.start:b.end], vectors)
+		}(b)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return embeddings, nil
+}
+
+func (e *Embedder) embedWithRetry(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	return retry.Do(ctx, e.conf.retry, func(ctx context.Context) ([][]float64, error) {
+		return e.cli.EmbedStrings(ctx, texts, opts...)
+	})
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bytedance/mockey"
+	"github.com/meguminnnnnnnnn/go-openai"
+)
+
+func TestSplitBatches(t *testing.T) {
+	emb := &Embedder{conf: newBatchConfig(&EmbeddingConfig{
+		MaxBatchSize:      3,
+		MaxTokensPerBatch: 10,
+		TokenCounter:      func(text string) int { return len(text) },
+	})}
+
+	batches := emb.splitBatches([]string{"a", "b", "c", "d", "eeeeeeeeee", "ffffffffffff", "g"})
+	expected := []batch{{0, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 7}}
+	if !reflect.DeepEqual(batches, expected) {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+
+	if batches = emb.splitBatches(nil); len(batches) != 0 {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+}
+
+func TestEmbedInBatches(t *testing.T) {
+	ctx := context.Background()
+	maxRetries := 2
+
+	t.Run("batches with retry", func(t *testing.T) {
+		emb, err := NewEmbedder(ctx, &EmbeddingConfig{
+			APIKey:         "api_key",
+			Model:          "embedding",
+			MaxBatchSize:   2,
+			MaxConcurrency: 2,
+			MaxRetries:     &maxRetries,
+			RetryBaseDelay: time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var calls int32
+		defer mockey.Mock((*openai.Client).CreateEmbeddings).To(func(ctx context.Context, conv openai.EmbeddingRequestConverter) (res openai.EmbeddingResponse, err error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				return res, &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests}
+			}
+			input := conv.Convert().Input.([]string)
+			for _, text := range input {
+				res.Data = append(res.Data, openai.Embedding{Embedding: []float32{float32(len(text))}})
+			}
+			return res, nil
+		}).Build().UnPatch()
+
+		result, err := emb.EmbedStrings(ctx, []string{"a", "bb", "ccc", "dddd", "eeeee"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := [][]float64{{1}, {2}, {3}, {4}, {5}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("unexpected result: %v", result)
+		}
+		if calls != 4 {
+			t.Fatalf("unexpected calls: %d", calls)
+		}
+	})
+
+	t.Run("non retryable error", func(t *testing.T) {
+		emb, err := NewEmbedder(ctx, &EmbeddingConfig{
+			APIKey:       "api_key",
+			Model:        "embedding",
+			MaxBatchSize: 1,
+			MaxRetries:   &maxRetries,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var calls int32
+		defer mockey.Mock((*openai.Client).CreateEmbeddings).To(func(ctx context.Context, conv openai.EmbeddingRequestConverter) (res openai.EmbeddingResponse, err error) {
+			atomic.AddInt32(&calls, 1)
+			return res, &openai.APIError{HTTPStatusCode: http.StatusBadRequest}
+		}).Build().UnPatch()
+
+		_, err = emb.EmbedStrings(ctx, []string{"a", "b"})
+		var apiErr *openai.APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		if calls != 1 {
+			t.Fatalf("unexpected calls: %d", calls)
+		}
+	})
+}
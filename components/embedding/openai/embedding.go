@@ -26,6 +26,7 @@ import (
 	"github.com/cloudwego/eino/components/embedding"
 
 	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino-ext/libs/embedproc"
 )
 
 type EmbeddingConfig struct {
@@ -78,17 +79,58 @@ type EmbeddingConfig struct {
 	// User is a unique identifier representing your end-user
 	// Optional. Helps OpenAI monitor and detect abuse
 	User *string `json:"user,omitempty"`
+
+	// MaxBatchSize limits the number of texts sent in one request, texts exceeding it are split into batches
+	// Optional. Default: 2048, the max input array length of OpenAI embedding API
+	MaxBatchSize int `json:"max_batch_size,omitempty"`
+
+	// MaxTokensPerBatch limits the estimated tokens of texts sent in one request
+	// Optional. Default: 0, no limit
+	MaxTokensPerBatch int `json:"max_tokens_per_batch,omitempty"`
+
+	// TokenCounter estimates tokens of a text for MaxTokensPerBatch
+	// Optional. Default: about 4 bytes per token
+	TokenCounter func(text string) int `json:"-"`
+
+	// MaxConcurrency limits the number of batch requests in flight
+	// Optional. Default: 1
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
+
+	// MaxRetries specifies the retry times of a batch request on rate limit (429) or server errors
+	// Optional. Default: 3
+	MaxRetries *int `json:"max_retries,omitempty"`
+
+	// RetryBaseDelay is the initial backoff delay, doubled on each retry with jitter
+	// Optional. Default: 1s
+	RetryBaseDelay time.Duration `json:"retry_base_delay,omitempty"`
+
+	// RetryMaxDelay caps the backoff delay
+	// Optional. Default: 30s
+	RetryMaxDelay time.Duration `json:"retry_max_delay,omitempty"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
 }
 
 var _ embedding.Embedder = (*Embedder)(nil)
 
 type Embedder struct {
-	cli *openai.EmbeddingClient
+	cli         *openai.EmbeddingClient
+	conf        *batchConfig
+	postProcess *embedproc.Config
 }
 
 func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error) {
-	var nConf *openai.EmbeddingConfig
+	var (
+		nConf       *openai.EmbeddingConfig
+		postProcess *embedproc.Config
+	)
+	bConf := newBatchConfig(config)
 	if config != nil {
+		postProcess = config.PostProcess
+
 		var httpClient *http.Client
 
 		if config.HTTPClient != nil {
@@ -115,14 +157,21 @@ func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error
 	}
 
 	return &Embedder{
-		cli: cli,
+		cli:         cli,
+		conf:        bConf,
+		postProcess: postProcess,
 	}, nil
 }
 
 func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) (
 	embeddings [][]float64, err error) {
 	ctx = callbacks.EnsureRunInfo(ctx, e.GetType(), components.ComponentOfEmbedding)
-	return e.cli.EmbedStrings(ctx, texts, opts...)
+	embeddings, err = e.embedInBatches(ctx, texts, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return embedproc.Apply(embeddings, embedproc.GetConfig(e.postProcess, opts...))
 }
 
 const typ = "OpenAI"
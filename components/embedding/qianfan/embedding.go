@@ -23,6 +23,8 @@ import (
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components"
 	"github.com/cloudwego/eino/components/embedding"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
 )
 
 // GetQianfanSingletonConfig qianfan config is singleton, you should set ak+sk / bear_token before init chat model
@@ -37,6 +39,11 @@ type EmbeddingConfig struct {
 	LLMRetryCount         *int
 	LLMRetryTimeout       *float32
 	LLMRetryBackoffFactor *float32
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config
 }
 
 type Embedder struct {
@@ -91,6 +98,10 @@ func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...emb
 		embeddings[i] = resp.Data[i].Embedding
 	}
 
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, err
+	}
+
 	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
 		Embeddings: embeddings,
 		Config:     conf,
@@ -5,6 +5,7 @@ go 1.18
 require (
 	github.com/baidubce/bce-qianfan-sdk/go/qianfan v0.0.14
 	github.com/cloudwego/eino v0.3.27
+	github.com/cloudwego/eino-ext/libs/embedproc v0.0.0-20261016103348-1f45c6cadc76
 )
 
 require (
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tei
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
+)
+
+const defaultMaxBatchSize = 32
+
+type EmbeddingConfig struct {
+	// BaseURL specifies the address of the Text Embeddings Inference server or Inference Endpoint
+	// Required. Example: http://localhost:8080
+	BaseURL string `json:"base_url"`
+
+	// APIKey is sent as a bearer token in the Authorization header, e.g. a Hugging Face token for Inference Endpoints,
+	// or the --api-key of the TEI server
+	// Optional. Default: no Authorization header
+	APIKey string `json:"api_key"`
+
+	// Headers are added to every request, e.g. the auth header of a gateway in front of the server
+	// Optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout specifies the maximum duration to wait for API responses
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: no timeout
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"http_client"`
+
+	// Model is the name of the model served, which is only reported in callbacks, as a TEI server serves one model
+	// Optional
+	Model string `json:"model"`
+
+	// Normalize specifies whether the server normalizes the embeddings
+	// Optional. Default: true
+	Normalize *bool `json:"normalize,omitempty"`
+
+	// Truncate truncates texts exceeding the max input length of the model, otherwise an error is returned
+	// Optional. Default: false
+	Truncate *bool `json:"truncate,omitempty"`
+
+	// PromptName selects a prompt of the sentence-transformers config of the model, which is prepended to the texts,
+	// e.g. "query" for models with query and document prompts. It can be overridden per call by WithPromptName.
+	// Optional. Default: "", the default prompt of the model
+	PromptName string `json:"prompt_name,omitempty"`
+
+	// MaxBatchSize limits the number of texts sent in one request, texts exceeding it are split into batches
+	// Optional. Default: 32, the default --max-client-batch-size of TEI
+	MaxBatchSize int `json:"max_batch_size,omitempty"`
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config `json:"post_process,omitempty"`
+}
+
+var _ embedding.Embedder = (*Embedder)(nil)
+
+type Embedder struct {
+	conf *EmbeddingConfig
+	cli  *http.Client
+}
+
+func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[tei embedding] config is nil")
+	}
+	if len(config.BaseURL) == 0 {
+		return nil, fmt.Errorf("[tei embedding] base url is required")
+	}
+
+	conf := *config
+	if conf.MaxBatchSize <= 0 {
+		conf.MaxBatchSize = defaultMaxBatchSize
+	}
+
+	cli := conf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: conf.Timeout}
+	}
+
+	return &Embedder{conf: &conf, cli: cli}, nil
+}
+
+type embedRequest struct {
+	Inputs     []string `json:"inputs"`
+	Normalize  *bool    `json:"normalize,omitempty"`
+	Truncate   *bool    `json:"truncate,omitempty"`
+	PromptName *string  `json:"prompt_name,omitempty"`
+}
+
+func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) (
+	embeddings [][]float64, err error) {
+	options := embedding.GetCommonOptions(&embedding.Options{Model: &e.conf.Model}, opts...)
+	implOpts := embedding.GetImplSpecificOptions(&implOptions{promptName: e.conf.PromptName}, opts...)
+
+	conf := &embedding.Config{
+		Model:          *options.Model,
+		EncodingFormat: "float",
+	}
+
+	ctx = callbacks.EnsureRunInfo(ctx, e.GetType(), components.ComponentOfEmbedding)
+	ctx = callbacks.OnStart(ctx, &embedding.CallbackInput{
+		Texts:  texts,
+		Config: conf,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	var promptName *string
+	if len(implOpts.promptName) > 0 {
+		promptName = &implOpts.promptName
+	}
+
+	embeddings = make([][]float64, 0, len(texts))
+	for start := 0; start < len(texts); start += e.conf.MaxBatchSize {
+		end := start + e.conf.MaxBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		var batch [][]float64
+		err = e.post(ctx, "/embed", &embedRequest{
+			Inputs:     texts[start:end],
+			Normalize:  e.conf.Normalize,
+			Truncate:   e.conf.Truncate,
+			PromptName: promptName,
+		}, &batch)
+		if err != nil {
+			return nil, fmt.Errorf("[tei embedding] embed batch [%d, %d) failed: %w", start, end, err)
+		}
+		if len(batch) != end-start {
+			return nil, fmt.Errorf("[tei embedding] invalid embedding length, expected=%d, got=%d", end-start, len(batch))
+		}
+		embeddings = append(embeddings, batch...)
+	}
+
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, fmt.Errorf("[tei embedding] post process failed: %w", err)
+	}
+
+	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
+		Embeddings: embeddings,
+		Config:     conf,
+	})
+
+	return embeddings, nil
+}
+
+func (e *Embedder) post(ctx context.Context, path string, req, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request failed: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(e.conf.BaseURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request failed: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if len(e.conf.APIKey) > 0 {
+		httpReq.Header.Set("Authorization", "Bearer "+e.conf.APIKey)
+	}
+	for k, v := range e.conf.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	httpResp, err := e.cli.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("read response failed: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status=%d, body=%s", httpResp.StatusCode, respBody)
+	}
+
+	if err = json.Unmarshal(respBody, resp); err != nil {
+		return fmt.Errorf("unmarshal response failed: %w", err)
+	}
+	return nil
+}
+
+const typ = "TEI"
+
+func (e *Embedder) GetType() string {
+	return typ
+}
+
+func (e *Embedder) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tei
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmbedStrings(t *testing.T) {
+	convey.Convey("test EmbedStrings", t, func() {
+		ctx := context.Background()
+
+		var reqs []*embedRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/embed" || r.Header.Get("Authorization") != "Bearer mock" || r.Header.Get("X-Gateway-Key") != "gw" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized","error_type":"unauthorized"}`))
+				return
+			}
+
+			req := &embedRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			reqs = append(reqs, req)
+
+			resp := make([][]float64, 0, len(req.Inputs))
+			for _, input := range req.Inputs {
+				resp = append(resp, []float64{float64(len(input))})
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer srv.Close()
+
+		truncate := true
+		embedder, err := NewEmbedder(ctx, &EmbeddingConfig{
+			BaseURL:      srv.URL,
+			APIKey:       "mock",
+			Headers:      map[string]string{"X-Gateway-Key": "gw"},
+			Truncate:     &truncate,
+			MaxBatchSize: 2,
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("test success", func() {
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a", "bb", "ccc"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(vectors, convey.ShouldResemble, [][]float64{{1}, {2}, {3}})
+
+			convey.So(len(reqs), convey.ShouldEqual, 2)
+			convey.So(reqs[0].Inputs, convey.ShouldResemble, []string{"a", "bb"})
+			convey.So(reqs[1].Inputs, convey.ShouldResemble, []string{"ccc"})
+			convey.So(*reqs[0].Truncate, convey.ShouldBeTrue)
+			convey.So(reqs[0].Normalize, convey.ShouldBeNil)
+			convey.So(reqs[0].PromptName, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test prompt name", func() {
+			_, err := embedder.EmbedStrings(ctx, []string{"a"}, WithPromptName("query"))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(*reqs[0].PromptName, convey.ShouldEqual, "query")
+		})
+
+		convey.Convey("test server error", func() {
+			embedder.conf.Headers = nil
+
+			vectors, err := embedder.EmbedStrings(ctx, []string{"a"})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "status=401")
+			convey.So(vectors, convey.ShouldBeNil)
+		})
+	})
+
+	convey.Convey("test NewEmbedder", t, func() {
+		_, err := NewEmbedder(context.Background(), &EmbeddingConfig{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
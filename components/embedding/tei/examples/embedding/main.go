@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/embedding/tei"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// docker run -p 8080:80 ghcr.io/huggingface/text-embeddings-inference:cpu-1.7 --model-id BAAI/bge-small-en-v1.5
+	embedder, err := tei.NewEmbedder(ctx, &tei.EmbeddingConfig{
+		BaseURL: "http://localhost:8080",
+		APIKey:  os.Getenv("TEI_API_KEY"), // optional, the --api-key of the server or a Hugging Face token
+		Model:   "BAAI/bge-small-en-v1.5",
+	})
+	if err != nil {
+		log.Fatalf("NewEmbedder failed, err=%v", err)
+	}
+
+	embeddings, err := embedder.EmbedStrings(ctx, []string{"hello world", "bye world"})
+	if err != nil {
+		log.Fatalf("EmbedStrings failed, err=%v", err)
+	}
+
+	log.Printf("embeddings count=%d, dimensions=%d", len(embeddings), len(embeddings[0]))
+}
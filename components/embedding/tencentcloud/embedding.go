@@ -26,6 +26,8 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	hunyuan "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/hunyuan/v20230901"
+
+	"github.com/cloudwego/eino-ext/libs/embedproc"
 )
 
 const defaultModel = "hunyuan-embedding"
@@ -34,12 +36,18 @@ type EmbeddingConfig struct {
 	SecretID  string
 	SecretKey string
 	Region    string
+
+	// PostProcess post-processes the embeddings, e.g. L2 normalization and dimension truncation,
+	// it can be overridden per call by embedproc.WithConfig.
+	// Optional. Default: nil, embeddings are returned as is.
+	PostProcess *embedproc.Config
 }
 
 var _ embedding.Embedder = (*Embedder)(nil)
 
 // Embedder is a Tencent Cloud embedding client
 type Embedder struct {
+	conf   *EmbeddingConfig
 	client *hunyuan.Client
 }
 
@@ -57,6 +65,7 @@ func NewEmbedder(ctx context.Context, config *EmbeddingConfig) (*Embedder, error
 	}
 
 	return &Embedder{
+		conf:   config,
 		client: client,
 	}, nil
 }
@@ -109,6 +118,10 @@ func (e *Embedder) EmbedStrings(ctx context.Context, texts []string, opts ...emb
 		totalTokens += int(*rsp.Response.Usage.TotalTokens)
 	}
 
+	if embeddings, err = embedproc.Apply(embeddings, embedproc.GetConfig(e.conf.PostProcess, opts...)); err != nil {
+		return nil, err
+	}
+
 	callbacks.OnEnd(ctx, &embedding.CallbackOutput{
 		Embeddings: embeddings,
 		Config:     conf,
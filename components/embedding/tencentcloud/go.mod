@@ -5,6 +5,7 @@ go 1.22.5
 require (
 	github.com/bytedance/mockey v1.2.14
 	github.com/cloudwego/eino v0.3.27
+	github.com/cloudwego/eino-ext/libs/embedproc v0.0.0-20261016103348-1f45c6cadc76
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common v1.0.1093
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/hunyuan v1.0.1093
 )
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/embedding/voyage"
+)
+
+func main() {
+	ctx := context.Background()
+
+	embedder, err := voyage.NewEmbedder(ctx, &voyage.EmbeddingConfig{
+		APIKey:    os.Getenv("VOYAGE_API_KEY"),
+		Model:     "voyage-3.5",
+		InputType: voyage.InputTypeDocument,
+	})
+	if err != nil {
+		log.Fatalf("NewEmbedder failed, err=%v", err)
+	}
+
+	// embed documents for indexing
+	embeddings, err := embedder.EmbedStrings(ctx, []string{"Eino is a LLM application development framework in Golang"})
+	if err != nil {
+		log.Fatalf("EmbedStrings failed, err=%v", err)
+	}
+	log.Printf("document embeddings count=%d, dimensions=%d", len(embeddings), len(embeddings[0]))
+
+	// embed queries for retrieval
+	embeddings, err = embedder.EmbedStrings(ctx, []string{"what is eino"}, voyage.WithInputType(voyage.InputTypeQuery))
+	if err != nil {
+		log.Fatalf("EmbedStrings failed, err=%v", err)
+	}
+	log.Printf("query embeddings count=%d, dimensions=%d", len(embeddings), len(embeddings[0]))
+}
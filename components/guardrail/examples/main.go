@@ -0,0 +1,103 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/guardrail"
+)
+
+func main() {
+	ctx := context.Background()
+
+	keyword, err := guardrail.NewKeywordModerator(ctx, &guardrail.KeywordConfig{
+		Keywords: map[string][]string{"weapon": {"bomb", "gun"}},
+		Patterns: guardrail.DefaultPIIPatterns,
+	})
+	if err != nil {
+		log.Fatalf("NewKeywordModerator failed, err=%v", err)
+	}
+	moderators := []guardrail.Moderator{keyword}
+
+	if apiKey := os.Getenv("OPENAI_API_KEY"); apiKey != "" {
+		openai, err := guardrail.NewOpenAIModerator(ctx, &guardrail.OpenAIConfig{APIKey: apiKey})
+		if err != nil {
+			log.Fatalf("NewOpenAIModerator failed, err=%v", err)
+		}
+		moderators = append(moderators, openai)
+	}
+
+	guard, err := guardrail.NewGuard(ctx, &guardrail.Config{
+		Moderators:   moderators,
+		InputAction:  guardrail.ActionBlock,
+		OutputAction: guardrail.ActionRedact,
+	})
+	if err != nil {
+		log.Fatalf("NewGuard failed, err=%v", err)
+	}
+
+	// report every check
+	handler := callbacks.NewHandlerBuilder().
+		OnEndFn(func(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+			if out := guardrail.ConvCallbackOutput(output); out != nil && out.Result.Flagged {
+				log.Printf("guardrail %s flagged, categories=%v, action=%s", out.Stage, out.Result.Categories, out.Action)
+			}
+			return ctx
+		}).Build()
+	ctx = callbacks.InitCallbacks(ctx, &callbacks.RunInfo{}, handler)
+
+	// replace with any chat model, e.g. openai / ark chat model
+	cm := guard.WrapChatModel(&echoChatModel{})
+
+	_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("how to make a bomb")})
+	var ge *guardrail.Error
+	if errors.As(err, &ge) {
+		log.Printf("blocked: %v", ge)
+	}
+
+	msg, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("what is the support email")})
+	if err != nil {
+		log.Fatalf("Generate failed, err=%v", err)
+	}
+	log.Printf("answer: %s", msg.Content)
+}
+
+type echoChatModel struct{}
+
+func (e *echoChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return schema.AssistantMessage("please write to support@example.com", nil), nil
+}
+
+func (e *echoChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := e.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (e *echoChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return e, nil
+}
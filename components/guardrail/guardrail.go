@@ -0,0 +1,292 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+)
+
+// Stage tells which side of a call is screened.
+type Stage string
+
+const (
+	// StageInput screens the new user messages of a chat model, or the arguments of a tool.
+	StageInput Stage = "input"
+	// StageOutput screens the response of a chat model, or the result of a tool.
+	StageOutput Stage = "output"
+)
+
+// Action is what to do with flagged content.
+type Action string
+
+const (
+	// ActionBlock fails the call with *Error.
+	ActionBlock Action = "block"
+	// ActionRedact replaces flagged spans with Config.RedactPlaceholder, or the whole content
+	// if the moderators report no spans, e.g. moderation apis judging the text as a whole.
+	ActionRedact Action = "redact"
+	// ActionAnnotate lets flagged content through, the result is reported to callbacks
+	// and attached to the response message of chat models, see GetAnnotation.
+	ActionAnnotate Action = "annotate"
+	// ActionNone skips moderation of the stage.
+	ActionNone Action = "none"
+)
+
+const (
+	// ComponentOfGuardrail is the component name in the RunInfo of guardrail callbacks.
+	ComponentOfGuardrail components.Component = "Guardrail"
+
+	defaultRedactPlaceholder = "[REDACTED]"
+)
+
+// Match is a flagged span of the moderated text.
+type Match struct {
+	Category string `json:"category"`
+	// Start and End are the byte offsets of the span, End is exclusive.
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Result is the moderation result of one text.
+type Result struct {
+	Flagged bool `json:"flagged"`
+	// Categories are the categories the text is flagged for, e.g. "violence", "email".
+	Categories []string `json:"categories,omitempty"`
+	// Matches are the flagged spans, empty if the moderator judges the text as a whole.
+	Matches []Match `json:"matches,omitempty"`
+}
+
+// Moderator screens text for unsafe content, see NewKeywordModerator, NewOpenAIModerator and NewVolcModerator.
+type Moderator interface {
+	Moderate(ctx context.Context, text string) (*Result, error)
+}
+
+// Error is returned when content is blocked.
+type Error struct {
+	Stage  Stage
+	Result *Result
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("[guardrail] %s blocked, categories: %s", e.Stage, strings.Join(e.Result.Categories, ","))
+}
+
+type Config struct {
+	// Moderators screen content in order, their results are merged.
+	// Required.
+	Moderators []Moderator
+	// InputAction is applied when input is flagged.
+	// Optional. Default: ActionBlock
+	InputAction Action
+	// OutputAction is applied when output is flagged.
+	// Optional. Default: ActionBlock
+	OutputAction Action
+	// RedactPlaceholder replaces flagged content when the action is ActionRedact.
+	// Optional. Default: "[REDACTED]"
+	RedactPlaceholder string
+}
+
+// Guard moderates the input and output of chat models and tools wrapped by WrapChatModel and WrapTool.
+type Guard struct {
+	config *Config
+}
+
+func NewGuard(_ context.Context, config *Config) (*Guard, error) {
+	if config == nil || len(config.Moderators) == 0 {
+		return nil, errors.New("[NewGuard] moderators not provided")
+	}
+	for i, m := range config.Moderators {
+		if m == nil {
+			return nil, fmt.Errorf("[NewGuard] moderator %d not provided", i)
+		}
+	}
+
+	nConf := *config
+	var err error
+	if nConf.InputAction, err = checkAction(nConf.InputAction); err != nil {
+		return nil, fmt.Errorf("[NewGuard] invalid input action: %w", err)
+	}
+	if nConf.OutputAction, err = checkAction(nConf.OutputAction); err != nil {
+		return nil, fmt.Errorf("[NewGuard] invalid output action: %w", err)
+	}
+	if nConf.RedactPlaceholder == "" {
+		nConf.RedactPlaceholder = defaultRedactPlaceholder
+	}
+
+	return &Guard{config: &nConf}, nil
+}
+
+func checkAction(action Action) (Action, error) {
+	switch action {
+	case "":
+		return ActionBlock, nil
+	case ActionBlock, ActionRedact, ActionAnnotate, ActionNone:
+		return action, nil
+	default:
+		return "", fmt.Errorf("unknown action %q", action)
+	}
+}
+
+// CallbackInput is the input of guardrail callbacks.
+type CallbackInput struct {
+	Stage Stage
+	Text  string
+}
+
+// CallbackOutput is the output of guardrail callbacks.
+type CallbackOutput struct {
+	Stage  Stage
+	Result *Result
+	// Action is the action applied, empty if the text is not flagged.
+	Action Action
+}
+
+// ConvCallbackInput converts the callback input to the guardrail callback input.
+func ConvCallbackInput(src callbacks.CallbackInput) *CallbackInput {
+	t, _ := src.(*CallbackInput)
+	return t
+}
+
+// ConvCallbackOutput converts the callback output to the guardrail callback output.
+func ConvCallbackOutput(src callbacks.CallbackOutput) *CallbackOutput {
+	t, _ := src.(*CallbackOutput)
+	return t
+}
+
+// Check moderates text of stage and applies the action of the stage. It returns *Error if text is blocked,
+// or the text to pass on, which differs from text only if it is redacted. result is nil if the stage is skipped.
+// Every check is reported to callbacks, with ComponentOfGuardrail as the component of RunInfo.
+func (g *Guard) Check(ctx context.Context, stage Stage, text string) (passed string, result *Result, err error) {
+	action := g.action(stage)
+	if action == ActionNone || len(text) == 0 {
+		return text, nil, nil
+	}
+
+	ctx = callbacks.ReuseHandlers(ctx, &callbacks.RunInfo{
+		Name:      string(ComponentOfGuardrail),
+		Type:      string(stage),
+		Component: ComponentOfGuardrail,
+	})
+	ctx = callbacks.OnStart(ctx, &CallbackInput{Stage: stage, Text: text})
+
+	result, err = g.moderate(ctx, text, action == ActionBlock)
+	if err != nil {
+		callbacks.OnError(ctx, err)
+		return "", nil, err
+	}
+
+	out := &CallbackOutput{Stage: stage, Result: result}
+	if result.Flagged {
+		out.Action = action
+	}
+	callbacks.OnEnd(ctx, out)
+
+	if !result.Flagged {
+		return text, result, nil
+	}
+	switch action {
+	case ActionBlock:
+		return "", result, &Error{Stage: stage, Result: result}
+	case ActionRedact:
+		return redact(text, result.Matches, g.config.RedactPlaceholder), result, nil
+	default:
+		return text, result, nil
+	}
+}
+
+func (g *Guard) action(stage Stage) Action {
+	if stage == StageInput {
+		return g.config.InputAction
+	}
+	return g.config.OutputAction
+}
+
+// moderate merges the results of all moderators, or stops at the first flagged one if firstFlagged.
+func (g *Guard) moderate(ctx context.Context, text string, firstFlagged bool) (*Result, error) {
+	merged := &Result{}
+	for _, m := range g.config.Moderators {
+		r, err := m.Moderate(ctx, text)
+		if err != nil {
+			return nil, fmt.Errorf("[guardrail] moderate failed: %w", err)
+		}
+		if r == nil || !r.Flagged {
+			continue
+		}
+
+		merged.Flagged = true
+		for _, c := range r.Categories {
+			if !contains(merged.Categories, c) {
+				merged.Categories = append(merged.Categories, c)
+			}
+		}
+		merged.Matches = append(merged.Matches, r.Matches...)
+		if firstFlagged {
+			break
+		}
+	}
+	return merged, nil
+}
+
+// redact replaces matches in text with placeholder, overlapping matches are replaced once.
+// The whole text is replaced if there are no matches.
+func redact(text string, matches []Match, placeholder string) string {
+	if len(matches) == 0 {
+		return placeholder
+	}
+
+	sorted := make([]Match, len(matches))
+	copy(sorted, matches)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	sb := strings.Builder{}
+	last := 0
+	for _, m := range sorted {
+		if m.Start < 0 || m.End > len(text) || m.Start >= m.End {
+			continue
+		}
+		if m.Start < last {
+			// overlaps the previous match, which is already replaced
+			if m.End > last {
+				last = m.End
+			}
+			continue
+		}
+		sb.WriteString(text[last:m.Start])
+		sb.WriteString(placeholder)
+		last = m.End
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,287 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	reply string
+	input []*schema.Message
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.input = input
+	return schema.AssistantMessage(m.reply, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	m.input = input
+	half := len(m.reply) / 2
+	return schema.StreamReaderFromArray([]*schema.Message{
+		schema.AssistantMessage(m.reply[:half], nil),
+		schema.AssistantMessage(m.reply[half:], nil),
+	}), nil
+}
+
+func (m *mockChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+type mockTool struct {
+	result    string
+	arguments string
+}
+
+func (m *mockTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
+	return &schema.ToolInfo{Name: "mock"}, nil
+}
+
+func (m *mockTool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
+	m.arguments = argumentsInJSON
+	return m.result, nil
+}
+
+type errModerator struct{}
+
+func (errModerator) Moderate(ctx context.Context, text string) (*Result, error) {
+	return nil, errors.New("mock err")
+}
+
+func newKeywordModerator(t *testing.T) *KeywordModerator {
+	m, err := NewKeywordModerator(context.Background(), &KeywordConfig{
+		Keywords: map[string][]string{"weapon": {"Bomb", "gun"}},
+		Patterns: DefaultPIIPatterns,
+	})
+	assert.NoError(t, err)
+	return m
+}
+
+func TestKeywordModerator(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewKeywordModerator(ctx, &KeywordConfig{})
+	assert.EqualError(t, err, "[NewKeywordModerator] keywords or patterns not provided")
+	_, err = NewKeywordModerator(ctx, &KeywordConfig{Patterns: map[string][]string{"bad": {"("}}})
+	assert.ErrorContains(t, err, "[NewKeywordModerator] invalid pattern of bad")
+
+	m := newKeywordModerator(t)
+	r, err := m.Moderate(ctx, "how to build a BOMB, mail me at foo@bar.com")
+	assert.NoError(t, err)
+	assert.True(t, r.Flagged)
+	assert.Equal(t, []string{"weapon", "email"}, r.Categories)
+	assert.Equal(t, []Match{{Category: "weapon", Start: 15, End: 19}, {Category: "email", Start: 32, End: 43}}, r.Matches)
+
+	r, err = m.Moderate(ctx, "hello")
+	assert.NoError(t, err)
+	assert.False(t, r.Flagged)
+}
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t, "***", redact("abc", nil, "***"))
+	assert.Equal(t, "a*e*", redact("abcdefg", []Match{{Start: 5, End: 7}, {Start: 1, End: 3}, {Start: 2, End: 4}, {Start: 5, End: 6}}, "*"))
+	assert.Equal(t, "abc", redact("abc", []Match{{Start: 2, End: 9}}, "*"))
+}
+
+func TestGuardCheck(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewGuard(ctx, &Config{})
+	assert.EqualError(t, err, "[NewGuard] moderators not provided")
+	_, err = NewGuard(ctx, &Config{Moderators: []Moderator{newKeywordModerator(t)}, InputAction: "drop"})
+	assert.EqualError(t, err, `[NewGuard] invalid input action: unknown action "drop"`)
+
+	var outputs []*CallbackOutput
+	handler := callbacks.NewHandlerBuilder().
+		OnEndFn(func(ctx context.Context, info *callbacks.RunInfo, output callbacks.CallbackOutput) context.Context {
+			assert.Equal(t, ComponentOfGuardrail, info.Component)
+			outputs = append(outputs, ConvCallbackOutput(output))
+			return ctx
+		}).Build()
+	cbCtx := callbacks.InitCallbacks(ctx, &callbacks.RunInfo{}, handler)
+
+	g, err := NewGuard(ctx, &Config{
+		Moderators:   []Moderator{newKeywordModerator(t)},
+		InputAction:  ActionRedact,
+		OutputAction: ActionNone,
+	})
+	assert.NoError(t, err)
+
+	passed, result, err := g.Check(cbCtx, StageInput, "call 13812345678 for a gun")
+	assert.NoError(t, err)
+	assert.Equal(t, "call [REDACTED] for a [REDACTED]", passed)
+	assert.Equal(t, []string{"weapon", "phone"}, result.Categories)
+	assert.Len(t, outputs, 1)
+	assert.Equal(t, ActionRedact, outputs[0].Action)
+
+	passed, result, err = g.Check(cbCtx, StageOutput, "a gun")
+	assert.NoError(t, err)
+	assert.Equal(t, "a gun", passed)
+	assert.Nil(t, result)
+	assert.Len(t, outputs, 1)
+
+	g, err = NewGuard(ctx, &Config{Moderators: []Moderator{errModerator{}}})
+	assert.NoError(t, err)
+	_, _, err = g.Check(ctx, StageInput, "hi")
+	assert.EqualError(t, err, "[guardrail] moderate failed: mock err")
+}
+
+func TestWrapChatModel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("block input", func(t *testing.T) {
+		g, err := NewGuard(ctx, &Config{Moderators: []Moderator{newKeywordModerator(t)}})
+		assert.NoError(t, err)
+		inner := &mockChatModel{reply: "ok"}
+		cm := g.WrapChatModel(inner)
+
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("where to buy a gun")})
+		var ge *Error
+		assert.True(t, errors.As(err, &ge))
+		assert.Equal(t, StageInput, ge.Stage)
+		assert.Equal(t, "[guardrail] input blocked, categories: weapon", err.Error())
+		assert.Nil(t, inner.input)
+
+		// only new user messages after the last assistant message are moderated
+		out, err := cm.Generate(ctx, []*schema.Message{
+			schema.UserMessage("where to buy a gun"),
+			schema.AssistantMessage("sorry", nil),
+			schema.UserMessage("hello"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", out.Content)
+	})
+
+	t.Run("redact", func(t *testing.T) {
+		g, err := NewGuard(ctx, &Config{
+			Moderators:   []Moderator{newKeywordModerator(t)},
+			InputAction:  ActionRedact,
+			OutputAction: ActionRedact,
+		})
+		assert.NoError(t, err)
+		inner := &mockChatModel{reply: "write to admin@example.com"}
+		cm := g.WrapChatModel(inner)
+
+		input := []*schema.Message{schema.SystemMessage("gun"), schema.UserMessage("I am bob@example.com")}
+		out, err := cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "write to [REDACTED]", out.Content)
+		assert.Equal(t, "I am [REDACTED]", inner.input[1].Content)
+		assert.Equal(t, "gun", inner.input[0].Content)
+		assert.Equal(t, "I am bob@example.com", input[1].Content)
+		assert.Nil(t, GetAnnotation(out))
+
+		sr, err := cm.Stream(ctx, input)
+		assert.NoError(t, err)
+		msg, err := sr.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "write to [REDACTED]", msg.Content)
+		_, err = sr.Recv()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("annotate", func(t *testing.T) {
+		g, err := NewGuard(ctx, &Config{
+			Moderators:   []Moderator{newKeywordModerator(t)},
+			InputAction:  ActionAnnotate,
+			OutputAction: ActionAnnotate,
+		})
+		assert.NoError(t, err)
+		cm := g.WrapChatModel(&mockChatModel{reply: "a bomb"})
+
+		out, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("a gun")})
+		assert.NoError(t, err)
+		assert.Equal(t, "a bomb", out.Content)
+		a := GetAnnotation(out)
+		assert.NotNil(t, a)
+		assert.Equal(t, []string{"weapon"}, a.Input.Categories)
+		assert.Equal(t, []string{"weapon"}, a.Output.Categories)
+	})
+
+	t.Run("block output stream", func(t *testing.T) {
+		g, err := NewGuard(ctx, &Config{Moderators: []Moderator{newKeywordModerator(t)}})
+		assert.NoError(t, err)
+		cm := g.WrapChatModel(&mockChatModel{reply: "a bomb"})
+
+		_, err = cm.Stream(ctx, []*schema.Message{schema.UserMessage("hi")})
+		var ge *Error
+		assert.True(t, errors.As(err, &ge))
+		assert.Equal(t, StageOutput, ge.Stage)
+	})
+}
+
+func TestWrapTool(t *testing.T) {
+	ctx := context.Background()
+	g, err := NewGuard(ctx, &Config{
+		Moderators:   []Moderator{newKeywordModerator(t)},
+		OutputAction: ActionRedact,
+	})
+	assert.NoError(t, err)
+
+	inner := &mockTool{result: `{"email": "bob@example.com"}`}
+	tl := g.WrapTool(inner)
+	result, err := tl.InvokableRun(ctx, `{"name": "bob"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"email": "[REDACTED]"}`, result)
+
+	_, err = tl.InvokableRun(ctx, `{"name": "gun"}`)
+	assert.ErrorContains(t, err, "[guardrail] input blocked")
+	assert.Equal(t, `{"name": "bob"}`, inner.arguments)
+}
+
+func TestOpenAIModerator(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewOpenAIModerator(ctx, &OpenAIConfig{})
+	assert.EqualError(t, err, "[NewOpenAIModerator] api key not provided")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/moderations", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == `{"model":"omni-moderation-latest","input":"bad"}` {
+			_, _ = w.Write([]byte(`{"results":[{"flagged":true,"categories":{"violence":true,"hate":false,"harassment":true}}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":{"message":"invalid","type":"invalid_request_error"}}`))
+	}))
+	defer srv.Close()
+
+	m, err := NewOpenAIModerator(ctx, &OpenAIConfig{APIKey: "sk-test", BaseURL: srv.URL + "/v1/"})
+	assert.NoError(t, err)
+
+	r, err := m.Moderate(ctx, "bad")
+	assert.NoError(t, err)
+	assert.True(t, r.Flagged)
+	assert.Equal(t, []string{"harassment", "violence"}, r.Categories)
+
+	_, err = m.Moderate(ctx, "other")
+	assert.EqualError(t, err, "request fail, status code: 401, type: invalid_request_error, message: invalid")
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultPIIPatterns are regular expressions of common personal information by category,
+// which could be used as KeywordConfig.Patterns together with ActionRedact.
+var DefaultPIIPatterns = map[string][]string{
+	"email":       {`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`},
+	"phone":       {`\+?\d{1,3}[- ]?\(?\d{3}\)?[- ]?\d{3,4}[- ]?\d{4}\b`, `\b1[3-9]\d{9}\b`},
+	"credit_card": {`\b(?:\d{4}[- ]?){3}\d{4}\b`},
+}
+
+type KeywordConfig struct {
+	// Keywords are the forbidden words by category, matched case-insensitively.
+	// Optional, at least one of Keywords and Patterns is required.
+	Keywords map[string][]string
+	// Patterns are the regular expressions of forbidden content by category, see DefaultPIIPatterns.
+	// Optional, at least one of Keywords and Patterns is required.
+	Patterns map[string][]string
+}
+
+type keywordRule struct {
+	category string
+	re       *regexp.Regexp
+}
+
+// KeywordModerator flags text containing keywords or matching patterns locally,
+// and reports every occurrence as a Match.
+type KeywordModerator struct {
+	rules []*keywordRule
+}
+
+func NewKeywordModerator(_ context.Context, config *KeywordConfig) (*KeywordModerator, error) {
+	if config == nil || (len(config.Keywords) == 0 && len(config.Patterns) == 0) {
+		return nil, errors.New("[NewKeywordModerator] keywords or patterns not provided")
+	}
+
+	m := &KeywordModerator{}
+	for _, category := range sortedKeys(config.Keywords) {
+		var quoted []string
+		for _, kw := range config.Keywords[category] {
+			if len(kw) > 0 {
+				quoted = append(quoted, regexp.QuoteMeta(kw))
+			}
+		}
+		if len(quoted) == 0 {
+			continue
+		}
+		m.rules = append(m.rules, &keywordRule{
+			category: category,
+			re:       regexp.MustCompile(`(?i)` + strings.Join(quoted, "|")),
+		})
+	}
+	for _, category := range sortedKeys(config.Patterns) {
+		for _, p := range config.Patterns[category] {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return nil, fmt.Errorf("[NewKeywordModerator] invalid pattern of %s: %w", category, err)
+			}
+			m.rules = append(m.rules, &keywordRule{category: category, re: re})
+		}
+	}
+
+	return m, nil
+}
+
+func (m *KeywordModerator) Moderate(_ context.Context, text string) (*Result, error) {
+	result := &Result{}
+	for _, rule := range m.rules {
+		locs := rule.re.FindAllStringIndex(text, -1)
+		if len(locs) == 0 {
+			continue
+		}
+
+		result.Flagged = true
+		if !contains(result.Categories, rule.category) {
+			result.Categories = append(result.Categories, rule.category)
+		}
+		for _, loc := range locs {
+			result.Matches = append(result.Matches, Match{Category: rule.category, Start: loc[0], End: loc[1]})
+		}
+	}
+	return result, nil
+}
+
+func (m *KeywordModerator) GetType() string {
+	return "Keyword"
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
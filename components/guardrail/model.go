@@ -0,0 +1,215 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"context"
+	"io"
+
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const extraKeyAnnotation = "_eino_ext_guardrail"
+
+// Annotation is attached to the response message of a guarded chat model,
+// when flagged content is let through by ActionAnnotate.
+type Annotation struct {
+	Input  *Result `json:"input,omitempty"`
+	Output *Result `json:"output,omitempty"`
+}
+
+// GetAnnotation returns the annotation of msg, nil if nothing is flagged or the action is not ActionAnnotate.
+func GetAnnotation(msg *schema.Message) *Annotation {
+	if msg == nil {
+		return nil
+	}
+	a, _ := msg.Extra[extraKeyAnnotation].(*Annotation)
+	return a
+}
+
+// WrapChatModel returns a ChatModel moderating the new user messages of each request,
+// i.e. those after the last assistant message, before calling cm, and its response after.
+// Stream buffers the whole response to moderate it, and returns it as a single chunk,
+// unless the output action is ActionNone.
+func (g *Guard) WrapChatModel(cm model.ToolCallingChatModel) model.ToolCallingChatModel {
+	return &guardedChatModel{guard: g, cm: cm}
+}
+
+type guardedChatModel struct {
+	guard *Guard
+	cm    model.ToolCallingChatModel
+}
+
+func (m *guardedChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	input, annotation, err := m.checkInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := m.cm.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.checkOutput(ctx, out, annotation)
+}
+
+func (m *guardedChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	input, annotation, err := m.checkInput(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	sr, err := m.cm.Stream(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if m.guard.config.OutputAction == ActionNone && annotation.Input == nil {
+		return sr, nil
+	}
+	defer sr.Close()
+
+	var chunks []*schema.Message
+	for {
+		chunk, err := sr.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, chunk)
+	}
+	if len(chunks) == 0 {
+		return schema.StreamReaderFromArray(chunks), nil
+	}
+
+	out, err := schema.ConcatMessages(chunks)
+	if err != nil {
+		return nil, err
+	}
+	out, err = m.checkOutput(ctx, out, annotation)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.StreamReaderFromArray([]*schema.Message{out}), nil
+}
+
+func (m *guardedChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	cm, err := m.cm.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	return m.guard.WrapChatModel(cm), nil
+}
+
+func (m *guardedChatModel) checkInput(ctx context.Context, input []*schema.Message) ([]*schema.Message, *Annotation, error) {
+	annotation := &Annotation{}
+
+	var checked []*schema.Message
+	for i := len(input) - 1; i >= 0; i-- {
+		msg := input[i]
+		if msg == nil {
+			continue
+		}
+		if msg.Role == schema.Assistant {
+			break
+		}
+		if msg.Role != schema.User {
+			continue
+		}
+
+		passed, result, err := m.guard.Check(ctx, StageInput, msg.Content)
+		if err != nil {
+			return nil, nil, err
+		}
+		if result == nil || !result.Flagged {
+			continue
+		}
+
+		annotation.Input = mergeResult(annotation.Input, result)
+		if passed != msg.Content {
+			if checked == nil {
+				// copy on write, input of the caller is never modified
+				checked = make([]*schema.Message, len(input))
+				copy(checked, input)
+			}
+			cp := *msg
+			cp.Content = passed
+			checked[i] = &cp
+		}
+	}
+	if checked == nil {
+		checked = input
+	}
+
+	if m.guard.config.InputAction != ActionAnnotate {
+		annotation.Input = nil
+	}
+	return checked, annotation, nil
+}
+
+func (m *guardedChatModel) checkOutput(ctx context.Context, out *schema.Message, annotation *Annotation) (*schema.Message, error) {
+	passed, result, err := m.guard.Check(ctx, StageOutput, out.Content)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil && result.Flagged {
+		out.Content = passed
+		if m.guard.config.OutputAction == ActionAnnotate {
+			annotation.Output = result
+		}
+	}
+
+	if annotation.Input != nil || annotation.Output != nil {
+		extra := make(map[string]any, len(out.Extra)+1)
+		for k, v := range out.Extra {
+			extra[k] = v
+		}
+		extra[extraKeyAnnotation] = annotation
+		out.Extra = extra
+	}
+	return out, nil
+}
+
+// mergeResult merges the results of several input messages, matches are dropped
+// since their offsets are relative to different messages.
+func mergeResult(dst, src *Result) *Result {
+	if dst == nil {
+		return src
+	}
+	dst.Matches = nil
+	for _, c := range src.Categories {
+		if !contains(dst.Categories, c) {
+			dst.Categories = append(dst.Categories, c)
+		}
+	}
+	return dst
+}
+
+func (m *guardedChatModel) GetType() string {
+	typ, _ := components.GetType(m.cm)
+	return typ
+}
+
+// IsCallbacksEnabled follows the wrapped model, guardrail checks are reported as callbacks of their own.
+func (m *guardedChatModel) IsCallbacksEnabled() bool {
+	return components.IsCallbacksEnabled(m.cm)
+}
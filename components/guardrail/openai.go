@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOpenAIBaseURL = "https://api.openai.com/v1"
+	defaultOpenAIModel   = "omni-moderation-latest"
+)
+
+type OpenAIConfig struct {
+	// APIKey is the api key of OpenAI.
+	// Required.
+	APIKey string
+	// BaseURL is the base url of OpenAI compatible api.
+	// Optional. Default: "https://api.openai.com/v1"
+	BaseURL string
+	// Model is the moderation model.
+	// Optional. Default: "omni-moderation-latest"
+	Model string
+	// Timeout specifies the duration to wait before timing out a request.
+	// Optional. Default: 0 (no timeout)
+	Timeout time.Duration
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client
+}
+
+// OpenAIModerator flags text with the OpenAI moderation api, see https://platform.openai.com/docs/api-reference/moderations.
+// Categories are those flagged by the api, e.g. "harassment", "violence". No Match is reported.
+type OpenAIModerator struct {
+	config *OpenAIConfig
+	cli    *http.Client
+}
+
+func NewOpenAIModerator(_ context.Context, config *OpenAIConfig) (*OpenAIModerator, error) {
+	if config == nil || config.APIKey == "" {
+		return nil, errors.New("[NewOpenAIModerator] api key not provided")
+	}
+
+	nConf := *config
+	if nConf.BaseURL == "" {
+		nConf.BaseURL = defaultOpenAIBaseURL
+	}
+	nConf.BaseURL = strings.TrimSuffix(nConf.BaseURL, "/")
+	if nConf.Model == "" {
+		nConf.Model = defaultOpenAIModel
+	}
+
+	cli := nConf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: nConf.Timeout}
+	}
+
+	return &OpenAIModerator{config: &nConf, cli: cli}, nil
+}
+
+type openAIRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+}
+
+type openAIResponse struct {
+	Results []struct {
+		Flagged    bool            `json:"flagged"`
+		Categories map[string]bool `json:"categories"`
+	} `json:"results"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error,omitempty"`
+}
+
+func (m *OpenAIModerator) Moderate(ctx context.Context, text string) (*Result, error) {
+	body, err := json.Marshal(&openAIRequest{Model: m.config.Model, Input: text})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.config.BaseURL+"/moderations", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.config.APIKey)
+
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+
+	origResp := &openAIResponse{}
+	if err = json.Unmarshal(respBody, origResp); err != nil {
+		return nil, fmt.Errorf("unmarshal response fail, status code: %d: %w", resp.StatusCode, err)
+	}
+	if origResp.Error != nil {
+		return nil, fmt.Errorf("request fail, status code: %d, type: %s, message: %s", resp.StatusCode, origResp.Error.Type, origResp.Error.Message)
+	}
+	if len(origResp.Results) == 0 {
+		return nil, fmt.Errorf("empty moderation results, status code: %d", resp.StatusCode)
+	}
+
+	r := origResp.Results[0]
+	result := &Result{Flagged: r.Flagged}
+	for category, flagged := range r.Categories {
+		if flagged {
+			result.Categories = append(result.Categories, category)
+		}
+	}
+	sort.Strings(result.Categories)
+	return result, nil
+}
+
+func (m *OpenAIModerator) GetType() string {
+	return "OpenAI"
+}
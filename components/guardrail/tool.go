@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+)
+
+// WrapTool returns a tool moderating the arguments before calling t, and its result after.
+// Redacting arguments may break their json format, ActionBlock is preferred for tool input.
+func (g *Guard) WrapTool(t tool.InvokableTool) tool.InvokableTool {
+	return &guardedTool{guard: g, tool: t}
+}
+
+type guardedTool struct {
+	guard *Guard
+	tool  tool.InvokableTool
+}
+
+func (t *guardedTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
+	return t.tool.Info(ctx)
+}
+
+func (t *guardedTool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
+	arguments, _, err := t.guard.Check(ctx, StageInput, argumentsInJSON)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := t.tool.InvokableRun(ctx, arguments, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	result, _, err = t.guard.Check(ctx, StageOutput, result)
+	if err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+func (t *guardedTool) GetType() string {
+	typ, _ := components.GetType(t.tool)
+	return typ
+}
+
+// IsCallbacksEnabled follows the wrapped tool, guardrail checks are reported as callbacks of their own.
+func (t *guardedTool) IsCallbacksEnabled() bool {
+	return components.IsCallbacksEnabled(t.tool)
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package guardrail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/volcengine/volc-sdk-golang/base"
+)
+
+const (
+	defaultVolcBaseURL = "open.volcengineapi.com"
+	defaultVolcRegion  = "cn-north-1"
+	defaultVolcBizType = "default"
+
+	volcAction  = "TextRisk"
+	volcVersion = "2022-11-07"
+	volcService = "text_risk"
+
+	volcDecisionPass = "PASS"
+)
+
+type VolcConfig struct {
+	// AK specifies the access key for authentication.
+	// Required.
+	AK string
+	// SK specifies the secret key for authentication.
+	// Required.
+	SK string
+	// AppID is the id of the content security application.
+	// Required.
+	AppID int64
+	// BizType is the business type configured in the content security console, which decides the risk policies.
+	// Optional. Default: "default"
+	BizType string
+	// BaseURL is the host of Volcengine openapi.
+	// Optional. Default: "open.volcengineapi.com"
+	BaseURL string
+	// Region of the service.
+	// Optional. Default: "cn-north-1"
+	Region string
+	// Timeout specifies the duration to wait before timing out a request.
+	// Optional. Default: 0 (no timeout)
+	Timeout time.Duration
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client
+}
+
+// VolcModerator flags text with the TextRisk api of Volcengine content security.
+// Text is flagged unless the decision is PASS, categories are the risk labels,
+// and matched words are reported as Match.
+type VolcModerator struct {
+	config     *VolcConfig
+	cli        *http.Client
+	credential *base.Credentials
+}
+
+func NewVolcModerator(_ context.Context, config *VolcConfig) (*VolcModerator, error) {
+	if config == nil || config.AK == "" || config.SK == "" {
+		return nil, errors.New("[NewVolcModerator] ak or sk not provided")
+	}
+	if config.AppID == 0 {
+		return nil, errors.New("[NewVolcModerator] app id not provided")
+	}
+
+	nConf := *config
+	if nConf.BaseURL == "" {
+		nConf.BaseURL = defaultVolcBaseURL
+	}
+	if nConf.Region == "" {
+		nConf.Region = defaultVolcRegion
+	}
+	if nConf.BizType == "" {
+		nConf.BizType = defaultVolcBizType
+	}
+
+	cli := nConf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: nConf.Timeout}
+	}
+
+	return &VolcModerator{
+		config: &nConf,
+		cli:    cli,
+		credential: &base.Credentials{
+			AccessKeyID:     nConf.AK,
+			SecretAccessKey: nConf.SK,
+			Service:         "BusinessSecurity",
+			Region:          nConf.Region,
+		},
+	}, nil
+}
+
+type volcRequest struct {
+	AppID      int64  `json:"AppId"`
+	Service    string `json:"Service"`
+	Parameters string `json:"Parameters"`
+}
+
+type volcParameters struct {
+	BizType string `json:"biztype"`
+	Text    string `json:"text"`
+}
+
+type volcResponse struct {
+	ResponseMetadata struct {
+		RequestID string `json:"RequestId"`
+		Error     *struct {
+			Code    string `json:"Code"`
+			Message string `json:"Message"`
+		} `json:"Error,omitempty"`
+	} `json:"ResponseMetadata"`
+	Result *struct {
+		Code    int64  `json:"Code"`
+		Message string `json:"Message"`
+		Data    *struct {
+			Decision string `json:"Decision"`
+			Results  []struct {
+				Decision string `json:"Decision"`
+				Label    string `json:"Label"`
+				Matches  []struct {
+					Word      string `json:"Word"`
+					Positions []struct {
+						StartPos int `json:"StartPos"`
+					} `json:"Positions"`
+				} `json:"Matches"`
+			} `json:"Results"`
+		} `json:"Data"`
+	} `json:"Result"`
+}
+
+func (m *VolcModerator) Moderate(ctx context.Context, text string) (*Result, error) {
+	params, err := json.Marshal(&volcParameters{BizType: m.config.BizType, Text: text})
+	if err != nil {
+		return nil, fmt.Errorf("marshal parameters fail: %w", err)
+	}
+	body, err := json.Marshal(&volcRequest{AppID: m.config.AppID, Service: volcService, Parameters: string(params)})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, (&url.URL{
+		Scheme:   "https",
+		Host:     m.config.BaseURL,
+		Path:     "/",
+		RawQuery: url.Values{"Action": {volcAction}, "Version": {volcVersion}}.Encode(),
+	}).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := m.cli.Do(m.credential.Sign(req).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("do request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+
+	origResp := &volcResponse{}
+	if err = json.Unmarshal(respBody, origResp); err != nil {
+		return nil, fmt.Errorf("unmarshal response fail, status code: %d: %w", resp.StatusCode, err)
+	}
+	if e := origResp.ResponseMetadata.Error; e != nil && e.Code != "" {
+		return nil, fmt.Errorf("request fail, code: %s, msg: %s, request id: %s", e.Code, e.Message, origResp.ResponseMetadata.RequestID)
+	}
+	if origResp.Result == nil || origResp.Result.Data == nil {
+		return nil, fmt.Errorf("empty moderation result, request id: %s", origResp.ResponseMetadata.RequestID)
+	}
+	if origResp.Result.Code != 0 {
+		return nil, fmt.Errorf("request fail, code: %d, msg: %s, request id: %s", origResp.Result.Code, origResp.Result.Message, origResp.ResponseMetadata.RequestID)
+	}
+
+	data := origResp.Result.Data
+	result := &Result{Flagged: data.Decision != "" && data.Decision != volcDecisionPass}
+	if !result.Flagged {
+		return result, nil
+	}
+
+	// positions are in characters, converted to byte offsets of the matched words
+	offsets := byteOffsets(text)
+	for _, r := range data.Results {
+		if r.Decision == volcDecisionPass || r.Label == "" {
+			continue
+		}
+		if !contains(result.Categories, r.Label) {
+			result.Categories = append(result.Categories, r.Label)
+		}
+		for _, match := range r.Matches {
+			for _, pos := range match.Positions {
+				if match.Word == "" || pos.StartPos < 0 || pos.StartPos >= len(offsets) {
+					continue
+				}
+				start := offsets[pos.StartPos]
+				if !strings.HasPrefix(text[start:], match.Word) {
+					continue
+				}
+				result.Matches = append(result.Matches, Match{Category: r.Label, Start: start, End: start + len(match.Word)})
+			}
+		}
+	}
+	return result, nil
+}
+
+func (m *VolcModerator) GetType() string {
+	return "Volc"
+}
+
+// byteOffsets returns the byte offset of each character of text, followed by len(text).
+func byteOffsets(text string) []int {
+	offsets := make([]int, 0, utf8.RuneCountInString(text)+1)
+	for i := range text {
+		offsets = append(offsets, i)
+	}
+	return append(offsets, len(text))
+}
@@ -19,5 +19,6 @@ package es8
 const typ = "ElasticSearch8"
 
 const (
-	defaultBatchSize = 5
+	defaultBatchSize        = 5
+	defaultVectorSimilarity = "cosine"
 )
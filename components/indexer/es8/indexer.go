@@ -21,6 +21,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components"
@@ -38,6 +43,22 @@ type IndexerConfig struct {
 	// BatchSize controls max texts size for embedding.
 	// Default is 5.
 	BatchSize int `json:"batch_size"`
+	// FlushBytes controls the flush threshold in bytes of bulk api.
+	// Default is 5MB, see esutil.BulkIndexerConfig.
+	FlushBytes int `json:"flush_bytes"`
+	// FlushInterval controls the periodic flush interval of bulk api.
+	// Default is 30s, see esutil.BulkIndexerConfig.
+	FlushInterval time.Duration `json:"flush_interval"`
+	// NumWorkers controls the number of workers sending bulk requests.
+	// Default is number of CPUs, see esutil.BulkIndexerConfig.
+	NumWorkers int `json:"num_workers"`
+	// CreateIndexIfNotExists if true, index will be created before the first write when it doesn't exist.
+	// Each EmbedKey from DocumentToFields will be mapped as dense_vector, whose dims is derived from embedding result,
+	// other fields are mapped dynamically by es.
+	CreateIndexIfNotExists bool `json:"create_index_if_not_exists"`
+	// VectorSimilarity similarity of dense_vector mapping when creating index.
+	// Default is "cosine".
+	VectorSimilarity string `json:"vector_similarity"`
 	// FieldMapping supports customize es fields from eino document.
 	// Each key - FieldValue.Value from field2Value will be saved, and
 	// vector of FieldValue.Value will be saved if FieldValue.EmbedKey is not empty.
@@ -62,6 +83,9 @@ type FieldValue struct {
 type Indexer struct {
 	client *elasticsearch.Client
 	config *IndexerConfig
+
+	indexMu      sync.Mutex
+	indexEnsured bool
 }
 
 func NewIndexer(_ context.Context, conf *IndexerConfig) (*Indexer, error) {
@@ -77,6 +101,10 @@ func NewIndexer(_ context.Context, conf *IndexerConfig) (*Indexer, error) {
 		conf.BatchSize = defaultBatchSize
 	}
 
+	if conf.VectorSimilarity == "" {
+		conf.VectorSimilarity = defaultVectorSimilarity
+	}
+
 	return &Indexer{
 		client: conf.Client,
 		config: conf,
@@ -110,13 +138,34 @@ func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...in
 func (i *Indexer) bulkAdd(ctx context.Context, docs []*schema.Document, options *indexer.Options) error {
 	emb := options.Embedding
 	bi, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
-		Index:  i.config.Index,
-		Client: i.client,
+		Index:         i.config.Index,
+		Client:        i.client,
+		NumWorkers:    i.config.NumWorkers,
+		FlushBytes:    i.config.FlushBytes,
+		FlushInterval: i.config.FlushInterval,
 	})
 	if err != nil {
 		return err
 	}
 
+	var (
+		failureMu  sync.Mutex
+		failureErr error
+	)
+	onFailure := func(ctx context.Context, item esutil.BulkIndexerItem, resp esutil.BulkIndexerResponseItem, err error) {
+		failureMu.Lock()
+		defer failureMu.Unlock()
+		if failureErr != nil {
+			return
+		}
+		if err != nil {
+			failureErr = fmt.Errorf("[bulkAdd] bulk item failed, id=%s, %w", item.DocumentID, err)
+		} else {
+			failureErr = fmt.Errorf("[bulkAdd] bulk item failed, id=%s, type=%s, reason=%s",
+				item.DocumentID, resp.Error.Type, resp.Error.Reason)
+		}
+	}
+
 	var (
 		tuples []tuple
 		texts  []string
@@ -140,6 +189,23 @@ func (i *Indexer) bulkAdd(ctx context.Context, docs []*schema.Document, options
 			}
 		}
 
+		if i.config.CreateIndexIfNotExists && len(tuples) > 0 {
+			// documents may not share the same embed keys, so collect dims from all of them
+			dims := make(map[string]int)
+			for _, t := range tuples {
+				for k, idx := range t.key2Idx {
+					dim := len(vectors[idx])
+					if prev, found := dims[k]; found && prev != dim {
+						return fmt.Errorf("[bulkAdd] inconsistent vector dims of field=%s, %d vs %d", k, prev, dim)
+					}
+					dims[k] = dim
+				}
+			}
+			if err = i.ensureIndex(ctx, dims); err != nil {
+				return err
+			}
+		}
+
 		for _, t := range tuples {
 			fields := t.fields
 			for k, idx := range t.key2Idx {
@@ -156,6 +222,7 @@ func (i *Indexer) bulkAdd(ctx context.Context, docs []*schema.Document, options
 				Action:     "index",
 				DocumentID: t.id,
 				Body:       bytes.NewReader(b),
+				OnFailure:  onFailure,
 			}); err != nil {
 				return err
 			}
@@ -237,7 +304,75 @@ func (i *Indexer) bulkAdd(ctx context.Context, docs []*schema.Document, options
 		}
 	}
 
-	return bi.Close(ctx)
+	if err = bi.Close(ctx); err != nil {
+		return err
+	}
+
+	return failureErr
+}
+
+// ensureIndex creates index with dense_vector mapping of embed keys if index not exists.
+func (i *Indexer) ensureIndex(ctx context.Context, dims map[string]int) error {
+	i.indexMu.Lock()
+	defer i.indexMu.Unlock()
+
+	if i.indexEnsured {
+		return nil
+	}
+
+	resp, err := i.client.Indices.Exists([]string{i.config.Index}, i.client.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("[ensureIndex] check index exists failed, %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		i.indexEnsured = true
+		return nil
+	case http.StatusNotFound:
+	default:
+		return fmt.Errorf("[ensureIndex] check index exists failed, status=%d", resp.StatusCode)
+	}
+
+	properties := make(map[string]any, len(dims))
+	for k, dim := range dims {
+		properties[k] = map[string]any{
+			"type":       "dense_vector",
+			"dims":       dim,
+			"index":      true,
+			"similarity": i.config.VectorSimilarity,
+		}
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"mappings": map[string]any{
+			"properties": properties,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("[ensureIndex] marshal mapping failed, %w", err)
+	}
+
+	createResp, err := i.client.Indices.Create(i.config.Index,
+		i.client.Indices.Create.WithContext(ctx),
+		i.client.Indices.Create.WithBody(bytes.NewReader(body)))
+	if err != nil {
+		return fmt.Errorf("[ensureIndex] create index failed, %w", err)
+	}
+	defer createResp.Body.Close()
+
+	if createResp.IsError() {
+		b, _ := io.ReadAll(createResp.Body)
+		// index may be created concurrently by others
+		if !strings.Contains(string(b), "resource_already_exists_exception") {
+			return fmt.Errorf("[ensureIndex] create index failed, status=%d, body=%s", createResp.StatusCode, b)
+		}
+	}
+
+	i.indexEnsured = true
+
+	return nil
 }
 
 func (i *Indexer) makeEmbeddingCtx(ctx context.Context, emb embedding.Embedder) context.Context {
@@ -21,12 +21,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	. "github.com/bytedance/mockey"
 	"github.com/cloudwego/eino/components/embedding"
 	"github.com/cloudwego/eino/components/indexer"
 	"github.com/cloudwego/eino/schema"
+	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/esutil"
 	"github.com/smartystreets/goconvey/convey"
 )
@@ -169,6 +172,35 @@ func TestBulkAdd(t *testing.T) {
 			convey.So(err, convey.ShouldBeError, fmt.Errorf("[bulkAdd] invalid vector length, expected=%d, got=%d", 2, 1))
 		})
 
+		PatchConvey("test ensureIndex with dims of all docs", func() {
+			var dims map[string]int
+			Mock(esutil.NewBulkIndexer).Return(bi, nil).Build()
+			Mock(GetMethod(bi, "Add")).Return(nil).Build()
+			Mock(GetMethod(bi, "Close")).Return(nil).Build()
+			Mock((*Indexer).ensureIndex).To(func(i *Indexer, ctx context.Context, d map[string]int) error {
+				dims = d
+				return nil
+			}).Build()
+
+			i := &Indexer{
+				config: &IndexerConfig{
+					Index:                  "mock_index",
+					BatchSize:              2,
+					CreateIndexIfNotExists: true,
+					DocumentToFields: func(ctx context.Context, doc *schema.Document) (field2Value map[string]FieldValue, err error) {
+						return map[string]FieldValue{
+							"k_" + doc.ID: {Value: doc.Content, EmbedKey: "vk_" + doc.ID},
+						}, nil
+					},
+				},
+			}
+			err := i.bulkAdd(ctx, docs, &indexer.Options{
+				Embedding: &mockEmbedding{size: []int{2}, mockVector: []float64{2.1}},
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(dims, convey.ShouldResemble, map[string]int{"vk_123": 1, "vk_456": 1})
+		})
+
 		PatchConvey("test success", func() {
 			var mps []esutil.BulkIndexerItem
 			Mock(esutil.NewBulkIndexer).Return(bi, nil).Build()
@@ -239,3 +271,69 @@ func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts .
 
 	return resp, nil
 }
+
+func TestEnsureIndex(t *testing.T) {
+	PatchConvey("test ensureIndex", t, func() {
+		ctx := context.Background()
+
+		var (
+			methods []string
+			mapping map[string]any
+		)
+		client, err := elasticsearch.NewClient(elasticsearch.Config{
+			Addresses: []string{"http://localhost:9200"},
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				methods = append(methods, req.Method)
+				status := http.StatusOK
+				if req.Method == http.MethodHead {
+					status = http.StatusNotFound
+				}
+				if req.Method == http.MethodPut {
+					b, _ := io.ReadAll(req.Body)
+					_ = json.Unmarshal(b, &mapping)
+				}
+				return &http.Response{
+					StatusCode: status,
+					Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+					Body:       io.NopCloser(strings.NewReader(`{}`)),
+				}, nil
+			}),
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		i, err := NewIndexer(ctx, &IndexerConfig{
+			Client:                 client,
+			Index:                  "mock_index",
+			CreateIndexIfNotExists: true,
+			DocumentToFields: func(ctx context.Context, doc *schema.Document) (field2Value map[string]FieldValue, err error) {
+				return nil, nil
+			},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.So(i.ensureIndex(ctx, map[string]int{"vector": 3}), convey.ShouldBeNil)
+		convey.So(methods, convey.ShouldResemble, []string{http.MethodHead, http.MethodPut})
+		convey.So(mapping, convey.ShouldResemble, map[string]any{
+			"mappings": map[string]any{
+				"properties": map[string]any{
+					"vector": map[string]any{
+						"type":       "dense_vector",
+						"dims":       float64(3),
+						"index":      true,
+						"similarity": defaultVectorSimilarity,
+					},
+				},
+			},
+		})
+
+		// index is ensured only once
+		convey.So(i.ensureIndex(ctx, map[string]int{"vector": 3}), convey.ShouldBeNil)
+		convey.So(len(methods), convey.ShouldEqual, 2)
+	})
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
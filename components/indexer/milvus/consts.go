@@ -31,6 +31,8 @@ const (
 	
 	defaultDim = 81920
 	
+	defaultBatchSize = 1000
+	
 	defaultIndexField = "vector"
 	
 	defaultConsistencyLevel = ConsistencyLevelBounded
@@ -66,7 +66,15 @@ type IndexerConfig struct {
 	// EnableDynamicSchema is means the collection is enabled to dynamic schema
 	// Optional, and the default value is false
 	// Enable to dynamic schema it could affect milvus performance
+	// If enabled with the default DocumentConverter, the keys of schema.Document's MetaData will be stored as dynamic fields as well
 	EnableDynamicSchema bool
+	// AutoID is means the primary key of the default fields is generated by milvus
+	// Optional, and the default value is false
+	// If enabled, the primary key is int64 and schema.Document's ID will be ignored by the default DocumentConverter
+	AutoID bool
+	// BatchSize is the max number of documents to be embedded and inserted in one request
+	// Optional, and the default value is 1000
+	BatchSize int
 	
 	// DocumentConverter is the function to convert the schema.Document to the row data
 	// Optional, and the default value is defaultDocumentConverter
@@ -187,6 +195,31 @@ func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...in
 		return nil, fmt.Errorf("[Indexer.Store] embedding not provided")
 	}
 	
+	// store documents into milvus in batches
+	ids = make([]string, 0, len(docs))
+	for start := 0; start < len(docs); start += i.config.BatchSize {
+		end := min(start+i.config.BatchSize, len(docs))
+		batchIDs, err := i.storeBatch(ctx, emb, docs[start:end], io.Partition)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, batchIDs...)
+	}
+	
+	// flush collection to make sure the data is visible
+	if err := i.config.Client.Flush(ctx, i.config.Collection, false); err != nil {
+		return nil, fmt.Errorf("[Indexer.Store] failed to flush collection: %w", err)
+	}
+	
+	// callback info on end
+	callbacks.OnEnd(ctx, &indexer.CallbackOutput{
+		IDs: ids,
+	})
+	return ids, nil
+}
+
+// storeBatch embeds and inserts one batch of documents
+func (i *Indexer) storeBatch(ctx context.Context, emb embedding.Embedder, docs []*schema.Document, partition string) ([]string, error) {
 	// load documents content
 	texts := make([]string, 0, len(docs))
 	for _, doc := range docs {
@@ -209,29 +242,18 @@ func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...in
 		return nil, fmt.Errorf("[Indexer.Store] failed to convert documents: %w", err)
 	}
 	
-	// store documents into milvus
-	results, err := i.config.Client.InsertRows(ctx, i.config.Collection, io.Partition, rows)
+	results, err := i.config.Client.InsertRows(ctx, i.config.Collection, partition, rows)
 	if err != nil {
 		return nil, fmt.Errorf("[Indexer.Store] failed to insert rows: %w", err)
 	}
 	
-	// flush collection to make sure the data is visible
-	if err := i.config.Client.Flush(ctx, i.config.Collection, false); err != nil {
-		return nil, fmt.Errorf("[Indexer.Store] failed to flush collection: %w", err)
-	}
-	
-	// callback info on end
-	ids = make([]string, results.Len())
+	ids := make([]string, results.Len())
 	for idx := 0; idx < results.Len(); idx++ {
-		ids[idx], err = results.GetAsString(idx)
+		ids[idx], err = getIDAsString(results, idx)
 		if err != nil {
 			return nil, fmt.Errorf("[Indexer.Store] failed to get id: %w", err)
 		}
 	}
-	
-	callbacks.OnEnd(ctx, &indexer.CallbackOutput{
-		IDs: ids,
-	})
 	return ids, nil
 }
 
@@ -256,6 +278,10 @@ func (i *IndexerConfig) getSchema(collection, description string, fields []*enti
 
 func (i *IndexerConfig) getDefaultDocumentConvert() func(ctx context.Context, docs []*schema.Document, vectors [][]float64) ([]interface{}, error) {
 	return func(ctx context.Context, docs []*schema.Document, vectors [][]float64) ([]interface{}, error) {
+		if i.AutoID || i.EnableDynamicSchema {
+			return i.convertToMapRows(docs, vectors)
+		}
+		
 		em := make([]defaultSchema, 0, len(docs))
 		texts := make([]string, 0, len(docs))
 		rows := make([]interface{}, 0, len(docs))
@@ -283,6 +309,40 @@ func (i *IndexerConfig) getDefaultDocumentConvert() func(ctx context.Context, do
 	}
 }
 
+// convertToMapRows converts the documents to map rows, which omits the auto id field and
+// expands the metadata keys as dynamic fields
+func (i *IndexerConfig) convertToMapRows(docs []*schema.Document, vectors [][]float64) ([]interface{}, error) {
+	if len(vectors) != len(docs) {
+		return nil, fmt.Errorf("vectors length not match, need: %d, got: %d", len(docs), len(vectors))
+	}
+	rows := make([]interface{}, 0, len(docs))
+	for idx, doc := range docs {
+		metadata, err := sonic.Marshal(doc.MetaData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+		}
+		row := map[string]interface{}{
+			defaultCollectionContent:  doc.Content,
+			defaultCollectionVector:   vector2Bytes(vectors[idx]),
+			defaultCollectionMetadata: metadata,
+		}
+		if !i.AutoID {
+			row[defaultCollectionID] = doc.ID
+		}
+		if i.EnableDynamicSchema {
+			for k, v := range doc.MetaData {
+				// the fixed fields take precedence over the dynamic fields
+				if _, ok := row[k]; ok || k == defaultCollectionID {
+					continue
+				}
+				row[k] = v
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
 // createdDefaultIndex creates the default index
 func (i *IndexerConfig) createdDefaultIndex(ctx context.Context, async bool) error {
 	index, err := entity.NewIndexAUTOINDEX(i.MetricType.getMetricType())
@@ -390,6 +450,12 @@ func (i *IndexerConfig) check() error {
 	}
 	if i.Fields == nil {
 		i.Fields = getDefaultFields()
+		if i.AutoID {
+			i.Fields[0] = getAutoIDField()
+		}
+	}
+	if i.BatchSize <= 0 {
+		i.BatchSize = defaultBatchSize
 	}
 	if i.DocumentConverter == nil {
 		i.DocumentConverter = i.getDefaultDocumentConvert()
@@ -383,6 +383,32 @@ func TestIndexer_Store(t *testing.T) {
 			convey.So(ids[1], convey.ShouldEqual, "doc2")
 		})
 		
+		PatchConvey("test store in batches", func() {
+			var insertCnt int
+			Mock(GetMethod(mockClient, "InsertRows")).To(func(ctx context.Context, collName string, partitionName string, rows []interface{}) (entity.Column, error) {
+				insertCnt++
+				ids := make([]string, 0, len(rows))
+				for _, row := range rows {
+					ids = append(ids, row.(*defaultSchema).ID)
+				}
+				return entity.NewColumnVarChar("id", ids), nil
+			}).Build()
+			Mock(GetMethod(mockClient, "Flush")).Return(nil).Build()
+			
+			indexer, err := NewIndexer(ctx, &IndexerConfig{
+				Client:     mockClient,
+				Collection: defaultCollection,
+				Embedding:  &mockEmbedding{},
+				BatchSize:  1,
+			})
+			convey.So(err, convey.ShouldBeNil)
+			
+			ids, err := indexer.Store(ctx, docs)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(insertCnt, convey.ShouldEqual, 2)
+			convey.So(ids, convey.ShouldResemble, []string{"doc1", "doc2"})
+		})
+		
 		PatchConvey("test store with custom embedding", func() {
 			// 模拟InsertRows成功
 			mockIDs := entity.NewColumnVarChar("id", []string{"doc1", "doc2"})
@@ -409,3 +435,51 @@ func TestIndexer_Store(t *testing.T) {
 		})
 	})
 }
+
+func TestIndexer_StoreWithAutoID(t *testing.T) {
+	PatchConvey("test Indexer.Store with auto id and dynamic schema", t, func() {
+		ctx := context.Background()
+		Mock(client.NewClient).Return(&client.GrpcClient{}, nil).Build()
+		mockClient, _ := client.NewClient(ctx, client.Config{})
+		
+		fields := getDefaultFields()
+		fields[0] = getAutoIDField()
+		Mock(GetMethod(mockClient, "HasCollection")).Return(true, nil).Build()
+		Mock(GetMethod(mockClient, "DescribeCollection")).Return(&entity.Collection{
+			Schema: &entity.Schema{
+				Fields:             fields,
+				EnableDynamicField: true,
+			},
+			Loaded: true,
+		}, nil).Build()
+		
+		var inserted []interface{}
+		Mock(GetMethod(mockClient, "InsertRows")).To(func(ctx context.Context, collName string, partitionName string, rows []interface{}) (entity.Column, error) {
+			inserted = rows
+			return entity.NewColumnInt64("id", []int64{100, 101}), nil
+		}).Build()
+		Mock(GetMethod(mockClient, "Flush")).Return(nil).Build()
+		
+		indexer, err := NewIndexer(ctx, &IndexerConfig{
+			Client:              mockClient,
+			Embedding:           &mockEmbedding{},
+			AutoID:              true,
+			EnableDynamicSchema: true,
+		})
+		convey.So(err, convey.ShouldBeNil)
+		
+		ids, err := indexer.Store(ctx, []*schema.Document{
+			{ID: "doc1", Content: "content1", MetaData: map[string]interface{}{"author": "a", "content": "ignored"}},
+			{ID: "doc2", Content: "content2"},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids, convey.ShouldResemble, []string{"100", "101"})
+		
+		convey.So(len(inserted), convey.ShouldEqual, 2)
+		row := inserted[0].(map[string]interface{})
+		_, hasID := row[defaultCollectionID]
+		convey.So(hasID, convey.ShouldBeFalse)
+		convey.So(row[defaultCollectionContent], convey.ShouldEqual, "content1")
+		convey.So(row["author"], convey.ShouldEqual, "a")
+	})
+}
@@ -73,6 +73,16 @@ func getDefaultFields() []*entity.Field {
 	}
 }
 
+// getAutoIDField returns the primary key field generated by milvus, which only supports int64
+func getAutoIDField() *entity.Field {
+	return entity.NewField().
+		WithName(defaultCollectionID).
+		WithDescription(defaultCollectionIDDesc).
+		WithIsPrimaryKey(true).
+		WithIsAutoID(true).
+		WithDataType(entity.FieldTypeInt64)
+}
+
 type ConsistencyLevel entity.ConsistencyLevel
 
 func (c *ConsistencyLevel) getConsistencyLevel() entity.ConsistencyLevel {
@@ -20,10 +20,12 @@ import (
 	"context"
 	"encoding/binary"
 	"math"
+	"strconv"
 
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components"
 	"github.com/cloudwego/eino/components/embedding"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 )
 
 // vector2Bytes converts vector to bytes
@@ -53,3 +55,15 @@ func makeEmbeddingCtx(ctx context.Context, emb embedding.Embedder) context.Conte
 
 	return callbacks.ReuseHandlers(ctx, runInfo)
 }
+
+// getIDAsString gets the primary key as string, the auto id of milvus is int64
+func getIDAsString(col entity.Column, idx int) (string, error) {
+	if c, ok := col.(*entity.ColumnInt64); ok {
+		id, err := c.ValueByIdx(idx)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(id, 10), nil
+	}
+	return col.GetAsString(idx)
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+const typ = "Neo4j"
+
+const (
+	defaultConcurrency = 4
+)
+
+// DefaultExtractPrompt is the default prompt template to extract entities and relations from a document.
+const DefaultExtractPrompt = "Extract the entities and the relations between them from the following text, " +
+	"to build a knowledge graph. Entities are people, organizations, places, products, concepts and events. " +
+	"Reply with a json object only, in the format:\n" +
+	`{{"entities": [{{"name": "Eino", "type": "framework", "description": "a LLM application development framework"}}], ` +
+	`"relations": [{{"source": "Eino", "target": "Golang", "type": "written_in", "description": "Eino is written in Golang"}}]}}` + "\n" +
+	"Use the same entity names in relations as in entities, and short lower snake case relation types.\n\n" +
+	"Text:\n{content}"
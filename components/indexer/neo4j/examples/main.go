@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+
+	neo4jindexer "github.com/cloudwego/eino-ext/components/indexer/neo4j"
+)
+
+func main() {
+	ctx := context.Background()
+
+	driver, err := neo4j.NewDriverWithContext(os.Getenv("NEO4J_URI"),
+		neo4j.BasicAuth(os.Getenv("NEO4J_USERNAME"), os.Getenv("NEO4J_PASSWORD"), ""))
+	if err != nil {
+		log.Fatalf("connect neo4j failed, err=%v", err)
+	}
+	defer driver.Close(ctx)
+
+	indexer, err := neo4jindexer.NewIndexer(ctx, &neo4jindexer.IndexerConfig{
+		Executor:  neo4jindexer.NewDriverExecutor(driver, ""),
+		ChatModel: &mockChatModel{}, // any chat model, e.g. ark or openai
+	})
+	if err != nil {
+		log.Fatalf("NewIndexer failed, err=%v", err)
+	}
+
+	if err = indexer.CreateSchema(ctx); err != nil {
+		log.Fatalf("CreateSchema failed, err=%v", err)
+	}
+
+	ids, err := indexer.Store(ctx, []*schema.Document{
+		{ID: "1", Content: "Eino is a LLM application development framework written in Golang.", MetaData: map[string]any{"source": "eino.md"}},
+		{ID: "2", Content: "CloudWeGo develops Eino, and Kitex, a Golang RPC framework.", MetaData: map[string]any{"source": "cloudwego.md"}},
+	})
+	if err != nil {
+		log.Fatalf("Store failed, err=%v", err)
+	}
+
+	log.Printf("stored ids: %v", ids)
+}
+
+// mockChatModel replies a fixed graph, replace it with a real chat model.
+type mockChatModel struct{}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return schema.AssistantMessage(`{"entities": [
+	{"name": "Eino", "type": "framework", "description": "a LLM application development framework"},
+	{"name": "Golang", "type": "language"},
+	{"name": "CloudWeGo", "type": "organization"}
+], "relations": [
+	{"source": "Eino", "target": "Golang", "type": "written_in"},
+	{"source": "CloudWeGo", "target": "Eino", "type": "develops"}
+]}`, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := m.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
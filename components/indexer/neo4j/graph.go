@@ -0,0 +1,125 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Entity is a node of the knowledge graph, entities are identified by the normalized name, see EntityKey.
+type Entity struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// Relation is a directed edge between two entities, identified by the entity names and the type.
+type Relation struct {
+	Source      string `json:"source"`
+	Target      string `json:"target"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// Graph is the entities and relations extracted from a document.
+type Graph struct {
+	Entities  []*Entity   `json:"entities"`
+	Relations []*Relation `json:"relations"`
+}
+
+// EntityKey normalizes the name of an entity, so that "Eino" and " eino " are the same entity.
+func EntityKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// parseGraph parses the json object in the reply of the chat model, which may be wrapped in a code block.
+func parseGraph(content string) (*Graph, error) {
+	start, end := strings.Index(content, "{"), strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return nil, errors.New("json object not found")
+	}
+
+	g := &Graph{}
+	if err := json.Unmarshal([]byte(content[start:end+1]), g); err != nil {
+		return nil, fmt.Errorf("unmarshal graph failed: %w", err)
+	}
+	return g, nil
+}
+
+// params converts the graph into the parameters of the upsert query. Entities are deduplicated by key,
+// and the entities only referenced by relations are added.
+func (g *Graph) params() (entities, relations []map[string]any) {
+	seen := make(map[string]bool)
+	addEntity := func(e *Entity) string {
+		key := EntityKey(e.Name)
+		if key == "" || seen[key] {
+			return key
+		}
+		seen[key] = true
+		entities = append(entities, map[string]any{
+			"key":         key,
+			"name":        strings.TrimSpace(e.Name),
+			"type":        strings.TrimSpace(e.Type),
+			"description": strings.TrimSpace(e.Description),
+		})
+		return key
+	}
+
+	for _, e := range g.Entities {
+		if e != nil {
+			addEntity(e)
+		}
+	}
+
+	relationSeen := make(map[string]bool)
+	for _, r := range g.Relations {
+		if r == nil {
+			continue
+		}
+		source, target := addEntity(&Entity{Name: r.Source}), addEntity(&Entity{Name: r.Target})
+		if source == "" || target == "" || source == target {
+			continue
+		}
+
+		relType := strings.Join(strings.Fields(strings.ToLower(r.Type)), "_")
+		if relType == "" {
+			relType = "related_to"
+		}
+		id := source + "\x00" + relType + "\x00" + target
+		if relationSeen[id] {
+			continue
+		}
+		relationSeen[id] = true
+		relations = append(relations, map[string]any{
+			"source":      source,
+			"target":      target,
+			"type":        relType,
+			"description": strings.TrimSpace(r.Description),
+		})
+	}
+
+	if entities == nil {
+		entities = []map[string]any{}
+	}
+	if relations == nil {
+		relations = []map[string]any{}
+	}
+	return entities, relations
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// upsertCypher upserts a chunk with the entities it mentions and the relations between them.
+// The mentions of the chunk are replaced, entities and relations are shared by chunks, and keep their first
+// non-empty type and description.
+const upsertCypher = `MERGE (c:Chunk {id: $id})
+SET c.content = $content, c.metadata = $metadata
+WITH c
+OPTIONAL MATCH (c)-[m:MENTIONS]->(:Entity)
+DELETE m
+WITH DISTINCT c
+CALL {
+  WITH c
+  UNWIND $entities AS e
+  MERGE (n:Entity {key: e.key})
+  ON CREATE SET n.name = e.name, n.type = e.type, n.description = e.description
+  ON MATCH SET n.type = CASE WHEN n.type = '' THEN e.type ELSE n.type END,
+    n.description = CASE WHEN n.description = '' THEN e.description ELSE n.description END
+  MERGE (c)-[:MENTIONS]->(n)
+  RETURN count(n) AS entities
+}
+CALL {
+  WITH c
+  UNWIND $relations AS r
+  MATCH (s:Entity {key: r.source}), (t:Entity {key: r.target})
+  MERGE (s)-[rel:RELATED_TO {type: r.type}]->(t)
+  ON CREATE SET rel.description = r.description, rel.chunk_ids = [c.id]
+  ON MATCH SET rel.chunk_ids = CASE WHEN c.id IN rel.chunk_ids THEN rel.chunk_ids ELSE rel.chunk_ids + c.id END
+  RETURN count(rel) AS relations
+}
+RETURN entities, relations`
+
+// schemaCyphers create the constraints, and the fulltext index used by neo4j retriever.
+var schemaCyphers = []string{
+	"CREATE CONSTRAINT eino_chunk_id IF NOT EXISTS FOR (c:Chunk) REQUIRE c.id IS UNIQUE",
+	"CREATE CONSTRAINT eino_entity_key IF NOT EXISTS FOR (n:Entity) REQUIRE n.key IS UNIQUE",
+	"CREATE FULLTEXT INDEX eino_entity_fulltext IF NOT EXISTS FOR (n:Entity) ON EACH [n.name, n.description]",
+}
+
+type IndexerConfig struct {
+	// Executor executes cypher queries, use NewDriverExecutor for a neo4j driver.
+	// Required.
+	Executor Executor
+	// ChatModel extracts the entities and relations from documents.
+	// Required.
+	ChatModel model.BaseChatModel
+	// Prompt is the prompt template in FString format, with the variable {content} of the document,
+	// the reply should contain a json object of Graph. Use {{ and }} for the literal braces.
+	// Optional. Default: DefaultExtractPrompt.
+	Prompt string
+	// Concurrency is the number of documents extracted and written concurrently.
+	// Optional. Default: 4.
+	Concurrency int
+}
+
+// Indexer builds a knowledge graph in Neo4j. For each document, it extracts the entities and relations with a chat model,
+// and writes a (:Chunk) node with the content and metadata, the (:Entity) nodes it [:MENTIONS], and the [:RELATED_TO]
+// relations between the entities. Entities are shared by documents, so the graph connects the documents.
+type Indexer struct {
+	config *IndexerConfig
+}
+
+func NewIndexer(_ context.Context, config *IndexerConfig) (*Indexer, error) {
+	if config == nil || config.Executor == nil {
+		return nil, errors.New("[NewIndexer] executor not provided")
+	}
+
+	if config.ChatModel == nil {
+		return nil, errors.New("[NewIndexer] chat model not provided")
+	}
+
+	if config.Prompt == "" {
+		config.Prompt = DefaultExtractPrompt
+	}
+
+	if config.Concurrency <= 0 {
+		config.Concurrency = defaultConcurrency
+	}
+
+	return &Indexer{config: config}, nil
+}
+
+// CreateSchema creates the unique constraints of chunks and entities, and the fulltext index of entities
+// used by neo4j retriever. It's idempotent, and should be called before the first Store.
+func (i *Indexer) CreateSchema(ctx context.Context) error {
+	for _, cypher := range schemaCyphers {
+		if _, err := i.config.Executor.Execute(ctx, cypher, nil); err != nil {
+			return fmt.Errorf("[neo4j indexer] create schema failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) (ids []string, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, i.GetType(), components.ComponentOfIndexer)
+	ctx = callbacks.OnStart(ctx, &indexer.CallbackInput{Docs: docs})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	for _, doc := range docs {
+		if doc.ID == "" {
+			return nil, errors.New("[neo4j indexer] doc id not set")
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, i.config.Concurrency)
+	)
+loop:
+	for _, doc := range docs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		wg.Add(1)
+		go func(doc *schema.Document) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := i.store(ctx, doc); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("[neo4j indexer] store document[%s] failed: %w", doc.ID, err)
+					cancel()
+				}
+			}
+		}(doc)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ids = make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	callbacks.OnEnd(ctx, &indexer.CallbackOutput{IDs: ids})
+
+	return ids, nil
+}
+
+// store extracts the graph of the document, and upserts it.
+func (i *Indexer) store(ctx context.Context, doc *schema.Document) error {
+	g, err := i.extract(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := json.Marshal(doc.MetaData)
+	if err != nil {
+		return fmt.Errorf("marshal metadata failed: %w", err)
+	}
+
+	entities, relations := g.params()
+	_, err = i.config.Executor.Execute(ctx, upsertCypher, map[string]any{
+		"id":        doc.ID,
+		"content":   doc.Content,
+		"metadata":  string(metadata),
+		"entities":  entities,
+		"relations": relations,
+	})
+	if err != nil {
+		return fmt.Errorf("upsert failed: %w", err)
+	}
+	return nil
+}
+
+// extract extracts the entities and relations of the document with the chat model.
+func (i *Indexer) extract(ctx context.Context, doc *schema.Document) (*Graph, error) {
+	if strings.TrimSpace(doc.Content) == "" {
+		return &Graph{}, nil
+	}
+
+	msgs, err := schema.UserMessage(i.config.Prompt).Format(ctx, map[string]any{
+		"content": doc.Content,
+	}, schema.FString)
+	if err != nil {
+		return nil, fmt.Errorf("format prompt failed: %w", err)
+	}
+
+	out, err := i.config.ChatModel.Generate(ctx, msgs)
+	if err != nil {
+		return nil, fmt.Errorf("extract graph failed: %w", err)
+	}
+
+	g, err := parseGraph(out.Content)
+	if err != nil {
+		return nil, fmt.Errorf("parse graph failed: %w", err)
+	}
+	return g, nil
+}
+
+func (i *Indexer) GetType() string {
+	return typ
+}
+
+func (i *Indexer) IsCallbacksEnabled() bool {
+	return true
+}
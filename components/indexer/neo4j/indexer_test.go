@@ -0,0 +1,169 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+type mockExecutor struct {
+	mu     sync.Mutex
+	params map[string]map[string]any
+	cypher []string
+	err    error
+}
+
+func (m *mockExecutor) Execute(ctx context.Context, cypher string, params map[string]any) ([]map[string]any, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.cypher = append(m.cypher, cypher)
+	if id, ok := params["id"].(string); ok {
+		if m.params == nil {
+			m.params = make(map[string]map[string]any)
+		}
+		m.params[id] = params
+	}
+	return []map[string]any{{"entities": int64(2), "relations": int64(1)}}, nil
+}
+
+type mockChatModel struct {
+	generate func(prompt string) (string, error)
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	content, err := m.generate(input[len(input)-1].Content)
+	if err != nil {
+		return nil, err
+	}
+	return schema.AssistantMessage(content, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestParseGraph(t *testing.T) {
+	_, err := parseGraph("no graph")
+	assert.Error(t, err)
+	_, err = parseGraph("{invalid}")
+	assert.Error(t, err)
+
+	g, err := parseGraph("```json\n" + `{"entities": [{"name": "Eino", "type": "framework", "description": "LLM framework"}, {"name": " eino "}],
+		"relations": [{"source": "EINO", "target": "Golang", "type": "Written In"}, {"source": "eino", "target": "golang", "type": "written_in"},
+		{"source": "Eino", "target": "Eino"}, {"source": "Eino", "target": "CloudWeGo"}]}` + "\n```")
+	assert.NoError(t, err)
+
+	entities, relations := g.params()
+	assert.Equal(t, []map[string]any{
+		{"key": "eino", "name": "Eino", "type": "framework", "description": "LLM framework"},
+		{"key": "golang", "name": "Golang", "type": "", "description": ""},
+		{"key": "cloudwego", "name": "CloudWeGo", "type": "", "description": ""},
+	}, entities)
+	assert.Equal(t, []map[string]any{
+		{"source": "eino", "target": "golang", "type": "written_in", "description": ""},
+		{"source": "eino", "target": "cloudwego", "type": "related_to", "description": ""},
+	}, relations)
+
+	entities, relations = (&Graph{}).params()
+	assert.Empty(t, entities)
+	assert.NotNil(t, entities)
+	assert.Empty(t, relations)
+	assert.NotNil(t, relations)
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+	cm := &mockChatModel{generate: func(prompt string) (string, error) {
+		if strings.Contains(prompt, "fail") {
+			return "", errors.New("mock err")
+		}
+		return `{"entities": [{"name": "Eino", "type": "framework"}, {"name": "Golang", "type": "language"}],
+			"relations": [{"source": "Eino", "target": "Golang", "type": "written_in"}]}`, nil
+	}}
+
+	_, err := NewIndexer(ctx, &IndexerConfig{ChatModel: cm})
+	assert.Error(t, err)
+	_, err = NewIndexer(ctx, &IndexerConfig{Executor: &mockExecutor{}})
+	assert.Error(t, err)
+
+	t.Run("create schema", func(t *testing.T) {
+		exec := &mockExecutor{}
+		i, err := NewIndexer(ctx, &IndexerConfig{Executor: exec, ChatModel: cm})
+		assert.NoError(t, err)
+		assert.NoError(t, i.CreateSchema(ctx))
+		assert.Equal(t, schemaCyphers, exec.cypher)
+
+		i, err = NewIndexer(ctx, &IndexerConfig{Executor: &mockExecutor{err: errors.New("mock err")}, ChatModel: cm})
+		assert.NoError(t, err)
+		assert.Error(t, i.CreateSchema(ctx))
+	})
+
+	t.Run("store", func(t *testing.T) {
+		exec := &mockExecutor{}
+		i, err := NewIndexer(ctx, &IndexerConfig{Executor: exec, ChatModel: cm, Concurrency: 2})
+		assert.NoError(t, err)
+
+		ids, err := i.Store(ctx, []*schema.Document{
+			{ID: "1", Content: "Eino is written in Golang.", MetaData: map[string]any{"source": "a.md"}},
+			{ID: "2", Content: " "},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, ids)
+
+		assert.Equal(t, upsertCypher, exec.cypher[0])
+		assert.Equal(t, map[string]any{
+			"id":       "1",
+			"content":  "Eino is written in Golang.",
+			"metadata": `{"source":"a.md"}`,
+			"entities": []map[string]any{
+				{"key": "eino", "name": "Eino", "type": "framework", "description": ""},
+				{"key": "golang", "name": "Golang", "type": "language", "description": ""},
+			},
+			"relations": []map[string]any{
+				{"source": "eino", "target": "golang", "type": "written_in", "description": ""},
+			},
+		}, exec.params["1"])
+		// empty documents are stored without extraction
+		assert.Empty(t, exec.params["2"]["entities"])
+	})
+
+	t.Run("store failed", func(t *testing.T) {
+		i, err := NewIndexer(ctx, &IndexerConfig{Executor: &mockExecutor{}, ChatModel: cm})
+		assert.NoError(t, err)
+		_, err = i.Store(ctx, []*schema.Document{{Content: "eino"}})
+		assert.Error(t, err)
+		_, err = i.Store(ctx, []*schema.Document{{ID: "1", Content: "fail"}})
+		assert.Error(t, err)
+
+		i, err = NewIndexer(ctx, &IndexerConfig{Executor: &mockExecutor{err: errors.New("mock err")}, ChatModel: cm})
+		assert.NoError(t, err)
+		_, err = i.Store(ctx, []*schema.Document{{ID: "1", Content: "eino"}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parent
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// InMemoryDocStore is a DocStore keeping parent documents in memory,
+// it also implements DocGetter of parent retriever.
+type InMemoryDocStore struct {
+	mu   sync.RWMutex
+	docs map[string]*schema.Document
+}
+
+func NewInMemoryDocStore() *InMemoryDocStore {
+	return &InMemoryDocStore{docs: make(map[string]*schema.Document)}
+}
+
+func (s *InMemoryDocStore) MSet(_ context.Context, docs []*schema.Document) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, doc := range docs {
+		s.docs[doc.ID] = doc
+	}
+	return nil
+}
+
+// MGet returns documents of ids, missing documents are skipped.
+func (s *InMemoryDocStore) MGet(_ context.Context, ids []string) ([]*schema.Document, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	docs := make([]*schema.Document, 0, len(ids))
+	for _, id := range ids {
+		if doc, ok := s.docs[id]; ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/indexer/parent"
+)
+
+func main() {
+	ctx := context.Background()
+
+	store := parent.NewInMemoryDocStore()
+	i, err := parent.NewIndexer(ctx, &parent.Config{
+		Indexer:     &printIndexer{}, // any indexer, e.g. volc_vikingdb indexer
+		Transformer: &lineSplitter{}, // any transformer, e.g. recursive splitter
+		DocStore:    store,
+	})
+	if err != nil {
+		log.Fatalf("NewIndexer failed, err=%v", err)
+	}
+
+	ids, err := i.Store(ctx, []*schema.Document{
+		{ID: "eino", Content: "Eino is a LLM application development framework in Golang.\nEino provides components, orchestration and flows."},
+	})
+	if err != nil {
+		log.Fatalf("Store failed, err=%v", err)
+	}
+	log.Printf("parent ids: %v", ids)
+
+	docs, err := store.MGet(ctx, ids)
+	if err != nil {
+		log.Fatalf("MGet failed, err=%v", err)
+	}
+	for _, doc := range docs {
+		log.Printf("parent id=%s, content=%s", doc.ID, doc.Content)
+	}
+}
+
+type printIndexer struct{}
+
+func (p *printIndexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) ([]string, error) {
+	ids := make([]string, len(docs))
+	for i, doc := range docs {
+		log.Printf("index sub document id=%s, parent_id=%v, content=%s", doc.ID, doc.MetaData["parent_id"], doc.Content)
+		ids[i] = doc.ID
+	}
+	return ids, nil
+}
+
+type lineSplitter struct{}
+
+func (l *lineSplitter) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	var result []*schema.Document
+	for _, doc := range src {
+		for _, line := range strings.Split(doc.Content, "\n") {
+			result = append(result, &schema.Document{Content: line})
+		}
+	}
+	return result, nil
+}
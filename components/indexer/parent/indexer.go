@@ -0,0 +1,163 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultParentIDKey = "parent_id"
+
+// DocStore stores parent documents, which are fetched by parent retriever with ids.
+type DocStore interface {
+	MSet(ctx context.Context, docs []*schema.Document) error
+}
+
+type Config struct {
+	// Indexer indexes sub documents for search.
+	// Required.
+	Indexer indexer.Indexer
+	// Transformer splits parent documents into sub documents, e.g. recursive splitter.
+	// Required.
+	Transformer document.Transformer
+	// DocStore stores parent documents, see NewInMemoryDocStore.
+	// Required.
+	DocStore DocStore
+	// ParentIDKey is the metadata key of parent document id in sub documents,
+	// should be the same as ParentIDKey of parent retriever.
+	// Optional. Default: "parent_id"
+	ParentIDKey string
+	// SubIDGenerator generates ids of sub documents.
+	// Optional. Default: {parentID}_{index}
+	SubIDGenerator func(ctx context.Context, parentID string, num int) ([]string, error)
+}
+
+// Indexer stores parent documents into DocStore, then splits them into small sub documents,
+// which are indexed by Indexer with parent id in metadata.
+type Indexer struct {
+	config *Config
+}
+
+func NewIndexer(_ context.Context, config *Config) (*Indexer, error) {
+	if config == nil || config.Indexer == nil {
+		return nil, errors.New("[NewIndexer] indexer not provided")
+	}
+	if config.Transformer == nil {
+		return nil, errors.New("[NewIndexer] transformer not provided")
+	}
+	if config.DocStore == nil {
+		return nil, errors.New("[NewIndexer] doc store not provided")
+	}
+
+	nConf := *config
+	if nConf.ParentIDKey == "" {
+		nConf.ParentIDKey = defaultParentIDKey
+	}
+	if nConf.SubIDGenerator == nil {
+		nConf.SubIDGenerator = defaultSubIDGenerator
+	}
+
+	return &Indexer{config: &nConf}, nil
+}
+
+// Store returns ids of parent documents.
+func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) (ids []string, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, i.GetType(), components.ComponentOfIndexer)
+	ctx = callbacks.OnStart(ctx, &indexer.CallbackInput{Docs: docs})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	for _, doc := range docs {
+		if doc.ID == "" {
+			return nil, errors.New("[parent indexer] parent document id not provided")
+		}
+	}
+
+	if err = i.config.DocStore.MSet(ctx, docs); err != nil {
+		return nil, fmt.Errorf("[parent indexer] store parent documents failed: %w", err)
+	}
+
+	var subDocs []*schema.Document
+	for _, doc := range docs {
+		subs, err := i.config.Transformer.Transform(ctx, []*schema.Document{doc})
+		if err != nil {
+			return nil, fmt.Errorf("[parent indexer] transform document failed, id=%s: %w", doc.ID, err)
+		}
+
+		subIDs, err := i.config.SubIDGenerator(ctx, doc.ID, len(subs))
+		if err != nil {
+			return nil, fmt.Errorf("[parent indexer] generate sub ids failed, id=%s: %w", doc.ID, err)
+		}
+		if len(subIDs) != len(subs) {
+			return nil, fmt.Errorf("[parent indexer] invalid sub ids length, expected=%d, got=%d", len(subs), len(subIDs))
+		}
+
+		for j, sub := range subs {
+			metaData := make(map[string]any, len(sub.MetaData)+1)
+			for k, v := range sub.MetaData {
+				metaData[k] = v
+			}
+			metaData[i.config.ParentIDKey] = doc.ID
+
+			subDocs = append(subDocs, &schema.Document{
+				ID:       subIDs[j],
+				Content:  sub.Content,
+				MetaData: metaData,
+			})
+		}
+	}
+
+	if _, err = i.config.Indexer.Store(ctx, subDocs, opts...); err != nil {
+		return nil, fmt.Errorf("[parent indexer] store sub documents failed: %w", err)
+	}
+
+	ids = make([]string, len(docs))
+	for j, doc := range docs {
+		ids[j] = doc.ID
+	}
+
+	callbacks.OnEnd(ctx, &indexer.CallbackOutput{IDs: ids})
+
+	return ids, nil
+}
+
+func defaultSubIDGenerator(_ context.Context, parentID string, num int) ([]string, error) {
+	ids := make([]string, num)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%s_%d", parentID, i)
+	}
+	return ids, nil
+}
+
+func (i *Indexer) GetType() string {
+	return "Parent"
+}
+
+func (i *Indexer) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockIndexer struct {
+	docs []*schema.Document
+	err  error
+}
+
+func (m *mockIndexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) ([]string, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.docs = append(m.docs, docs...)
+	ids := make([]string, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc.ID
+	}
+	return ids, nil
+}
+
+type sentenceSplitter struct{}
+
+func (s *sentenceSplitter) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	var result []*schema.Document
+	for _, doc := range src {
+		for _, part := range strings.Split(doc.Content, ".") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, &schema.Document{Content: part, MetaData: doc.MetaData})
+			}
+		}
+	}
+	return result, nil
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewIndexer(ctx, &Config{Transformer: &sentenceSplitter{}, DocStore: NewInMemoryDocStore()})
+	assert.Error(t, err)
+	_, err = NewIndexer(ctx, &Config{Indexer: &mockIndexer{}, DocStore: NewInMemoryDocStore()})
+	assert.Error(t, err)
+	_, err = NewIndexer(ctx, &Config{Indexer: &mockIndexer{}, Transformer: &sentenceSplitter{}})
+	assert.Error(t, err)
+
+	t.Run("store", func(t *testing.T) {
+		mi := &mockIndexer{}
+		store := NewInMemoryDocStore()
+		i, err := NewIndexer(ctx, &Config{
+			Indexer:     mi,
+			Transformer: &sentenceSplitter{},
+			DocStore:    store,
+		})
+		assert.NoError(t, err)
+
+		ids, err := i.Store(ctx, []*schema.Document{
+			{ID: "a", Content: "first. second.", MetaData: map[string]any{"source": "x"}},
+			{ID: "b", Content: "third."},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, ids)
+
+		assert.Len(t, mi.docs, 3)
+		assert.Equal(t, "a_0", mi.docs[0].ID)
+		assert.Equal(t, "first", mi.docs[0].Content)
+		assert.Equal(t, "a", mi.docs[0].MetaData[defaultParentIDKey])
+		assert.Equal(t, "x", mi.docs[0].MetaData["source"])
+		assert.Equal(t, "a_1", mi.docs[1].ID)
+		assert.Equal(t, "b_0", mi.docs[2].ID)
+		assert.Equal(t, "b", mi.docs[2].MetaData[defaultParentIDKey])
+
+		parents, err := store.MGet(ctx, []string{"b", "missing", "a"})
+		assert.NoError(t, err)
+		assert.Len(t, parents, 2)
+		assert.Equal(t, "third.", parents[0].Content)
+		assert.Equal(t, "first. second.", parents[1].Content)
+	})
+
+	t.Run("custom parent id key and sub id generator", func(t *testing.T) {
+		mi := &mockIndexer{}
+		i, err := NewIndexer(ctx, &Config{
+			Indexer:     mi,
+			Transformer: &sentenceSplitter{},
+			DocStore:    NewInMemoryDocStore(),
+			ParentIDKey: "pid",
+			SubIDGenerator: func(ctx context.Context, parentID string, num int) ([]string, error) {
+				ids := make([]string, num)
+				for j := range ids {
+					ids[j] = fmt.Sprintf("%s-chunk-%d", parentID, j)
+				}
+				return ids, nil
+			},
+		})
+		assert.NoError(t, err)
+
+		_, err = i.Store(ctx, []*schema.Document{{ID: "a", Content: "first. second."}})
+		assert.NoError(t, err)
+		assert.Equal(t, "a-chunk-1", mi.docs[1].ID)
+		assert.Equal(t, "a", mi.docs[1].MetaData["pid"])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		i, err := NewIndexer(ctx, &Config{
+			Indexer:     &mockIndexer{},
+			Transformer: &sentenceSplitter{},
+			DocStore:    NewInMemoryDocStore(),
+		})
+		assert.NoError(t, err)
+		_, err = i.Store(ctx, []*schema.Document{{Content: "no id"}})
+		assert.Error(t, err)
+
+		i, err = NewIndexer(ctx, &Config{
+			Indexer:     &mockIndexer{},
+			Transformer: &sentenceSplitter{},
+			DocStore:    NewInMemoryDocStore(),
+			SubIDGenerator: func(ctx context.Context, parentID string, num int) ([]string, error) {
+				return []string{"only_one"}, nil
+			},
+		})
+		assert.NoError(t, err)
+		_, err = i.Store(ctx, []*schema.Document{{ID: "a", Content: "first. second."}})
+		assert.Error(t, err)
+
+		i, err = NewIndexer(ctx, &Config{
+			Indexer:     &mockIndexer{err: fmt.Errorf("mock err")},
+			Transformer: &sentenceSplitter{},
+			DocStore:    NewInMemoryDocStore(),
+		})
+		assert.NoError(t, err)
+		_, err = i.Store(ctx, []*schema.Document{{ID: "a", Content: "first."}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/schema"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cloudwego/eino-ext/components/indexer/pgvector"
+)
+
+func main() {
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, os.Getenv("POSTGRES_DSN"))
+	if err != nil {
+		log.Fatalf("connect postgres failed, err=%v", err)
+	}
+	defer pool.Close()
+
+	if _, err = pool.Exec(ctx, `CREATE EXTENSION IF NOT EXISTS vector;
+CREATE TABLE IF NOT EXISTS eino_documents (id text PRIMARY KEY, content text, metadata jsonb, embedding vector(4));`); err != nil {
+		log.Fatalf("create table failed, err=%v", err)
+	}
+
+	indexer, err := pgvector.NewIndexer(ctx, &pgvector.IndexerConfig{
+		Conn:      pool,
+		Embedding: &mockEmbedding{},
+	})
+	if err != nil {
+		log.Fatalf("NewIndexer failed, err=%v", err)
+	}
+
+	ids, err := indexer.Store(ctx, []*schema.Document{
+		{ID: "1", Content: "eino is a llm application framework", MetaData: map[string]any{"lang": "go"}},
+		{ID: "2", Content: "pgvector is a postgres extension", MetaData: map[string]any{"lang": "c"}},
+	})
+	if err != nil {
+		log.Fatalf("Store failed, err=%v", err)
+	}
+
+	log.Printf("Store success, ids=%v", ids)
+}
+
+type mockEmbedding struct{}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	resp := make([][]float64, len(texts))
+	for i := range texts {
+		resp[i] = []float64{float64(i), 0.1, 0.2, 0.3}
+	}
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgvector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Executor executes sql statements, *pgxpool.Pool, *pgx.Conn and pgx.Tx are all satisfied.
+type Executor interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+type IndexerConfig struct {
+	// Conn executes upsert statements, pgxpool.Pool is recommended.
+	Conn Executor
+	// Table is the table to upsert into, "schema.table" is supported.
+	// The table should be created in advance with pgvector extension enabled:
+	//   CREATE EXTENSION IF NOT EXISTS vector;
+	//   CREATE TABLE eino_documents (id text PRIMARY KEY, content text, metadata jsonb, embedding vector(1536));
+	// Default "eino_documents".
+	Table string
+	// BatchSize controls the number of documents embedded and upserted in one statement.
+	// Default 10.
+	BatchSize int
+	// Embedding vectorization method for document content.
+	Embedding embedding.Embedder
+}
+
+type Indexer struct {
+	config *IndexerConfig
+}
+
+func NewIndexer(ctx context.Context, config *IndexerConfig) (*Indexer, error) {
+	if config.Embedding == nil {
+		return nil, fmt.Errorf("[NewIndexer] embedding not provided for pgvector indexer")
+	}
+
+	if config.Conn == nil {
+		return nil, fmt.Errorf("[NewIndexer] pgvector conn not provided")
+	}
+
+	if config.Table == "" {
+		config.Table = defaultTable
+	}
+
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+
+	return &Indexer{
+		config: config,
+	}, nil
+}
+
+func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) (ids []string, err error) {
+	options := indexer.GetCommonOptions(&indexer.Options{
+		Embedding: i.config.Embedding,
+	}, opts...)
+
+	ctx = callbacks.EnsureRunInfo(ctx, i.GetType(), components.ComponentOfIndexer)
+	ctx = callbacks.OnStart(ctx, &indexer.CallbackInput{Docs: docs})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if options.Embedding == nil {
+		return nil, fmt.Errorf("[pgvector indexer] embedding not provided")
+	}
+
+	for start := 0; start < len(docs); start += i.config.BatchSize {
+		end := start + i.config.BatchSize
+		if end > len(docs) {
+			end = len(docs)
+		}
+
+		if err = i.upsertBatch(ctx, docs[start:end], options.Embedding); err != nil {
+			return nil, err
+		}
+	}
+
+	ids = make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	callbacks.OnEnd(ctx, &indexer.CallbackOutput{IDs: ids})
+
+	return ids, nil
+}
+
+func (i *Indexer) upsertBatch(ctx context.Context, docs []*schema.Document, emb embedding.Embedder) error {
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		if doc.ID == "" {
+			return fmt.Errorf("[upsertBatch] doc id not set")
+		}
+		texts = append(texts, doc.Content)
+	}
+
+	vectors, err := emb.EmbedStrings(i.makeEmbeddingCtx(ctx, emb), texts)
+	if err != nil {
+		return fmt.Errorf("[upsertBatch] embedding failed, %w", err)
+	}
+
+	if len(vectors) != len(texts) {
+		return fmt.Errorf("[upsertBatch] invalid vector length, expected=%d, got=%d", len(texts), len(vectors))
+	}
+
+	values := make([]string, 0, len(docs))
+	args := make([]any, 0, len(docs)*4)
+	for idx, doc := range docs {
+		metadata, err := json.Marshal(doc.MetaData)
+		if err != nil {
+			return fmt.Errorf("[upsertBatch] marshal metadata failed, doc id=%s, %w", doc.ID, err)
+		}
+
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d::jsonb, $%d::vector)", n+1, n+2, n+3, n+4))
+		args = append(args, doc.ID, doc.Content, string(metadata), vectorLiteral(vectors[idx]))
+	}
+
+	sql := fmt.Sprintf("INSERT INTO %s (id, content, metadata, embedding) VALUES %s "+
+		"ON CONFLICT (id) DO UPDATE SET content = EXCLUDED.content, metadata = EXCLUDED.metadata, embedding = EXCLUDED.embedding",
+		sanitizeTable(i.config.Table), strings.Join(values, ", "))
+
+	if _, err = i.config.Conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("[upsertBatch] upsert failed, %w", err)
+	}
+
+	return nil
+}
+
+func (i *Indexer) makeEmbeddingCtx(ctx context.Context, emb embedding.Embedder) context.Context {
+	runInfo := &callbacks.RunInfo{
+		Component: components.ComponentOfEmbedding,
+	}
+
+	if embType, ok := components.GetType(emb); ok {
+		runInfo.Type = embType
+	}
+
+	runInfo.Name = runInfo.Type + string(runInfo.Component)
+
+	return callbacks.ReuseHandlers(ctx, runInfo)
+}
+
+func (i *Indexer) GetType() string {
+	return typ
+}
+
+func (i *Indexer) IsCallbacksEnabled() bool {
+	return true
+}
+
+// vectorLiteral formats vector as pgvector text representation, e.g. [1,2,3].
+// pgvector stores float32, so the values are formatted in float32 precision.
+func vectorLiteral(vector []float64) string {
+	sb := strings.Builder{}
+	sb.WriteByte('[')
+	for idx, v := range vector {
+		if idx > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(v, 'f', -1, 32))
+	}
+	sb.WriteByte(']')
+
+	return sb.String()
+}
+
+func sanitizeTable(table string) string {
+	return pgx.Identifier(strings.Split(table, ".")).Sanitize()
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgvector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/schema"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewIndexer(t *testing.T) {
+	convey.Convey("test NewIndexer", t, func() {
+		ctx := context.Background()
+
+		convey.Convey("test embedding not provided", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{Conn: &mockExecutor{}})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewIndexer] embedding not provided for pgvector indexer"))
+			convey.So(i, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test conn not provided", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{Embedding: &mockEmbedding{}})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewIndexer] pgvector conn not provided"))
+			convey.So(i, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test default config", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{Conn: &mockExecutor{}, Embedding: &mockEmbedding{}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(i.config.Table, convey.ShouldEqual, defaultTable)
+			convey.So(i.config.BatchSize, convey.ShouldEqual, defaultBatchSize)
+		})
+	})
+}
+
+func TestStore(t *testing.T) {
+	convey.Convey("test Store", t, func() {
+		ctx := context.Background()
+		docs := []*schema.Document{
+			{ID: "1", Content: "eino", MetaData: map[string]any{"lang": "go"}},
+			{ID: "2", Content: "pgvector"},
+			{ID: "3", Content: "postgres"},
+		}
+
+		convey.Convey("test doc id not set", func() {
+			i, _ := NewIndexer(ctx, &IndexerConfig{Conn: &mockExecutor{}, Embedding: &mockEmbedding{}})
+			ids, err := i.Store(ctx, []*schema.Document{{Content: "eino"}})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[upsertBatch] doc id not set"))
+			convey.So(ids, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test exec failed", func() {
+			i, _ := NewIndexer(ctx, &IndexerConfig{Conn: &mockExecutor{err: fmt.Errorf("mock err")}, Embedding: &mockEmbedding{}})
+			ids, err := i.Store(ctx, docs)
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[upsertBatch] upsert failed, mock err"))
+			convey.So(ids, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test success", func() {
+			exec := &mockExecutor{}
+			i, _ := NewIndexer(ctx, &IndexerConfig{
+				Conn:      exec,
+				Table:     "public.docs",
+				BatchSize: 2,
+				Embedding: &mockEmbedding{},
+			})
+			ids, err := i.Store(ctx, docs)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids, convey.ShouldResemble, []string{"1", "2", "3"})
+			convey.So(len(exec.sqls), convey.ShouldEqual, 2)
+			convey.So(strings.HasPrefix(exec.sqls[0], `INSERT INTO "public"."docs" (id, content, metadata, embedding) VALUES ($1, $2, $3::jsonb, $4::vector), ($5, $6, $7::jsonb, $8::vector) ON CONFLICT (id)`), convey.ShouldBeTrue)
+			convey.So(exec.args[0], convey.ShouldResemble, []any{"1", "eino", `{"lang":"go"}`, "[0.1,0.2]", "2", "pgvector", "null", "[0.1,0.2]"})
+			convey.So(len(exec.args[1]), convey.ShouldEqual, 4)
+		})
+	})
+}
+
+type mockExecutor struct {
+	err  error
+	sqls []string
+	args [][]any
+}
+
+func (m *mockExecutor) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	if m.err != nil {
+		return pgconn.CommandTag{}, m.err
+	}
+	m.sqls = append(m.sqls, sql)
+	m.args = append(m.args, arguments)
+	return pgconn.NewCommandTag("INSERT 0 1"), nil
+}
+
+type mockEmbedding struct{}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	resp := make([][]float64, len(texts))
+	for i := range resp {
+		resp[i] = []float64{0.1, 0.2}
+	}
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/schema"
+	"github.com/qdrant/go-client/qdrant"
+
+	qdrantindexer "github.com/cloudwego/eino-ext/components/indexer/qdrant"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Use qdrant.Config{Host, Port, APIKey, UseTLS: true} for qdrant cloud.
+	cli, err := qdrant.NewClient(&qdrant.Config{
+		Host:   "localhost",
+		Port:   6334,
+		APIKey: os.Getenv("QDRANT_API_KEY"),
+	})
+	if err != nil {
+		log.Fatalf("NewClient failed, err=%v", err)
+	}
+	defer cli.Close()
+
+	if err = cli.CreateCollection(ctx, &qdrant.CreateCollection{
+		CollectionName: "eino",
+		VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
+			Size:     4,
+			Distance: qdrant.Distance_Cosine,
+		}),
+	}); err != nil {
+		log.Printf("CreateCollection failed, err=%v", err)
+	}
+
+	indexer, err := qdrantindexer.NewIndexer(ctx, &qdrantindexer.IndexerConfig{
+		Client:     cli,
+		Collection: "eino",
+		BatchSize:  2,
+		Embedding:  &mockEmbedding{},
+	})
+	if err != nil {
+		log.Fatalf("NewIndexer failed, err=%v", err)
+	}
+
+	ids, err := indexer.Store(ctx, []*schema.Document{
+		{ID: "1", Content: "eino is a llm application framework", MetaData: map[string]any{"lang": "go"}},
+		{ID: "2", Content: "qdrant is a vector database", MetaData: map[string]any{"lang": "rust"}},
+		{ID: "3", Content: "milvus is a vector database", MetaData: map[string]any{"lang": "go"}},
+	})
+	if err != nil {
+		log.Fatalf("Store failed, err=%v", err)
+	}
+
+	log.Printf("Store success, ids=%v", ids)
+}
+
+type mockEmbedding struct{}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	resp := make([][]float64, len(texts))
+	for i := range texts {
+		resp[i] = []float64{float64(i), 0.1, 0.2, 0.3}
+	}
+	return resp, nil
+}
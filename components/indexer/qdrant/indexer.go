@@ -0,0 +1,274 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+type IndexerConfig struct {
+	// Client is the qdrant gRPC client, it's safe for concurrent use.
+	// Either Client or ClientConfig should be provided.
+	Client *qdrant.Client
+	// ClientConfig is used to create a client when Client is not provided.
+	// For qdrant cloud, set APIKey and UseTLS; for local instances, Host and Port are enough.
+	ClientConfig *qdrant.Config
+	// Collection is the collection to upsert points into, the collection should be created in advance.
+	Collection string
+	// VectorName is the name of the vector to store embedding, for collections configured with named vectors.
+	// Default "", which means the unnamed default vector.
+	VectorName string
+	// DocumentToPayload converts document to point payload.
+	// Default defaultDocumentToPayload, which puts content and all metadata at top level of payload,
+	// so that metadata could be used in payload filters directly.
+	DocumentToPayload func(ctx context.Context, doc *schema.Document) (map[string]any, error)
+	// BatchSize controls the number of documents embedded and upserted in one request.
+	// Default 10.
+	BatchSize int
+	// Parallelism controls the number of batches upserted concurrently.
+	// Default 1.
+	Parallelism int
+	// Embedding vectorization method for document content.
+	Embedding embedding.Embedder
+}
+
+type Indexer struct {
+	config *IndexerConfig
+}
+
+func NewIndexer(ctx context.Context, config *IndexerConfig) (*Indexer, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[NewIndexer] config not provided")
+	}
+
+	if config.Embedding == nil {
+		return nil, fmt.Errorf("[NewIndexer] embedding not provided for qdrant indexer")
+	}
+
+	if config.Collection == "" {
+		return nil, fmt.Errorf("[NewIndexer] qdrant collection not provided")
+	}
+
+	if config.Client == nil {
+		if config.ClientConfig == nil {
+			return nil, fmt.Errorf("[NewIndexer] qdrant client not provided")
+		}
+
+		cli, err := qdrant.NewClient(config.ClientConfig)
+		if err != nil {
+			return nil, fmt.Errorf("[NewIndexer] create qdrant client failed, %w", err)
+		}
+		config.Client = cli
+	}
+
+	if config.DocumentToPayload == nil {
+		config.DocumentToPayload = defaultDocumentToPayload
+	}
+
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+
+	if config.Parallelism <= 0 {
+		config.Parallelism = defaultParallelism
+	}
+
+	return &Indexer{
+		config: config,
+	}, nil
+}
+
+func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) (ids []string, err error) {
+	options := indexer.GetCommonOptions(&indexer.Options{
+		Embedding: i.config.Embedding,
+	}, opts...)
+
+	ctx = callbacks.EnsureRunInfo(ctx, i.GetType(), components.ComponentOfIndexer)
+	ctx = callbacks.OnStart(ctx, &indexer.CallbackInput{Docs: docs})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if options.Embedding == nil {
+		return nil, fmt.Errorf("[qdrant indexer] embedding not provided")
+	}
+
+	pointIDs := make([]*qdrant.PointId, len(docs))
+	ids = make([]string, len(docs))
+	for idx, doc := range docs {
+		pointIDs[idx] = docIDToPointID(doc.ID)
+		ids[idx] = doc.ID
+		if ids[idx] == "" {
+			ids[idx] = pointIDString(pointIDs[idx])
+		}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, i.config.Parallelism)
+	)
+
+	setErr := func(e error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = e
+		}
+		mu.Unlock()
+	}
+
+batches:
+	for start := 0; start < len(docs); start += i.config.BatchSize {
+		end := min(start+i.config.BatchSize, len(docs))
+
+		// stop scheduling new batches once the caller gives up
+		if ctx.Err() != nil {
+			setErr(fmt.Errorf("[qdrant indexer] context done before batch, %w", ctx.Err()))
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			setErr(fmt.Errorf("[qdrant indexer] context done before batch, %w", ctx.Err()))
+			break batches
+		}
+
+		wg.Add(1)
+		go func(batch []*schema.Document, batchIDs []*qdrant.PointId) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if e := i.upsertBatch(ctx, batch, batchIDs, options.Embedding); e != nil {
+				setErr(e)
+			}
+		}(docs[start:end], pointIDs[start:end])
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	callbacks.OnEnd(ctx, &indexer.CallbackOutput{IDs: ids})
+
+	return ids, nil
+}
+
+func (i *Indexer) upsertBatch(ctx context.Context, docs []*schema.Document, pointIDs []*qdrant.PointId,
+	emb embedding.Embedder) error {
+	texts := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		texts = append(texts, doc.Content)
+	}
+
+	vectors, err := emb.EmbedStrings(i.makeEmbeddingCtx(ctx, emb), texts)
+	if err != nil {
+		return fmt.Errorf("[upsertBatch] embedding failed, %w", err)
+	}
+
+	if len(vectors) != len(texts) {
+		return fmt.Errorf("[upsertBatch] invalid vector length, expected=%d, got=%d", len(texts), len(vectors))
+	}
+
+	points := make([]*qdrant.PointStruct, 0, len(docs))
+	for idx, doc := range docs {
+		payload, err := i.config.DocumentToPayload(ctx, doc)
+		if err != nil {
+			return err
+		}
+
+		value, err := qdrant.TryValueMap(payload)
+		if err != nil {
+			return fmt.Errorf("[upsertBatch] convert payload failed, doc id=%s, %w", doc.ID, err)
+		}
+
+		points = append(points, &qdrant.PointStruct{
+			Id:      pointIDs[idx],
+			Vectors: i.makeVectors(vector2Float32(vectors[idx])),
+			Payload: value,
+		})
+	}
+
+	if _, err = i.config.Client.Upsert(ctx, &qdrant.UpsertPoints{
+		CollectionName: i.config.Collection,
+		Wait:           qdrant.PtrOf(true),
+		Points:         points,
+	}); err != nil {
+		return fmt.Errorf("[upsertBatch] upsert points failed, %w", err)
+	}
+
+	return nil
+}
+
+func (i *Indexer) makeVectors(vector []float32) *qdrant.Vectors {
+	if i.config.VectorName == "" {
+		return qdrant.NewVectors(vector...)
+	}
+
+	return qdrant.NewVectorsMap(map[string]*qdrant.Vector{
+		i.config.VectorName: qdrant.NewVector(vector...),
+	})
+}
+
+func (i *Indexer) makeEmbeddingCtx(ctx context.Context, emb embedding.Embedder) context.Context {
+	runInfo := &callbacks.RunInfo{
+		Component: components.ComponentOfEmbedding,
+	}
+
+	if embType, ok := components.GetType(emb); ok {
+		runInfo.Type = embType
+	}
+
+	runInfo.Name = runInfo.Type + string(runInfo.Component)
+
+	return callbacks.ReuseHandlers(ctx, runInfo)
+}
+
+func (i *Indexer) GetType() string {
+	return typ
+}
+
+func (i *Indexer) IsCallbacksEnabled() bool {
+	return true
+}
+
+func defaultDocumentToPayload(ctx context.Context, doc *schema.Document) (map[string]any, error) {
+	payload := make(map[string]any, len(doc.MetaData)+2)
+	for k, v := range doc.MetaData {
+		payload[k] = v
+	}
+	payload[defaultFieldContent] = doc.Content
+	if doc.ID != "" {
+		payload[defaultFieldDocID] = doc.ID
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/schema"
+	"github.com/qdrant/go-client/qdrant"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewIndexer(t *testing.T) {
+	PatchConvey("test NewIndexer", t, func() {
+		ctx := context.Background()
+
+		PatchConvey("test config not provided", func() {
+			i, err := NewIndexer(ctx, nil)
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewIndexer] config not provided"))
+			convey.So(i, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test embedding not provided", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:     &qdrant.Client{},
+				Collection: "eino",
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewIndexer] embedding not provided for qdrant indexer"))
+			convey.So(i, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test collection not provided", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:    &qdrant.Client{},
+				Embedding: &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewIndexer] qdrant collection not provided"))
+			convey.So(i, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test client not provided", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Collection: "eino",
+				Embedding:  &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewIndexer] qdrant client not provided"))
+			convey.So(i, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test success", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:     &qdrant.Client{},
+				Collection: "eino",
+				Embedding:  &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(i.config.BatchSize, convey.ShouldEqual, defaultBatchSize)
+			convey.So(i.config.Parallelism, convey.ShouldEqual, defaultParallelism)
+		})
+	})
+}
+
+func TestStore(t *testing.T) {
+	PatchConvey("test Store", t, func() {
+		ctx := context.Background()
+		cli := &qdrant.Client{}
+		docs := []*schema.Document{
+			{ID: "1", Content: "1", MetaData: map[string]any{"tag": "a"}},
+			{ID: "ae5fb4b4-1c5c-4b1e-8a48-8a3b9fd0f3a7", Content: "2"},
+			{ID: "doc_3", Content: "3"},
+		}
+
+		PatchConvey("test embedding failed", func() {
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:     cli,
+				Collection: "eino",
+				Embedding:  &mockEmbedding{err: fmt.Errorf("mock err")},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			ids, err := i.Store(ctx, docs)
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[upsertBatch] embedding failed, mock err"))
+			convey.So(ids, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test upsert failed", func() {
+			Mock(GetMethod(cli, "Upsert")).Return(nil, fmt.Errorf("mock err")).Build()
+
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:     cli,
+				Collection: "eino",
+				Embedding:  &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			ids, err := i.Store(ctx, docs)
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[upsertBatch] upsert points failed, mock err"))
+			convey.So(ids, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test context canceled", func() {
+			var calls int
+			Mock(GetMethod(cli, "Upsert")).To(func(ctx context.Context, request *qdrant.UpsertPoints) (*qdrant.UpdateResult, error) {
+				calls++
+				return &qdrant.UpdateResult{}, nil
+			}).Build()
+
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:     cli,
+				Collection: "eino",
+				BatchSize:  1,
+				Embedding:  &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			cctx, cancel := context.WithCancel(ctx)
+			cancel()
+			ids, err := i.Store(cctx, docs)
+			convey.So(errors.Is(err, context.Canceled), convey.ShouldBeTrue)
+			convey.So(ids, convey.ShouldBeNil)
+			convey.So(calls, convey.ShouldEqual, 0)
+		})
+
+		PatchConvey("test success with batches", func() {
+			var (
+				mu     sync.Mutex
+				points []*qdrant.PointStruct
+			)
+			Mock(GetMethod(cli, "Upsert")).To(func(ctx context.Context, request *qdrant.UpsertPoints) (*qdrant.UpdateResult, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				points = append(points, request.Points...)
+				return &qdrant.UpdateResult{}, nil
+			}).Build()
+
+			i, err := NewIndexer(ctx, &IndexerConfig{
+				Client:      cli,
+				Collection:  "eino",
+				VectorName:  "dense",
+				BatchSize:   1,
+				Parallelism: 2,
+				Embedding:   &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			ids, err := i.Store(ctx, docs)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(ids), convey.ShouldEqual, 3)
+			convey.So(ids[0], convey.ShouldEqual, "1")
+			convey.So(ids[1], convey.ShouldEqual, "ae5fb4b4-1c5c-4b1e-8a48-8a3b9fd0f3a7")
+			convey.So(ids[2], convey.ShouldEqual, "doc_3")
+			convey.So(len(points), convey.ShouldEqual, 3)
+			for _, p := range points {
+				convey.So(p.Vectors.GetVectors().GetVectors()["dense"], convey.ShouldNotBeNil)
+				convey.So(p.Payload[defaultFieldContent], convey.ShouldNotBeNil)
+				if pointIDString(p.Id) == pointIDString(docIDToPointID("doc_3")) {
+					convey.So(p.Payload[defaultFieldDocID].GetStringValue(), convey.ShouldEqual, "doc_3")
+				}
+			}
+		})
+	})
+}
+
+type mockEmbedding struct {
+	err error
+}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	resp := make([][]float64, len(texts))
+	for i := range resp {
+		resp[i] = []float64{0.1, 0.2, 0.3}
+	}
+
+	return resp, nil
+}
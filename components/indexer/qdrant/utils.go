@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+// docIDToPointID converts document id to qdrant point id, which only accepts uuid or unsigned integer.
+// Other ids are mapped to a stable uuid, so that upserting the same document twice overwrites the point.
+func docIDToPointID(id string) *qdrant.PointId {
+	if _, err := uuid.Parse(id); err == nil {
+		return qdrant.NewID(id)
+	}
+
+	if num, err := strconv.ParseUint(id, 10, 64); err == nil {
+		return qdrant.NewIDNum(num)
+	}
+
+	if id == "" {
+		return qdrant.NewID(uuid.NewString())
+	}
+
+	return qdrant.NewID(uuid.NewSHA1(uuid.NameSpaceOID, []byte(id)).String())
+}
+
+func pointIDString(id *qdrant.PointId) string {
+	if id.GetUuid() != "" {
+		return id.GetUuid()
+	}
+
+	return strconv.FormatUint(id.GetNum(), 10)
+}
+
+func vector2Float32(vector []float64) []float32 {
+	ret := make([]float32, len(vector))
+	for i, v := range vector {
+		ret[i] = float32(v)
+	}
+
+	return ret
+}
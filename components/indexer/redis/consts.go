@@ -19,4 +19,8 @@ package redis
 const (
 	defaultReturnFieldContent       = "content"
 	defaultReturnFieldVectorContent = "vector_content"
+
+	vectorTypeFloat32 = "FLOAT32"
+
+	extraKeyRedisTTL = "_redis_ttl" // value: time.Duration
 )
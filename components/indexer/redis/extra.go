@@ -0,0 +1,45 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// SetExtraDataTTL set expiration of the hash key written by defaultDocumentToFields.
+func SetExtraDataTTL(doc *schema.Document, ttl time.Duration) {
+	if doc == nil {
+		return
+	}
+
+	if doc.MetaData == nil {
+		doc.MetaData = make(map[string]any)
+	}
+
+	doc.MetaData[extraKeyRedisTTL] = ttl
+}
+
+func GetExtraDataTTL(doc *schema.Document) (time.Duration, bool) {
+	if doc == nil || doc.MetaData == nil {
+		return 0, false
+	}
+
+	val, ok := doc.MetaData[extraKeyRedisTTL].(time.Duration)
+	return val, ok
+}
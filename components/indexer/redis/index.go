@@ -0,0 +1,186 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type VectorAlgorithm string
+
+const (
+	// VectorAlgorithmFlat brute force index, suits small datasets (< 1M vectors) or when perfect accuracy is required.
+	VectorAlgorithmFlat VectorAlgorithm = "FLAT"
+	// VectorAlgorithmHNSW approximate nearest neighbors index, suits large datasets.
+	VectorAlgorithmHNSW VectorAlgorithm = "HNSW"
+)
+
+type DistanceMetric string
+
+const (
+	DistanceMetricCosine DistanceMetric = "COSINE"
+	DistanceMetricL2     DistanceMetric = "L2"
+	DistanceMetricIP     DistanceMetric = "IP"
+)
+
+// IndexConfig describes an FT.CREATE index on hashes written by the default Indexer.
+// see: https://redis.io/docs/latest/develop/interact/search-and-query/advanced-concepts/vectors/#create-a-vector-index
+type IndexConfig struct {
+	// Name index name, should be used in redis retriever.
+	Name string
+	// KeyPrefix should be the same as IndexerConfig.KeyPrefix.
+	KeyPrefix string
+	// ContentField text field name.
+	// Default "content".
+	ContentField string
+	// VectorField vector field name, correspond to FieldValue.EmbedKey.
+	// Default "vector_content".
+	VectorField string
+	// Dim keeps same with dimensions of Embedding, required.
+	Dim int
+	// Algorithm vector index algorithm.
+	// Default VectorAlgorithmFlat.
+	Algorithm VectorAlgorithm
+	// DistanceMetric default DistanceMetricCosine.
+	DistanceMetric DistanceMetric
+	// InitialCapacity initial vector capacity of the index, optional.
+	InitialCapacity int
+
+	// BlockSize block size to hold vectors, only for VectorAlgorithmFlat, optional.
+	BlockSize int
+	// M max number of outgoing edges for each node in each layer, only for VectorAlgorithmHNSW, optional.
+	M int
+	// EFConstruction max number of connected neighbors to consider during graph building, only for VectorAlgorithmHNSW, optional.
+	EFConstruction int
+	// EFRuntime max top candidates during KNN search, only for VectorAlgorithmHNSW, optional.
+	EFRuntime int
+	// Epsilon relative factor that sets the boundaries for vector range queries, only for VectorAlgorithmHNSW, optional.
+	Epsilon float64
+
+	// TagFields metadata fields indexed as TAG, which could be used in filter query like @field:{val}.
+	TagFields []string
+	// NumericFields metadata fields indexed as NUMERIC, which could be used in filter query like @field:[min max].
+	NumericFields []string
+}
+
+// CreateIndex creates a vector index with FT.CREATE, matching hashes written by defaultDocumentToFields.
+// see: https://redis.io/docs/latest/commands/ft.create/
+func CreateIndex(ctx context.Context, client *redis.Client, config *IndexConfig) error {
+	if client == nil {
+		return fmt.Errorf("[CreateIndex] redis client not provided")
+	}
+
+	schemas, err := buildIndexSchemas(config)
+	if err != nil {
+		return err
+	}
+
+	options := &redis.FTCreateOptions{
+		OnHash: true,
+	}
+	if config.KeyPrefix != "" {
+		options.Prefix = []any{config.KeyPrefix}
+	}
+
+	if err = client.FTCreate(ctx, config.Name, options, schemas...).Err(); err != nil {
+		return fmt.Errorf("[CreateIndex] create index failed, %w", err)
+	}
+
+	return nil
+}
+
+func buildIndexSchemas(config *IndexConfig) ([]*redis.FieldSchema, error) {
+	if config == nil || config.Name == "" {
+		return nil, fmt.Errorf("[CreateIndex] index name not provided")
+	}
+
+	if config.Dim <= 0 {
+		return nil, fmt.Errorf("[CreateIndex] invalid vector dim=%d", config.Dim)
+	}
+
+	contentField := config.ContentField
+	if contentField == "" {
+		contentField = defaultReturnFieldContent
+	}
+
+	vectorField := config.VectorField
+	if vectorField == "" {
+		vectorField = defaultReturnFieldVectorContent
+	}
+
+	metric := config.DistanceMetric
+	if metric == "" {
+		metric = DistanceMetricCosine
+	}
+
+	// vectors are written as FLOAT32 bytes by Indexer, see vector2Bytes.
+	vectorArgs := &redis.FTVectorArgs{}
+	switch config.Algorithm {
+	case "", VectorAlgorithmFlat:
+		vectorArgs.FlatOptions = &redis.FTFlatOptions{
+			Type:            vectorTypeFloat32,
+			Dim:             config.Dim,
+			DistanceMetric:  string(metric),
+			InitialCapacity: config.InitialCapacity,
+			BlockSize:       config.BlockSize,
+		}
+	case VectorAlgorithmHNSW:
+		vectorArgs.HNSWOptions = &redis.FTHNSWOptions{
+			Type:                   vectorTypeFloat32,
+			Dim:                    config.Dim,
+			DistanceMetric:         string(metric),
+			InitialCapacity:        config.InitialCapacity,
+			MaxEdgesPerNode:        config.M,
+			MaxAllowedEdgesPerNode: config.EFConstruction,
+			EFRunTime:              config.EFRuntime,
+			Epsilon:                config.Epsilon,
+		}
+	default:
+		return nil, fmt.Errorf("[CreateIndex] unknown vector algorithm=%s", config.Algorithm)
+	}
+
+	schemas := []*redis.FieldSchema{
+		{
+			FieldName: contentField,
+			FieldType: redis.SearchFieldTypeText,
+		},
+		{
+			FieldName:  vectorField,
+			FieldType:  redis.SearchFieldTypeVector,
+			VectorArgs: vectorArgs,
+		},
+	}
+
+	for _, field := range config.TagFields {
+		schemas = append(schemas, &redis.FieldSchema{
+			FieldName: field,
+			FieldType: redis.SearchFieldTypeTag,
+		})
+	}
+
+	for _, field := range config.NumericFields {
+		schemas = append(schemas, &redis.FieldSchema{
+			FieldName: field,
+			FieldType: redis.SearchFieldTypeNumeric,
+		})
+	}
+
+	return schemas, nil
+}
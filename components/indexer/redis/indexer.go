@@ -19,6 +19,7 @@ package redis
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components"
@@ -56,6 +57,8 @@ type Hashes struct {
 	Key string
 	// Key redis hashes field - val pairs
 	Field2Value map[string]FieldValue
+	// TTL expiration of the hash key, no expiration if TTL <= 0.
+	TTL time.Duration
 }
 
 type FieldValue struct {
@@ -157,6 +160,9 @@ func (i *Indexer) pipelineHSet(ctx context.Context, docs []*schema.Document, opt
 			}
 
 			pipeline.HSet(ctx, i.config.KeyPrefix+t.key, flatten(fields)...)
+			if t.ttl > 0 {
+				pipeline.Expire(ctx, i.config.KeyPrefix+t.key, t.ttl)
+			}
 		}
 
 		tuples = tuples[:0]
@@ -223,6 +229,7 @@ func (i *Indexer) pipelineHSet(ctx context.Context, docs []*schema.Document, opt
 			key:     key,
 			fields:  fields,
 			key2Idx: key2Idx,
+			ttl:     hashes.TTL,
 		})
 	}
 
@@ -276,14 +283,20 @@ func defaultDocumentToFields(ctx context.Context, doc *schema.Document) (*Hashes
 		},
 	}
 	for k := range doc.MetaData {
+		if k == extraKeyRedisTTL {
+			continue
+		}
 		field2Value[k] = FieldValue{
 			Value: doc.MetaData[k],
 		}
 	}
 
+	ttl, _ := GetExtraDataTTL(doc)
+
 	return &Hashes{
 		Key:         doc.ID,
 		Field2Value: field2Value,
+		TTL:         ttl,
 	}, nil
 }
 
@@ -291,6 +304,7 @@ type tuple struct {
 	key     string
 	fields  map[string]any
 	key2Idx map[string]int
+	ttl     time.Duration
 }
 
 func flatten(fields map[string]any) []any {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log"
 	"testing"
+	"time"
 
 	. "github.com/bytedance/mockey"
 	"github.com/cloudwego/eino/components/embedding"
@@ -183,6 +184,116 @@ func TestPipelineHSet(t *testing.T) {
 			contains(d1)
 			contains(d2)
 		})
+
+		PatchConvey("test success with ttl", func() {
+			ttls := make(map[string]time.Duration)
+			pl := &redis.Pipeline{}
+			Mock(GetMethod(mockClient, "Pipeline")).Return(pl).Build()
+			Mock(GetMethod(pl, "HSet")).Return(nil).Build()
+			Mock(GetMethod(pl, "Expire")).To(func(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd {
+				ttls[key] = expiration
+				return nil
+			}).Build()
+			Mock(GetMethod(pl, "Exec")).Return(nil, nil).Build()
+
+			d3 := &schema.Document{ID: "3", Content: "zxc"}
+			SetExtraDataTTL(d3, time.Hour)
+			i := &Indexer{
+				config: &IndexerConfig{
+					Client:           mockClient,
+					DocumentToHashes: defaultDocumentToFields,
+					BatchSize:        1,
+				},
+			}
+
+			convey.So(i.pipelineHSet(ctx, []*schema.Document{d1, d3}, &indexer.Options{
+				Embedding: &mockEmbedding{sizeForCall: []int{1, 1}, dims: 8},
+			}), convey.ShouldBeNil)
+			convey.So(ttls, convey.ShouldResemble, map[string]time.Duration{"3": time.Hour})
+		})
+	})
+}
+
+func TestDefaultDocumentToFieldsTTL(t *testing.T) {
+	PatchConvey("test defaultDocumentToFields ttl", t, func() {
+		ctx := context.Background()
+		doc := &schema.Document{ID: "1", Content: "asd", MetaData: map[string]any{"a": 1}}
+		SetExtraDataTTL(doc, time.Minute)
+		ttl, ok := GetExtraDataTTL(doc)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(ttl, convey.ShouldEqual, time.Minute)
+
+		hashes, err := defaultDocumentToFields(ctx, doc)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hashes.TTL, convey.ShouldEqual, time.Minute)
+		_, found := hashes.Field2Value[extraKeyRedisTTL]
+		convey.So(found, convey.ShouldBeFalse)
+		convey.So(hashes.Field2Value["a"].Value, convey.ShouldEqual, 1)
+	})
+}
+
+func TestCreateIndex(t *testing.T) {
+	PatchConvey("test CreateIndex", t, func() {
+		ctx := context.Background()
+		mockClient := &redis.Client{}
+
+		PatchConvey("test invalid config", func() {
+			convey.So(CreateIndex(ctx, nil, &IndexConfig{Name: "idx", Dim: 8}), convey.ShouldBeError,
+				fmt.Errorf("[CreateIndex] redis client not provided"))
+			convey.So(CreateIndex(ctx, mockClient, &IndexConfig{Dim: 8}), convey.ShouldBeError,
+				fmt.Errorf("[CreateIndex] index name not provided"))
+			convey.So(CreateIndex(ctx, mockClient, &IndexConfig{Name: "idx"}), convey.ShouldBeError,
+				fmt.Errorf("[CreateIndex] invalid vector dim=0"))
+			convey.So(CreateIndex(ctx, mockClient, &IndexConfig{Name: "idx", Dim: 8, Algorithm: "IVF"}), convey.ShouldBeError,
+				fmt.Errorf("[CreateIndex] unknown vector algorithm=IVF"))
+		})
+
+		PatchConvey("test hnsw with tag and numeric fields", func() {
+			var (
+				gotIndex   string
+				gotOptions *redis.FTCreateOptions
+				gotSchemas []*redis.FieldSchema
+			)
+			Mock(GetMethod(mockClient, "FTCreate")).To(func(ctx context.Context, index string, options *redis.FTCreateOptions, schema ...*redis.FieldSchema) *redis.StatusCmd {
+				gotIndex, gotOptions, gotSchemas = index, options, schema
+				return redis.NewStatusResult("OK", nil)
+			}).Build()
+
+			err := CreateIndex(ctx, mockClient, &IndexConfig{
+				Name:           "idx",
+				KeyPrefix:      "doc:",
+				Dim:            8,
+				Algorithm:      VectorAlgorithmHNSW,
+				DistanceMetric: DistanceMetricL2,
+				M:              16,
+				EFConstruction: 200,
+				TagFields:      []string{"category"},
+				NumericFields:  []string{"price"},
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(gotIndex, convey.ShouldEqual, "idx")
+			convey.So(gotOptions.OnHash, convey.ShouldBeTrue)
+			convey.So(gotOptions.Prefix, convey.ShouldResemble, []any{"doc:"})
+			convey.So(len(gotSchemas), convey.ShouldEqual, 4)
+			convey.So(gotSchemas[0].FieldName, convey.ShouldEqual, defaultReturnFieldContent)
+			convey.So(gotSchemas[1].FieldName, convey.ShouldEqual, defaultReturnFieldVectorContent)
+			convey.So(gotSchemas[1].VectorArgs.FlatOptions, convey.ShouldBeNil)
+			convey.So(gotSchemas[1].VectorArgs.HNSWOptions, convey.ShouldResemble, &redis.FTHNSWOptions{
+				Type:                   vectorTypeFloat32,
+				Dim:                    8,
+				DistanceMetric:         "L2",
+				MaxEdgesPerNode:        16,
+				MaxAllowedEdgesPerNode: 200,
+			})
+			convey.So(gotSchemas[2].FieldType, convey.ShouldEqual, redis.SearchFieldTypeTag)
+			convey.So(gotSchemas[3].FieldType, convey.ShouldEqual, redis.SearchFieldTypeNumeric)
+		})
+
+		PatchConvey("test create failed", func() {
+			Mock(GetMethod(mockClient, "FTCreate")).Return(redis.NewStatusResult("", fmt.Errorf("mock err"))).Build()
+			convey.So(CreateIndex(ctx, mockClient, &IndexConfig{Name: "idx", Dim: 8}), convey.ShouldBeError,
+				fmt.Errorf("[CreateIndex] create index failed, %w", fmt.Errorf("mock err")))
+		})
 	})
 }
 
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc_vikingdb
+
+import (
+	"github.com/volcengine/volc-sdk-golang/service/vikingdb"
+)
+
+// GetCollection returns the collection of the indexer, fetched when the indexer is created.
+func (i *Indexer) GetCollection() *vikingdb.Collection {
+	return i.collection
+}
+
+// GetFields returns the fields of the collection, e.g. to check the scalar fields before SetExtraDataFields.
+func (i *Indexer) GetFields() []vikingdb.Field {
+	if i.collection == nil {
+		return nil
+	}
+
+	return i.collection.Fields
+}
+
+// GetField returns the field of the collection by name.
+func (i *Indexer) GetField(name string) (vikingdb.Field, bool) {
+	for _, field := range i.GetFields() {
+		if field.FieldName == name {
+			return field, true
+		}
+	}
+
+	return vikingdb.Field{}, false
+}
+
+// GetScalarFields returns the names of the fields which can be set by SetExtraDataFields,
+// that is all the fields except the primary key, content and vector fields written by the indexer.
+func (i *Indexer) GetScalarFields() []string {
+	var names []string
+	for _, field := range i.GetFields() {
+		if field.IsPrimaryKey {
+			continue
+		}
+
+		switch field.FieldName {
+		case defaultFieldID, defaultFieldContent, defaultFieldVector, defaultFieldSparseVector:
+			continue
+		}
+
+		switch field.FieldType {
+		case "vector", "sparse_vector", "text":
+			continue
+		}
+
+		names = append(names, field.FieldName)
+	}
+
+	return names
+}
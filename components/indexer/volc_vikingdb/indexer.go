@@ -30,7 +30,8 @@ import (
 )
 
 const (
-	defaultAddBatchSize = 5
+	defaultAddBatchSize    = 5
+	defaultDeleteBatchSize = 100
 )
 
 type IndexerConfig struct {
@@ -48,6 +49,11 @@ type IndexerConfig struct {
 	EmbeddingConfig EmbeddingConfig `json:"embedding_config"`
 
 	AddBatchSize int `json:"add_batch_size"`
+	// DeleteBatchSize 每次 DeleteData 请求删除的最大数据条数, 默认 100
+	DeleteBatchSize int `json:"delete_batch_size"`
+
+	// ValidateFields 为 true 时, Store 前校验 SetExtraDataFields 设置的字段是否存在于数据集中, 避免写入未定义的字段
+	ValidateFields bool `json:"validate_fields"`
 }
 
 type EmbeddingConfig struct {
@@ -88,6 +94,10 @@ func NewIndexer(ctx context.Context, config *IndexerConfig) (*Indexer, error) {
 		config.AddBatchSize = defaultAddBatchSize
 	}
 
+	if config.DeleteBatchSize == 0 {
+		config.DeleteBatchSize = defaultDeleteBatchSize
+	}
+
 	service := vikingdb.NewVikingDBService(config.Host, config.Region, config.AK, config.SK, config.Scheme)
 	if config.ConnectionTimeout != 0 {
 		service.SetConnectionTimeout(config.ConnectionTimeout)
@@ -118,6 +128,8 @@ func NewIndexer(ctx context.Context, config *IndexerConfig) (*Indexer, error) {
 	return i, nil
 }
 
+// Store upserts docs into the collection, a doc whose id already exists replaces the stored data,
+// so re-storing the docs of a changed source updates them in place.
 func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) (ids []string, err error) {
 
 	options := indexer.GetCommonOptions(&indexer.Options{
@@ -132,6 +144,10 @@ func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...in
 		}
 	}()
 
+	if err = i.validateDocuments(docs); err != nil {
+		return nil, err
+	}
+
 	ids = make([]string, 0, len(docs))
 	for _, sub := range chunk(docs, i.config.AddBatchSize) {
 		data, err := i.convertDocuments(ctx, sub, options)
@@ -151,6 +167,34 @@ func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...in
 	return ids, nil
 }
 
+// Delete deletes the data of ids from the collection, ids which don't exist are ignored.
+func (i *Indexer) Delete(ctx context.Context, ids []string) error {
+	for _, sub := range chunk(ids, i.config.DeleteBatchSize) {
+		if err := i.collection.DeleteData(sub); err != nil {
+			return fmt.Errorf("DeleteData failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) validateDocuments(docs []*schema.Document) error {
+	if !i.config.ValidateFields {
+		return nil
+	}
+
+	for _, doc := range docs {
+		fields, _ := GetExtraVikingDBFields(doc)
+		for name := range fields {
+			if _, ok := i.GetField(name); !ok {
+				return fmt.Errorf("[VikingDBIndexer] field not found in collection, id=%s, field=%s", doc.ID, name)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (i *Indexer) convertDocuments(ctx context.Context, docs []*schema.Document, options *indexer.Options) (data []vikingdb.Data, err error) {
 	var (
 		useBuiltinEmbedding = i.config.EmbeddingConfig.UseBuiltin && options.Embedding == nil
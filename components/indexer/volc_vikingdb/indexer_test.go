@@ -328,6 +328,70 @@ func TestConvertDocuments(t *testing.T) {
 	})
 }
 
+func TestDelete(t *testing.T) {
+	PatchConvey("test Delete", t, func() {
+		ctx := context.Background()
+		collection := &vikingdb.Collection{}
+		idx := &Indexer{
+			config:     &IndexerConfig{DeleteBatchSize: 2},
+			collection: collection,
+		}
+
+		PatchConvey("test DeleteData failed", func() {
+			Mock(GetMethod(collection, "DeleteData")).Return(fmt.Errorf("mock err")).Build()
+			err := idx.Delete(ctx, []string{"1"})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "mock err")
+		})
+
+		PatchConvey("test success", func() {
+			var batches [][]string
+			Mock(GetMethod(collection, "DeleteData")).To(func(id interface{}) error {
+				batches = append(batches, id.([]string))
+				return nil
+			}).Build()
+			err := idx.Delete(ctx, []string{"1", "2", "3"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(batches, convey.ShouldEqual, [][]string{{"1", "2"}, {"3"}})
+		})
+	})
+}
+
+func TestCollectionFields(t *testing.T) {
+	PatchConvey("test collection fields", t, func() {
+		ctx := context.Background()
+		idx := &Indexer{
+			config: &IndexerConfig{ValidateFields: true},
+			collection: &vikingdb.Collection{
+				Fields: []vikingdb.Field{
+					{FieldName: defaultFieldID, FieldType: "string", IsPrimaryKey: true},
+					{FieldName: defaultFieldContent, FieldType: "string"},
+					{FieldName: defaultFieldVector, FieldType: "vector", Dim: 3},
+					{FieldName: "source", FieldType: "string"},
+					{FieldName: "tags", FieldType: "list<string>"},
+				},
+			},
+		}
+
+		field, ok := idx.GetField("source")
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(field.FieldType, convey.ShouldEqual, "string")
+		_, ok = idx.GetField("unknown")
+		convey.So(ok, convey.ShouldBeFalse)
+		convey.So(idx.GetScalarFields(), convey.ShouldEqual, []string{"source", "tags"})
+		convey.So((&Indexer{}).GetFields(), convey.ShouldBeNil)
+
+		PatchConvey("test Store with unknown field", func() {
+			doc := &schema.Document{ID: "1", Content: "asd"}
+			SetExtraDataFields(doc, map[string]interface{}{"unknown": "asd"})
+			ids, err := idx.Store(ctx, []*schema.Document{doc})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "field not found in collection")
+			convey.So(ids, convey.ShouldBeNil)
+		})
+	})
+}
+
 type mockEmbedding struct{}
 
 func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
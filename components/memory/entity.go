@@ -0,0 +1,230 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// EntityStore stores what's known about the entities of conversations, e.g. people, places and projects,
+// as a description per entity name. Implementations must be safe for concurrent use.
+type EntityStore interface {
+	// Entities returns the entities of the session, empty if the session doesn't exist.
+	Entities(ctx context.Context, sessionID string) (map[string]string, error)
+	// Update adds or overwrites the descriptions of entities of the session.
+	Update(ctx context.Context, sessionID string, entities map[string]string) error
+	// Clear removes all entities of the session.
+	Clear(ctx context.Context, sessionID string) error
+}
+
+// NewInMemoryEntityStore returns an EntityStore keeping entities in process memory.
+func NewInMemoryEntityStore() EntityStore {
+	return &inMemoryEntityStore{sessions: make(map[string]map[string]string)}
+}
+
+type inMemoryEntityStore struct {
+	mu       sync.RWMutex
+	sessions map[string]map[string]string
+}
+
+func (s *inMemoryEntityStore) Entities(ctx context.Context, sessionID string) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entities := make(map[string]string, len(s.sessions[sessionID]))
+	for k, v := range s.sessions[sessionID] {
+		entities[k] = v
+	}
+	return entities, nil
+}
+
+func (s *inMemoryEntityStore) Update(ctx context.Context, sessionID string, entities map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		session = make(map[string]string, len(entities))
+		s.sessions[sessionID] = session
+	}
+	for k, v := range entities {
+		session[k] = v
+	}
+	return nil
+}
+
+func (s *inMemoryEntityStore) Clear(ctx context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, sessionID)
+	return nil
+}
+
+const (
+	defaultEntityInstruction = "Extract the entities mentioned in the new messages of the conversation below, " +
+		"e.g. people, organizations, places, products and projects, with what is known about them. " +
+		"Merge the new information into the known description of an entity if there is one. " +
+		"Reply with a json object mapping entity names to their descriptions only, {} if there are no entities."
+	defaultEntityPrefix = "Known entities of the conversation:\n"
+)
+
+type EntityConfig struct {
+	// History stores the messages.
+	// Required.
+	History ChatHistory
+	// ChatModel extracts entities from appended messages.
+	// Required.
+	ChatModel model.BaseChatModel
+	// Store stores the entities.
+	// Optional. Default: NewInMemoryEntityStore()
+	Store EntityStore
+	// Instruction is the system prompt of the extraction request, the model must reply with a json object
+	// mapping entity names to descriptions.
+	// Optional. Default: an instruction to extract people, organizations, places, products and projects.
+	Instruction string
+}
+
+// NewEntityHistory returns a ChatHistory extracting entities from appended messages with a ChatModel.
+// Messages returns the known entities as a system message, followed by the messages of History.
+func NewEntityHistory(ctx context.Context, config *EntityConfig) (ChatHistory, error) {
+	if config == nil || config.History == nil {
+		return nil, errors.New("[NewEntityHistory] history not provided")
+	}
+	if config.ChatModel == nil {
+		return nil, errors.New("[NewEntityHistory] chat model not provided")
+	}
+
+	h := &entityHistory{
+		history:     config.History,
+		cm:          config.ChatModel,
+		store:       config.Store,
+		instruction: config.Instruction,
+	}
+	if h.store == nil {
+		h.store = NewInMemoryEntityStore()
+	}
+	if len(h.instruction) == 0 {
+		h.instruction = defaultEntityInstruction
+	}
+	return h, nil
+}
+
+type entityHistory struct {
+	history     ChatHistory
+	cm          model.BaseChatModel
+	store       EntityStore
+	instruction string
+}
+
+func (h *entityHistory) Messages(ctx context.Context, sessionID string) ([]*schema.Message, error) {
+	msgs, err := h.history.Messages(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	entities, err := h.store.Entities(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("[EntityHistory] get entities failed: %w", err)
+	}
+	if len(entities) == 0 {
+		return msgs, nil
+	}
+	return append([]*schema.Message{schema.SystemMessage(defaultEntityPrefix + formatEntities(entities))}, msgs...), nil
+}
+
+func (h *entityHistory) Append(ctx context.Context, sessionID string, msgs ...*schema.Message) error {
+	if err := h.history.Append(ctx, sessionID, msgs...); err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	known, err := h.store.Entities(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("[EntityHistory] get entities failed: %w", err)
+	}
+	prompt := "New messages:\n" + transcript(msgs)
+	if len(known) > 0 {
+		prompt = "Known entities:\n" + formatEntities(known) + "\n" + prompt
+	}
+	resp, err := h.cm.Generate(ctx, []*schema.Message{
+		schema.SystemMessage(h.instruction),
+		schema.UserMessage(prompt),
+	})
+	if err != nil {
+		return fmt.Errorf("[EntityHistory] extract entities failed: %w", err)
+	}
+
+	entities, err := parseEntities(resp.Content)
+	if err != nil {
+		return fmt.Errorf("[EntityHistory] parse entities failed: %w", err)
+	}
+	if len(entities) == 0 {
+		return nil
+	}
+	if err = h.store.Update(ctx, sessionID, entities); err != nil {
+		return fmt.Errorf("[EntityHistory] update entities failed: %w", err)
+	}
+	return nil
+}
+
+func (h *entityHistory) Clear(ctx context.Context, sessionID string) error {
+	if err := h.history.Clear(ctx, sessionID); err != nil {
+		return err
+	}
+	if err := h.store.Clear(ctx, sessionID); err != nil {
+		return fmt.Errorf("[EntityHistory] clear entities failed: %w", err)
+	}
+	return nil
+}
+
+func formatEntities(entities map[string]string) string {
+	names := make([]string, 0, len(entities))
+	for name := range entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sb := strings.Builder{}
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("- %s: %s\n", name, entities[name]))
+	}
+	return sb.String()
+}
+
+// parseEntities parses the json object of the model reply, which may be wrapped in a markdown code block.
+func parseEntities(content string) (map[string]string, error) {
+	content = strings.TrimSpace(content)
+	if start, end := strings.Index(content, "{"), strings.LastIndex(content, "}"); start >= 0 && end > start {
+		content = content[start : end+1]
+	}
+	entities := make(map[string]string)
+	if err := json.Unmarshal([]byte(content), &entities); err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/memory"
+)
+
+// echoChatModel stands for a real ChatModel, e.g. of openai or ark.
+type echoChatModel struct{}
+
+func (e *echoChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return schema.AssistantMessage("you said: "+input[len(input)-1].Content, nil), nil
+}
+
+func (e *echoChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := e.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func main() {
+	ctx := context.Background()
+
+	history := memory.NewInMemoryHistory(&memory.InMemoryConfig{MaxMessages: 100})
+	if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+		var err error
+		history, err = memory.NewRedisHistory(ctx, &memory.RedisConfig{
+			Client: redis.NewClient(&redis.Options{Addr: addr}),
+		})
+		if err != nil {
+			log.Fatalf("NewRedisHistory failed, err=%v", err)
+		}
+	}
+
+	var cm model.BaseChatModel = &echoChatModel{}
+	sessionID := "session-1"
+	defer func() {
+		_ = history.Clear(ctx, sessionID)
+	}()
+
+	for _, text := range []string{"hello", "what did I just say?"} {
+		past, err := history.Messages(ctx, sessionID)
+		if err != nil {
+			log.Fatalf("Messages failed, err=%v", err)
+		}
+
+		question := schema.UserMessage(text)
+		input := append([]*schema.Message{schema.SystemMessage("You are a helpful assistant.")}, past...)
+		input = append(input, question)
+		answer, err := cm.Generate(ctx, input)
+		if err != nil {
+			log.Fatalf("Generate failed, err=%v", err)
+		}
+
+		if err = history.Append(ctx, sessionID, question, answer); err != nil {
+			log.Fatalf("Append failed, err=%v", err)
+		}
+		log.Printf("%s -> %s (with %d messages of history)", question.Content, answer.Content, len(past))
+	}
+}
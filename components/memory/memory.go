@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// ChatHistory stores the messages of conversations, each identified by a session id.
+// Implementations must be safe for concurrent use.
+type ChatHistory interface {
+	// Messages returns the messages of the session, oldest first, empty if the session doesn't exist.
+	Messages(ctx context.Context, sessionID string) ([]*schema.Message, error)
+	// Append adds messages to the end of the session.
+	Append(ctx context.Context, sessionID string, msgs ...*schema.Message) error
+	// Clear removes all messages of the session.
+	Clear(ctx context.Context, sessionID string) error
+}
+
+var errEmptySessionID = errors.New("session id is empty")
+
+type InMemoryConfig struct {
+	// MaxMessages keeps only the newest messages of each session.
+	// Optional. Default: 0, unlimited
+	MaxMessages int
+}
+
+// NewInMemoryHistory returns a ChatHistory keeping messages in process memory, e.g. for tests and single-instance services.
+func NewInMemoryHistory(config *InMemoryConfig) ChatHistory {
+	h := &inMemoryHistory{sessions: make(map[string][]*schema.Message)}
+	if config != nil {
+		h.maxMessages = config.MaxMessages
+	}
+	return h
+}
+
+type inMemoryHistory struct {
+	maxMessages int
+
+	mu       sync.RWMutex
+	sessions map[string][]*schema.Message
+}
+
+func (h *inMemoryHistory) Messages(ctx context.Context, sessionID string) ([]*schema.Message, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	msgs := h.sessions[sessionID]
+	return append(make([]*schema.Message, 0, len(msgs)), msgs...), nil
+}
+
+func (h *inMemoryHistory) Append(ctx context.Context, sessionID string, msgs ...*schema.Message) error {
+	if len(sessionID) == 0 {
+		return errEmptySessionID
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	all := append(h.sessions[sessionID], msgs...)
+	if h.maxMessages > 0 && len(all) > h.maxMessages {
+		all = append([]*schema.Message{}, all[len(all)-h.maxMessages:]...)
+	}
+	h.sessions[sessionID] = all
+	return nil
+}
+
+func (h *inMemoryHistory) Clear(ctx context.Context, sessionID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.sessions, sessionID)
+	return nil
+}
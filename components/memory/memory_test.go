@@ -0,0 +1,152 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	input []*schema.Message
+	resp  string
+	err   error
+	calls int
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.calls++
+	m.input = input
+	if m.err != nil {
+		return nil, m.err
+	}
+	return schema.AssistantMessage(m.resp, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, errors.New("not implemented")
+}
+
+func contents(msgs []*schema.Message) []string {
+	var ret []string
+	for _, msg := range msgs {
+		ret = append(ret, msg.Content)
+	}
+	return ret
+}
+
+func TestInMemoryHistory(t *testing.T) {
+	ctx := context.Background()
+	h := NewInMemoryHistory(&InMemoryConfig{MaxMessages: 3})
+
+	assert.Error(t, h.Append(ctx, "", schema.UserMessage("q1")))
+	assert.NoError(t, h.Append(ctx, "s1", schema.UserMessage("q1"), schema.AssistantMessage("a1", nil)))
+	assert.NoError(t, h.Append(ctx, "s1", schema.UserMessage("q2"), schema.AssistantMessage("a2", nil)))
+	assert.NoError(t, h.Append(ctx, "s2", schema.UserMessage("hi")))
+
+	msgs, err := h.Messages(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a1", "q2", "a2"}, contents(msgs))
+
+	assert.NoError(t, h.Clear(ctx, "s1"))
+	msgs, err = h.Messages(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+	msgs, err = h.Messages(ctx, "s2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hi"}, contents(msgs))
+}
+
+func TestSummaryHistory(t *testing.T) {
+	ctx := context.Background()
+	cm := &mockChatModel{resp: " user asked q1 "}
+	_, err := NewSummaryHistory(ctx, &SummaryConfig{History: NewInMemoryHistory(nil)})
+	assert.Error(t, err)
+	_, err = NewSummaryHistory(ctx, &SummaryConfig{History: NewInMemoryHistory(nil), ChatModel: cm, MaxMessages: 4, KeepMessages: 4})
+	assert.Error(t, err)
+
+	h, err := NewSummaryHistory(ctx, &SummaryConfig{History: NewInMemoryHistory(nil), ChatModel: cm, MaxMessages: 4})
+	assert.NoError(t, err)
+
+	assert.NoError(t, h.Append(ctx, "s", schema.UserMessage("q1"), schema.AssistantMessage("a1", nil)))
+	assert.NoError(t, h.Append(ctx, "s", schema.UserMessage("q2"), schema.AssistantMessage("a2", nil)))
+	assert.Equal(t, 0, cm.calls)
+
+	// the kept messages start at the user message, not the tool result
+	assert.NoError(t, h.Append(ctx, "s",
+		schema.AssistantMessage("", []schema.ToolCall{{ID: "call-1", Function: schema.FunctionCall{Name: "search", Arguments: "{}"}}}),
+		schema.ToolMessage("result", "call-1"),
+		schema.UserMessage("q3")))
+	assert.Equal(t, 1, cm.calls)
+	assert.Contains(t, cm.input[1].Content, "[user]: q1")
+	assert.Contains(t, cm.input[1].Content, "[call call-1 to tool search]: {}")
+
+	msgs, err := h.Messages(ctx, "s")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{defaultSummaryPrefix + "user asked q1", "q3"}, contents(msgs))
+	assert.True(t, IsSummary(msgs[0]))
+
+	cm.err = errors.New("unavailable")
+	assert.ErrorIs(t, h.Append(ctx, "s", schema.AssistantMessage("a3", nil), schema.UserMessage("q4"), schema.AssistantMessage("a4", nil)), cm.err)
+}
+
+func TestEntityHistory(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewEntityHistory(ctx, &EntityConfig{History: NewInMemoryHistory(nil)})
+	assert.Error(t, err)
+
+	cm := &mockChatModel{resp: "```json\n{\"Alice\": \"the user, lives in Paris\"}\n```"}
+	store := NewInMemoryEntityStore()
+	h, err := NewEntityHistory(ctx, &EntityConfig{History: NewInMemoryHistory(nil), ChatModel: cm, Store: store})
+	assert.NoError(t, err)
+
+	assert.NoError(t, h.Append(ctx, "s", schema.UserMessage("I'm Alice, I live in Paris")))
+	cm.resp = `{"Alice": "the user, moved to Berlin", "Acme": "where Alice works"}`
+	assert.NoError(t, h.Append(ctx, "s", schema.UserMessage("I moved to Berlin for Acme")))
+	assert.Contains(t, cm.input[1].Content, "- Alice: the user, lives in Paris")
+
+	msgs, err := h.Messages(ctx, "s")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		defaultEntityPrefix + "- Acme: where Alice works\n- Alice: the user, moved to Berlin\n",
+		"I'm Alice, I live in Paris",
+		"I moved to Berlin for Acme",
+	}, contents(msgs))
+
+	cm.resp = "not json"
+	assert.Error(t, h.Append(ctx, "s", schema.UserMessage("hi")))
+
+	assert.NoError(t, h.Clear(ctx, "s"))
+	entities, err := store.Entities(ctx, "s")
+	assert.NoError(t, err)
+	assert.Empty(t, entities)
+}
+
+func TestSQLRebind(t *testing.T) {
+	h := &sqlHistory{dialect: DialectPostgres}
+	assert.Equal(t, "SELECT a FROM t WHERE b = $1 AND c = $2", h.rebind("SELECT a FROM t WHERE b = ? AND c = ?"))
+	h.dialect = DialectMySQL
+	assert.Equal(t, "SELECT a FROM t WHERE b = ?", h.rebind("SELECT a FROM t WHERE b = ?"))
+
+	_, err := NewSQLHistory(context.Background(), &SQLConfig{})
+	assert.Error(t, err)
+}
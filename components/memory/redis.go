@@ -0,0 +1,124 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisConfig struct {
+	// Client is the redis client, e.g. *redis.Client or *redis.ClusterClient.
+	// Required.
+	Client redis.UniversalClient
+	// KeyPrefix is prepended to session ids to form the keys of the message lists.
+	// Optional. Default: "eino:memory:"
+	KeyPrefix string
+	// TTL expires a session after it's not appended to for this long.
+	// Optional. Default: 0, never expire
+	TTL time.Duration
+	// MaxMessages keeps only the newest messages of each session.
+	// Optional. Default: 0, unlimited
+	MaxMessages int
+}
+
+// NewRedisHistory returns a ChatHistory storing each session as a redis list of json encoded messages.
+func NewRedisHistory(ctx context.Context, config *RedisConfig) (ChatHistory, error) {
+	if config == nil || config.Client == nil {
+		return nil, errors.New("[NewRedisHistory] redis client not provided")
+	}
+
+	h := &redisHistory{
+		client:      config.Client,
+		keyPrefix:   config.KeyPrefix,
+		ttl:         config.TTL,
+		maxMessages: config.MaxMessages,
+	}
+	if len(h.keyPrefix) == 0 {
+		h.keyPrefix = "eino:memory:"
+	}
+	return h, nil
+}
+
+type redisHistory struct {
+	client      redis.UniversalClient
+	keyPrefix   string
+	ttl         time.Duration
+	maxMessages int
+}
+
+func (h *redisHistory) Messages(ctx context.Context, sessionID string) ([]*schema.Message, error) {
+	values, err := h.client.LRange(ctx, h.keyPrefix+sessionID, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("[RedisHistory] get messages failed: %w", err)
+	}
+
+	msgs := make([]*schema.Message, 0, len(values))
+	for _, v := range values {
+		msg := &schema.Message{}
+		if err = json.Unmarshal([]byte(v), msg); err != nil {
+			return nil, fmt.Errorf("[RedisHistory] unmarshal message failed: %w", err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func (h *redisHistory) Append(ctx context.Context, sessionID string, msgs ...*schema.Message) error {
+	if len(sessionID) == 0 {
+		return errEmptySessionID
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	values := make([]any, 0, len(msgs))
+	for _, msg := range msgs {
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("[RedisHistory] marshal message failed: %w", err)
+		}
+		values = append(values, b)
+	}
+
+	key := h.keyPrefix + sessionID
+	pipe := h.client.TxPipeline()
+	pipe.RPush(ctx, key, values...)
+	if h.maxMessages > 0 {
+		pipe.LTrim(ctx, key, int64(-h.maxMessages), -1)
+	}
+	if h.ttl > 0 {
+		pipe.Expire(ctx, key, h.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("[RedisHistory] append messages failed: %w", err)
+	}
+	return nil
+}
+
+func (h *redisHistory) Clear(ctx context.Context, sessionID string) error {
+	if err := h.client.Del(ctx, h.keyPrefix+sessionID).Err(); err != nil {
+		return fmt.Errorf("[RedisHistory] clear messages failed: %w", err)
+	}
+	return nil
+}
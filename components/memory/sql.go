@@ -0,0 +1,222 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// Dialect is the sql dialect of SQLConfig.DB.
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectMySQL    Dialect = "mysql"
+	DialectPostgres Dialect = "postgres"
+)
+
+var tableNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type SQLConfig struct {
+	// DB is the database, opened with the driver of Dialect.
+	// Required.
+	DB *sql.DB
+	// Dialect decides the placeholders and the table definition.
+	// Required.
+	Dialect Dialect
+	// Table is the table storing messages, with columns id, session_id and message.
+	// Optional. Default: "eino_chat_history"
+	Table string
+	// CreateTable creates the table and its index if they don't exist.
+	// Optional. Default: false
+	CreateTable bool
+	// MaxMessages keeps only the newest messages of each session.
+	// Optional. Default: 0, unlimited
+	MaxMessages int
+}
+
+// NewSQLHistory returns a ChatHistory storing messages in a sql table, one json encoded message per row.
+func NewSQLHistory(ctx context.Context, config *SQLConfig) (ChatHistory, error) {
+	if config == nil || config.DB == nil {
+		return nil, errors.New("[NewSQLHistory] db not provided")
+	}
+	switch config.Dialect {
+	case DialectSQLite, DialectMySQL, DialectPostgres:
+	default:
+		return nil, fmt.Errorf("[NewSQLHistory] unsupported dialect: %q", config.Dialect)
+	}
+	table := config.Table
+	if len(table) == 0 {
+		table = "eino_chat_history"
+	}
+	if !tableNameRegexp.MatchString(table) {
+		return nil, fmt.Errorf("[NewSQLHistory] invalid table name: %q", table)
+	}
+
+	h := &sqlHistory{
+		db:          config.DB,
+		dialect:     config.Dialect,
+		table:       table,
+		maxMessages: config.MaxMessages,
+	}
+	if config.CreateTable {
+		if err := h.createTable(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+type sqlHistory struct {
+	db          *sql.DB
+	dialect     Dialect
+	table       string
+	maxMessages int
+}
+
+// rebind replaces the ? placeholders of query with those of the dialect.
+func (h *sqlHistory) rebind(query string) string {
+	if h.dialect != DialectPostgres {
+		return query
+	}
+	sb := strings.Builder{}
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			sb.WriteString(fmt.Sprintf("$%d", n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func (h *sqlHistory) createTable(ctx context.Context) error {
+	var id string
+	switch h.dialect {
+	case DialectSQLite:
+		id = "INTEGER PRIMARY KEY AUTOINCREMENT"
+	case DialectMySQL:
+		id = "BIGINT AUTO_INCREMENT PRIMARY KEY"
+	case DialectPostgres:
+		id = "BIGSERIAL PRIMARY KEY"
+	}
+	stmts := []string{
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id %s, session_id VARCHAR(255) NOT NULL, message TEXT NOT NULL)", h.table, id),
+	}
+	if h.dialect == DialectMySQL {
+		// mysql has no CREATE INDEX IF NOT EXISTS, the index is created along with the table
+		stmts[0] = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id %s, session_id VARCHAR(255) NOT NULL, message TEXT NOT NULL, "+
+			"INDEX idx_%s_session_id (session_id, id))", h.table, id, h.table)
+	} else {
+		stmts = append(stmts, fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_%s_session_id ON %s (session_id, id)", h.table, h.table))
+	}
+	for _, stmt := range stmts {
+		if _, err := h.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("[SQLHistory] create table failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (h *sqlHistory) Messages(ctx context.Context, sessionID string) ([]*schema.Message, error) {
+	rows, err := h.db.QueryContext(ctx,
+		h.rebind(fmt.Sprintf("SELECT message FROM %s WHERE session_id = ? ORDER BY id", h.table)), sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("[SQLHistory] get messages failed: %w", err)
+	}
+	defer rows.Close()
+
+	var msgs []*schema.Message
+	for rows.Next() {
+		var v string
+		if err = rows.Scan(&v); err != nil {
+			return nil, fmt.Errorf("[SQLHistory] scan message failed: %w", err)
+		}
+		msg := &schema.Message{}
+		if err = json.Unmarshal([]byte(v), msg); err != nil {
+			return nil, fmt.Errorf("[SQLHistory] unmarshal message failed: %w", err)
+		}
+		msgs = append(msgs, msg)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[SQLHistory] get messages failed: %w", err)
+	}
+	return msgs, nil
+}
+
+func (h *sqlHistory) Append(ctx context.Context, sessionID string, msgs ...*schema.Message) (err error) {
+	if len(sessionID) == 0 {
+		return errEmptySessionID
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("[SQLHistory] begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	insert := h.rebind(fmt.Sprintf("INSERT INTO %s (session_id, message) VALUES (?, ?)", h.table))
+	for _, msg := range msgs {
+		b, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("[SQLHistory] marshal message failed: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, insert, sessionID, string(b)); err != nil {
+			return fmt.Errorf("[SQLHistory] insert message failed: %w", err)
+		}
+	}
+
+	if h.maxMessages > 0 {
+		// the derived table works around mysql not supporting LIMIT in IN subqueries
+		trim := h.rebind(fmt.Sprintf("DELETE FROM %s WHERE session_id = ? AND id NOT IN "+
+			"(SELECT id FROM (SELECT id FROM %s WHERE session_id = ? ORDER BY id DESC LIMIT %d) AS newest)",
+			h.table, h.table, h.maxMessages))
+		if _, err = tx.ExecContext(ctx, trim, sessionID, sessionID); err != nil {
+			return fmt.Errorf("[SQLHistory] trim messages failed: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("[SQLHistory] commit failed: %w", err)
+	}
+	return nil
+}
+
+func (h *sqlHistory) Clear(ctx context.Context, sessionID string) error {
+	_, err := h.db.ExecContext(ctx, h.rebind(fmt.Sprintf("DELETE FROM %s WHERE session_id = ?", h.table)), sessionID)
+	if err != nil {
+		return fmt.Errorf("[SQLHistory] clear messages failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	extraKeySummary = "_eino_ext_memory_summary"
+
+	defaultSummaryInstruction = "Summarize the conversation below for the assistant to continue it. " +
+		"Keep the facts, decisions, user preferences and results of tool calls that may matter later, " +
+		"and drop small talk. Reply with the summary only."
+	defaultSummaryPrefix = "Summary of the earlier conversation:\n"
+)
+
+// IsSummary tells whether msg is a summary written by the summary history.
+func IsSummary(msg *schema.Message) bool {
+	if msg == nil {
+		return false
+	}
+	v, _ := msg.Extra[extraKeySummary].(bool)
+	return v
+}
+
+type SummaryConfig struct {
+	// History stores the summary and the recent messages.
+	// Required.
+	History ChatHistory
+	// ChatModel summarizes the older messages.
+	// Required.
+	ChatModel model.BaseChatModel
+	// MaxMessages triggers summarization when a session has more messages.
+	// Optional. Default: 20
+	MaxMessages int
+	// KeepMessages is the number of newest messages kept as is when summarizing, rounded up to start at a user
+	// message, so that tool calls are never separated from their results.
+	// Optional. Default: MaxMessages / 2
+	KeepMessages int
+	// Instruction is the system prompt of the summarization request.
+	// Optional. Default: an instruction to keep facts, decisions, preferences and tool results.
+	Instruction string
+}
+
+// NewSummaryHistory returns a ChatHistory that, once a session grows beyond MaxMessages, replaces its older messages
+// with a summary generated by a ChatModel. The summary is a system message at the start of the session, recognized
+// by IsSummary, and is summarized again along with the messages dropped later.
+// Appends to a session are serialized within the process only, share a session across processes with care.
+func NewSummaryHistory(ctx context.Context, config *SummaryConfig) (ChatHistory, error) {
+	if config == nil || config.History == nil {
+		return nil, errors.New("[NewSummaryHistory] history not provided")
+	}
+	if config.ChatModel == nil {
+		return nil, errors.New("[NewSummaryHistory] chat model not provided")
+	}
+
+	h := &summaryHistory{
+		history:      config.History,
+		cm:           config.ChatModel,
+		maxMessages:  config.MaxMessages,
+		keepMessages: config.KeepMessages,
+		instruction:  config.Instruction,
+	}
+	if h.maxMessages <= 0 {
+		h.maxMessages = 20
+	}
+	if h.keepMessages <= 0 {
+		h.keepMessages = h.maxMessages / 2
+	}
+	if h.keepMessages >= h.maxMessages {
+		return nil, errors.New("[NewSummaryHistory] keep messages must be less than max messages")
+	}
+	if len(h.instruction) == 0 {
+		h.instruction = defaultSummaryInstruction
+	}
+	return h, nil
+}
+
+type summaryHistory struct {
+	history      ChatHistory
+	cm           model.BaseChatModel
+	maxMessages  int
+	keepMessages int
+	instruction  string
+
+	mu sync.Mutex
+}
+
+func (h *summaryHistory) Messages(ctx context.Context, sessionID string) ([]*schema.Message, error) {
+	return h.history.Messages(ctx, sessionID)
+}
+
+func (h *summaryHistory) Clear(ctx context.Context, sessionID string) error {
+	return h.history.Clear(ctx, sessionID)
+}
+
+func (h *summaryHistory) Append(ctx context.Context, sessionID string, msgs ...*schema.Message) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := h.history.Append(ctx, sessionID, msgs...); err != nil {
+		return err
+	}
+	all, err := h.history.Messages(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if len(all) <= h.maxMessages {
+		return nil
+	}
+
+	cut := len(all) - h.keepMessages
+	for cut < len(all) && all[cut].Role != schema.User {
+		cut++
+	}
+	if cut == len(all) {
+		// no user message to start the kept messages at, wait for the next turn
+		return nil
+	}
+
+	resp, err := h.cm.Generate(ctx, []*schema.Message{
+		schema.SystemMessage(h.instruction),
+		schema.UserMessage(transcript(all[:cut])),
+	})
+	if err != nil {
+		return fmt.Errorf("[SummaryHistory] summarize %d messages failed: %w", cut, err)
+	}
+
+	summary := &schema.Message{
+		Role:    schema.System,
+		Content: defaultSummaryPrefix + strings.TrimSpace(resp.Content),
+		Extra:   map[string]any{extraKeySummary: true},
+	}
+	if err = h.history.Clear(ctx, sessionID); err != nil {
+		return err
+	}
+	return h.history.Append(ctx, sessionID, append([]*schema.Message{summary}, all[cut:]...)...)
+}
+
+// transcript renders msgs as plain text for the requests to summarize them or extract entities from them.
+func transcript(msgs []*schema.Message) string {
+	sb := strings.Builder{}
+	for _, msg := range msgs {
+		switch {
+		case IsSummary(msg):
+			sb.WriteString("[earlier summary]: ")
+		case msg.Role == schema.Tool:
+			sb.WriteString(fmt.Sprintf("[tool result of %s]: ", msg.ToolCallID))
+		default:
+			sb.WriteString(fmt.Sprintf("[%s]: ", msg.Role))
+		}
+		sb.WriteString(msg.Content)
+		for _, part := range msg.MultiContent {
+			if part.Type == schema.ChatMessagePartTypeText {
+				sb.WriteString(part.Text)
+			}
+		}
+		for _, call := range msg.ToolCalls {
+			sb.WriteString(fmt.Sprintf("\n[call %s to tool %s]: %s", call.ID, call.Function.Name, call.Function.Arguments))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
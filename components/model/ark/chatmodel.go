@@ -26,6 +26,8 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/cloudwego/eino-ext/libs/acl/retry"
+	"github.com/cloudwego/eino-ext/libs/acl/toolargs"
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components"
 	fmodel "github.com/cloudwego/eino/components/model"
@@ -64,6 +66,11 @@ type ChatModelConfig struct {
 	// Optional. Default: 2
 	RetryTimes *int `json:"retry_times"`
 
+	// Retry retries failed requests by the retry layer shared by the models and embedders, with jittered exponential
+	// backoff honoring Retry-After. If Retry is set, RetryTimes will not be used.
+	// Optional. Default: nil, requests are retried by the sdk with RetryTimes
+	Retry *retry.Config `json:"retry,omitempty"`
+
 	// BaseURL specifies the base URL for Ark service
 	// Optional. Default: "https://ark.cn-beijing.volces.com/api/v3"
 	BaseURL string `json:"base_url"`
@@ -130,6 +137,11 @@ type ChatModelConfig struct {
 
 	// ResponseFormat specifies the format that the model must output.
 	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+
+	// RepairToolCallArguments fixes malformed tool call arguments in streamed responses, e.g. trailing garbage,
+	// so that the concatenated arguments are valid json.
+	// Optional. Default: false
+	RepairToolCallArguments bool `json:"repair_tool_call_arguments,omitempty"`
 }
 
 type ResponseFormat struct {
@@ -150,14 +162,22 @@ func buildClient(config *ChatModelConfig) *arkruntime.Client {
 		config.RetryTimes = &defaultRetryTimes
 	}
 
+	retryTimes, httpClient := *config.RetryTimes, config.HTTPClient
+	if config.Retry != nil {
+		if httpClient == nil {
+			httpClient = &http.Client{Timeout: *config.Timeout}
+		}
+		retryTimes, httpClient = 0, retry.WrapHTTPClient(httpClient, config.Retry)
+	}
+
 	opts := []arkruntime.ConfigOption{
-		arkruntime.WithRetryTimes(*config.RetryTimes),
+		arkruntime.WithRetryTimes(retryTimes),
 		arkruntime.WithBaseUrl(config.BaseURL),
 		arkruntime.WithRegion(config.Region),
 		arkruntime.WithTimeout(*config.Timeout),
 	}
-	if config.HTTPClient != nil {
-		opts = append(opts, arkruntime.WithHTTPClient(config.HTTPClient))
+	if httpClient != nil {
+		opts = append(opts, arkruntime.WithHTTPClient(httpClient))
 	}
 
 	if len(config.APIKey) > 0 {
@@ -387,9 +407,21 @@ func (cm *ChatModel) Stream(ctx context.Context, in []*schema.Message, opts ...f
 
 		}()
 
+		var repairer *toolargs.StreamRepairer
+		if cm.config.RepairToolCallArguments {
+			repairer = toolargs.NewStreamRepairer()
+		}
 		for {
 			resp, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
+				if repairer != nil {
+					if fin := repairer.Finish(); fin != nil {
+						sw.Send(&fmodel.CallbackOutput{
+							Message: fin,
+							Config:  reqConf,
+						}, nil)
+					}
+				}
 				return
 			}
 
@@ -407,6 +439,9 @@ func (cm *ChatModel) Stream(ctx context.Context, in []*schema.Message, opts ...f
 			if !msgFound {
 				continue
 			}
+			if repairer != nil {
+				repairer.Process(msg)
+			}
 
 			closed := sw.Send(&fmodel.CallbackOutput{
 				Message:    msg,
@@ -746,6 +781,23 @@ func toArkContent(content string, multiContent []schema.ChatMessagePart) (*model
 					Detail: model.ImageURLDetail(part.ImageURL.Detail),
 				},
 			})
+		case schema.ChatMessagePartTypeVideoURL:
+			if part.VideoURL == nil {
+				return nil, fmt.Errorf("VideoURL field must not be nil when Type is ChatMessagePartTypeVideoURL")
+			}
+			videoURL := &model.ChatMessageVideoURL{
+				URL: part.VideoURL.URL,
+			}
+			if fps, ok := GetVideoFPS(part.VideoURL); ok {
+				videoURL.FPS = &fps
+			}
+			parts = append(parts, &model.ChatCompletionMessageContentPart{
+				Type:     model.ChatCompletionMessageContentPartTypeVideoURL,
+				VideoURL: videoURL,
+			})
+		case schema.ChatMessagePartTypeAudioURL:
+			// audio is only accepted by the realtime api of doubao speech models, not by chat completions
+			return nil, fmt.Errorf("unsupported chat message part type: %s, ark chat completions api does not accept audio input", part.Type)
 		default:
 			return nil, fmt.Errorf("unsupported chat message part type: %s", part.Type)
 		}
@@ -188,6 +188,44 @@ func Test_Generate(t *testing.T) {
 				},
 			})
 		})
+
+		PatchConvey("generate_with_video_success", func() {
+			video := &schema.ChatMessageVideoURL{URL: "https://{RL_ADDRESS}/video.mp4"}
+			SetVideoFPS(video, 0.5)
+
+			multiModalMsg := schema.UserMessage("")
+			multiModalMsg.MultiContent = []schema.ChatMessagePart{
+				{
+					Type:     schema.ChatMessagePartTypeVideoURL,
+					VideoURL: video,
+				},
+			}
+
+			req, err := toArkContent(multiModalMsg.Content, multiModalMsg.MultiContent)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(req.ListValue, convey.ShouldHaveLength, 1)
+			fps := 0.5
+			convey.So(req.ListValue[0], convey.ShouldEqual, &model.ChatCompletionMessageContentPart{
+				Type: model.ChatCompletionMessageContentPartTypeVideoURL,
+				VideoURL: &model.ChatMessageVideoURL{
+					URL: "https://{RL_ADDRESS}/video.mp4",
+					FPS: &fps,
+				},
+			})
+
+			_, err = toArkContent("", []schema.ChatMessagePart{{Type: schema.ChatMessagePartTypeVideoURL}})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		PatchConvey("generate_with_audio_fail", func() {
+			_, err := toArkContent("", []schema.ChatMessagePart{
+				{
+					Type:     schema.ChatMessagePartTypeAudioURL,
+					AudioURL: &schema.ChatMessageAudioURL{URL: "https://{RL_ADDRESS}/audio.wav"},
+				},
+			})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
 	})
 
 }
@@ -5,10 +5,12 @@ go 1.18
 require (
 	github.com/bytedance/mockey v1.2.14
 	github.com/cloudwego/eino v0.3.27
+	github.com/cloudwego/eino-ext/libs/acl/retry v0.0.0-20261016112401-7f62d5de0f0a
+	github.com/cloudwego/eino-ext/libs/acl/toolargs v0.0.0-20261016103348-1f45c6cadc76
 	github.com/getkin/kin-openapi v0.118.0
 	github.com/smartystreets/goconvey v1.8.1
 	github.com/stretchr/testify v1.9.0
-	github.com/volcengine/volcengine-go-sdk v1.1.4
+	github.com/volcengine/volcengine-go-sdk v1.1.16
 )
 
 require (
@@ -50,3 +52,9 @@ require (
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// TODO: drop the replace once libs/acl/retry is tagged, the required version is not on the main branch yet
+replace github.com/cloudwego/eino-ext/libs/acl/retry => ../../../libs/acl/retry
+
+// TODO: drop the replace once libs/acl/toolargs is tagged, the required version is not on the main branch yet
+replace github.com/cloudwego/eino-ext/libs/acl/toolargs => ../../../libs/acl/toolargs
@@ -24,6 +24,7 @@ import (
 const (
 	keyOfRequestID        = "ark-request-id"
 	keyOfReasoningContent = "ark-reasoning-content"
+	keyOfVideoFPS         = "ark-video-fps"
 )
 
 type arkRequestID string
@@ -55,3 +56,23 @@ func GetReasoningContent(msg *schema.Message) (string, bool) {
 
 	return reasoningContent, true
 }
+
+// SetVideoFPS sets the number of frames per second extracted from the video for the model to understand,
+// higher fps captures more details of fast motion and costs more tokens. Ark uses its default if not set.
+func SetVideoFPS(video *schema.ChatMessageVideoURL, fps float64) {
+	if video == nil {
+		return
+	}
+	if video.Extra == nil {
+		video.Extra = make(map[string]any)
+	}
+	video.Extra[keyOfVideoFPS] = fps
+}
+
+func GetVideoFPS(video *schema.ChatMessageVideoURL) (float64, bool) {
+	if video == nil {
+		return 0, false
+	}
+	fps, ok := video.Extra[keyOfVideoFPS].(float64)
+	return fps, ok
+}
@@ -53,3 +53,17 @@ func TestConcatMessages(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, "how are you", reasoningContent)
 }
+
+func TestVideoFPS(t *testing.T) {
+	video := &schema.ChatMessageVideoURL{URL: "https://example.com/video.mp4"}
+	_, ok := GetVideoFPS(video)
+	assert.False(t, ok)
+
+	SetVideoFPS(video, 2)
+	fps, ok := GetVideoFPS(video)
+	assert.True(t, ok)
+	assert.Equal(t, float64(2), fps)
+
+	_, ok = GetVideoFPS(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,447 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/document"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+var _ model.ToolCallingChatModel = (*ChatModel)(nil)
+
+// Config contains the configuration options for the Bedrock model.
+// Credentials are resolved by the standard AWS credential chain, e.g. environment variables, shared config files
+// and IAM roles, unless AccessKey and SecretAccessKey are set.
+type Config struct {
+	// Region is the AWS region of Bedrock runtime, e.g. "us-east-1"
+	// Optional. Default: the region of the AWS credential chain, e.g. AWS_REGION
+	Region string
+
+	// AccessKey and SecretAccessKey are static credentials, which take precedence over the credential chain
+	// Optional
+	AccessKey       string
+	SecretAccessKey string
+	// SessionToken is the session token of temporary static credentials
+	// Optional
+	SessionToken string
+
+	// Profile is the profile of the shared config files used by the credential chain
+	// Optional. Default: AWS_PROFILE or "default"
+	Profile string
+
+	// HTTPClient specifies the client to send HTTP requests
+	// Optional. Default: the http client of AWS SDK
+	HTTPClient *http.Client
+
+	// Model is the model id or the inference profile id
+	// Required. Example: "anthropic.claude-3-5-sonnet-20240620-v1:0", "amazon.nova-pro-v1:0", "meta.llama3-1-70b-instruct-v1:0"
+	Model string
+
+	// MaxTokens limits the maximum number of tokens to generate
+	// Optional. Default: the default of the model
+	MaxTokens *int
+
+	// Temperature controls randomness in generation
+	// Optional. Default: the default of the model
+	Temperature *float32
+
+	// TopP controls diversity via nucleus sampling
+	// Optional. Default: the default of the model
+	TopP *float32
+
+	// StopSequences are sequences that stop generation
+	// Optional
+	StopSequences []string
+
+	// AdditionalModelRequestFields are the model specific parameters not supported by the Converse API,
+	// e.g. {"top_k": 200} for Anthropic Claude models
+	// Optional
+	AdditionalModelRequestFields map[string]any
+}
+
+// converseClient is the part of bedrockruntime.Client used by ChatModel.
+type converseClient interface {
+	Converse(ctx context.Context, params *bedrockruntime.ConverseInput, optFns ...func(*bedrockruntime.Options)) (*bedrockruntime.ConverseOutput, error)
+	ConverseStream(ctx context.Context, params *bedrockruntime.ConverseStreamInput, optFns ...func(*bedrockruntime.Options)) (*bedrockruntime.ConverseStreamOutput, error)
+}
+
+type ChatModel struct {
+	cli converseClient
+
+	model            string
+	maxTokens        *int
+	temperature      *float32
+	topP             *float32
+	stopSequences    []string
+	additionalFields map[string]any
+	tools            []types.Tool
+	origTools        []*schema.ToolInfo
+	toolChoice       *schema.ToolChoice
+}
+
+func NewChatModel(ctx context.Context, config *Config) (*ChatModel, error) {
+	if config == nil {
+		return nil, errors.New("[NewChatModel] config not provided")
+	}
+	if len(config.Model) == 0 {
+		return nil, errors.New("[NewChatModel] model is required")
+	}
+
+	awsCfg, err := loadAWSConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("[NewChatModel] load aws config fail: %w", err)
+	}
+
+	return &ChatModel{
+		cli:              bedrockruntime.NewFromConfig(awsCfg),
+		model:            config.Model,
+		maxTokens:        config.MaxTokens,
+		temperature:      config.Temperature,
+		topP:             config.TopP,
+		stopSequences:    config.StopSequences,
+		additionalFields: config.AdditionalModelRequestFields,
+	}, nil
+}
+
+func loadAWSConfig(ctx context.Context, config *Config) (aws.Config, error) {
+	var opts []func(*awsConfig.LoadOptions) error
+	if config.Region != "" {
+		opts = append(opts, awsConfig.WithRegion(config.Region))
+	}
+	if config.SecretAccessKey != "" && config.AccessKey != "" {
+		opts = append(opts, awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			config.AccessKey,
+			config.SecretAccessKey,
+			config.SessionToken,
+		)))
+	} else if config.Profile != "" {
+		opts = append(opts, awsConfig.WithSharedConfigProfile(config.Profile))
+	}
+	if config.HTTPClient != nil {
+		opts = append(opts, awsConfig.WithHTTPClient(config.HTTPClient))
+	}
+
+	return awsConfig.LoadDefaultConfig(ctx, opts...)
+}
+
+func (cm *ChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (message *schema.Message, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, cm.GetType(), components.ComponentOfChatModel)
+	ctx = callbacks.OnStart(ctx, cm.getCallbackInput(input, opts...))
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	req, err := cm.genRequest(input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cm.cli.Converse(ctx, &bedrockruntime.ConverseInput{
+		ModelId:                      req.modelID,
+		Messages:                     req.messages,
+		System:                       req.system,
+		InferenceConfig:              req.inferenceConfig,
+		ToolConfig:                   req.toolConfig,
+		AdditionalModelRequestFields: req.additionalFields,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("converse fail: %w", err)
+	}
+	message, err = convOutputMessage(resp)
+	if err != nil {
+		return nil, fmt.Errorf("convert response to schema message fail: %w", err)
+	}
+	callbacks.OnEnd(ctx, cm.getCallbackOutput(message))
+	return message, nil
+}
+
+func (cm *ChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (result *schema.StreamReader[*schema.Message], err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, cm.GetType(), components.ComponentOfChatModel)
+	ctx = callbacks.OnStart(ctx, cm.getCallbackInput(input, opts...))
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	req, err := cm.genRequest(input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cm.cli.ConverseStream(ctx, &bedrockruntime.ConverseStreamInput{
+		ModelId:                      req.modelID,
+		Messages:                     req.messages,
+		System:                       req.system,
+		InferenceConfig:              req.inferenceConfig,
+		ToolConfig:                   req.toolConfig,
+		AdditionalModelRequestFields: req.additionalFields,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("converse stream fail: %w", err)
+	}
+	stream := resp.GetStream()
+
+	sr, sw := schema.Pipe[*model.CallbackOutput](1)
+	go func() {
+		defer func() {
+			panicErr := recover()
+
+			if panicErr != nil {
+				_ = sw.Send(nil, newPanicErr(panicErr, debug.Stack()))
+			}
+			_ = stream.Close()
+			sw.Close()
+		}()
+
+		streamCtx := &streamContext{}
+		for event := range stream.Events() {
+			message, err_ := convStreamEvent(event, streamCtx)
+			if err_ != nil {
+				_ = sw.Send(nil, fmt.Errorf("convert response chunk to schema message fail: %w", err_))
+				return
+			}
+			if message == nil {
+				continue
+			}
+			if closed := sw.Send(cm.getCallbackOutput(message), nil); closed {
+				return
+			}
+		}
+		if err_ := stream.Err(); err_ != nil {
+			_ = sw.Send(nil, err_)
+		}
+	}()
+	_, sr = callbacks.OnEndWithStreamOutput(ctx, sr)
+	return schema.StreamReaderWithConvert(sr, func(t *model.CallbackOutput) (*schema.Message, error) {
+		return t.Message, nil
+	}), nil
+}
+
+func (cm *ChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	if len(tools) == 0 {
+		return nil, errors.New("no tools to bind")
+	}
+	bTools, err := toBedrockTools(tools)
+	if err != nil {
+		return nil, fmt.Errorf("to bedrock tools fail: %w", err)
+	}
+
+	tc := schema.ToolChoiceAllowed
+	ncm := *cm
+	ncm.tools = bTools
+	ncm.toolChoice = &tc
+	ncm.origTools = tools
+	return &ncm, nil
+}
+
+func (cm *ChatModel) BindTools(tools []*schema.ToolInfo) error {
+	if len(tools) == 0 {
+		return errors.New("no tools to bind")
+	}
+	result, err := toBedrockTools(tools)
+	if err != nil {
+		return err
+	}
+
+	cm.tools = result
+	cm.origTools = tools
+	tc := schema.ToolChoiceAllowed
+	cm.toolChoice = &tc
+	return nil
+}
+
+func (cm *ChatModel) BindForcedTools(tools []*schema.ToolInfo) error {
+	if len(tools) == 0 {
+		return errors.New("no tools to bind")
+	}
+	result, err := toBedrockTools(tools)
+	if err != nil {
+		return err
+	}
+
+	cm.tools = result
+	cm.origTools = tools
+	tc := schema.ToolChoiceForced
+	cm.toolChoice = &tc
+	return nil
+}
+
+type converseRequest struct {
+	modelID          *string
+	messages         []types.Message
+	system           []types.SystemContentBlock
+	inferenceConfig  *types.InferenceConfiguration
+	toolConfig       *types.ToolConfiguration
+	additionalFields document.Interface
+}
+
+func (cm *ChatModel) genRequest(input []*schema.Message, opts ...model.Option) (*converseRequest, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("input is empty")
+	}
+
+	commonOptions := model.GetCommonOptions(&model.Options{
+		Model:       &cm.model,
+		Temperature: cm.temperature,
+		MaxTokens:   cm.maxTokens,
+		TopP:        cm.topP,
+		Stop:        cm.stopSequences,
+		Tools:       nil,
+		ToolChoice:  cm.toolChoice,
+	}, opts...)
+
+	req := &converseRequest{
+		modelID:         commonOptions.Model,
+		inferenceConfig: &types.InferenceConfiguration{},
+	}
+	if commonOptions.MaxTokens != nil {
+		req.inferenceConfig.MaxTokens = aws.Int32(int32(*commonOptions.MaxTokens))
+	}
+	req.inferenceConfig.Temperature = commonOptions.Temperature
+	req.inferenceConfig.TopP = commonOptions.TopP
+	if len(commonOptions.Stop) > 0 {
+		req.inferenceConfig.StopSequences = commonOptions.Stop
+	}
+	if len(cm.additionalFields) > 0 {
+		req.additionalFields = document.NewLazyDocument(cm.additionalFields)
+	}
+
+	tools := cm.tools
+	if commonOptions.Tools != nil {
+		var err error
+		if tools, err = toBedrockTools(commonOptions.Tools); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(tools) > 0 {
+		req.toolConfig = &types.ToolConfiguration{Tools: tools}
+	}
+
+	if commonOptions.ToolChoice != nil {
+		switch *commonOptions.ToolChoice {
+		case schema.ToolChoiceForbidden:
+			req.toolConfig = nil // act like forbid tools
+		case schema.ToolChoiceAllowed:
+			if req.toolConfig != nil {
+				req.toolConfig.ToolChoice = &types.ToolChoiceMemberAuto{}
+			}
+		case schema.ToolChoiceForced:
+			if len(tools) == 0 {
+				return nil, fmt.Errorf("tool choice is forced but tool is not provided")
+			} else if len(tools) == 1 {
+				req.toolConfig.ToolChoice = &types.ToolChoiceMemberTool{
+					Value: types.SpecificToolChoice{Name: tools[0].(*types.ToolMemberToolSpec).Value.Name},
+				}
+			} else {
+				req.toolConfig.ToolChoice = &types.ToolChoiceMemberAny{}
+			}
+		default:
+			return nil, fmt.Errorf("tool choice=%s not support", *commonOptions.ToolChoice)
+		}
+	}
+
+	var err error
+	req.system, req.messages, err = convSchemaMessages(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (cm *ChatModel) getCallbackInput(input []*schema.Message, opts ...model.Option) *model.CallbackInput {
+	result := &model.CallbackInput{
+		Messages: input,
+		Tools: model.GetCommonOptions(&model.Options{
+			Tools: cm.origTools,
+		}, opts...).Tools,
+		Config: cm.getConfig(),
+	}
+	return result
+}
+
+func (cm *ChatModel) getCallbackOutput(output *schema.Message) *model.CallbackOutput {
+	result := &model.CallbackOutput{
+		Message: output,
+		Config:  cm.getConfig(),
+	}
+	if output.ResponseMeta != nil && output.ResponseMeta.Usage != nil {
+		result.TokenUsage = &model.TokenUsage{
+			PromptTokens:     output.ResponseMeta.Usage.PromptTokens,
+			CompletionTokens: output.ResponseMeta.Usage.CompletionTokens,
+			TotalTokens:      output.ResponseMeta.Usage.TotalTokens,
+		}
+	}
+	return result
+}
+
+func (cm *ChatModel) getConfig() *model.Config {
+	result := &model.Config{
+		Model: cm.model,
+		Stop:  cm.stopSequences,
+	}
+	if cm.maxTokens != nil {
+		result.MaxTokens = *cm.maxTokens
+	}
+	if cm.temperature != nil {
+		result.Temperature = *cm.temperature
+	}
+	if cm.topP != nil {
+		result.TopP = *cm.topP
+	}
+	return result
+}
+
+func (cm *ChatModel) GetType() string {
+	return "Bedrock"
+}
+
+func (cm *ChatModel) IsCallbacksEnabled() bool {
+	return true
+}
+
+type panicErr struct {
+	info  any
+	stack []byte
+}
+
+func (p *panicErr) Error() string {
+	return fmt.Sprintf("panic error: %v, \nstack: %s", p.info, string(p.stack))
+}
+
+func newPanicErr(info any, stack []byte) error {
+	return &panicErr{
+		info:  info,
+		stack: stack,
+	}
+}
@@ -0,0 +1,234 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/document"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type mockClient struct {
+	input *bedrockruntime.ConverseInput
+	resp  *bedrockruntime.ConverseOutput
+}
+
+func (m *mockClient) Converse(ctx context.Context, params *bedrockruntime.ConverseInput, optFns ...func(*bedrockruntime.Options)) (*bedrockruntime.ConverseOutput, error) {
+	m.input = params
+	return m.resp, nil
+}
+
+func (m *mockClient) ConverseStream(ctx context.Context, params *bedrockruntime.ConverseStreamInput, optFns ...func(*bedrockruntime.Options)) (*bedrockruntime.ConverseStreamOutput, error) {
+	panic("not implemented")
+}
+
+func TestBedrock(t *testing.T) {
+	ctx := context.Background()
+	weatherTool := &schema.ToolInfo{
+		Name: "get_weather",
+		Desc: "get the weather of a city",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"city": {Type: schema.String, Required: true},
+		}),
+	}
+
+	convey.Convey("test NewChatModel", t, func() {
+		_, err := NewChatModel(ctx, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, err = NewChatModel(ctx, &Config{Region: "us-east-1"})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		cm, err := NewChatModel(ctx, &Config{
+			Region:          "us-east-1",
+			AccessKey:       "ak",
+			SecretAccessKey: "sk",
+			Model:           "amazon.nova-pro-v1:0",
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cm.GetType(), convey.ShouldEqual, "Bedrock")
+	})
+
+	convey.Convey("test Generate", t, func() {
+		cli := &mockClient{resp: &bedrockruntime.ConverseOutput{
+			Output: &types.ConverseOutputMemberMessage{Value: types.Message{
+				Role: types.ConversationRoleAssistant,
+				Content: []types.ContentBlock{
+					&types.ContentBlockMemberText{Value: "let me check"},
+					&types.ContentBlockMemberToolUse{Value: types.ToolUseBlock{
+						ToolUseId: aws.String("tooluse_1"),
+						Name:      aws.String("get_weather"),
+						Input:     document.NewLazyDocument(map[string]any{"city": "Paris"}),
+					}},
+				},
+			}},
+			StopReason: types.StopReasonToolUse,
+			Usage: &types.TokenUsage{
+				InputTokens:  aws.Int32(10),
+				OutputTokens: aws.Int32(5),
+				TotalTokens:  aws.Int32(15),
+			},
+		}}
+		maxTokens := 100
+		cm := &ChatModel{cli: cli, model: "amazon.nova-pro-v1:0", maxTokens: &maxTokens}
+		tcm, err := cm.WithTools([]*schema.ToolInfo{weatherTool})
+		convey.So(err, convey.ShouldBeNil)
+
+		msg, err := tcm.Generate(ctx, []*schema.Message{
+			schema.SystemMessage("you are a weather assistant"),
+			schema.UserMessage("what's the weather in Paris?"),
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(msg.Content, convey.ShouldEqual, "let me check")
+		convey.So(len(msg.ToolCalls), convey.ShouldEqual, 1)
+		convey.So(msg.ToolCalls[0].ID, convey.ShouldEqual, "tooluse_1")
+		convey.So(msg.ToolCalls[0].Function.Name, convey.ShouldEqual, "get_weather")
+		convey.So(msg.ToolCalls[0].Function.Arguments, convey.ShouldEqual, `{"city":"Paris"}`)
+		convey.So(msg.ResponseMeta.FinishReason, convey.ShouldEqual, "tool_use")
+		convey.So(msg.ResponseMeta.Usage.TotalTokens, convey.ShouldEqual, 15)
+
+		convey.So(aws.ToString(cli.input.ModelId), convey.ShouldEqual, "amazon.nova-pro-v1:0")
+		convey.So(aws.ToInt32(cli.input.InferenceConfig.MaxTokens), convey.ShouldEqual, 100)
+		convey.So(len(cli.input.System), convey.ShouldEqual, 1)
+		convey.So(len(cli.input.Messages), convey.ShouldEqual, 1)
+		convey.So(len(cli.input.ToolConfig.Tools), convey.ShouldEqual, 1)
+		_, ok := cli.input.ToolConfig.ToolChoice.(*types.ToolChoiceMemberAuto)
+		convey.So(ok, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("test genRequest", t, func() {
+		cm := &ChatModel{model: "amazon.nova-pro-v1:0"}
+
+		convey.Convey("merge messages", func() {
+			req, err := cm.genRequest([]*schema.Message{
+				schema.UserMessage("what's the weather in Paris?"),
+				schema.AssistantMessage("", []schema.ToolCall{{
+					ID:       "tooluse_1",
+					Function: schema.FunctionCall{Name: "get_weather", Arguments: `{"city":"Paris"}`},
+				}}),
+				schema.ToolMessage("sunny", "tooluse_1"),
+				schema.UserMessage("and London?"),
+			}, model.WithModel("anthropic.claude-3-5-sonnet-20240620-v1:0"), model.WithStop([]string{"\n"}))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(aws.ToString(req.modelID), convey.ShouldEqual, "anthropic.claude-3-5-sonnet-20240620-v1:0")
+			convey.So(req.inferenceConfig.StopSequences, convey.ShouldResemble, []string{"\n"})
+			convey.So(len(req.messages), convey.ShouldEqual, 3)
+			convey.So(req.messages[1].Role, convey.ShouldEqual, types.ConversationRoleAssistant)
+			convey.So(req.messages[2].Role, convey.ShouldEqual, types.ConversationRoleUser)
+			convey.So(len(req.messages[2].Content), convey.ShouldEqual, 2)
+			result, ok := req.messages[2].Content[0].(*types.ContentBlockMemberToolResult)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(aws.ToString(result.Value.ToolUseId), convey.ShouldEqual, "tooluse_1")
+		})
+
+		convey.Convey("image", func() {
+			req, err := cm.genRequest([]*schema.Message{{
+				Role: schema.User,
+				MultiContent: []schema.ChatMessagePart{
+					{Type: schema.ChatMessagePartTypeText, Text: "what's in the image?"},
+					{Type: schema.ChatMessagePartTypeImageURL, ImageURL: &schema.ChatMessageImageURL{URL: "data:image/png;base64,aGVsbG8="}},
+				},
+			}})
+			convey.So(err, convey.ShouldBeNil)
+			image, ok := req.messages[0].Content[1].(*types.ContentBlockMemberImage)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(image.Value.Format, convey.ShouldEqual, types.ImageFormatPng)
+			convey.So(string(image.Value.Source.(*types.ImageSourceMemberBytes).Value), convey.ShouldEqual, "hello")
+
+			_, err = cm.genRequest([]*schema.Message{{
+				Role: schema.User,
+				MultiContent: []schema.ChatMessagePart{
+					{Type: schema.ChatMessagePartTypeImageURL, ImageURL: &schema.ChatMessageImageURL{URL: "https://example.com/a.png"}},
+				},
+			}})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("tool choice", func() {
+			convey.So(cm.BindForcedTools([]*schema.ToolInfo{weatherTool}), convey.ShouldBeNil)
+			req, err := cm.genRequest([]*schema.Message{schema.UserMessage("hi")})
+			convey.So(err, convey.ShouldBeNil)
+			choice, ok := req.toolConfig.ToolChoice.(*types.ToolChoiceMemberTool)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(aws.ToString(choice.Value.Name), convey.ShouldEqual, "get_weather")
+
+			req, err = cm.genRequest([]*schema.Message{schema.UserMessage("hi")}, model.WithToolChoice(schema.ToolChoiceForbidden))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(req.toolConfig, convey.ShouldBeNil)
+		})
+
+		convey.Convey("only system messages", func() {
+			_, err := cm.genRequest([]*schema.Message{schema.SystemMessage("hi")})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+
+	convey.Convey("test convStreamEvent", t, func() {
+		streamCtx := &streamContext{}
+		events := []types.ConverseStreamOutput{
+			&types.ConverseStreamOutputMemberMessageStart{Value: types.MessageStartEvent{Role: types.ConversationRoleAssistant}},
+			&types.ConverseStreamOutputMemberContentBlockDelta{Value: types.ContentBlockDeltaEvent{
+				ContentBlockIndex: aws.Int32(0),
+				Delta:             &types.ContentBlockDeltaMemberText{Value: "let me check"},
+			}},
+			&types.ConverseStreamOutputMemberContentBlockStop{Value: types.ContentBlockStopEvent{ContentBlockIndex: aws.Int32(0)}},
+			&types.ConverseStreamOutputMemberContentBlockStart{Value: types.ContentBlockStartEvent{
+				ContentBlockIndex: aws.Int32(1),
+				Start: &types.ContentBlockStartMemberToolUse{Value: types.ToolUseBlockStart{
+					Name:      aws.String("get_weather"),
+					ToolUseId: aws.String("tooluse_1"),
+				}},
+			}},
+			&types.ConverseStreamOutputMemberContentBlockDelta{Value: types.ContentBlockDeltaEvent{
+				ContentBlockIndex: aws.Int32(1),
+				Delta:             &types.ContentBlockDeltaMemberToolUse{Value: types.ToolUseBlockDelta{Input: aws.String(`{"city":`)}},
+			}},
+			&types.ConverseStreamOutputMemberContentBlockDelta{Value: types.ContentBlockDeltaEvent{
+				ContentBlockIndex: aws.Int32(1),
+				Delta:             &types.ContentBlockDeltaMemberToolUse{Value: types.ToolUseBlockDelta{Input: aws.String(`"Paris"}`)}},
+			}},
+			&types.ConverseStreamOutputMemberMessageStop{Value: types.MessageStopEvent{StopReason: types.StopReasonToolUse}},
+			&types.ConverseStreamOutputMemberMetadata{Value: types.ConverseStreamMetadataEvent{
+				Usage: &types.TokenUsage{InputTokens: aws.Int32(10), OutputTokens: aws.Int32(5), TotalTokens: aws.Int32(15)},
+			}},
+		}
+
+		var msgs []*schema.Message
+		for _, event := range events {
+			msg, err := convStreamEvent(event, streamCtx)
+			convey.So(err, convey.ShouldBeNil)
+			if msg != nil {
+				msgs = append(msgs, msg)
+			}
+		}
+		msg, err := schema.ConcatMessages(msgs)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(msg.Content, convey.ShouldEqual, "let me check")
+		convey.So(len(msg.ToolCalls), convey.ShouldEqual, 1)
+		convey.So(msg.ToolCalls[0].ID, convey.ShouldEqual, "tooluse_1")
+		convey.So(msg.ToolCalls[0].Function.Arguments, convey.ShouldEqual, `{"city":"Paris"}`)
+		convey.So(msg.ResponseMeta.FinishReason, convey.ShouldEqual, "tool_use")
+		convey.So(msg.ResponseMeta.Usage.TotalTokens, convey.ShouldEqual, 15)
+	})
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/bedrock"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// credentials are resolved by the AWS credential chain, e.g. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	cm, err := bedrock.NewChatModel(ctx, &bedrock.Config{
+		Region: "us-east-1",
+		Model:  "anthropic.claude-3-5-sonnet-20240620-v1:0",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of bedrock failed, err=%v", err)
+	}
+
+	msg, err := cm.Generate(ctx, []*schema.Message{
+		schema.SystemMessage("you are a helpful assistant"),
+		schema.UserMessage("what is the capital of France?"),
+	})
+	if err != nil {
+		log.Fatalf("Generate of bedrock failed, err=%v", err)
+	}
+
+	fmt.Println(msg.Content)
+	fmt.Printf("usage: %+v\n", msg.ResponseMeta.Usage)
+}
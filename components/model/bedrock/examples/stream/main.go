@@ -0,0 +1,62 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/bedrock"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := bedrock.NewChatModel(ctx, &bedrock.Config{
+		Region:  "us-east-1",
+		Profile: "bedrock", // a profile of ~/.aws/config
+		Model:   "amazon.nova-pro-v1:0",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of bedrock failed, err=%v", err)
+	}
+
+	sr, err := cm.Stream(ctx, []*schema.Message{
+		schema.UserMessage("write a haiku about the sea"),
+	})
+	if err != nil {
+		log.Fatalf("Stream of bedrock failed, err=%v", err)
+	}
+	defer sr.Close()
+
+	for {
+		msg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Recv of bedrock failed, err=%v", err)
+		}
+		fmt.Print(msg.Content)
+	}
+	fmt.Println()
+}
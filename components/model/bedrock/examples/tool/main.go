@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/bedrock"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := bedrock.NewChatModel(ctx, &bedrock.Config{
+		Region:          "us-east-1",
+		AccessKey:       os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		Model:           "anthropic.claude-3-5-sonnet-20240620-v1:0",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of bedrock failed, err=%v", err)
+	}
+
+	tcm, err := cm.WithTools([]*schema.ToolInfo{{
+		Name: "get_weather",
+		Desc: "get the weather of a city",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"city": {Type: schema.String, Desc: "name of the city", Required: true},
+		}),
+	}})
+	if err != nil {
+		log.Fatalf("WithTools of bedrock failed, err=%v", err)
+	}
+
+	input := []*schema.Message{
+		schema.UserMessage("what's the weather in Paris?"),
+	}
+	msg, err := tcm.Generate(ctx, input)
+	if err != nil {
+		log.Fatalf("Generate of bedrock failed, err=%v", err)
+	}
+	if len(msg.ToolCalls) == 0 {
+		fmt.Println(msg.Content)
+		return
+	}
+
+	input = append(input, msg)
+	for _, tc := range msg.ToolCalls {
+		fmt.Printf("call %s with %s\n", tc.Function.Name, tc.Function.Arguments)
+		input = append(input, schema.ToolMessage(`{"weather": "sunny", "temperature": 22}`, tc.ID))
+	}
+
+	msg, err = tcm.Generate(ctx, input)
+	if err != nil {
+		log.Fatalf("Generate of bedrock failed, err=%v", err)
+	}
+	fmt.Println(msg.Content)
+}
@@ -0,0 +1,354 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bedrock
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/document"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	"github.com/cloudwego/eino/schema"
+)
+
+func toBedrockTools(tools []*schema.ToolInfo) ([]types.Tool, error) {
+	result := make([]types.Tool, 0, len(tools))
+	for _, tool := range tools {
+		inputSchema := map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		}
+		if tool.ParamsOneOf != nil {
+			s, err := tool.ParamsOneOf.ToOpenAPIV3()
+			if err != nil {
+				return nil, fmt.Errorf("convert tool params to openapi schema fail: %w", err)
+			}
+			if s != nil {
+				// the document encoder uses `document` struct tags instead of json tags, so the schema is converted to a map first
+				b, err := json.Marshal(s)
+				if err != nil {
+					return nil, fmt.Errorf("marshal tool schema fail: %w", err)
+				}
+				inputSchema = map[string]any{}
+				if err = json.Unmarshal(b, &inputSchema); err != nil {
+					return nil, fmt.Errorf("unmarshal tool schema fail: %w", err)
+				}
+			}
+		}
+
+		spec := types.ToolSpecification{
+			Name:        aws.String(tool.Name),
+			InputSchema: &types.ToolInputSchemaMemberJson{Value: document.NewLazyDocument(inputSchema)},
+		}
+		if tool.Desc != "" {
+			spec.Description = aws.String(tool.Desc)
+		}
+		result = append(result, &types.ToolMemberToolSpec{Value: spec})
+	}
+	return result, nil
+}
+
+// convSchemaMessages converts the input messages to the system prompts and the conversation of Converse API.
+// Tool messages are sent as tool results of user messages, and consecutive messages of the same role are merged,
+// as Converse API requires the roles to alternate.
+func convSchemaMessages(input []*schema.Message) ([]types.SystemContentBlock, []types.Message, error) {
+	var system []types.SystemContentBlock
+	var messages []types.Message
+	for _, message := range input {
+		if message.Role == schema.System {
+			system = append(system, &types.SystemContentBlockMemberText{Value: message.Content})
+			continue
+		}
+		if isMessageEmpty(message) {
+			continue
+		}
+
+		content, err := convSchemaMessage(message)
+		if err != nil {
+			return nil, nil, fmt.Errorf("convert schema message fail: %w", err)
+		}
+
+		role := types.ConversationRoleUser
+		if message.Role == schema.Assistant {
+			role = types.ConversationRoleAssistant
+		}
+		if len(messages) > 0 && messages[len(messages)-1].Role == role {
+			messages[len(messages)-1].Content = append(messages[len(messages)-1].Content, content...)
+			continue
+		}
+		messages = append(messages, types.Message{
+			Role:    role,
+			Content: content,
+		})
+	}
+	if len(messages) == 0 {
+		return nil, nil, fmt.Errorf("no message to send except system messages")
+	}
+	return system, messages, nil
+}
+
+func convSchemaMessage(message *schema.Message) ([]types.ContentBlock, error) {
+	var result []types.ContentBlock
+	if message.Role == schema.Tool {
+		return append(result, &types.ContentBlockMemberToolResult{
+			Value: types.ToolResultBlock{
+				ToolUseId: aws.String(message.ToolCallID),
+				Content: []types.ToolResultContentBlock{
+					&types.ToolResultContentBlockMemberText{Value: message.Content},
+				},
+			},
+		}), nil
+	}
+
+	if len(message.Content) > 0 {
+		result = append(result, &types.ContentBlockMemberText{Value: message.Content})
+	} else {
+		for i := range message.MultiContent {
+			switch message.MultiContent[i].Type {
+			case schema.ChatMessagePartTypeText:
+				result = append(result, &types.ContentBlockMemberText{Value: message.MultiContent[i].Text})
+			case schema.ChatMessagePartTypeImageURL:
+				if message.MultiContent[i].ImageURL == nil {
+					continue
+				}
+				image, err := convImage(message.MultiContent[i].ImageURL.URL)
+				if err != nil {
+					return nil, fmt.Errorf("extract base64 image fail: %w", err)
+				}
+				result = append(result, &types.ContentBlockMemberImage{Value: *image})
+			default:
+				return nil, fmt.Errorf("bedrock message type not supported: %s", message.MultiContent[i].Type)
+			}
+		}
+	}
+
+	for i := range message.ToolCalls {
+		var input any = map[string]any{}
+		if len(message.ToolCalls[i].Function.Arguments) > 0 {
+			if err := json.Unmarshal([]byte(message.ToolCalls[i].Function.Arguments), &input); err != nil {
+				return nil, fmt.Errorf("unmarshal arguments of tool call %s fail: %w", message.ToolCalls[i].ID, err)
+			}
+		}
+		result = append(result, &types.ContentBlockMemberToolUse{
+			Value: types.ToolUseBlock{
+				ToolUseId: aws.String(message.ToolCalls[i].ID),
+				Name:      aws.String(message.ToolCalls[i].Function.Name),
+				Input:     document.NewLazyDocument(input),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+func convOutputMessage(resp *bedrockruntime.ConverseOutput) (*schema.Message, error) {
+	message := &schema.Message{
+		Role: schema.Assistant,
+		ResponseMeta: &schema.ResponseMeta{
+			FinishReason: string(resp.StopReason),
+			Usage:        convUsage(resp.Usage),
+		},
+	}
+
+	output, ok := resp.Output.(*types.ConverseOutputMemberMessage)
+	if !ok {
+		return nil, fmt.Errorf("unknown bedrock output type: %T", resp.Output)
+	}
+	for _, item := range output.Value.Content {
+		switch block := item.(type) {
+		case *types.ContentBlockMemberText:
+			message.Content += block.Value
+		case *types.ContentBlockMemberToolUse:
+			arguments, err := convDocument(block.Value.Input)
+			if err != nil {
+				return nil, fmt.Errorf("convert tool use input fail: %w", err)
+			}
+			message.ToolCalls = append(message.ToolCalls, schema.ToolCall{
+				ID:   aws.ToString(block.Value.ToolUseId),
+				Type: "function",
+				Function: schema.FunctionCall{
+					Name:      aws.ToString(block.Value.Name),
+					Arguments: arguments,
+				},
+			})
+		case *types.ContentBlockMemberReasoningContent:
+			// reasoning content is not supported by schema.Message yet
+		default:
+			return nil, fmt.Errorf("unknown bedrock content block type: %T", item)
+		}
+	}
+
+	return message, nil
+}
+
+type streamContext struct {
+	toolIndex *int
+	// blockToolIndex maps the content block index to the index of the tool call
+	blockToolIndex map[int32]int
+}
+
+func convStreamEvent(event types.ConverseStreamOutput, streamCtx *streamContext) (*schema.Message, error) {
+	result := &schema.Message{
+		Role: schema.Assistant,
+	}
+
+	switch e := event.(type) {
+	case *types.ConverseStreamOutputMemberMessageStart, *types.ConverseStreamOutputMemberContentBlockStop:
+		return nil, nil
+
+	case *types.ConverseStreamOutputMemberMessageStop:
+		result.ResponseMeta = &schema.ResponseMeta{
+			FinishReason: string(e.Value.StopReason),
+		}
+		return result, nil
+
+	case *types.ConverseStreamOutputMemberMetadata:
+		if e.Value.Usage == nil {
+			return nil, nil
+		}
+		result.ResponseMeta = &schema.ResponseMeta{
+			Usage: convUsage(e.Value.Usage),
+		}
+		return result, nil
+
+	case *types.ConverseStreamOutputMemberContentBlockStart:
+		start, ok := e.Value.Start.(*types.ContentBlockStartMemberToolUse)
+		if !ok {
+			return nil, nil
+		}
+		num := 0
+		if streamCtx.toolIndex != nil {
+			num = *streamCtx.toolIndex + 1
+		}
+		streamCtx.toolIndex = &num
+		if streamCtx.blockToolIndex == nil {
+			streamCtx.blockToolIndex = map[int32]int{}
+		}
+		streamCtx.blockToolIndex[aws.ToInt32(e.Value.ContentBlockIndex)] = num
+
+		result.ToolCalls = append(result.ToolCalls, schema.ToolCall{
+			Index: &num,
+			ID:    aws.ToString(start.Value.ToolUseId),
+			Type:  "function",
+			Function: schema.FunctionCall{
+				Name: aws.ToString(start.Value.Name),
+			},
+		})
+		return result, nil
+
+	case *types.ConverseStreamOutputMemberContentBlockDelta:
+		switch delta := e.Value.Delta.(type) {
+		case *types.ContentBlockDeltaMemberText:
+			result.Content = delta.Value
+
+		case *types.ContentBlockDeltaMemberToolUse:
+			num, ok := streamCtx.blockToolIndex[aws.ToInt32(e.Value.ContentBlockIndex)]
+			if !ok {
+				return nil, fmt.Errorf("tool use delta of unknown content block: %d", aws.ToInt32(e.Value.ContentBlockIndex))
+			}
+			result.ToolCalls = append(result.ToolCalls, schema.ToolCall{
+				Index: &num,
+				Function: schema.FunctionCall{
+					Arguments: aws.ToString(delta.Value.Input),
+				},
+			})
+
+		default:
+			return nil, nil
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unknown stream event type: %T", e)
+	}
+}
+
+func convUsage(usage *types.TokenUsage) *schema.TokenUsage {
+	if usage == nil {
+		return nil
+	}
+	return &schema.TokenUsage{
+		PromptTokens:     int(aws.ToInt32(usage.InputTokens)),
+		CompletionTokens: int(aws.ToInt32(usage.OutputTokens)),
+		TotalTokens:      int(aws.ToInt32(usage.TotalTokens)),
+	}
+}
+
+func convDocument(doc document.Interface) (string, error) {
+	if doc == nil {
+		return "", nil
+	}
+	b, err := doc.MarshalSmithyDocument()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func convImage(data string) (*types.ImageBlock, error) {
+	if !strings.HasPrefix(data, "data:") {
+		return nil, fmt.Errorf("invalid base64 image: %s", data)
+	}
+	contents := strings.SplitN(data[5:], ",", 2)
+	if len(contents) != 2 {
+		return nil, fmt.Errorf("invalid base64 image: %s", data)
+	}
+	headParts := strings.Split(contents[0], ";")
+	bBase64 := false
+	for _, part := range headParts {
+		if part == "base64" {
+			bBase64 = true
+		}
+	}
+	if !bBase64 {
+		return nil, fmt.Errorf("invalid base64 image: %s", data)
+	}
+
+	var format types.ImageFormat
+	switch headParts[0] {
+	case "image/png":
+		format = types.ImageFormatPng
+	case "image/jpeg", "image/jpg":
+		format = types.ImageFormatJpeg
+	case "image/gif":
+		format = types.ImageFormatGif
+	case "image/webp":
+		format = types.ImageFormatWebp
+	default:
+		return nil, fmt.Errorf("image type not supported: %s", headParts[0])
+	}
+
+	b, err := base64.StdEncoding.DecodeString(contents[1])
+	if err != nil {
+		return nil, fmt.Errorf("decode base64 image fail: %w", err)
+	}
+	return &types.ImageBlock{
+		Format: format,
+		Source: &types.ImageSourceMemberBytes{Value: b},
+	}, nil
+}
+
+func isMessageEmpty(message *schema.Message) bool {
+	if len(message.Content) == 0 && len(message.ToolCalls) == 0 && len(message.MultiContent) == 0 {
+		return true
+	}
+	return false
+}
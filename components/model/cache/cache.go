@@ -0,0 +1,335 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultLRUCapacity         = 1000
+	defaultSimilarityThreshold = 0.95
+
+	extraKeyCacheHit = "_eino_ext_cache_hit"
+)
+
+// Cacher is the storage backend of cached responses.
+type Cacher interface {
+	// Get returns the response cached under key, found is false if key is not cached.
+	// The returned message is owned by the caller, it must not be shared with other Get calls.
+	Get(ctx context.Context, key string) (msg *schema.Message, found bool, err error)
+	// Set caches msg under key.
+	Set(ctx context.Context, key string, msg *schema.Message) error
+}
+
+// SemanticCacher is a Cacher that could also look up responses by the similarity of input embeddings.
+type SemanticCacher interface {
+	Cacher
+	// Search returns the response added under scope whose vector is the most similar to vector,
+	// together with their cosine similarity. found is false if nothing was added under scope.
+	// Like Get, the returned message is owned by the caller.
+	Search(ctx context.Context, scope string, vector []float64) (msg *schema.Message, similarity float64, found bool, err error)
+	// Add caches msg with the vector of its input under scope.
+	Add(ctx context.Context, scope string, vector []float64, msg *schema.Message) error
+}
+
+type Config struct {
+	// ChatModel is the underlying chat model, whose responses are cached.
+	// Required.
+	ChatModel model.ToolCallingChatModel
+	// Cacher is the cache backend, see NewLRUCacher and NewRedisCacher.
+	// It must implement SemanticCacher if Embedder is set.
+	// Optional. Default: in-memory lru cache with 1000 entries.
+	Cacher Cacher
+	// Model is the model name of ChatModel, which is part of cache key,
+	// so that responses from different models won't be mixed up.
+	// Could be overridden by model.WithModel.
+	// Required if ChatModel is shared by different models.
+	Model string
+	// Embedder enables semantic cache: when no response is cached for the exact input,
+	// the last user message is embedded and compared with those of cached responses
+	// sharing the same preceding messages and options.
+	// Optional. Default: nil, exact match only.
+	Embedder embedding.Embedder
+	// SimilarityThreshold is the minimum cosine similarity for a semantic cache hit.
+	// Optional. Default: 0.95
+	SimilarityThreshold float64
+	// IgnoreCacheError continues generating without cache when Cacher or Embedder returns error.
+	// Optional. Default: false
+	IgnoreCacheError bool
+}
+
+var _ model.ToolCallingChatModel = (*ChatModel)(nil)
+
+// ChatModel wraps a model.ToolCallingChatModel, inputs answered before are replied from cache,
+// and only missed ones are sent to the underlying chat model.
+type ChatModel struct {
+	config   *Config
+	cm       model.ToolCallingChatModel
+	tools    []*schema.ToolInfo
+	semantic SemanticCacher
+}
+
+func NewChatModel(_ context.Context, config *Config) (*ChatModel, error) {
+	if config == nil || config.ChatModel == nil {
+		return nil, errors.New("[NewChatModel] chat model not provided")
+	}
+	if config.SimilarityThreshold < 0 || config.SimilarityThreshold > 1 {
+		return nil, errors.New("[NewChatModel] similarity threshold must be in [0, 1]")
+	}
+
+	if config.Cacher == nil {
+		config.Cacher = NewLRUCacher(defaultLRUCapacity)
+	}
+	if config.SimilarityThreshold == 0 {
+		config.SimilarityThreshold = defaultSimilarityThreshold
+	}
+
+	c := &ChatModel{config: config, cm: config.ChatModel}
+	if config.Embedder != nil {
+		semantic, ok := config.Cacher.(SemanticCacher)
+		if !ok {
+			return nil, errors.New("[NewChatModel] cacher does not implement SemanticCacher")
+		}
+		c.semantic = semantic
+	}
+
+	return c, nil
+}
+
+func (c *ChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	l, err := c.lookup(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+	if l.hit != nil {
+		return l.hit, nil
+	}
+
+	out, err := c.cm.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.store(ctx, l, out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Stream replies a cache hit as a stream of one chunk. On miss, chunks are passed through
+// and the concatenated response is cached once the stream ends without error, so responses
+// whose stream is closed early by the caller are not cached.
+func (c *ChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	l, err := c.lookup(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+	if l.hit != nil {
+		return schema.StreamReaderFromArray([]*schema.Message{l.hit}), nil
+	}
+
+	sr, err := c.cm.Stream(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if l.skip {
+		return sr, nil
+	}
+
+	r, w := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer func() {
+			sr.Close()
+			w.Close()
+		}()
+
+		var chunks []*schema.Message
+		for {
+			chunk, err := sr.Recv()
+			if err == io.EOF {
+				break
+			}
+			if closed := w.Send(chunk, err); closed || err != nil {
+				return
+			}
+			chunks = append(chunks, chunk)
+		}
+
+		if len(chunks) == 0 {
+			return
+		}
+		out, err := schema.ConcatMessages(chunks)
+		if err != nil {
+			if !c.config.IgnoreCacheError {
+				w.Send(nil, fmt.Errorf("[cache] concat stream failed: %w", err))
+			}
+			return
+		}
+		if err = c.store(ctx, l, out); err != nil {
+			w.Send(nil, err)
+		}
+	}()
+
+	return r, nil
+}
+
+func (c *ChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	cm, err := c.cm.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatModel{
+		config:   c.config,
+		cm:       cm,
+		tools:    tools,
+		semantic: c.semantic,
+	}, nil
+}
+
+type lookup struct {
+	skip   bool
+	key    string
+	scope  string
+	vector []float64
+	hit    *schema.Message
+}
+
+func (c *ChatModel) lookup(ctx context.Context, input []*schema.Message, opts []model.Option) (*lookup, error) {
+	if model.GetImplSpecificOptions(&options{}, opts...).skipCache {
+		return &lookup{skip: true}, nil
+	}
+
+	common := model.GetCommonOptions(&model.Options{
+		Model: &c.config.Model,
+		Tools: c.tools,
+	}, opts...)
+
+	keys, err := buildKeys(input, common)
+	if err != nil {
+		return nil, fmt.Errorf("[cache] build cache key failed: %w", err)
+	}
+
+	l := &lookup{key: keys.exact}
+	msg, found, err := c.config.Cacher.Get(ctx, keys.exact)
+	if err != nil && !c.config.IgnoreCacheError {
+		return nil, fmt.Errorf("[cache] get cache failed: %w", err)
+	}
+	if found {
+		l.hit = markHit(msg)
+		return l, nil
+	}
+
+	if c.semantic == nil || len(keys.text) == 0 {
+		return l, nil
+	}
+
+	vectors, err := c.config.Embedder.EmbedStrings(ctx, []string{keys.text})
+	if err == nil && len(vectors) != 1 {
+		err = fmt.Errorf("invalid embedding length, expected=1, got=%d", len(vectors))
+	}
+	if err != nil {
+		if c.config.IgnoreCacheError {
+			return l, nil
+		}
+		return nil, fmt.Errorf("[cache] embed input failed: %w", err)
+	}
+	l.scope, l.vector = keys.scope, vectors[0]
+
+	msg, similarity, found, err := c.semantic.Search(ctx, l.scope, l.vector)
+	if err != nil && !c.config.IgnoreCacheError {
+		return nil, fmt.Errorf("[cache] search cache failed: %w", err)
+	}
+	if found && similarity >= c.config.SimilarityThreshold {
+		l.hit = markHit(msg)
+	}
+
+	return l, nil
+}
+
+func (c *ChatModel) store(ctx context.Context, l *lookup, msg *schema.Message) error {
+	if l.skip {
+		return nil
+	}
+
+	if err := c.config.Cacher.Set(ctx, l.key, msg); err != nil && !c.config.IgnoreCacheError {
+		return fmt.Errorf("[cache] set cache failed: %w", err)
+	}
+	if l.vector == nil {
+		return nil
+	}
+	if err := c.semantic.Add(ctx, l.scope, l.vector, msg); err != nil && !c.config.IgnoreCacheError {
+		return fmt.Errorf("[cache] add semantic cache failed: %w", err)
+	}
+
+	return nil
+}
+
+func markHit(msg *schema.Message) *schema.Message {
+	if msg.Extra == nil {
+		msg.Extra = make(map[string]any, 1)
+	}
+	msg.Extra[extraKeyCacheHit] = true
+	return msg
+}
+
+// IsCacheHit reports whether msg is replied from cache rather than generated by the underlying chat model.
+func IsCacheHit(msg *schema.Message) bool {
+	if msg == nil {
+		return false
+	}
+	hit, _ := msg.Extra[extraKeyCacheHit].(bool)
+	return hit
+}
+
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+const typ = "Cache"
+
+func (c *ChatModel) GetType() string {
+	return typ
+}
+
+// IsCallbacksEnabled returns true, callbacks are triggered by the underlying chat model when cache is missed.
+func (c *ChatModel) IsCallbacksEnabled() bool {
+	return true
+}
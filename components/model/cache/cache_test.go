@@ -0,0 +1,330 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	calls int32
+	err   error
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	atomic.AddInt32(&m.calls, 1)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return schema.AssistantMessage("re: "+input[len(input)-1].Content, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	atomic.AddInt32(&m.calls, 1)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{
+		schema.AssistantMessage("re", nil),
+		schema.AssistantMessage(": ", nil),
+		schema.AssistantMessage(input[len(input)-1].Content, nil),
+	}), nil
+}
+
+func (m *mockChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+func (m *mockChatModel) Calls() int {
+	return int(atomic.LoadInt32(&m.calls))
+}
+
+type mockEmbedder struct {
+	vectors map[string][]float64
+	err     error
+}
+
+func (m *mockEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = m.vectors[text]
+	}
+	return vectors, nil
+}
+
+type errCacher struct{}
+
+func (errCacher) Get(ctx context.Context, key string) (*schema.Message, bool, error) {
+	return nil, false, errors.New("mock err")
+}
+
+func (errCacher) Set(ctx context.Context, key string, msg *schema.Message) error {
+	return errors.New("mock err")
+}
+
+func TestNewChatModel(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewChatModel(ctx, &Config{})
+	assert.EqualError(t, err, "[NewChatModel] chat model not provided")
+
+	_, err = NewChatModel(ctx, &Config{ChatModel: &mockChatModel{}, SimilarityThreshold: 1.5})
+	assert.EqualError(t, err, "[NewChatModel] similarity threshold must be in [0, 1]")
+
+	_, err = NewChatModel(ctx, &Config{ChatModel: &mockChatModel{}, Cacher: errCacher{}, Embedder: &mockEmbedder{}})
+	assert.EqualError(t, err, "[NewChatModel] cacher does not implement SemanticCacher")
+
+	cm, err := NewChatModel(ctx, &Config{ChatModel: &mockChatModel{}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Cache", cm.GetType())
+	assert.True(t, cm.IsCallbacksEnabled())
+}
+
+func TestGenerate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("exact match", func(t *testing.T) {
+		inner := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: inner, Model: "m1"})
+		assert.NoError(t, err)
+
+		out, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hello  world")})
+		assert.NoError(t, err)
+		assert.Equal(t, "re: hello  world", out.Content)
+		assert.False(t, IsCacheHit(out))
+
+		out, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage(" hello\nworld ")})
+		assert.NoError(t, err)
+		assert.Equal(t, "re: hello  world", out.Content)
+		assert.True(t, IsCacheHit(out))
+		assert.Equal(t, 1, inner.Calls())
+
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("hello world")}, model.WithModel("m2"))
+		assert.NoError(t, err)
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("hello world")}, model.WithTemperature(0.5))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, inner.Calls())
+	})
+
+	t.Run("skip cache", func(t *testing.T) {
+		inner := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: inner})
+		assert.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			out, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")}, WithSkipCache())
+			assert.NoError(t, err)
+			assert.False(t, IsCacheHit(out))
+		}
+		assert.Equal(t, 2, inner.Calls())
+		assert.Equal(t, 0, cm.config.Cacher.(*LRUCacher).Len())
+	})
+
+	t.Run("error not cached", func(t *testing.T) {
+		inner := &mockChatModel{err: errors.New("mock err")}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: inner})
+		assert.NoError(t, err)
+
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		assert.EqualError(t, err, "mock err")
+		assert.Equal(t, 0, cm.config.Cacher.(*LRUCacher).Len())
+	})
+
+	t.Run("cache error", func(t *testing.T) {
+		inner := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: inner, Cacher: errCacher{}})
+		assert.NoError(t, err)
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		assert.EqualError(t, err, "[cache] get cache failed: mock err")
+
+		cm, err = NewChatModel(ctx, &Config{ChatModel: inner, Cacher: errCacher{}, IgnoreCacheError: true})
+		assert.NoError(t, err)
+		out, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		assert.NoError(t, err)
+		assert.Equal(t, "re: hi", out.Content)
+	})
+
+	t.Run("semantic match", func(t *testing.T) {
+		inner := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{
+			ChatModel: inner,
+			Embedder: &mockEmbedder{vectors: map[string][]float64{
+				"how to reset my password": {1, 0},
+				"how do I reset password":  {0.99, 0.1},
+				"what is the price":        {0, 1},
+			}},
+			SimilarityThreshold: 0.9,
+		})
+		assert.NoError(t, err)
+
+		system := schema.SystemMessage("you are a helpful assistant")
+		out, err := cm.Generate(ctx, []*schema.Message{system, schema.UserMessage("how to reset my password")})
+		assert.NoError(t, err)
+		assert.False(t, IsCacheHit(out))
+
+		out, err = cm.Generate(ctx, []*schema.Message{system, schema.UserMessage("how do I reset password")})
+		assert.NoError(t, err)
+		assert.True(t, IsCacheHit(out))
+		assert.Equal(t, "re: how to reset my password", out.Content)
+
+		out, err = cm.Generate(ctx, []*schema.Message{system, schema.UserMessage("what is the price")})
+		assert.NoError(t, err)
+		assert.False(t, IsCacheHit(out))
+
+		// different preceding messages are never matched semantically
+		out, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("how do I reset password")})
+		assert.NoError(t, err)
+		assert.False(t, IsCacheHit(out))
+		assert.Equal(t, 3, inner.Calls())
+	})
+
+	t.Run("embed error", func(t *testing.T) {
+		inner := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: inner, Embedder: &mockEmbedder{err: errors.New("mock err")}})
+		assert.NoError(t, err)
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		assert.EqualError(t, err, "[cache] embed input failed: mock err")
+
+		cm, err = NewChatModel(ctx, &Config{ChatModel: inner, Embedder: &mockEmbedder{err: errors.New("mock err")}, IgnoreCacheError: true})
+		assert.NoError(t, err)
+		_, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		assert.NoError(t, err)
+		out, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		assert.NoError(t, err)
+		assert.True(t, IsCacheHit(out))
+	})
+}
+
+func TestStream(t *testing.T) {
+	ctx := context.Background()
+	inner := &mockChatModel{}
+	cm, err := NewChatModel(ctx, &Config{ChatModel: inner})
+	assert.NoError(t, err)
+
+	recvAll := func(sr *schema.StreamReader[*schema.Message]) []*schema.Message {
+		defer sr.Close()
+		var chunks []*schema.Message
+		for {
+			chunk, err := sr.Recv()
+			if err == io.EOF {
+				return chunks
+			}
+			assert.NoError(t, err)
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	sr, err := cm.Stream(ctx, []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	chunks := recvAll(sr)
+	assert.Len(t, chunks, 3)
+
+	sr, err = cm.Stream(ctx, []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	chunks = recvAll(sr)
+	assert.Len(t, chunks, 1)
+	assert.Equal(t, "re: hi", chunks[0].Content)
+	assert.True(t, IsCacheHit(chunks[0]))
+
+	out, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	assert.True(t, IsCacheHit(out))
+	assert.Equal(t, 1, inner.Calls())
+
+	// responses whose stream is closed early are not cached
+	sr, err = cm.Stream(ctx, []*schema.Message{schema.UserMessage("bye")})
+	assert.NoError(t, err)
+	_, err = sr.Recv()
+	assert.NoError(t, err)
+	sr.Close()
+	out, err = cm.Generate(ctx, []*schema.Message{schema.UserMessage("bye")})
+	assert.NoError(t, err)
+	assert.False(t, IsCacheHit(out))
+}
+
+func TestBuildKeys(t *testing.T) {
+	k1, err := buildKeys([]*schema.Message{
+		schema.UserMessage("weather?"),
+		{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{ID: "call_1", Function: schema.FunctionCall{Name: "weather", Arguments: "{}"}}}},
+		{Role: schema.Tool, ToolCallID: "call_1", Content: "sunny"},
+	}, &model.Options{})
+	assert.NoError(t, err)
+	assert.Empty(t, k1.text)
+
+	k2, err := buildKeys([]*schema.Message{
+		schema.UserMessage("weather?"),
+		{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{ID: "call_2", Function: schema.FunctionCall{Name: "weather", Arguments: "{}"}}}},
+		{Role: schema.Tool, ToolCallID: "call_2", Content: "sunny"},
+	}, &model.Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, k1.exact, k2.exact)
+
+	k3, err := buildKeys([]*schema.Message{schema.UserMessage("weather?")}, &model.Options{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, k1.exact, k3.exact)
+	assert.Equal(t, "weather?", k3.text)
+	assert.NotEmpty(t, k3.scope)
+}
+
+func TestLRUCacher(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRUCacher(2)
+
+	assert.NoError(t, c.Set(ctx, "a", schema.AssistantMessage("a", nil)))
+	assert.NoError(t, c.Add(ctx, "s", []float64{1, 0}, schema.AssistantMessage("s1", nil)))
+	_, found, err := c.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	// s1 is the least recently used
+	assert.NoError(t, c.Add(ctx, "s", []float64{0, 1}, schema.AssistantMessage("s2", nil)))
+	assert.Equal(t, 2, c.Len())
+	msg, similarity, found, err := c.Search(ctx, "s", []float64{1, 0})
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "s2", msg.Content)
+	assert.InDelta(t, 0, similarity, 1e-9)
+
+	// a is evicted now
+	assert.NoError(t, c.Set(ctx, "b", schema.AssistantMessage("b", nil)))
+	_, found, err = c.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	_, _, found, err = c.Search(ctx, "other", []float64{1, 0})
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	msg, _, err = c.Get(ctx, "b")
+	assert.NoError(t, err)
+	msg.Content = "modified"
+	msg, _, err = c.Get(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", msg.Content)
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/cloudwego/eino-ext/components/model/cache"
+)
+
+func main() {
+	ctx := context.Background()
+
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+
+	cm, err := cache.NewChatModel(ctx, &cache.Config{
+		// replace with any chat model, e.g. openai / ark chat model
+		ChatModel: &echoChatModel{},
+		Cacher:    cache.NewRedisCacher(client, "eino_chat:", 24*time.Hour),
+		Model:     "gpt-4o",
+		// replace with any embedding.Embedder, e.g. openai / ark embedder
+		Embedder:            &keywordEmbedder{},
+		SimilarityThreshold: 0.9,
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of cache failed, err=%v", err)
+	}
+
+	system := schema.SystemMessage("you are the customer service of eino shop")
+	for _, question := range []string{
+		"How do I reset my password?",
+		"How do I reset  my password? ", // exact hit, whitespace is normalized
+		"How can I reset the password?", // semantic hit
+		"What is the refund policy?",    // miss
+	} {
+		msg, err := cm.Generate(ctx, []*schema.Message{system, schema.UserMessage(question)})
+		if err != nil {
+			log.Fatalf("Generate failed, err=%v", err)
+		}
+		log.Printf("question=%q, cache hit=%v, answer=%q", question, cache.IsCacheHit(msg), msg.Content)
+	}
+}
+
+type echoChatModel struct{}
+
+func (e *echoChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	log.Printf("calling chat model")
+	return schema.AssistantMessage("answer to: "+input[len(input)-1].Content, nil), nil
+}
+
+func (e *echoChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := e.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (e *echoChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return e, nil
+}
+
+// keywordEmbedder embeds texts by a few keywords, to show how semantic cache works without calling an api.
+type keywordEmbedder struct{}
+
+func (k *keywordEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	keywords := []string{"reset", "password", "refund", "policy"}
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = make([]float64, len(keywords))
+		for j, keyword := range keywords {
+			if strings.Contains(strings.ToLower(text), keyword) {
+				vectors[i][j] = 1
+			}
+		}
+	}
+	return vectors, nil
+}
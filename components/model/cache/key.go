@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+type keys struct {
+	// exact is the key of the whole input.
+	exact string
+	// scope is the key of the input without the last user message,
+	// responses are only compared semantically within the same scope.
+	scope string
+	// text is the last user message to embed, empty if semantic cache does not apply.
+	text string
+}
+
+type keyParams struct {
+	Model       string             `json:"model,omitempty"`
+	Temperature *float32           `json:"temperature,omitempty"`
+	MaxTokens   *int               `json:"max_tokens,omitempty"`
+	TopP        *float32           `json:"top_p,omitempty"`
+	Stop        []string           `json:"stop,omitempty"`
+	ToolChoice  *schema.ToolChoice `json:"tool_choice,omitempty"`
+	Tools       []keyTool          `json:"tools,omitempty"`
+}
+
+type keyTool struct {
+	Name   string `json:"name"`
+	Desc   string `json:"desc,omitempty"`
+	Params any    `json:"params,omitempty"`
+}
+
+type keyMessage struct {
+	Role         schema.RoleType          `json:"role"`
+	Content      string                   `json:"content,omitempty"`
+	MultiContent []schema.ChatMessagePart `json:"multi_content,omitempty"`
+	Name         string                   `json:"name,omitempty"`
+	ToolCalls    []keyToolCall            `json:"tool_calls,omitempty"`
+}
+
+// keyToolCall leaves out tool call ids, as keyMessage does with ToolCallID of tool messages.
+// They are generated randomly by most providers, so conversations with the same tool calls
+// would never share the same key otherwise.
+type keyToolCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments,omitempty"`
+}
+
+type keyInput struct {
+	Params   *keyParams    `json:"params"`
+	Messages []*keyMessage `json:"messages"`
+}
+
+func buildKeys(input []*schema.Message, options *model.Options) (*keys, error) {
+	params, err := buildKeyParams(options)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*keyMessage, len(input))
+	for i, msg := range input {
+		messages[i] = buildKeyMessage(msg)
+	}
+
+	k := &keys{}
+	if k.exact, err = hash(&keyInput{Params: params, Messages: messages}); err != nil {
+		return nil, err
+	}
+
+	if n := len(messages); n > 0 && messages[n-1].Role == schema.User &&
+		len(messages[n-1].MultiContent) == 0 && len(messages[n-1].Content) > 0 {
+		if k.scope, err = hash(&keyInput{Params: params, Messages: messages[:n-1]}); err != nil {
+			return nil, err
+		}
+		k.text = messages[n-1].Content
+	}
+
+	return k, nil
+}
+
+func buildKeyParams(options *model.Options) (*keyParams, error) {
+	params := &keyParams{
+		Temperature: options.Temperature,
+		MaxTokens:   options.MaxTokens,
+		TopP:        options.TopP,
+		Stop:        options.Stop,
+		ToolChoice:  options.ToolChoice,
+	}
+	if options.Model != nil {
+		params.Model = *options.Model
+	}
+
+	for _, tool := range options.Tools {
+		if tool == nil {
+			continue
+		}
+		kt := keyTool{Name: tool.Name, Desc: tool.Desc}
+		if tool.ParamsOneOf != nil {
+			s, err := tool.ParamsOneOf.ToOpenAPIV3()
+			if err != nil {
+				return nil, err
+			}
+			kt.Params = s
+		}
+		params.Tools = append(params.Tools, kt)
+	}
+
+	return params, nil
+}
+
+func buildKeyMessage(msg *schema.Message) *keyMessage {
+	if msg == nil {
+		return &keyMessage{}
+	}
+
+	km := &keyMessage{
+		Role:         msg.Role,
+		Content:      normalize(msg.Content),
+		MultiContent: msg.MultiContent,
+		Name:         msg.Name,
+	}
+	for _, tc := range msg.ToolCalls {
+		km.ToolCalls = append(km.ToolCalls, keyToolCall{
+			Name:      tc.Function.Name,
+			Arguments: strings.TrimSpace(tc.Function.Arguments),
+		})
+	}
+	return km
+}
+
+// normalize trims text and collapses runs of whitespace,
+// so that inputs differing only in formatting share the same key.
+func normalize(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func hash(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
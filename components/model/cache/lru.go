@@ -0,0 +1,172 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+type lruEntry struct {
+	// key is set for entries added by Set, scope and vector for those added by Add.
+	key      string
+	semantic bool
+	scope    string
+	vector   []float64
+	// data is the json encoded message, so that every Get returns a new message.
+	data []byte
+}
+
+// LRUCacher is an in-memory SemanticCacher, evicting least recently used entries when full.
+// Semantic search scans all entries of the scope, which suits caches of moderate size.
+type LRUCacher struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+	scopes   map[string]map[*list.Element]struct{}
+}
+
+// NewLRUCacher creates an in-memory lru Cacher holding at most capacity responses.
+func NewLRUCacher(capacity int) *LRUCacher {
+	if capacity <= 0 {
+		capacity = defaultLRUCapacity
+	}
+
+	return &LRUCacher{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+		scopes:   make(map[string]map[*list.Element]struct{}),
+	}
+}
+
+func (c *LRUCacher) Get(_ context.Context, key string) (*schema.Message, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	c.ll.MoveToFront(elem)
+	return decode(elem.Value.(*lruEntry).data)
+}
+
+func (c *LRUCacher) Set(_ context.Context, key string, msg *schema.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruEntry).data = data
+		c.ll.MoveToFront(elem)
+		return nil
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, data: data})
+	c.evict()
+
+	return nil
+}
+
+func (c *LRUCacher) Search(_ context.Context, scope string, vector []float64) (*schema.Message, float64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		best           *list.Element
+		bestSimilarity float64
+	)
+	for elem := range c.scopes[scope] {
+		similarity := cosineSimilarity(vector, elem.Value.(*lruEntry).vector)
+		if best == nil || similarity > bestSimilarity {
+			best, bestSimilarity = elem, similarity
+		}
+	}
+	if best == nil {
+		return nil, 0, false, nil
+	}
+
+	c.ll.MoveToFront(best)
+	msg, _, err := decode(best.Value.(*lruEntry).data)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return msg, bestSimilarity, true, nil
+}
+
+func (c *LRUCacher) Add(_ context.Context, scope string, vector []float64, msg *schema.Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem := c.ll.PushFront(&lruEntry{semantic: true, scope: scope, vector: vector, data: data})
+	if c.scopes[scope] == nil {
+		c.scopes[scope] = make(map[*list.Element]struct{})
+	}
+	c.scopes[scope][elem] = struct{}{}
+	c.evict()
+
+	return nil
+}
+
+// Len returns the number of cached responses, including both exact and semantic entries.
+func (c *LRUCacher) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
+
+func (c *LRUCacher) evict() {
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+
+		entry := oldest.Value.(*lruEntry)
+		if !entry.semantic {
+			delete(c.items, entry.key)
+			continue
+		}
+		delete(c.scopes[entry.scope], oldest)
+		if len(c.scopes[entry.scope]) == 0 {
+			delete(c.scopes, entry.scope)
+		}
+	}
+}
+
+func decode(data []byte) (*schema.Message, bool, error) {
+	msg := &schema.Message{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, false, err
+	}
+	return msg, true, nil
+}
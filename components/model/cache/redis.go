@@ -0,0 +1,142 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+type redisSemanticEntry struct {
+	Vector  []float64       `json:"vector"`
+	Message json.RawMessage `json:"message"`
+}
+
+// RedisCacher is a SemanticCacher storing responses in redis as json.
+// Exact entries are stored as strings at keyPrefix+key, semantic entries of one scope
+// are stored in a hash at keyPrefix+"semantic:"+scope, which Search scans as a whole.
+type RedisCacher struct {
+	client    redis.UniversalClient
+	keyPrefix string
+	ttl       time.Duration
+}
+
+// NewRedisCacher creates a redis Cacher, ttl <= 0 means no expiration.
+// The ttl of a semantic scope is refreshed every time a response is added to it.
+func NewRedisCacher(client redis.UniversalClient, keyPrefix string, ttl time.Duration) *RedisCacher {
+	return &RedisCacher{
+		client:    client,
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+	}
+}
+
+func (c *RedisCacher) Get(ctx context.Context, key string) (*schema.Message, bool, error) {
+	b, err := c.client.Get(ctx, c.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return decode(b)
+}
+
+func (c *RedisCacher) Set(ctx context.Context, key string, msg *schema.Message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, c.keyPrefix+key, b, c.ttl).Err()
+}
+
+func (c *RedisCacher) Search(ctx context.Context, scope string, vector []float64) (*schema.Message, float64, bool, error) {
+	fields, err := c.client.HGetAll(ctx, c.semanticKey(scope)).Result()
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	var (
+		best           *redisSemanticEntry
+		bestSimilarity float64
+	)
+	for _, field := range fields {
+		entry := &redisSemanticEntry{}
+		if err = json.Unmarshal([]byte(field), entry); err != nil {
+			return nil, 0, false, err
+		}
+		similarity := cosineSimilarity(vector, entry.Vector)
+		if best == nil || similarity > bestSimilarity {
+			best, bestSimilarity = entry, similarity
+		}
+	}
+	if best == nil {
+		return nil, 0, false, nil
+	}
+
+	msg, _, err := decode(best.Message)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return msg, bestSimilarity, true, nil
+}
+
+func (c *RedisCacher) Add(ctx context.Context, scope string, vector []float64, msg *schema.Message) error {
+	m, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(&redisSemanticEntry{Vector: vector, Message: m})
+	if err != nil {
+		return err
+	}
+
+	key := c.semanticKey(scope)
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, vectorHash(vector), b)
+		if c.ttl > 0 {
+			pipe.Expire(ctx, key, c.ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *RedisCacher) semanticKey(scope string) string {
+	return c.keyPrefix + "semantic:" + scope
+}
+
+func vectorHash(vector []float64) string {
+	b := make([]byte, len(vector)*8)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint64(b[i*8:], math.Float64bits(v))
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
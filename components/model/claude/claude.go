@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/bedrock"
@@ -57,6 +58,14 @@ var _ model.ToolCallingChatModel = (*ChatModel)(nil)
 //	    MaxTokens: 2000,
 //	})
 func NewChatModel(ctx context.Context, config *Config) (*ChatModel, error) {
+	var commonOpts []option.RequestOption
+	if config.MaxRetries != nil {
+		commonOpts = append(commonOpts, option.WithMaxRetries(*config.MaxRetries))
+	}
+	if config.Timeout > 0 {
+		commonOpts = append(commonOpts, option.WithRequestTimeout(config.Timeout))
+	}
+
 	var cli *anthropic.Client
 	if !config.ByBedrock {
 		var opts []option.RequestOption
@@ -68,7 +77,7 @@ func NewChatModel(ctx context.Context, config *Config) (*ChatModel, error) {
 		if config.HTTPClient != nil {
 			opts = append(opts, option.WithHTTPClient(config.HTTPClient))
 		}
-		cli = anthropic.NewClient(opts...)
+		cli = anthropic.NewClient(append(opts, commonOpts...)...)
 	} else {
 		var opts []func(*awsConfig.LoadOptions) error
 		if config.Region != "" {
@@ -87,7 +96,7 @@ func NewChatModel(ctx context.Context, config *Config) (*ChatModel, error) {
 		if config.HTTPClient != nil {
 			opts = append(opts, awsConfig.WithHTTPClient(config.HTTPClient))
 		}
-		cli = anthropic.NewClient(bedrock.WithLoadDefaultConfig(ctx, opts...))
+		cli = anthropic.NewClient(append([]option.RequestOption{bedrock.WithLoadDefaultConfig(ctx, opts...)}, commonOpts...)...)
 	}
 	return &ChatModel{
 		cli:           cli,
@@ -172,6 +181,14 @@ type Config struct {
 
 	// HTTPClient specifies the client to send HTTP requests.
 	HTTPClient *http.Client `json:"http_client"`
+
+	// MaxRetries specifies the number of retry attempts for failed API calls
+	// Optional. Default: 2 (anthropic sdk default)
+	MaxRetries *int `json:"max_retries"`
+
+	// Timeout specifies the maximum duration of each API request attempt
+	// Optional. Default: no timeout
+	Timeout time.Duration `json:"timeout"`
 }
 
 type ChatModel struct {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/bytedance/mockey"
@@ -260,3 +261,15 @@ func TestWithTools(t *testing.T) {
 	assert.Equal(t, "test model", ncm.(*ChatModel).model)
 	assert.Equal(t, "test tool name", ncm.(*ChatModel).origTools[0].Name)
 }
+
+func TestNewChatModelWithRetry(t *testing.T) {
+	maxRetries := 5
+	cm, err := NewChatModel(context.Background(), &Config{
+		APIKey:     "test-key",
+		Model:      "claude-3-opus-20240229",
+		MaxRetries: &maxRetries,
+		Timeout:    time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, cm.cli)
+}
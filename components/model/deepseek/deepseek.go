@@ -21,10 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"runtime/debug"
 	"strings"
 	"time"
 
+	"github.com/cloudwego/eino-ext/libs/acl/retry"
+	"github.com/cloudwego/eino-ext/libs/acl/toolargs"
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components"
 	"github.com/cloudwego/eino/components/model"
@@ -48,6 +51,9 @@ const (
 	toolChoiceRequired = "required" // required means the model must call one or more tools.
 )
 
+// defaultTimeout is the timeout of the sdk, used by the http client of the retry layer
+const defaultTimeout = 5 * time.Minute
+
 type ChatModelConfig struct {
 	// APIKey is your authentication key
 	// Required
@@ -57,6 +63,11 @@ type ChatModelConfig struct {
 	// Optional. Default: 5 minutes
 	Timeout time.Duration `json:"timeout"`
 
+	// Retry retries failed requests by the retry layer shared by the models and embedders, with jittered exponential
+	// backoff honoring Retry-After, e.g. on 429, 5xx and connection resets.
+	// Optional. Default: nil, requests are not retried
+	Retry *retry.Config `json:"retry,omitempty"`
+
 	// BaseURL is your custom deepseek endpoint url
 	// Optional. Default: https://api.deepseek.com/
 	BaseURL string `json:"base_url"`
@@ -112,6 +123,18 @@ type ChatModelConfig struct {
 
 	// TopLogProbs specifies the number of most likely tokens to return at each token position, each with an associated log probability.
 	TopLogProbs int `json:"top_log_probs"`
+
+	// ReasoningContentMode decides how the reasoning content of reasoning models, e.g. deepseek-reasoner, is surfaced in output messages
+	// Optional. Default: ReasoningContentModeExtra
+	ReasoningContentMode ReasoningContentMode `json:"reasoning_content_mode,omitempty"`
+
+	// ThinkingTags wraps reasoning content in Content when ReasoningContentMode is ReasoningContentModeTags
+	// Optional. Default: &ThinkingTags{Start: "<think>", End: "</think>"}
+	ThinkingTags *ThinkingTags `json:"thinking_tags,omitempty"`
+
+	// RepairToolCallArguments makes Stream fix tool call argument fragments that can't be concatenated into valid json
+	// Optional. Default: false
+	RepairToolCallArguments bool `json:"repair_tool_call_arguments,omitempty"`
 }
 
 var _ model.ToolCallingChatModel = (*ChatModel)(nil)
@@ -129,6 +152,9 @@ func NewChatModel(_ context.Context, config *ChatModelConfig) (*ChatModel, error
 	if len(config.Model) == 0 {
 		return nil, fmt.Errorf("model is required")
 	}
+	if err := checkReasoningContentMode(config.ReasoningContentMode); err != nil {
+		return nil, err
+	}
 
 	var opts []deepseek.Option
 	if config.Timeout > 0 {
@@ -145,6 +171,13 @@ func NewChatModel(_ context.Context, config *ChatModelConfig) (*ChatModel, error
 	if len(config.Path) > 0 {
 		opts = append(opts, deepseek.WithPath(config.Path))
 	}
+	if config.Retry != nil {
+		timeout := defaultTimeout
+		if config.Timeout > 0 {
+			timeout = config.Timeout
+		}
+		opts = append(opts, deepseek.WithHTTPClient(retry.WrapHTTPClient(&http.Client{Timeout: timeout}, config.Retry)))
+	}
 
 	cli, err := deepseek.NewClientWithOptions(config.APIKey, opts...)
 	if err != nil {
@@ -233,6 +266,7 @@ func (cm *ChatModel) Generate(ctx context.Context, in []*schema.Message, opts ..
 		if len(choice.Message.ReasoningContent) > 0 {
 			SetReasoningContent(outMsg, choice.Message.ReasoningContent)
 		}
+		cm.newReasoningFormatter().format(outMsg)
 
 		break
 	}
@@ -285,10 +319,35 @@ func (cm *ChatModel) Stream(ctx context.Context, in []*schema.Message, opts ...m
 		}()
 
 		var lastEmptyMsg *schema.Message
+		formatter := cm.newReasoningFormatter()
+		var repairer *toolargs.StreamRepairer
+		if cm.conf.RepairToolCallArguments {
+			repairer = toolargs.NewStreamRepairer()
+		}
 
 		for {
 			chunk, chunkErr := stream.Recv()
 			if errors.Is(chunkErr, io.EOF) {
+				if endTag, ok := formatter.close(); ok {
+					endMsg := &schema.Message{Role: schema.Assistant, Content: endTag}
+					if lastEmptyMsg != nil {
+						cMsg, cErr := schema.ConcatMessages([]*schema.Message{lastEmptyMsg, endMsg})
+						if cErr != nil {
+							_ = sw.Send(nil, fmt.Errorf("failed to concatenate stream messages: %w", cErr))
+							return
+						}
+						endMsg = cMsg
+					}
+					lastEmptyMsg = endMsg
+				}
+				if repairer != nil {
+					if fin := repairer.Finish(); fin != nil {
+						sw.Send(&model.CallbackOutput{
+							Message: fin,
+							Config:  cbInput.Config,
+						}, nil)
+					}
+				}
 				if lastEmptyMsg != nil {
 					sw.Send(&model.CallbackOutput{
 						Message:    lastEmptyMsg,
@@ -308,6 +367,10 @@ func (cm *ChatModel) Stream(ctx context.Context, in []*schema.Message, opts ...m
 			if !found {
 				continue
 			}
+			formatter.format(msg)
+			if repairer != nil {
+				repairer.Process(msg)
+			}
 
 			if lastEmptyMsg != nil {
 				cMsg, cErr := schema.ConcatMessages([]*schema.Message{lastEmptyMsg, msg})
@@ -604,7 +667,7 @@ func (cm *ChatModel) generateRequest(_ context.Context, in []*schema.Message, op
 
 	msgs := make([]deepseek.ChatCompletionMessage, 0, len(in))
 	for _, inMsg := range in {
-		msg, e := toDeepSeekMessage(inMsg)
+		msg, e := toDeepSeekMessage(cm.stripThinking(inMsg))
 		if e != nil {
 			return nil, nil, e
 		}
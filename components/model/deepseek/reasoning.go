@@ -0,0 +1,156 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deepseek
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+type ReasoningContentMode string
+
+const (
+	// ReasoningContentModeExtra keeps reasoning content in Extra of output messages only, see GetReasoningContent.
+	ReasoningContentModeExtra ReasoningContentMode = "extra"
+	// ReasoningContentModeTags also wraps reasoning content in ThinkingTags at the beginning of Content,
+	// e.g. "<think>reasoning</think>answer", for downstream rendering Content only.
+	// Thinking blocks are stripped from the Content of assistant messages in input, as DeepSeek suggests
+	// not to send reasoning content back.
+	ReasoningContentModeTags ReasoningContentMode = "tags"
+	// ReasoningContentModeStrip drops reasoning content from output messages.
+	ReasoningContentModeStrip ReasoningContentMode = "strip"
+)
+
+// ThinkingTags are the opening and closing tags wrapping reasoning content in Content.
+type ThinkingTags struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+var defaultThinkingTags = &ThinkingTags{Start: "<think>", End: "</think>"}
+
+func checkReasoningContentMode(mode ReasoningContentMode) error {
+	switch mode {
+	case "", ReasoningContentModeExtra, ReasoningContentModeTags, ReasoningContentModeStrip:
+		return nil
+	default:
+		return fmt.Errorf("unknown reasoning content mode: %s", mode)
+	}
+}
+
+// SplitReasoningContent returns the reasoning content and the answer of message, whichever ReasoningContentMode is used.
+// Reasoning content in Extra is preferred, and a thinking block wrapped in tags at the beginning of Content is split out.
+// An unclosed thinking block, e.g. of a partially received stream, is taken as reasoning content entirely.
+// tags is optional, default: "<think>" and "</think>".
+func SplitReasoningContent(message *schema.Message, tags *ThinkingTags) (reasoning, content string) {
+	if message == nil {
+		return "", ""
+	}
+	if tags == nil {
+		tags = defaultThinkingTags
+	}
+
+	reasoning, inExtra := GetReasoningContent(message)
+	content = message.Content
+	if len(tags.Start) == 0 || !strings.HasPrefix(content, tags.Start) {
+		return reasoning, content
+	}
+
+	inner := content[len(tags.Start):]
+	thinking := inner
+	content = ""
+	if idx := strings.Index(inner, tags.End); idx >= 0 {
+		thinking = inner[:idx]
+		content = inner[idx+len(tags.End):]
+	}
+	if !inExtra {
+		reasoning = thinking
+	}
+	return reasoning, content
+}
+
+// reasoningFormatter applies ReasoningContentMode to output messages,
+// it keeps whether a thinking block is open between chunks of a stream.
+type reasoningFormatter struct {
+	mode     ReasoningContentMode
+	tags     *ThinkingTags
+	thinking bool
+}
+
+func (cm *ChatModel) newReasoningFormatter() *reasoningFormatter {
+	tags := cm.conf.ThinkingTags
+	if tags == nil {
+		tags = defaultThinkingTags
+	}
+	return &reasoningFormatter{mode: cm.conf.ReasoningContentMode, tags: tags}
+}
+
+func (f *reasoningFormatter) format(msg *schema.Message) {
+	switch f.mode {
+	case ReasoningContentModeStrip:
+		delete(msg.Extra, extraKeyReasoningContent)
+		if len(msg.Extra) == 0 {
+			msg.Extra = nil
+		}
+	case ReasoningContentModeTags:
+		sb := strings.Builder{}
+		if reasoning, ok := GetReasoningContent(msg); ok && len(reasoning) > 0 {
+			if !f.thinking {
+				sb.WriteString(f.tags.Start)
+				f.thinking = true
+			}
+			sb.WriteString(reasoning)
+		}
+		if f.thinking && (len(msg.Content) > 0 || len(msg.ToolCalls) > 0) {
+			sb.WriteString(f.tags.End)
+			f.thinking = false
+		}
+		sb.WriteString(msg.Content)
+		msg.Content = sb.String()
+	}
+}
+
+// close returns the closing tag if a thinking block is still open at the end of a stream.
+func (f *reasoningFormatter) close() (string, bool) {
+	if !f.thinking {
+		return "", false
+	}
+	f.thinking = false
+	return f.tags.End, true
+}
+
+// stripThinking removes the thinking block from Content of assistant input messages in ReasoningContentModeTags.
+func (cm *ChatModel) stripThinking(msg *schema.Message) *schema.Message {
+	if cm.conf.ReasoningContentMode != ReasoningContentModeTags || msg == nil || msg.Role != schema.Assistant {
+		return msg
+	}
+
+	tags := cm.conf.ThinkingTags
+	if tags == nil {
+		tags = defaultThinkingTags
+	}
+	_, content := SplitReasoningContent(msg, tags)
+	if content == msg.Content {
+		return msg
+	}
+
+	cp := *msg
+	cp.Content = content
+	return &cp
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deepseek
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/eino/schema"
+	"github.com/cohesion-org/deepseek-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitReasoningContent(t *testing.T) {
+	reasoning, content := SplitReasoningContent(nil, nil)
+	assert.Equal(t, "", reasoning)
+	assert.Equal(t, "", content)
+
+	msg := schema.AssistantMessage("answer", nil)
+	SetReasoningContent(msg, "reasoning")
+	reasoning, content = SplitReasoningContent(msg, nil)
+	assert.Equal(t, "reasoning", reasoning)
+	assert.Equal(t, "answer", content)
+
+	msg = schema.AssistantMessage("<think>reasoning</think>answer", nil)
+	reasoning, content = SplitReasoningContent(msg, nil)
+	assert.Equal(t, "reasoning", reasoning)
+	assert.Equal(t, "answer", content)
+
+	msg = schema.AssistantMessage("<think>reasoning</think>answer", nil)
+	SetReasoningContent(msg, "reasoning in extra")
+	reasoning, content = SplitReasoningContent(msg, nil)
+	assert.Equal(t, "reasoning in extra", reasoning)
+	assert.Equal(t, "answer", content)
+
+	msg = schema.AssistantMessage("[[reason", nil)
+	reasoning, content = SplitReasoningContent(msg, &ThinkingTags{Start: "[[", End: "]]"})
+	assert.Equal(t, "reason", reasoning)
+	assert.Equal(t, "", content)
+}
+
+func TestReasoningFormatter(t *testing.T) {
+	newMsg := func(reasoning, content string) *schema.Message {
+		msg := schema.AssistantMessage(content, nil)
+		if len(reasoning) > 0 {
+			SetReasoningContent(msg, reasoning)
+		}
+		return msg
+	}
+
+	f := (&ChatModel{conf: &ChatModelConfig{ReasoningContentMode: ReasoningContentModeTags}}).newReasoningFormatter()
+	var contents []string
+	for _, msg := range []*schema.Message{newMsg("a", ""), newMsg("b", ""), newMsg("", "c"), newMsg("", "d")} {
+		f.format(msg)
+		contents = append(contents, msg.Content)
+	}
+	assert.Equal(t, []string{"<think>a", "b", "</think>c", "d"}, contents)
+	_, ok := f.close()
+	assert.False(t, ok)
+
+	msg := newMsg("a", "")
+	f.format(msg)
+	endTag, ok := f.close()
+	assert.True(t, ok)
+	assert.Equal(t, "</think>", endTag)
+
+	f = (&ChatModel{conf: &ChatModelConfig{ReasoningContentMode: ReasoningContentModeStrip}}).newReasoningFormatter()
+	msg = newMsg("a", "b")
+	f.format(msg)
+	_, ok = GetReasoningContent(msg)
+	assert.False(t, ok)
+	assert.Nil(t, msg.Extra)
+	assert.Equal(t, "b", msg.Content)
+
+	f = (&ChatModel{conf: &ChatModelConfig{}}).newReasoningFormatter()
+	msg = newMsg("a", "b")
+	f.format(msg)
+	reasoning, _ := GetReasoningContent(msg)
+	assert.Equal(t, "a", reasoning)
+	assert.Equal(t, "b", msg.Content)
+}
+
+func TestStripThinking(t *testing.T) {
+	cm := &ChatModel{conf: &ChatModelConfig{ReasoningContentMode: ReasoningContentModeTags}}
+	in := schema.AssistantMessage("<think>reasoning</think>answer", nil)
+	assert.Equal(t, "answer", cm.stripThinking(in).Content)
+	assert.Equal(t, "<think>reasoning</think>answer", in.Content)
+
+	user := schema.UserMessage("<think>hi</think>")
+	assert.Equal(t, user, cm.stripThinking(user))
+
+	cm = &ChatModel{conf: &ChatModelConfig{}}
+	assert.Equal(t, in, cm.stripThinking(in))
+}
+
+func TestNewChatModelReasoningContentMode(t *testing.T) {
+	_, err := NewChatModel(context.Background(), &ChatModelConfig{Model: "deepseek-reasoner", ReasoningContentMode: "unknown"})
+	assert.EqualError(t, err, "unknown reasoning content mode: unknown")
+}
+
+func TestChatModelStreamWithThinkingTags(t *testing.T) {
+	responses := []*deepseek.StreamChatCompletionResponse{
+		{Choices: []deepseek.StreamChoices{{Index: 0, Delta: deepseek.StreamDelta{Role: "assistant", ReasoningContent: "let me"}}}},
+		{Choices: []deepseek.StreamChoices{{Index: 0, Delta: deepseek.StreamDelta{Role: "assistant", ReasoningContent: " think"}}}},
+		{Choices: []deepseek.StreamChoices{{Index: 0, Delta: deepseek.StreamDelta{Role: "assistant", Content: "hello"}}}},
+	}
+
+	defer mockey.Mock((*deepseek.Client).CreateChatCompletionStream).To(func(ctx context.Context, request *deepseek.StreamChatCompletionRequest) (deepseek.ChatCompletionStream, error) {
+		assert.Equal(t, "answer", request.Messages[1].Content)
+		return &mockStream{responses: responses}, nil
+	}).Build().UnPatch()
+
+	ctx := context.Background()
+	cm, err := NewChatModel(ctx, &ChatModelConfig{
+		APIKey:               "my-api-key",
+		Model:                "deepseek-reasoner",
+		ReasoningContentMode: ReasoningContentModeTags,
+	})
+	assert.Nil(t, err)
+
+	result, err := cm.Stream(ctx, []*schema.Message{
+		schema.UserMessage("hi"),
+		schema.AssistantMessage("<think>reasoning</think>answer", nil),
+		schema.UserMessage("hello"),
+	})
+	assert.Nil(t, err)
+
+	var msgs []*schema.Message
+	for {
+		chunk, err := result.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		msgs = append(msgs, chunk)
+	}
+
+	msg, err := schema.ConcatMessages(msgs)
+	assert.Nil(t, err)
+	assert.Equal(t, "<think>let me think</think>hello", msg.Content)
+	reasoning, content := SplitReasoningContent(msg, nil)
+	assert.Equal(t, "let me think", reasoning)
+	assert.Equal(t, "hello", content)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fallback
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// DefaultShouldFallback retries on the next chat model for errors that another provider may not have:
+// timeouts, connection failures, 408, 429 and 5xx responses.
+// Errors after ctx is done are never retried, since every following attempt would fail the same way.
+func DefaultShouldFallback(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	if code, ok := StatusCode(err); ok {
+		return code == 408 || code == 429 || code >= 500
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range retryableMessages {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+var retryableMessages = []string{
+	"timeout", "timed out", "rate limit", "too many requests", "overloaded",
+	"connection reset", "connection refused", "service unavailable", "bad gateway",
+}
+
+var statusCodePattern = regexp.MustCompile(`(?i)status(?:\s*code)?\s*[:=]?\s*([1-5]\d\d)\b`)
+
+var statusCodeFields = []string{"HTTPStatusCode", "StatusCode"}
+
+// StatusCode extracts the HTTP status code from err, looking through the error chain for
+// a StatusCode() method, a HTTPStatusCode or StatusCode field as used by most provider SDKs,
+// and finally a "status code: 429" like text in the message.
+func StatusCode(err error) (int, bool) {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if sc, ok := e.(interface{ StatusCode() int }); ok && sc.StatusCode() > 0 {
+			return sc.StatusCode(), true
+		}
+
+		v := reflect.ValueOf(e)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		for _, name := range statusCodeFields {
+			f := v.FieldByName(name)
+			if f.IsValid() && f.CanInt() && f.Int() > 0 {
+				return int(f.Int()), true
+			}
+		}
+	}
+
+	if m := statusCodePattern.FindStringSubmatch(err.Error()); m != nil {
+		code, _ := strconv.Atoi(m[1])
+		return code, true
+	}
+	return 0, false
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/model/fallback"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// providerChatModel stands for any real chat model, e.g. ark, openai or deepseek.
+type providerChatModel struct {
+	name string
+	err  error
+}
+
+func (p *providerChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return schema.AssistantMessage("answered by "+p.name, nil), nil
+}
+
+func (p *providerChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := p.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (p *providerChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return p, nil
+}
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := fallback.NewChatModel(ctx, &fallback.Config{
+		Candidates: []*fallback.Candidate{
+			{Name: "ark", ChatModel: &providerChatModel{name: "ark", err: errors.New("error, status code: 429, message: rate limit exceeded")}},
+			{Name: "openai", ChatModel: &providerChatModel{name: "openai"}},
+			{Name: "deepseek", ChatModel: &providerChatModel{name: "deepseek"}},
+		},
+		// skip ark for a minute after it fails
+		Cooldown: time.Minute,
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel failed, err=%v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		msg, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		if err != nil {
+			log.Fatalf("Generate failed, err=%v", err)
+		}
+		log.Printf("request %d: %s", i, msg.Content)
+	}
+}
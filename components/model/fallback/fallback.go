@@ -0,0 +1,281 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+type Candidate struct {
+	// ChatModel is one of the providers to send requests to.
+	// Required.
+	ChatModel model.ToolCallingChatModel
+	// Name identifies the candidate in errors.
+	// Optional. Default: the type of ChatModel, or its index.
+	Name string
+	// Weight is the share of requests first sent to this candidate when Config.LoadBalance is enabled.
+	// Optional. Default: 1
+	Weight int
+}
+
+type Config struct {
+	// Candidates are tried in order until one of them succeeds.
+	// Required.
+	Candidates []*Candidate
+	// ShouldFallback tells whether a failed request should be retried on the next candidate.
+	// Optional. Default: DefaultShouldFallback
+	ShouldFallback func(ctx context.Context, err error) bool
+	// LoadBalance picks the first candidate of every request randomly by weight,
+	// the others remain in order as fallbacks.
+	// Optional. Default: false
+	LoadBalance bool
+	// Cooldown marks a candidate unhealthy for this long after a request fails with an error
+	// that should fall back. Unhealthy candidates are tried only after all healthy ones.
+	// Optional. Default: 0, candidates are always tried in order.
+	Cooldown time.Duration
+}
+
+// Error is returned when all candidates failed, it unwraps to the error of the last candidate.
+type Error struct {
+	Names  []string
+	Errors []error
+}
+
+func (e *Error) Error() string {
+	sb := strings.Builder{}
+	sb.WriteString("[fallback] all chat models failed")
+	for i, err := range e.Errors {
+		sb.WriteString(fmt.Sprintf("; %s: %v", e.Names[i], err))
+	}
+	return sb.String()
+}
+
+func (e *Error) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e.Errors[len(e.Errors)-1]
+}
+
+var _ model.ToolCallingChatModel = (*ChatModel)(nil)
+
+// ChatModel sends requests to the first candidate, and falls back to the next one
+// when a request fails with a retryable error, e.g. timeout, 429 or 5xx.
+type ChatModel struct {
+	config     *Config
+	candidates []*candidate
+	now        func() time.Time
+	intn       func(n int) int
+}
+
+type candidate struct {
+	*Candidate
+	// unhealthyUntil is shared by the chat models derived by WithTools.
+	unhealthyUntil *int64
+}
+
+func NewChatModel(_ context.Context, config *Config) (*ChatModel, error) {
+	if config == nil || len(config.Candidates) == 0 {
+		return nil, errors.New("[NewChatModel] candidates not provided")
+	}
+	if config.Cooldown < 0 {
+		return nil, errors.New("[NewChatModel] cooldown must not be negative")
+	}
+
+	candidates := make([]*candidate, len(config.Candidates))
+	for i, c := range config.Candidates {
+		if c == nil || c.ChatModel == nil {
+			return nil, fmt.Errorf("[NewChatModel] chat model of candidate %d not provided", i)
+		}
+		if c.Weight < 0 {
+			return nil, fmt.Errorf("[NewChatModel] weight of candidate %d must not be negative", i)
+		}
+		cc := *c
+		if cc.Weight == 0 {
+			cc.Weight = 1
+		}
+		if len(cc.Name) == 0 {
+			if t, ok := components.GetType(cc.ChatModel); ok {
+				cc.Name = t
+			} else {
+				cc.Name = fmt.Sprintf("candidate_%d", i)
+			}
+		}
+		candidates[i] = &candidate{Candidate: &cc, unhealthyUntil: new(int64)}
+	}
+	if config.ShouldFallback == nil {
+		config.ShouldFallback = DefaultShouldFallback
+	}
+
+	return &ChatModel{
+		config:     config,
+		candidates: candidates,
+		now:        time.Now,
+		intn:       rand.Intn,
+	}, nil
+}
+
+func (c *ChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	fe := &Error{}
+	for _, cand := range c.order() {
+		out, err := cand.ChatModel.Generate(ctx, input, opts...)
+		if err == nil {
+			return out, nil
+		}
+		if !c.fallback(ctx, cand, err) {
+			return nil, err
+		}
+		fe.Names = append(fe.Names, cand.Name)
+		fe.Errors = append(fe.Errors, err)
+	}
+	return nil, fe
+}
+
+// Stream falls back when Stream returns an error, or when the first chunk is an error, since
+// nothing has been passed to the caller yet. Errors after the first chunk are returned as is.
+func (c *ChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	fe := &Error{}
+	for _, cand := range c.order() {
+		sr, err := cand.ChatModel.Stream(ctx, input, opts...)
+		if err == nil {
+			var first *schema.Message
+			first, err = sr.Recv()
+			if err == nil || err == io.EOF {
+				return prepend(first, err, sr), nil
+			}
+			sr.Close()
+		}
+		if !c.fallback(ctx, cand, err) {
+			return nil, err
+		}
+		fe.Names = append(fe.Names, cand.Name)
+		fe.Errors = append(fe.Errors, err)
+	}
+	return nil, fe
+}
+
+func (c *ChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	candidates := make([]*candidate, len(c.candidates))
+	for i, cand := range c.candidates {
+		cm, err := cand.ChatModel.WithTools(tools)
+		if err != nil {
+			return nil, fmt.Errorf("[fallback] bind tools to %s failed: %w", cand.Name, err)
+		}
+		cc := *cand.Candidate
+		cc.ChatModel = cm
+		candidates[i] = &candidate{Candidate: &cc, unhealthyUntil: cand.unhealthyUntil}
+	}
+	return &ChatModel{
+		config:     c.config,
+		candidates: candidates,
+		now:        c.now,
+		intn:       c.intn,
+	}, nil
+}
+
+// order returns the candidates to try for one request: healthy ones first, the first of them
+// picked by weight if load balancing, then unhealthy ones as the last resort.
+func (c *ChatModel) order() []*candidate {
+	now := c.now().UnixNano()
+	healthy := make([]*candidate, 0, len(c.candidates))
+	var unhealthy []*candidate
+	for _, cand := range c.candidates {
+		if atomic.LoadInt64(cand.unhealthyUntil) > now {
+			unhealthy = append(unhealthy, cand)
+		} else {
+			healthy = append(healthy, cand)
+		}
+	}
+
+	if c.config.LoadBalance && len(healthy) > 1 {
+		var total int
+		for _, cand := range healthy {
+			total += cand.Weight
+		}
+		n := c.intn(total)
+		for i, cand := range healthy {
+			if n < cand.Weight {
+				copy(healthy[1:i+1], healthy[:i])
+				healthy[0] = cand
+				break
+			}
+			n -= cand.Weight
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (c *ChatModel) fallback(ctx context.Context, cand *candidate, err error) bool {
+	if !c.config.ShouldFallback(ctx, err) {
+		return false
+	}
+	if c.config.Cooldown > 0 {
+		atomic.StoreInt64(cand.unhealthyUntil, c.now().Add(c.config.Cooldown).UnixNano())
+	}
+	return true
+}
+
+func prepend(first *schema.Message, firstErr error, sr *schema.StreamReader[*schema.Message]) *schema.StreamReader[*schema.Message] {
+	if firstErr == io.EOF {
+		sr.Close()
+		return schema.StreamReaderFromArray([]*schema.Message{})
+	}
+
+	r, w := schema.Pipe[*schema.Message](1)
+	go func() {
+		defer func() {
+			sr.Close()
+			w.Close()
+		}()
+		if closed := w.Send(first, nil); closed {
+			return
+		}
+		for {
+			chunk, err := sr.Recv()
+			if err == io.EOF {
+				return
+			}
+			if closed := w.Send(chunk, err); closed || err != nil {
+				return
+			}
+		}
+	}()
+	return r
+}
+
+const typ = "Fallback"
+
+func (c *ChatModel) GetType() string {
+	return typ
+}
+
+// IsCallbacksEnabled returns true, callbacks are triggered by the candidate chat models.
+func (c *ChatModel) IsCallbacksEnabled() bool {
+	return true
+}
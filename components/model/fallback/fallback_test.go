@@ -0,0 +1,244 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	name      string
+	err       error
+	streamErr error
+	calls     int
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return schema.AssistantMessage(m.name, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	sr, sw := schema.Pipe[*schema.Message](2)
+	go func() {
+		defer sw.Close()
+		if m.streamErr != nil {
+			sw.Send(nil, m.streamErr)
+			return
+		}
+		sw.Send(schema.AssistantMessage(m.name, nil), nil)
+		sw.Send(schema.AssistantMessage("!", nil), nil)
+	}()
+	return sr, nil
+}
+
+func (m *mockChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return &mockChatModel{name: m.name + "+tools", err: m.err, streamErr: m.streamErr}, nil
+}
+
+func (m *mockChatModel) GetType() string {
+	return "Mock"
+}
+
+type apiError struct {
+	HTTPStatusCode int
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("api error, code: %d", e.HTTPStatusCode)
+}
+
+func TestNewChatModel(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewChatModel(ctx, &Config{})
+	assert.Error(t, err)
+	_, err = NewChatModel(ctx, &Config{Candidates: []*Candidate{{}}})
+	assert.Error(t, err)
+	_, err = NewChatModel(ctx, &Config{Candidates: []*Candidate{{ChatModel: &mockChatModel{}, Weight: -1}}})
+	assert.Error(t, err)
+
+	cm, err := NewChatModel(ctx, &Config{Candidates: []*Candidate{{ChatModel: &mockChatModel{}}, {ChatModel: &mockChatModel{}, Name: "b"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Mock", cm.candidates[0].Name)
+	assert.Equal(t, "b", cm.candidates[1].Name)
+	assert.Equal(t, 1, cm.candidates[0].Weight)
+}
+
+func TestGenerate(t *testing.T) {
+	ctx := context.Background()
+	input := []*schema.Message{schema.UserMessage("hi")}
+
+	t.Run("fallback on retryable error", func(t *testing.T) {
+		a := &mockChatModel{name: "a", err: &apiError{HTTPStatusCode: 429}}
+		b := &mockChatModel{name: "b", err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded)}
+		c := &mockChatModel{name: "c"}
+		cm, err := NewChatModel(ctx, &Config{Candidates: []*Candidate{{ChatModel: a}, {ChatModel: b}, {ChatModel: c}}})
+		assert.NoError(t, err)
+
+		out, err := cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "c", out.Content)
+		assert.Equal(t, []int{1, 1, 1}, []int{a.calls, b.calls, c.calls})
+	})
+
+	t.Run("stop on non-retryable error", func(t *testing.T) {
+		a := &mockChatModel{name: "a", err: &apiError{HTTPStatusCode: 400}}
+		b := &mockChatModel{name: "b"}
+		cm, err := NewChatModel(ctx, &Config{Candidates: []*Candidate{{ChatModel: a}, {ChatModel: b}}})
+		assert.NoError(t, err)
+
+		_, err = cm.Generate(ctx, input)
+		var ae *apiError
+		assert.True(t, errors.As(err, &ae))
+		assert.Equal(t, 0, b.calls)
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		a := &mockChatModel{name: "a", err: errors.New("status code: 503")}
+		b := &mockChatModel{name: "b", err: errors.New("request timed out")}
+		cm, err := NewChatModel(ctx, &Config{Candidates: []*Candidate{{ChatModel: a, Name: "a"}, {ChatModel: b, Name: "b"}}})
+		assert.NoError(t, err)
+
+		_, err = cm.Generate(ctx, input)
+		var fe *Error
+		assert.True(t, errors.As(err, &fe))
+		assert.Equal(t, []string{"a", "b"}, fe.Names)
+		assert.Equal(t, "[fallback] all chat models failed; a: status code: 503; b: request timed out", err.Error())
+		assert.Equal(t, b.err, errors.Unwrap(err))
+	})
+
+	t.Run("cooldown", func(t *testing.T) {
+		a := &mockChatModel{name: "a", err: errors.New("status code: 500")}
+		b := &mockChatModel{name: "b"}
+		cm, err := NewChatModel(ctx, &Config{
+			Candidates: []*Candidate{{ChatModel: a}, {ChatModel: b}},
+			Cooldown:   time.Minute,
+		})
+		assert.NoError(t, err)
+		now := time.Unix(0, 0)
+		cm.now = func() time.Time { return now }
+
+		_, err = cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		_, err = cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, a.calls)
+		assert.Equal(t, 2, b.calls)
+
+		// health is shared with chat models bound with tools
+		withTools, err := cm.WithTools(nil)
+		assert.NoError(t, err)
+		out, err := withTools.Generate(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "b+tools", out.Content)
+
+		now = now.Add(2 * time.Minute)
+		a.err = nil
+		out, err = cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "a", out.Content)
+	})
+
+	t.Run("load balance", func(t *testing.T) {
+		a := &mockChatModel{name: "a"}
+		b := &mockChatModel{name: "b"}
+		c := &mockChatModel{name: "c"}
+		cm, err := NewChatModel(ctx, &Config{
+			Candidates:  []*Candidate{{ChatModel: a, Weight: 1}, {ChatModel: b, Weight: 2}, {ChatModel: c, Weight: 3}},
+			LoadBalance: true,
+		})
+		assert.NoError(t, err)
+
+		for n, want := range []string{"a", "b", "b", "c", "c", "c"} {
+			cm.intn = func(int) int { return n }
+			order := cm.order()
+			assert.Equal(t, want, order[0].ChatModel.(*mockChatModel).name)
+			assert.Len(t, order, 3)
+		}
+		cm.intn = func(int) int { return 5 }
+		order := cm.order()
+		assert.Equal(t, []string{"c", "a", "b"}, []string{
+			order[0].ChatModel.(*mockChatModel).name,
+			order[1].ChatModel.(*mockChatModel).name,
+			order[2].ChatModel.(*mockChatModel).name,
+		})
+	})
+}
+
+func TestStream(t *testing.T) {
+	ctx := context.Background()
+	input := []*schema.Message{schema.UserMessage("hi")}
+
+	a := &mockChatModel{name: "a", err: errors.New("429 Too Many Requests")}
+	b := &mockChatModel{name: "b", streamErr: errors.New("service unavailable")}
+	c := &mockChatModel{name: "c"}
+	cm, err := NewChatModel(ctx, &Config{Candidates: []*Candidate{{ChatModel: a}, {ChatModel: b}, {ChatModel: c}}})
+	assert.NoError(t, err)
+
+	sr, err := cm.Stream(ctx, input)
+	assert.NoError(t, err)
+	defer sr.Close()
+	var contents []string
+	for {
+		msg, err := sr.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		contents = append(contents, msg.Content)
+	}
+	assert.Equal(t, []string{"c", "!"}, contents)
+	assert.Equal(t, []int{1, 1, 1}, []int{a.calls, b.calls, c.calls})
+}
+
+func TestShouldFallback(t *testing.T) {
+	ctx := context.Background()
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	assert.True(t, DefaultShouldFallback(ctx, &apiError{HTTPStatusCode: 502}))
+	assert.True(t, DefaultShouldFallback(ctx, fmt.Errorf("call: %w", &apiError{HTTPStatusCode: 408})))
+	assert.False(t, DefaultShouldFallback(ctx, &apiError{HTTPStatusCode: 401}))
+	assert.True(t, DefaultShouldFallback(ctx, io.ErrUnexpectedEOF))
+	assert.True(t, DefaultShouldFallback(ctx, errors.New("error, status code: 529, message: overloaded")))
+	assert.True(t, DefaultShouldFallback(ctx, errors.New("dial tcp: connection refused")))
+	assert.False(t, DefaultShouldFallback(ctx, errors.New("invalid request")))
+	assert.False(t, DefaultShouldFallback(canceled, context.Canceled))
+	assert.False(t, DefaultShouldFallback(canceled, &apiError{HTTPStatusCode: 503}))
+
+	code, ok := StatusCode(errors.New("POST https://api: 404 Not Found, status=404"))
+	assert.True(t, ok)
+	assert.Equal(t, 404, code)
+	_, ok = StatusCode(errors.New("no code"))
+	assert.False(t, ok)
+}
@@ -18,9 +18,11 @@ package gemini
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"strings"
 
 	"github.com/bytedance/sonic"
 	"github.com/cloudwego/eino/callbacks"
@@ -301,6 +303,7 @@ func (cm *ChatModel) initGenerativeModelSession(opts ...model.Option) (*genai.Ch
 	geminiOptions := model.GetImplSpecificOptions(&options{
 		TopK:           cm.topK,
 		ResponseSchema: cm.responseSchema,
+		SafetySettings: cm.safetySettings,
 	}, opts...)
 	conf := &model.Config{}
 
@@ -312,7 +315,7 @@ func (cm *ChatModel) initGenerativeModelSession(opts ...model.Option) (*genai.Ch
 		m = cm.cli.GenerativeModel(cm.model)
 		conf.Model = cm.model
 	}
-	m.SafetySettings = cm.safetySettings
+	m.SafetySettings = geminiOptions.SafetySettings
 
 	tools := cm.tools
 	if commonOptions.Tools != nil {
@@ -522,48 +525,83 @@ func (cm *ChatModel) convSchemaMessage(message *schema.Message) (*genai.Content,
 		if message.Content != "" {
 			content.Parts = append(content.Parts, genai.Text(message.Content))
 		}
-		content.Parts = append(content.Parts, cm.convMedia(message.MultiContent)...)
+		media, err := cm.convMedia(message.MultiContent)
+		if err != nil {
+			return nil, err
+		}
+		content.Parts = append(content.Parts, media...)
 	}
 	return content, nil
 }
 
-func (cm *ChatModel) convMedia(contents []schema.ChatMessagePart) []genai.Part {
+func (cm *ChatModel) convMedia(contents []schema.ChatMessagePart) ([]genai.Part, error) {
 	result := make([]genai.Part, 0, len(contents))
 	for _, content := range contents {
+		var (
+			part genai.Part
+			err  error
+		)
 		switch content.Type {
 		case schema.ChatMessagePartTypeText:
-			result = append(result, genai.Text(content.Text))
+			part = genai.Text(content.Text)
 		case schema.ChatMessagePartTypeImageURL:
 			if content.ImageURL != nil {
-				result = append(result, genai.FileData{
-					MIMEType: content.ImageURL.MIMEType,
-					URI:      content.ImageURL.URI,
-				})
+				part, err = convMediaPart(content.ImageURL.URL, content.ImageURL.URI, content.ImageURL.MIMEType)
 			}
 		case schema.ChatMessagePartTypeAudioURL:
 			if content.AudioURL != nil {
-				result = append(result, genai.FileData{
-					MIMEType: content.AudioURL.MIMEType,
-					URI:      content.AudioURL.URI,
-				})
+				part, err = convMediaPart(content.AudioURL.URL, content.AudioURL.URI, content.AudioURL.MIMEType)
 			}
 		case schema.ChatMessagePartTypeVideoURL:
 			if content.VideoURL != nil {
-				result = append(result, genai.FileData{
-					MIMEType: content.VideoURL.MIMEType,
-					URI:      content.VideoURL.URI,
-				})
+				part, err = convMediaPart(content.VideoURL.URL, content.VideoURL.URI, content.VideoURL.MIMEType)
 			}
 		case schema.ChatMessagePartTypeFileURL:
 			if content.FileURL != nil {
-				result = append(result, genai.FileData{
-					MIMEType: content.FileURL.MIMEType,
-					URI:      content.FileURL.URI,
-				})
+				part, err = convMediaPart(content.FileURL.URL, content.FileURL.URI, content.FileURL.MIMEType)
 			}
 		}
+		if err != nil {
+			return nil, fmt.Errorf("convert %s part fail: %w", content.Type, err)
+		}
+		if part != nil {
+			result = append(result, part)
+		}
+	}
+	return result, nil
+}
+
+// convMediaPart prefers URI (e.g. uploaded file uri) as FileData,
+// otherwise URL in data uri format (data:<mime>;base64,<data>) will be sent as inline Blob.
+func convMediaPart(url, uri, mimeType string) (genai.Part, error) {
+	if uri == "" && strings.HasPrefix(url, "data:") {
+		header, data, found := strings.Cut(strings.TrimPrefix(url, "data:"), ",")
+		if !found || !strings.HasSuffix(header, ";base64") {
+			return nil, fmt.Errorf("invalid data uri, only base64 encoding is supported")
+		}
+		raw, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, fmt.Errorf("decode base64 data fail: %w", err)
+		}
+		if mimeType == "" {
+			mimeType = strings.TrimSuffix(header, ";base64")
+		}
+		return genai.Blob{
+			MIMEType: mimeType,
+			Data:     raw,
+		}, nil
 	}
-	return result
+
+	if uri == "" {
+		uri = url
+	}
+	if uri == "" {
+		return nil, nil
+	}
+	return genai.FileData{
+		MIMEType: mimeType,
+		URI:      uri,
+	}, nil
 }
 
 func (cm *ChatModel) convResponse(resp *genai.GenerateContentResponse) (*schema.Message, error) {
@@ -265,3 +265,24 @@ func TestWithTools(t *testing.T) {
 	assert.Equal(t, "test model", ncm.(*ChatModel).model)
 	assert.Equal(t, "test tool name", ncm.(*ChatModel).origTools[0].Name)
 }
+
+func TestConvMediaPart(t *testing.T) {
+	part, err := convMediaPart("", "https://example.com/sunset.jpg", "image/jpeg")
+	assert.NoError(t, err)
+	assert.Equal(t, genai.FileData{MIMEType: "image/jpeg", URI: "https://example.com/sunset.jpg"}, part)
+
+	part, err = convMediaPart("gs://bucket/video.mp4", "", "video/mp4")
+	assert.NoError(t, err)
+	assert.Equal(t, genai.FileData{MIMEType: "video/mp4", URI: "gs://bucket/video.mp4"}, part)
+
+	part, err = convMediaPart("data:image/png;base64,aGVsbG8=", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, genai.Blob{MIMEType: "image/png", Data: []byte("hello")}, part)
+
+	_, err = convMediaPart("data:image/png,hello", "", "")
+	assert.Error(t, err)
+
+	part, err = convMediaPart("", "", "")
+	assert.NoError(t, err)
+	assert.Nil(t, part)
+}
@@ -19,11 +19,13 @@ package gemini
 import (
 	"github.com/cloudwego/eino/components/model"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/generative-ai-go/genai"
 )
 
 type options struct {
 	TopK           *int32
 	ResponseSchema *openapi3.Schema
+	SafetySettings []*genai.SafetySetting
 }
 
 func WithTopK(k int32) model.Option {
@@ -37,3 +39,10 @@ func WithResponseSchema(s *openapi3.Schema) model.Option {
 		o.ResponseSchema = s
 	})
 }
+
+// WithSafetySettings overrides Config.SafetySettings for a single request.
+func WithSafetySettings(settings []*genai.SafetySetting) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *options) {
+		o.SafetySettings = settings
+	})
+}
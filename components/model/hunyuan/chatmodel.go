@@ -0,0 +1,240 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hunyuan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultBaseURL = "https://api.hunyuan.cloud.tencent.com/v1"
+
+var _ model.ToolCallingChatModel = (*ChatModel)(nil)
+
+// ChatModelConfig parameters detail see:
+// https://cloud.tencent.com/document/product/1729/111007
+type ChatModelConfig struct {
+	// APIKey is your Hunyuan API key, created in the console of Hunyuan
+	// Required
+	APIKey string `json:"api_key"`
+
+	// Timeout specifies the maximum duration to wait for API responses
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: no timeout
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"http_client"`
+
+	// BaseURL specifies the OpenAI compatible endpoint of Hunyuan
+	// Optional. Default: https://api.hunyuan.cloud.tencent.com/v1
+	BaseURL string `json:"base_url"`
+
+	// Model specifies the ID of the model to use
+	// Required. Example: hunyuan-turbos-latest, hunyuan-functioncall
+	Model string `json:"model"`
+
+	// MaxTokens limits the maximum number of tokens that can be generated in the chat completion
+	// Optional. Default: model's maximum
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
+	// Temperature specifies what sampling temperature to use
+	// Generally recommend altering this or TopP but not both.
+	// Range: 0.0 to 2.0. Higher values make output more random
+	// Optional. Default: the default of the model
+	Temperature *float32 `json:"temperature,omitempty"`
+
+	// TopP controls diversity via nucleus sampling
+	// Generally recommend altering this or Temperature but not both.
+	// Range: 0.0 to 1.0. Lower values make output more focused
+	// Optional. Default: the default of the model
+	TopP *float32 `json:"top_p,omitempty"`
+
+	// Stop sequences where the API will stop generating further tokens
+	// Optional. Example: []string{"\n", "User:"}
+	Stop []string `json:"stop,omitempty"`
+
+	// PresencePenalty prevents repetition by penalizing tokens based on presence
+	// Range: -2.0 to 2.0. Positive values increase likelihood of new topics
+	// Optional. Default: 0
+	PresencePenalty *float32 `json:"presence_penalty,omitempty"`
+
+	// FrequencyPenalty prevents repetition by penalizing tokens based on frequency
+	// Range: -2.0 to 2.0. Positive values decrease likelihood of repetition
+	// Optional. Default: 0
+	FrequencyPenalty *float32 `json:"frequency_penalty,omitempty"`
+
+	// Seed enables deterministic sampling for consistent outputs
+	// Optional. Set for reproducible results
+	Seed *int `json:"seed,omitempty"`
+
+	// EnableEnhancement enables the search enhancement of Hunyuan, which searches the web for the answers.
+	// Disable it to reduce the latency, or when the answers should only be based on the messages.
+	// Optional. Default: true, enabled by Hunyuan
+	EnableEnhancement *bool `json:"enable_enhancement,omitempty"`
+}
+
+type ChatModel struct {
+	cli *openai.Client
+}
+
+func NewChatModel(ctx context.Context, config *ChatModelConfig) (*ChatModel, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[NewChatModel] config not provided")
+	}
+	if len(config.Model) == 0 {
+		return nil, fmt.Errorf("[NewChatModel] model is required")
+	}
+
+	var httpClient *http.Client
+
+	if config.HTTPClient != nil {
+		httpClient = config.HTTPClient
+	} else {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+	if config.EnableEnhancement != nil {
+		c := *httpClient
+		c.Transport = &bodyFieldsTransport{
+			base:   c.Transport,
+			fields: map[string]any{"enable_enhancement": *config.EnableEnhancement},
+		}
+		httpClient = &c
+	}
+
+	baseURL := config.BaseURL
+	if len(baseURL) == 0 {
+		baseURL = defaultBaseURL
+	}
+
+	cli, err := openai.NewClient(ctx, &openai.Config{
+		BaseURL:          baseURL,
+		APIKey:           config.APIKey,
+		HTTPClient:       httpClient,
+		Model:            config.Model,
+		MaxTokens:        config.MaxTokens,
+		Temperature:      config.Temperature,
+		TopP:             config.TopP,
+		Stop:             config.Stop,
+		PresencePenalty:  config.PresencePenalty,
+		FrequencyPenalty: config.FrequencyPenalty,
+		Seed:             config.Seed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatModel{
+		cli: cli,
+	}, nil
+}
+
+func (cm *ChatModel) Generate(ctx context.Context, in []*schema.Message, opts ...model.Option) (
+	outMsg *schema.Message, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, cm.GetType(), components.ComponentOfChatModel)
+	return cm.cli.Generate(ctx, in, opts...)
+}
+
+func (cm *ChatModel) Stream(ctx context.Context, in []*schema.Message, opts ...model.Option) (
+	outStream *schema.StreamReader[*schema.Message], err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, cm.GetType(), components.ComponentOfChatModel)
+	return cm.cli.Stream(ctx, in, opts...)
+}
+
+func (cm *ChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	cli, err := cm.cli.WithToolsForClient(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &ChatModel{cli: cli}, nil
+}
+
+func (cm *ChatModel) BindTools(tools []*schema.ToolInfo) error {
+	return cm.cli.BindTools(tools)
+}
+
+func (cm *ChatModel) BindForcedTools(tools []*schema.ToolInfo) error {
+	return cm.cli.BindForcedTools(tools)
+}
+
+const typ = "Hunyuan"
+
+func (cm *ChatModel) GetType() string {
+	return typ
+}
+
+func (cm *ChatModel) IsCallbacksEnabled() bool {
+	return cm.cli.IsCallbacksEnabled()
+}
+
+// bodyFieldsTransport adds the fields of Hunyuan, which OpenAI chat completion request doesn't have, to request bodies.
+type bodyFieldsTransport struct {
+	base   http.RoundTripper
+	fields map[string]any
+}
+
+func (t *bodyFieldsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if req.Body == nil || req.Method != http.MethodPost {
+		return base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	var payload map[string]json.RawMessage
+	if err = json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request body: %w", err)
+	}
+	for k, v := range t.fields {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal field %s: %w", k, err)
+		}
+		payload[k] = raw
+	}
+	if body, err = json.Marshal(payload); err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hunyuan
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChatModel(t *testing.T) {
+	convey.Convey("test ChatModel", t, func() {
+		ctx := context.Background()
+
+		var (
+			header http.Header
+			req    map[string]any
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/chat/completions" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			header = r.Header
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &req)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"1","object":"chat.completion","created":0,"model":"hunyuan-functioncall",` +
+				`"choices":[{"index":0,"message":{"role":"assistant","content":"","tool_calls":[{"id":"call_1","type":"function",` +
+				`"function":{"name":"get_weather","arguments":"{\"city\":\"深圳\"}"}}]},"finish_reason":"tool_calls"}],` +
+				`"usage":{"prompt_tokens":20,"completion_tokens":10,"total_tokens":30}}`))
+		}))
+		defer srv.Close()
+
+		enableEnhancement := false
+		cm, err := NewChatModel(ctx, &ChatModelConfig{
+			APIKey:            "sk-mock",
+			BaseURL:           srv.URL + "/v1",
+			Model:             "hunyuan-functioncall",
+			EnableEnhancement: &enableEnhancement,
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		tcm, err := cm.WithTools([]*schema.ToolInfo{{
+			Name: "get_weather",
+			Desc: "get the weather of a city",
+			ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+				"city": {Type: schema.String, Required: true},
+			}),
+		}})
+		convey.So(err, convey.ShouldBeNil)
+
+		msg, err := tcm.Generate(ctx, []*schema.Message{schema.UserMessage("深圳天气怎么样")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(msg.ToolCalls), convey.ShouldEqual, 1)
+		convey.So(msg.ToolCalls[0].Function.Name, convey.ShouldEqual, "get_weather")
+		convey.So(msg.ToolCalls[0].Function.Arguments, convey.ShouldEqual, `{"city":"深圳"}`)
+		convey.So(msg.ResponseMeta.Usage.TotalTokens, convey.ShouldEqual, 30)
+
+		convey.So(header.Get("Authorization"), convey.ShouldEqual, "Bearer sk-mock")
+		convey.So(req["model"], convey.ShouldEqual, "hunyuan-functioncall")
+		convey.So(req["enable_enhancement"], convey.ShouldEqual, false)
+		convey.So(len(req["tools"].([]any)), convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("test NewChatModel", t, func() {
+		_, err := NewChatModel(context.Background(), &ChatModelConfig{APIKey: "sk-mock"})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		client := &http.Client{}
+		enableEnhancement := true
+		_, err = NewChatModel(context.Background(), &ChatModelConfig{
+			APIKey:            "sk-mock",
+			Model:             "hunyuan-turbos-latest",
+			HTTPClient:        client,
+			EnableEnhancement: &enableEnhancement,
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(client.Transport, convey.ShouldBeNil)
+	})
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/hunyuan"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := hunyuan.NewChatModel(ctx, &hunyuan.ChatModelConfig{
+		APIKey: os.Getenv("HUNYUAN_API_KEY"),
+		Model:  "hunyuan-turbos-latest",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of hunyuan failed, err=%v", err)
+	}
+
+	msg, err := cm.Generate(ctx, []*schema.Message{
+		schema.SystemMessage("你是一个乐于助人的助手"),
+		schema.UserMessage("法国的首都是哪里？"),
+	})
+	if err != nil {
+		log.Fatalf("Generate of hunyuan failed, err=%v", err)
+	}
+
+	fmt.Println(msg)
+}
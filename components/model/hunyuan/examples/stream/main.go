@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/hunyuan"
+)
+
+func main() {
+	ctx := context.Background()
+
+	enableEnhancement := false
+	cm, err := hunyuan.NewChatModel(ctx, &hunyuan.ChatModelConfig{
+		APIKey: os.Getenv("HUNYUAN_API_KEY"),
+		Model:  "hunyuan-turbos-latest",
+		// answer without the web search, for lower latency
+		EnableEnhancement: &enableEnhancement,
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of hunyuan failed, err=%v", err)
+	}
+
+	sr, err := cm.Stream(ctx, []*schema.Message{
+		schema.UserMessage("写一首关于大海的短诗"),
+	})
+	if err != nil {
+		log.Fatalf("Stream of hunyuan failed, err=%v", err)
+	}
+	defer sr.Close()
+
+	for {
+		msg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Recv of hunyuan failed, err=%v", err)
+		}
+		fmt.Print(msg.Content)
+	}
+	fmt.Println()
+}
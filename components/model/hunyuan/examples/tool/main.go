@@ -0,0 +1,62 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/hunyuan"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := hunyuan.NewChatModel(ctx, &hunyuan.ChatModelConfig{
+		APIKey: os.Getenv("HUNYUAN_API_KEY"),
+		Model:  "hunyuan-functioncall",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of hunyuan failed, err=%v", err)
+	}
+
+	tcm, err := cm.WithTools([]*schema.ToolInfo{{
+		Name: "get_weather",
+		Desc: "查询城市的天气",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"city": {Type: schema.String, Desc: "城市名", Required: true},
+		}),
+	}})
+	if err != nil {
+		log.Fatalf("WithTools of hunyuan failed, err=%v", err)
+	}
+
+	msg, err := tcm.Generate(ctx, []*schema.Message{
+		schema.UserMessage("深圳今天天气怎么样？"),
+	})
+	if err != nil {
+		log.Fatalf("Generate of hunyuan failed, err=%v", err)
+	}
+
+	for _, tc := range msg.ToolCalls {
+		fmt.Printf("call %s with %s\n", tc.Function.Name, tc.Function.Arguments)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/cloudwego/eino/schema"
 
 	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino-ext/libs/acl/retry"
 )
 
 var _ model.ToolCallingChatModel = (*ChatModel)(nil)
@@ -34,7 +35,7 @@ var _ model.ToolCallingChatModel = (*ChatModel)(nil)
 type ChatModelConfig struct {
 	// APIKey is your authentication key
 	// Use OpenAI API key or Azure API key depending on the service
-	// Required
+	// Required, unless AzureTokenCredential is set
 	APIKey string `json:"api_key"`
 
 	// Timeout specifies the maximum duration to wait for API responses
@@ -47,6 +48,11 @@ type ChatModelConfig struct {
 	// Optional. Default &http.Client{Timeout: Timeout}
 	HTTPClient *http.Client `json:"http_client"`
 
+	// Retry retries failed requests by the retry layer shared by the models and embedders, with jittered exponential
+	// backoff honoring Retry-After, e.g. on 429, 5xx and connection resets.
+	// Optional. Default: nil, requests are not retried
+	Retry *retry.Config `json:"retry,omitempty"`
+
 	// The following three fields are only required when using Azure OpenAI Service, otherwise they can be ignored.
 	// For more details, see: https://learn.microsoft.com/en-us/azure/ai-services/openai/
 
@@ -63,6 +69,18 @@ type ChatModelConfig struct {
 	// Required for Azure
 	APIVersion string `json:"api_version"`
 
+	// AzureTokenCredential authenticates with Azure AD (Microsoft Entra ID) tokens instead of APIKey, e.g. by
+	// openai.NewAzureManagedIdentityCredential or openai.NewAzureClientSecretCredential of libs/acl/openai.
+	// Tokens are refreshed before they expire.
+	// Optional for Azure
+	AzureTokenCredential openai.AzureTokenCredential `json:"-"`
+
+	// AzureDeployments maps models to the names of the Azure deployments, e.g. {"gpt-4o": "my-gpt-4o"},
+	// models not in the map are mapped to deployments by removing "." and ":", e.g. "gpt-3.5-turbo" to "gpt-35-turbo".
+	// It also applies to models of model.WithModel.
+	// Optional for Azure
+	AzureDeployments map[string]string `json:"azure_deployments,omitempty"`
+
 	// The following fields correspond to OpenAI's chat completion API parameters
 	// Ref: https://platform.openai.com/docs/api-reference/chat/create
 
@@ -121,6 +139,43 @@ type ChatModelConfig struct {
 
 	// TopLogProbs specifies the number of most likely tokens to return at each token position, each with an associated log probability.
 	TopLogProbs int `json:"top_log_probs"`
+
+	// ParallelToolCalls specifies whether the model may call multiple tools in one response.
+	// Set it to false to get at most one tool call per response. Only sent when tools are provided.
+	// Optional. Default: decided by the model service, true for OpenAI
+	ParallelToolCalls *bool `json:"parallel_tool_calls,omitempty"`
+
+	// StrictTools sends all tools with strict: true, so that arguments of tool calls always match the parameters schema.
+	// Strict schemas require all fields to be required and no additional properties.
+	// Optional. Default: false
+	StrictTools bool `json:"strict_tools"`
+
+	// RepairToolCallArguments repairs the arguments of streamed tool calls, e.g. unclosed objects or trailing garbage,
+	// so that the concatenated arguments are valid json.
+	// Optional. Default: false
+	RepairToolCallArguments bool `json:"repair_tool_call_arguments,omitempty"`
+
+	// Modalities are the types of output the model generates, e.g. []openai.Modality{openai.ModalityText, openai.ModalityAudio}
+	// for audio output. The generated audio is returned as an audio part of MultiContent, and its transcript as Content.
+	// Optional. Default: text only
+	Modalities []openai.Modality `json:"modalities,omitempty"`
+
+	// Audio configures the voice and format of the output audio.
+	// Required when Modalities contains openai.ModalityAudio.
+	Audio *openai.AudioOutput `json:"audio,omitempty"`
+
+	// UseResponsesAPI sends requests to the Responses API instead of chat completions, which supports built-in tools
+	// and reasoning summaries. Fields not supported by Responses API, e.g. Stop and Seed, are ignored.
+	// Optional. Default: false
+	UseResponsesAPI bool `json:"use_responses_api"`
+
+	// BuiltInTools are the tools run by OpenAI, e.g. web_search_preview and file_search. Only for UseResponsesAPI.
+	// Optional.
+	BuiltInTools []*openai.BuiltInTool `json:"built_in_tools,omitempty"`
+
+	// Reasoning configures the effort and summary of reasoning models. Only for UseResponsesAPI.
+	// Optional.
+	Reasoning *openai.Reasoning `json:"reasoning,omitempty"`
 }
 
 var _ model.ChatModel = (*ChatModel)(nil)
@@ -139,26 +194,39 @@ func NewChatModel(ctx context.Context, config *ChatModelConfig) (*ChatModel, err
 		} else {
 			httpClient = &http.Client{Timeout: config.Timeout}
 		}
+		if config.Retry != nil {
+			httpClient = retry.WrapHTTPClient(httpClient, config.Retry)
+		}
 
 		nConf = &openai.Config{
-			ByAzure:          config.ByAzure,
-			BaseURL:          config.BaseURL,
-			APIVersion:       config.APIVersion,
-			APIKey:           config.APIKey,
-			HTTPClient:       httpClient,
-			Model:            config.Model,
-			MaxTokens:        config.MaxTokens,
-			Temperature:      config.Temperature,
-			TopP:             config.TopP,
-			Stop:             config.Stop,
-			PresencePenalty:  config.PresencePenalty,
-			ResponseFormat:   config.ResponseFormat,
-			Seed:             config.Seed,
-			FrequencyPenalty: config.FrequencyPenalty,
-			LogitBias:        config.LogitBias,
-			User:             config.User,
-			LogProbs:         config.LogProbs,
-			TopLogProbs:      config.TopLogProbs,
+			ByAzure:                 config.ByAzure,
+			BaseURL:                 config.BaseURL,
+			APIVersion:              config.APIVersion,
+			AzureTokenCredential:    config.AzureTokenCredential,
+			AzureDeployments:        config.AzureDeployments,
+			APIKey:                  config.APIKey,
+			HTTPClient:              httpClient,
+			Model:                   config.Model,
+			MaxTokens:               config.MaxTokens,
+			Temperature:             config.Temperature,
+			TopP:                    config.TopP,
+			Stop:                    config.Stop,
+			PresencePenalty:         config.PresencePenalty,
+			ResponseFormat:          config.ResponseFormat,
+			Seed:                    config.Seed,
+			FrequencyPenalty:        config.FrequencyPenalty,
+			LogitBias:               config.LogitBias,
+			User:                    config.User,
+			LogProbs:                config.LogProbs,
+			TopLogProbs:             config.TopLogProbs,
+			ParallelToolCalls:       config.ParallelToolCalls,
+			StrictTools:             config.StrictTools,
+			RepairToolCallArguments: config.RepairToolCallArguments,
+			Modalities:              config.Modalities,
+			Audio:                   config.Audio,
+			UseResponsesAPI:         config.UseResponsesAPI,
+			BuiltInTools:            config.BuiltInTools,
+			Reasoning:               config.Reasoning,
 		}
 	}
 	cli, err := openai.NewClient(ctx, nConf)
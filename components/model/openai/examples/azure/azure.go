@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/openai"
+	aclopenai "github.com/cloudwego/eino-ext/libs/acl/openai"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// use the managed identity of the Azure host, e.g. a VM, AKS or App Service,
+	// or aclopenai.NewAzureClientSecretCredential for a service principal
+	cred, err := aclopenai.NewAzureManagedIdentityCredential(&aclopenai.AzureManagedIdentityCredentialConfig{
+		ClientID: os.Getenv("AZURE_CLIENT_ID"), // empty for the system-assigned identity
+	})
+	if err != nil {
+		log.Fatalf("NewAzureManagedIdentityCredential failed, err=%v", err)
+	}
+
+	chatModel, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		ByAzure:              true,
+		BaseURL:              "https://{RESOURCE_NAME}.openai.azure.com",
+		APIVersion:           "2024-06-01",
+		AzureTokenCredential: cred,
+		AzureDeployments: map[string]string{
+			"gpt-4o":      "prod-gpt-4o",
+			"gpt-4o-mini": "prod-gpt-4o-mini",
+		},
+		Model: "gpt-4o",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel failed, err=%v", err)
+	}
+
+	input := []*schema.Message{
+		schema.UserMessage("as a machine, how do you answer user's question?"),
+	}
+
+	// sent to the deployment prod-gpt-4o
+	resp, err := chatModel.Generate(ctx, input)
+	if err != nil {
+		log.Fatalf("Generate failed, err=%v", err)
+	}
+	fmt.Printf("output: \n%v\n", resp)
+
+	// sent to the deployment prod-gpt-4o-mini
+	resp, err = chatModel.Generate(ctx, input, model.WithModel("gpt-4o-mini"))
+	if err != nil {
+		log.Fatalf("Generate failed, err=%v", err)
+	}
+	fmt.Printf("output: \n%v\n", resp)
+}
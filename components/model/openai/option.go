@@ -0,0 +1,35 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"github.com/cloudwego/eino/components/model"
+
+	"github.com/cloudwego/eino-ext/libs/acl/openai"
+)
+
+// WithParallelToolCalls overrides ChatModelConfig.ParallelToolCalls for a single request,
+// set it to false to get at most one tool call in the response.
+func WithParallelToolCalls(parallel bool) model.Option {
+	return openai.WithParallelToolCalls(parallel)
+}
+
+// WithStrictTools sends the tools of the given names with strict: true for a single request,
+// in addition to all tools being strict when ChatModelConfig.StrictTools is enabled.
+func WithStrictTools(toolNames ...string) model.Option {
+	return openai.WithStrictTools(toolNames...)
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/model/ratelimit"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// echoChatModel stands for any real chat model, e.g. openai or ark.
+type echoChatModel struct{}
+
+func (e *echoChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return schema.AssistantMessage(input[len(input)-1].Content, nil), nil
+}
+
+func (e *echoChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	msg, err := e.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return schema.StreamReaderFromArray([]*schema.Message{msg}), nil
+}
+
+func (e *echoChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return e, nil
+}
+
+func main() {
+	ctx := context.Background()
+
+	// one limiter per account, shared by every chat model calling it
+	limiter, err := ratelimit.NewLimiter(&ratelimit.LimiterConfig{
+		Limits: map[string]ratelimit.Limit{
+			"gpt-4o-mini": {RequestsPerMinute: 30, TokensPerMinute: 40000},
+		},
+	})
+	if err != nil {
+		log.Fatalf("NewLimiter failed, err=%v", err)
+	}
+
+	cm, err := ratelimit.NewChatModel(ctx, &ratelimit.Config{
+		ChatModel: &echoChatModel{},
+		Limiter:   limiter,
+		Model:     "gpt-4o-mini",
+		MaxWait:   5 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel failed, err=%v", err)
+	}
+
+	// 30 requests pass at once, then one request is admitted every 2 seconds,
+	// requests that would wait longer than 5 seconds fail with ErrRateLimited
+	var wg sync.WaitGroup
+	for i := 0; i < 35; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start := time.Now()
+			_, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+			if errors.Is(err, ratelimit.ErrRateLimited) {
+				log.Printf("request %d rate limited: %v", i, err)
+				return
+			}
+			if err != nil {
+				log.Printf("request %d failed: %v", i, err)
+				return
+			}
+			log.Printf("request %d done in %v", i, time.Since(start))
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned when a request can not be admitted within the allowed wait.
+var ErrRateLimited = errors.New("rate limited")
+
+// Limit is the quota of a model, 0 means unlimited.
+type Limit struct {
+	// RequestsPerMinute is the number of requests allowed per minute.
+	RequestsPerMinute int
+	// TokensPerMinute is the number of tokens, prompt and completion, allowed per minute.
+	TokensPerMinute int
+}
+
+type LimiterConfig struct {
+	// Limits are the quotas by model name.
+	// Optional.
+	Limits map[string]Limit
+	// DefaultLimit applies to models not found in Limits.
+	// Optional. Default: models not found in Limits are not limited.
+	DefaultLimit *Limit
+}
+
+// Limiter keeps the quotas of models. It is safe for concurrent use, and should be shared by
+// all ChatModels calling the same account, so that they draw from the same quota.
+type Limiter struct {
+	limits       map[string]Limit
+	defaultLimit *Limit
+
+	mu      sync.Mutex
+	buckets map[string]*modelBuckets
+	now     func() time.Time
+}
+
+type modelBuckets struct {
+	requests *bucket
+	tokens   *bucket
+}
+
+func NewLimiter(config *LimiterConfig) (*Limiter, error) {
+	if config == nil {
+		config = &LimiterConfig{}
+	}
+	check := func(name string, l Limit) error {
+		if l.RequestsPerMinute < 0 || l.TokensPerMinute < 0 {
+			return fmt.Errorf("[NewLimiter] invalid limit of model %q: %+v", name, l)
+		}
+		return nil
+	}
+	for name, l := range config.Limits {
+		if err := check(name, l); err != nil {
+			return nil, err
+		}
+	}
+	if config.DefaultLimit != nil {
+		if err := check("", *config.DefaultLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Limiter{
+		limits:       config.Limits,
+		defaultLimit: config.DefaultLimit,
+		buckets:      make(map[string]*modelBuckets),
+		now:          time.Now,
+	}, nil
+}
+
+// reservation is the quota taken by one request, which is given back if the request is canceled,
+// and corrected by the actual token usage once known.
+type reservation struct {
+	limiter *Limiter
+	buckets *modelBuckets
+	tokens  int
+}
+
+// reserve takes one request and tokens from the quota of model, and returns how long the caller
+// has to wait before sending the request. Quota is not taken if the wait would exceed maxWait,
+// negative maxWait means no limit.
+func (l *Limiter) reserve(model string, tokens int, maxWait time.Duration) (*reservation, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	mb := l.modelBuckets(model)
+	if mb == nil {
+		return &reservation{limiter: l}, 0, nil
+	}
+	if mb.tokens != nil && float64(tokens) > mb.tokens.capacity {
+		return nil, 0, fmt.Errorf("%w: request of %d tokens exceeds tokens per minute limit %v of model %q",
+			ErrRateLimited, tokens, mb.tokens.capacity, model)
+	}
+
+	now := l.now()
+	var wait time.Duration
+	if mb.requests != nil {
+		wait = maxDuration(wait, mb.requests.delay(now, 1))
+	}
+	if mb.tokens != nil {
+		wait = maxDuration(wait, mb.tokens.delay(now, float64(tokens)))
+	}
+	if maxWait >= 0 && wait > maxWait {
+		return nil, wait, fmt.Errorf("%w: model %q needs to wait %v", ErrRateLimited, model, wait)
+	}
+
+	if mb.requests != nil {
+		mb.requests.take(1)
+	}
+	if mb.tokens != nil {
+		mb.tokens.take(float64(tokens))
+	}
+	return &reservation{limiter: l, buckets: mb, tokens: tokens}, wait, nil
+}
+
+func (l *Limiter) modelBuckets(model string) *modelBuckets {
+	if mb, ok := l.buckets[model]; ok {
+		return mb
+	}
+
+	limit, ok := l.limits[model]
+	if !ok {
+		if l.defaultLimit == nil {
+			return nil
+		}
+		limit = *l.defaultLimit
+	}
+	if limit.RequestsPerMinute == 0 && limit.TokensPerMinute == 0 {
+		return nil
+	}
+
+	now := l.now()
+	mb := &modelBuckets{}
+	if limit.RequestsPerMinute > 0 {
+		mb.requests = newBucket(limit.RequestsPerMinute, now)
+	}
+	if limit.TokensPerMinute > 0 {
+		mb.tokens = newBucket(limit.TokensPerMinute, now)
+	}
+	l.buckets[model] = mb
+	return mb
+}
+
+// cancel gives back the quota of a request that was never sent.
+func (r *reservation) cancel() {
+	if r.buckets == nil {
+		return
+	}
+	r.limiter.mu.Lock()
+	defer r.limiter.mu.Unlock()
+
+	now := r.limiter.now()
+	if r.buckets.requests != nil {
+		r.buckets.requests.give(now, 1)
+	}
+	if r.buckets.tokens != nil {
+		r.buckets.tokens.give(now, float64(r.tokens))
+	}
+}
+
+// settle corrects the reserved tokens to the actual usage of the request.
+func (r *reservation) settle(actual int) {
+	if r.buckets == nil || r.buckets.tokens == nil {
+		return
+	}
+	r.limiter.mu.Lock()
+	defer r.limiter.mu.Unlock()
+
+	r.buckets.tokens.give(r.limiter.now(), float64(r.tokens-actual))
+	r.tokens = actual
+}
+
+// bucket is a token bucket refilled continuously at capacity per minute.
+// Its level may drop below zero, which queues later callers behind earlier ones.
+type bucket struct {
+	capacity float64
+	rate     float64 // per second
+	level    float64
+	last     time.Time
+}
+
+func newBucket(perMinute int, now time.Time) *bucket {
+	return &bucket{
+		capacity: float64(perMinute),
+		rate:     float64(perMinute) / 60,
+		level:    float64(perMinute),
+		last:     now,
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.level = math.Min(b.capacity, b.level+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+func (b *bucket) delay(now time.Time, n float64) time.Duration {
+	b.refill(now)
+	if b.level >= n {
+		return 0
+	}
+	return time.Duration((n - b.level) / b.rate * float64(time.Second))
+}
+
+func (b *bucket) take(n float64) {
+	b.level -= n
+}
+
+func (b *bucket) give(now time.Time, n float64) {
+	b.refill(now)
+	b.level = math.Min(b.capacity, b.level+n)
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
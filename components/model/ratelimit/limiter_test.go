@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func newTestLimiter(t *testing.T, config *LimiterConfig) (*Limiter, *fakeClock) {
+	l, err := NewLimiter(config)
+	assert.NoError(t, err)
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l.now = clock.now
+	return l, clock
+}
+
+func TestNewLimiter(t *testing.T) {
+	_, err := NewLimiter(&LimiterConfig{Limits: map[string]Limit{"m": {RequestsPerMinute: -1}}})
+	assert.Error(t, err)
+	_, err = NewLimiter(&LimiterConfig{DefaultLimit: &Limit{TokensPerMinute: -1}})
+	assert.Error(t, err)
+	_, err = NewLimiter(nil)
+	assert.NoError(t, err)
+}
+
+func TestLimiterRequests(t *testing.T) {
+	l, clock := newTestLimiter(t, &LimiterConfig{
+		Limits: map[string]Limit{"m": {RequestsPerMinute: 2}},
+	})
+
+	for i := 0; i < 2; i++ {
+		_, wait, err := l.reserve("m", 0, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Duration(0), wait)
+	}
+
+	// fail fast does not take quota
+	_, wait, err := l.reserve("m", 0, 0)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, 30*time.Second, wait)
+
+	// waiting callers queue up behind each other
+	_, wait, err = l.reserve("m", 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, wait)
+	r, wait, err := l.reserve("m", 0, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 60*time.Second, wait)
+
+	r.cancel()
+	_, wait, err = l.reserve("m", 0, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 60*time.Second, wait)
+
+	clock.t = clock.t.Add(2 * time.Minute)
+	_, wait, err = l.reserve("m", 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	// models without limit are not limited
+	for i := 0; i < 10; i++ {
+		_, wait, err = l.reserve("other", 1000, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Duration(0), wait)
+	}
+}
+
+func TestLimiterTokens(t *testing.T) {
+	l, clock := newTestLimiter(t, &LimiterConfig{
+		DefaultLimit: &Limit{TokensPerMinute: 600},
+	})
+
+	r, wait, err := l.reserve("m", 500, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, wait, err = l.reserve("m", 200, 0)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, 10*time.Second, wait)
+
+	// actual usage lower than estimated gives tokens back
+	r.settle(300)
+	_, wait, err = l.reserve("m", 200, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	// level is 100 now, 60 seconds refill the whole bucket but never more
+	clock.t = clock.t.Add(time.Hour)
+	_, wait, err = l.reserve("m", 600, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, _, err = l.reserve("m", 601, -1)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+}
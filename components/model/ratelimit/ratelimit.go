@@ -0,0 +1,195 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+type Config struct {
+	// ChatModel is the underlying chat model whose requests are limited.
+	// Required.
+	ChatModel model.ToolCallingChatModel
+	// Limiter holds the quotas, share it between ChatModels to share quotas.
+	// Required.
+	Limiter *Limiter
+	// Model is the model name selecting the quota in Limiter.
+	// Could be overridden by model.WithModel.
+	// Required if Limiter has per model limits.
+	Model string
+	// FailFast returns ErrRateLimited at once when quota is used up, instead of waiting for it.
+	// Optional. Default: false
+	FailFast bool
+	// MaxWait is the longest time a request waits for quota before failing with ErrRateLimited.
+	// Optional. Default: 0, wait until quota is available or ctx is done.
+	MaxWait time.Duration
+	// EstimateTokens estimates the tokens of a request before sending it, the estimate is
+	// corrected by the usage in the response once received.
+	// Optional. Default: about 4 characters per token of message contents.
+	EstimateTokens func(input []*schema.Message) int
+}
+
+var _ model.ToolCallingChatModel = (*ChatModel)(nil)
+
+// ChatModel wraps a model.ToolCallingChatModel, requests wait for, or fail without, the quota
+// of requests per minute and tokens per minute of the model.
+type ChatModel struct {
+	config *Config
+}
+
+func NewChatModel(_ context.Context, config *Config) (*ChatModel, error) {
+	if config == nil || config.ChatModel == nil {
+		return nil, errors.New("[NewChatModel] chat model not provided")
+	}
+	if config.Limiter == nil {
+		return nil, errors.New("[NewChatModel] limiter not provided")
+	}
+	if config.MaxWait < 0 {
+		return nil, errors.New("[NewChatModel] max wait must not be negative")
+	}
+
+	if config.EstimateTokens == nil {
+		config.EstimateTokens = estimateTokens
+	}
+
+	return &ChatModel{config: config}, nil
+}
+
+func (c *ChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	r, err := c.acquire(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.config.ChatModel.Generate(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if usage := usageOf(out); usage != nil {
+		r.settle(usage.TotalTokens)
+	}
+	return out, nil
+}
+
+func (c *ChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	r, err := c.acquire(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sr, err := c.config.ChatModel.Stream(ctx, input, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// usage is usually carried by the last chunk, chunks with usage correct the reservation in place
+	return schema.StreamReaderWithConvert(sr, func(msg *schema.Message) (*schema.Message, error) {
+		if usage := usageOf(msg); usage != nil {
+			r.settle(usage.TotalTokens)
+		}
+		return msg, nil
+	}), nil
+}
+
+func (c *ChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	cm, err := c.config.ChatModel.WithTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	config := *c.config
+	config.ChatModel = cm
+	return &ChatModel{config: &config}, nil
+}
+
+// acquire waits until the request is admitted by the quota.
+func (c *ChatModel) acquire(ctx context.Context, input []*schema.Message, opts ...model.Option) (*reservation, error) {
+	options := model.GetCommonOptions(&model.Options{
+		Model: &c.config.Model,
+	}, opts...)
+
+	maxWait := c.config.MaxWait
+	if c.config.FailFast {
+		maxWait = 0
+	} else if maxWait == 0 {
+		maxWait = -1
+	}
+
+	r, wait, err := c.config.Limiter.reserve(*options.Model, c.config.EstimateTokens(input), maxWait)
+	if err != nil {
+		return nil, err
+	}
+	if wait <= 0 {
+		return r, nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return r, nil
+	case <-ctx.Done():
+		r.cancel()
+		return nil, ctx.Err()
+	}
+}
+
+func usageOf(msg *schema.Message) *schema.TokenUsage {
+	if msg == nil || msg.ResponseMeta == nil || msg.ResponseMeta.Usage == nil {
+		return nil
+	}
+	usage := *msg.ResponseMeta.Usage
+	if usage.TotalTokens == 0 {
+		usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+	}
+	if usage.TotalTokens == 0 {
+		return nil
+	}
+	return &usage
+}
+
+func estimateTokens(input []*schema.Message) int {
+	var chars int
+	for _, msg := range input {
+		if msg == nil {
+			continue
+		}
+		chars += utf8.RuneCountInString(msg.Content)
+		for _, part := range msg.MultiContent {
+			chars += utf8.RuneCountInString(part.Text)
+		}
+		for _, call := range msg.ToolCalls {
+			chars += utf8.RuneCountInString(call.Function.Arguments)
+		}
+	}
+	return (chars + 3) / 4
+}
+
+const typ = "RateLimit"
+
+func (c *ChatModel) GetType() string {
+	return typ
+}
+
+// IsCallbacksEnabled returns true, callbacks are triggered by the underlying chat model.
+func (c *ChatModel) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	usage *schema.TokenUsage
+	calls int
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.calls++
+	return &schema.Message{
+		Role:         schema.Assistant,
+		Content:      "ok",
+		ResponseMeta: &schema.ResponseMeta{Usage: m.usage},
+	}, nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	m.calls++
+	return schema.StreamReaderFromArray([]*schema.Message{
+		schema.AssistantMessage("o", nil),
+		{Role: schema.Assistant, Content: "k", ResponseMeta: &schema.ResponseMeta{Usage: m.usage}},
+	}), nil
+}
+
+func (m *mockChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	return m, nil
+}
+
+func TestNewChatModel(t *testing.T) {
+	limiter, err := NewLimiter(nil)
+	assert.NoError(t, err)
+
+	_, err = NewChatModel(context.Background(), &Config{Limiter: limiter})
+	assert.Error(t, err)
+	_, err = NewChatModel(context.Background(), &Config{ChatModel: &mockChatModel{}})
+	assert.Error(t, err)
+	_, err = NewChatModel(context.Background(), &Config{ChatModel: &mockChatModel{}, Limiter: limiter, MaxWait: -time.Second})
+	assert.Error(t, err)
+}
+
+func TestChatModel(t *testing.T) {
+	ctx := context.Background()
+	input := []*schema.Message{schema.UserMessage("hello")}
+
+	t.Run("fail fast", func(t *testing.T) {
+		limiter, _ := newTestLimiter(t, &LimiterConfig{
+			Limits: map[string]Limit{
+				"small": {RequestsPerMinute: 1},
+				"large": {RequestsPerMinute: 100},
+			},
+		})
+		mock := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: mock, Limiter: limiter, Model: "small", FailFast: true})
+		assert.NoError(t, err)
+
+		_, err = cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		_, err = cm.Generate(ctx, input)
+		assert.True(t, errors.Is(err, ErrRateLimited))
+		_, err = cm.Stream(ctx, input)
+		assert.True(t, errors.Is(err, ErrRateLimited))
+		assert.Equal(t, 1, mock.calls)
+
+		// model option selects another quota
+		_, err = cm.Generate(ctx, input, model.WithModel("large"))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, mock.calls)
+
+		// the quota is shared by chat models with the same limiter
+		withTools, err := cm.WithTools(nil)
+		assert.NoError(t, err)
+		_, err = withTools.Generate(ctx, input)
+		assert.True(t, errors.Is(err, ErrRateLimited))
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		limiter, _ := newTestLimiter(t, &LimiterConfig{
+			DefaultLimit: &Limit{RequestsPerMinute: 1},
+		})
+		mock := &mockChatModel{}
+		cm, err := NewChatModel(ctx, &Config{ChatModel: mock, Limiter: limiter})
+		assert.NoError(t, err)
+
+		_, err = cm.Generate(ctx, input)
+		assert.NoError(t, err)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		_, err = cm.Generate(timeoutCtx, input)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, 1, mock.calls)
+		// the canceled request gave its quota back
+		assert.Equal(t, float64(0), limiter.buckets[""].requests.level)
+
+		cm.config.MaxWait = time.Second
+		_, err = cm.Generate(ctx, input)
+		assert.True(t, errors.Is(err, ErrRateLimited))
+	})
+
+	t.Run("settle tokens", func(t *testing.T) {
+		limiter, _ := newTestLimiter(t, &LimiterConfig{
+			DefaultLimit: &Limit{TokensPerMinute: 1000},
+		})
+		mock := &mockChatModel{usage: &schema.TokenUsage{PromptTokens: 80, CompletionTokens: 20}}
+		cm, err := NewChatModel(ctx, &Config{
+			ChatModel: mock,
+			Limiter:   limiter,
+			EstimateTokens: func(input []*schema.Message) int {
+				return 10
+			},
+		})
+		assert.NoError(t, err)
+
+		_, err = cm.Generate(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(900), limiter.buckets[""].tokens.level)
+
+		sr, err := cm.Stream(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(890), limiter.buckets[""].tokens.level)
+		for {
+			_, err = sr.Recv()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+		}
+		sr.Close()
+		assert.Equal(t, float64(800), limiter.buckets[""].tokens.level)
+	})
+}
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, estimateTokens(nil))
+	assert.Equal(t, 2, estimateTokens([]*schema.Message{schema.UserMessage("hello")}))
+	assert.Equal(t, 3, estimateTokens([]*schema.Message{
+		schema.UserMessage("你好"),
+		{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{Function: schema.FunctionCall{Arguments: `{"a":1}`}}}},
+	}))
+}
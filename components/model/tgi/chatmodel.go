@@ -0,0 +1,211 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tgi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/eino-ext/libs/acl/openai"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultModel = "tgi"
+
+var _ model.ToolCallingChatModel = (*ChatModel)(nil)
+
+// ChatModelConfig parameters detail see:
+// https://huggingface.co/docs/text-generation-inference/messages_api
+type ChatModelConfig struct {
+
+	// BaseURL specifies the Messages API address of the Text Generation Inference server or Inference Endpoint
+	// Required. Example: http://localhost:8080/v1
+	BaseURL string `json:"base_url"`
+
+	// APIKey is sent as a bearer token in the Authorization header, e.g. a Hugging Face token for Inference Endpoints,
+	// or the --api-key of the TGI server
+	// Optional. Default: no token
+	APIKey string `json:"api_key"`
+
+	// Headers are added to every request, e.g. the auth header of a gateway in front of the server,
+	// which override the Authorization header of APIKey
+	// Optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Timeout specifies the maximum duration to wait for API responses
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: no timeout
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"http_client"`
+
+	// Model is the name of the model, which is ignored by TGI as a server serves one model
+	// Optional. Default: "tgi"
+	Model string `json:"model"`
+
+	// MaxTokens limits the maximum number of tokens that can be generated in the chat completion
+	// Optional. Default: the --max-total-tokens of the server minus the input tokens
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
+	// Temperature specifies what sampling temperature to use
+	// Generally recommend altering this or TopP but not both.
+	// Optional. Default: 1.0
+	Temperature *float32 `json:"temperature,omitempty"`
+
+	// TopP controls diversity via nucleus sampling
+	// Generally recommend altering this or Temperature but not both.
+	// Optional. Default: 1.0
+	TopP *float32 `json:"top_p,omitempty"`
+
+	// Stop sequences where the API will stop generating further tokens
+	// Optional. Example: []string{"\n", "User:"}
+	Stop []string `json:"stop,omitempty"`
+
+	// PresencePenalty prevents repetition by penalizing tokens based on presence
+	// Optional. Default: 0
+	PresencePenalty *float32 `json:"presence_penalty,omitempty"`
+
+	// FrequencyPenalty prevents repetition by penalizing tokens based on frequency
+	// Optional. Default: 0
+	FrequencyPenalty *float32 `json:"frequency_penalty,omitempty"`
+
+	// ResponseFormat specifies the format of the model's response, TGI supports json_schema by guided decoding
+	// Optional. Use for structured outputs
+	ResponseFormat *openai.ChatCompletionResponseFormat `json:"response_format,omitempty"`
+
+	// Seed enables deterministic sampling for consistent outputs
+	// Optional. Set for reproducible results
+	Seed *int `json:"seed,omitempty"`
+}
+
+type ChatModel struct {
+	cli *openai.Client
+}
+
+func NewChatModel(ctx context.Context, config *ChatModelConfig) (*ChatModel, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[NewChatModel] config not provided")
+	}
+	if len(config.BaseURL) == 0 {
+		return nil, fmt.Errorf("[NewChatModel] base url is required")
+	}
+
+	var httpClient *http.Client
+
+	if config.HTTPClient != nil {
+		httpClient = config.HTTPClient
+	} else {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+	if len(config.Headers) > 0 {
+		c := *httpClient
+		c.Transport = &headerTransport{base: c.Transport, headers: config.Headers}
+		httpClient = &c
+	}
+
+	modelName := config.Model
+	if len(modelName) == 0 {
+		modelName = defaultModel
+	}
+
+	cli, err := openai.NewClient(ctx, &openai.Config{
+		BaseURL:          config.BaseURL,
+		APIKey:           config.APIKey,
+		HTTPClient:       httpClient,
+		Model:            modelName,
+		MaxTokens:        config.MaxTokens,
+		Temperature:      config.Temperature,
+		TopP:             config.TopP,
+		Stop:             config.Stop,
+		PresencePenalty:  config.PresencePenalty,
+		FrequencyPenalty: config.FrequencyPenalty,
+		ResponseFormat:   config.ResponseFormat,
+		Seed:             config.Seed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChatModel{
+		cli: cli,
+	}, nil
+}
+
+func (cm *ChatModel) Generate(ctx context.Context, in []*schema.Message, opts ...model.Option) (
+	outMsg *schema.Message, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, cm.GetType(), components.ComponentOfChatModel)
+	return cm.cli.Generate(ctx, in, opts...)
+}
+
+func (cm *ChatModel) Stream(ctx context.Context, in []*schema.Message, opts ...model.Option) (
+	outStream *schema.StreamReader[*schema.Message], err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, cm.GetType(), components.ComponentOfChatModel)
+	return cm.cli.Stream(ctx, in, opts...)
+}
+
+func (cm *ChatModel) WithTools(tools []*schema.ToolInfo) (model.ToolCallingChatModel, error) {
+	cli, err := cm.cli.WithToolsForClient(tools)
+	if err != nil {
+		return nil, err
+	}
+	return &ChatModel{cli: cli}, nil
+}
+
+func (cm *ChatModel) BindTools(tools []*schema.ToolInfo) error {
+	return cm.cli.BindTools(tools)
+}
+
+func (cm *ChatModel) BindForcedTools(tools []*schema.ToolInfo) error {
+	return cm.cli.BindForcedTools(tools)
+}
+
+const typ = "TGI"
+
+func (cm *ChatModel) GetType() string {
+	return typ
+}
+
+func (cm *ChatModel) IsCallbacksEnabled() bool {
+	return cm.cli.IsCallbacksEnabled()
+}
+
+// headerTransport adds the configured headers to every request.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return base.RoundTrip(req)
+}
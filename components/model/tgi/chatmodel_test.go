@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tgi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChatModel(t *testing.T) {
+	convey.Convey("test ChatModel", t, func() {
+		ctx := context.Background()
+
+		var (
+			header http.Header
+			req    map[string]any
+		)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/chat/completions" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			header = r.Header
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &req)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"","object":"chat.completion","created":0,"model":"meta-llama/Llama-3.1-8B-Instruct",` +
+				`"choices":[{"index":0,"message":{"role":"assistant","content":"hello"},"finish_reason":"stop"}],` +
+				`"usage":{"prompt_tokens":5,"completion_tokens":1,"total_tokens":6}}`))
+		}))
+		defer srv.Close()
+
+		cm, err := NewChatModel(ctx, &ChatModelConfig{
+			BaseURL: srv.URL + "/v1",
+			APIKey:  "hf_mock",
+			Headers: map[string]string{"X-Gateway-Key": "gw"},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		msg, err := cm.Generate(ctx, []*schema.Message{schema.UserMessage("hi")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(msg.Content, convey.ShouldEqual, "hello")
+		convey.So(msg.ResponseMeta.Usage.TotalTokens, convey.ShouldEqual, 6)
+
+		convey.So(header.Get("Authorization"), convey.ShouldEqual, "Bearer hf_mock")
+		convey.So(header.Get("X-Gateway-Key"), convey.ShouldEqual, "gw")
+		convey.So(req["model"], convey.ShouldEqual, defaultModel)
+	})
+
+	convey.Convey("test NewChatModel", t, func() {
+		_, err := NewChatModel(context.Background(), &ChatModelConfig{})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		client := &http.Client{}
+		_, err = NewChatModel(context.Background(), &ChatModelConfig{
+			BaseURL:    "http://localhost:8080/v1",
+			HTTPClient: client,
+			Headers:    map[string]string{"X-Gateway-Key": "gw"},
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(client.Transport, convey.ShouldBeNil)
+	})
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/tgi"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// docker run -p 8080:80 ghcr.io/huggingface/text-generation-inference:3.3.4 --model-id meta-llama/Llama-3.1-8B-Instruct
+	cm, err := tgi.NewChatModel(ctx, &tgi.ChatModelConfig{
+		BaseURL:   "http://localhost:8080/v1",
+		APIKey:    os.Getenv("HF_TOKEN"), // optional, e.g. for Inference Endpoints
+		MaxTokens: of(512),
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of tgi failed, err=%v", err)
+	}
+
+	msg, err := cm.Generate(ctx, []*schema.Message{
+		schema.SystemMessage("you are a helpful assistant"),
+		schema.UserMessage("what is the capital of France?"),
+	})
+	if err != nil {
+		log.Fatalf("Generate of tgi failed, err=%v", err)
+	}
+
+	fmt.Println(msg)
+}
+
+func of[T any](t T) *T {
+	return &t
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/model/tgi"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := tgi.NewChatModel(ctx, &tgi.ChatModelConfig{
+		BaseURL: "http://localhost:8080/v1",
+		// e.g. the auth header of a gateway in front of the server
+		Headers: map[string]string{"X-Api-Key": "your-gateway-key"},
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel of tgi failed, err=%v", err)
+	}
+
+	sr, err := cm.Stream(ctx, []*schema.Message{
+		schema.UserMessage("write a haiku about the sea"),
+	})
+	if err != nil {
+		log.Fatalf("Stream of tgi failed, err=%v", err)
+	}
+	defer sr.Close()
+
+	for {
+		msg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Recv of tgi failed, err=%v", err)
+		}
+		fmt.Print(msg.Content)
+	}
+	fmt.Println()
+}
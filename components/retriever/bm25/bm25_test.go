@@ -0,0 +1,158 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bm25
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+var corpus = []*schema.Document{
+	{ID: "1", Content: "Eino is a LLM application development framework in Golang.", MetaData: map[string]any{"lang": "go"}},
+	{ID: "2", Content: "Golang is a programming language, Golang is fast."},
+	{ID: "3", Content: "The weather is sunny today."},
+	{ID: "4", Content: "Eino 是 Golang 开发的大模型应用框架。", MetaData: map[string]any{"lang": "zh"}},
+}
+
+func TestDefaultTokenizer(t *testing.T) {
+	assert.Equal(t, []string{"eino", "框架", "架很", "很好", "v2", "好"}, DefaultTokenizer("Eino 框架很好, v2 好"))
+	assert.Empty(t, DefaultTokenizer(" ,. "))
+}
+
+func TestIndex(t *testing.T) {
+	idx := NewIndex(&IndexConfig{StopWords: []string{"is", "a"}})
+	assert.Error(t, idx.Add(&schema.Document{Content: "no id"}))
+	assert.NoError(t, idx.Add(corpus...))
+	assert.Equal(t, 4, idx.Len())
+
+	docs := idx.Search("what is golang", 0, nil, nil)
+	assert.Len(t, docs, 3)
+	// more occurrences in a shorter document rank first
+	assert.Equal(t, "2", docs[0].ID)
+	assert.Greater(t, docs[0].Score(), docs[1].Score())
+
+	// bm25 of a single matched term: idf * tf * (k1 + 1) / (tf + k1 * (1 - b + b * len / avgLen))
+	docs = idx.Search("weather", 0, nil, nil)
+	assert.Len(t, docs, 1)
+	avgLen := float64(idx.totalLen) / 4
+	expected := math.Log((4-1+0.5)/(1+0.5)+1) * 2.2 / (1 + 1.2*(0.25+0.75*4/avgLen))
+	assert.InDelta(t, expected, docs[0].Score(), 1e-9)
+
+	docs = idx.Search("大模型", 0, nil, nil)
+	assert.Len(t, docs, 1)
+	assert.Equal(t, "4", docs[0].ID)
+
+	threshold := docs[0].Score() + 1
+	assert.Empty(t, idx.Search("大模型", 0, &threshold, nil))
+	assert.Empty(t, idx.Search("is a", 0, nil, nil))
+	assert.Empty(t, idx.Search("unknown", 0, nil, nil))
+	assert.Len(t, idx.Search("golang eino", 1, nil, nil), 1)
+
+	// the index is not modified by the returned documents
+	docs[0].MetaData["lang"] = "modified"
+	assert.Equal(t, "zh", idx.Get("4").MetaData["lang"])
+	assert.Nil(t, idx.Get("5"))
+
+	// overwrite and delete
+	assert.NoError(t, idx.Add(&schema.Document{ID: "3", Content: "Golang weather station."}))
+	assert.Len(t, idx.Search("sunny", 0, nil, nil), 0)
+	assert.Len(t, idx.Search("weather", 0, nil, nil), 1)
+	idx.Delete("3", "5")
+	assert.Equal(t, 3, idx.Len())
+	assert.Empty(t, idx.Search("weather", 0, nil, nil))
+	assert.NotContains(t, idx.df, "weather")
+}
+
+func TestPersistence(t *testing.T) {
+	idx := NewIndex(nil)
+	assert.NoError(t, idx.Add(corpus...))
+	want := idx.Search("golang eino", 0, nil, nil)
+
+	for _, format := range []Format{FormatJSON, FormatGob} {
+		buf := &bytes.Buffer{}
+		assert.NoError(t, idx.Save(buf, format))
+
+		loaded := NewIndex(nil)
+		assert.NoError(t, loaded.Add(&schema.Document{ID: "old", Content: "golang"}))
+		assert.NoError(t, loaded.Load(buf, format))
+		assert.Equal(t, 4, loaded.Len())
+		assert.Equal(t, idx.totalLen, loaded.totalLen)
+		assert.Equal(t, want, loaded.Search("golang eino", 0, nil, nil))
+	}
+
+	assert.Error(t, idx.Save(&bytes.Buffer{}, "xml"))
+	assert.Error(t, idx.Load(&bytes.Buffer{}, FormatJSON))
+
+	dir := t.TempDir()
+	for _, name := range []string{"index.json", "index.gob"} {
+		path := filepath.Join(dir, "sub", name)
+		assert.NoError(t, idx.SaveFile(path))
+
+		loaded := NewIndex(nil)
+		assert.NoError(t, loaded.LoadFile(path))
+		assert.Equal(t, want, loaded.Search("golang eino", 0, nil, nil))
+	}
+	assert.True(t, os.IsNotExist(NewIndex(nil).LoadFile(filepath.Join(dir, "missing.json"))))
+}
+
+func TestIndexerAndRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewIndexer(ctx, &IndexerConfig{})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &RetrieverConfig{})
+	assert.Error(t, err)
+
+	idx := NewIndex(nil)
+	path := filepath.Join(t.TempDir(), "index.json")
+	i, err := NewIndexer(ctx, &IndexerConfig{Index: idx, PersistPath: path})
+	assert.NoError(t, err)
+	r, err := NewRetriever(ctx, &RetrieverConfig{Index: idx, TopK: 1})
+	assert.NoError(t, err)
+
+	ids, err := i.Store(ctx, corpus)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, ids)
+	_, err = i.Store(ctx, []*schema.Document{{Content: "no id"}})
+	assert.Error(t, err)
+
+	docs, err := r.Retrieve(ctx, "golang eino")
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+	assert.Equal(t, "1", docs[0].ID)
+
+	docs, err = r.Retrieve(ctx, "golang eino", retriever.WithTopK(10), WithFilter(func(doc *schema.Document) bool {
+		return doc.MetaData["lang"] != "go"
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "4", docs[0].ID)
+
+	assert.NoError(t, i.Delete(ctx, []string{"1"}))
+	loaded := NewIndex(nil)
+	assert.NoError(t, loaded.LoadFile(path))
+	assert.Equal(t, 3, loaded.Len())
+}
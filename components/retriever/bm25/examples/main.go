@@ -0,0 +1,66 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/bm25"
+)
+
+func main() {
+	ctx := context.Background()
+
+	path := filepath.Join(os.TempDir(), "eino-bm25-example.json")
+	index := bm25.NewIndex(&bm25.IndexConfig{StopWords: []string{"what", "is", "a"}})
+	if err := index.LoadFile(path); err != nil && !os.IsNotExist(err) {
+		log.Fatalf("LoadFile failed, err=%v", err)
+	}
+
+	indexer, err := bm25.NewIndexer(ctx, &bm25.IndexerConfig{Index: index, PersistPath: path})
+	if err != nil {
+		log.Fatalf("NewIndexer failed, err=%v", err)
+	}
+
+	if _, err = indexer.Store(ctx, []*schema.Document{
+		{ID: "1", Content: "Eino is a LLM application development framework in Golang."},
+		{ID: "2", Content: "Eino provides components, orchestration and flows."},
+		{ID: "3", Content: "The weather is sunny today."},
+	}); err != nil {
+		log.Fatalf("Store failed, err=%v", err)
+	}
+
+	r, err := bm25.NewRetriever(ctx, &bm25.RetrieverConfig{Index: index})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "what is eino", retriever.WithTopK(2))
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, score=%v, content=%s", doc.ID, doc.Score(), doc.Content)
+	}
+}
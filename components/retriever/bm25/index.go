@@ -0,0 +1,279 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bm25
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultK1 = 1.2
+	defaultB  = 0.75
+)
+
+type IndexConfig struct {
+	// K1 controls the term frequency saturation.
+	// Optional. Default: 1.2.
+	K1 float64
+	// B controls the document length normalization, 0 disables it.
+	// Optional. Default: 0.75.
+	B *float64
+	// Tokenizer splits documents and queries into terms.
+	// Optional. Default: DefaultTokenizer.
+	Tokenizer Tokenizer
+	// StopWords are the terms ignored, e.g. "the" and "what", matched after tokenization.
+	// Optional. Default: nil.
+	StopWords []string
+}
+
+// Index is an in-memory BM25 index of documents, safe for concurrent use.
+// It's shared by Indexer and Retriever, and can be saved to and loaded from a file.
+type Index struct {
+	k1        float64
+	b         float64
+	tokenizer Tokenizer
+	stopWords map[string]bool
+
+	mu       sync.RWMutex
+	docs     map[string]*entry
+	df       map[string]int
+	totalLen int
+}
+
+type entry struct {
+	doc    *schema.Document
+	tf     map[string]int
+	length int
+}
+
+func NewIndex(config *IndexConfig) *Index {
+	if config == nil {
+		config = &IndexConfig{}
+	}
+
+	idx := &Index{
+		k1:        config.K1,
+		b:         defaultB,
+		tokenizer: config.Tokenizer,
+		stopWords: make(map[string]bool, len(config.StopWords)),
+		docs:      make(map[string]*entry),
+		df:        make(map[string]int),
+	}
+	if idx.k1 <= 0 {
+		idx.k1 = defaultK1
+	}
+	if config.B != nil {
+		idx.b = *config.B
+	}
+	if idx.tokenizer == nil {
+		idx.tokenizer = DefaultTokenizer
+	}
+	for _, w := range config.StopWords {
+		idx.stopWords[strings.ToLower(w)] = true
+	}
+
+	return idx
+}
+
+// Add adds the documents to the index, documents with the same id are overwritten.
+func (idx *Index) Add(docs ...*schema.Document) error {
+	entries := make([]*entry, 0, len(docs))
+	for _, doc := range docs {
+		if doc.ID == "" {
+			return errors.New("doc id not set")
+		}
+		entries = append(entries, idx.newEntry(doc))
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, e := range entries {
+		idx.remove(e.doc.ID)
+		idx.docs[e.doc.ID] = e
+		idx.totalLen += e.length
+		for t := range e.tf {
+			idx.df[t]++
+		}
+	}
+	return nil
+}
+
+// Delete deletes the documents by ids, ids not found are ignored.
+func (idx *Index) Delete(ids ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, id := range ids {
+		idx.remove(id)
+	}
+}
+
+// Get returns a copy of the document, or nil if not found.
+func (idx *Index) Get(id string) *schema.Document {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	if e, ok := idx.docs[id]; ok {
+		return copyDocument(e.doc)
+	}
+	return nil
+}
+
+// Len returns the number of documents.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Search returns copies of the documents matching any term of the query, sorted by BM25 score in descending order,
+// with the scores set. topK <= 0 returns all the matched documents, and filter is optional.
+func (idx *Index) Search(query string, topK int, scoreThreshold *float64, filter func(doc *schema.Document) bool) []*schema.Document {
+	terms := make(map[string]bool)
+	for _, t := range idx.tokenize(query) {
+		terms[t] = true
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if len(terms) == 0 || len(idx.docs) == 0 {
+		return []*schema.Document{}
+	}
+
+	n := float64(len(idx.docs))
+	avgLen := float64(idx.totalLen) / n
+	idf := make(map[string]float64, len(terms))
+	for t := range terms {
+		if df := idx.df[t]; df > 0 {
+			idf[t] = math.Log((n-float64(df)+0.5)/(float64(df)+0.5) + 1)
+		}
+	}
+	if len(idf) == 0 {
+		return []*schema.Document{}
+	}
+
+	type scored struct {
+		e     *entry
+		score float64
+	}
+	var candidates []scored
+	for _, e := range idx.docs {
+		score := 0.0
+		for t, w := range idf {
+			tf := float64(e.tf[t])
+			if tf == 0 {
+				continue
+			}
+			norm := 1 - idx.b
+			if avgLen > 0 {
+				norm += idx.b * float64(e.length) / avgLen
+			}
+			score += w * tf * (idx.k1 + 1) / (tf + idx.k1*norm)
+		}
+		if score == 0 || (scoreThreshold != nil && score < *scoreThreshold) {
+			continue
+		}
+		if filter != nil && !filter(e.doc) {
+			continue
+		}
+		candidates = append(candidates, scored{e: e, score: score})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].e.doc.ID < candidates[j].e.doc.ID
+	})
+	if topK > 0 && len(candidates) > topK {
+		candidates = candidates[:topK]
+	}
+
+	docs := make([]*schema.Document, 0, len(candidates))
+	for _, c := range candidates {
+		docs = append(docs, copyDocument(c.e.doc).WithScore(c.score))
+	}
+	return docs
+}
+
+// documents returns the documents sorted by id.
+func (idx *Index) documents() []*schema.Document {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	docs := make([]*schema.Document, 0, len(idx.docs))
+	for _, e := range idx.docs {
+		docs = append(docs, e.doc)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].ID < docs[j].ID
+	})
+	return docs
+}
+
+// remove removes the document, the lock should be held.
+func (idx *Index) remove(id string) {
+	e, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	delete(idx.docs, id)
+	idx.totalLen -= e.length
+	for t := range e.tf {
+		if idx.df[t]--; idx.df[t] <= 0 {
+			delete(idx.df, t)
+		}
+	}
+}
+
+func (idx *Index) newEntry(doc *schema.Document) *entry {
+	terms := idx.tokenize(doc.Content)
+	tf := make(map[string]int)
+	for _, t := range terms {
+		tf[t]++
+	}
+	return &entry{doc: copyDocument(doc), tf: tf, length: len(terms)}
+}
+
+func (idx *Index) tokenize(text string) []string {
+	terms := idx.tokenizer(text)
+	if len(idx.stopWords) == 0 {
+		return terms
+	}
+	result := terms[:0:0]
+	for _, t := range terms {
+		if !idx.stopWords[t] {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// copyDocument copies the document and its metadata, so that the index is not modified by callers.
+func copyDocument(doc *schema.Document) *schema.Document {
+	d := *doc
+	d.MetaData = make(map[string]any, len(doc.MetaData))
+	for k, v := range doc.MetaData {
+		d.MetaData[k] = v
+	}
+	return &d
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bm25
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+)
+
+type IndexerConfig struct {
+	// Index stores the documents, share it with Retriever.
+	// Required.
+	Index *Index
+	// PersistPath is the file the index is saved to after each Store, see Index.SaveFile.
+	// Optional. Default: "", not saved.
+	PersistPath string
+}
+
+// Indexer adds documents to an in-memory Index, no embedding is needed.
+type Indexer struct {
+	config *IndexerConfig
+}
+
+func NewIndexer(_ context.Context, config *IndexerConfig) (*Indexer, error) {
+	if config == nil || config.Index == nil {
+		return nil, errors.New("[NewIndexer] index not provided")
+	}
+
+	return &Indexer{config: config}, nil
+}
+
+func (i *Indexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) (ids []string, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, i.GetType(), components.ComponentOfIndexer)
+	ctx = callbacks.OnStart(ctx, &indexer.CallbackInput{Docs: docs})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if err = i.config.Index.Add(docs...); err != nil {
+		return nil, fmt.Errorf("[bm25 indexer] add documents failed: %w", err)
+	}
+
+	if i.config.PersistPath != "" {
+		if err = i.config.Index.SaveFile(i.config.PersistPath); err != nil {
+			return nil, fmt.Errorf("[bm25 indexer] save index failed: %w", err)
+		}
+	}
+
+	ids = make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+
+	callbacks.OnEnd(ctx, &indexer.CallbackOutput{IDs: ids})
+
+	return ids, nil
+}
+
+// Delete deletes the documents by ids from the index.
+func (i *Indexer) Delete(ctx context.Context, ids []string) error {
+	i.config.Index.Delete(ids...)
+
+	if i.config.PersistPath != "" {
+		if err := i.config.Index.SaveFile(i.config.PersistPath); err != nil {
+			return fmt.Errorf("[bm25 indexer] save index failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (i *Indexer) GetType() string {
+	return typ
+}
+
+func (i *Indexer) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bm25
+
+import (
+	"bufio"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// Format is the file format of a saved index.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	// FormatGob is smaller and faster than json, metadata is still encoded as json, so that any value is supported.
+	FormatGob Format = "gob"
+)
+
+// snapshot is the saved index, only the documents are saved, and they are tokenized again when loaded,
+// so that the index config can be changed.
+type snapshot struct {
+	Documents []*schema.Document `json:"documents"`
+}
+
+type gobDocument struct {
+	ID       string
+	Content  string
+	MetaData []byte
+}
+
+// Save writes the documents of the index in the format.
+func (idx *Index) Save(w io.Writer, format Format) error {
+	docs := idx.documents()
+
+	switch format {
+	case FormatJSON:
+		return json.NewEncoder(w).Encode(&snapshot{Documents: docs})
+	case FormatGob:
+		gobDocs := make([]*gobDocument, 0, len(docs))
+		for _, doc := range docs {
+			meta, err := json.Marshal(doc.MetaData)
+			if err != nil {
+				return fmt.Errorf("marshal metadata failed, id=%s: %w", doc.ID, err)
+			}
+			gobDocs = append(gobDocs, &gobDocument{ID: doc.ID, Content: doc.Content, MetaData: meta})
+		}
+		return gob.NewEncoder(w).Encode(gobDocs)
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// Load replaces the documents of the index with the documents read in the format.
+func (idx *Index) Load(r io.Reader, format Format) error {
+	var docs []*schema.Document
+
+	switch format {
+	case FormatJSON:
+		s := &snapshot{}
+		if err := json.NewDecoder(r).Decode(s); err != nil {
+			return fmt.Errorf("decode index failed: %w", err)
+		}
+		docs = s.Documents
+	case FormatGob:
+		var gobDocs []*gobDocument
+		if err := gob.NewDecoder(r).Decode(&gobDocs); err != nil {
+			return fmt.Errorf("decode index failed: %w", err)
+		}
+		for _, d := range gobDocs {
+			doc := &schema.Document{ID: d.ID, Content: d.Content}
+			if err := json.Unmarshal(d.MetaData, &doc.MetaData); err != nil {
+				return fmt.Errorf("unmarshal metadata failed, id=%s: %w", d.ID, err)
+			}
+			docs = append(docs, doc)
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+
+	entries := make(map[string]*entry, len(docs))
+	for _, doc := range docs {
+		if doc == nil || doc.ID == "" {
+			return errors.New("doc id not set")
+		}
+		entries[doc.ID] = idx.newEntry(doc)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.docs = make(map[string]*entry, len(entries))
+	idx.df = make(map[string]int)
+	idx.totalLen = 0
+	for id, e := range entries {
+		idx.docs[id] = e
+		idx.totalLen += e.length
+		for t := range e.tf {
+			idx.df[t]++
+		}
+	}
+	return nil
+}
+
+// SaveFile writes the index to the file atomically, in FormatGob if the extension is ".gob", otherwise FormatJSON.
+func (idx *Index) SaveFile(path string) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create dir failed: %w", err)
+	}
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temp file failed: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	if err = idx.Save(w, formatOf(path)); err != nil {
+		f.Close()
+		return fmt.Errorf("save index failed: %w", err)
+	}
+	if err = w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("write index failed: %w", err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync index failed: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("close index failed: %w", err)
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("rename index failed: %w", err)
+	}
+	return nil
+}
+
+// LoadFile replaces the documents of the index with the file saved by SaveFile.
+func (idx *Index) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return idx.Load(bufio.NewReader(f), formatOf(path))
+}
+
+func formatOf(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".gob") {
+		return FormatGob
+	}
+	return FormatJSON
+}
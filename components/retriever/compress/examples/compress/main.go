@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/compress"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// replace with any retriever, e.g. components/retriever/volc_vikingdb,
+	// and compress.NewLLMCompressor with a chat model for better relevance
+	r, err := compress.NewRetriever(ctx, &compress.Config{
+		Retriever: &staticRetriever{},
+		Compressor: compress.NewExtractiveCompressor(&compress.ExtractiveConfig{
+			StopWords: []string{"what", "is", "a"},
+		}),
+		MaxTokens: 100,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "what is eino")
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, spans=%v, content=%s", doc.ID, doc.MetaData["_compress_spans"], doc.Content)
+	}
+}
+
+type staticRetriever struct{}
+
+func (s *staticRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return []*schema.Document{
+		{ID: "1", Content: "Eino is a LLM application development framework in Golang. The weather is sunny today. " +
+			"Eino provides components, orchestration and flows."},
+		{ID: "2", Content: "The weather is sunny today. It's a good day for a walk."},
+	}, nil
+}
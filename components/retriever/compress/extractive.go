@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type ExtractiveConfig struct {
+	// MinScore is the minimum fraction of the distinct query terms a relevant sentence contains.
+	// Optional. Default: 0, sentences containing any query term are relevant.
+	MinScore float64
+	// StopWords are the terms ignored in the query, e.g. "the" and "what".
+	// Optional. Default: nil.
+	StopWords []string
+}
+
+// ExtractiveCompressor selects the sentences by term overlap with the query, without model calls.
+// Terms are lower cased words, and bigrams of CJK characters.
+type ExtractiveCompressor struct {
+	minScore  float64
+	stopWords map[string]bool
+}
+
+func NewExtractiveCompressor(config *ExtractiveConfig) *ExtractiveCompressor {
+	if config == nil {
+		config = &ExtractiveConfig{}
+	}
+
+	stopWords := make(map[string]bool, len(config.StopWords))
+	for _, w := range config.StopWords {
+		stopWords[strings.ToLower(w)] = true
+	}
+
+	return &ExtractiveCompressor{minScore: config.MinScore, stopWords: stopWords}
+}
+
+func (e *ExtractiveCompressor) Select(_ context.Context, query string, sentences []string) ([]int, error) {
+	queryTerms := make(map[string]bool)
+	for _, t := range terms(query) {
+		if !e.stopWords[t] {
+			queryTerms[t] = true
+		}
+	}
+	if len(queryTerms) == 0 {
+		return nil, nil
+	}
+
+	type scored struct {
+		idx   int
+		score float64
+	}
+	var candidates []scored
+	for i, s := range sentences {
+		matched := make(map[string]bool)
+		for _, t := range terms(s) {
+			if queryTerms[t] {
+				matched[t] = true
+			}
+		}
+		score := float64(len(matched)) / float64(len(queryTerms))
+		if len(matched) > 0 && score >= e.minScore {
+			candidates = append(candidates, scored{idx: i, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	indexes := make([]int, len(candidates))
+	for i, c := range candidates {
+		indexes[i] = c.idx
+	}
+	return indexes, nil
+}
+
+// terms splits the text into lower cased words, and bigrams of consecutive CJK characters.
+func terms(text string) []string {
+	var (
+		result []string
+		word   []rune
+		cjk    []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			result = append(result, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			result = append(result, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			result = append(result, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+
+	return result
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+)
+
+// DefaultLLMPrompt is the default prompt template of LLMCompressor.
+const DefaultLLMPrompt = "Select the sentences that are relevant to answering the question from the numbered sentences below. " +
+	"Reply with the numbers of the relevant sentences only, the most relevant first, separated by commas. " +
+	"Reply with 0 if none of them is relevant.\n\n" +
+	"Question: {query}\n\n" +
+	"Sentences:\n{sentences}"
+
+var numberPattern = regexp.MustCompile(`\d+`)
+
+type LLMConfig struct {
+	// ChatModel selects the relevant sentences.
+	// Required.
+	ChatModel model.BaseChatModel
+	// Prompt is the prompt template in FString format, with the variables {query}, and {sentences} numbered from 1,
+	// e.g. "[1] first sentence". The reply is parsed as the numbers of the relevant sentences.
+	// Optional. Default: DefaultLLMPrompt.
+	Prompt string
+}
+
+// LLMCompressor selects the sentences with a chat model. The model replies with the numbers of the sentences
+// instead of rewriting them, so the kept text is exactly the original text.
+type LLMCompressor struct {
+	chatModel model.BaseChatModel
+	prompt    string
+}
+
+func NewLLMCompressor(_ context.Context, config *LLMConfig) (*LLMCompressor, error) {
+	if config == nil || config.ChatModel == nil {
+		return nil, errors.New("chat model is required")
+	}
+
+	prompt := config.Prompt
+	if prompt == "" {
+		prompt = DefaultLLMPrompt
+	}
+
+	return &LLMCompressor{chatModel: config.ChatModel, prompt: prompt}, nil
+}
+
+func (l *LLMCompressor) Select(ctx context.Context, query string, sentences []string) ([]int, error) {
+	sb := strings.Builder{}
+	for i, s := range sentences {
+		sb.WriteString(fmt.Sprintf("[%d] %s\n", i+1, s))
+	}
+
+	msgs, err := schema.UserMessage(l.prompt).Format(ctx, map[string]any{
+		"query":     query,
+		"sentences": sb.String(),
+	}, schema.FString)
+	if err != nil {
+		return nil, fmt.Errorf("format prompt failed: %w", err)
+	}
+
+	out, err := l.chatModel.Generate(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// numbers out of range, including 0 for none, are ignored by the retriever
+	var indexes []int
+	for _, n := range numberPattern.FindAllString(out.Content, -1) {
+		num, err := strconv.Atoi(n)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, num-1)
+	}
+	return indexes, nil
+}
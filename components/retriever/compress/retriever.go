@@ -0,0 +1,269 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultSeparator   = " ... "
+	defaultConcurrency = 4
+	defaultSpansKey    = "_compress_spans"
+)
+
+// Compressor selects the sentences of a document relevant to the query.
+// Implemented by ExtractiveCompressor and LLMCompressor.
+type Compressor interface {
+	// Select returns the indexes of the relevant sentences, the most relevant first.
+	Select(ctx context.Context, query string, sentences []string) ([]int, error)
+}
+
+// Span is a byte range [Start, End) of the original content of a document.
+type Span struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type Config struct {
+	// Retriever retrieves documents to compress.
+	// Required.
+	Retriever retriever.Retriever
+	// Compressor selects the relevant sentences of each document.
+	// Required.
+	Compressor Compressor
+	// MaxTokens is the token budget of all the returned documents, the most relevant sentences of the
+	// earlier documents are kept first, and the sentences exceeding the budget are dropped.
+	// Optional. Default: 0, no budget.
+	MaxTokens int
+	// LenFunc counts the tokens of a sentence.
+	// Optional. Default: an approximation, 1 token per CJK character and per 4 bytes of other text.
+	LenFunc func(s string) int
+	// Separator joins the kept passages which are not adjacent in the original content.
+	// Optional. Default: " ... ".
+	Separator string
+	// Concurrency is the number of documents compressed concurrently.
+	// Optional. Default: 4.
+	Concurrency int
+	// SpansKey is the metadata key of the []Span of the kept passages in the original content, for citations.
+	// Optional. Default: "_compress_spans".
+	SpansKey string
+}
+
+// Retriever compresses retrieved documents, keeping only the sentences relevant to the query,
+// to reduce the prompt size. Documents without relevant sentences are dropped, the others keep their
+// ids and metadata, and record the spans of the kept passages in the original content.
+// Options are passed to the underlying Retriever.
+type Retriever struct {
+	config *Config
+}
+
+func NewRetriever(_ context.Context, config *Config) (*Retriever, error) {
+	if config == nil || config.Retriever == nil {
+		return nil, errors.New("[NewRetriever] retriever not provided")
+	}
+
+	if config.Compressor == nil {
+		return nil, errors.New("[NewRetriever] compressor not provided")
+	}
+
+	conf := *config
+	if conf.LenFunc == nil {
+		conf.LenFunc = approximateTokens
+	}
+	if conf.Separator == "" {
+		conf.Separator = defaultSeparator
+	}
+	if conf.Concurrency <= 0 {
+		conf.Concurrency = defaultConcurrency
+	}
+	if conf.SpansKey == "" {
+		conf.SpansKey = defaultSpansKey
+	}
+
+	return &Retriever{config: &conf}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query: query,
+		Extra: map[string]any{"max_tokens": r.config.MaxTokens},
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	candidates, err := r.config.Retriever.Retrieve(ctx, query, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("[compress retriever] retrieve failed: %w", err)
+	}
+
+	sentences := make([][]Span, len(candidates))
+	for i, doc := range candidates {
+		sentences[i] = splitSentences(doc.Content)
+	}
+
+	selected, err := r.selectSentences(ctx, query, candidates, sentences)
+	if err != nil {
+		return nil, fmt.Errorf("[compress retriever] compress failed: %w", err)
+	}
+
+	docs = make([]*schema.Document, 0, len(candidates))
+	used := 0
+	for i, doc := range candidates {
+		keep := make([]bool, len(sentences[i]))
+		kept := 0
+		for _, idx := range selected[i] {
+			s := sentences[i][idx]
+			cost := r.config.LenFunc(doc.Content[s.Start:s.End])
+			if r.config.MaxTokens > 0 && used+cost > r.config.MaxTokens {
+				continue
+			}
+			keep[idx] = true
+			kept++
+			used += cost
+		}
+		if kept == 0 {
+			continue
+		}
+		docs = append(docs, r.compress(doc, sentences[i], keep))
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+// selectSentences selects the sentences of the documents concurrently, and returns the valid and distinct indexes.
+func (r *Retriever) selectSentences(ctx context.Context, query string, docs []*schema.Document, sentences [][]Span) ([][]int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, r.config.Concurrency)
+		selected = make([][]int, len(docs))
+	)
+loop:
+	for i, doc := range docs {
+		if len(sentences[i]) == 0 {
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		wg.Add(1)
+		go func(i int, doc *schema.Document) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			texts := make([]string, len(sentences[i]))
+			for j, s := range sentences[i] {
+				texts[j] = doc.Content[s.Start:s.End]
+			}
+			indexes, err := r.config.Compressor.Select(ctx, query, texts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("select sentences of document[%s] failed: %w", doc.ID, err)
+					cancel()
+				}
+				return
+			}
+
+			seen := make(map[int]bool, len(indexes))
+			for _, idx := range indexes {
+				if idx < 0 || idx >= len(texts) || seen[idx] {
+					continue
+				}
+				seen[idx] = true
+				selected[i] = append(selected[i], idx)
+			}
+		}(i, doc)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return selected, nil
+}
+
+// compress returns a copy of the document with the kept sentences, adjacent sentences are merged into one passage,
+// keeping the original text between them.
+func (r *Retriever) compress(doc *schema.Document, sentences []Span, keep []bool) *schema.Document {
+	var spans []Span
+	for i, s := range sentences {
+		if !keep[i] {
+			continue
+		}
+		if i > 0 && keep[i-1] {
+			spans[len(spans)-1].End = s.End
+			continue
+		}
+		spans = append(spans, s)
+	}
+
+	passages := make([]string, len(spans))
+	for i, s := range spans {
+		passages[i] = doc.Content[s.Start:s.End]
+	}
+
+	meta := make(map[string]any, len(doc.MetaData)+1)
+	for k, v := range doc.MetaData {
+		meta[k] = v
+	}
+	meta[r.config.SpansKey] = spans
+
+	d := *doc
+	d.Content = strings.Join(passages, r.config.Separator)
+	d.MetaData = meta
+	return &d
+}
+
+func (r *Retriever) GetType() string {
+	return "Compress"
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const content = "Eino is a framework in Golang. The weather is sunny today! Eino provides components.\nIt costs 3.14 dollars."
+
+type mockRetriever struct {
+	docs []*schema.Document
+	err  error
+}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return m.docs, m.err
+}
+
+type mockCompressor struct {
+	err error
+}
+
+func (m *mockCompressor) Select(ctx context.Context, query string, sentences []string) ([]int, error) {
+	return nil, m.err
+}
+
+type mockChatModel struct {
+	prompt string
+	reply  string
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.prompt = input[len(input)-1].Content
+	return schema.AssistantMessage(m.reply, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, errors.New("not implemented")
+}
+
+func spanOf(text, sub string) Span {
+	start := strings.Index(text, sub)
+	return Span{Start: start, End: start + len(sub)}
+}
+
+func TestSplitSentences(t *testing.T) {
+	texts := func(text string) []string {
+		var result []string
+		for _, s := range splitSentences(text) {
+			result = append(result, text[s.Start:s.End])
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{
+		"Eino is a framework in Golang.",
+		"The weather is sunny today!",
+		"Eino provides components.",
+		"It costs 3.14 dollars.",
+	}, texts(content))
+	assert.Equal(t, []string{"你好。", "世界！", "e.g., no split"}, texts("  你好。世界！\n\ne.g., no split  "))
+	assert.Equal(t, []string{"bad \xff."}, texts("bad \xff."))
+	assert.Empty(t, texts(" \n "))
+
+	assert.Equal(t, []string{"eino", "框架", "架很", "很好", "v2", "好"}, terms("Eino 框架很好, v2 好"))
+	assert.Equal(t, 2+2, approximateTokens("你好, eino"))
+}
+
+func TestExtractiveCompressor(t *testing.T) {
+	ctx := context.Background()
+	sentences := []string{"Eino is a framework in Golang.", "The weather is sunny today!", "Eino provides components."}
+
+	c := NewExtractiveCompressor(&ExtractiveConfig{StopWords: []string{"What", "is"}})
+	indexes, err := c.Select(ctx, "what is eino framework", sentences)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 2}, indexes)
+
+	c = NewExtractiveCompressor(&ExtractiveConfig{MinScore: 0.6})
+	indexes, err = c.Select(ctx, "eino framework", sentences)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0}, indexes)
+
+	indexes, err = NewExtractiveCompressor(nil).Select(ctx, "?", sentences)
+	assert.NoError(t, err)
+	assert.Empty(t, indexes)
+}
+
+func TestLLMCompressor(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewLLMCompressor(ctx, &LLMConfig{})
+	assert.Error(t, err)
+
+	cm := &mockChatModel{reply: "3, 1"}
+	c, err := NewLLMCompressor(ctx, &LLMConfig{ChatModel: cm})
+	assert.NoError(t, err)
+
+	indexes, err := c.Select(ctx, "what is eino", []string{"Eino is {a} framework.", "The weather is sunny.", "Eino provides components."})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 0}, indexes)
+	assert.Contains(t, cm.prompt, "Question: what is eino\n")
+	assert.Contains(t, cm.prompt, "[1] Eino is {a} framework.\n[2] The weather is sunny.\n[3] Eino provides components.\n")
+}
+
+func TestRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &Config{Compressor: NewExtractiveCompressor(nil)})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}})
+	assert.Error(t, err)
+
+	docs := []*schema.Document{
+		{ID: "1", Content: content, MetaData: map[string]any{"source": "a.md"}},
+		{ID: "2", Content: "Nothing relevant here."},
+		{ID: "3", Content: "Eino has graphs. Eino has chains."},
+	}
+
+	t.Run("extractive", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever:  &mockRetriever{docs: docs},
+			Compressor: NewExtractiveCompressor(&ExtractiveConfig{StopWords: []string{"what", "is"}}),
+		})
+		assert.NoError(t, err)
+
+		result, err := r.Retrieve(ctx, "what is eino framework")
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+
+		assert.Equal(t, "1", result[0].ID)
+		assert.Equal(t, "Eino is a framework in Golang. ... Eino provides components.", result[0].Content)
+		assert.Equal(t, "a.md", result[0].MetaData["source"])
+		assert.Equal(t, []Span{
+			spanOf(content, "Eino is a framework in Golang."),
+			spanOf(content, "Eino provides components."),
+		}, result[0].MetaData[defaultSpansKey])
+
+		// adjacent sentences are merged
+		assert.Equal(t, "3", result[1].ID)
+		assert.Equal(t, docs[2].Content, result[1].Content)
+		assert.Equal(t, []Span{{Start: 0, End: len(docs[2].Content)}}, result[1].MetaData[defaultSpansKey])
+
+		// the retrieved documents are not modified
+		assert.Equal(t, content, docs[0].Content)
+		assert.NotContains(t, docs[0].MetaData, defaultSpansKey)
+	})
+
+	t.Run("max tokens", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever:  &mockRetriever{docs: docs},
+			Compressor: NewExtractiveCompressor(&ExtractiveConfig{StopWords: []string{"what", "is"}}),
+			MaxTokens:  4,
+			LenFunc:    func(s string) int { return len(strings.Fields(s)) },
+			SpansKey:   "spans",
+		})
+		assert.NoError(t, err)
+
+		// the most relevant sentence exceeds the budget, the next one is kept
+		result, err := r.Retrieve(ctx, "what is eino framework")
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "Eino provides components.", result[0].Content)
+		assert.Equal(t, []Span{spanOf(content, "Eino provides components.")}, result[0].MetaData["spans"])
+	})
+
+	t.Run("llm", func(t *testing.T) {
+		c, err := NewLLMCompressor(ctx, &LLMConfig{ChatModel: &mockChatModel{reply: "4, 1, 9, 4, 0"}})
+		assert.NoError(t, err)
+		r, err := NewRetriever(ctx, &Config{
+			Retriever:   &mockRetriever{docs: docs[:1]},
+			Compressor:  c,
+			Separator:   "\n",
+			Concurrency: 1,
+		})
+		assert.NoError(t, err)
+
+		result, err := r.Retrieve(ctx, "how much")
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "Eino is a framework in Golang.\nIt costs 3.14 dollars.", result[0].Content)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever:  &mockRetriever{docs: docs},
+			Compressor: &mockCompressor{err: errors.New("mock err")},
+		})
+		assert.NoError(t, err)
+		_, err = r.Retrieve(ctx, "query")
+		assert.Error(t, err)
+
+		r, err = NewRetriever(ctx, &Config{
+			Retriever:  &mockRetriever{err: errors.New("mock err")},
+			Compressor: NewExtractiveCompressor(nil),
+		})
+		assert.NoError(t, err)
+		_, err = r.Retrieve(ctx, "query")
+		assert.Error(t, err)
+	})
+}
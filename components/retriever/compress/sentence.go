@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// splitSentences splits the text into sentences by the sentence terminators and line breaks,
+// and returns their spans without the surrounding spaces.
+// Latin terminators end a sentence only when followed by a space, so that "3.14" and "e.g.," are kept.
+func splitSentences(text string) []Span {
+	var spans []Span
+	start := 0
+	flush := func(end int) {
+		seg := text[start:end]
+		s := start + len(seg) - len(strings.TrimLeftFunc(seg, unicode.IsSpace))
+		e := start + len(strings.TrimRightFunc(seg, unicode.IsSpace))
+		if s < e {
+			spans = append(spans, Span{Start: s, End: e})
+		}
+		start = end
+	}
+
+	for end := 0; end < len(text); {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+		switch r {
+		case '\n', '。', '！', '？', '；':
+			flush(end)
+		case '.', '!', '?':
+			next, _ := utf8.DecodeRuneInString(text[end:])
+			if end == len(text) || unicode.IsSpace(next) {
+				flush(end)
+			}
+		}
+	}
+	flush(len(text))
+
+	return spans
+}
+
+// approximateTokens approximates the tokens of the text, 1 token per CJK character and per 4 bytes of other text.
+func approximateTokens(s string) int {
+	cjk, other := 0, 0
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if isCJK(r) {
+			cjk++
+		} else {
+			other += size
+		}
+		i += size
+	}
+	return cjk + (other+3)/4
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
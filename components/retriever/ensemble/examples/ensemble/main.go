@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/ensemble"
+)
+
+func main() {
+	ctx := context.Background()
+
+	r, err := ensemble.NewRetriever(ctx, &ensemble.Config{
+		Retrievers: []*ensemble.WeightedRetriever{
+			{Retriever: &staticRetriever{name: "web", docs: []*schema.Document{
+				(&schema.Document{ID: "https://github.com/cloudwego/eino", Content: "Eino is a LLM application development framework in Golang."}).WithScore(12),
+				(&schema.Document{ID: "https://www.cloudwego.io", Content: "CloudWeGo is a set of Golang microservice frameworks."}).WithScore(3),
+			}}, Weight: 0.4}, // e.g. bingsearch-backed retriever
+			{Retriever: &staticRetriever{name: "vector", docs: []*schema.Document{
+				(&schema.Document{ID: "doc_1", Content: "Eino provides components, orchestration and flows."}).WithScore(0.82),
+				(&schema.Document{ID: "https://github.com/cloudwego/eino", Content: "Eino is a LLM application development framework in Golang."}).WithScore(0.75),
+			}}, Weight: 0.6}, // e.g. volc_vikingdb retriever
+		},
+		FusionMode: ensemble.FusionModeWeightedScore,
+		TopK:       3,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "what is eino")
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, score=%v, content=%s", doc.ID, doc.Score(), doc.Content)
+	}
+}
+
+type staticRetriever struct {
+	name string
+	docs []*schema.Document
+}
+
+func (s *staticRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	log.Printf("%s retrieve query: %s", s.name, query)
+	return s.docs, nil
+}
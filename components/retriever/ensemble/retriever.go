@@ -0,0 +1,232 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ensemble
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultRRFK = 60
+
+type FusionMode string
+
+const (
+	// FusionModeRRF fuses ranked lists with weighted reciprocal rank fusion: score = sum(weight / (k + rank)).
+	FusionModeRRF FusionMode = "rrf"
+	// FusionModeWeightedScore min-max normalizes scores of each list into [0, 1],
+	// then sums them with weights: score = sum(weight * normalized_score).
+	FusionModeWeightedScore FusionMode = "weighted_score"
+)
+
+type WeightedRetriever struct {
+	// Retriever is one of the ensembled retrievers.
+	// Required.
+	Retriever retriever.Retriever
+	// Weight is the weight of results of Retriever in fusion.
+	// Optional. Default: 1
+	Weight float64
+}
+
+type Config struct {
+	// Retrievers are called in parallel with the same query and options.
+	// Required.
+	Retrievers []*WeightedRetriever
+	// FusionMode decides how results are fused.
+	// Optional. Default: FusionModeRRF
+	FusionMode FusionMode
+	// RRFK is the constant k of reciprocal rank fusion, only used in FusionModeRRF.
+	// Optional. Default: 60
+	RRFK int
+	// TopK limits the number of fused documents returned.
+	// Optional. Default: 0, return all fused documents
+	TopK int
+}
+
+// Retriever retrieves with multiple retrievers in parallel, e.g. web search and vector retrieval,
+// then merges and deduplicates results by weighted fusion.
+// Fused score is set as document score.
+type Retriever struct {
+	config *Config
+}
+
+func NewRetriever(_ context.Context, config *Config) (*Retriever, error) {
+	if config == nil || len(config.Retrievers) == 0 {
+		return nil, errors.New("[NewRetriever] retrievers not provided")
+	}
+
+	nConf := *config
+	nConf.Retrievers = make([]*WeightedRetriever, len(config.Retrievers))
+	for i, wr := range config.Retrievers {
+		if wr == nil || wr.Retriever == nil {
+			return nil, fmt.Errorf("[NewRetriever] retriever not provided, index=%d", i)
+		}
+		if wr.Weight < 0 {
+			return nil, fmt.Errorf("[NewRetriever] invalid weight, index=%d, weight=%v", i, wr.Weight)
+		}
+		nwr := *wr
+		if nwr.Weight == 0 {
+			nwr.Weight = 1
+		}
+		nConf.Retrievers[i] = &nwr
+	}
+
+	switch nConf.FusionMode {
+	case "":
+		nConf.FusionMode = FusionModeRRF
+	case FusionModeRRF, FusionModeWeightedScore:
+	default:
+		return nil, fmt.Errorf("[NewRetriever] unknown fusion mode: %s", nConf.FusionMode)
+	}
+	if nConf.RRFK <= 0 {
+		nConf.RRFK = defaultRRFK
+	}
+
+	return &Retriever{config: &nConf}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query: query,
+		TopK:  r.config.TopK,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	results := make([][]*schema.Document, len(r.config.Retrievers))
+	errs := make([]error, len(r.config.Retrievers))
+	wg := sync.WaitGroup{}
+	for i := range r.config.Retrievers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = r.config.Retrievers[i].Retriever.Retrieve(ctx, query, opts...)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, e := range errs {
+		if e != nil {
+			return nil, fmt.Errorf("[ensemble retriever] retrieve failed, index=%d: %w", i, e)
+		}
+	}
+
+	weights := make([]float64, len(r.config.Retrievers))
+	for i, wr := range r.config.Retrievers {
+		weights[i] = wr.Weight
+	}
+
+	if r.config.FusionMode == FusionModeWeightedScore {
+		docs = fuseByWeightedScore(results, weights)
+	} else {
+		docs = fuseByRRF(results, weights, r.config.RRFK)
+	}
+	if r.config.TopK > 0 && len(docs) > r.config.TopK {
+		docs = docs[:r.config.TopK]
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+func fuseByRRF(results [][]*schema.Document, weights []float64, k int) []*schema.Document {
+	return fuse(results, func(i, rank int, _ *schema.Document) float64 {
+		return weights[i] / float64(k+rank+1)
+	})
+}
+
+func fuseByWeightedScore(results [][]*schema.Document, weights []float64) []*schema.Document {
+	mins := make([]float64, len(results))
+	maxs := make([]float64, len(results))
+	for i, result := range results {
+		mins[i], maxs[i] = math.Inf(1), math.Inf(-1)
+		for _, doc := range result {
+			if doc == nil {
+				continue
+			}
+			mins[i] = math.Min(mins[i], doc.Score())
+			maxs[i] = math.Max(maxs[i], doc.Score())
+		}
+	}
+
+	return fuse(results, func(i, _ int, doc *schema.Document) float64 {
+		if maxs[i] == mins[i] {
+			// all documents of the list are equally relevant
+			return weights[i]
+		}
+		return weights[i] * (doc.Score() - mins[i]) / (maxs[i] - mins[i])
+	})
+}
+
+// fuse sums scores of documents across results, documents are deduplicated by ID, or by content if ID is empty.
+func fuse(results [][]*schema.Document, score func(i, rank int, doc *schema.Document) float64) []*schema.Document {
+	scores := make(map[string]float64)
+	docs := make(map[string]*schema.Document)
+	var order []string
+
+	for i, result := range results {
+		for rank, doc := range result {
+			if doc == nil {
+				continue
+			}
+
+			key := doc.ID
+			if key == "" {
+				key = "content:" + doc.Content
+			}
+
+			if _, ok := docs[key]; !ok {
+				docs[key] = doc
+				order = append(order, key)
+			}
+			scores[key] += score(i, rank, doc)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+
+	fused := make([]*schema.Document, 0, len(order))
+	for _, key := range order {
+		fused = append(fused, docs[key].WithScore(scores[key]))
+	}
+
+	return fused
+}
+
+func (r *Retriever) GetType() string {
+	return "Ensemble"
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
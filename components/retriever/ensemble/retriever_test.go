@@ -0,0 +1,147 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ensemble
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRetriever struct {
+	docs []*schema.Document
+	err  error
+}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	// copy documents, as fusion sets score on returned documents
+	result := make([]*schema.Document, len(m.docs))
+	for i, doc := range m.docs {
+		result[i] = (&schema.Document{ID: doc.ID, Content: doc.Content}).WithScore(doc.Score())
+	}
+	return result, nil
+}
+
+func doc(id string, score float64) *schema.Document {
+	return (&schema.Document{ID: id, Content: "content " + id}).WithScore(score)
+}
+
+func TestNewRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &Config{})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retrievers: []*WeightedRetriever{{}}})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retrievers: []*WeightedRetriever{{Retriever: &mockRetriever{}, Weight: -1}}})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retrievers: []*WeightedRetriever{{Retriever: &mockRetriever{}}}, FusionMode: "unknown"})
+	assert.Error(t, err)
+
+	r, err := NewRetriever(ctx, &Config{Retrievers: []*WeightedRetriever{{Retriever: &mockRetriever{}}}})
+	assert.NoError(t, err)
+	assert.Equal(t, FusionModeRRF, r.config.FusionMode)
+	assert.Equal(t, defaultRRFK, r.config.RRFK)
+	assert.Equal(t, 1.0, r.config.Retrievers[0].Weight)
+}
+
+func TestRetrieve(t *testing.T) {
+	ctx := context.Background()
+
+	web := &mockRetriever{docs: []*schema.Document{doc("a", 10), doc("b", 5), doc("c", 0)}}
+	vector := &mockRetriever{docs: []*schema.Document{doc("c", 0.9), doc("d", 0.5)}}
+
+	t.Run("rrf", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retrievers: []*WeightedRetriever{
+				{Retriever: web, Weight: 1},
+				{Retriever: vector, Weight: 2},
+			},
+			RRFK: 1,
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "query")
+		assert.NoError(t, err)
+		// a: 1/2, b: 1/3, c: 1/4 + 2/2, d: 2/3
+		assert.Equal(t, []string{"c", "d", "a", "b"}, ids(docs))
+		assert.InDelta(t, 1.25, docs[0].Score(), 1e-9)
+	})
+
+	t.Run("weighted score", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retrievers: []*WeightedRetriever{
+				{Retriever: web, Weight: 0.3},
+				{Retriever: vector, Weight: 0.7},
+			},
+			FusionMode: FusionModeWeightedScore,
+			TopK:       3,
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "query")
+		assert.NoError(t, err)
+		// a: 0.3, b: 0.15, c: 0 + 0.7, d: 0
+		assert.Equal(t, []string{"c", "a", "b"}, ids(docs))
+		assert.InDelta(t, 0.7, docs[0].Score(), 1e-9)
+		assert.InDelta(t, 0.3, docs[1].Score(), 1e-9)
+		assert.InDelta(t, 0.15, docs[2].Score(), 1e-9)
+	})
+
+	t.Run("weighted score with equal scores", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retrievers: []*WeightedRetriever{
+				{Retriever: &mockRetriever{docs: []*schema.Document{doc("a", 0.5)}}, Weight: 0.5},
+			},
+			FusionMode: FusionModeWeightedScore,
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "query")
+		assert.NoError(t, err)
+		assert.Len(t, docs, 1)
+		assert.InDelta(t, 0.5, docs[0].Score(), 1e-9)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retrievers: []*WeightedRetriever{
+				{Retriever: web},
+				{Retriever: &mockRetriever{err: fmt.Errorf("mock err")}},
+			},
+		})
+		assert.NoError(t, err)
+
+		_, err = r.Retrieve(ctx, "query")
+		assert.Error(t, err)
+	})
+}
+
+func ids(docs []*schema.Document) []string {
+	result := make([]string, len(docs))
+	for i, d := range docs {
+		result[i] = d.ID
+	}
+	return result
+}
@@ -19,9 +19,14 @@ package es8
 const typ = "ElasticSearch8"
 
 const (
-	defaultTopK = 10
+	defaultTopK             = 10
+	defaultContentFieldName = "content"
 )
 
+// MetaKeyHighlight is the metadata key of highlight fragments set by default result parser,
+// and the value type is map[string][]string, which is field name to fragments.
+const MetaKeyHighlight = "_highlight"
+
 func GetType() string {
 	return typ
 }
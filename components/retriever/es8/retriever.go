@@ -18,6 +18,7 @@ package es8
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/cloudwego/eino/components"
@@ -50,6 +51,15 @@ type RetrieverConfig struct {
 	// ResultParser parse document from es search hits.
 	// If ResultParser not provided, defaultResultParser will be used as default
 	ResultParser func(ctx context.Context, hit types.Hit) (doc *schema.Document, err error)
+	// ContentFieldName the field of document content in _source, used by defaultResultParser.
+	// Other fields in _source will be put into document metadata.
+	// Default is "content"
+	ContentFieldName string `json:"content_field_name"`
+	// Highlight highlight matched terms of hits, which will be added to request built by SearchMode.
+	// Highlight fragments will be put into document metadata with key MetaKeyHighlight by defaultResultParser.
+	Highlight *types.Highlight `json:"highlight"`
+	// RequestHook modifies search request built by SearchMode before sending, e.g. adding aggregations or custom query DSL.
+	RequestHook func(ctx context.Context, req *search.Request) (*search.Request, error)
 	// Embedding vectorization method, must provide when SearchMode needed
 	Embedding embedding.Embedder
 }
@@ -75,8 +85,12 @@ func NewRetriever(_ context.Context, conf *RetrieverConfig) (*Retriever, error)
 		conf.TopK = defaultTopK
 	}
 
+	if conf.ContentFieldName == "" {
+		conf.ContentFieldName = defaultContentFieldName
+	}
+
 	if conf.ResultParser == nil {
-		return nil, fmt.Errorf("[NewRetriever] result parser not provided")
+		conf.ResultParser = defaultResultParser(conf.ContentFieldName)
 	}
 
 	if conf.Client == nil {
@@ -113,6 +127,16 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 		return nil, err
 	}
 
+	if r.config.Highlight != nil && req.Highlight == nil {
+		req.Highlight = r.config.Highlight
+	}
+
+	if r.config.RequestHook != nil {
+		if req, err = r.config.RequestHook(ctx, req); err != nil {
+			return nil, fmt.Errorf("[es8 retriever] request hook failed, %w", err)
+		}
+	}
+
 	resp, err := search.NewSearchFunc(r.client)().
 		Index(r.config.Index).
 		Request(req).
@@ -153,3 +177,45 @@ func (r *Retriever) GetType() string {
 func (r *Retriever) IsCallbacksEnabled() bool {
 	return true
 }
+
+func defaultResultParser(contentFieldName string) func(ctx context.Context, hit types.Hit) (*schema.Document, error) {
+	return func(ctx context.Context, hit types.Hit) (*schema.Document, error) {
+		doc := &schema.Document{
+			MetaData: map[string]any{},
+		}
+
+		if hit.Id_ != nil {
+			doc.ID = *hit.Id_
+		}
+
+		if len(hit.Source_) > 0 {
+			var src map[string]any
+			if err := json.Unmarshal(hit.Source_, &src); err != nil {
+				return nil, fmt.Errorf("[defaultResultParser] unmarshal source failed, %w", err)
+			}
+
+			for k, v := range src {
+				if k != contentFieldName {
+					doc.MetaData[k] = v
+					continue
+				}
+
+				content, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("[defaultResultParser] content field=%s is not string, got=%T", k, v)
+				}
+				doc.Content = content
+			}
+		}
+
+		if len(hit.Highlight) > 0 {
+			doc.MetaData[MetaKeyHighlight] = hit.Highlight
+		}
+
+		if hit.Score_ != nil {
+			doc.WithScore(float64(*hit.Score_))
+		}
+
+		return doc, nil
+	}
+}
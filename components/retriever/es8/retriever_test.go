@@ -92,6 +92,56 @@ func TestNewRetriever(t *testing.T) {
 		assert.Equal(t, "i'm fine, thank you", docs[0].Content)
 	})
 
+	t.Run("default_result_parser", func(t *testing.T) {
+		var hooked *search.Request
+		r, err := NewRetriever(ctx, &RetrieverConfig{
+			Client:    &elasticsearch.Client{},
+			Index:     "eino_ut",
+			Highlight: &types.Highlight{Fields: map[string]types.HighlightField{"content": {}}},
+			RequestHook: func(ctx context.Context, req *search.Request) (*search.Request, error) {
+				hooked = req
+				return req, nil
+			},
+			SearchMode: &mockSearchMode{},
+		})
+		assert.NoError(t, err)
+
+		mockSearch := search.NewSearchFunc(r.client)()
+
+		defer mockey.Mock(mockey.GetMethod(mockSearch, "Index")).
+			Return(mockSearch).Build().Patch().UnPatch()
+
+		defer mockey.Mock(mockey.GetMethod(mockSearch, "Request")).
+			Return(mockSearch).Build().Patch().UnPatch()
+
+		id := "1"
+		score := types.Float64(1.5)
+		defer mockey.Mock(mockey.GetMethod(mockSearch, "Do")).Return(&search.Response{
+			Hits: types.HitsMetadata{
+				Hits: []types.Hit{
+					{
+						Id_:       &id,
+						Score_:    &score,
+						Source_:   json.RawMessage(`{"content": "i'm fine, thank you", "author": "eino"}`),
+						Highlight: map[string][]string{"content": {"i'm <em>fine</em>"}},
+					},
+				},
+			},
+		}, nil).Build().Patch().UnPatch()
+
+		docs, err := r.Retrieve(ctx, "how are you")
+		assert.NoError(t, err)
+		assert.NotNil(t, hooked)
+		assert.NotNil(t, hooked.Highlight)
+
+		assert.Len(t, docs, 1)
+		assert.Equal(t, "1", docs[0].ID)
+		assert.Equal(t, "i'm fine, thank you", docs[0].Content)
+		assert.Equal(t, "eino", docs[0].MetaData["author"])
+		assert.Equal(t, map[string][]string{"content": {"i'm <em>fine</em>"}}, docs[0].MetaData[MetaKeyHighlight])
+		assert.Equal(t, 1.5, docs[0].Score())
+	})
+
 }
 
 type mockSearchMode struct{}
@@ -26,7 +26,8 @@ const (
 	defaultAutoIndexLevel = 1
 	defaultLoadedProgress = 100
 
-	defaultMetricType = entity.HAMMING
+	defaultMetricType       = entity.HAMMING
+	defaultSparseMetricType = entity.IP
 
 	typeParamDim = "dim"
 )
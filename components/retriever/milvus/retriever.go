@@ -70,6 +70,23 @@ type RetrieverConfig struct {
 	// Embedding is the embedding vectorization method for values needs to be embedded from schema.Document's content.
 	// Required
 	Embedding embedding.Embedder
+
+	// Hybrid search config, hybrid search is enabled only if both SparseVectorField and SparseEmbedding are provided
+	// SparseVectorField is the sparse vector field name in the collection
+	// Optional, and the default value is empty
+	SparseVectorField string
+	// SparseEmbedding is the function to convert the query to sparse vector
+	// Optional, and the default value is nil
+	SparseEmbedding func(ctx context.Context, query string) (entity.SparseEmbedding, error)
+	// SparseMetricType is the metric type for sparse vector
+	// Optional, and the default value is "IP"
+	SparseMetricType entity.MetricType
+	// SparseSp is the search params for sparse vector
+	// Optional, and the default value is entity.IndexSparseInvertedSearchParam, and the drop ratio is 0
+	SparseSp entity.SearchParam
+	// Reranker is used to merge the dense and sparse search results
+	// Optional, and the default value is client.RRFReranker
+	Reranker client.Reranker
 }
 
 type Retriever struct {
@@ -106,6 +123,11 @@ func NewRetriever(ctx context.Context, config *RetrieverConfig) (*Retriever, err
 	if err := checkCollectionSchema(config.VectorField, collection.Schema); err != nil {
 		return nil, fmt.Errorf("[NewRetriever] collection schema not match: %w", err)
 	}
+	if config.isHybrid() {
+		if err := checkCollectionSchema(config.SparseVectorField, collection.Schema); err != nil {
+			return nil, fmt.Errorf("[NewRetriever] collection schema not match: sparse %w", err)
+		}
+	}
 	
 	// check the collection load state
 	if !collection.Loaded {
@@ -146,6 +168,11 @@ func NewRetriever(ctx context.Context, config *RetrieverConfig) (*Retriever, err
 			ScoreThreshold:    config.ScoreThreshold,
 			Sp:                config.Sp,
 			Embedding:         config.Embedding,
+			SparseVectorField: config.SparseVectorField,
+			SparseEmbedding:   config.SparseEmbedding,
+			SparseMetricType:  config.SparseMetricType,
+			SparseSp:          config.SparseSp,
+			Reranker:          config.Reranker,
 		},
 	}, nil
 }
@@ -170,6 +197,7 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 		ScoreThreshold: co.ScoreThreshold,
 		Extra: map[string]any{
 			"metric_type": r.config.MetricType,
+			"hybrid":      r.config.isHybrid(),
 		},
 	})
 	defer func() {
@@ -207,19 +235,23 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 		searchParams = append(searchParams, io.SearchQueryOptFn)
 	}
 	
-	results, err = r.config.Client.Search(
-		ctx,
-		r.config.Collection,
-		r.config.Partition,
-		io.Filter,
-		r.config.OutputFields,
-		vec,
-		r.config.VectorField,
-		r.config.MetricType,
-		*co.TopK,
-		r.config.Sp,
-		searchParams...,
-	)
+	if r.config.isHybrid() {
+		results, err = r.hybridSearch(ctx, query, io.Filter, vec, *co.TopK, searchParams)
+	} else {
+		results, err = r.config.Client.Search(
+			ctx,
+			r.config.Collection,
+			r.config.Partition,
+			io.Filter,
+			r.config.OutputFields,
+			vec,
+			r.config.VectorField,
+			r.config.MetricType,
+			*co.TopK,
+			r.config.Sp,
+			searchParams...,
+		)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("[milvus retriever] search has error: %w", err)
 	}
@@ -250,6 +282,31 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 	return documents, nil
 }
 
+// hybridSearch searches both the dense and sparse vector fields, and merges the results with the reranker
+func (r *Retriever) hybridSearch(ctx context.Context, query, filter string, vec []entity.Vector, topK int,
+	searchParams []client.SearchQueryOptionFunc) ([]client.SearchResult, error) {
+	sparse, err := r.config.SparseEmbedding(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("sparse embedding has error: %w", err)
+	}
+	
+	subRequests := []*client.ANNSearchRequest{
+		client.NewANNSearchRequest(r.config.VectorField, r.config.MetricType, filter, vec, r.config.Sp, topK),
+		client.NewANNSearchRequest(r.config.SparseVectorField, r.config.SparseMetricType, filter, []entity.Vector{sparse}, r.config.SparseSp, topK),
+	}
+	
+	return r.config.Client.HybridSearch(
+		ctx,
+		r.config.Collection,
+		r.config.Partition,
+		topK,
+		r.config.OutputFields,
+		r.config.Reranker,
+		subRequests,
+		searchParams...,
+	)
+}
+
 func (r *Retriever) GetType() string {
 	return typ
 }
@@ -293,5 +350,25 @@ func (r *RetrieverConfig) check() error {
 	if r.MetricType == "" {
 		r.MetricType = defaultMetricType
 	}
+	if r.isHybrid() {
+		if r.SparseMetricType == "" {
+			r.SparseMetricType = defaultSparseMetricType
+		}
+		if r.SparseSp == nil {
+			sp, err := entity.NewIndexSparseInvertedSearchParam(0)
+			if err != nil {
+				return fmt.Errorf("[NewRetriever] failed to create sparse search params: %w", err)
+			}
+			r.SparseSp = sp
+		}
+		if r.Reranker == nil {
+			r.Reranker = client.NewRRFReranker()
+		}
+	}
 	return nil
 }
+
+// isHybrid reports whether the retriever searches both dense and sparse vector fields
+func (r *RetrieverConfig) isHybrid() bool {
+	return r.SparseVectorField != "" && r.SparseEmbedding != nil
+}
@@ -414,6 +414,98 @@ func TestRetriever_Retrieve(t *testing.T) {
 	})
 }
 
+func TestRetriever_HybridSearch(t *testing.T) {
+	PatchConvey("test Retriever.Retrieve with hybrid search", t, func() {
+		ctx := context.Background()
+		Mock(client.NewClient).Return(&client.GrpcClient{}, nil).Build()
+		mockClient, _ := client.NewClient(ctx, client.Config{})
+
+		Mock(GetMethod(mockClient, "HasCollection")).Return(true, nil).Build()
+		Mock(GetMethod(mockClient, "DescribeCollection")).Return(&entity.Collection{
+			Loaded: true,
+			Schema: &entity.Schema{
+				Fields: []*entity.Field{
+					{
+						Name:     defaultVectorField,
+						DataType: entity.FieldTypeBinaryVector,
+						TypeParams: map[string]string{
+							"dim": "128",
+						},
+					},
+					{
+						Name:     "sparse_vector",
+						DataType: entity.FieldTypeSparseVector,
+					},
+				},
+			},
+		}, nil).Build()
+
+		sparseEmbedding := func(ctx context.Context, query string) (entity.SparseEmbedding, error) {
+			return entity.NewSliceSparseEmbedding([]uint32{1, 5}, []float32{0.5, 0.2})
+		}
+
+		PatchConvey("test sparse vector field not found", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Client:            mockClient,
+				Embedding:         &mockEmbedding{sizeForCall: []int{1}},
+				SparseVectorField: "not_exist",
+				SparseEmbedding:   sparseEmbedding,
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] collection schema not match: sparse vector field not found"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test hybrid search success", func() {
+			var subRequestCnt int
+			Mock(GetMethod(mockClient, "HybridSearch")).To(func(ctx context.Context, collName string, partitions []string, limit int, outputFields []string, reranker client.Reranker, subRequests []*client.ANNSearchRequest, opts ...client.SearchQueryOptionFunc) ([]client.SearchResult, error) {
+				subRequestCnt = len(subRequests)
+				return []client.SearchResult{
+					{
+						IDs: entity.NewColumnVarChar("id", []string{"1"}),
+						Fields: []entity.Column{
+							entity.NewColumnVarChar("id", []string{"1"}),
+							entity.NewColumnVarChar("content", []string{"test"}),
+						},
+						Scores: []float32{1},
+					},
+				}, nil
+			}).Build()
+
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Client:            mockClient,
+				Embedding:         &mockEmbedding{sizeForCall: []int{1}},
+				SparseVectorField: "sparse_vector",
+				SparseEmbedding:   sparseEmbedding,
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(r.config.SparseMetricType, convey.ShouldEqual, defaultSparseMetricType)
+			convey.So(r.config.Reranker, convey.ShouldNotBeNil)
+
+			documents, err := r.Retrieve(ctx, "test")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(subRequestCnt, convey.ShouldEqual, 2)
+			convey.So(len(documents), convey.ShouldEqual, 1)
+			convey.So(documents[0].Content, convey.ShouldEqual, "test")
+		})
+
+		PatchConvey("test sparse embedding error", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Client:            mockClient,
+				Embedding:         &mockEmbedding{sizeForCall: []int{1}},
+				SparseVectorField: "sparse_vector",
+				SparseEmbedding: func(ctx context.Context, query string) (entity.SparseEmbedding, error) {
+					return nil, fmt.Errorf("sparse error")
+				},
+			})
+			convey.So(err, convey.ShouldBeNil)
+
+			documents, err := r.Retrieve(ctx, "test")
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[milvus retriever] search has error: sparse embedding has error: sparse error"))
+			convey.So(documents, convey.ShouldBeNil)
+		})
+	})
+}
+
 type mockEmbedding struct {
 	err         error
 	cnt         int
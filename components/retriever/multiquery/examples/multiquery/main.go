@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/model/openai"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/multiquery"
+)
+
+func main() {
+	ctx := context.Background()
+
+	cm, err := openai.NewChatModel(ctx, &openai.ChatModelConfig{
+		APIKey: os.Getenv("OPENAI_API_KEY"),
+		Model:  "gpt-4o-mini",
+	})
+	if err != nil {
+		log.Fatalf("NewChatModel failed, err=%v", err)
+	}
+
+	r, err := multiquery.NewRetriever(ctx, &multiquery.Config{
+		Retriever:  &staticRetriever{}, // any retriever, e.g. volc_vikingdb retriever
+		ChatModel:  cm,
+		QueryCount: 3,
+		TopK:       5,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "what is eino")
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, score=%v, content=%s", doc.ID, doc.Score(), doc.Content)
+	}
+}
+
+type staticRetriever struct{}
+
+func (s *staticRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	log.Printf("retrieve query: %s", query)
+	return []*schema.Document{
+		{ID: "1", Content: "Eino is a LLM application development framework in Golang."},
+		{ID: "2", Content: "Eino provides components, orchestration and flows."},
+	}, nil
+}
@@ -0,0 +1,240 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multiquery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultQueryCount = 3
+	defaultRRFK       = 60
+
+	defaultPrompt = `You are an AI language model assistant. Your task is to generate {count} different versions of the given user question to retrieve relevant documents from a vector database. By generating multiple perspectives on the user question, your goal is to help the user overcome some of the limitations of distance-based similarity search. Provide these alternative questions separated by newlines, without numbering or any other text.
+Original question: {query}`
+)
+
+var numberingPrefix = regexp.MustCompile(`^\s*(\d+[.)、:]|[-*•])\s*`)
+
+type Config struct {
+	// Retriever is the underlying retriever, called once for each query.
+	// Required.
+	Retriever retriever.Retriever
+	// ChatModel expands the user query into paraphrases.
+	// Required if QueryGenerator is not provided.
+	ChatModel model.BaseChatModel
+	// QueryCount is the number of paraphrases generated by ChatModel, original query is always retrieved.
+	// Optional. Default: 3
+	QueryCount int
+	// Prompt is the query expansion prompt, {query} and {count} are replaced with user query and QueryCount.
+	// Optional. Default: defaultPrompt
+	Prompt string
+	// QueryGenerator customizes query expansion, ChatModel and Prompt are ignored if provided.
+	// Optional.
+	QueryGenerator func(ctx context.Context, query string) ([]string, error)
+	// RRFK is the constant k of reciprocal rank fusion: score = sum(1 / (k + rank)).
+	// Optional. Default: 60
+	RRFK int
+	// TopK limits the number of fused documents returned.
+	// Optional. Default: 0, return all fused documents
+	TopK int
+}
+
+// Retriever expands the user query into multiple queries with ChatModel, retrieves them in parallel,
+// then merges and deduplicates results with reciprocal rank fusion.
+// Fused score is set as document score.
+type Retriever struct {
+	config *Config
+}
+
+func NewRetriever(_ context.Context, config *Config) (*Retriever, error) {
+	if config == nil || config.Retriever == nil {
+		return nil, errors.New("[NewRetriever] retriever not provided")
+	}
+
+	if config.ChatModel == nil && config.QueryGenerator == nil {
+		return nil, errors.New("[NewRetriever] chat model or query generator not provided")
+	}
+
+	nConf := *config
+	if nConf.QueryCount <= 0 {
+		nConf.QueryCount = defaultQueryCount
+	}
+	if nConf.Prompt == "" {
+		nConf.Prompt = defaultPrompt
+	}
+	if nConf.RRFK <= 0 {
+		nConf.RRFK = defaultRRFK
+	}
+
+	return &Retriever{config: &nConf}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query: query,
+		TopK:  r.config.TopK,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	queries, err := r.expandQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]*schema.Document, len(queries))
+	errs := make([]error, len(queries))
+	wg := sync.WaitGroup{}
+	for i := range queries {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = r.config.Retriever.Retrieve(ctx, queries[i], opts...)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, e := range errs {
+		if e != nil {
+			return nil, fmt.Errorf("[multiquery retriever] retrieve failed, query=%s: %w", queries[i], e)
+		}
+	}
+
+	docs = fuseDocuments(results, r.config.RRFK)
+	if r.config.TopK > 0 && len(docs) > r.config.TopK {
+		docs = docs[:r.config.TopK]
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+// expandQuery returns original query followed by deduplicated paraphrases.
+func (r *Retriever) expandQuery(ctx context.Context, query string) ([]string, error) {
+	var (
+		generated []string
+		err       error
+	)
+	if r.config.QueryGenerator != nil {
+		generated, err = r.config.QueryGenerator(ctx, query)
+	} else {
+		generated, err = r.generateByChatModel(ctx, query)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[multiquery retriever] expand query failed: %w", err)
+	}
+
+	seen := map[string]bool{query: true}
+	queries := []string{query}
+	for _, q := range generated {
+		q = strings.TrimSpace(q)
+		if q == "" || seen[q] {
+			continue
+		}
+		seen[q] = true
+		queries = append(queries, q)
+	}
+
+	return queries, nil
+}
+
+func (r *Retriever) generateByChatModel(ctx context.Context, query string) ([]string, error) {
+	prompt := strings.NewReplacer("{query}", query, "{count}", fmt.Sprint(r.config.QueryCount)).Replace(r.config.Prompt)
+
+	msg, err := r.config.ChatModel.Generate(ctx, []*schema.Message{schema.UserMessage(prompt)})
+	if err != nil {
+		return nil, err
+	}
+
+	var queries []string
+	for _, line := range strings.Split(msg.Content, "\n") {
+		line = strings.TrimSpace(numberingPrefix.ReplaceAllString(line, ""))
+		if line != "" {
+			queries = append(queries, line)
+		}
+	}
+
+	if len(queries) > r.config.QueryCount {
+		queries = queries[:r.config.QueryCount]
+	}
+
+	return queries, nil
+}
+
+// fuseDocuments merges ranked lists with reciprocal rank fusion,
+// documents are deduplicated by ID, or by content if ID is empty.
+func fuseDocuments(results [][]*schema.Document, k int) []*schema.Document {
+	scores := make(map[string]float64)
+	docs := make(map[string]*schema.Document)
+	var order []string
+
+	for _, result := range results {
+		for rank, doc := range result {
+			if doc == nil {
+				continue
+			}
+
+			key := doc.ID
+			if key == "" {
+				key = "content:" + doc.Content
+			}
+
+			if _, ok := docs[key]; !ok {
+				docs[key] = doc
+				order = append(order, key)
+			}
+			scores[key] += 1 / float64(k+rank+1)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+
+	fused := make([]*schema.Document, 0, len(order))
+	for _, key := range order {
+		fused = append(fused, docs[key].WithScore(scores[key]))
+	}
+
+	return fused
+}
+
+func (r *Retriever) GetType() string {
+	return "MultiQuery"
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package multiquery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChatModel struct {
+	content string
+	input   []*schema.Message
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	m.input = input
+	return schema.AssistantMessage(m.content, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type mockRetriever struct {
+	mu      sync.Mutex
+	queries []string
+	docs    map[string][]*schema.Document
+}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.queries = append(m.queries, query)
+	docs, ok := m.docs[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+	return docs, nil
+}
+
+func TestRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &Config{ChatModel: &mockChatModel{}})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}})
+	assert.Error(t, err)
+
+	cm := &mockChatModel{content: "1. what is eino framework\n2) eino introduction\n\n- what is eino\n4. extra query"}
+	rtr := &mockRetriever{docs: map[string][]*schema.Document{
+		"what is eino":           {{ID: "1"}, {ID: "2"}},
+		"what is eino framework": {{ID: "2"}, {ID: "3"}},
+		"eino introduction":      {{ID: "2"}, {ID: "1"}, {Content: "no id"}},
+	}}
+	r, err := NewRetriever(ctx, &Config{
+		Retriever: rtr,
+		ChatModel: cm,
+	})
+	assert.NoError(t, err)
+
+	docs, err := r.Retrieve(ctx, "what is eino")
+	assert.NoError(t, err)
+	assert.Contains(t, cm.input[0].Content, "Original question: what is eino")
+	assert.Contains(t, cm.input[0].Content, "generate 3 different versions")
+	assert.ElementsMatch(t, []string{"what is eino", "what is eino framework", "eino introduction"}, rtr.queries)
+	assert.Len(t, docs, 4)
+	assert.Equal(t, "2", docs[0].ID)
+	assert.Equal(t, "1", docs[1].ID)
+	assert.Equal(t, "3", docs[2].ID)
+	assert.Equal(t, "no id", docs[3].Content)
+	assert.InDelta(t, 1.0/61+1.0/62+1.0/61, docs[0].Score(), 1e-9)
+
+	r, err = NewRetriever(ctx, &Config{
+		Retriever: rtr,
+		QueryGenerator: func(ctx context.Context, query string) ([]string, error) {
+			return []string{"unknown"}, nil
+		},
+	})
+	assert.NoError(t, err)
+	_, err = r.Retrieve(ctx, "what is eino")
+	assert.Error(t, err)
+
+	r, err = NewRetriever(ctx, &Config{
+		Retriever: rtr,
+		QueryGenerator: func(ctx context.Context, query string) ([]string, error) {
+			return []string{"eino introduction"}, nil
+		},
+		TopK: 1,
+	})
+	assert.NoError(t, err)
+	docs, err = r.Retrieve(ctx, "what is eino")
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+	assert.Equal(t, "1", docs[0].ID)
+}
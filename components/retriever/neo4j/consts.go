@@ -0,0 +1,51 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+const typ = "Neo4j"
+
+const (
+	defaultFullTextIndex = "eino_entity_fulltext"
+	defaultTopK          = 5
+	defaultMaxHops       = 1
+	defaultMaxRelations  = 50
+	defaultChunkTopK     = 5
+	maxHopsLimit         = 3
+)
+
+const (
+	// MetaKeyKind is the metadata key of the kind of returned documents, KindEntity or KindChunk.
+	MetaKeyKind = "_neo4j_kind"
+	// MetaKeyEntity is the metadata key of the entity name of entity documents.
+	MetaKeyEntity = "_neo4j_entity"
+	// MetaKeyEntityType is the metadata key of the entity type of entity documents.
+	MetaKeyEntityType = "_neo4j_entity_type"
+	// MetaKeyEntities is the metadata key of the names of the matched entities mentioned by chunk documents.
+	MetaKeyEntities = "_neo4j_entities"
+)
+
+const (
+	// KindEntity documents describe a matched entity and its relations.
+	KindEntity = "entity"
+	// KindChunk documents are the indexed chunks mentioning the matched entities.
+	KindChunk = "chunk"
+)
+
+// DefaultQueryPrompt is the default prompt template to extract the entity names from a query.
+const DefaultQueryPrompt = "Extract the names of the entities, e.g. people, organizations, places, products and concepts, " +
+	"in the following question. Reply with the names only, one per line.\n\n" +
+	"Question: {query}"
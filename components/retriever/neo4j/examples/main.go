@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+
+	neo4jretriever "github.com/cloudwego/eino-ext/components/retriever/neo4j"
+)
+
+func main() {
+	ctx := context.Background()
+
+	driver, err := neo4j.NewDriverWithContext(os.Getenv("NEO4J_URI"),
+		neo4j.BasicAuth(os.Getenv("NEO4J_USERNAME"), os.Getenv("NEO4J_PASSWORD"), ""))
+	if err != nil {
+		log.Fatalf("connect neo4j failed, err=%v", err)
+	}
+	defer driver.Close(ctx)
+
+	// the graph is built by neo4j indexer, see components/indexer/neo4j/examples
+	r, err := neo4jretriever.NewRetriever(ctx, &neo4jretriever.RetrieverConfig{
+		Executor: neo4jretriever.NewDriverExecutor(driver, ""),
+		MaxHops:  2,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "who develops eino")
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("kind=%v, id=%s, score=%v, content=%s", doc.MetaData[neo4jretriever.MetaKeyKind], doc.ID, doc.Score(), doc.Content)
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Executor executes a cypher query, and returns the records as maps.
+// Use NewDriverExecutor for a neo4j driver.
+type Executor interface {
+	Execute(ctx context.Context, cypher string, params map[string]any) ([]map[string]any, error)
+}
+
+// NewDriverExecutor returns an Executor running queries with the driver on the database,
+// an empty database means the default database of the server.
+func NewDriverExecutor(driver neo4j.DriverWithContext, database string) Executor {
+	return &driverExecutor{driver: driver, database: database}
+}
+
+type driverExecutor struct {
+	driver   neo4j.DriverWithContext
+	database string
+}
+
+func (d *driverExecutor) Execute(ctx context.Context, cypher string, params map[string]any) ([]map[string]any, error) {
+	var opts []neo4j.ExecuteQueryConfigurationOption
+	if d.database != "" {
+		opts = append(opts, neo4j.ExecuteQueryWithDatabase(d.database))
+	}
+
+	result, err := neo4j.ExecuteQuery(ctx, d.driver, cypher, params, neo4j.EagerResultTransformer, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]map[string]any, 0, len(result.Records))
+	for _, record := range result.Records {
+		records = append(records, record.AsMap())
+	}
+	return records, nil
+}
@@ -0,0 +1,327 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+// entityCypher searches the seed entities in the fulltext index, and collects their relations within the hops.
+const entityCypher = `CALL db.index.fulltext.queryNodes($index, $query) YIELD node, score
+WHERE score >= $threshold
+WITH node, score ORDER BY score DESC LIMIT $topK
+OPTIONAL MATCH p = (node)-[:RELATED_TO*1..%d]-(:Entity)
+WITH node, score, collect(p) AS paths
+WITH node, score, reduce(rels = [], p IN paths | rels + [r IN relationships(p) WHERE NOT r IN rels]) AS rels
+RETURN node.key AS key, node.name AS name, node.type AS type, node.description AS description, score,
+  [r IN rels[..$maxRelations] | {source: startNode(r).name, target: endNode(r).name, type: r.type, description: r.description}] AS relations
+ORDER BY score DESC`
+
+// chunkCypher returns the chunks mentioning the most seed entities.
+const chunkCypher = `MATCH (c:Chunk)-[:MENTIONS]->(n:Entity) WHERE n.key IN $keys
+WITH c, collect(n.name) AS entities, count(n) AS hits
+ORDER BY hits DESC, c.id LIMIT $topK
+RETURN c.id AS id, c.content AS content, c.metadata AS metadata, entities, hits`
+
+// luceneSpecial matches the special characters of lucene query syntax.
+var luceneSpecial = regexp.MustCompile(`[+\-&|!(){}\[\]^"~*?:\\/]`)
+
+// listItemPrefix matches the bullets and numbers of the list items, e.g. "- ", "1. ".
+var listItemPrefix = regexp.MustCompile(`^\s*(?:[-*•]|\d+[.)、])\s*`)
+
+type RetrieverConfig struct {
+	// Executor executes cypher queries, use NewDriverExecutor for a neo4j driver.
+	// Required.
+	Executor Executor
+	// FullTextIndex is the fulltext index of entity names and descriptions, created by neo4j indexer CreateSchema.
+	// Optional. Default: "eino_entity_fulltext".
+	FullTextIndex string
+	// ChatModel extracts the entity names from queries, which are searched instead of the whole query.
+	// Optional. Default: nil, search the query.
+	ChatModel model.BaseChatModel
+	// Prompt is the prompt template in FString format to extract the entity names, with the variable {query},
+	// the reply should contain an entity name per line.
+	// Optional. Default: DefaultQueryPrompt.
+	Prompt string
+	// TopK limits the number of matched entities.
+	// Optional. Default: 5.
+	TopK int
+	// ScoreThreshold filters out entities with lower fulltext score.
+	// Optional. Default: nil, no filter.
+	ScoreThreshold *float64
+	// MaxHops is the depth of relations traversed from the matched entities, at most 3.
+	// Optional. Default: 1.
+	MaxHops int
+	// MaxRelations limits the number of relations of a matched entity.
+	// Optional. Default: 50.
+	MaxRelations int
+	// ChunkTopK limits the number of chunks mentioning the matched entities, negative disables chunk documents.
+	// Optional. Default: 5.
+	ChunkTopK int
+}
+
+// Retriever retrieves from the knowledge graph built by neo4j indexer. It matches the entities of the query in the
+// fulltext index, traverses their relations, and returns a KindEntity document describing each matched entity and its
+// relations, followed by the KindChunk documents of the chunks mentioning the most matched entities.
+type Retriever struct {
+	config *RetrieverConfig
+}
+
+func NewRetriever(_ context.Context, config *RetrieverConfig) (*Retriever, error) {
+	if config == nil || config.Executor == nil {
+		return nil, errors.New("[NewRetriever] executor not provided")
+	}
+
+	if config.FullTextIndex == "" {
+		config.FullTextIndex = defaultFullTextIndex
+	}
+
+	if config.Prompt == "" {
+		config.Prompt = DefaultQueryPrompt
+	}
+
+	if config.TopK <= 0 {
+		config.TopK = defaultTopK
+	}
+
+	if config.MaxHops <= 0 {
+		config.MaxHops = defaultMaxHops
+	}
+	if config.MaxHops > maxHopsLimit {
+		return nil, fmt.Errorf("[NewRetriever] max hops should be at most %d, got %d", maxHopsLimit, config.MaxHops)
+	}
+
+	if config.MaxRelations <= 0 {
+		config.MaxRelations = defaultMaxRelations
+	}
+
+	if config.ChunkTopK == 0 {
+		config.ChunkTopK = defaultChunkTopK
+	}
+
+	return &Retriever{config: config}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	co := retriever.GetCommonOptions(&retriever.Options{
+		Index:          &r.config.FullTextIndex,
+		TopK:           &r.config.TopK,
+		ScoreThreshold: r.config.ScoreThreshold,
+	}, opts...)
+
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query:          query,
+		TopK:           *co.TopK,
+		ScoreThreshold: co.ScoreThreshold,
+		Extra: map[string]any{
+			"max_hops":    r.config.MaxHops,
+			"chunk_top_k": r.config.ChunkTopK,
+		},
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	search, err := r.searchQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("[neo4j retriever] extract entities failed: %w", err)
+	}
+
+	docs = make([]*schema.Document, 0)
+	if search == "" {
+		callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+		return docs, nil
+	}
+
+	threshold := 0.0
+	if co.ScoreThreshold != nil {
+		threshold = *co.ScoreThreshold
+	}
+	records, err := r.config.Executor.Execute(ctx, fmt.Sprintf(entityCypher, r.config.MaxHops), map[string]any{
+		"index":        *co.Index,
+		"query":        search,
+		"threshold":    threshold,
+		"topK":         *co.TopK,
+		"maxRelations": r.config.MaxRelations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[neo4j retriever] search entities failed: %w", err)
+	}
+
+	keys := make([]string, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, toString(record["key"]))
+		docs = append(docs, entityDocument(record))
+	}
+
+	if len(keys) > 0 && r.config.ChunkTopK > 0 {
+		records, err = r.config.Executor.Execute(ctx, chunkCypher, map[string]any{
+			"keys": keys,
+			"topK": r.config.ChunkTopK,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[neo4j retriever] search chunks failed: %w", err)
+		}
+
+		for _, record := range records {
+			doc, err := chunkDocument(record, len(keys))
+			if err != nil {
+				return nil, fmt.Errorf("[neo4j retriever] %w", err)
+			}
+			docs = append(docs, doc)
+		}
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+// searchQuery returns the lucene query of the fulltext index, the quoted entity names extracted by ChatModel,
+// or the escaped query if ChatModel is not set or no entity is extracted.
+func (r *Retriever) searchQuery(ctx context.Context, query string) (string, error) {
+	escaped := strings.TrimSpace(luceneSpecial.ReplaceAllString(query, `\$0`))
+	if r.config.ChatModel == nil {
+		return escaped, nil
+	}
+
+	msgs, err := schema.UserMessage(r.config.Prompt).Format(ctx, map[string]any{
+		"query": query,
+	}, schema.FString)
+	if err != nil {
+		return "", fmt.Errorf("format prompt failed: %w", err)
+	}
+
+	out, err := r.config.ChatModel.Generate(ctx, msgs)
+	if err != nil {
+		return "", err
+	}
+
+	var phrases []string
+	for _, line := range strings.Split(out.Content, "\n") {
+		name := strings.TrimSpace(listItemPrefix.ReplaceAllString(line, ""))
+		if name != "" {
+			phrases = append(phrases, `"`+luceneSpecial.ReplaceAllString(name, `\$0`)+`"`)
+		}
+	}
+	if len(phrases) == 0 {
+		return escaped, nil
+	}
+	return strings.Join(phrases, " OR "), nil
+}
+
+// entityDocument describes the entity and its relations, one per line.
+func entityDocument(record map[string]any) *schema.Document {
+	name, entityType, description := toString(record["name"]), toString(record["type"]), toString(record["description"])
+
+	sb := strings.Builder{}
+	sb.WriteString(name)
+	if entityType != "" {
+		sb.WriteString(" (" + entityType + ")")
+	}
+	if description != "" {
+		sb.WriteString(": " + description)
+	}
+
+	relations, _ := record["relations"].([]any)
+	for _, rel := range relations {
+		m, ok := rel.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n%s -[%s]-> %s", toString(m["source"]), toString(m["type"]), toString(m["target"])))
+		if d := toString(m["description"]); d != "" {
+			sb.WriteString(": " + d)
+		}
+	}
+
+	doc := &schema.Document{
+		ID:      "entity:" + toString(record["key"]),
+		Content: sb.String(),
+		MetaData: map[string]any{
+			MetaKeyKind:       KindEntity,
+			MetaKeyEntity:     name,
+			MetaKeyEntityType: entityType,
+		},
+	}
+	return doc.WithScore(toFloat(record["score"]))
+}
+
+// chunkDocument returns the chunk, scored by the fraction of the matched entities it mentions.
+func chunkDocument(record map[string]any, matched int) (*schema.Document, error) {
+	doc := &schema.Document{
+		ID:       toString(record["id"]),
+		Content:  toString(record["content"]),
+		MetaData: map[string]any{},
+	}
+	if metadata := toString(record["metadata"]); metadata != "" && metadata != "null" {
+		if err := json.Unmarshal([]byte(metadata), &doc.MetaData); err != nil {
+			return nil, fmt.Errorf("unmarshal metadata failed, id=%s, %w", doc.ID, err)
+		}
+	}
+
+	var entities []string
+	items, _ := record["entities"].([]any)
+	for _, item := range items {
+		entities = append(entities, toString(item))
+	}
+	doc.MetaData[MetaKeyKind] = KindChunk
+	doc.MetaData[MetaKeyEntities] = entities
+
+	return doc.WithScore(toFloat(record["hits"]) / float64(matched)), nil
+}
+
+func toString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
+func toFloat(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int64:
+		return float64(n)
+	case int:
+		return float64(n)
+	}
+	return 0
+}
+
+func (r *Retriever) GetType() string {
+	return typ
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
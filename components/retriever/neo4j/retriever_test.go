@@ -0,0 +1,184 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package neo4j
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+type mockExecutor struct {
+	params   []map[string]any
+	entities []map[string]any
+	chunks   []map[string]any
+	err      error
+}
+
+func (m *mockExecutor) Execute(ctx context.Context, cypher string, params map[string]any) ([]map[string]any, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.params = append(m.params, params)
+	if strings.HasPrefix(cypher, "CALL db.index.fulltext.queryNodes") {
+		return m.entities, nil
+	}
+	return m.chunks, nil
+}
+
+type mockChatModel struct {
+	reply string
+}
+
+func (m *mockChatModel) Generate(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.Message, error) {
+	return schema.AssistantMessage(m.reply, nil), nil
+}
+
+func (m *mockChatModel) Stream(ctx context.Context, input []*schema.Message, opts ...model.Option) (*schema.StreamReader[*schema.Message], error) {
+	return nil, errors.New("not implemented")
+}
+
+func newMockExecutor() *mockExecutor {
+	return &mockExecutor{
+		entities: []map[string]any{
+			{
+				"key": "eino", "name": "Eino", "type": "framework", "description": "LLM framework", "score": 2.5,
+				"relations": []any{
+					map[string]any{"source": "Eino", "target": "Golang", "type": "written_in", "description": "Eino is written in Golang"},
+					map[string]any{"source": "CloudWeGo", "target": "Eino", "type": "develops", "description": nil},
+				},
+			},
+			{"key": "golang", "name": "Golang", "type": "", "description": "", "score": 1.0, "relations": []any{}},
+		},
+		chunks: []map[string]any{
+			{"id": "1", "content": "Eino is written in Golang.", "metadata": `{"source":"a.md"}`, "entities": []any{"Eino", "Golang"}, "hits": int64(2)},
+			{"id": "2", "content": "CloudWeGo develops Eino.", "metadata": "null", "entities": []any{"Eino"}, "hits": int64(1)},
+		},
+	}
+}
+
+func TestNewRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &RetrieverConfig{})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &RetrieverConfig{Executor: &mockExecutor{}, MaxHops: 4})
+	assert.Error(t, err)
+
+	r, err := NewRetriever(ctx, &RetrieverConfig{Executor: &mockExecutor{}})
+	assert.NoError(t, err)
+	assert.Equal(t, defaultFullTextIndex, r.config.FullTextIndex)
+	assert.Equal(t, defaultTopK, r.config.TopK)
+	assert.Equal(t, defaultMaxHops, r.config.MaxHops)
+	assert.Equal(t, defaultMaxRelations, r.config.MaxRelations)
+	assert.Equal(t, defaultChunkTopK, r.config.ChunkTopK)
+}
+
+func TestRetrieve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("query", func(t *testing.T) {
+		exec := newMockExecutor()
+		r, err := NewRetriever(ctx, &RetrieverConfig{Executor: exec, MaxHops: 2})
+		assert.NoError(t, err)
+
+		threshold := 0.5
+		docs, err := r.Retrieve(ctx, "what is eino (golang)?", retriever.WithTopK(3), retriever.WithScoreThreshold(threshold))
+		assert.NoError(t, err)
+		assert.Len(t, docs, 4)
+
+		assert.Equal(t, map[string]any{
+			"index":        defaultFullTextIndex,
+			"query":        `what is eino \(golang\)\?`,
+			"threshold":    0.5,
+			"topK":         3,
+			"maxRelations": defaultMaxRelations,
+		}, exec.params[0])
+		assert.Equal(t, map[string]any{"keys": []string{"eino", "golang"}, "topK": defaultChunkTopK}, exec.params[1])
+
+		assert.Equal(t, "entity:eino", docs[0].ID)
+		assert.Equal(t, "Eino (framework): LLM framework\n"+
+			"Eino -[written_in]-> Golang: Eino is written in Golang\n"+
+			"CloudWeGo -[develops]-> Eino", docs[0].Content)
+		assert.Equal(t, 2.5, docs[0].Score())
+		assert.Equal(t, KindEntity, docs[0].MetaData[MetaKeyKind])
+		assert.Equal(t, "Eino", docs[0].MetaData[MetaKeyEntity])
+		assert.Equal(t, "Golang", docs[1].Content)
+
+		assert.Equal(t, "1", docs[2].ID)
+		assert.Equal(t, "a.md", docs[2].MetaData["source"])
+		assert.Equal(t, KindChunk, docs[2].MetaData[MetaKeyKind])
+		assert.Equal(t, []string{"Eino", "Golang"}, docs[2].MetaData[MetaKeyEntities])
+		assert.Equal(t, 1.0, docs[2].Score())
+		assert.Equal(t, 0.5, docs[3].Score())
+	})
+
+	t.Run("chat model", func(t *testing.T) {
+		exec := newMockExecutor()
+		r, err := NewRetriever(ctx, &RetrieverConfig{
+			Executor:  exec,
+			ChatModel: &mockChatModel{reply: "1. Eino\n- C++ \"STL\"\n"},
+			ChunkTopK: -1,
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "who develops eino and stl?")
+		assert.NoError(t, err)
+		assert.Len(t, docs, 2)
+		assert.Len(t, exec.params, 1)
+		assert.Equal(t, `"Eino" OR "C\+\+ \"STL\""`, exec.params[0]["query"])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		exec := &mockExecutor{}
+		r, err := NewRetriever(ctx, &RetrieverConfig{Executor: exec})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "  ")
+		assert.NoError(t, err)
+		assert.Empty(t, docs)
+		assert.Empty(t, exec.params)
+
+		// no entity matched, chunks are not searched
+		docs, err = r.Retrieve(ctx, "eino")
+		assert.NoError(t, err)
+		assert.Empty(t, docs)
+		assert.Len(t, exec.params, 1)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &RetrieverConfig{Executor: &mockExecutor{err: fmt.Errorf("mock err")}})
+		assert.NoError(t, err)
+		_, err = r.Retrieve(ctx, "eino")
+		assert.Error(t, err)
+
+		exec := newMockExecutor()
+		exec.chunks[0]["metadata"] = "{invalid"
+		r, err = NewRetriever(ctx, &RetrieverConfig{Executor: exec})
+		assert.NoError(t, err)
+		_, err = r.Retrieve(ctx, "eino")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/normalize"
+)
+
+func main() {
+	ctx := context.Background()
+
+	threshold := 0.5
+	// replace with any retriever, e.g. components/retriever/pgvector with DistanceL2
+	r, err := normalize.NewRetriever(ctx, &normalize.Config{
+		Retriever:      &l2Retriever{},
+		Metric:         normalize.MetricL2,
+		TopK:           2,
+		ScoreThreshold: &threshold,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "eino", retriever.WithTopK(10))
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, score=%v, raw score=%v, content=%s", doc.ID, doc.Score(), doc.MetaData["_raw_score"], doc.Content)
+	}
+}
+
+// l2Retriever returns euclidean distances as scores, lower is better.
+type l2Retriever struct{}
+
+func (l *l2Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return []*schema.Document{
+		(&schema.Document{ID: "1", Content: "Eino is a LLM application development framework in Golang."}).WithScore(0.2),
+		(&schema.Document{ID: "2", Content: "Eino provides components, orchestration and flows."}).WithScore(0.6),
+		(&schema.Document{ID: "3", Content: "The weather is sunny today."}).WithScore(1.8),
+	}, nil
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package normalize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+// Metric is the metric of the scores returned by the underlying retriever.
+type Metric string
+
+const (
+	// MetricCosine is cosine similarity in [-1, 1], higher is better, normalized by (score+1)/2.
+	// e.g. pgvector with DistanceCosine, qdrant and milvus with COSINE.
+	MetricCosine Metric = "cosine"
+	// MetricInnerProduct is inner product, unbounded and higher is better, normalized by sigmoid.
+	// Use MetricCosine instead if the vectors are normalized, as the inner product is the cosine similarity then.
+	MetricInnerProduct Metric = "ip"
+	// MetricL2 is euclidean distance, or its square, in [0, +inf), lower is better, normalized by 1/(1+score).
+	// e.g. pgvector with DistanceL2, milvus with L2.
+	MetricL2 Metric = "l2"
+	// MetricMinMax scales scores of the results into [0, 1] by the min and max of them, higher is better.
+	// It suits unbounded scores like bm25, but the normalized scores are only comparable within one query.
+	MetricMinMax Metric = "min_max"
+)
+
+const defaultRawScoreKey = "_raw_score"
+
+type Config struct {
+	// Retriever retrieves documents with scores of Metric.
+	// Required.
+	Retriever retriever.Retriever
+	// Metric is the metric of the scores returned by Retriever.
+	// Required if Normalize is not set.
+	Metric Metric
+	// Normalize maps a score of Retriever into [0, 1], higher is better, overrides Metric.
+	// Optional. Default: nil, normalize by Metric.
+	Normalize func(score float64) float64
+	// TopK limits the number of documents returned after normalization.
+	// Optional. Default: 0, return all documents.
+	TopK int
+	// ScoreThreshold filters out documents with normalized score lower than threshold.
+	// Optional. Default: nil, no filter.
+	ScoreThreshold *float64
+	// RawScoreKey is the metadata key of the score returned by Retriever, for debugging.
+	// Optional. Default: "_raw_score".
+	RawScoreKey string
+}
+
+// Retriever normalizes scores of the underlying retriever into [0, 1], so that thresholds and scores
+// are comparable across vector stores, then applies TopK and ScoreThreshold to the normalized scores.
+// Documents are sorted by normalized score in descending order.
+// Options are passed to the underlying Retriever, note that retriever.WithScoreThreshold is in its raw metric.
+type Retriever struct {
+	config *Config
+}
+
+func NewRetriever(_ context.Context, config *Config) (*Retriever, error) {
+	if config == nil || config.Retriever == nil {
+		return nil, errors.New("[NewRetriever] retriever not provided")
+	}
+
+	if config.Normalize == nil {
+		switch config.Metric {
+		case MetricCosine, MetricInnerProduct, MetricL2, MetricMinMax:
+		case "":
+			return nil, errors.New("[NewRetriever] metric not provided")
+		default:
+			return nil, fmt.Errorf("[NewRetriever] unknown metric: %s", config.Metric)
+		}
+	}
+
+	if config.RawScoreKey == "" {
+		config.RawScoreKey = defaultRawScoreKey
+	}
+
+	return &Retriever{config: config}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query:          query,
+		TopK:           r.config.TopK,
+		ScoreThreshold: r.config.ScoreThreshold,
+		Extra:          map[string]any{"metric": r.config.Metric},
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	candidates, err := r.config.Retriever.Retrieve(ctx, query, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("[normalize retriever] retrieve failed: %w", err)
+	}
+
+	normalize := r.normalizer(candidates)
+	for _, doc := range candidates {
+		raw := doc.Score()
+		if doc.MetaData == nil {
+			doc.MetaData = make(map[string]any)
+		}
+		doc.MetaData[r.config.RawScoreKey] = raw
+		doc.WithScore(normalize(raw))
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score() > candidates[j].Score()
+	})
+
+	docs = make([]*schema.Document, 0, len(candidates))
+	for _, doc := range candidates {
+		if r.config.ScoreThreshold != nil && doc.Score() < *r.config.ScoreThreshold {
+			// sorted, the rest are lower
+			break
+		}
+		docs = append(docs, doc)
+		if r.config.TopK > 0 && len(docs) >= r.config.TopK {
+			break
+		}
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+// normalizer returns the normalize function of the config, docs are used by MetricMinMax.
+func (r *Retriever) normalizer(docs []*schema.Document) func(score float64) float64 {
+	if r.config.Normalize != nil {
+		return r.config.Normalize
+	}
+
+	switch r.config.Metric {
+	case MetricCosine:
+		return func(score float64) float64 { return clamp((score + 1) / 2) }
+	case MetricInnerProduct:
+		return func(score float64) float64 { return 1 / (1 + math.Exp(-score)) }
+	case MetricL2:
+		return func(score float64) float64 { return 1 / (1 + math.Max(score, 0)) }
+	default:
+		if len(docs) == 0 {
+			return nil
+		}
+		lo, hi := docs[0].Score(), docs[0].Score()
+		for _, doc := range docs[1:] {
+			lo, hi = math.Min(lo, doc.Score()), math.Max(hi, doc.Score())
+		}
+		return func(score float64) float64 {
+			if hi == lo {
+				return 1
+			}
+			return (score - lo) / (hi - lo)
+		}
+	}
+}
+
+func clamp(score float64) float64 {
+	return math.Max(0, math.Min(1, score))
+}
+
+func (r *Retriever) GetType() string {
+	return "Normalize"
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package normalize
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRetriever struct {
+	scores []float64
+	err    error
+}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	docs := make([]*schema.Document, len(m.scores))
+	for i, score := range m.scores {
+		docs[i] = (&schema.Document{ID: fmt.Sprint(i + 1)}).WithScore(score)
+	}
+	return docs, nil
+}
+
+func TestNewRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &Config{Metric: MetricCosine})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}, Metric: "hamming"})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}, Normalize: func(score float64) float64 { return score }})
+	assert.NoError(t, err)
+}
+
+func TestRetrieve(t *testing.T) {
+	ctx := context.Background()
+	threshold := 0.5
+
+	tests := []struct {
+		name      string
+		metric    Metric
+		scores    []float64
+		threshold *float64
+		topK      int
+		wantIDs   []string
+		wantScore []float64
+	}{
+		{
+			name:      "cosine",
+			metric:    MetricCosine,
+			scores:    []float64{0.8, -0.2, 0.2, 1},
+			threshold: &threshold,
+			wantIDs:   []string{"4", "1", "3"},
+			wantScore: []float64{1, 0.9, 0.6},
+		},
+		{
+			name:      "l2 is sorted ascending",
+			metric:    MetricL2,
+			scores:    []float64{0, 1, 3},
+			topK:      2,
+			wantIDs:   []string{"1", "2"},
+			wantScore: []float64{1, 0.5},
+		},
+		{
+			name:      "ip",
+			metric:    MetricInnerProduct,
+			scores:    []float64{-10, 0, 10},
+			threshold: &threshold,
+			wantIDs:   []string{"3", "2"},
+			wantScore: []float64{0.9999546021312976, 0.5},
+		},
+		{
+			name:      "min max",
+			metric:    MetricMinMax,
+			scores:    []float64{12, 2, 7},
+			wantIDs:   []string{"1", "3", "2"},
+			wantScore: []float64{1, 0.5, 0},
+		},
+		{
+			name:      "min max of equal scores",
+			metric:    MetricMinMax,
+			scores:    []float64{3, 3},
+			wantIDs:   []string{"1", "2"},
+			wantScore: []float64{1, 1},
+		},
+		{
+			name:   "empty",
+			metric: MetricMinMax,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRetriever(ctx, &Config{
+				Retriever:      &mockRetriever{scores: tt.scores},
+				Metric:         tt.metric,
+				TopK:           tt.topK,
+				ScoreThreshold: tt.threshold,
+			})
+			assert.NoError(t, err)
+
+			docs, err := r.Retrieve(ctx, "query")
+			assert.NoError(t, err)
+			assert.Len(t, docs, len(tt.wantIDs))
+			for i, doc := range docs {
+				assert.Equal(t, tt.wantIDs[i], doc.ID)
+				assert.InDelta(t, tt.wantScore[i], doc.Score(), 1e-9)
+				var id int
+				_, _ = fmt.Sscan(doc.ID, &id)
+				assert.Equal(t, tt.scores[id-1], doc.MetaData[defaultRawScoreKey])
+			}
+		})
+	}
+
+	r, err := NewRetriever(ctx, &Config{
+		Retriever:   &mockRetriever{scores: []float64{50, 100}},
+		Normalize:   func(score float64) float64 { return score / 100 },
+		RawScoreKey: "bm25",
+	})
+	assert.NoError(t, err)
+	docs, err := r.Retrieve(ctx, "query")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", docs[0].ID)
+	assert.Equal(t, 1.0, docs[0].Score())
+	assert.Equal(t, 100.0, docs[0].MetaData["bm25"])
+
+	r, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{err: fmt.Errorf("mock err")}, Metric: MetricCosine})
+	assert.NoError(t, err)
+	_, err = r.Retrieve(ctx, "query")
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/parent"
+)
+
+func main() {
+	ctx := context.Background()
+
+	r, err := parent.NewRetriever(ctx, &parent.Config{
+		Retriever: &staticRetriever{}, // any retriever, e.g. volc_vikingdb retriever
+		DocGetter: &mapDocGetter{docs: map[string]*schema.Document{
+			"eino": {ID: "eino", Content: "Eino is a LLM application development framework in Golang.\nEino provides components, orchestration and flows."},
+		}}, // e.g. InMemoryDocStore of parent indexer
+		TopK: 3,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "what is eino")
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, score=%v, content=%s", doc.ID, doc.Score(), doc.Content)
+	}
+}
+
+type staticRetriever struct{}
+
+func (s *staticRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return []*schema.Document{
+		(&schema.Document{ID: "eino_0", Content: "Eino is a LLM application development framework in Golang.",
+			MetaData: map[string]any{"parent_id": "eino"}}).WithScore(0.9),
+		(&schema.Document{ID: "eino_1", Content: "Eino provides components, orchestration and flows.",
+			MetaData: map[string]any{"parent_id": "eino"}}).WithScore(0.6),
+	}, nil
+}
+
+type mapDocGetter struct {
+	docs map[string]*schema.Document
+}
+
+func (m *mapDocGetter) MGet(ctx context.Context, ids []string) ([]*schema.Document, error) {
+	var result []*schema.Document
+	for _, id := range ids {
+		if doc, ok := m.docs[id]; ok {
+			result = append(result, doc)
+		}
+	}
+	return result, nil
+}
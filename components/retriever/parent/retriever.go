@@ -0,0 +1,144 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultParentIDKey = "parent_id"
+
+// DocGetter fetches parent documents by ids, e.g. InMemoryDocStore of parent indexer.
+type DocGetter interface {
+	MGet(ctx context.Context, ids []string) ([]*schema.Document, error)
+}
+
+type Config struct {
+	// Retriever retrieves sub documents, which have parent id in metadata.
+	// Required.
+	Retriever retriever.Retriever
+	// DocGetter fetches parent documents.
+	// Required.
+	DocGetter DocGetter
+	// ParentIDKey is the metadata key of parent document id in sub documents,
+	// should be the same as ParentIDKey of parent indexer.
+	// Optional. Default: "parent_id"
+	ParentIDKey string
+	// TopK is the max number of parent documents returned.
+	// Optional. Default: all parents of retrieved sub documents
+	TopK int
+}
+
+// Retriever retrieves small sub documents with Retriever, and returns their parent documents
+// fetched from DocGetter, in order of first appearance of each parent.
+type Retriever struct {
+	config *Config
+}
+
+func NewRetriever(_ context.Context, config *Config) (*Retriever, error) {
+	if config == nil || config.Retriever == nil {
+		return nil, errors.New("[NewRetriever] retriever not provided")
+	}
+	if config.DocGetter == nil {
+		return nil, errors.New("[NewRetriever] doc getter not provided")
+	}
+
+	nConf := *config
+	if nConf.ParentIDKey == "" {
+		nConf.ParentIDKey = defaultParentIDKey
+	}
+
+	return &Retriever{config: &nConf}, nil
+}
+
+// Retrieve returns parent documents, score of each parent is the highest score of its sub documents.
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{Query: query, TopK: r.config.TopK})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	subDocs, err := r.config.Retriever.Retrieve(ctx, query, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("[parent retriever] retrieve sub documents failed: %w", err)
+	}
+
+	var parentIDs []string
+	scores := make(map[string]float64)
+	for _, sub := range subDocs {
+		parentID, ok := sub.MetaData[r.config.ParentIDKey].(string)
+		if !ok || parentID == "" {
+			continue
+		}
+		score, seen := scores[parentID]
+		if !seen {
+			parentIDs = append(parentIDs, parentID)
+			scores[parentID] = sub.Score()
+		} else if sub.Score() > score {
+			scores[parentID] = sub.Score()
+		}
+	}
+
+	if r.config.TopK > 0 && len(parentIDs) > r.config.TopK {
+		parentIDs = parentIDs[:r.config.TopK]
+	}
+
+	docs = make([]*schema.Document, 0, len(parentIDs))
+	if len(parentIDs) > 0 {
+		parents, err := r.config.DocGetter.MGet(ctx, parentIDs)
+		if err != nil {
+			return nil, fmt.Errorf("[parent retriever] get parent documents failed: %w", err)
+		}
+
+		for _, parent := range parents {
+			if parent == nil {
+				continue
+			}
+			doc := &schema.Document{
+				ID:       parent.ID,
+				Content:  parent.Content,
+				MetaData: make(map[string]any, len(parent.MetaData)),
+			}
+			for k, v := range parent.MetaData {
+				doc.MetaData[k] = v
+			}
+			docs = append(docs, doc.WithScore(scores[parent.ID]))
+		}
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+func (r *Retriever) GetType() string {
+	return "Parent"
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
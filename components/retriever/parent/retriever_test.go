@@ -0,0 +1,153 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parent
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRetriever struct {
+	docs []*schema.Document
+	err  error
+}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return m.docs, m.err
+}
+
+type mockDocGetter struct {
+	docs map[string]*schema.Document
+	err  error
+}
+
+func (m *mockDocGetter) MGet(ctx context.Context, ids []string) ([]*schema.Document, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var result []*schema.Document
+	for _, id := range ids {
+		if doc, ok := m.docs[id]; ok {
+			result = append(result, doc)
+		}
+	}
+	return result, nil
+}
+
+func sub(id, parentID string, score float64) *schema.Document {
+	return (&schema.Document{ID: id, MetaData: map[string]any{defaultParentIDKey: parentID}}).WithScore(score)
+}
+
+func TestRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &Config{DocGetter: &mockDocGetter{}})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}})
+	assert.Error(t, err)
+
+	getter := &mockDocGetter{docs: map[string]*schema.Document{
+		"a": {ID: "a", Content: "parent a"},
+		"b": {ID: "b", Content: "parent b", MetaData: map[string]any{"source": "x"}},
+		"c": {ID: "c", Content: "parent c"},
+	}}
+
+	t.Run("retrieve parents", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever: &mockRetriever{docs: []*schema.Document{
+				sub("b_0", "b", 0.9),
+				sub("a_1", "a", 0.7),
+				sub("b_1", "b", 0.95),
+				{ID: "orphan"},
+				sub("c_0", "c", 0.5),
+			}},
+			DocGetter: getter,
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "query")
+		assert.NoError(t, err)
+		assert.Len(t, docs, 3)
+		assert.Equal(t, "b", docs[0].ID)
+		assert.Equal(t, "parent b", docs[0].Content)
+		assert.Equal(t, "x", docs[0].MetaData["source"])
+		assert.Equal(t, 0.95, docs[0].Score())
+		assert.Equal(t, "a", docs[1].ID)
+		assert.Equal(t, 0.7, docs[1].Score())
+		assert.Equal(t, "c", docs[2].ID)
+		_, ok := getter.docs["b"].MetaData["_score"]
+		assert.False(t, ok)
+	})
+
+	t.Run("top k", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever: &mockRetriever{docs: []*schema.Document{
+				sub("c_0", "c", 0.9),
+				sub("a_0", "a", 0.8),
+				sub("b_0", "b", 0.7),
+			}},
+			DocGetter: getter,
+			TopK:      2,
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "query")
+		assert.NoError(t, err)
+		assert.Len(t, docs, 2)
+		assert.Equal(t, "c", docs[0].ID)
+		assert.Equal(t, "a", docs[1].ID)
+	})
+
+	t.Run("custom parent id key", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever: &mockRetriever{docs: []*schema.Document{
+				{ID: "a_0", MetaData: map[string]any{"pid": "a"}},
+			}},
+			DocGetter:   getter,
+			ParentIDKey: "pid",
+		})
+		assert.NoError(t, err)
+
+		docs, err := r.Retrieve(ctx, "query")
+		assert.NoError(t, err)
+		assert.Len(t, docs, 1)
+		assert.Equal(t, "a", docs[0].ID)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		r, err := NewRetriever(ctx, &Config{
+			Retriever: &mockRetriever{err: fmt.Errorf("mock err")},
+			DocGetter: getter,
+		})
+		assert.NoError(t, err)
+		_, err = r.Retrieve(ctx, "query")
+		assert.Error(t, err)
+
+		r, err = NewRetriever(ctx, &Config{
+			Retriever: &mockRetriever{docs: []*schema.Document{sub("a_0", "a", 1)}},
+			DocGetter: &mockDocGetter{err: fmt.Errorf("mock err")},
+		})
+		assert.NoError(t, err)
+		_, err = r.Retrieve(ctx, "query")
+		assert.Error(t, err)
+	})
+}
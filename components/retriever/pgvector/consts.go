@@ -0,0 +1,36 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgvector
+
+const typ = "PGVector"
+
+const (
+	defaultTable = "eino_documents"
+	defaultTopK  = 5
+)
+
+// DistanceFunction is the pgvector distance operator used to search.
+type DistanceFunction string
+
+const (
+	// DistanceCosine uses cosine distance operator <=>, score is cosine similarity, the higher the better.
+	DistanceCosine DistanceFunction = "cosine"
+	// DistanceL2 uses euclidean distance operator <->, score is l2 distance, the lower the better.
+	DistanceL2 DistanceFunction = "l2"
+	// DistanceInnerProduct uses negative inner product operator <#>, score is inner product, the higher the better.
+	DistanceInnerProduct DistanceFunction = "inner_product"
+)
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/cloudwego/eino-ext/components/retriever/pgvector"
+)
+
+func main() {
+	ctx := context.Background()
+
+	pool, err := pgxpool.New(ctx, os.Getenv("POSTGRES_DSN"))
+	if err != nil {
+		log.Fatalf("connect postgres failed, err=%v", err)
+	}
+	defer pool.Close()
+
+	threshold := 0.1
+	retriever, err := pgvector.NewRetriever(ctx, &pgvector.RetrieverConfig{
+		Conn:             pool,
+		DistanceFunction: pgvector.DistanceCosine,
+		TopK:             2,
+		ScoreThreshold:   &threshold,
+		Embedding:        &mockEmbedding{},
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := retriever.Retrieve(ctx, "llm framework", pgvector.WithFilter("metadata->>'lang' = $2", "go"))
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		fmt.Printf("id=%s, score=%v, content=%s, metadata=%v\n", doc.ID, doc.Score(), doc.Content, doc.MetaData)
+	}
+}
+
+type mockEmbedding struct{}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	return [][]float64{{0, 0.1, 0.2, 0.3}}, nil
+}
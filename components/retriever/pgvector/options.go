@@ -0,0 +1,37 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgvector
+
+import (
+	"github.com/cloudwego/eino/components/retriever"
+)
+
+type implOptions struct {
+	Filter     string
+	FilterArgs []any
+}
+
+// WithFilter set sql fragment to filter documents, which will be used in WHERE clause.
+// Placeholders of args start from $2, since $1 is the query vector, e.g.
+//
+//	WithFilter("metadata->>'lang' = $2 AND (metadata->>'year')::int > $3", "go", 2020)
+func WithFilter(filter string, args ...any) retriever.Option {
+	return retriever.WrapImplSpecificOptFn(func(o *implOptions) {
+		o.Filter = filter
+		o.FilterArgs = args
+	})
+}
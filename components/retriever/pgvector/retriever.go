@@ -0,0 +1,255 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgvector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+// Querier queries rows, *pgxpool.Pool, *pgx.Conn and pgx.Tx are all satisfied.
+type Querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type RetrieverConfig struct {
+	// Conn queries documents, pgxpool.Pool is recommended.
+	Conn Querier
+	// Table is the table to search, "schema.table" is supported.
+	// The table should contain id, content, metadata(jsonb) and embedding(vector) columns, see pgvector indexer.
+	// Default "eino_documents".
+	Table string
+	// DistanceFunction is the operator used to search, the index of embedding column should be built with the same operator class.
+	// Default DistanceCosine.
+	DistanceFunction DistanceFunction
+	// TopK limits number of results given, default 5.
+	TopK int
+	// ScoreThreshold filters documents by score, see DistanceFunction for the meaning of score.
+	// For DistanceL2, documents with distance greater than ScoreThreshold are filtered,
+	// otherwise documents with similarity less than ScoreThreshold are filtered.
+	// Default nil, which means no threshold.
+	ScoreThreshold *float64
+	// Embedding vectorization method for query.
+	Embedding embedding.Embedder
+}
+
+type Retriever struct {
+	config *RetrieverConfig
+}
+
+func NewRetriever(ctx context.Context, config *RetrieverConfig) (*Retriever, error) {
+	if config.Embedding == nil {
+		return nil, fmt.Errorf("[NewRetriever] embedding not provided for pgvector retriever")
+	}
+
+	if config.Conn == nil {
+		return nil, fmt.Errorf("[NewRetriever] pgvector conn not provided")
+	}
+
+	if config.Table == "" {
+		config.Table = defaultTable
+	}
+
+	switch config.DistanceFunction {
+	case "":
+		config.DistanceFunction = DistanceCosine
+	case DistanceCosine, DistanceL2, DistanceInnerProduct:
+	default:
+		return nil, fmt.Errorf("[NewRetriever] unknown distance function: %s", config.DistanceFunction)
+	}
+
+	if config.TopK == 0 {
+		config.TopK = defaultTopK
+	}
+
+	return &Retriever{
+		config: config,
+	}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	co := retriever.GetCommonOptions(&retriever.Options{
+		Index:          &r.config.Table,
+		TopK:           &r.config.TopK,
+		ScoreThreshold: r.config.ScoreThreshold,
+		Embedding:      r.config.Embedding,
+	}, opts...)
+	io := retriever.GetImplSpecificOptions(&implOptions{}, opts...)
+
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query:          query,
+		TopK:           *co.TopK,
+		Filter:         io.Filter,
+		ScoreThreshold: co.ScoreThreshold,
+		Extra: map[string]any{
+			"distance_function": r.config.DistanceFunction,
+		},
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	emb := co.Embedding
+	if emb == nil {
+		return nil, fmt.Errorf("[pgvector retriever] embedding not provided")
+	}
+
+	vectors, err := emb.EmbedStrings(r.makeEmbeddingCtx(ctx, emb), []string{query})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("[pgvector retriever] invalid return length of vector, got=%d, expected=1", len(vectors))
+	}
+
+	sql, args := r.buildQuery(*co.Index, *co.TopK, co.ScoreThreshold, io, vectorLiteral(vectors[0]))
+
+	rows, err := r.config.Conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("[pgvector retriever] query failed, %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id, content string
+			metadata    []byte
+			score       float64
+		)
+		if err = rows.Scan(&id, &content, &metadata, &score); err != nil {
+			return nil, fmt.Errorf("[pgvector retriever] scan row failed, %w", err)
+		}
+
+		doc := &schema.Document{
+			ID:      id,
+			Content: content,
+		}
+		if len(metadata) > 0 {
+			if err = json.Unmarshal(metadata, &doc.MetaData); err != nil {
+				return nil, fmt.Errorf("[pgvector retriever] unmarshal metadata failed, id=%s, %w", id, err)
+			}
+		}
+
+		docs = append(docs, doc.WithScore(score))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[pgvector retriever] iterate rows failed, %w", err)
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+// buildQuery builds the search sql, $1 is the query vector, followed by filter args and score threshold.
+func (r *Retriever) buildQuery(table string, topK int, scoreThreshold *float64, io *implOptions, vector string) (string, []any) {
+	var distance, score, cmp string
+	switch r.config.DistanceFunction {
+	case DistanceL2:
+		distance = "embedding <-> $1::vector"
+		score = distance
+		cmp = "<="
+	case DistanceInnerProduct:
+		distance = "embedding <#> $1::vector"
+		score = "(" + distance + ") * -1"
+		cmp = ">="
+	default:
+		distance = "embedding <=> $1::vector"
+		score = "1 - (" + distance + ")"
+		cmp = ">="
+	}
+
+	args := []any{vector}
+	var conditions []string
+	if io.Filter != "" {
+		conditions = append(conditions, "("+io.Filter+")")
+		args = append(args, io.FilterArgs...)
+	}
+	if scoreThreshold != nil {
+		args = append(args, *scoreThreshold)
+		conditions = append(conditions, fmt.Sprintf("%s %s $%d", score, cmp, len(args)))
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("SELECT id, content, metadata, ")
+	sb.WriteString(score)
+	sb.WriteString(" AS score FROM ")
+	sb.WriteString(pgx.Identifier(strings.Split(table, ".")).Sanitize())
+	if len(conditions) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(conditions, " AND "))
+	}
+	sb.WriteString(" ORDER BY ")
+	sb.WriteString(distance)
+	sb.WriteString(" LIMIT ")
+	sb.WriteString(strconv.Itoa(topK))
+
+	return sb.String(), args
+}
+
+func (r *Retriever) makeEmbeddingCtx(ctx context.Context, emb embedding.Embedder) context.Context {
+	runInfo := &callbacks.RunInfo{
+		Component: components.ComponentOfEmbedding,
+	}
+
+	if embType, ok := components.GetType(emb); ok {
+		runInfo.Type = embType
+	}
+
+	runInfo.Name = runInfo.Type + string(runInfo.Component)
+
+	return callbacks.ReuseHandlers(ctx, runInfo)
+}
+
+func (r *Retriever) GetType() string {
+	return typ
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
+
+// vectorLiteral formats vector as pgvector text representation, e.g. [1,2,3].
+// pgvector stores float32, so the values are formatted in float32 precision.
+func vectorLiteral(vector []float64) string {
+	sb := strings.Builder{}
+	sb.WriteByte('[')
+	for idx, v := range vector {
+		if idx > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(v, 'f', -1, 32))
+	}
+	sb.WriteByte(']')
+
+	return sb.String()
+}
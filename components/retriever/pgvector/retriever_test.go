@@ -0,0 +1,178 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pgvector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewRetriever(t *testing.T) {
+	convey.Convey("test NewRetriever", t, func() {
+		ctx := context.Background()
+
+		convey.Convey("test embedding not provided", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{Conn: &mockQuerier{}})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] embedding not provided for pgvector retriever"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test conn not provided", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{Embedding: &mockEmbedding{}})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] pgvector conn not provided"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test unknown distance function", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Conn:             &mockQuerier{},
+				DistanceFunction: "hamming",
+				Embedding:        &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] unknown distance function: hamming"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestBuildQuery(t *testing.T) {
+	convey.Convey("test buildQuery", t, func() {
+		threshold := 0.5
+
+		convey.Convey("test cosine without filter", func() {
+			r := &Retriever{config: &RetrieverConfig{DistanceFunction: DistanceCosine}}
+			sql, args := r.buildQuery("eino_documents", 5, nil, &implOptions{}, "[1,2]")
+			convey.So(sql, convey.ShouldEqual, `SELECT id, content, metadata, 1 - (embedding <=> $1::vector) AS score FROM "eino_documents" ORDER BY embedding <=> $1::vector LIMIT 5`)
+			convey.So(args, convey.ShouldResemble, []any{"[1,2]"})
+		})
+
+		convey.Convey("test l2 with filter and threshold", func() {
+			r := &Retriever{config: &RetrieverConfig{DistanceFunction: DistanceL2}}
+			sql, args := r.buildQuery("public.docs", 3, &threshold, &implOptions{
+				Filter:     "metadata->>'lang' = $2",
+				FilterArgs: []any{"go"},
+			}, "[1,2]")
+			convey.So(sql, convey.ShouldEqual, `SELECT id, content, metadata, embedding <-> $1::vector AS score FROM "public"."docs" WHERE (metadata->>'lang' = $2) AND embedding <-> $1::vector <= $3 ORDER BY embedding <-> $1::vector LIMIT 3`)
+			convey.So(args, convey.ShouldResemble, []any{"[1,2]", "go", 0.5})
+		})
+
+		convey.Convey("test inner product with threshold", func() {
+			r := &Retriever{config: &RetrieverConfig{DistanceFunction: DistanceInnerProduct}}
+			sql, args := r.buildQuery("docs", 1, &threshold, &implOptions{}, "[1,2]")
+			convey.So(sql, convey.ShouldEqual, `SELECT id, content, metadata, (embedding <#> $1::vector) * -1 AS score FROM "docs" WHERE (embedding <#> $1::vector) * -1 >= $2 ORDER BY embedding <#> $1::vector LIMIT 1`)
+			convey.So(args, convey.ShouldResemble, []any{"[1,2]", 0.5})
+		})
+	})
+}
+
+func TestRetrieve(t *testing.T) {
+	convey.Convey("test Retrieve", t, func() {
+		ctx := context.Background()
+
+		convey.Convey("test query failed", func() {
+			r, _ := NewRetriever(ctx, &RetrieverConfig{
+				Conn:      &mockQuerier{err: fmt.Errorf("mock err")},
+				Embedding: &mockEmbedding{},
+			})
+			docs, err := r.Retrieve(ctx, "eino")
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[pgvector retriever] query failed, mock err"))
+			convey.So(docs, convey.ShouldBeNil)
+		})
+
+		convey.Convey("test success", func() {
+			q := &mockQuerier{rows: &mockRows{values: [][]any{
+				{"1", "eino", []byte(`{"lang":"go"}`), 0.9},
+				{"2", "pgvector", []byte(nil), 0.8},
+			}}}
+			r, _ := NewRetriever(ctx, &RetrieverConfig{
+				Conn:      q,
+				Embedding: &mockEmbedding{},
+			})
+			docs, err := r.Retrieve(ctx, "eino", retriever.WithTopK(2), WithFilter("metadata ? $2", "lang"))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(q.args, convey.ShouldResemble, []any{"[0.1,0.2]", "lang"})
+			convey.So(len(docs), convey.ShouldEqual, 2)
+			convey.So(docs[0].ID, convey.ShouldEqual, "1")
+			convey.So(docs[0].Content, convey.ShouldEqual, "eino")
+			convey.So(docs[0].MetaData["lang"], convey.ShouldEqual, "go")
+			convey.So(docs[0].Score(), convey.ShouldEqual, 0.9)
+			convey.So(docs[1].MetaData["lang"], convey.ShouldBeNil)
+			convey.So(docs[1].Score(), convey.ShouldEqual, 0.8)
+			convey.So(q.rows.closed, convey.ShouldBeTrue)
+		})
+	})
+}
+
+type mockQuerier struct {
+	err  error
+	rows *mockRows
+	sql  string
+	args []any
+}
+
+func (m *mockQuerier) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.sql = sql
+	m.args = args
+	return m.rows, nil
+}
+
+type mockRows struct {
+	values [][]any
+	idx    int
+	closed bool
+}
+
+func (m *mockRows) Close()                                       { m.closed = true }
+func (m *mockRows) Err() error                                   { return nil }
+func (m *mockRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (m *mockRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (m *mockRows) RawValues() [][]byte                          { return nil }
+func (m *mockRows) Conn() *pgx.Conn                              { return nil }
+
+func (m *mockRows) Next() bool {
+	m.idx++
+	return m.idx <= len(m.values)
+}
+
+func (m *mockRows) Values() ([]any, error) {
+	return m.values[m.idx-1], nil
+}
+
+func (m *mockRows) Scan(dest ...any) error {
+	row := m.values[m.idx-1]
+	*dest[0].(*string) = row[0].(string)
+	*dest[1].(*string) = row[1].(string)
+	*dest[2].(*[]byte) = row[2].([]byte)
+	*dest[3].(*float64) = row[3].(float64)
+	return nil
+}
+
+type mockEmbedding struct{}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	return [][]float64{{0.1, 0.2}}, nil
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+const (
+	typ = "Qdrant"
+
+	defaultTopK = 5
+
+	// defaultFieldContent is the payload key of document content, correspond to qdrant indexer.
+	defaultFieldContent = "content"
+	// defaultFieldDocID is the payload key of the original document id, correspond to qdrant indexer.
+	defaultFieldDocID = "doc_id"
+)
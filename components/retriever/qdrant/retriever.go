@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/qdrant/go-client/qdrant"
+)
+
+type RetrieverConfig struct {
+	// Client is the qdrant gRPC client, it's safe for concurrent use.
+	// Either Client or ClientConfig should be provided.
+	Client *qdrant.Client
+	// ClientConfig is used to create a client when Client is not provided.
+	// For qdrant cloud, set APIKey and UseTLS; for local instances, Host and Port are enough.
+	ClientConfig *qdrant.Config
+	// Collection is the collection to search.
+	Collection string
+	// VectorName is the name of the vector to search, for collections configured with named vectors.
+	// Default "", which means the unnamed default vector.
+	VectorName string
+	// Filter is the default payload filter, could be overridden by WithFilter.
+	// see: https://qdrant.tech/documentation/concepts/filtering/
+	Filter *qdrant.Filter
+	// ScoreThreshold filters out points with a score worse than the threshold.
+	// Whether the score is higher or lower depends on the distance metric of the collection.
+	// Default nil, which means no threshold.
+	ScoreThreshold *float64
+	// TopK limits number of results given, default 5.
+	TopK int
+	// DocumentConverter converts retrieved point to eino Document, default defaultDocumentConverter.
+	DocumentConverter func(ctx context.Context, point *qdrant.ScoredPoint) (*schema.Document, error)
+	// Embedding vectorization method for query.
+	Embedding embedding.Embedder
+}
+
+type Retriever struct {
+	config *RetrieverConfig
+}
+
+func NewRetriever(ctx context.Context, config *RetrieverConfig) (*Retriever, error) {
+	if config == nil {
+		return nil, fmt.Errorf("[NewRetriever] config not provided")
+	}
+
+	if config.Embedding == nil {
+		return nil, fmt.Errorf("[NewRetriever] embedding not provided for qdrant retriever")
+	}
+
+	if config.Collection == "" {
+		return nil, fmt.Errorf("[NewRetriever] qdrant collection not provided")
+	}
+
+	if config.Client == nil {
+		if config.ClientConfig == nil {
+			return nil, fmt.Errorf("[NewRetriever] qdrant client not provided")
+		}
+
+		cli, err := qdrant.NewClient(config.ClientConfig)
+		if err != nil {
+			return nil, fmt.Errorf("[NewRetriever] create qdrant client failed, %w", err)
+		}
+		config.Client = cli
+	}
+
+	if config.TopK == 0 {
+		config.TopK = defaultTopK
+	}
+
+	if config.DocumentConverter == nil {
+		config.DocumentConverter = defaultDocumentConverter
+	}
+
+	return &Retriever{
+		config: config,
+	}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	co := retriever.GetCommonOptions(&retriever.Options{
+		Index:          &r.config.Collection,
+		TopK:           &r.config.TopK,
+		ScoreThreshold: r.config.ScoreThreshold,
+		Embedding:      r.config.Embedding,
+	}, opts...)
+	io := retriever.GetImplSpecificOptions(&implOptions{
+		Filter: r.config.Filter,
+	}, opts...)
+
+	var filter string
+	if io.Filter != nil {
+		filter = io.Filter.String()
+	}
+
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query:          query,
+		TopK:           *co.TopK,
+		Filter:         filter,
+		ScoreThreshold: co.ScoreThreshold,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	emb := co.Embedding
+	if emb == nil {
+		return nil, fmt.Errorf("[qdrant retriever] embedding not provided")
+	}
+
+	vectors, err := emb.EmbedStrings(r.makeEmbeddingCtx(ctx, emb), []string{query})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("[qdrant retriever] invalid return length of vector, got=%d, expected=1", len(vectors))
+	}
+
+	req := &qdrant.QueryPoints{
+		CollectionName: *co.Index,
+		Query:          qdrant.NewQuery(vector2Float32(vectors[0])...),
+		Filter:         io.Filter,
+		Limit:          qdrant.PtrOf(uint64(*co.TopK)),
+		WithPayload:    qdrant.NewWithPayload(true),
+	}
+	if r.config.VectorName != "" {
+		req.Using = qdrant.PtrOf(r.config.VectorName)
+	}
+	if co.ScoreThreshold != nil {
+		req.ScoreThreshold = qdrant.PtrOf(float32(*co.ScoreThreshold))
+	}
+
+	points, err := r.config.Client.Query(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("[qdrant retriever] query failed, %w", err)
+	}
+
+	docs = make([]*schema.Document, 0, len(points))
+	for _, point := range points {
+		doc, err := r.config.DocumentConverter(ctx, point)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+func (r *Retriever) makeEmbeddingCtx(ctx context.Context, emb embedding.Embedder) context.Context {
+	runInfo := &callbacks.RunInfo{
+		Component: components.ComponentOfEmbedding,
+	}
+
+	if embType, ok := components.GetType(emb); ok {
+		runInfo.Type = embType
+	}
+
+	runInfo.Name = runInfo.Type + string(runInfo.Component)
+
+	return callbacks.ReuseHandlers(ctx, runInfo)
+}
+
+func (r *Retriever) GetType() string {
+	return typ
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
+
+func defaultDocumentConverter(ctx context.Context, point *qdrant.ScoredPoint) (*schema.Document, error) {
+	doc := &schema.Document{
+		ID:       pointIDString(point.GetId()),
+		MetaData: make(map[string]any, len(point.GetPayload())),
+	}
+
+	for k, v := range point.GetPayload() {
+		switch k {
+		case defaultFieldContent:
+			doc.Content = v.GetStringValue()
+			continue
+		case defaultFieldDocID:
+			// the point id is derived from the original id if it's neither uuid nor unsigned integer
+			if id := v.GetStringValue(); id != "" {
+				doc.ID = id
+			}
+			continue
+		}
+		doc.MetaData[k] = valueToAny(v)
+	}
+
+	return doc.WithScore(float64(point.GetScore())), nil
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/qdrant/go-client/qdrant"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewRetriever(t *testing.T) {
+	PatchConvey("test NewRetriever", t, func() {
+		ctx := context.Background()
+
+		PatchConvey("test config not provided", func() {
+			r, err := NewRetriever(ctx, nil)
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] config not provided"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test embedding not provided", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Client:     &qdrant.Client{},
+				Collection: "eino",
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] embedding not provided for qdrant retriever"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test collection not provided", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Client:    &qdrant.Client{},
+				Embedding: &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] qdrant collection not provided"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test client not provided", func() {
+			r, err := NewRetriever(ctx, &RetrieverConfig{
+				Collection: "eino",
+				Embedding:  &mockEmbedding{},
+			})
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[NewRetriever] qdrant client not provided"))
+			convey.So(r, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestRetrieve(t *testing.T) {
+	PatchConvey("test Retrieve", t, func() {
+		ctx := context.Background()
+		cli := &qdrant.Client{}
+
+		r, err := NewRetriever(ctx, &RetrieverConfig{
+			Client:     cli,
+			Collection: "eino",
+			VectorName: "dense",
+			Embedding:  &mockEmbedding{},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		PatchConvey("test query failed", func() {
+			Mock(GetMethod(cli, "Query")).Return(nil, fmt.Errorf("mock err")).Build()
+
+			docs, err := r.Retrieve(ctx, "test")
+			convey.So(err, convey.ShouldBeError, fmt.Errorf("[qdrant retriever] query failed, mock err"))
+			convey.So(docs, convey.ShouldBeNil)
+		})
+
+		PatchConvey("test success", func() {
+			var req *qdrant.QueryPoints
+			Mock(GetMethod(cli, "Query")).To(func(ctx context.Context, request *qdrant.QueryPoints) ([]*qdrant.ScoredPoint, error) {
+				req = request
+				return []*qdrant.ScoredPoint{
+					{
+						Id:    qdrant.NewIDNum(1),
+						Score: 0.9,
+						Payload: qdrant.NewValueMap(map[string]any{
+							"content": "eino",
+							"lang":    "go",
+							"tags":    []any{"a", "b"},
+						}),
+					},
+				}, nil
+			}).Build()
+
+			filter := &qdrant.Filter{
+				Must: []*qdrant.Condition{qdrant.NewMatch("lang", "go")},
+			}
+			docs, err := r.Retrieve(ctx, "test", WithFilter(filter), retriever.WithTopK(3), retriever.WithScoreThreshold(0.5))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(req.GetUsing(), convey.ShouldEqual, "dense")
+			convey.So(req.GetLimit(), convey.ShouldEqual, 3)
+			convey.So(req.GetScoreThreshold(), convey.ShouldEqual, float32(0.5))
+			convey.So(req.GetFilter(), convey.ShouldEqual, filter)
+			convey.So(len(docs), convey.ShouldEqual, 1)
+			convey.So(docs[0].ID, convey.ShouldEqual, "1")
+			convey.So(docs[0].Content, convey.ShouldEqual, "eino")
+			convey.So(docs[0].MetaData["lang"], convey.ShouldEqual, "go")
+			convey.So(docs[0].MetaData["tags"], convey.ShouldResemble, []any{"a", "b"})
+			convey.So(docs[0].Score(), convey.ShouldAlmostEqual, 0.9, 1e-6)
+		})
+	})
+}
+
+func TestDefaultDocumentConverter(t *testing.T) {
+	convey.Convey("test defaultDocumentConverter", t, func() {
+		ctx := context.Background()
+
+		convey.Convey("test original doc id", func() {
+			// doc_1 is stored by qdrant indexer as a point with uuid id derived from it, and kept in payload
+			doc, err := defaultDocumentConverter(ctx, &qdrant.ScoredPoint{
+				Id:    qdrant.NewID("9a3c1b4e-5f0d-5e7a-8c2b-3d4e5f6a7b8c"),
+				Score: 0.8,
+				Payload: qdrant.NewValueMap(map[string]any{
+					"content": "eino",
+					"doc_id":  "doc_1",
+				}),
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(doc.ID, convey.ShouldEqual, "doc_1")
+			convey.So(doc.Content, convey.ShouldEqual, "eino")
+			convey.So(doc.MetaData, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("test point id", func() {
+			id := "ae5fb4b4-1c5c-4b1e-8a48-8a3b9fd0f3a7"
+			doc, err := defaultDocumentConverter(ctx, &qdrant.ScoredPoint{
+				Id:      qdrant.NewID(id),
+				Payload: qdrant.NewValueMap(map[string]any{"content": "eino"}),
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(doc.ID, convey.ShouldEqual, id)
+		})
+	})
+}
+
+type mockEmbedding struct{}
+
+func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	return [][]float64{{0.1, 0.2, 0.3}}, nil
+}
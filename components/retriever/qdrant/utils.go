@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package qdrant
+
+import (
+	"strconv"
+
+	"github.com/qdrant/go-client/qdrant"
+)
+
+func pointIDString(id *qdrant.PointId) string {
+	if id.GetUuid() != "" {
+		return id.GetUuid()
+	}
+
+	return strconv.FormatUint(id.GetNum(), 10)
+}
+
+// valueToAny converts qdrant payload value to go builtin types.
+func valueToAny(v *qdrant.Value) any {
+	switch kind := v.GetKind().(type) {
+	case *qdrant.Value_BoolValue:
+		return kind.BoolValue
+	case *qdrant.Value_IntegerValue:
+		return kind.IntegerValue
+	case *qdrant.Value_DoubleValue:
+		return kind.DoubleValue
+	case *qdrant.Value_StringValue:
+		return kind.StringValue
+	case *qdrant.Value_ListValue:
+		values := kind.ListValue.GetValues()
+		list := make([]any, 0, len(values))
+		for _, item := range values {
+			list = append(list, valueToAny(item))
+		}
+		return list
+	case *qdrant.Value_StructValue:
+		fields := kind.StructValue.GetFields()
+		m := make(map[string]any, len(fields))
+		for k, item := range fields {
+			m[k] = valueToAny(item)
+		}
+		return m
+	default:
+		return nil
+	}
+}
+
+func vector2Float32(vector []float64) []float32 {
+	ret := make([]float32, len(vector))
+	for i, v := range vector {
+		ret[i] = float32(v)
+	}
+
+	return ret
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// TagFilter builds a TAG field filter matching any of values, e.g. @category:{news | blog}.
+// Punctuation and spaces in values are escaped.
+// see: https://redis.io/docs/latest/develop/interact/search-and-query/advanced-concepts/tags/
+func TagFilter(field string, values ...string) string {
+	escaped := make([]string, 0, len(values))
+	for _, v := range values {
+		escaped = append(escaped, escapeTagValue(v))
+	}
+
+	return fmt.Sprintf("@%s:{%s}", field, strings.Join(escaped, " | "))
+}
+
+// NumericRangeFilter builds a NUMERIC field filter with inclusive boundaries, e.g. @price:[10 100].
+// Use math.Inf(-1) or math.Inf(1) for open boundaries.
+func NumericRangeFilter(field string, min, max float64) string {
+	return fmt.Sprintf("@%s:[%s %s]", field, formatNumericBound(min), formatNumericBound(max))
+}
+
+// AndFilters joins filters by intersection, empty filters are ignored.
+func AndFilters(filters ...string) string {
+	return joinFilters(" ", filters)
+}
+
+// OrFilters joins filters by union, empty filters are ignored.
+func OrFilters(filters ...string) string {
+	return joinFilters(" | ", filters)
+}
+
+func joinFilters(sep string, filters []string) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f != "" {
+			parts = append(parts, f)
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	default:
+		return "(" + strings.Join(parts, sep) + ")"
+	}
+}
+
+func escapeTagValue(val string) string {
+	var sb strings.Builder
+	for _, r := range val {
+		if strings.ContainsRune(",.<>{}[]\"':;!@#$%^&*()-+=~|/\\ ", r) {
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func formatNumericBound(val float64) string {
+	switch {
+	case math.IsInf(val, -1):
+		return "-inf"
+	case math.IsInf(val, 1):
+		return "+inf"
+	default:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"testing"
 
 	. "github.com/bytedance/mockey"
@@ -258,3 +259,16 @@ func (m *mockEmbedding) EmbedStrings(ctx context.Context, texts []string, opts .
 
 	return r, nil
 }
+
+func TestFilters(t *testing.T) {
+	PatchConvey("test filters", t, func() {
+		convey.So(TagFilter("category", "news", "tech blog"), convey.ShouldEqual, `@category:{news | tech\ blog}`)
+		convey.So(TagFilter("email", "a@b.com"), convey.ShouldEqual, `@email:{a\@b\.com}`)
+		convey.So(NumericRangeFilter("price", 10, 99.5), convey.ShouldEqual, "@price:[10 99.5]")
+		convey.So(NumericRangeFilter("price", math.Inf(-1), math.Inf(1)), convey.ShouldEqual, "@price:[-inf +inf]")
+		convey.So(AndFilters(), convey.ShouldEqual, "")
+		convey.So(AndFilters("", "@a:{x}"), convey.ShouldEqual, "@a:{x}")
+		convey.So(AndFilters("@a:{x}", "@b:[1 2]"), convey.ShouldEqual, "(@a:{x} @b:[1 2])")
+		convey.So(OrFilters("@a:{x}", "@b:[1 2]"), convey.ShouldEqual, "(@a:{x} | @b:[1 2])")
+	})
+}
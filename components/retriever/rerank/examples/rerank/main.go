@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/retriever/rerank"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// replace with any retriever and reranker, e.g.
+	// components/retriever/volc_vikingdb and components/document/transformer/reranker/cohere
+	r, err := rerank.NewRetriever(ctx, &rerank.Config{
+		Retriever: &staticRetriever{},
+		Reranker:  &keywordReranker{},
+		TopK:      2,
+	})
+	if err != nil {
+		log.Fatalf("NewRetriever failed, err=%v", err)
+	}
+
+	docs, err := r.Retrieve(ctx, "eino", retriever.WithTopK(10))
+	if err != nil {
+		log.Fatalf("Retrieve failed, err=%v", err)
+	}
+
+	for _, doc := range docs {
+		log.Printf("id=%s, score=%v, content=%s", doc.ID, doc.Score(), doc.Content)
+	}
+}
+
+type staticRetriever struct{}
+
+func (s *staticRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return []*schema.Document{
+		{ID: "1", Content: "The weather is sunny today."},
+		{ID: "2", Content: "Eino is a LLM application development framework in Golang."},
+		{ID: "3", Content: "Eino provides components, orchestration and flows."},
+	}, nil
+}
+
+type keywordReranker struct{}
+
+func (k *keywordReranker) Rerank(ctx context.Context, query string, docs []*schema.Document) ([]*schema.Document, error) {
+	var result []*schema.Document
+	for _, doc := range docs {
+		if strings.Contains(strings.ToLower(doc.Content), strings.ToLower(query)) {
+			result = append(result, doc.WithScore(1))
+		}
+	}
+	return result, nil
+}
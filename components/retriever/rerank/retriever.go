@@ -0,0 +1,119 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+// Reranker reorders docs by relevance to query.
+// Implemented by rerankers in components/document/transformer/reranker, e.g. cohere and volc.
+type Reranker interface {
+	Rerank(ctx context.Context, query string, docs []*schema.Document) ([]*schema.Document, error)
+}
+
+type Config struct {
+	// Retriever retrieves candidate documents.
+	// Required.
+	Retriever retriever.Retriever
+	// Reranker reorders candidate documents.
+	// Required.
+	Reranker Reranker
+	// TopK limits the number of documents returned after reranking.
+	// Optional. Default: 0, return all reranked documents.
+	TopK int
+	// ScoreThreshold filters out reranked documents with score lower than threshold.
+	// Optional. Default: nil, no filter.
+	ScoreThreshold *float64
+}
+
+// Retriever composes retrieve→rerank in one retriever.Retriever.
+// Options are passed to the underlying Retriever, e.g. use retriever.WithTopK to retrieve more candidates.
+type Retriever struct {
+	config *Config
+}
+
+func NewRetriever(_ context.Context, config *Config) (*Retriever, error) {
+	if config == nil || config.Retriever == nil {
+		return nil, errors.New("[NewRetriever] retriever not provided")
+	}
+
+	if config.Reranker == nil {
+		return nil, errors.New("[NewRetriever] reranker not provided")
+	}
+
+	return &Retriever{config: config}, nil
+}
+
+func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) (docs []*schema.Document, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
+	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
+		Query:          query,
+		TopK:           r.config.TopK,
+		ScoreThreshold: r.config.ScoreThreshold,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	candidates, err := r.config.Retriever.Retrieve(ctx, query, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("[rerank retriever] retrieve failed: %w", err)
+	}
+
+	if len(candidates) == 0 {
+		callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: candidates})
+		return candidates, nil
+	}
+
+	reranked, err := r.config.Reranker.Rerank(ctx, query, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("[rerank retriever] rerank failed: %w", err)
+	}
+
+	docs = make([]*schema.Document, 0, len(reranked))
+	for _, doc := range reranked {
+		if r.config.ScoreThreshold != nil && doc.Score() < *r.config.ScoreThreshold {
+			continue
+		}
+		docs = append(docs, doc)
+		if r.config.TopK > 0 && len(docs) >= r.config.TopK {
+			break
+		}
+	}
+
+	callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
+
+	return docs, nil
+}
+
+func (r *Retriever) GetType() string {
+	return "Rerank"
+}
+
+func (r *Retriever) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRetriever struct {
+	docs []*schema.Document
+	err  error
+}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	return m.docs, m.err
+}
+
+type mockReranker struct {
+	scores map[string]float64
+	err    error
+}
+
+func (m *mockReranker) Rerank(ctx context.Context, query string, docs []*schema.Document) ([]*schema.Document, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	result := make([]*schema.Document, len(docs))
+	for i, doc := range docs {
+		result[i] = doc.WithScore(m.scores[doc.ID])
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score() > result[j].Score()
+	})
+	return result, nil
+}
+
+func TestRetriever(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewRetriever(ctx, &Config{Reranker: &mockReranker{}})
+	assert.Error(t, err)
+	_, err = NewRetriever(ctx, &Config{Retriever: &mockRetriever{}})
+	assert.Error(t, err)
+
+	docs := []*schema.Document{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}}
+	threshold := 0.2
+	r, err := NewRetriever(ctx, &Config{
+		Retriever:      &mockRetriever{docs: docs},
+		Reranker:       &mockReranker{scores: map[string]float64{"1": 0.1, "2": 0.8, "3": 0.5, "4": 0.3}},
+		TopK:           2,
+		ScoreThreshold: &threshold,
+	})
+	assert.NoError(t, err)
+
+	result, err := r.Retrieve(ctx, "query")
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "2", result[0].ID)
+	assert.Equal(t, "3", result[1].ID)
+
+	r, err = NewRetriever(ctx, &Config{
+		Retriever:      &mockRetriever{docs: docs},
+		Reranker:       &mockReranker{scores: map[string]float64{"1": 0.1, "2": 0.8, "3": 0.5, "4": 0.3}},
+		ScoreThreshold: &threshold,
+	})
+	assert.NoError(t, err)
+	result, err = r.Retrieve(ctx, "query")
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+
+	r, err = NewRetriever(ctx, &Config{
+		Retriever: &mockRetriever{docs: docs},
+		Reranker:  &mockReranker{err: fmt.Errorf("mock err")},
+	})
+	assert.NoError(t, err)
+	_, err = r.Retrieve(ctx, "query")
+	assert.Error(t, err)
+
+	r, err = NewRetriever(ctx, &Config{
+		Retriever: &mockRetriever{err: fmt.Errorf("mock err")},
+		Reranker:  &mockReranker{},
+	})
+	assert.NoError(t, err)
+	_, err = r.Retrieve(ctx, "query")
+	assert.Error(t, err)
+}
@@ -21,6 +21,8 @@ const typ = "VikingDB"
 const (
 	ExtraKeyVikingDBFields = "_vikingdb_fields" // value: map[string]interface{}
 	ExtraKeyVikingDBTTL    = "_vikingdb_ttl"    // value: int64
+	// ExtraKeyVikingDBGroupKey is set when retrieving with GroupBy, docs without the group field don't have it
+	ExtraKeyVikingDBGroupKey = "_vikingdb_group_key" // value: string
 )
 
 const (
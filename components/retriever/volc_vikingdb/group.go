@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc_vikingdb
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+const (
+	defaultTopKPerGroup       = 1
+	defaultCandidateTopKRatio = 4
+)
+
+type implOptions struct {
+	GroupBy *GroupByConfig
+}
+
+// WithGroupBy overrides RetrieverConfig.GroupBy for a single retrieval, nil disables grouping.
+func WithGroupBy(groupBy *GroupByConfig) retriever.Option {
+	return retriever.WrapImplSpecificOptFn(func(o *implOptions) {
+		o.GroupBy = groupBy
+	})
+}
+
+func (g *GroupByConfig) topKPerGroup() int {
+	if g.TopKPerGroup <= 0 {
+		return defaultTopKPerGroup
+	}
+
+	return g.TopKPerGroup
+}
+
+func (g *GroupByConfig) candidateTopK(topK int) int {
+	if g.CandidateTopK > 0 {
+		return g.CandidateTopK
+	}
+
+	return topK * g.topKPerGroup() * defaultCandidateTopKRatio
+}
+
+// groupDocuments keeps at most TopKPerGroup docs of each group and topK groups, in the order of scores.
+// Docs without the group field are groups of their own.
+func groupDocuments(docs []*schema.Document, g *GroupByConfig, topK int) []*schema.Document {
+	var (
+		result = make([]*schema.Document, 0, len(docs))
+		counts = make(map[string]int)
+		groups = 0
+	)
+
+	for _, doc := range docs {
+		fields, _ := doc.MetaData[ExtraKeyVikingDBFields].(map[string]interface{})
+		val, ok := fields[g.Field]
+		if !ok || val == nil {
+			if topK > 0 && groups >= topK {
+				continue
+			}
+			groups++
+			result = append(result, doc)
+			continue
+		}
+
+		key := fmt.Sprint(val)
+		if n, seen := counts[key]; seen {
+			if n >= g.topKPerGroup() {
+				continue
+			}
+		} else {
+			if topK > 0 && groups >= topK {
+				continue
+			}
+			groups++
+		}
+
+		counts[key]++
+		doc.MetaData[ExtraKeyVikingDBGroupKey] = key
+		result = append(result, doc)
+	}
+
+	return result
+}
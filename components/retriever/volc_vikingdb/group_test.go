@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc_vikingdb
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/volcengine/volc-sdk-golang/service/vikingdb"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/schema"
+)
+
+func TestGroupDocuments(t *testing.T) {
+	PatchConvey("test groupDocuments", t, func() {
+		newDoc := func(id string, fields map[string]interface{}) *schema.Document {
+			return &schema.Document{ID: id, MetaData: map[string]any{ExtraKeyVikingDBFields: fields}}
+		}
+		ids := func(docs []*schema.Document) []string {
+			resp := make([]string, len(docs))
+			for i, d := range docs {
+				resp[i] = d.ID
+			}
+			return resp
+		}
+		docs := func() []*schema.Document {
+			return []*schema.Document{
+				newDoc("a1", map[string]interface{}{"source": "a.md"}),
+				newDoc("a2", map[string]interface{}{"source": "a.md"}),
+				newDoc("b1", map[string]interface{}{"source": "b.md"}),
+				newDoc("x", map[string]interface{}{}),
+				newDoc("a3", map[string]interface{}{"source": "a.md"}),
+				newDoc("c1", map[string]interface{}{"source": "c.md"}),
+				newDoc("b2", map[string]interface{}{"source": "b.md"}),
+			}
+		}
+
+		PatchConvey("test one per group", func() {
+			result := groupDocuments(docs(), &GroupByConfig{Field: "source"}, 10)
+			convey.So(ids(result), convey.ShouldEqual, []string{"a1", "b1", "x", "c1"})
+			convey.So(result[0].MetaData[ExtraKeyVikingDBGroupKey], convey.ShouldEqual, "a.md")
+			_, ok := result[2].MetaData[ExtraKeyVikingDBGroupKey]
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+
+		PatchConvey("test top k per group and top k groups", func() {
+			result := groupDocuments(docs(), &GroupByConfig{Field: "source", TopKPerGroup: 2}, 2)
+			convey.So(ids(result), convey.ShouldEqual, []string{"a1", "a2", "b1", "b2"})
+		})
+
+		PatchConvey("test candidateTopK", func() {
+			convey.So((&GroupByConfig{TopKPerGroup: 2}).candidateTopK(10), convey.ShouldEqual, 80)
+			convey.So((&GroupByConfig{CandidateTopK: 50}).candidateTopK(10), convey.ShouldEqual, 50)
+		})
+	})
+}
+
+func TestRetrieveWithGroupBy(t *testing.T) {
+	PatchConvey("test Retrieve with group by", t, func() {
+		ctx := context.Background()
+		index := &vikingdb.Index{}
+		r := &Retriever{
+			config: &RetrieverConfig{
+				TopK:      of(2),
+				Partition: defaultPartition,
+				EmbeddingConfig: EmbeddingConfig{
+					Embedding: &mockEmbedding{fn: func() ([][]float64, error) {
+						return [][]float64{{1.1}}, nil
+					}},
+				},
+				GroupBy: &GroupByConfig{Field: "source"},
+			},
+			index: index,
+		}
+
+		var limit int
+		Mock((*Retriever).makeSearchOption).To(func(_ *Retriever, _ map[string]interface{}, options *retriever.Options) *vikingdb.SearchOptions {
+			limit = *options.TopK
+			return vikingdb.NewSearchOptions()
+		}).Build()
+		Mock(GetMethod(index, "SearchByVector")).Return(
+			[]*vikingdb.Data{
+				{Id: "a1", Score: 0.9, Fields: map[string]interface{}{"content": "a1", "source": "a.md"}},
+				{Id: "a2", Score: 0.8, Fields: map[string]interface{}{"content": "a2", "source": "a.md"}},
+				{Id: "b1", Score: 0.7, Fields: map[string]interface{}{"content": "b1", "source": "b.md"}},
+			}, nil).Build()
+
+		PatchConvey("test config group by", func() {
+			docs, err := r.Retrieve(ctx, "query")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(limit, convey.ShouldEqual, 8)
+			convey.So(len(docs), convey.ShouldEqual, 2)
+			convey.So(docs[1].ID, convey.ShouldEqual, "b1")
+			convey.So(docs[1].MetaData[ExtraKeyVikingDBGroupKey], convey.ShouldEqual, "b.md")
+		})
+
+		PatchConvey("test option disables group by", func() {
+			docs, err := r.Retrieve(ctx, "query", WithGroupBy(nil), retriever.WithTopK(3))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(limit, convey.ShouldEqual, 3)
+			convey.So(len(docs), convey.ShouldEqual, 3)
+		})
+	})
+}
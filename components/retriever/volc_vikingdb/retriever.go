@@ -59,6 +59,22 @@ type RetrieverConfig struct {
 	ScoreThreshold *float64 `json:"score_threshold,omitempty"`
 	// FilterDSL 标量过滤 filter 表达式 https://www.volcengine.com/docs/84313/1254609
 	FilterDSL map[string]any `json:"filter_dsl,omitempty"`
+	// GroupBy 按标量字段分组检索, 配置后 TopK 表示返回的分组数, 可通过 WithGroupBy 在单次检索中覆盖
+	GroupBy *GroupByConfig `json:"group_by,omitempty"`
+}
+
+// GroupByConfig 分组检索配置, 例如按文档来源字段分组, 使每个来源最多返回 TopKPerGroup 个切片, 避免结果集中在同一文档
+// 分组在客户端进行: 先召回 CandidateTopK 条候选数据, 再在候选数据中分组, 组间按组内最高分排序,
+// 分组字段值写入 Document MetaData 的 ExtraKeyVikingDBGroupKey
+// 由于只在候选数据中分组, 候选数据集中在少数分组时, 返回的分组数可能少于 TopK, 组内数据也可能少于 TopKPerGroup, 可调大 CandidateTopK 缓解
+// 注意: 暂不支持多向量检索, VikingDB SDK 的 SearchByVector 每次只能传入一个查询向量
+type GroupByConfig struct {
+	// Field 分组字段, 需为数据集中的标量字段, 不包含该字段的数据各自单独成组
+	Field string `json:"field"`
+	// TopKPerGroup 每组返回的最大数据条数, 默认 1
+	TopKPerGroup int `json:"top_k_per_group"`
+	// CandidateTopK 分组前召回的候选数据条数, 默认 TopK * TopKPerGroup * 4
+	CandidateTopK int `json:"candidate_top_k"`
 }
 
 type EmbeddingConfig struct {
@@ -115,6 +131,10 @@ func NewRetriever(ctx context.Context, config *RetrieverConfig) (*Retriever, err
 		config.TopK = ptrOf(defaultTopK)
 	}
 
+	if config.GroupBy != nil && len(config.GroupBy.Field) == 0 {
+		return nil, fmt.Errorf("[VikingDBRetriever] group by field not provided")
+	}
+
 	r := &Retriever{
 		config:   config,
 		service:  service,
@@ -148,6 +168,9 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 		Embedding:      r.config.EmbeddingConfig.Embedding,
 		DSLInfo:        r.config.FilterDSL,
 	}, opts...)
+	implOptions := retriever.GetImplSpecificOptions(&implOptions{
+		GroupBy: r.config.GroupBy,
+	}, opts...)
 
 	ctx = callbacks.EnsureRunInfo(ctx, r.GetType(), components.ComponentOfRetriever)
 	ctx = callbacks.OnStart(ctx, &retriever.CallbackInput{
@@ -162,10 +185,23 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 		}
 	}()
 
+	group := implOptions.GroupBy
+	searchOptions := options
+	if group != nil {
+		if len(group.Field) == 0 {
+			return nil, fmt.Errorf("[VikingDBRetriever] group by field not provided")
+		}
+
+		// recall more candidates to be grouped
+		nOptions := *options
+		nOptions.TopK = ptrOf(group.candidateTopK(dereferenceOrZero(options.TopK)))
+		searchOptions = &nOptions
+	}
+
 	var result []*vikingdb.Data
 
 	if r.config.WithMultiModal {
-		result, err = r.index.SearchWithMultiModal(r.makeSearchOption(nil, options).SetText(query))
+		result, err = r.index.SearchWithMultiModal(r.makeSearchOption(nil, searchOptions).SetText(query))
 		if err != nil {
 			return nil, err
 		}
@@ -184,7 +220,7 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 			return nil, err
 		}
 
-		result, err = r.index.SearchByVector(dense, r.makeSearchOption(sparse, options))
+		result, err = r.index.SearchByVector(dense, r.makeSearchOption(sparse, searchOptions))
 		if err != nil {
 			return nil, err
 		}
@@ -204,6 +240,10 @@ func (r *Retriever) Retrieve(ctx context.Context, query string, opts ...retrieve
 		docs = append(docs, doc.WithDSLInfo(options.DSLInfo))
 	}
 
+	if group != nil {
+		docs = groupDocuments(docs, group, dereferenceOrZero(options.TopK))
+	}
+
 	ctx = callbacks.OnEnd(ctx, &retriever.CallbackOutput{Docs: docs})
 
 	return docs, nil
@@ -0,0 +1,263 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bravesearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+const defaultBaseURL = "https://api.search.brave.com/res/v1"
+
+type SafeSearch string
+type Freshness string
+
+const (
+	// SafeSearch settings
+	SafeSearchOff      SafeSearch = "off"
+	SafeSearchModerate SafeSearch = "moderate"
+	SafeSearchStrict   SafeSearch = "strict"
+
+	// Freshness settings, custom date range is also supported, e.g. "2024-01-01to2024-06-30"
+	FreshnessDay   Freshness = "pd"
+	FreshnessWeek  Freshness = "pw"
+	FreshnessMonth Freshness = "pm"
+	FreshnessYear  Freshness = "py"
+)
+
+// Config represents the Brave search tool configuration.
+type Config struct {
+	// Eino tool settings
+	ToolName string `json:"tool_name"` // optional, default is "brave_search"
+	ToolDesc string `json:"tool_desc"` // optional, default is "search web for information by brave"
+
+	// APIKey is required to access the Brave Web Search API.
+	APIKey string `json:"api_key"`
+
+	// BaseURL specifies the address of the Brave Search API.
+	// Optional, default: "https://api.search.brave.com/res/v1"
+	BaseURL string `json:"base_url"`
+
+	// Country specifies the 2 character country code where the search results come from, e.g. "US", "CN".
+	// Optional, default: "" (decided by Brave)
+	Country string `json:"country"`
+
+	// SearchLang specifies the language of the search results, e.g. "en", "zh-hans".
+	// Optional, default: "" (decided by Brave)
+	SearchLang string `json:"search_lang"`
+
+	// MaxResults specifies the maximum number of search results to return, at most 20.
+	// Optional, default: 10
+	MaxResults int `json:"max_results"`
+
+	// SafeSearch specifies the safe search setting.
+	// Optional, default: SafeSearchModerate
+	SafeSearch SafeSearch `json:"safe_search"`
+
+	// Freshness filters results by discovery time.
+	// Optional, default: "" (no limit)
+	Freshness Freshness `json:"freshness"`
+
+	// Timeout specifies the maximum duration for a single request.
+	// Optional, default: 30 * time.Second
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send requests, Timeout is ignored if provided.
+	// Optional, default: &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"-"`
+}
+
+// NewTool creates a new Brave search tool instance.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	brave, err := newBraveSearch(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create brave search tool: %w", err)
+	}
+
+	searchTool, err := utils.InferTool(config.ToolName, config.ToolDesc, brave.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return searchTool, nil
+}
+
+// validate validates the Brave search tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "brave_search"
+	}
+
+	if c.ToolDesc == "" {
+		c.ToolDesc = "search web for information by brave"
+	}
+
+	if c.APIKey == "" {
+		return errors.New("brave search tool config is missing API key")
+	}
+
+	if c.BaseURL == "" {
+		c.BaseURL = defaultBaseURL
+	}
+
+	if c.MaxResults <= 0 {
+		c.MaxResults = 10
+	}
+
+	if c.MaxResults > 20 {
+		c.MaxResults = 20
+	}
+
+	if c.SafeSearch == "" {
+		c.SafeSearch = SafeSearchModerate
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = 30 * time.Second
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: c.Timeout}
+	}
+
+	return nil
+}
+
+// braveSearch represents the Brave search tool.
+type braveSearch struct {
+	config *Config
+}
+
+// newBraveSearch creates a new Brave search client.
+func newBraveSearch(config *Config) (*braveSearch, error) {
+	if config == nil {
+		return nil, errors.New("brave search tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &braveSearch{config: config}, nil
+}
+
+type SearchRequest struct {
+	Query string `json:"query" jsonschema_description:"The query to search the web for"`
+	Page  int    `json:"page" jsonschema_description:"The page number to search for, default: 1"`
+}
+
+type SearchResult struct {
+	Title       string `json:"title" jsonschema_description:"The title of the search result"`
+	Link        string `json:"link" jsonschema_description:"The link of the search result"`
+	Description string `json:"description" jsonschema_description:"The description of the search result"`
+}
+
+type SearchResponse struct {
+	Results []*SearchResult `json:"results" jsonschema_description:"The results of the search"`
+}
+
+// braveResponse is the json response of Brave web search api.
+type braveResponse struct {
+	Web struct {
+		Results []struct {
+			Title       string `json:"title"`
+			URL         string `json:"url"`
+			Description string `json:"description"`
+		} `json:"results"`
+	} `json:"web"`
+}
+
+// ErrRateLimit is returned when the quota or rate limit of the API key is exceeded.
+var ErrRateLimit = errors.New("brave search rate limit exceeded")
+
+// Search searches the web for information.
+func (b *braveSearch) Search(ctx context.Context, request *SearchRequest) (*SearchResponse, error) {
+	if request.Query == "" {
+		return nil, errors.New("query is required")
+	}
+
+	params := url.Values{}
+	params.Set("q", request.Query)
+	params.Set("count", strconv.Itoa(b.config.MaxResults))
+	params.Set("safesearch", string(b.config.SafeSearch))
+	if request.Page > 1 {
+		// offset is the zero based page index, at most 9
+		params.Set("offset", strconv.Itoa(request.Page-1))
+	}
+	if b.config.Country != "" {
+		params.Set("country", b.config.Country)
+	}
+	if b.config.SearchLang != "" {
+		params.Set("search_lang", b.config.SearchLang)
+	}
+	if b.config.Freshness != "" {
+		params.Set("freshness", string(b.config.Freshness))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.config.BaseURL+"/web/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Subscription-Token", b.config.APIKey)
+
+	resp, err := b.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("%w: %s", ErrRateLimit, string(body))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var br braveResponse
+	if err = json.Unmarshal(body, &br); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	results := make([]*SearchResult, 0, len(br.Web.Results))
+	for _, r := range br.Web.Results {
+		results = append(results, &SearchResult{
+			Title:       r.Title,
+			Link:        r.URL,
+			Description: r.Description,
+		})
+	}
+
+	return &SearchResponse{
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bravesearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_validate(t *testing.T) {
+	conf := &Config{APIKey: "key", MaxResults: 50}
+	assert.NoError(t, conf.validate())
+	assert.Equal(t, "brave_search", conf.ToolName)
+	assert.Equal(t, defaultBaseURL, conf.BaseURL)
+	assert.Equal(t, 20, conf.MaxResults)
+	assert.Equal(t, SafeSearchModerate, conf.SafeSearch)
+
+	assert.Error(t, (&Config{}).validate())
+
+	_, err := NewTool(context.Background(), &Config{APIKey: "key"})
+	assert.NoError(t, err)
+}
+
+func TestBraveSearch_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/web/search", r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("X-Subscription-Token"))
+
+		q := r.URL.Query()
+		assert.Equal(t, "eino", q.Get("q"))
+		assert.Equal(t, "5", q.Get("count"))
+		assert.Equal(t, "1", q.Get("offset"))
+		assert.Equal(t, "US", q.Get("country"))
+		assert.Equal(t, "en", q.Get("search_lang"))
+		assert.Equal(t, "strict", q.Get("safesearch"))
+		assert.Equal(t, "pw", q.Get("freshness"))
+
+		w.Write([]byte(`{
+			"type": "search",
+			"query": {"original": "eino"},
+			"web": {
+				"type": "search",
+				"results": [
+					{"title": "Eino", "url": "https://github.com/cloudwego/eino", "description": "LLM application development framework", "age": "1 day ago"}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	s, err := newBraveSearch(&Config{
+		APIKey:     "key",
+		BaseURL:    server.URL,
+		Country:    "US",
+		SearchLang: "en",
+		MaxResults: 5,
+		SafeSearch: SafeSearchStrict,
+		Freshness:  FreshnessWeek,
+	})
+	assert.NoError(t, err)
+
+	resp, err := s.Search(context.Background(), &SearchRequest{Query: "eino", Page: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []*SearchResult{
+		{Title: "Eino", Link: "https://github.com/cloudwego/eino", Description: "LLM application development framework"},
+	}, resp.Results)
+
+	_, err = s.Search(context.Background(), &SearchRequest{})
+	assert.Error(t, err)
+}
+
+func TestBraveSearch_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	s, err := newBraveSearch(&Config{APIKey: "key", BaseURL: server.URL})
+	assert.NoError(t, err)
+
+	_, err = s.Search(context.Background(), &SearchRequest{Query: "eino"})
+	assert.True(t, errors.Is(err, ErrRateLimit))
+}
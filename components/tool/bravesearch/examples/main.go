@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/tool/bravesearch"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Create search tool
+	searchTool, err := bravesearch.NewTool(ctx, &bravesearch.Config{
+		APIKey:     os.Getenv("BRAVE_API_KEY"),
+		Country:    "US",
+		SearchLang: "en",
+		MaxResults: 5,
+		Freshness:  bravesearch.FreshnessMonth,
+	})
+	if err != nil {
+		log.Fatalf("NewTool of bravesearch failed, err=%v", err)
+	}
+
+	// Create search request
+	searchReq := &bravesearch.SearchRequest{
+		Query: "Golang programming development",
+		Page:  1,
+	}
+
+	jsonReq, err := json.Marshal(searchReq)
+	if err != nil {
+		log.Fatalf("Marshal of search request failed, err=%v", err)
+	}
+
+	// Execute search
+	resp, err := searchTool.InvokableRun(ctx, string(jsonReq))
+	if err != nil {
+		log.Fatalf("Search of bravesearch failed, err=%v", err)
+	}
+
+	var searchResp bravesearch.SearchResponse
+	if err := json.Unmarshal([]byte(resp), &searchResp); err != nil {
+		log.Fatalf("Unmarshal of search response failed, err=%v", err)
+	}
+
+	// Print results
+	fmt.Println("Search Results:")
+	fmt.Println("==============")
+	for i, result := range searchResp.Results {
+		fmt.Printf("\n%d. Title: %s\n", i+1, result.Title)
+		fmt.Printf("   Link: %s\n", result.Link)
+		fmt.Printf("   Description: %s\n", result.Description)
+	}
+}
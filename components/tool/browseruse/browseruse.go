@@ -19,15 +19,24 @@ package browseruse
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/bytedance/sonic"
 	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/input"
 	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/cdproto/target"
 	"github.com/chromedp/chromedp"
+	"github.com/chromedp/chromedp/kb"
 	"github.com/cloudwego/eino-ext/components/tool/duckduckgo/ddgsearch"
 	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/components/prompt"
@@ -37,15 +46,23 @@ import (
 )
 
 const (
+	defaultSearchResultNum = 5
+	streamBufferSize       = 10
+
 	toolName        = "browser_use"
 	toolDescription = `
 Interact with a web browser to perform various actions such as navigation, element interaction, content extraction, and tab management:
 Navigation:
 - 'go_to_url': Go to a specific URL in the current tab
-- 'web_search': Search the query in the current tab, the query should be a search query like humans search in web.
+- 'web_search': Search the web for the query, the query should be a search query like humans search in web. Returns the top results without navigating
+- 'navigate_result': Go to a result of the last 'web_search' by index in the current tab
 Element Interaction:
 - 'click_element': Click an element by index
 - 'input_text': Input text into a form element
+- 'select_option': Select an option of a select dropdown element by option value or visible text
+- 'press_key': Press a key such as Enter, Escape or Tab, on the element of the index if given, otherwise on the focused element
+- 'upload_file': Upload local files with a file input element
+- 'hover': Move the mouse over an element, e.g. to open a hover menu
 - 'scroll_down'/'scroll_up': Scroll the page (with optional pixel amount)
 Content Extraction:
 - 'extract_content': Extract page content to retrieve specific information from the page, e.g.all company names, a specific description, links with companies in structured format or simply links
@@ -53,6 +70,9 @@ Tab Management:
 - 'switch_tab': Switch to a specific tab
 - 'open_tab': Open a new tab with a URL
 - 'close_tab': Close the current tab
+Session:
+- 'save_session': Save the cookies and local storage of the browser, e.g. after logging in, so the session can be restored later
+- 'load_session': Restore a saved session and go to the page it was saved on
 Utility:
 - 'wait': Wait for a specified number of seconds
 `
@@ -63,6 +83,31 @@ Extraction goal: {goal}
 
 Page content:
 {page}
+`
+
+	selectOptionScript = `
+(() => {
+	const el = document.evaluate(%s, document, null, XPathResult.FIRST_ORDERED_NODE_TYPE, null).singleNodeValue;
+	if (!el || el.tagName !== 'SELECT') return {ok: false, options: []};
+	const want = %s;
+	const options = Array.from(el.options);
+	const opt = options.find(o => o.value === want) || options.find(o => o.text.trim() === want.trim());
+	if (!opt) return {ok: false, options: options.map(o => o.text.trim())};
+	el.value = opt.value;
+	opt.selected = true;
+	el.dispatchEvent(new Event('input', {bubbles: true}));
+	el.dispatchEvent(new Event('change', {bubbles: true}));
+	return {ok: true, text: opt.text.trim()};
+})()
+`
+
+	elementCenterScript = `
+(() => {
+	const el = document.evaluate(%s, document, null, XPathResult.FIRST_ORDERED_NODE_TYPE, null).singleNodeValue;
+	if (!el) return {x: 0, y: 0};
+	const rect = el.getBoundingClientRect();
+	return {x: rect.left + rect.width / 2, y: rect.top + rect.height / 2};
+})()
 `
 )
 
@@ -72,10 +117,27 @@ type Config struct {
 	ExtraChromiumArgs  []string `json:"extra_chromium_args"`
 	ChromeInstancePath string   `json:"chrome_instance_path"`
 	ProxyServer        string   `json:"proxy_server"`
-
+	// UserDataDir is the chrome profile directory, which keeps cookies, local storage and logins across restarts.
+	// Optional. Default: a temporary directory removed on cleanup.
+	UserDataDir string `json:"user_data_dir"`
+	// SessionDir is the directory of the sessions saved by 'save_session' and restored by 'load_session'.
+	// Optional. The session actions are unavailable if empty.
+	SessionDir string `json:"session_dir"`
+
+	// SearchEngine is used by the 'web_search' action, e.g. NewDDGSearchEngine, or NewToolSearchEngine with the bingsearch or googlesearch tool.
+	SearchEngine SearchEngine
+	// SearchResultNum is the number of results returned by the 'web_search' action. Default: 5.
+	SearchResultNum int
+	// Deprecated: use SearchEngine with NewDDGSearchEngine instead, DDGSearchTool is used if SearchEngine is nil.
 	DDGSearchTool    *ddgsearch.DDGS
 	ExtractChatModel model.BaseChatModel
 
+	// ActionTimeout is the timeout of each action, the deadline of the context passed to ExecuteWithContext,
+	// InvokableRun or StreamableRun also applies. Optional. Default: no timeout.
+	ActionTimeout time.Duration
+	// ActionTimeouts overrides ActionTimeout for the actions, e.g. a longer timeout for 'extract_content'.
+	ActionTimeouts map[Action]time.Duration
+
 	Logf func(string, ...any)
 }
 
@@ -112,6 +174,7 @@ type ElementInfo struct {
 	Index       int    `json:"index"`
 	Description string `json:"description"`
 	Type        string `json:"type"`
+	InputType   string `json:"input_type,omitempty"`
 	XPath       string `json:"xpath"`
 }
 
@@ -125,7 +188,12 @@ type Tool struct {
 	elements        []ElementInfo
 	currentTabID    int
 	tabs            []TabInfo
-	searchTool      *ddgsearch.DDGS
+	searchEngine    SearchEngine
+	searchNum       int
+	searchResults   []*SearchResult
+	sessionDir      string
+	actionTimeout   time.Duration
+	actionTimeouts  map[Action]time.Duration
 	cm              model.BaseChatModel
 	tpl             prompt.ChatTemplate
 }
@@ -136,8 +204,10 @@ func (b *Tool) Info(_ context.Context) (*schema.ToolInfo, error) {
 
 func (b *Tool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
 	param := &Param{}
-	err := sonic.UnmarshalString(argumentsInJSON, param)
-	result, err := b.Execute(param)
+	if err := sonic.UnmarshalString(argumentsInJSON, param); err != nil {
+		return "", err
+	}
+	result, err := b.ExecuteWithContext(ctx, param)
 	if err != nil {
 		return "", err
 	}
@@ -148,6 +218,37 @@ func (b *Tool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ..
 	return content, nil
 }
 
+// StreamableRun executes the action like InvokableRun, and streams the intermediate results before the final one,
+// e.g. the navigation started and the chunks of the content extracted by ExtractChatModel.
+// Each chunk of the stream is a json encoded ToolResult, the last one is the result of the action.
+func (b *Tool) StreamableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (*schema.StreamReader[string], error) {
+	param := &Param{}
+	if err := sonic.UnmarshalString(argumentsInJSON, param); err != nil {
+		return nil, err
+	}
+
+	sr, sw := schema.Pipe[string](streamBufferSize)
+	send := func(result *ToolResult) {
+		content, err := sonic.MarshalString(result)
+		sw.Send(content, err)
+	}
+	go func() {
+		defer sw.Close()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		result, err := b.execute(ctx, param, send)
+		if err != nil {
+			sw.Send("", err)
+			return
+		}
+		send(result)
+	}()
+
+	return sr, nil
+}
+
 func NewBrowserUseTool(ctx context.Context, config *Config) (*Tool, error) {
 	if config == nil {
 		config = &Config{}
@@ -166,15 +267,21 @@ func NewBrowserUseTool(ctx context.Context, config *Config) (*Tool, error) {
 								string(ActionGoToURL),
 								string(ActionClickElement),
 								string(ActionInputText),
+								string(ActionSelectOption),
+								string(ActionPressKey),
+								string(ActionUploadFile),
+								string(ActionHover),
 								string(ActionScrollDown),
 								string(ActionScrollUp),
-								//string(ActionSendKeys),
 								string(ActionWebSearch),
+								string(ActionNavigateResult),
 								string(ActionWait),
 								string(ActionExtractContent),
 								string(ActionSwitchTab),
 								string(ActionOpenTab),
 								string(ActionCloseTab),
+								string(ActionSaveSession),
+								string(ActionLoadSession),
 							},
 							Description: "The browser action to perform",
 						},
@@ -188,7 +295,7 @@ func NewBrowserUseTool(ctx context.Context, config *Config) (*Tool, error) {
 					"index": {
 						Value: &openapi3.Schema{
 							Type:        openapi3.TypeInteger,
-							Description: "Element index for 'click_element', 'input_text' actions",
+							Description: "Element index for 'click_element', 'input_text', 'select_option', 'upload_file', 'hover' actions, optional for 'press_key' action, or search result index for 'navigate_result' action",
 						},
 					},
 					"text": {
@@ -224,7 +331,26 @@ func NewBrowserUseTool(ctx context.Context, config *Config) (*Tool, error) {
 					"keys": {
 						Value: &openapi3.Schema{
 							Type:        openapi3.TypeString,
-							Description: "Keys to send for 'send_keys' action",
+							Description: "Key to press for 'press_key' action, e.g. Enter, Escape, Tab, Backspace, Delete, Space, ArrowUp, ArrowDown, ArrowLeft, ArrowRight, PageUp, PageDown, Home, End or a single character",
+						},
+					},
+					"option": {
+						Value: &openapi3.Schema{
+							Type:        openapi3.TypeString,
+							Description: "Option value or visible text to select for 'select_option' action",
+						},
+					},
+					"file_paths": {
+						Value: &openapi3.Schema{
+							Type:        openapi3.TypeArray,
+							Items:       &openapi3.SchemaRef{Value: &openapi3.Schema{Type: openapi3.TypeString}},
+							Description: "Local file paths to upload for 'upload_file' action",
+						},
+					},
+					"session_name": {
+						Value: &openapi3.Schema{
+							Type:        openapi3.TypeString,
+							Description: "Session name for 'save_session' and 'load_session' actions, letters, digits, '_' and '-' only, default: default",
 						},
 					},
 					"seconds": {
@@ -237,10 +363,17 @@ func NewBrowserUseTool(ctx context.Context, config *Config) (*Tool, error) {
 				Required: []string{},
 			}),
 		},
-		tabs:       make([]TabInfo, 0),
-		searchTool: config.DDGSearchTool,
-		cm:         config.ExtractChatModel,
-		tpl:        prompt.FromMessages(schema.FString, schema.UserMessage(extractContentPrompt)),
+		tabs:           make([]TabInfo, 0),
+		searchEngine:   config.SearchEngine,
+		searchNum:      config.SearchResultNum,
+		sessionDir:     config.SessionDir,
+		actionTimeout:  config.ActionTimeout,
+		actionTimeouts: config.ActionTimeouts,
+		cm:             config.ExtractChatModel,
+		tpl:            prompt.FromMessages(schema.FString, schema.UserMessage(extractContentPrompt)),
+	}
+	if but.searchEngine == nil && config.DDGSearchTool != nil {
+		but.searchEngine = NewDDGSearchEngine(config.DDGSearchTool)
 	}
 
 	err := but.initialize(ctx, config)
@@ -290,6 +423,10 @@ func (b *Tool) initialize(ctx context.Context, config *Config) error {
 		opts = append(opts, chromedp.ProxyServer(config.ProxyServer))
 	}
 
+	if config.UserDataDir != "" {
+		opts = append(opts, chromedp.UserDataDir(config.UserDataDir))
+	}
+
 	b.allocatorCtx, b.allocatorCancel = chromedp.NewExecAllocator(ctx, opts...)
 
 	logf := func(string, ...any) {}
@@ -336,38 +473,66 @@ func (b *Tool) updateTabsInfo(ctx context.Context) error {
 type Param struct {
 	Action Action `json:"action"`
 
-	URL          *string `json:"url,omitempty"`
-	Index        *int    `json:"index,omitempty"`
-	Text         *string `json:"text,omitempty"`
-	ScrollAmount *int    `json:"scroll_amount,omitempty"`
-	TabID        *int    `json:"tab_id,omitempty"`
-	Query        *string `json:"query,omitempty"`
-	Goal         *string `json:"goal,omitempty"`
-	Keys         *string `json:"keys,omitempty"`
-	Seconds      *int    `json:"seconds,omitempty"`
+	URL          *string  `json:"url,omitempty"`
+	Index        *int     `json:"index,omitempty"`
+	Text         *string  `json:"text,omitempty"`
+	ScrollAmount *int     `json:"scroll_amount,omitempty"`
+	TabID        *int     `json:"tab_id,omitempty"`
+	Query        *string  `json:"query,omitempty"`
+	Goal         *string  `json:"goal,omitempty"`
+	Keys         *string  `json:"keys,omitempty"`
+	Seconds      *int     `json:"seconds,omitempty"`
+	Option       *string  `json:"option,omitempty"`
+	FilePaths    []string `json:"file_paths,omitempty"`
+	SessionName  *string  `json:"session_name,omitempty"`
 }
 
 type Action string
 
 const (
-	ActionGoToURL      Action = "go_to_url"
-	ActionClickElement Action = "click_element"
-	ActionInputText    Action = "input_text"
-	ActionScrollDown   Action = "scroll_down"
-	ActionScrollUp     Action = "scroll_up"
-	//ActionSendKeys       Action = "send_keys"
+	ActionGoToURL        Action = "go_to_url"
+	ActionClickElement   Action = "click_element"
+	ActionInputText      Action = "input_text"
+	ActionSelectOption   Action = "select_option"
+	ActionPressKey       Action = "press_key"
+	ActionUploadFile     Action = "upload_file"
+	ActionHover          Action = "hover"
+	ActionScrollDown     Action = "scroll_down"
+	ActionScrollUp       Action = "scroll_up"
 	ActionWebSearch      Action = "web_search"
+	ActionNavigateResult Action = "navigate_result"
 	ActionWait           Action = "wait"
 	ActionExtractContent Action = "extract_content"
 	ActionSwitchTab      Action = "switch_tab"
 	ActionOpenTab        Action = "open_tab"
 	ActionCloseTab       Action = "close_tab"
+	ActionSaveSession    Action = "save_session"
+	ActionLoadSession    Action = "load_session"
 )
 
+// Execute executes the action, which is aborted if the action timeout is exceeded.
 func (b *Tool) Execute(params *Param) (*ToolResult, error) {
+	return b.ExecuteWithContext(context.Background(), params)
+}
+
+// ExecuteWithContext executes the action, which is aborted if ctx is done or the action timeout is exceeded.
+func (b *Tool) ExecuteWithContext(ctx context.Context, params *Param) (*ToolResult, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	return b.execute(ctx, params, nil)
+}
+
+// execute executes the action, emit receives the intermediate results if not nil.
+func (b *Tool) execute(callerCtx context.Context, params *Param, emit func(*ToolResult)) (*ToolResult, error) {
+	streaming := emit != nil
+	if !streaming {
+		emit = func(*ToolResult) {}
+	}
+
+	ctx, cancel := b.actionContext(callerCtx, b.ctx, params.Action)
+	defer cancel()
+
 	var result *ToolResult
 
 	switch params.Action {
@@ -377,7 +542,8 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		}
 		url := *params.URL
 
-		err := chromedp.Run(b.ctx,
+		emit(&ToolResult{Output: fmt.Sprintf("navigating to %s", url)})
+		err := chromedp.Run(ctx,
 			chromedp.Navigate(url),
 			chromedp.WaitReady("body", chromedp.ByQuery),
 		)
@@ -385,7 +551,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 			return &ToolResult{Error: fmt.Sprintf("failed to navigate to %s: %v", url, err)}, nil
 		}
 
-		if err := b.updateElements(b.ctx); err != nil {
+		if err := b.updateElements(ctx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 		}
 
@@ -401,7 +567,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		}
 
 		element := b.elements[index]
-		err := chromedp.Run(b.ctx,
+		err := chromedp.Run(ctx,
 			chromedp.WaitVisible(element.XPath, chromedp.BySearch),
 			chromedp.Click(element.XPath, chromedp.BySearch),
 		)
@@ -409,9 +575,9 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 			return &ToolResult{Error: fmt.Sprintf("failed to click element %d: %v", index, err)}, nil
 		}
 
-		err = chromedp.Run(b.ctx, chromedp.Sleep(1*time.Second))
+		err = chromedp.Run(ctx, chromedp.Sleep(1*time.Second))
 
-		if err := b.updateElements(b.ctx); err != nil {
+		if err := b.updateElements(ctx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 		}
 
@@ -431,7 +597,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		}
 
 		element := b.elements[index]
-		err := chromedp.Run(b.ctx,
+		err := chromedp.Run(ctx,
 			chromedp.WaitVisible(element.XPath, chromedp.BySearch),
 			chromedp.Clear(element.XPath, chromedp.BySearch),
 			chromedp.SendKeys(element.XPath, text, chromedp.BySearch),
@@ -442,6 +608,130 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 
 		result = &ToolResult{Output: fmt.Sprintf("successfully input text '%s' to element %d", text, index)}
 
+	case ActionSelectOption:
+		if params.Option == nil {
+			return &ToolResult{Error: "option is required for 'select_option' action"}, nil
+		}
+		element, errResult := b.elementAt(params.Index, params.Action)
+		if errResult != nil {
+			return errResult, nil
+		}
+		if element.Type != "SELECT" {
+			return &ToolResult{Error: fmt.Sprintf("element %d is not a select dropdown", element.Index)}, nil
+		}
+
+		var selected struct {
+			OK      bool     `json:"ok"`
+			Text    string   `json:"text"`
+			Options []string `json:"options"`
+		}
+		err := chromedp.Run(ctx,
+			chromedp.WaitVisible(element.XPath, chromedp.BySearch),
+			chromedp.Evaluate(fmt.Sprintf(selectOptionScript, jsString(element.XPath), jsString(*params.Option)), &selected),
+		)
+		if err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to select option of element %d: %v", element.Index, err)}, nil
+		}
+		if !selected.OK {
+			return &ToolResult{Error: fmt.Sprintf("option '%s' not found in element %d, available options: %v", *params.Option, element.Index, selected.Options)}, nil
+		}
+
+		result = &ToolResult{Output: fmt.Sprintf("successfully selected option '%s' of element %d", selected.Text, element.Index)}
+
+	case ActionPressKey:
+		if params.Keys == nil || *params.Keys == "" {
+			return &ToolResult{Error: "keys is required for 'press_key' action"}, nil
+		}
+		key, ok := keyOf(*params.Keys)
+		if !ok {
+			return &ToolResult{Error: fmt.Sprintf("unsupported key: %s", *params.Keys)}, nil
+		}
+
+		var actions []chromedp.Action
+		on := "the focused element"
+		if params.Index != nil {
+			element, errResult := b.elementAt(params.Index, params.Action)
+			if errResult != nil {
+				return errResult, nil
+			}
+			actions = append(actions, chromedp.Focus(element.XPath, chromedp.BySearch))
+			on = fmt.Sprintf("element %d", element.Index)
+		}
+		actions = append(actions, chromedp.KeyEvent(key), chromedp.Sleep(1*time.Second))
+
+		if err := chromedp.Run(ctx, actions...); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to press key %s: %v", *params.Keys, err)}, nil
+		}
+
+		// the key may submit a form or navigate, refresh the elements
+		if err := b.updateElements(ctx); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
+		}
+
+		result = &ToolResult{Output: fmt.Sprintf("successfully pressed key %s on %s", *params.Keys, on)}
+
+	case ActionUploadFile:
+		if len(params.FilePaths) == 0 {
+			return &ToolResult{Error: "file_paths is required for 'upload_file' action"}, nil
+		}
+		element, errResult := b.elementAt(params.Index, params.Action)
+		if errResult != nil {
+			return errResult, nil
+		}
+		if element.Type != "INPUT" || element.InputType != "file" {
+			return &ToolResult{Error: fmt.Sprintf("element %d is not a file input", element.Index)}, nil
+		}
+
+		files := make([]string, 0, len(params.FilePaths))
+		for _, path := range params.FilePaths {
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				return &ToolResult{Error: fmt.Sprintf("invalid file path %s: %v", path, err)}, nil
+			}
+			if info, err := os.Stat(abs); err != nil || info.IsDir() {
+				return &ToolResult{Error: fmt.Sprintf("file %s does not exist", path)}, nil
+			}
+			files = append(files, abs)
+		}
+
+		if err := chromedp.Run(ctx, chromedp.SetUploadFiles(element.XPath, files, chromedp.BySearch)); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to upload files to element %d: %v", element.Index, err)}, nil
+		}
+
+		result = &ToolResult{Output: fmt.Sprintf("successfully uploaded %d files to element %d", len(files), element.Index)}
+
+	case ActionHover:
+		element, errResult := b.elementAt(params.Index, params.Action)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		var center struct {
+			X float64 `json:"x"`
+			Y float64 `json:"y"`
+		}
+		err := chromedp.Run(ctx,
+			chromedp.ScrollIntoView(element.XPath, chromedp.BySearch),
+			chromedp.Evaluate(fmt.Sprintf(elementCenterScript, jsString(element.XPath)), &center),
+		)
+		if err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to locate element %d: %v", element.Index, err)}, nil
+		}
+		err = chromedp.Run(ctx,
+			chromedp.MouseEvent(input.MouseMoved, center.X, center.Y),
+			chromedp.Sleep(500*time.Millisecond),
+		)
+		if err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to hover element %d: %v", element.Index, err)}, nil
+		}
+
+		// hover menus reveal new elements
+		if err := b.updateElements(ctx); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
+		}
+
+		result = &ToolResult{Output: fmt.Sprintf("successfully hovered element %d", element.Index)}
+
 	case ActionScrollDown, ActionScrollUp:
 		direction := 1
 		if params.Action == ActionScrollUp {
@@ -456,7 +746,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		}
 
 		script := fmt.Sprintf("window.scrollBy(0, %d);", direction*amount)
-		err := chromedp.Run(b.ctx,
+		err := chromedp.Run(ctx,
 			chromedp.Evaluate(script, nil),
 		)
 
@@ -464,7 +754,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 			return &ToolResult{Error: fmt.Sprintf("failed to scroll: %v", err)}, nil
 		}
 
-		if err := b.updateElements(b.ctx); err != nil {
+		if err := b.updateElements(ctx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 		}
 
@@ -476,7 +766,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 			seconds = *params.Seconds
 		}
 
-		err := chromedp.Run(b.ctx,
+		err := chromedp.Run(ctx,
 			chromedp.Sleep(time.Duration(seconds)*time.Second),
 		)
 
@@ -487,44 +777,76 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		result = &ToolResult{Output: fmt.Sprintf("successfully waited for %d seconds", seconds)}
 
 	case ActionWebSearch:
-		if b.searchTool == nil {
+		if b.searchEngine == nil {
 			return nil, fmt.Errorf("web search fail, no search tool found")
 		}
 		if params.Query == nil {
 			return &ToolResult{Error: "query is required for 'web_search' action"}, nil
 		}
-		searchResults, err := b.searchTool.Search(b.ctx, &ddgsearch.SearchParams{Query: *params.Query})
+		num := b.searchNum
+		if num <= 0 {
+			num = defaultSearchResultNum
+		}
+		emit(&ToolResult{Output: fmt.Sprintf("searching web for %s", *params.Query)})
+		searchResults, err := b.searchEngine.Search(ctx, *params.Query, num)
 		if err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to search: %v", err)}, nil
 		}
-		if len(searchResults.Results) == 0 {
+		if len(searchResults) == 0 {
 			return &ToolResult{Error: "search result is empty"}, nil
 		}
-		newCtx, _ := chromedp.NewContext(b.ctx)
-		if err := chromedp.Run(newCtx,
-			chromedp.Navigate(searchResults.Results[0].URL),
-			chromedp.WaitReady("body", chromedp.ByQuery),
-		); err != nil {
-			return &ToolResult{Error: fmt.Sprintf("failed to open new tab: %v", err)}, nil
+		if len(searchResults) > num {
+			searchResults = searchResults[:num]
+		}
+		b.searchResults = searchResults
+
+		var sb strings.Builder
+		sb.WriteString("successfully searched web, use 'navigate_result' with the index to open a result:\n")
+		for i, r := range searchResults {
+			fmt.Fprintf(&sb, "[%d] %s\n    %s\n", i, r.Title, r.URL)
+			if r.Summary != "" {
+				fmt.Fprintf(&sb, "    %s\n", r.Summary)
+			}
 		}
-		b.ctx = newCtx
 
-		if err := b.updateTabsInfo(b.ctx); err != nil {
-			return &ToolResult{Error: fmt.Sprintf("failed to update tab information: %v", err)}, nil
+		result = &ToolResult{Output: sb.String()}
+
+	case ActionNavigateResult:
+		if params.Index == nil {
+			return &ToolResult{Error: "index is required for 'navigate_result' action"}, nil
+		}
+		index := *params.Index
+		if len(b.searchResults) == 0 {
+			return &ToolResult{Error: "no search results, use 'web_search' first"}, nil
+		}
+		if index < 0 || index >= len(b.searchResults) {
+			return &ToolResult{Error: fmt.Sprintf("search result index %d out of range", index)}, nil
+		}
+		url := b.searchResults[index].URL
+
+		emit(&ToolResult{Output: fmt.Sprintf("navigating to search result %d: %s", index, url)})
+		err := chromedp.Run(ctx,
+			chromedp.Navigate(url),
+			chromedp.WaitReady("body", chromedp.ByQuery),
+		)
+		if err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to navigate to %s: %v", url, err)}, nil
 		}
-		if err := b.updateElements(b.ctx); err != nil {
+
+		if err := b.updateElements(ctx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 		}
 
-		result = &ToolResult{Output: "successfully search web and opened new tabL: " + searchResults.Results[0].URL}
+		result = &ToolResult{Output: fmt.Sprintf("successfully navigated to search result %d: %s", index, url)}
 
 	case ActionExtractContent:
 		if params.Goal == nil {
 			return &ToolResult{Error: "goal is required for 'extract_content' action"}, nil
 		}
 
+		emit(&ToolResult{Output: "extracting content"})
 		var html string
-		err := chromedp.Run(b.ctx,
+		err := chromedp.Run(ctx,
 			chromedp.Evaluate(`document.documentElement.outerHTML`, &html),
 		)
 		if err != nil {
@@ -534,7 +856,7 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		if b.cm == nil {
 			result = &ToolResult{Output: fmt.Sprintf("extract content: %s", html)}
 		} else {
-			message, err := b.tpl.Format(b.ctx, map[string]interface{}{
+			message, err := b.tpl.Format(ctx, map[string]interface{}{
 				"goal": *params.Goal,
 				"page": html,
 			})
@@ -542,7 +864,16 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 				return &ToolResult{Error: fmt.Sprintf("format extract prompt fail: %v", err)}, nil
 			}
 
-			extractResult, err := b.cm.Generate(b.ctx, message)
+			if streaming {
+				content, err := b.streamExtract(ctx, message, emit)
+				if err != nil {
+					return &ToolResult{Error: fmt.Sprintf("generate extract content fail: %v", err)}, nil
+				}
+				result = &ToolResult{Output: fmt.Sprintf("extract content: %s", content)}
+				break
+			}
+
+			extractResult, err := b.cm.Generate(ctx, message)
 			if err != nil {
 				return &ToolResult{Error: fmt.Sprintf("generate extract content fail: %v", err)}, nil
 			}
@@ -556,8 +887,14 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		}
 		url := *params.URL
 
+		emit(&ToolResult{Output: fmt.Sprintf("opening new tab %s", url)})
 		newCtx, _ := chromedp.NewContext(b.ctx)
-		if err := chromedp.Run(newCtx,
+		tabCtx, tabCancel, err := b.attachTab(callerCtx, newCtx, params.Action)
+		if err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to open new tab: %v", err)}, nil
+		}
+		defer tabCancel()
+		if err := chromedp.Run(tabCtx,
 			chromedp.Navigate(url),
 			chromedp.WaitReady("body", chromedp.ByQuery),
 		); err != nil {
@@ -565,10 +902,10 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		}
 		b.ctx = newCtx
 
-		if err := b.updateTabsInfo(b.ctx); err != nil {
+		if err := b.updateTabsInfo(tabCtx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update tab information: %v", err)}, nil
 		}
-		if err := b.updateElements(b.ctx); err != nil {
+		if err := b.updateElements(tabCtx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 		}
 
@@ -587,7 +924,12 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		targetID := b.tabs[tabID].TargetID
 
 		newCtx, _ := chromedp.NewContext(b.ctx, chromedp.WithTargetID(targetID))
-		err := chromedp.Run(newCtx, target.ActivateTarget(targetID))
+		tabCtx, tabCancel, err := b.attachTab(callerCtx, newCtx, params.Action)
+		if err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to switch tab: %v", err)}, nil
+		}
+		defer tabCancel()
+		err = chromedp.Run(tabCtx, target.ActivateTarget(targetID))
 		if err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to switch tab: %v", err)}, nil
 		}
@@ -595,24 +937,24 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 		b.ctx = newCtx
 		b.currentTabID = tabID
 
-		if err := b.updateTabsInfo(b.ctx); err != nil {
+		if err := b.updateTabsInfo(tabCtx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update tab information: %v", err)}, nil
 		}
-		if err := b.updateElements(b.ctx); err != nil {
+		if err := b.updateElements(tabCtx); err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 		}
 
 		result = &ToolResult{Output: fmt.Sprintf("successfully switched to tab %d", tabID)}
 
 	case ActionCloseTab:
-		err := chromedp.Run(b.ctx, page.Close())
+		err := chromedp.Run(ctx, page.Close())
 
 		if err != nil {
 			return &ToolResult{Error: fmt.Sprintf("failed to close tab: %v", err)}, nil
 		}
 
 		if len(b.tabs) > 1 {
-			if err := b.updateTabsInfo(b.ctx); err != nil {
+			if err := b.updateTabsInfo(ctx); err != nil {
 				return &ToolResult{Error: fmt.Sprintf("failed to update tab information: %v", err)}, nil
 			}
 
@@ -620,10 +962,15 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 				newTargetID := b.tabs[0].TargetID
 
 				newCtx, _ := chromedp.NewContext(b.ctx, chromedp.WithTargetID(newTargetID))
+				tabCtx, tabCancel, err := b.attachTab(callerCtx, newCtx, params.Action)
+				if err != nil {
+					return &ToolResult{Error: fmt.Sprintf("failed to switch tab: %v", err)}, nil
+				}
+				defer tabCancel()
 				b.ctx = newCtx
 				b.currentTabID = b.tabs[0].ID
 
-				if err := b.updateElements(b.ctx); err != nil {
+				if err := b.updateElements(tabCtx); err != nil {
 					return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
 				}
 			}
@@ -631,6 +978,32 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 
 		result = &ToolResult{Output: "successfully closed current tab"}
 
+	case ActionSaveSession:
+		path, err := b.sessionPath(params.SessionName)
+		if err != nil {
+			return &ToolResult{Error: err.Error()}, nil
+		}
+		if err = b.saveSession(ctx, path); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to save session: %v", err)}, nil
+		}
+
+		result = &ToolResult{Output: fmt.Sprintf("successfully saved session '%s'", strings.TrimSuffix(filepath.Base(path), ".json"))}
+
+	case ActionLoadSession:
+		path, err := b.sessionPath(params.SessionName)
+		if err != nil {
+			return &ToolResult{Error: err.Error()}, nil
+		}
+		if err = b.loadSession(ctx, path); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to load session: %v", err)}, nil
+		}
+
+		if err := b.updateElements(ctx); err != nil {
+			return &ToolResult{Error: fmt.Sprintf("failed to update elements: %v", err)}, nil
+		}
+
+		result = &ToolResult{Output: fmt.Sprintf("successfully loaded session '%s'", strings.TrimSuffix(filepath.Base(path), ".json"))}
+
 	default:
 		return &ToolResult{Error: fmt.Sprintf("unknown action: %s", params.Action)}, nil
 	}
@@ -638,6 +1011,130 @@ func (b *Tool) Execute(params *Param) (*ToolResult, error) {
 	return result, nil
 }
 
+// actionContext derives the context of the action from the tab context parent, which is done if ctx is done
+// or the action timeout is exceeded.
+func (b *Tool) actionContext(ctx, parent context.Context, action Action) (context.Context, context.CancelFunc) {
+	if parent == nil {
+		parent = context.Background()
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timeout := b.actionTimeout
+	if t, ok := b.actionTimeouts[action]; ok {
+		timeout = t
+	}
+	deadline, ok := ctx.Deadline()
+	if timeout > 0 && (!ok || time.Now().Add(timeout).Before(deadline)) {
+		deadline, ok = time.Now().Add(timeout), true
+	}
+
+	var (
+		actionCtx context.Context
+		cancel    context.CancelFunc
+	)
+	if ok {
+		actionCtx, cancel = context.WithDeadline(parent, deadline)
+	} else {
+		actionCtx, cancel = context.WithCancel(parent)
+	}
+	stop := context.AfterFunc(ctx, cancel)
+
+	return actionCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// attachTab attaches to the tab of a new chromedp context, and returns the action context of the tab.
+// The tab is attached without the deadline, since the tab would be closed if the context of its first run is done.
+func (b *Tool) attachTab(ctx, tabCtx context.Context, action Action) (context.Context, context.CancelFunc, error) {
+	if err := chromedp.Run(tabCtx); err != nil {
+		return nil, nil, err
+	}
+	actionCtx, cancel := b.actionContext(ctx, tabCtx, action)
+	return actionCtx, cancel, nil
+}
+
+// streamExtract generates the extracted content by streaming, each chunk is emitted as an intermediate result.
+func (b *Tool) streamExtract(ctx context.Context, message []*schema.Message, emit func(*ToolResult)) (string, error) {
+	sr, err := b.cm.Stream(ctx, message)
+	if err != nil {
+		return "", err
+	}
+	defer sr.Close()
+
+	var sb strings.Builder
+	for {
+		chunk, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(chunk.Content)
+		emit(&ToolResult{Output: chunk.Content})
+	}
+	return sb.String(), nil
+}
+
+// elementAt returns the element of the index, or an error result if the index is missing or out of range.
+func (b *Tool) elementAt(index *int, action Action) (*ElementInfo, *ToolResult) {
+	if index == nil {
+		return nil, &ToolResult{Error: fmt.Sprintf("index is required for '%s' action", action)}
+	}
+	if *index < 0 || *index >= len(b.elements) {
+		return nil, &ToolResult{Error: fmt.Sprintf("index %d out of range", *index)}
+	}
+	return &b.elements[*index], nil
+}
+
+// keyOf converts the key name to the key sent by chromedp.KeyEvent.
+func keyOf(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "enter", "return":
+		return kb.Enter, true
+	case "escape", "esc":
+		return kb.Escape, true
+	case "tab":
+		return kb.Tab, true
+	case "backspace":
+		return kb.Backspace, true
+	case "delete":
+		return kb.Delete, true
+	case "space":
+		return " ", true
+	case "arrowup", "up":
+		return kb.ArrowUp, true
+	case "arrowdown", "down":
+		return kb.ArrowDown, true
+	case "arrowleft", "left":
+		return kb.ArrowLeft, true
+	case "arrowright", "right":
+		return kb.ArrowRight, true
+	case "pageup":
+		return kb.PageUp, true
+	case "pagedown":
+		return kb.PageDown, true
+	case "home":
+		return kb.Home, true
+	case "end":
+		return kb.End, true
+	}
+	if utf8.RuneCountInString(name) == 1 {
+		return name, true
+	}
+	return "", false
+}
+
+// jsString quotes s as a javascript string literal.
+func jsString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
 func (b *Tool) updateElements(ctx context.Context) error {
 	var nodes []*cdp.Node
 	err := chromedp.Run(ctx,
@@ -679,10 +1176,16 @@ func (b *Tool) updateElements(ctx context.Context) error {
 			description = fmt.Sprintf("TextArea: %s", node.AttributeValue("placeholder"))
 		}
 
+		var inputType string
+		if node.NodeName == "INPUT" {
+			inputType = node.AttributeValue("type")
+		}
+
 		b.elements = append(b.elements, ElementInfo{
 			Index:       i,
 			Description: description,
 			Type:        node.NodeName,
+			InputType:   inputType,
 			XPath:       node.FullXPath(),
 		})
 	}
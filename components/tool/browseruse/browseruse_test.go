@@ -19,13 +19,21 @@ package browseruse
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/bytedance/mockey"
 	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/network"
 	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/cdproto/target"
 	"github.com/chromedp/chromedp"
+	"github.com/chromedp/chromedp/kb"
 	"github.com/cloudwego/eino-ext/components/tool/duckduckgo/ddgsearch"
 	"github.com/stretchr/testify/assert"
 )
@@ -100,19 +108,35 @@ func TestExecute(t *testing.T) {
 	})
 
 	mockey.PatchConvey("web search", t, func() {
-		tool.searchTool = &ddgsearch.DDGS{}
+		tool.searchEngine = NewDDGSearchEngine(&ddgsearch.DDGS{})
 		defer mockey.Mock((*ddgsearch.DDGS).Search).Return(&ddgsearch.SearchResponse{
 			Results: []ddgsearch.SearchResult{
-				{URL: "https://example.com/search"},
+				{Title: "Example", URL: "https://example.com/search", Description: "example page"},
+				{Title: "Other", URL: "https://example.com/other"},
 			},
 		}, nil).Build().UnPatch()
-		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
-		defer mockey.Mock(chromedp.NewContext).Return(context.Background(), func() {}).Build().UnPatch()
 
 		query := "test query"
 		result, err := tool.Execute(&Param{Action: ActionWebSearch, Query: &query})
 		assert.NoError(t, err)
-		assert.Contains(t, result.Output, "successfully search web")
+		assert.Equal(t, "successfully searched web, use 'navigate_result' with the index to open a result:\n"+
+			"[0] Example\n    https://example.com/search\n    example page\n"+
+			"[1] Other\n    https://example.com/other\n", result.Output)
+	})
+
+	mockey.PatchConvey("navigate result", t, func() {
+		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+		tool.searchResults = []*SearchResult{{URL: "https://example.com/a"}, {URL: "https://example.com/b"}}
+
+		index := 1
+		result, err := tool.Execute(&Param{Action: ActionNavigateResult, Index: &index})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully navigated to search result 1: https://example.com/b", result.Output)
+
+		index = 2
+		result, err = tool.Execute(&Param{Action: ActionNavigateResult, Index: &index})
+		assert.NoError(t, err)
+		assert.Equal(t, "search result index 2 out of range", result.Error)
 	})
 
 	mockey.PatchConvey("wait", t, func() {
@@ -166,6 +190,253 @@ func TestExecute(t *testing.T) {
 
 }
 
+func TestExecuteFormActions(t *testing.T) {
+	tool := &Tool{}
+	defer mockey.Mock((*Tool).updateElements).Return(nil).Build().UnPatch()
+
+	tool.elements = []ElementInfo{
+		{Index: 0, Type: "SELECT", XPath: "/html/body/select"},
+		{Index: 1, Type: "INPUT", InputType: "file", XPath: "/html/body/input[1]"},
+		{Index: 2, Type: "INPUT", InputType: "text", XPath: "/html/body/input[2]"},
+		{Index: 3, Type: "A", XPath: "/html/body/a"},
+	}
+
+	mockey.PatchConvey("select option", t, func() {
+		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+		option := "Beijing"
+		index := 0
+		result, err := tool.Execute(&Param{Action: ActionSelectOption, Index: &index, Option: &option})
+		assert.NoError(t, err)
+		assert.Contains(t, result.Error, "option 'Beijing' not found in element 0")
+
+		index = 2
+		result, err = tool.Execute(&Param{Action: ActionSelectOption, Index: &index, Option: &option})
+		assert.NoError(t, err)
+		assert.Equal(t, "element 2 is not a select dropdown", result.Error)
+
+		result, err = tool.Execute(&Param{Action: ActionSelectOption, Option: &option})
+		assert.NoError(t, err)
+		assert.Equal(t, "index is required for 'select_option' action", result.Error)
+	})
+
+	mockey.PatchConvey("press key", t, func() {
+		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+		keys := "Enter"
+		result, err := tool.Execute(&Param{Action: ActionPressKey, Keys: &keys})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully pressed key Enter on the focused element", result.Output)
+
+		index := 2
+		result, err = tool.Execute(&Param{Action: ActionPressKey, Keys: &keys, Index: &index})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully pressed key Enter on element 2", result.Output)
+
+		keys = "F13"
+		result, err = tool.Execute(&Param{Action: ActionPressKey, Keys: &keys})
+		assert.NoError(t, err)
+		assert.Equal(t, "unsupported key: F13", result.Error)
+	})
+
+	mockey.PatchConvey("upload file", t, func() {
+		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+		file := filepath.Join(t.TempDir(), "resume.pdf")
+		assert.NoError(t, os.WriteFile(file, []byte("pdf"), 0o644))
+
+		index := 1
+		result, err := tool.Execute(&Param{Action: ActionUploadFile, Index: &index, FilePaths: []string{file}})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully uploaded 1 files to element 1", result.Output)
+
+		result, err = tool.Execute(&Param{Action: ActionUploadFile, Index: &index, FilePaths: []string{file + ".missing"}})
+		assert.NoError(t, err)
+		assert.Contains(t, result.Error, "does not exist")
+
+		index = 2
+		result, err = tool.Execute(&Param{Action: ActionUploadFile, Index: &index, FilePaths: []string{file}})
+		assert.NoError(t, err)
+		assert.Equal(t, "element 2 is not a file input", result.Error)
+	})
+
+	mockey.PatchConvey("hover", t, func() {
+		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+		index := 3
+		result, err := tool.Execute(&Param{Action: ActionHover, Index: &index})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully hovered element 3", result.Output)
+
+		index = 4
+		result, err = tool.Execute(&Param{Action: ActionHover, Index: &index})
+		assert.NoError(t, err)
+		assert.Equal(t, "index 4 out of range", result.Error)
+	})
+}
+
+func TestKeyOf(t *testing.T) {
+	key, ok := keyOf("escape")
+	assert.True(t, ok)
+	assert.Equal(t, kb.Escape, key)
+
+	key, ok = keyOf("a")
+	assert.True(t, ok)
+	assert.Equal(t, "a", key)
+
+	_, ok = keyOf("ctrl+a")
+	assert.False(t, ok)
+}
+
+func TestSearchEngine(t *testing.T) {
+	ctx := context.Background()
+
+	engine := SearchEngineFunc(func(ctx context.Context, query string, num int) ([]*SearchResult, error) {
+		return []*SearchResult{{Title: query, URL: "https://example.com"}}, nil
+	})
+	results, err := engine.Search(ctx, "eino", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []*SearchResult{{Title: "eino", URL: "https://example.com"}}, results)
+
+	// output of the bingsearch tool
+	results = findSearchResults(mustUnmarshal(t, `{"results": [{"title": "A", "url": "https://a.com", "description": "a"}]}`))
+	assert.Equal(t, []*SearchResult{{Title: "A", URL: "https://a.com", Summary: "a"}}, results)
+
+	// output of the googlesearch tool
+	results = findSearchResults(mustUnmarshal(t, `{"query": "q", "items": [{"link": "https://b.com", "title": "B", "snippet": "b"}]}`))
+	assert.Equal(t, []*SearchResult{{Title: "B", URL: "https://b.com", Summary: "b"}}, results)
+
+	assert.Nil(t, findSearchResults(mustUnmarshal(t, `{"items": [{"title": "no url"}]}`)))
+}
+
+func mustUnmarshal(t *testing.T, s string) any {
+	var v any
+	assert.NoError(t, json.Unmarshal([]byte(s), &v))
+	return v
+}
+
+func TestSession(t *testing.T) {
+	mockey.PatchConvey("session path", t, func() {
+		tool := &Tool{}
+		_, err := tool.sessionPath(nil)
+		assert.EqualError(t, err, "session dir is not configured")
+
+		tool.sessionDir = "sessions"
+		path, err := tool.sessionPath(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join("sessions", "default.json"), path)
+
+		name := "../escape"
+		_, err = tool.sessionPath(&name)
+		assert.Error(t, err)
+	})
+	mockey.PatchConvey("save and load session", t, func() {
+		defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+		defer mockey.Mock((*Tool).updateElements).Return(nil).Build().UnPatch()
+		tool := &Tool{sessionDir: t.TempDir()}
+		name := "github"
+
+		result, err := tool.Execute(&Param{Action: ActionSaveSession, SessionName: &name})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully saved session 'github'", result.Output)
+		info, err := os.Stat(filepath.Join(tool.sessionDir, "github.json"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		result, err = tool.Execute(&Param{Action: ActionLoadSession, SessionName: &name})
+		assert.NoError(t, err)
+		assert.Equal(t, "successfully loaded session 'github'", result.Output)
+
+		missing := "missing"
+		result, err = tool.Execute(&Param{Action: ActionLoadSession, SessionName: &missing})
+		assert.NoError(t, err)
+		assert.Contains(t, result.Error, "failed to load session")
+	})
+	mockey.PatchConvey("cookie params", t, func() {
+		now := time.Now()
+		params := toCookieParams([]*network.Cookie{
+			{Name: "session", Value: "a", Domain: "example.com", Path: "/", Session: true},
+			{Name: "valid", Value: "b", Domain: "example.com", Path: "/", Expires: float64(now.Add(time.Hour).Unix())},
+			{Name: "expired", Value: "c", Domain: "example.com", Path: "/", Expires: float64(now.Add(-time.Hour).Unix())},
+		}, now)
+		assert.Len(t, params, 2)
+		assert.Equal(t, "session", params[0].Name)
+		assert.Nil(t, params[0].Expires)
+		assert.Equal(t, "valid", params[1].Name)
+		assert.NotNil(t, params[1].Expires)
+	})
+}
+
+func TestActionContext(t *testing.T) {
+	mockey.PatchConvey("no timeout", t, func() {
+		tool := &Tool{}
+		ctx, cancel := tool.actionContext(context.Background(), context.Background(), ActionWait)
+		defer cancel()
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+	mockey.PatchConvey("action timeout", t, func() {
+		tool := &Tool{
+			actionTimeout:  time.Minute,
+			actionTimeouts: map[Action]time.Duration{ActionExtractContent: time.Hour},
+		}
+		ctx, cancel := tool.actionContext(context.Background(), context.Background(), ActionWait)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+		ctx, cancel = tool.actionContext(context.Background(), context.Background(), ActionExtractContent)
+		defer cancel()
+		deadline, ok = ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Second)
+
+		// the earlier deadline of the caller applies
+		callerCtx, callerCancel := context.WithTimeout(context.Background(), time.Second)
+		defer callerCancel()
+		ctx, cancel = tool.actionContext(callerCtx, context.Background(), ActionWait)
+		defer cancel()
+		deadline, ok = ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Second), deadline, time.Second)
+	})
+	mockey.PatchConvey("caller cancel", t, func() {
+		tool := &Tool{}
+		callerCtx, callerCancel := context.WithCancel(context.Background())
+		ctx, cancel := tool.actionContext(callerCtx, context.Background(), ActionWait)
+		defer cancel()
+		callerCancel()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("action context is not cancelled with the caller")
+		}
+	})
+}
+
+func TestStreamableRun(t *testing.T) {
+	defer mockey.Mock(chromedp.Run).Return(nil).Build().UnPatch()
+	defer mockey.Mock((*Tool).updateElements).Return(nil).Build().UnPatch()
+	tool := &Tool{}
+
+	sr, err := tool.StreamableRun(context.Background(), `{"action":"go_to_url","url":"test url"}`)
+	assert.NoError(t, err)
+	defer sr.Close()
+
+	var results []*ToolResult
+	for {
+		chunk, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.NoError(t, err)
+		result := &ToolResult{}
+		assert.NoError(t, json.Unmarshal([]byte(chunk), result))
+		results = append(results, result)
+	}
+	assert.Equal(t, []*ToolResult{
+		{Output: "navigating to test url"},
+		{Output: "successfully navigated to test url"},
+	}, results)
+}
+
 func TestUpdateElements(t *testing.T) {
 	ctx := context.Background()
 	tool := Tool{}
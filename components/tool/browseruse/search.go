@@ -0,0 +1,146 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package browseruse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudwego/eino-ext/components/tool/duckduckgo/ddgsearch"
+	"github.com/cloudwego/eino/components/tool"
+)
+
+// SearchResult is a web search result.
+type SearchResult struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// SearchEngine searches the web for the 'web_search' action.
+type SearchEngine interface {
+	// Search returns at most num results of the query.
+	Search(ctx context.Context, query string, num int) ([]*SearchResult, error)
+}
+
+// SearchEngineFunc adapts a function to SearchEngine.
+type SearchEngineFunc func(ctx context.Context, query string, num int) ([]*SearchResult, error)
+
+func (f SearchEngineFunc) Search(ctx context.Context, query string, num int) ([]*SearchResult, error) {
+	return f(ctx, query, num)
+}
+
+// NewDDGSearchEngine creates a SearchEngine with the DuckDuckGo search client.
+func NewDDGSearchEngine(ddg *ddgsearch.DDGS) SearchEngine {
+	return &ddgSearchEngine{ddg: ddg}
+}
+
+type ddgSearchEngine struct {
+	ddg *ddgsearch.DDGS
+}
+
+func (d *ddgSearchEngine) Search(ctx context.Context, query string, num int) ([]*SearchResult, error) {
+	resp, err := d.ddg.Search(ctx, &ddgsearch.SearchParams{Query: query, MaxResults: num})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*SearchResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		results = append(results, &SearchResult{Title: r.Title, URL: r.URL, Summary: r.Description})
+	}
+	return results, nil
+}
+
+// NewToolSearchEngine creates a SearchEngine with a search tool, e.g. the bingsearch or googlesearch tool.
+// The tool is invoked with {"query": <query>, "num": <num>}, and its output is expected to be a json object
+// containing a list of results, whose url is in the "url" or "link" field.
+func NewToolSearchEngine(t tool.InvokableTool) SearchEngine {
+	return &toolSearchEngine{tool: t}
+}
+
+type toolSearchEngine struct {
+	tool tool.InvokableTool
+}
+
+func (t *toolSearchEngine) Search(ctx context.Context, query string, num int) ([]*SearchResult, error) {
+	args, err := json.Marshal(map[string]any{"query": query, "num": num})
+	if err != nil {
+		return nil, err
+	}
+	output, err := t.tool.InvokableRun(ctx, string(args))
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+	if err = json.Unmarshal([]byte(output), &v); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal search output: %w", err)
+	}
+	results := findSearchResults(v)
+	if len(results) > num {
+		results = results[:num]
+	}
+	return results, nil
+}
+
+// findSearchResults finds the first list of objects with url in the search output.
+func findSearchResults(v any) []*SearchResult {
+	switch val := v.(type) {
+	case []any:
+		var results []*SearchResult
+		for _, item := range val {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			url := firstString(obj, "url", "link")
+			if url == "" {
+				continue
+			}
+			results = append(results, &SearchResult{
+				Title:   firstString(obj, "title", "name"),
+				URL:     url,
+				Summary: firstString(obj, "summary", "description", "snippet", "desc", "content"),
+			})
+		}
+		if len(results) > 0 {
+			return results
+		}
+		for _, item := range val {
+			if results = findSearchResults(item); len(results) > 0 {
+				return results
+			}
+		}
+	case map[string]any:
+		for _, item := range val {
+			if results := findSearchResults(item); len(results) > 0 {
+				return results
+			}
+		}
+	}
+	return nil
+}
+
+func firstString(obj map[string]any, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := obj[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package browseruse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/cdproto/storage"
+	"github.com/chromedp/chromedp"
+)
+
+const defaultSessionName = "default"
+
+var sessionNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// SessionState is the browser state saved by 'save_session' and restored by 'load_session'.
+type SessionState struct {
+	// URL is the url of the current tab when the session is saved.
+	URL string `json:"url"`
+	// Cookies are all the cookies of the browser.
+	Cookies []*network.Cookie `json:"cookies"`
+	// Origins are the localStorage of the origins.
+	Origins []*OriginStorage `json:"origins"`
+	// SavedAt is the time the session is saved.
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// OriginStorage is the localStorage of an origin.
+type OriginStorage struct {
+	Origin       string            `json:"origin"`
+	LocalStorage map[string]string `json:"local_storage"`
+}
+
+// SaveSession saves the cookies and the localStorage of the current page to the file.
+func (b *Tool) SaveSession(path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.saveSession(b.ctx, path)
+}
+
+// LoadSession restores the cookies and the localStorage from the file saved by SaveSession,
+// and navigates to the saved url.
+func (b *Tool) LoadSession(path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.loadSession(b.ctx, path)
+}
+
+// sessionPath returns the file path of the named session in the session dir.
+func (b *Tool) sessionPath(name *string) (string, error) {
+	if b.sessionDir == "" {
+		return "", fmt.Errorf("session dir is not configured")
+	}
+	n := defaultSessionName
+	if name != nil && *name != "" {
+		n = *name
+	}
+	if !sessionNameRegexp.MatchString(n) {
+		return "", fmt.Errorf("invalid session name '%s', only letters, digits, '_' and '-' are allowed", n)
+	}
+	return filepath.Join(b.sessionDir, n+".json"), nil
+}
+
+func (b *Tool) saveSession(ctx context.Context, path string) error {
+	state := &SessionState{SavedAt: time.Now()}
+
+	var origin struct {
+		Origin       string            `json:"origin"`
+		LocalStorage map[string]string `json:"local_storage"`
+	}
+	err := chromedp.Run(ctx,
+		chromedp.Location(&state.URL),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			cookies, err := storage.GetCookies().Do(ctx)
+			if err != nil {
+				return err
+			}
+			state.Cookies = cookies
+			return nil
+		}),
+		chromedp.Evaluate(localStorageScript, &origin),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get browser state: %w", err)
+	}
+	if origin.Origin != "" && origin.Origin != "null" && len(origin.LocalStorage) > 0 {
+		state.Origins = append(state.Origins, &OriginStorage{Origin: origin.Origin, LocalStorage: origin.LocalStorage})
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create session dir: %w", err)
+	}
+	// the session contains credentials, keep it private
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write session: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Tool) loadSession(ctx context.Context, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read session: %w", err)
+	}
+	state := &SessionState{}
+	if err = json.Unmarshal(data, state); err != nil {
+		return fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+
+	if cookies := toCookieParams(state.Cookies, time.Now()); len(cookies) > 0 {
+		err = chromedp.Run(ctx, chromedp.ActionFunc(func(ctx context.Context) error {
+			return storage.SetCookies(cookies).Do(ctx)
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to set cookies: %w", err)
+		}
+	}
+
+	for _, o := range state.Origins {
+		entries, err := json.Marshal(o.LocalStorage)
+		if err != nil {
+			return fmt.Errorf("failed to marshal local storage: %w", err)
+		}
+		// localStorage can only be set on a page of the origin
+		err = chromedp.Run(ctx,
+			chromedp.Navigate(o.Origin),
+			chromedp.Evaluate(fmt.Sprintf(setLocalStorageScript, entries), nil),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set local storage of %s: %w", o.Origin, err)
+		}
+	}
+
+	if state.URL != "" && state.URL != "about:blank" {
+		err = chromedp.Run(ctx,
+			chromedp.Navigate(state.URL),
+			chromedp.WaitReady("body", chromedp.ByQuery),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to navigate to %s: %w", state.URL, err)
+		}
+	}
+
+	return nil
+}
+
+// toCookieParams converts the saved cookies to the params of storage.SetCookies, dropping the expired ones.
+func toCookieParams(cookies []*network.Cookie, now time.Time) []*network.CookieParam {
+	params := make([]*network.CookieParam, 0, len(cookies))
+	for _, c := range cookies {
+		p := &network.CookieParam{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			Secure:   c.Secure,
+			HTTPOnly: c.HTTPOnly,
+			SameSite: c.SameSite,
+		}
+		if !c.Session && c.Expires > 0 {
+			expires := time.Unix(0, int64(c.Expires*float64(time.Second)))
+			if expires.Before(now) {
+				continue
+			}
+			t := cdp.TimeSinceEpoch(expires)
+			p.Expires = &t
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+const (
+	localStorageScript = `
+(() => {
+	try {
+		return {origin: location.origin, local_storage: Object.fromEntries(Object.entries(localStorage))};
+	} catch (e) {
+		return {origin: location.origin, local_storage: {}};
+	}
+})()
+`
+
+	setLocalStorageScript = `
+(() => {
+	const entries = %s;
+	for (const [k, v] of Object.entries(entries)) localStorage.setItem(k, v);
+})()
+`
+)
@@ -0,0 +1,385 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commandline
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+)
+
+type Language string
+
+const (
+	LanguagePython     Language = "python"
+	LanguageJavaScript Language = "javascript"
+)
+
+// CodeRequest is a code snippet to run
+type CodeRequest struct {
+	Language Language
+	Code     string
+	// Timeout limits the wall time of the run, no limit if zero
+	Timeout time.Duration
+}
+
+// CodeResult is the result of a run
+type CodeResult struct {
+	Stdout    string
+	Stderr    string
+	ExitCode  int
+	TimedOut  bool
+	Artifacts []*Artifact
+}
+
+// Artifact is a file written by the code to the output directory
+type Artifact struct {
+	Name    string
+	Content []byte
+}
+
+// CodeRunner defines the interface for running code snippets in a sandbox, e.g. a Docker container or a WASM runtime.
+// CPU and memory limits are enforced by the implementation.
+type CodeRunner interface {
+	RunCode(ctx context.Context, req *CodeRequest) (*CodeResult, error)
+}
+
+type CodeInterpreterConfig struct {
+	// Runner is the sandbox backend running the code, required.
+	Runner CodeRunner
+	// Languages supported by the tool, default: python and javascript.
+	Languages []Language
+	// Timeout limits the wall time of each run, default: 30s.
+	Timeout time.Duration
+	// MaxOutputBytes limits the size of stdout, stderr and each artifact returned to the model, default: 16KB.
+	MaxOutputBytes int
+}
+
+func NewCodeInterpreter(_ context.Context, cfg *CodeInterpreterConfig) (*CodeInterpreter, error) {
+	if cfg == nil {
+		return nil, errors.New("config is required")
+	}
+	if cfg.Runner == nil {
+		return nil, errors.New("runner is required")
+	}
+	languages := cfg.Languages
+	if len(languages) == 0 {
+		languages = []Language{LanguagePython, LanguageJavaScript}
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	maxOutputBytes := cfg.MaxOutputBytes
+	if maxOutputBytes <= 0 {
+		maxOutputBytes = 16 * 1024
+	}
+
+	enum := make([]any, 0, len(languages))
+	names := make([]string, 0, len(languages))
+	for _, l := range languages {
+		enum = append(enum, string(l))
+		names = append(names, string(l))
+	}
+
+	return &CodeInterpreter{
+		info: &schema.ToolInfo{
+			Name: "code_interpreter",
+			Desc: fmt.Sprintf("Executes %s code in a sandbox and returns stdout, stderr and exit code. "+
+				"Only printed outputs are visible. Files written to the directory in the OUTPUT_DIR environment variable are returned as artifacts. "+
+				"Each run starts from a clean state and times out after %v.", strings.Join(names, " or "), timeout),
+			ParamsOneOf: schema.NewParamsOneOfByOpenAPIV3(&openapi3.Schema{
+				Type: openapi3.TypeObject,
+				Properties: map[string]*openapi3.SchemaRef{
+					"language": {
+						Value: &openapi3.Schema{
+							Type:        openapi3.TypeString,
+							Description: "The language of the code.",
+							Enum:        enum,
+						},
+					},
+					"code": {
+						Value: &openapi3.Schema{
+							Type:        openapi3.TypeString,
+							Description: "The code to execute.",
+						},
+					},
+				},
+				Required: []string{"language", "code"},
+			}),
+		},
+		runner:         cfg.Runner,
+		languages:      languages,
+		timeout:        timeout,
+		maxOutputBytes: maxOutputBytes,
+	}, nil
+}
+
+type CodeInterpreter struct {
+	info           *schema.ToolInfo
+	runner         CodeRunner
+	languages      []Language
+	timeout        time.Duration
+	maxOutputBytes int
+}
+
+func (c *CodeInterpreter) Info(_ context.Context) (*schema.ToolInfo, error) {
+	return c.info, nil
+}
+
+type CodeInput struct {
+	Language Language `json:"language"`
+	Code     string   `json:"code"`
+}
+
+type CodeOutput struct {
+	Stdout    string            `json:"stdout"`
+	Stderr    string            `json:"stderr,omitempty"`
+	ExitCode  int               `json:"exit_code"`
+	TimedOut  bool              `json:"timed_out,omitempty"`
+	Artifacts []*ArtifactOutput `json:"artifacts,omitempty"`
+}
+
+type ArtifactOutput struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+	// Content is the text content of the artifact
+	Content string `json:"content,omitempty"`
+	// Base64 is the base64 encoded content of a binary artifact
+	Base64 string `json:"base64,omitempty"`
+	// Truncated reports whether the content is truncated or omitted due to MaxOutputBytes
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+func (c *CodeInterpreter) Execute(ctx context.Context, args *CodeInput) (*CodeOutput, error) {
+	if strings.TrimSpace(args.Code) == "" {
+		return nil, errors.New("code is required")
+	}
+	if args.Language == "" && len(c.languages) == 1 {
+		args.Language = c.languages[0]
+	}
+	supported := false
+	for _, l := range c.languages {
+		if l == args.Language {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return nil, fmt.Errorf("unsupported language: %s", args.Language)
+	}
+
+	result, err := c.runner.RunCode(ctx, &CodeRequest{
+		Language: args.Language,
+		Code:     args.Code,
+		Timeout:  c.timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &CodeOutput{
+		Stdout:   truncateOutput(result.Stdout, c.maxOutputBytes),
+		Stderr:   truncateOutput(result.Stderr, c.maxOutputBytes),
+		ExitCode: result.ExitCode,
+		TimedOut: result.TimedOut,
+	}
+	for _, a := range result.Artifacts {
+		ao := &ArtifactOutput{Name: a.Name, Size: len(a.Content)}
+		switch {
+		case utf8.Valid(a.Content):
+			ao.Content = truncateOutput(string(a.Content), c.maxOutputBytes)
+			ao.Truncated = len(a.Content) > c.maxOutputBytes
+		case base64.StdEncoding.EncodedLen(len(a.Content)) <= c.maxOutputBytes:
+			ao.Base64 = base64.StdEncoding.EncodeToString(a.Content)
+		default:
+			ao.Truncated = true
+		}
+		output.Artifacts = append(output.Artifacts, ao)
+	}
+
+	return output, nil
+}
+
+func (c *CodeInterpreter) InvokableRun(ctx context.Context, argumentsInJSON string, _ ...tool.Option) (string, error) {
+	args := &CodeInput{}
+	if err := json.Unmarshal([]byte(argumentsInJSON), args); err != nil {
+		return "", fmt.Errorf("extract argument fail: %w", err)
+	}
+
+	output, err := c.Execute(ctx, args)
+	if err != nil {
+		return "", fmt.Errorf("execute error: %w", err)
+	}
+
+	result, err := json.Marshal(output)
+	if err != nil {
+		return "", fmt.Errorf("marshal output fail: %w", err)
+	}
+	return string(result), nil
+}
+
+// truncateOutput keeps the tail of s within maxBytes, since errors are usually printed last.
+func truncateOutput(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := len(s) - maxBytes
+	for cut < len(s) && !utf8.RuneStart(s[cut]) {
+		cut++
+	}
+	return fmt.Sprintf("...[%d bytes truncated]\n%s", cut, s[cut:])
+}
+
+// CommandExecutor defines the interface for executing shell commands with stdout, stderr
+// and exit code reported separately, e.g. *sandbox.DockerSandbox.
+type CommandExecutor interface {
+	Operator
+	Exec(ctx context.Context, command string) (stdout, stderr string, exitCode int, err error)
+}
+
+type SandboxCodeRunnerConfig struct {
+	// Executor runs the commands in the sandbox, required.
+	// CPU and memory limits are configured on the sandbox, e.g. sandbox.Config.
+	Executor CommandExecutor
+	// Commands maps languages to the interpreter commands,
+	// default: python3 for python and node for javascript.
+	Commands map[Language]string
+	// MaxArtifacts limits the number of artifacts returned, default: 10.
+	MaxArtifacts int
+}
+
+// NewSandboxCodeRunner creates a CodeRunner running code with the interpreters in a sandbox, e.g. a Docker container.
+// Each run is placed in a temporary directory, which is removed after the run.
+func NewSandboxCodeRunner(_ context.Context, cfg *SandboxCodeRunnerConfig) (*SandboxCodeRunner, error) {
+	if cfg == nil {
+		return nil, errors.New("config is required")
+	}
+	if cfg.Executor == nil {
+		return nil, errors.New("executor is required")
+	}
+	commands := map[Language]string{
+		LanguagePython:     defaultPythonCommand,
+		LanguageJavaScript: "node",
+	}
+	for l, c := range cfg.Commands {
+		commands[l] = c
+	}
+	maxArtifacts := cfg.MaxArtifacts
+	if maxArtifacts <= 0 {
+		maxArtifacts = 10
+	}
+
+	return &SandboxCodeRunner{
+		executor:     cfg.Executor,
+		commands:     commands,
+		maxArtifacts: maxArtifacts,
+	}, nil
+}
+
+type SandboxCodeRunner struct {
+	executor     CommandExecutor
+	commands     map[Language]string
+	maxArtifacts int
+}
+
+var codeFileExt = map[Language]string{
+	LanguagePython:     ".py",
+	LanguageJavaScript: ".js",
+}
+
+const (
+	// timeoutExitCode is the exit code of timeout(1) when the command times out and exits on SIGTERM
+	timeoutExitCode = 124
+	// killedExitCode is the exit code of commands killed by SIGKILL, either by timeout(1) after the grace period,
+	// or by the sandbox, e.g. when the memory limit is exceeded
+	killedExitCode = 137
+	// timeoutKillAfter is the grace period between SIGTERM and SIGKILL of timed out commands
+	timeoutKillAfter = "1s"
+)
+
+func (r *SandboxCodeRunner) RunCode(ctx context.Context, req *CodeRequest) (*CodeResult, error) {
+	command, ok := r.commands[req.Language]
+	if !ok {
+		return nil, fmt.Errorf("unsupported language: %s", req.Language)
+	}
+
+	dir := "run_" + uuid.New().String()[:8]
+	outputDir := path.Join(dir, "outputs")
+	fileName := path.Join(dir, "main"+codeFileExt[req.Language])
+
+	if err := r.executor.WriteFile(ctx, fileName, req.Code); err != nil {
+		return nil, fmt.Errorf("failed to create code file: %w", err)
+	}
+	defer func() {
+		_, _, _, _ = r.executor.Exec(context.Background(), "rm -rf "+dir)
+	}()
+
+	cmd := fmt.Sprintf("mkdir -p %s && cd %s && OUTPUT_DIR=outputs ", outputDir, dir)
+	if req.Timeout > 0 {
+		// fractional seconds, since timeout(1) treats 0 as no limit
+		cmd += fmt.Sprintf("timeout -s TERM -k %s %.3fs ", timeoutKillAfter, req.Timeout.Seconds())
+	}
+	cmd += command + " " + path.Base(fileName)
+
+	start := time.Now()
+	stdout, stderr, exitCode, err := r.executor.Exec(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("execute error: %w", err)
+	}
+
+	result := &CodeResult{
+		Stdout:   stdout,
+		Stderr:   stderr,
+		ExitCode: exitCode,
+		// commands ignoring SIGTERM are killed after the grace period, which can't be told apart from
+		// other kills, e.g. out of memory, by the exit code only
+		TimedOut: req.Timeout > 0 && (exitCode == timeoutExitCode ||
+			(exitCode == killedExitCode && time.Since(start) >= req.Timeout)),
+	}
+
+	files, _, _, err := r.executor.Exec(ctx, fmt.Sprintf("find %s -type f | head -n %d", outputDir, r.maxArtifacts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+	for _, file := range strings.Split(strings.TrimSpace(files), "\n") {
+		if file == "" {
+			continue
+		}
+		content, err := r.executor.ReadFile(ctx, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact %s: %w", file, err)
+		}
+		result.Artifacts = append(result.Artifacts, &Artifact{
+			Name:    strings.TrimPrefix(file, outputDir+"/"),
+			Content: []byte(content),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commandline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRunner struct {
+	req    *CodeRequest
+	result *CodeResult
+}
+
+func (f *fakeRunner) RunCode(_ context.Context, req *CodeRequest) (*CodeResult, error) {
+	f.req = req
+	if f.result == nil {
+		return nil, errors.New("runner error")
+	}
+	return f.result, nil
+}
+
+func TestCodeInterpreter(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewCodeInterpreter(ctx, nil)
+	assert.Error(t, err)
+	_, err = NewCodeInterpreter(ctx, &CodeInterpreterConfig{})
+	assert.Error(t, err)
+
+	runner := &fakeRunner{result: &CodeResult{
+		Stdout:   strings.Repeat("a", 20) + "tail",
+		Stderr:   "warning",
+		ExitCode: 1,
+		Artifacts: []*Artifact{
+			{Name: "result.txt", Content: []byte("hello")},
+			{Name: "small.bin", Content: []byte{0xff, 0x00}},
+			{Name: "large.bin", Content: append([]byte{0xff}, make([]byte, 100)...)},
+		},
+	}}
+	ci, err := NewCodeInterpreter(ctx, &CodeInterpreterConfig{Runner: runner, MaxOutputBytes: 10, Timeout: 5 * time.Second})
+	assert.NoError(t, err)
+
+	info, err := ci.Info(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "code_interpreter", info.Name)
+	s, err := info.ParamsOneOf.ToOpenAPIV3()
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"python", "javascript"}, s.Properties["language"].Value.Enum)
+
+	result, err := ci.InvokableRun(ctx, `{"language": "python", "code": "print(1)"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &CodeRequest{Language: LanguagePython, Code: "print(1)", Timeout: 5 * time.Second}, runner.req)
+
+	output := &CodeOutput{}
+	assert.NoError(t, json.Unmarshal([]byte(result), output))
+	assert.Equal(t, "...[14 bytes truncated]\naaaaaatail", output.Stdout)
+	assert.Equal(t, "warning", output.Stderr)
+	assert.Equal(t, 1, output.ExitCode)
+	assert.Equal(t, []*ArtifactOutput{
+		{Name: "result.txt", Size: 5, Content: "hello"},
+		{Name: "small.bin", Size: 2, Base64: "/wA="},
+		{Name: "large.bin", Size: 101, Truncated: true},
+	}, output.Artifacts)
+
+	_, err = ci.InvokableRun(ctx, `{"language": "ruby", "code": "puts 1"}`)
+	assert.Error(t, err)
+	_, err = ci.InvokableRun(ctx, `{"language": "python", "code": " "}`)
+	assert.Error(t, err)
+
+	runner.result = nil
+	_, err = ci.InvokableRun(ctx, `{"language": "python", "code": "print(1)"}`)
+	assert.Error(t, err)
+}
+
+type fakeExecutor struct {
+	pyOperator
+	files    map[string]string
+	commands []string
+	exitCode int
+	// artifacts are the files listed in the output directory
+	artifacts map[string]string
+}
+
+func (f *fakeExecutor) WriteFile(_ context.Context, path string, content string) error {
+	f.files[path] = content
+	return nil
+}
+
+func (f *fakeExecutor) ReadFile(_ context.Context, path string) (string, error) {
+	content, ok := f.files[path]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return content, nil
+}
+
+func (f *fakeExecutor) Exec(_ context.Context, command string) (string, string, int, error) {
+	f.commands = append(f.commands, command)
+	switch {
+	case strings.HasPrefix(command, "find "):
+		outputDir := strings.Fields(command)[1]
+		var listed []string
+		for name, content := range f.artifacts {
+			f.files[outputDir+"/"+name] = content
+			listed = append(listed, outputDir+"/"+name)
+		}
+		sort.Strings(listed)
+		return strings.Join(listed, "\n") + "\n", "", 0, nil
+	case strings.HasPrefix(command, "rm "):
+		return "", "", 0, nil
+	default:
+		return "out", "err", f.exitCode, nil
+	}
+}
+
+func TestSandboxCodeRunner(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewSandboxCodeRunner(ctx, &SandboxCodeRunnerConfig{})
+	assert.Error(t, err)
+
+	exec := &fakeExecutor{files: map[string]string{}, exitCode: 124}
+	runner, err := NewSandboxCodeRunner(ctx, &SandboxCodeRunnerConfig{
+		Executor: exec,
+		Commands: map[Language]string{LanguageJavaScript: "deno run"},
+	})
+	assert.NoError(t, err)
+
+	var dir string
+	result, err := runner.RunCode(ctx, &CodeRequest{Language: LanguageJavaScript, Code: "console.log(1)", Timeout: 3 * time.Second})
+	assert.NoError(t, err)
+	for path, code := range exec.files {
+		assert.Equal(t, "console.log(1)", code)
+		assert.True(t, strings.HasSuffix(path, "/main.js"))
+		dir = strings.TrimSuffix(path, "/main.js")
+	}
+	assert.Equal(t, "mkdir -p "+dir+"/outputs && cd "+dir+" && OUTPUT_DIR=outputs timeout -s TERM -k 1s 3.000s deno run main.js", exec.commands[0])
+	assert.Equal(t, "rm -rf "+dir, exec.commands[len(exec.commands)-1])
+	assert.Equal(t, &CodeResult{Stdout: "out", Stderr: "err", ExitCode: 124, TimedOut: true}, result)
+
+	// killed before the timeout, e.g. out of memory
+	exec.files = map[string]string{}
+	exec.exitCode = 137
+	exec.commands = nil
+	result, err = runner.RunCode(ctx, &CodeRequest{Language: LanguageJavaScript, Code: "console.log(1)", Timeout: 300 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(exec.commands[0], "timeout -s TERM -k 1s 0.300s deno run main.js"))
+	assert.Equal(t, 137, result.ExitCode)
+	assert.False(t, result.TimedOut)
+
+	exec.files = map[string]string{}
+	exec.exitCode = 0
+	exec.commands = nil
+	_, err = runner.RunCode(ctx, &CodeRequest{Language: LanguagePython, Code: "print(1)"})
+	assert.NoError(t, err)
+	for path := range exec.files {
+		dir = strings.TrimSuffix(path, "/main.py")
+	}
+	assert.Equal(t, "mkdir -p "+dir+"/outputs && cd "+dir+" && OUTPUT_DIR=outputs python3 main.py", exec.commands[0])
+
+	exec.artifacts = map[string]string{"a.txt": "A", "sub/b.csv": "x,y"}
+	result, err = runner.RunCode(ctx, &CodeRequest{Language: LanguagePython, Code: "print(1)"})
+	assert.NoError(t, err)
+	assert.False(t, result.TimedOut)
+	assert.Equal(t, []*Artifact{
+		{Name: "a.txt", Content: []byte("A")},
+		{Name: "sub/b.csv", Content: []byte("x,y")},
+	}, result.Artifacts)
+
+	_, err = runner.RunCode(ctx, &CodeRequest{Language: "ruby", Code: "puts 1"})
+	assert.Error(t, err)
+}
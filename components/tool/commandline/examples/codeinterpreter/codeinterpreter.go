@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/tool/commandline"
+	"github.com/cloudwego/eino-ext/components/tool/commandline/sandbox"
+)
+
+func main() {
+	ctx := context.Background()
+	op, err := sandbox.NewDockerSandbox(ctx, &sandbox.Config{
+		Image:       "nikolaik/python-nodejs:python3.12-nodejs22-slim", // image with both python3 and node
+		MemoryLimit: 256 * 1024 * 1024,
+		CPULimit:    0.5,
+		Timeout:     time.Minute,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// you should ensure that docker has been started before create a docker container
+	err = op.Create(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer op.Cleanup(ctx)
+
+	runner, err := commandline.NewSandboxCodeRunner(ctx, &commandline.SandboxCodeRunnerConfig{Executor: op})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ci, err := commandline.NewCodeInterpreter(ctx, &commandline.CodeInterpreterConfig{
+		Runner:  runner,
+		Timeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	info, err := ci.Info(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("tool name: %s,  tool desc: %s", info.Name, info.Desc)
+
+	code := "import os\n\nsquares = [i * i for i in range(10)]\nprint(sum(squares))\n\nwith open(os.path.join(os.environ['OUTPUT_DIR'], 'squares.csv'), 'w') as f:\n    f.write('\\n'.join(str(s) for s in squares))"
+	log.Printf("execute code:\n%s", code)
+	output, err := ci.Execute(ctx, &commandline.CodeInput{Language: commandline.LanguagePython, Code: code})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("stdout: %s, stderr: %s, exit code: %d", output.Stdout, output.Stderr, output.ExitCode)
+	for _, artifact := range output.Artifacts {
+		log.Printf("artifact %s:\n%s", artifact.Name, artifact.Content)
+	}
+
+	result, err := ci.InvokableRun(ctx, `{"language": "javascript", "code": "console.log([1, 2, 3].map(x => x * 2))"}`)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("result:\n", result)
+}
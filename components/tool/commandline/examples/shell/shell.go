@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/tool/commandline"
+)
+
+func main() {
+	ctx := context.Background()
+
+	workDir, err := os.MkdirTemp("", "shell")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(workDir)
+
+	sh, err := commandline.NewShell(ctx, &commandline.ShellConfig{
+		Policy: &commandline.ShellPolicy{
+			AllowCommands:  []string{`^(ls|cat|echo|grep|wc)\b`},
+			DenyCommands:   []string{`\brm\b`, `\bcurl\b`},
+			WorkDir:        workDir,
+			AllowEnv:       []string{"PATH", "LANG"},
+			MaxOutputBytes: 4096,
+			DryRun:         os.Getenv("SHELL_DRY_RUN") == "true",
+		},
+		AuditFunc: func(ctx context.Context, record *commandline.ShellAuditRecord) {
+			log.Printf("audit: command=%q allowed=%v reason=%q dry_run=%v", record.Command, record.Allowed, record.Reason, record.DryRun)
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, command := range []string{
+		"echo hello > hello.txt && cat hello.txt",
+		"cat /etc/passwd",
+		"rm hello.txt",
+	} {
+		result, err := sh.Execute(ctx, &commandline.ShellInput{Command: command})
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("command: %s\nresult: %s", command, result)
+	}
+}
@@ -165,8 +165,24 @@ func (s *DockerSandbox) Create(ctx context.Context) error {
 
 // RunCommand executes a command in the sandbox
 func (s *DockerSandbox) RunCommand(ctx context.Context, cmd string) (string, error) {
+	stdout, stderr, exitCode, err := s.Exec(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+
+	if exitCode != 0 {
+		return "", fmt.Errorf("command execution failed with exit code %d: %s",
+			exitCode, stderr)
+	}
+
+	return stdout, nil
+}
+
+// Exec executes a command in the sandbox, and returns stdout, stderr and exit code of the command.
+// Unlike RunCommand, a non-zero exit code is not treated as an error.
+func (s *DockerSandbox) Exec(ctx context.Context, cmd string) (stdout, stderr string, exitCode int, err error) {
 	if s.containerID == "" {
-		return "", fmt.Errorf("sandbox not initialized")
+		return "", "", 0, fmt.Errorf("sandbox not initialized")
 	}
 
 	timeout := s.config.Timeout
@@ -186,7 +202,7 @@ func (s *DockerSandbox) RunCommand(ctx context.Context, cmd string) (string, err
 	// Create execution instance
 	execID, err := s.client.ContainerExecCreate(ctx, s.containerID, execConfig)
 	if err != nil {
-		return "", fmt.Errorf("failed to create exec instance: %w", err)
+		return "", "", 0, fmt.Errorf("failed to create exec instance: %w", err)
 	}
 
 	// Attach to execution instance
@@ -196,7 +212,7 @@ func (s *DockerSandbox) RunCommand(ctx context.Context, cmd string) (string, err
 		ConsoleSize: nil,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to attach to exec instance: %w", err)
+		return "", "", 0, fmt.Errorf("failed to attach to exec instance: %w", err)
 	}
 	defer resp.Close()
 
@@ -212,24 +228,19 @@ func (s *DockerSandbox) RunCommand(ctx context.Context, cmd string) (string, err
 	select {
 	case err := <-outputDone:
 		if err != nil {
-			return "", fmt.Errorf("failed to read command output: %w", err)
+			return "", "", 0, fmt.Errorf("failed to read command output: %w", err)
 		}
 	case <-ctx.Done():
-		return "", fmt.Errorf("command execution timedout after %v", timeout)
+		return "", "", 0, fmt.Errorf("command execution timedout after %v", timeout)
 	}
 
 	// Check execution status
 	inspectResp, err := s.client.ContainerExecInspect(ctx, execID.ID)
 	if err != nil {
-		return "", fmt.Errorf("failed to inspect exec status: %w", err)
-	}
-
-	if inspectResp.ExitCode != 0 {
-		return "", fmt.Errorf("command execution failed with exit code %d: %s",
-			inspectResp.ExitCode, errBuf.String())
+		return "", "", 0, fmt.Errorf("failed to inspect exec status: %w", err)
 	}
 
-	return outBuf.String(), nil
+	return outBuf.String(), errBuf.String(), inspectResp.ExitCode, nil
 }
 
 // ReadFile reads a file from the container
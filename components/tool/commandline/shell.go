@@ -0,0 +1,206 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commandline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+type ShellConfig struct {
+	// Operator runs the commands, e.g. a Docker sandbox.
+	// If not provided, commands run locally with "sh -c", with environment variables scrubbed by the policy.
+	Operator Operator
+	// Policy restricts the commands allowed to run, no restriction if not provided.
+	Policy *ShellPolicy
+	// Timeout limits the duration of local commands, default: 30s.
+	Timeout time.Duration
+	// AuditFunc is called with every command requested by the model, optional.
+	AuditFunc func(ctx context.Context, record *ShellAuditRecord)
+}
+
+// ShellAuditRecord describes a command requested by the model and the decision of the policy
+type ShellAuditRecord struct {
+	Command string
+	WorkDir string
+	// Allowed reports whether the command passes the policy
+	Allowed bool
+	// Reason is the reason why the command is denied
+	Reason string
+	// DryRun reports whether the command is not run due to dry-run mode
+	DryRun bool
+}
+
+type ShellInput struct {
+	Command string `json:"command"`
+}
+
+// NewShell creates a shell command tool restricted by the policy
+func NewShell(_ context.Context, cfg *ShellConfig) (*Shell, error) {
+	if cfg == nil {
+		return nil, errors.New("config is required")
+	}
+	policy, err := compileShellPolicy(cfg.Policy)
+	if err != nil {
+		return nil, err
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	desc := "Executes a shell command and returns its output."
+	if policy.workDir != "" {
+		desc += fmt.Sprintf(" Commands run in %s, and paths outside it are not allowed.", policy.workDir)
+	}
+	if policy.dryRun {
+		desc += " Commands are not actually executed in dry-run mode."
+	}
+
+	return &Shell{
+		info: &schema.ToolInfo{
+			Name: "shell_execute",
+			Desc: desc,
+			ParamsOneOf: schema.NewParamsOneOfByOpenAPIV3(&openapi3.Schema{
+				Type: openapi3.TypeObject,
+				Properties: map[string]*openapi3.SchemaRef{
+					"command": {
+						Value: &openapi3.Schema{
+							Type:        openapi3.TypeString,
+							Description: "The shell command to execute.",
+						},
+					},
+				},
+				Required: []string{"command"},
+			}),
+		},
+		operator:  cfg.Operator,
+		policy:    policy,
+		timeout:   timeout,
+		auditFunc: cfg.AuditFunc,
+	}, nil
+}
+
+type Shell struct {
+	info      *schema.ToolInfo
+	operator  Operator
+	policy    *compiledShellPolicy
+	timeout   time.Duration
+	auditFunc func(ctx context.Context, record *ShellAuditRecord)
+}
+
+func (s *Shell) Info(_ context.Context) (*schema.ToolInfo, error) {
+	return s.info, nil
+}
+
+// Execute runs the command if allowed by the policy. Denied commands and failed commands are
+// reported in the result rather than as errors, so that the model is able to correct them.
+func (s *Shell) Execute(ctx context.Context, args *ShellInput) (string, error) {
+	record := &ShellAuditRecord{
+		Command: args.Command,
+		WorkDir: s.policy.workDir,
+		DryRun:  s.policy.dryRun,
+	}
+	if err := s.policy.check(args.Command); err != nil {
+		record.Reason = err.Error()
+	} else {
+		record.Allowed = true
+	}
+	if s.auditFunc != nil {
+		s.auditFunc(ctx, record)
+	}
+
+	if !record.Allowed {
+		return fmt.Sprintf("command denied by policy: %s", record.Reason), nil
+	}
+	if record.DryRun {
+		if record.WorkDir != "" {
+			return fmt.Sprintf("[dry-run] would run in %s: %s", record.WorkDir, args.Command), nil
+		}
+		return fmt.Sprintf("[dry-run] would run: %s", args.Command), nil
+	}
+
+	var (
+		output string
+		err    error
+	)
+	if s.operator != nil {
+		command := args.Command
+		if s.policy.workDir != "" {
+			command = fmt.Sprintf("cd %q && %s", s.policy.workDir, command)
+		}
+		output, err = s.operator.RunCommand(ctx, command)
+	} else {
+		output, err = s.runLocal(ctx, args.Command)
+	}
+	if err != nil {
+		output = strings.TrimRight(output, "\n")
+		if output != "" {
+			output += "\n"
+		}
+		output += err.Error()
+	}
+
+	return s.policy.truncate(output), nil
+}
+
+func (s *Shell) runLocal(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = s.policy.workDir
+	cmd.Env = s.policy.env()
+	// child processes may keep the output open after sh is killed on timeout
+	cmd.WaitDelay = 100 * time.Millisecond
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return output.String(), fmt.Errorf("command timed out after %v", s.timeout)
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return output.String(), fmt.Errorf("command exited with code %d", exitErr.ExitCode())
+	}
+	if err != nil {
+		return output.String(), fmt.Errorf("failed to run command: %w", err)
+	}
+	return output.String(), nil
+}
+
+func (s *Shell) InvokableRun(ctx context.Context, argumentsInJSON string, _ ...tool.Option) (string, error) {
+	args := &ShellInput{}
+	if err := json.Unmarshal([]byte(argumentsInJSON), args); err != nil {
+		return "", fmt.Errorf("extract argument fail: %w", err)
+	}
+
+	return s.Execute(ctx, args)
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commandline
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultScrubEnvPattern matches the names of environment variables which usually hold secrets
+const DefaultScrubEnvPattern = `(?i)(KEY|TOKEN|SECRET|PASSW(OR)?D|CREDENTIAL|AUTH|COOKIE|SESSION)`
+
+// ShellPolicy configures the commands a shell tool is allowed to run.
+// The policy guards against mistakes of the model rather than serving as a security boundary,
+// so run the commands in a sandbox whenever possible.
+type ShellPolicy struct {
+	// AllowCommands are regexes of allowed commands, a command must match one of them if not empty, e.g. `^(ls|cat|grep)\b`.
+	AllowCommands []string
+	// DenyCommands are regexes of denied commands, checked before AllowCommands, e.g. `\brm\s+-rf\b`.
+	DenyCommands []string
+	// WorkDir jails the commands, which run in WorkDir and are not allowed to reference absolute paths
+	// outside WorkDir, parent directories or home directories. No jail if empty.
+	WorkDir string
+	// AllowEnv are the names of environment variables passed to local commands, all variables are passed if empty.
+	AllowEnv []string
+	// ScrubEnv is the regex of names of environment variables removed from local commands,
+	// default: DefaultScrubEnvPattern. Set to "-" to disable scrubbing.
+	ScrubEnv string
+	// MaxOutputBytes limits the size of the output returned to the model, default: 16000.
+	MaxOutputBytes int
+	// DryRun reports what would run instead of running the commands.
+	DryRun bool
+}
+
+type compiledShellPolicy struct {
+	allow    []*regexp.Regexp
+	deny     []*regexp.Regexp
+	workDir  string
+	allowEnv map[string]bool
+	scrubEnv *regexp.Regexp
+	maxBytes int
+	dryRun   bool
+}
+
+func compileShellPolicy(p *ShellPolicy) (*compiledShellPolicy, error) {
+	if p == nil {
+		p = &ShellPolicy{}
+	}
+
+	c := &compiledShellPolicy{
+		maxBytes: p.MaxOutputBytes,
+		dryRun:   p.DryRun,
+	}
+	if c.maxBytes <= 0 {
+		c.maxBytes = MaxResponseLen
+	}
+
+	for _, pattern := range p.AllowCommands {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allow command pattern %q: %w", pattern, err)
+		}
+		c.allow = append(c.allow, re)
+	}
+	for _, pattern := range p.DenyCommands {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deny command pattern %q: %w", pattern, err)
+		}
+		c.deny = append(c.deny, re)
+	}
+
+	if p.WorkDir != "" {
+		workDir, err := filepath.Abs(p.WorkDir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid work dir: %w", err)
+		}
+		c.workDir = filepath.Clean(workDir)
+	}
+
+	if len(p.AllowEnv) > 0 {
+		c.allowEnv = make(map[string]bool, len(p.AllowEnv))
+		for _, name := range p.AllowEnv {
+			c.allowEnv[name] = true
+		}
+	}
+
+	scrub := p.ScrubEnv
+	if scrub == "" {
+		scrub = DefaultScrubEnvPattern
+	}
+	if scrub != "-" {
+		re, err := regexp.Compile(scrub)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scrub env pattern: %w", err)
+		}
+		c.scrubEnv = re
+	}
+
+	return c, nil
+}
+
+// check returns an error describing why the command is not allowed.
+func (c *compiledShellPolicy) check(command string) error {
+	if strings.TrimSpace(command) == "" {
+		return fmt.Errorf("command is empty")
+	}
+
+	for _, re := range c.deny {
+		if re.MatchString(command) {
+			return fmt.Errorf("command matches denied pattern %q", re.String())
+		}
+	}
+
+	if len(c.allow) > 0 {
+		allowed := false
+		for _, re := range c.allow {
+			if re.MatchString(command) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("command does not match any allowed pattern")
+		}
+	}
+
+	if c.workDir != "" {
+		if err := c.checkJail(command); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var shellWordRe = regexp.MustCompile(`[^\s;|&<>()'"=]+`)
+
+// checkJail rejects commands referencing paths outside the work dir.
+func (c *compiledShellPolicy) checkJail(command string) error {
+	for _, word := range shellWordRe.FindAllString(strings.NewReplacer(`'`, " ", `"`, " ").Replace(command), -1) {
+		switch {
+		case strings.HasPrefix(word, "~"):
+			return fmt.Errorf("home directory %q is outside work dir", word)
+		case word == ".." || strings.HasPrefix(word, "../") || strings.HasSuffix(word, "/..") || strings.Contains(word, "/../"):
+			return fmt.Errorf("parent directory %q is outside work dir", word)
+		case strings.HasPrefix(word, "/"):
+			path := filepath.Clean(word)
+			if path != c.workDir && !strings.HasPrefix(path, c.workDir+string(filepath.Separator)) && path != os.DevNull {
+				return fmt.Errorf("path %q is outside work dir", word)
+			}
+		}
+	}
+	return nil
+}
+
+// env returns the environment variables passed to local commands.
+func (c *compiledShellPolicy) env() []string {
+	var env []string
+	for _, kv := range os.Environ() {
+		name := kv
+		if i := strings.Index(kv, "="); i >= 0 {
+			name = kv[:i]
+		}
+		if c.allowEnv != nil && !c.allowEnv[name] {
+			continue
+		}
+		if c.scrubEnv != nil && c.scrubEnv.MatchString(name) {
+			continue
+		}
+		env = append(env, kv)
+	}
+	return env
+}
+
+// truncate keeps the head of output within the max output bytes.
+func (c *compiledShellPolicy) truncate(output string) string {
+	if len(output) <= c.maxBytes {
+		return output
+	}
+	cut := c.maxBytes
+	for cut > 0 && !utf8.RuneStart(output[cut]) {
+		cut--
+	}
+	return output[:cut] + fmt.Sprintf("\n<output clipped, %d of %d bytes shown>", cut, len(output))
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commandline
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShellPolicy(t *testing.T) {
+	_, err := compileShellPolicy(&ShellPolicy{AllowCommands: []string{"("}})
+	assert.Error(t, err)
+	_, err = compileShellPolicy(&ShellPolicy{DenyCommands: []string{"("}})
+	assert.Error(t, err)
+
+	workDir := t.TempDir()
+	p, err := compileShellPolicy(&ShellPolicy{
+		AllowCommands: []string{`^(ls|cat|grep|echo)\b`},
+		DenyCommands:  []string{`\bsecret\b`},
+		WorkDir:       workDir,
+	})
+	assert.NoError(t, err)
+
+	allowed := []string{
+		"ls -la",
+		"cat a.txt | grep foo",
+		"echo hi > /dev/null",
+		"cat " + filepath.Join(workDir, "a.txt"),
+		"cat ./sub/a..b.txt",
+	}
+	for _, c := range allowed {
+		assert.NoError(t, p.check(c), c)
+	}
+
+	denied := []string{
+		"",
+		"rm -rf a",
+		"cat secret",
+		"cat /etc/passwd",
+		"cat ../a.txt",
+		"ls sub/../..",
+		"cat ~/.ssh/id_rsa",
+		"cat '/etc/passwd'",
+		"echo a>/etc/hosts",
+	}
+	for _, c := range denied {
+		assert.Error(t, p.check(c), c)
+	}
+
+	p, err = compileShellPolicy(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, p.check("cat /etc/passwd"))
+	assert.Equal(t, MaxResponseLen, p.maxBytes)
+}
+
+func TestShellPolicy_Env(t *testing.T) {
+	t.Setenv("SHELL_TEST_PLAIN", "1")
+	t.Setenv("SHELL_TEST_API_KEY", "2")
+
+	p, err := compileShellPolicy(&ShellPolicy{})
+	assert.NoError(t, err)
+	env := p.env()
+	assert.Contains(t, env, "SHELL_TEST_PLAIN=1")
+	assert.NotContains(t, env, "SHELL_TEST_API_KEY=2")
+
+	p, err = compileShellPolicy(&ShellPolicy{ScrubEnv: "-"})
+	assert.NoError(t, err)
+	assert.Contains(t, p.env(), "SHELL_TEST_API_KEY=2")
+
+	p, err = compileShellPolicy(&ShellPolicy{AllowEnv: []string{"SHELL_TEST_PLAIN"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SHELL_TEST_PLAIN=1"}, p.env())
+}
+
+func TestShellPolicy_Truncate(t *testing.T) {
+	p, err := compileShellPolicy(&ShellPolicy{MaxOutputBytes: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", p.truncate("abc"))
+	assert.Equal(t, "abcd\n<output clipped, 4 of 6 bytes shown>", p.truncate("abcdef"))
+	assert.Equal(t, "ab\n<output clipped, 2 of 5 bytes shown>", p.truncate("ab你"))
+}
+
+func TestShell(t *testing.T) {
+	ctx := context.Background()
+	workDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(workDir, "a.txt"), []byte("hello"), 0644))
+	t.Setenv("SHELL_TEST_TOKEN", "secret")
+
+	_, err := NewShell(ctx, nil)
+	assert.Error(t, err)
+
+	var records []*ShellAuditRecord
+	sh, err := NewShell(ctx, &ShellConfig{
+		Policy: &ShellPolicy{
+			DenyCommands: []string{`\brm\b`},
+			WorkDir:      workDir,
+		},
+		Timeout: time.Second,
+		AuditFunc: func(_ context.Context, record *ShellAuditRecord) {
+			records = append(records, record)
+		},
+	})
+	assert.NoError(t, err)
+
+	info, err := sh.Info(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "shell_execute", info.Name)
+
+	result, err := sh.InvokableRun(ctx, `{"command": "cat a.txt"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", result)
+
+	result, err = sh.InvokableRun(ctx, `{"command": "echo -n $SHELL_TEST_TOKEN"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "", result)
+
+	result, err = sh.InvokableRun(ctx, `{"command": "cat missing.txt"}`)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "command exited with code 1")
+
+	result, err = sh.InvokableRun(ctx, `{"command": "sleep 5"}`)
+	assert.NoError(t, err)
+	assert.Contains(t, result, "command timed out")
+
+	result, err = sh.InvokableRun(ctx, `{"command": "rm a.txt"}`)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "command denied by policy"))
+	_, err = os.Stat(filepath.Join(workDir, "a.txt"))
+	assert.NoError(t, err)
+
+	assert.Len(t, records, 5)
+	assert.Equal(t, &ShellAuditRecord{Command: "cat a.txt", WorkDir: workDir, Allowed: true}, records[0])
+	assert.False(t, records[4].Allowed)
+	assert.NotEmpty(t, records[4].Reason)
+
+	_, err = sh.InvokableRun(ctx, `{"command": `)
+	assert.Error(t, err)
+}
+
+func TestShell_DryRun(t *testing.T) {
+	ctx := context.Background()
+	op := &shellOperator{}
+	sh, err := NewShell(ctx, &ShellConfig{
+		Operator: op,
+		Policy:   &ShellPolicy{DryRun: true, WorkDir: "/workspace"},
+	})
+	assert.NoError(t, err)
+
+	result, err := sh.Execute(ctx, &ShellInput{Command: "touch a.txt"})
+	assert.NoError(t, err)
+	assert.Equal(t, "[dry-run] would run in /workspace: touch a.txt", result)
+	assert.Empty(t, op.commands)
+}
+
+func TestShell_Operator(t *testing.T) {
+	ctx := context.Background()
+	op := &shellOperator{}
+	sh, err := NewShell(ctx, &ShellConfig{
+		Operator: op,
+		Policy:   &ShellPolicy{WorkDir: "/workspace"},
+	})
+	assert.NoError(t, err)
+
+	result, err := sh.Execute(ctx, &ShellInput{Command: "ls"})
+	assert.NoError(t, err)
+	assert.Equal(t, "output", result)
+	assert.Equal(t, []string{`cd "/workspace" && ls`}, op.commands)
+
+	op.err = errors.New("command execution failed with exit code 2")
+	result, err = sh.Execute(ctx, &ShellInput{Command: "ls"})
+	assert.NoError(t, err)
+	assert.Equal(t, "command execution failed with exit code 2", result)
+}
+
+type shellOperator struct {
+	pyOperator
+	commands []string
+	err      error
+}
+
+func (s *shellOperator) RunCommand(_ context.Context, command string) (string, error) {
+	s.commands = append(s.commands, command)
+	if s.err != nil {
+		return "", s.err
+	}
+	return "output", nil
+}
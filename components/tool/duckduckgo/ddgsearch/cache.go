@@ -17,11 +17,24 @@
 package ddgsearch
 
 import (
+	"context"
+	"encoding/json"
+	"net/url"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 )
 
+// CacheStore is a pluggable store of search results, shared by Search, News, Images and Videos.
+// Implementations should be safe for concurrent use, see NewDiskCache and NewRedisCache.
+type CacheStore interface {
+	// Get returns the cached value of key, ok is false if key is missing or expired.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set caches value of key, which expires after ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
 // janitor is a background task that cleans up expired Cache items
 type janitor struct {
 	interval time.Duration
@@ -109,6 +122,28 @@ func (c *cache) set(key string, value interface{}) {
 	}
 }
 
+// Get implements CacheStore
+func (c *cache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	value, ok := c.get(key)
+	if !ok {
+		return nil, false, nil
+	}
+	b, ok := value.([]byte)
+	return b, ok, nil
+}
+
+// Set implements CacheStore
+func (c *cache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items[key] = &cacheItem{
+		value:      value,
+		expiration: time.Now().Add(ttl),
+	}
+	return nil
+}
+
 func (c *cache) delete(key string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -139,3 +174,60 @@ func (c *cache) deleteExpired() {
 		delete(c.items, k)
 	}
 }
+
+// getCache unmarshals the cached value of key into v, returns false on cache miss.
+// Cache errors are ignored, so that a broken cache never fails searches.
+func (d *DDGS) getCache(ctx context.Context, key string, v interface{}) bool {
+	if d.cache == nil {
+		return false
+	}
+
+	value, ok, err := d.cache.Get(ctx, key)
+	if err != nil || !ok {
+		return false
+	}
+
+	return json.Unmarshal(value, v) == nil
+}
+
+// setCache caches v of key, errors are ignored.
+func (d *DDGS) setCache(ctx context.Context, key string, v interface{}) {
+	if d.cache == nil {
+		return
+	}
+
+	value, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	_ = d.cache.Set(ctx, key, value, d.config.CacheTTL)
+}
+
+// buildCacheKey builds a cache key from search type and normalized query params,
+// so that equivalent searches share the same cache entry.
+func buildCacheKey(searchType string, query string, params url.Values) string {
+	params.Set("q", normalizeQuery(query))
+	return searchType + ":" + params.Encode() // Encode sorts params by key
+}
+
+// normalizeQuery lower-cases the query and collapses whitespaces.
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+// normalizeRegion returns RegionWT for empty region.
+func normalizeRegion(region Region) string {
+	if region == "" {
+		return string(RegionWT)
+	}
+	return string(region)
+}
+
+// normalizeSafeSearch returns SafeSearchModerate, which is the default of DuckDuckGo, for empty safe search.
+func normalizeSafeSearch(safeSearch SafeSearch) string {
+	if safeSearch == "" {
+		return string(SafeSearchModerate)
+	}
+	return string(safeSearch)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ddgsearch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DiskCache is a CacheStore persisting search results as files in a directory,
+// so that cached results survive process restarts.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a DiskCache in dir, which is created if not exists.
+//
+// Example:
+//
+//	store, err := ddgsearch.NewDiskCache(filepath.Join(os.TempDir(), "ddgsearch"))
+//	if err != nil {
+//		return err
+//	}
+//	client, err := ddgsearch.New(&ddgsearch.Config{CacheStore: store, CacheTTL: time.Hour})
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("cache dir is required")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache dir: %w", err)
+	}
+
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get implements CacheStore, expired entries are removed.
+func (c *DiskCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	path := c.path(key)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	// file layout: 8 bytes expiration in unix nanoseconds, followed by value
+	if len(data) < 8 {
+		_ = os.Remove(path)
+		return nil, false, nil
+	}
+
+	expiration := time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	if time.Now().After(expiration) {
+		_ = os.Remove(path)
+		return nil, false, nil
+	}
+
+	return data[8:], true, nil
+}
+
+// Set implements CacheStore, the file is written atomically.
+func (c *DiskCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data[:8], uint64(time.Now().Add(ttl).UnixNano()))
+	copy(data[8:], value)
+
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	return nil
+}
+
+// path returns the file path of key, keys are hashed to be safe file names.
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
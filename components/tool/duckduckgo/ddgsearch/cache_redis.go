@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ddgsearch
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultRedisCachePrefix = "ddgsearch:"
+
+// RedisCache is a CacheStore keeping search results in Redis,
+// so that cached results are shared across processes and survive restarts.
+type RedisCache struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisCache creates a RedisCache, keys are prefixed with prefix, default "ddgsearch:".
+//
+// Example:
+//
+//	store := ddgsearch.NewRedisCache(redis.NewClient(&redis.Options{Addr: "localhost:6379"}), "")
+//	client, err := ddgsearch.New(&ddgsearch.Config{CacheStore: store, CacheTTL: time.Hour})
+func NewRedisCache(client redis.UniversalClient, prefix string) *RedisCache {
+	if prefix == "" {
+		prefix = defaultRedisCachePrefix
+	}
+	return &RedisCache{client: client, prefix: prefix}
+}
+
+// Get implements CacheStore
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set implements CacheStore
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.client.Set(ctx, c.prefix+key, value, ttl).Err()
+}
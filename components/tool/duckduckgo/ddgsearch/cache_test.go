@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ddgsearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDiskCache(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := NewDiskCache(""); err == nil {
+		t.Error("NewDiskCache() expected error for empty dir")
+	}
+
+	c, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDiskCache() error = %v", err)
+	}
+
+	if _, ok, err := c.Get(ctx, "missing"); ok || err != nil {
+		t.Errorf("Get() missing key got ok=%v, err=%v", ok, err)
+	}
+
+	if err = c.Set(ctx, "key", []byte("value"), time.Minute); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	value, ok, err := c.Get(ctx, "key")
+	if err != nil || !ok || string(value) != "value" {
+		t.Errorf("Get() got value=%s, ok=%v, err=%v", value, ok, err)
+	}
+
+	if err = c.Set(ctx, "expired", []byte("value"), -time.Second); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if _, ok, _ = c.Get(ctx, "expired"); ok {
+		t.Error("Get() expected expired key to be missing")
+	}
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	ctx := context.Background()
+	c := newCache(time.Minute)
+
+	if err := c.Set(ctx, "key", []byte("value"), time.Minute); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	value, ok, err := c.Get(ctx, "key")
+	if err != nil || !ok || string(value) != "value" {
+		t.Errorf("Get() got value=%s, ok=%v, err=%v", value, ok, err)
+	}
+}
+
+func TestCacheKeyNormalization(t *testing.T) {
+	a := (&SearchParams{Query: "  Golang   Tutorial "}).getCacheKey()
+	b := (&SearchParams{Query: "golang tutorial", Region: RegionWT, SafeSearch: SafeSearchModerate, Page: 1}).getCacheKey()
+	if a != b {
+		t.Errorf("expected equal cache keys, got %q and %q", a, b)
+	}
+
+	c := (&SearchParams{Query: "golang tutorial", Page: 2}).getCacheKey()
+	if a == c {
+		t.Error("expected different cache keys for different pages")
+	}
+
+	news := (&NewsParams{Query: "golang tutorial"}).getCacheKey()
+	images := (&ImagesParams{Query: "golang tutorial"}).getCacheKey()
+	if news == a || news == images {
+		t.Error("expected different cache keys for different search types")
+	}
+}
+
+func TestDDGS_CacheStore(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`<script type="text/javascript">vqd="12345";</script>`))
+		case "/d.js":
+			w.Write([]byte(`{"results": [{"t": "Test Result 1", "u": "http://example.com/1", "a": "Description 1"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	oldBase, oldSearch := baseURL, searchURL
+	baseURL, searchURL = server.URL, server.URL+"/d.js"
+	defer func() { baseURL, searchURL = oldBase, oldSearch }()
+
+	store, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// clients sharing the same store, as if the process restarted
+	for i := 0; i < 2; i++ {
+		client, err := New(&Config{Timeout: 5 * time.Second, CacheStore: store, CacheTTL: time.Hour})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := client.Search(context.Background(), &SearchParams{Query: "Test Query"})
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(resp.Results) != 1 || resp.Results[0].URL != "http://example.com/1" {
+			t.Errorf("Search() got unexpected results: %+v", resp.Results)
+		}
+	}
+
+	// one vqd request and one search request
+	if requestCount != 2 {
+		t.Errorf("expected 2 requests with cache, got %d", requestCount)
+	}
+}
@@ -35,7 +35,7 @@ type DDGS struct {
 	headers map[string]string
 	proxy   string
 	timeout time.Duration
-	cache   *cache
+	cache   CacheStore
 	config  *Config
 }
 
@@ -66,9 +66,17 @@ type Config struct {
 
 	// Cache enables in-memory caching of search results.
 	// When enabled, identical search requests will return cached results
-	// for improved performance. Cache entries expire after 5 minutes.
+	// for improved performance. Cache entries expire after CacheTTL.
 	Cache bool
 
+	// CacheStore specifies a persistent store of search results, e.g. NewDiskCache or NewRedisCache,
+	// so that cached results survive process restarts. Caching is enabled if provided, regardless of Cache.
+	CacheStore CacheStore
+
+	// CacheTTL specifies the expiration of cached search results.
+	// Default is 5 minutes.
+	CacheTTL time.Duration
+
 	// MaxRetries specifies the maximum number of retry attempts for failed requests.
 	// Default is 3.
 	MaxRetries int
@@ -92,6 +100,10 @@ func New(cfg *Config) (*DDGS, error) {
 		cfg.MaxRetries = 3
 	}
 
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = 5 * time.Minute
+	}
+
 	d := &DDGS{
 		client:  &http.Client{Timeout: cfg.Timeout},
 		headers: cfg.Headers,
@@ -118,8 +130,10 @@ func New(cfg *Config) (*DDGS, error) {
 		}
 	}
 
-	if cfg.Cache {
-		d.cache = newCache(5 * time.Minute) // 5 minutes cache expiration
+	if cfg.CacheStore != nil {
+		d.cache = cfg.CacheStore
+	} else if cfg.Cache {
+		d.cache = newCache(cfg.CacheTTL)
 	}
 
 	return d, nil
@@ -187,10 +201,8 @@ func (d *DDGS) sendRequestWithRetry(ctx context.Context, req *http.Request, para
 
 // getVQD retrieves the VQD token required for search requests
 func (d *DDGS) getVQD(ctx context.Context, query string) (string, error) {
-	endpoint := "https://duckduckgo.com"
-
 	// Create request with query parameter
-	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
@@ -228,3 +240,57 @@ func (d *DDGS) getVQD(ctx context.Context, query string) (string, error) {
 
 	return vqd, nil
 }
+
+// getJSON sends a GET request to a DuckDuckGo json endpoint with retry, and returns the response body
+func (d *DDGS) getJSON(ctx context.Context, endpoint string, queryParams url.Values) ([]byte, error) {
+	var lastErr error
+	for attempt := 0; attempt <= d.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Second * time.Duration(attempt))
+		}
+
+		// Check context cancellation
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.URL.RawQuery = queryParams.Encode()
+
+		for k, v := range d.headers {
+			req.Header.Set(k, v)
+		}
+		if req.Header.Get("User-Agent") == "" {
+			req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Referer", "https://duckduckgo.com/")
+
+		resp, err := d.client.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to send request: %w", err)
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("failed to read response: %w", err)
+			continue
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			return body, nil
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusForbidden:
+			lastErr = ErrRateLimit
+		default:
+			return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, truncateString(string(body), 200))
+		}
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ddgsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const imagesPageSize = 100
+
+// imageTimeRanges maps TimeRange to the time filter of DuckDuckGo image API
+var imageTimeRanges = map[TimeRange]string{
+	TimeRangeDay:   "Day",
+	TimeRangeWeek:  "Week",
+	TimeRangeMonth: "Month",
+	TimeRangeYear:  "Year",
+}
+
+// Images performs a DuckDuckGo image search with the given parameters.
+func (d *DDGS) Images(ctx context.Context, params *ImagesParams) (*ImagesResponse, error) {
+	if params == nil || params.Query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	// Try to get from cache
+	cacheKey := params.getCacheKey()
+	cached := &ImagesResponse{}
+	if d.getCache(ctx, cacheKey, cached) {
+		return cached, nil
+	}
+
+	vqd, err := d.getVQD(ctx, params.Query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vqd: %w", err)
+	}
+
+	// Image API treats moderate as strict
+	safeSearchMap := map[SafeSearch]string{
+		SafeSearchStrict:   "1",
+		SafeSearchModerate: "1",
+		SafeSearchOff:      "-1",
+	}
+
+	region := params.Region
+	if region == "" {
+		region = RegionWT
+	}
+
+	queryParams := url.Values{
+		"l":   {string(region)},
+		"o":   {"json"},
+		"q":   {params.Query},
+		"vqd": {vqd},
+		"f":   {params.buildFilters()},
+	}
+	if p, ok := safeSearchMap[params.SafeSearch]; ok {
+		queryParams.Set("p", p)
+	}
+
+	maxResults := params.MaxResults
+	if maxResults <= 0 {
+		maxResults = imagesPageSize
+	} else if maxResults > 5*imagesPageSize {
+		maxResults = 5 * imagesPageSize
+	}
+
+	var allResults []ImageResult
+	seenImages := make(map[string]bool)
+
+	for offset := 0; offset < maxResults; offset += imagesPageSize {
+		queryParams.Set("s", fmt.Sprintf("%d", offset))
+
+		body, err := d.getJSON(ctx, imagesURL, queryParams)
+		if err != nil {
+			return nil, err
+		}
+
+		var raw rawImagesResponse
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse images response (body: %s): %w", truncateString(string(body), 200), err)
+		}
+
+		for _, r := range raw.Results {
+			if r.Image == "" || seenImages[r.Image] {
+				continue
+			}
+			seenImages[r.Image] = true
+
+			r.URL = normalizeURL(r.URL)
+			allResults = append(allResults, r)
+		}
+
+		if raw.Next == "" || len(raw.Results) == 0 {
+			break
+		}
+	}
+
+	if len(allResults) == 0 {
+		return nil, ErrNoResults
+	}
+
+	if len(allResults) > maxResults {
+		allResults = allResults[:maxResults]
+	}
+
+	response := &ImagesResponse{
+		Results: allResults,
+	}
+	d.setCache(ctx, cacheKey, response)
+
+	return response, nil
+}
+
+// buildFilters builds the "f" query parameter of DuckDuckGo image API,
+// e.g. "time:Week,size:Large,color:,type:photo,layout:,license:Public"
+func (p *ImagesParams) buildFilters() string {
+	return strings.Join([]string{
+		"time:" + imageTimeRanges[p.TimeRange],
+		"size:" + string(p.Size),
+		"color:" + p.Color,
+		"type:" + string(p.Type),
+		"layout:" + string(p.Layout),
+		"license:" + string(p.License),
+	}, ",")
+}
+
+// getCacheKey generates a unique cache key for the image search parameters
+func (p *ImagesParams) getCacheKey() string {
+	v := url.Values{}
+	v.Set("r", normalizeRegion(p.Region))
+	v.Set("s", normalizeSafeSearch(p.SafeSearch))
+	v.Set("f", p.buildFilters())
+	v.Set("m", fmt.Sprintf("%d", p.MaxResults))
+
+	return buildCacheKey("images", p.Query, v)
+}
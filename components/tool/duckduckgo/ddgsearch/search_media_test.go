@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ddgsearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newMediaTestServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *DDGS {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.Write([]byte(`<script type="text/javascript">vqd="12345";</script>`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	oldBase, oldImages, oldVideos := baseURL, imagesURL, videosURL
+	baseURL, imagesURL, videosURL = server.URL, server.URL+"/i.js", server.URL+"/v.js"
+	t.Cleanup(func() {
+		baseURL, imagesURL, videosURL = oldBase, oldImages, oldVideos
+	})
+
+	client, err := New(&Config{Timeout: 5 * time.Second, MaxRetries: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestDDGS_Images(t *testing.T) {
+	client := newMediaTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/i.js" {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("vqd") != "12345" || q.Get("q") != "golang gopher" {
+			t.Errorf("unexpected query: %v", q)
+		}
+		if got := q.Get("f"); got != "time:Week,size:Large,color:,type:photo,layout:,license:Public" {
+			t.Errorf("unexpected filters: %s", got)
+		}
+		if got := q.Get("p"); got != "-1" {
+			t.Errorf("unexpected safe search: %s", got)
+		}
+		w.Write([]byte(`{
+			"results": [
+				{"title": "Gopher 1", "image": "http://example.com/1.png", "thumbnail": "http://example.com/t1.png", "url": "http://example.com/1", "height": 100, "width": 200, "source": "Bing"},
+				{"title": "Gopher 1 dup", "image": "http://example.com/1.png", "url": "http://example.com/1"},
+				{"title": "Gopher 2", "image": "http://example.com/2.png", "url": "http://example.com/2"}
+			],
+			"next": ""
+		}`))
+	})
+
+	_, err := client.Images(context.Background(), &ImagesParams{})
+	if err == nil {
+		t.Error("Images() expected error for empty query")
+	}
+
+	resp, err := client.Images(context.Background(), &ImagesParams{
+		Query:      "golang gopher",
+		SafeSearch: SafeSearchOff,
+		TimeRange:  TimeRangeWeek,
+		Size:       ImageSizeLarge,
+		Type:       ImageTypePhoto,
+		License:    ImageLicensePublic,
+		MaxResults: 10,
+	})
+	if err != nil {
+		t.Fatalf("Images() error = %v", err)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("Images() got %d results, want 2", len(resp.Results))
+	}
+	if r := resp.Results[0]; r.Title != "Gopher 1" || r.Width != 200 || r.Height != 100 || r.Source != "Bing" {
+		t.Errorf("Images() got unexpected result: %+v", r)
+	}
+}
+
+func TestDDGS_Videos(t *testing.T) {
+	client := newMediaTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v.js" {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		if got := q.Get("f"); got != "publishedAfter:m,videoDefinition:,videoDuration:short,videoLicense:" {
+			t.Errorf("unexpected filters: %s", got)
+		}
+		w.Write([]byte(`{
+			"results": [
+				{"content": "https://www.youtube.com/watch?v=1", "title": "Go in 100 seconds", "description": "intro", "duration": "2:10",
+				 "embed_url": "https://www.youtube.com/embed/1", "images": {"large": "http://example.com/l.jpg", "medium": "http://example.com/m.jpg"},
+				 "published": "2024-01-01T00:00:00.0000000", "publisher": "YouTube", "statistics": {"viewCount": 1024}, "uploader": "gopher"},
+				{"content": "https://www.youtube.com/watch?v=2", "title": "Go tour", "images": {"medium": "http://example.com/m2.jpg"}}
+			],
+			"next": "v.js?s=60"
+		}`))
+	})
+
+	resp, err := client.Videos(context.Background(), &VideosParams{
+		Query:      "golang",
+		TimeRange:  TimeRangeMonth,
+		Duration:   VideoDurationShort,
+		MaxResults: 1,
+	})
+	if err != nil {
+		t.Fatalf("Videos() error = %v", err)
+	}
+	if len(resp.Results) != 1 {
+		t.Fatalf("Videos() got %d results, want 1", len(resp.Results))
+	}
+	r := resp.Results[0]
+	if r.URL != "https://www.youtube.com/watch?v=1" || r.Image != "http://example.com/l.jpg" || r.ViewCount != 1024 || r.Publisher != "YouTube" {
+		t.Errorf("Videos() got unexpected result: %+v", r)
+	}
+}
+
+func TestDDGS_MediaRateLimit(t *testing.T) {
+	client := newMediaTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := client.Images(context.Background(), &ImagesParams{Query: "golang"})
+	if !IsRateLimitErr(err) {
+		t.Errorf("Images() error = %v, want rate limit error", err)
+	}
+}
@@ -32,6 +32,13 @@ func (d *DDGS) News(ctx context.Context, params *NewsParams) (*NewsResponse, err
 		return nil, fmt.Errorf("query is required")
 	}
 
+	// Try to get from cache
+	cacheKey := params.getCacheKey()
+	cached := &NewsResponse{}
+	if d.getCache(ctx, cacheKey, cached) {
+		return cached, nil
+	}
+
 	// Get vqd token
 	vqd, err := d.getVQD(ctx, params.Query)
 	if err != nil {
@@ -186,7 +193,21 @@ func (d *DDGS) News(ctx context.Context, params *NewsParams) (*NewsResponse, err
 		allResults = allResults[:maxResults]
 	}
 
-	return &NewsResponse{
+	response := &NewsResponse{
 		Results: allResults,
-	}, nil
+	}
+	d.setCache(ctx, cacheKey, response)
+
+	return response, nil
+}
+
+// getCacheKey generates a unique cache key for the news search parameters
+func (p *NewsParams) getCacheKey() string {
+	v := url.Values{}
+	v.Set("r", normalizeRegion(p.Region))
+	v.Set("s", normalizeSafeSearch(p.SafeSearch))
+	v.Set("t", string(p.TimeRange))
+	v.Set("m", fmt.Sprintf("%d", p.MaxResults))
+
+	return buildCacheKey("news", p.Query, v)
 }
@@ -40,10 +40,12 @@ func (d *DDGS) Search(ctx context.Context, params *SearchParams) (*SearchRespons
 		params.cacheKey = params.getCacheKey()
 
 		// Try to get from cache
-		if cached, ok := d.cache.get(params.cacheKey); ok {
-			if response, ok := cached.(*SearchResponse); ok {
-				return response, nil
+		cached := &SearchResponse{}
+		if d.getCache(ctx, params.cacheKey, cached) {
+			if params.MaxResults > 0 && len(cached.Results) > params.MaxResults {
+				cached.Results = cached.Results[:params.MaxResults]
 			}
+			return cached, nil
 		}
 	}
 
@@ -70,7 +72,7 @@ func (d *DDGS) Search(ctx context.Context, params *SearchParams) (*SearchRespons
 
 	// Cache the response if caching is enabled
 	if d.cache != nil && params.cacheKey != "" {
-		d.cache.set(params.cacheKey, response)
+		d.setCache(ctx, params.cacheKey, response)
 	}
 
 	// max results
@@ -142,13 +144,17 @@ func (p *SearchParams) buildSearchURL(vqd string) string {
 func (p *SearchParams) getCacheKey() string {
 	// Use url.Values to consistently encode parameters
 	v := url.Values{}
-	v.Set("q", p.Query)
-	v.Set("r", string(p.Region))
-	v.Set("s", string(p.SafeSearch))
+	v.Set("r", normalizeRegion(p.Region))
+	v.Set("s", normalizeSafeSearch(p.SafeSearch))
 	v.Set("t", string(p.TimeRange))
-	v.Set("p", strconv.Itoa(p.Page))
+	v.Set("m", strconv.Itoa(p.MaxResults)) // page size depends on max results
+	page := p.Page
+	if page < 1 {
+		page = 1
+	}
+	v.Set("p", strconv.Itoa(page))
 
-	return v.Encode()
+	return buildCacheKey("text", p.Query, v)
 }
 
 // parseSearchResponse parses the search response from DuckDuckGo
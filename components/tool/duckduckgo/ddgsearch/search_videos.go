@@ -0,0 +1,158 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ddgsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const videosPageSize = 60
+
+// Videos performs a DuckDuckGo video search with the given parameters.
+func (d *DDGS) Videos(ctx context.Context, params *VideosParams) (*VideosResponse, error) {
+	if params == nil || params.Query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	// Try to get from cache
+	cacheKey := params.getCacheKey()
+	cached := &VideosResponse{}
+	if d.getCache(ctx, cacheKey, cached) {
+		return cached, nil
+	}
+
+	vqd, err := d.getVQD(ctx, params.Query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vqd: %w", err)
+	}
+
+	safeSearchMap := map[SafeSearch]string{
+		SafeSearchStrict:   "1",
+		SafeSearchModerate: "-1",
+		SafeSearchOff:      "-2",
+	}
+
+	region := params.Region
+	if region == "" {
+		region = RegionWT
+	}
+
+	queryParams := url.Values{
+		"l":   {string(region)},
+		"o":   {"json"},
+		"q":   {params.Query},
+		"vqd": {vqd},
+		"f":   {params.buildFilters()},
+	}
+	if p, ok := safeSearchMap[params.SafeSearch]; ok {
+		queryParams.Set("p", p)
+	}
+
+	maxResults := params.MaxResults
+	if maxResults <= 0 {
+		maxResults = videosPageSize
+	} else if maxResults > 200 {
+		maxResults = 200
+	}
+
+	var allResults []VideoResult
+	seenURLs := make(map[string]bool)
+
+	for offset := 0; offset < maxResults; offset += videosPageSize {
+		queryParams.Set("s", fmt.Sprintf("%d", offset))
+
+		body, err := d.getJSON(ctx, videosURL, queryParams)
+		if err != nil {
+			return nil, err
+		}
+
+		var raw rawVideosResponse
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse videos response (body: %s): %w", truncateString(string(body), 200), err)
+		}
+
+		for _, r := range raw.Results {
+			if r.Content == "" || seenURLs[r.Content] {
+				continue
+			}
+			seenURLs[r.Content] = true
+
+			image := r.Images.Large
+			if image == "" {
+				image = r.Images.Medium
+			}
+
+			allResults = append(allResults, VideoResult{
+				Title:       r.Title,
+				Description: r.Description,
+				URL:         r.Content,
+				EmbedURL:    r.EmbedURL,
+				Duration:    r.Duration,
+				Published:   r.Published,
+				Publisher:   r.Publisher,
+				Uploader:    r.Uploader,
+				Image:       image,
+				ViewCount:   r.Statistics.ViewCount,
+			})
+		}
+
+		if raw.Next == "" || len(raw.Results) == 0 {
+			break
+		}
+	}
+
+	if len(allResults) == 0 {
+		return nil, ErrNoResults
+	}
+
+	if len(allResults) > maxResults {
+		allResults = allResults[:maxResults]
+	}
+
+	response := &VideosResponse{
+		Results: allResults,
+	}
+	d.setCache(ctx, cacheKey, response)
+
+	return response, nil
+}
+
+// buildFilters builds the "f" query parameter of DuckDuckGo video API,
+// e.g. "publishedAfter:w,videoDefinition:high,videoDuration:short,videoLicense:"
+func (p *VideosParams) buildFilters() string {
+	return strings.Join([]string{
+		"publishedAfter:" + string(p.TimeRange),
+		"videoDefinition:" + string(p.Resolution),
+		"videoDuration:" + string(p.Duration),
+		"videoLicense:" + string(p.License),
+	}, ",")
+}
+
+// getCacheKey generates a unique cache key for the video search parameters
+func (p *VideosParams) getCacheKey() string {
+	v := url.Values{}
+	v.Set("r", normalizeRegion(p.Region))
+	v.Set("s", normalizeSafeSearch(p.SafeSearch))
+	v.Set("f", p.buildFilters())
+	v.Set("m", fmt.Sprintf("%d", p.MaxResults))
+
+	return buildCacheKey("videos", p.Query, v)
+}
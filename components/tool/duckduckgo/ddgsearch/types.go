@@ -21,6 +21,8 @@ var (
 	baseURL   = "https://duckduckgo.com"
 	searchURL = "https://links.duckduckgo.com/d.js"
 	newsURL   = "https://duckduckgo.com/news.js"
+	imagesURL = "https://duckduckgo.com/i.js"
+	videosURL = "https://duckduckgo.com/v.js"
 )
 
 // Region represents a geographical region for search results.
@@ -227,3 +229,203 @@ type rawNewsResponse struct {
 	ResponseType  string `json:"response_type"`  // Type of response
 	QueryCategory string `json:"query_category"` // Category of query
 }
+
+// ImageSize filters image results by size.
+type ImageSize string
+
+const (
+	ImageSizeSmall     ImageSize = "Small"
+	ImageSizeMedium    ImageSize = "Medium"
+	ImageSizeLarge     ImageSize = "Large"
+	ImageSizeWallpaper ImageSize = "Wallpaper"
+)
+
+// ImageType filters image results by type.
+type ImageType string
+
+const (
+	ImageTypePhoto       ImageType = "photo"
+	ImageTypeClipart     ImageType = "clipart"
+	ImageTypeGif         ImageType = "gif"
+	ImageTypeTransparent ImageType = "transparent"
+	ImageTypeLine        ImageType = "line"
+)
+
+// ImageLayout filters image results by layout.
+type ImageLayout string
+
+const (
+	ImageLayoutSquare ImageLayout = "Square"
+	ImageLayoutTall   ImageLayout = "Tall"
+	ImageLayoutWide   ImageLayout = "Wide"
+)
+
+// ImageLicense filters image results by license.
+type ImageLicense string
+
+const (
+	// ImageLicenseAny includes all Creative Commons licenses
+	ImageLicenseAny ImageLicense = "any"
+	// ImageLicensePublic includes public domain images
+	ImageLicensePublic ImageLicense = "Public"
+	// ImageLicenseShare includes images free to share and use
+	ImageLicenseShare ImageLicense = "Share"
+	// ImageLicenseShareCommercially includes images free to share and use commercially
+	ImageLicenseShareCommercially ImageLicense = "ShareCommercially"
+	// ImageLicenseModify includes images free to modify, share and use
+	ImageLicenseModify ImageLicense = "Modify"
+	// ImageLicenseModifyCommercially includes images free to modify, share and use commercially
+	ImageLicenseModifyCommercially ImageLicense = "ModifyCommercially"
+)
+
+// ImagesParams configures the image search behavior.
+type ImagesParams struct {
+	// Query is the search term or phrase
+	Query string `json:"query"`
+
+	// Region specifies the geographical region for results
+	Region Region `json:"region"`
+
+	// SafeSearch controls filtering of explicit content
+	SafeSearch SafeSearch `json:"safe_search"`
+
+	// TimeRange limits results to a specific time period, TimeRangeYear is the widest supported
+	TimeRange TimeRange `json:"time_range"`
+
+	// Size filters images by size, empty for all sizes
+	Size ImageSize `json:"size"`
+
+	// Color filters images by color, e.g. "color", "Monochrome", "Red", "Blue", empty for all colors
+	Color string `json:"color"`
+
+	// Type filters images by type, empty for all types
+	Type ImageType `json:"type"`
+
+	// Layout filters images by layout, empty for all layouts
+	Layout ImageLayout `json:"layout"`
+
+	// License filters images by license, empty for all licenses
+	License ImageLicense `json:"license"`
+
+	// MaxResults limits the number of results returned, default: 100, max: 500
+	MaxResults int `json:"max_results"`
+}
+
+// ImageResult represents a single image search result from DuckDuckGo.
+type ImageResult struct {
+	Title     string `json:"title"`     // Image title
+	Image     string `json:"image"`     // URL of the original image
+	Thumbnail string `json:"thumbnail"` // URL of the image thumbnail
+	URL       string `json:"url"`       // URL of the page containing the image
+	Height    int    `json:"height"`    // Image height in pixels
+	Width     int    `json:"width"`     // Image width in pixels
+	Source    string `json:"source"`    // Image source, e.g. "Bing"
+}
+
+// ImagesResponse represents the complete response from an image search request.
+type ImagesResponse struct {
+	Results []ImageResult `json:"results"` // List of image results
+}
+
+// rawImagesResponse represents the raw response from DuckDuckGo image API.
+type rawImagesResponse struct {
+	Results []ImageResult `json:"results"`
+	Next    string        `json:"next"` // Path of next page, empty if no more results
+}
+
+// VideoDuration filters video results by duration.
+type VideoDuration string
+
+const (
+	// VideoDurationShort includes videos shorter than 4 minutes
+	VideoDurationShort VideoDuration = "short"
+	// VideoDurationMedium includes videos between 4 and 20 minutes
+	VideoDurationMedium VideoDuration = "medium"
+	// VideoDurationLong includes videos longer than 20 minutes
+	VideoDurationLong VideoDuration = "long"
+)
+
+// VideoResolution filters video results by resolution.
+type VideoResolution string
+
+const (
+	VideoResolutionHigh     VideoResolution = "high"
+	VideoResolutionStandard VideoResolution = "standard"
+)
+
+// VideoLicense filters video results by license.
+type VideoLicense string
+
+const (
+	VideoLicenseCreativeCommon VideoLicense = "creativeCommon"
+	VideoLicenseYoutube        VideoLicense = "youtube"
+)
+
+// VideosParams configures the video search behavior.
+type VideosParams struct {
+	// Query is the search term or phrase
+	Query string `json:"query"`
+
+	// Region specifies the geographical region for results
+	Region Region `json:"region"`
+
+	// SafeSearch controls filtering of explicit content
+	SafeSearch SafeSearch `json:"safe_search"`
+
+	// TimeRange limits results to a specific time period, TimeRangeYear is not supported
+	TimeRange TimeRange `json:"time_range"`
+
+	// Resolution filters videos by resolution, empty for all resolutions
+	Resolution VideoResolution `json:"resolution"`
+
+	// Duration filters videos by duration, empty for all durations
+	Duration VideoDuration `json:"duration"`
+
+	// License filters videos by license, empty for all licenses
+	License VideoLicense `json:"license"`
+
+	// MaxResults limits the number of results returned, default: 60, max: 200
+	MaxResults int `json:"max_results"`
+}
+
+// VideoResult represents a single video search result from DuckDuckGo.
+type VideoResult struct {
+	Title       string `json:"title"`       // Video title
+	Description string `json:"description"` // Video description
+	URL         string `json:"url"`         // Video page URL
+	EmbedURL    string `json:"embed_url"`   // URL for embedding the video
+	Duration    string `json:"duration"`    // Video duration, e.g. "5:20"
+	Published   string `json:"published"`   // Publish time in ISO8601
+	Publisher   string `json:"publisher"`   // Publisher name, e.g. "YouTube"
+	Uploader    string `json:"uploader"`    // Uploader name
+	Image       string `json:"image"`       // URL of the video cover image
+	ViewCount   int64  `json:"view_count"`  // Number of views
+}
+
+// VideosResponse represents the complete response from a video search request.
+type VideosResponse struct {
+	Results []VideoResult `json:"results"` // List of video results
+}
+
+// rawVideosResponse represents the raw response from DuckDuckGo video API.
+type rawVideosResponse struct {
+	Results []struct {
+		Content     string `json:"content"`
+		Description string `json:"description"`
+		Duration    string `json:"duration"`
+		EmbedURL    string `json:"embed_url"`
+		Images      struct {
+			Large  string `json:"large"`
+			Medium string `json:"medium"`
+			Small  string `json:"small"`
+		} `json:"images"`
+		Published  string `json:"published"`
+		Publisher  string `json:"publisher"`
+		Statistics struct {
+			ViewCount int64 `json:"viewCount"`
+		} `json:"statistics"`
+		Title    string `json:"title"`
+		Uploader string `json:"uploader"`
+	} `json:"results"`
+	Next string `json:"next"` // Path of next page, empty if no more results
+}
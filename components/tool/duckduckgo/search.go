@@ -123,15 +123,30 @@ type ddgs struct {
 	ddg    *ddgsearch.DDGS
 }
 
+// SearchType is the type of search requested by the agent.
+type SearchType string
+
+const (
+	SearchTypeText   SearchType = "text"
+	SearchTypeNews   SearchType = "news"
+	SearchTypeImages SearchType = "images"
+	SearchTypeVideos SearchType = "videos"
+)
+
 type SearchRequest struct {
-	Query string `json:"query" jsonschema_description:"The query to search the web for"`
-	Page  int    `json:"page" jsonschema_description:"The page number to search for, default: 1"`
+	Query      string     `json:"query" jsonschema_description:"The query to search the web for"`
+	Page       int        `json:"page" jsonschema_description:"The page number to search for, default: 1, only for text search"`
+	SearchType SearchType `json:"search_type,omitempty" jsonschema:"enum=text,enum=news,enum=images,enum=videos" jsonschema_description:"The type of search, use news for recent events, default: text"`
+	TimeRange  string     `json:"time_range,omitempty" jsonschema:"enum=d,enum=w,enum=m,enum=y" jsonschema_description:"Limit results to the past day(d), week(w), month(m) or year(y), default: no limit"`
 }
 
 type SearchResult struct {
 	Title       string `json:"title" jsonschema_description:"The title of the search result"`
 	Description string `json:"description" jsonschema_description:"The description of the search result"`
 	Link        string `json:"link" jsonschema_description:"The link of the search result"`
+	Date        string `json:"date,omitempty" jsonschema_description:"The publish date of the news or video"`
+	Source      string `json:"source,omitempty" jsonschema_description:"The source of the news, image or video"`
+	Image       string `json:"image,omitempty" jsonschema_description:"The image link of the result"`
 }
 
 type SearchResponse struct {
@@ -139,13 +154,118 @@ type SearchResponse struct {
 }
 
 func (d *ddgs) Search(ctx context.Context, request *SearchRequest) (*SearchResponse, error) {
+	timeRange := d.config.TimeRange
+	if request.TimeRange != "" {
+		timeRange = ddgsearch.TimeRange(request.TimeRange)
+	}
+
+	switch request.SearchType {
+	case "", SearchTypeText:
+		return d.searchText(ctx, request, timeRange)
+	case SearchTypeNews:
+		return d.searchNews(ctx, request, timeRange)
+	case SearchTypeImages:
+		return d.searchImages(ctx, request, timeRange)
+	case SearchTypeVideos:
+		return d.searchVideos(ctx, request, timeRange)
+	default:
+		return nil, fmt.Errorf("unknown search type: %s", request.SearchType)
+	}
+}
+
+func (d *ddgs) searchText(ctx context.Context, request *SearchRequest, timeRange ddgsearch.TimeRange) (*SearchResponse, error) {
 	results, err := d.ddg.Search(ctx, &ddgsearch.SearchParams{
 		Query:      request.Query,
 		Region:     ddgsearch.Region(d.config.Region),
 		MaxResults: d.config.MaxResults,
 		Page:       request.Page,
 		SafeSearch: d.config.SafeSearch,
-		TimeRange:  d.config.TimeRange,
+		TimeRange:  timeRange,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	searchResponse := &SearchResponse{
+		Results: make([]*SearchResult, len(results.Results)),
+	}
+
+	for i, result := range results.Results {
+		searchResponse.Results[i] = &SearchResult{
+			Title:       result.Title,
+			Description: result.Description,
+			Link:        result.URL,
+		}
+	}
+
+	return searchResponse, nil
+}
+
+func (d *ddgs) searchNews(ctx context.Context, request *SearchRequest, timeRange ddgsearch.TimeRange) (*SearchResponse, error) {
+	results, err := d.ddg.News(ctx, &ddgsearch.NewsParams{
+		Query:      request.Query,
+		Region:     d.config.Region,
+		MaxResults: d.config.MaxResults,
+		SafeSearch: d.config.SafeSearch,
+		TimeRange:  timeRange,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	searchResponse := &SearchResponse{
+		Results: make([]*SearchResult, len(results.Results)),
+	}
+
+	for i, result := range results.Results {
+		searchResponse.Results[i] = &SearchResult{
+			Title:       result.Title,
+			Description: result.Body,
+			Link:        result.URL,
+			Date:        result.Date,
+			Source:      result.Source,
+			Image:       result.Image,
+		}
+	}
+
+	return searchResponse, nil
+}
+
+func (d *ddgs) searchImages(ctx context.Context, request *SearchRequest, timeRange ddgsearch.TimeRange) (*SearchResponse, error) {
+	results, err := d.ddg.Images(ctx, &ddgsearch.ImagesParams{
+		Query:      request.Query,
+		Region:     d.config.Region,
+		MaxResults: d.config.MaxResults,
+		SafeSearch: d.config.SafeSearch,
+		TimeRange:  timeRange,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	searchResponse := &SearchResponse{
+		Results: make([]*SearchResult, len(results.Results)),
+	}
+
+	for i, result := range results.Results {
+		searchResponse.Results[i] = &SearchResult{
+			Title:  result.Title,
+			Link:   result.URL,
+			Source: result.Source,
+			Image:  result.Image,
+		}
+	}
+
+	return searchResponse, nil
+}
+
+func (d *ddgs) searchVideos(ctx context.Context, request *SearchRequest, timeRange ddgsearch.TimeRange) (*SearchResponse, error) {
+	results, err := d.ddg.Videos(ctx, &ddgsearch.VideosParams{
+		Query:      request.Query,
+		Region:     d.config.Region,
+		MaxResults: d.config.MaxResults,
+		SafeSearch: d.config.SafeSearch,
+		TimeRange:  timeRange,
 	})
 	if err != nil {
 		return nil, err
@@ -160,6 +280,9 @@ func (d *ddgs) Search(ctx context.Context, request *SearchRequest) (*SearchRespo
 			Title:       result.Title,
 			Description: result.Description,
 			Link:        result.URL,
+			Date:        result.Published,
+			Source:      result.Publisher,
+			Image:       result.Image,
 		}
 	}
 
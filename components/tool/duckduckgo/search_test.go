@@ -267,3 +267,46 @@ func TestNewTool(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, tool)
 }
+
+func TestDDGS_SearchType(t *testing.T) {
+	ctx := context.Background()
+	ddgs, err := newDDGS(ctx, &Config{MaxResults: 5, TimeRange: ddgsearch.TimeRangeMonth})
+	assert.NoError(t, err)
+
+	mockey.PatchConvey("search type", t, func() {
+		mockey.Mock((*ddgsearch.DDGS).News).To(func(ctx context.Context, params *ddgsearch.NewsParams) (*ddgsearch.NewsResponse, error) {
+			assert.Equal(t, ddgsearch.TimeRangeDay, params.TimeRange)
+			return &ddgsearch.NewsResponse{Results: []ddgsearch.NewsResult{
+				{Title: "news", Body: "news body", URL: "news link", Date: "2025-01-01T00:00:00Z", Source: "source"},
+			}}, nil
+		}).Build()
+		mockey.Mock((*ddgsearch.DDGS).Images).To(func(ctx context.Context, params *ddgsearch.ImagesParams) (*ddgsearch.ImagesResponse, error) {
+			assert.Equal(t, ddgsearch.TimeRangeMonth, params.TimeRange)
+			return &ddgsearch.ImagesResponse{Results: []ddgsearch.ImageResult{
+				{Title: "image", URL: "image page", Image: "image link", Source: "Bing"},
+			}}, nil
+		}).Build()
+		mockey.Mock((*ddgsearch.DDGS).Videos).To(func(ctx context.Context, params *ddgsearch.VideosParams) (*ddgsearch.VideosResponse, error) {
+			return &ddgsearch.VideosResponse{Results: []ddgsearch.VideoResult{
+				{Title: "video", Description: "video desc", URL: "video link", Published: "2025-01-01", Publisher: "YouTube"},
+			}}, nil
+		}).Build()
+
+		resp, err := ddgs.Search(ctx, &SearchRequest{Query: "q", SearchType: SearchTypeNews, TimeRange: "d"})
+		assert.NoError(t, err)
+		assert.Equal(t, &SearchResult{Title: "news", Description: "news body", Link: "news link",
+			Date: "2025-01-01T00:00:00Z", Source: "source"}, resp.Results[0])
+
+		resp, err = ddgs.Search(ctx, &SearchRequest{Query: "q", SearchType: SearchTypeImages})
+		assert.NoError(t, err)
+		assert.Equal(t, &SearchResult{Title: "image", Link: "image page", Image: "image link", Source: "Bing"}, resp.Results[0])
+
+		resp, err = ddgs.Search(ctx, &SearchRequest{Query: "q", SearchType: SearchTypeVideos})
+		assert.NoError(t, err)
+		assert.Equal(t, "video link", resp.Results[0].Link)
+		assert.Equal(t, "YouTube", resp.Results[0].Source)
+
+		_, err = ddgs.Search(ctx, &SearchRequest{Query: "q", SearchType: "unknown"})
+		assert.Error(t, err)
+	})
+}
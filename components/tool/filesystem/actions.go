@@ -0,0 +1,313 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// errStopWalk stops walking the directory when max entries are reached
+var errStopWalk = errors.New("stop walk")
+
+type FileInfo struct {
+	Path    string `json:"path" jsonschema_description:"The path relative to the base dir"`
+	IsDir   bool   `json:"is_dir" jsonschema_description:"Whether the path is a directory"`
+	Size    int64  `json:"size" jsonschema_description:"The size of the file in bytes"`
+	Mode    string `json:"mode" jsonschema_description:"The file mode, e.g. -rw-r--r--"`
+	ModTime string `json:"mod_time" jsonschema_description:"The modification time in RFC3339 format"`
+}
+
+func (f *fileSystem) fileInfo(abs string, info fs.FileInfo) *FileInfo {
+	return &FileInfo{
+		Path:    f.rel(abs),
+		IsDir:   info.IsDir(),
+		Size:    info.Size(),
+		Mode:    info.Mode().String(),
+		ModTime: info.ModTime().Format(time.RFC3339),
+	}
+}
+
+type ReadFileRequest struct {
+	Path   string `json:"path" jsonschema_description:"The path of the file relative to the base dir"`
+	Offset int    `json:"offset,omitempty" jsonschema_description:"The line number to start reading from, 1-based, default: 1"`
+	Limit  int    `json:"limit,omitempty" jsonschema_description:"The number of lines to read, default: all lines"`
+}
+
+type ReadFileResponse struct {
+	Content    string `json:"content" jsonschema_description:"The content of the file"`
+	TotalLines int    `json:"total_lines" jsonschema_description:"The total number of lines of the file"`
+	Truncated  bool   `json:"truncated,omitempty" jsonschema_description:"Whether the content is truncated due to the size limit, read with offset and limit to get the rest"`
+}
+
+// ReadFile reads the content of a text file.
+func (f *fileSystem) ReadFile(ctx context.Context, req *ReadFileRequest) (*ReadFileResponse, error) {
+	abs, err := f.resolve(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	head := data
+	if len(head) > 8000 {
+		head = head[:8000]
+	}
+	if bytes.IndexByte(head, 0) >= 0 {
+		return nil, fmt.Errorf("file %q is binary", req.Path)
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	resp := &ReadFileResponse{TotalLines: len(lines)}
+
+	start := 0
+	if req.Offset > 1 {
+		start = req.Offset - 1
+	}
+	if start > len(lines) {
+		start = len(lines)
+	}
+	end := len(lines)
+	if req.Limit > 0 && start+req.Limit < end {
+		end = start + req.Limit
+	}
+
+	content := strings.Join(lines[start:end], "")
+	if len(content) > f.config.MaxReadBytes {
+		cut := f.config.MaxReadBytes
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		content = content[:cut]
+		resp.Truncated = true
+	}
+	resp.Content = content
+
+	return resp, nil
+}
+
+type WriteFileRequest struct {
+	Path    string `json:"path" jsonschema_description:"The path of the file relative to the base dir"`
+	Content string `json:"content" jsonschema_description:"The content to write"`
+	Append  bool   `json:"append,omitempty" jsonschema_description:"Whether to append to the file instead of overwriting it"`
+}
+
+type WriteFileResponse struct {
+	Path         string `json:"path" jsonschema_description:"The path of the file relative to the base dir"`
+	BytesWritten int    `json:"bytes_written" jsonschema_description:"The number of bytes written"`
+}
+
+// WriteFile writes content to a file, creating parent directories if needed.
+func (f *fileSystem) WriteFile(ctx context.Context, req *WriteFileRequest) (*WriteFileResponse, error) {
+	if f.config.ReadOnly {
+		return nil, errors.New("filesystem is read-only")
+	}
+
+	abs, err := f.resolve(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if abs == f.baseDir {
+		return nil, errors.New("path is required")
+	}
+
+	size := len(req.Content)
+	if req.Append {
+		if info, err := os.Stat(abs); err == nil {
+			size += int(info.Size())
+		}
+	}
+	if size > f.config.MaxWriteBytes {
+		return nil, fmt.Errorf("file size %d exceeds the limit of %d bytes", size, f.config.MaxWriteBytes)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(abs), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create parent directory: %w", err)
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if req.Append {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+	file, err := os.OpenFile(abs, flag, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	n, err := file.WriteString(req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return &WriteFileResponse{Path: f.rel(abs), BytesWritten: n}, nil
+}
+
+type ListDirRequest struct {
+	Path      string `json:"path,omitempty" jsonschema_description:"The path of the directory relative to the base dir, default: the base dir"`
+	Recursive bool   `json:"recursive,omitempty" jsonschema_description:"Whether to list the subdirectories recursively"`
+}
+
+type ListDirResponse struct {
+	Entries   []*FileInfo `json:"entries" jsonschema_description:"The files and directories"`
+	Truncated bool        `json:"truncated,omitempty" jsonschema_description:"Whether the entries are truncated due to the entry limit"`
+}
+
+// ListDir lists the files and directories in a directory.
+func (f *fileSystem) ListDir(ctx context.Context, req *ListDirRequest) (*ListDirResponse, error) {
+	abs, err := f.resolve(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListDirResponse{Entries: make([]*FileInfo, 0)}
+
+	if !req.Recursive {
+		entries, err := os.ReadDir(abs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dir: %w", err)
+		}
+		for _, entry := range entries {
+			if len(resp.Entries) >= f.config.MaxEntries {
+				resp.Truncated = true
+				break
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			resp.Entries = append(resp.Entries, f.fileInfo(filepath.Join(abs, entry.Name()), info))
+		}
+		return resp, nil
+	}
+
+	err = filepath.WalkDir(abs, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if path == abs {
+			return nil
+		}
+		if len(resp.Entries) >= f.config.MaxEntries {
+			resp.Truncated = true
+			return errStopWalk
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		resp.Entries = append(resp.Entries, f.fileInfo(path, info))
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopWalk) {
+		return nil, fmt.Errorf("failed to list dir: %w", err)
+	}
+
+	return resp, nil
+}
+
+type GlobRequest struct {
+	Pattern string `json:"pattern" jsonschema_description:"The glob pattern relative to the base dir, supporting *, ?, ** for any directories, [abc] and {a,b}, e.g. \"src/**/*.{go,md}\""`
+}
+
+type GlobResponse struct {
+	Paths     []string `json:"paths" jsonschema_description:"The matched file paths relative to the base dir"`
+	Truncated bool     `json:"truncated,omitempty" jsonschema_description:"Whether the paths are truncated due to the entry limit"`
+}
+
+// Glob finds the files matching the glob pattern.
+func (f *fileSystem) Glob(ctx context.Context, req *GlobRequest) (*GlobResponse, error) {
+	if req.Pattern == "" {
+		return nil, errors.New("pattern is required")
+	}
+	if filepath.IsAbs(req.Pattern) {
+		rel, err := filepath.Rel(f.baseDir, req.Pattern)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return nil, fmt.Errorf("pattern %q is outside the base dir", req.Pattern)
+		}
+		req.Pattern = rel
+	}
+	re, err := compileGlob(req.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GlobResponse{Paths: make([]string, 0)}
+	err = filepath.WalkDir(f.baseDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel := f.rel(path)
+		if !re.MatchString(rel) {
+			return nil
+		}
+		if len(resp.Paths) >= f.config.MaxEntries {
+			resp.Truncated = true
+			return errStopWalk
+		}
+		resp.Paths = append(resp.Paths, rel)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopWalk) {
+		return nil, fmt.Errorf("failed to glob: %w", err)
+	}
+	sort.Strings(resp.Paths)
+
+	return resp, nil
+}
+
+type StatRequest struct {
+	Path string `json:"path" jsonschema_description:"The path of the file or directory relative to the base dir"`
+}
+
+// Stat gets the information of a file or directory.
+func (f *fileSystem) Stat(ctx context.Context, req *StatRequest) (*FileInfo, error) {
+	abs, err := f.resolve(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(abs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat: %w", err)
+	}
+
+	return f.fileInfo(abs, info), nil
+}
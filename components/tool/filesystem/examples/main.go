@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/tool"
+
+	"github.com/cloudwego/eino-ext/components/tool/filesystem"
+)
+
+func main() {
+	ctx := context.Background()
+
+	baseDir, err := os.MkdirTemp("", "filesystem-example")
+	if err != nil {
+		log.Fatalf("MkdirTemp failed, err=%v", err)
+	}
+	defer os.RemoveAll(baseDir)
+
+	tools, err := filesystem.NewTools(ctx, &filesystem.Config{
+		BaseDir:      baseDir,
+		MaxReadBytes: 64 * 1024,
+	})
+	if err != nil {
+		log.Fatalf("NewTools of filesystem failed, err=%v", err)
+	}
+
+	invokable := make(map[string]tool.InvokableTool, len(tools))
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			log.Fatalf("Info of tool failed, err=%v", err)
+		}
+		invokable[info.Name] = t.(tool.InvokableTool)
+	}
+
+	calls := []struct {
+		name string
+		args string
+	}{
+		{"write_file", `{"path": "notes/todo.md", "content": "- write docs\n- add tests\n"}`},
+		{"write_file", `{"path": "notes/todo.md", "content": "- release\n", "append": true}`},
+		{"read_file", `{"path": "notes/todo.md", "offset": 2, "limit": 2}`},
+		{"list_dir", `{"recursive": true}`},
+		{"glob", `{"pattern": "**/*.md"}`},
+		{"stat", `{"path": "notes"}`},
+		{"read_file", `{"path": "../etc/passwd"}`}, // rejected, outside the base dir
+	}
+
+	for _, call := range calls {
+		resp, err := invokable[call.name].InvokableRun(ctx, call.args)
+		if err != nil {
+			fmt.Printf("%s failed: %v\n", call.name, err)
+			continue
+		}
+		fmt.Printf("%s: %s\n", call.name, resp)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+// Config represents the filesystem tools configuration.
+type Config struct {
+	// BaseDir is the root directory of the tools, required.
+	// All paths are resolved relative to BaseDir, and paths escaping BaseDir, including through symlinks, are rejected.
+	BaseDir string `json:"base_dir"`
+
+	// ReadOnly disables the write_file tool.
+	// Optional, default: false
+	ReadOnly bool `json:"read_only"`
+
+	// MaxReadBytes specifies the maximum bytes returned by read_file, longer content is truncated.
+	// Optional, default: 256KB
+	MaxReadBytes int `json:"max_read_bytes"`
+
+	// MaxWriteBytes specifies the maximum size of a file written by write_file.
+	// Optional, default: 1MB
+	MaxWriteBytes int `json:"max_write_bytes"`
+
+	// MaxEntries specifies the maximum entries returned by list_dir and glob.
+	// Optional, default: 1000
+	MaxEntries int `json:"max_entries"`
+}
+
+// validate validates the filesystem tools configuration.
+func (c *Config) validate() error {
+	if c.BaseDir == "" {
+		return errors.New("filesystem tool config is missing base dir")
+	}
+
+	if c.MaxReadBytes <= 0 {
+		c.MaxReadBytes = 256 * 1024
+	}
+
+	if c.MaxWriteBytes <= 0 {
+		c.MaxWriteBytes = 1024 * 1024
+	}
+
+	if c.MaxEntries <= 0 {
+		c.MaxEntries = 1000
+	}
+
+	return nil
+}
+
+// NewTools creates the filesystem tools: read_file, write_file, list_dir, glob and stat.
+// write_file is omitted in read-only mode.
+func NewTools(ctx context.Context, config *Config) ([]tool.BaseTool, error) {
+	fs, err := newFileSystem(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create filesystem tools: %w", err)
+	}
+
+	readTool, err := utils.InferTool("read_file", "read the content of a text file, optionally a range of lines", fs.ReadFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool read_file: %w", err)
+	}
+
+	listTool, err := utils.InferTool("list_dir", "list the files and directories in a directory", fs.ListDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool list_dir: %w", err)
+	}
+
+	globTool, err := utils.InferTool("glob", "find files by glob pattern, e.g. \"**/*.go\"", fs.Glob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool glob: %w", err)
+	}
+
+	statTool, err := utils.InferTool("stat", "get the size, type and modification time of a file or directory", fs.Stat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool stat: %w", err)
+	}
+
+	tools := []tool.BaseTool{readTool, listTool, globTool, statTool}
+
+	if !config.ReadOnly {
+		writeTool, err := utils.InferTool("write_file", "write content to a file, creating parent directories if needed", fs.WriteFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer tool write_file: %w", err)
+		}
+		tools = append(tools, writeTool)
+	}
+
+	return tools, nil
+}
+
+// fileSystem implements the filesystem tools rooted at the base dir.
+type fileSystem struct {
+	config  *Config
+	baseDir string
+}
+
+// newFileSystem creates a new filesystem rooted at the base dir.
+func newFileSystem(config *Config) (*fileSystem, error) {
+	if config == nil {
+		return nil, errors.New("filesystem tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	baseDir, err := filepath.Abs(config.BaseDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base dir: %w", err)
+	}
+	baseDir, err = filepath.EvalSymlinks(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base dir: %w", err)
+	}
+	info, err := os.Stat(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base dir: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("base dir is not a directory: %s", config.BaseDir)
+	}
+
+	return &fileSystem{config: config, baseDir: baseDir}, nil
+}
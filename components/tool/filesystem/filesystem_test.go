@@ -0,0 +1,233 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFileSystem(t *testing.T, config *Config) *fileSystem {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# readme\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("line1\nline2\nline3\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "pkg", "util.go"), []byte("package pkg\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "pkg", "data.bin"), []byte{0x1, 0x0, 0x2}, 0o644))
+
+	if config == nil {
+		config = &Config{}
+	}
+	config.BaseDir = dir
+	fs, err := newFileSystem(config)
+	require.NoError(t, err)
+	return fs
+}
+
+func TestNewTools(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewTools(ctx, nil)
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{})
+	assert.Error(t, err)
+
+	tools, err := NewTools(ctx, &Config{BaseDir: t.TempDir()})
+	require.NoError(t, err)
+	assert.Len(t, tools, 5)
+
+	tools, err = NewTools(ctx, &Config{BaseDir: t.TempDir(), ReadOnly: true})
+	require.NoError(t, err)
+	assert.Len(t, tools, 4)
+	for _, tl := range tools {
+		info, err := tl.Info(ctx)
+		require.NoError(t, err)
+		assert.NotEqual(t, "write_file", info.Name)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	fs := newTestFileSystem(t, nil)
+
+	p, err := fs.resolve("src/main.go")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(fs.baseDir, "src", "main.go"), p)
+
+	p, err = fs.resolve("src/../README.md")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(fs.baseDir, "README.md"), p)
+
+	p, err = fs.resolve("new/dir/file.txt")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(fs.baseDir, "new", "dir", "file.txt"), p)
+
+	p, err = fs.resolve(filepath.Join(fs.baseDir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(fs.baseDir, "README.md"), p)
+
+	for _, path := range []string{"../outside", "src/../../outside", "/etc/passwd"} {
+		_, err = fs.resolve(path)
+		assert.Error(t, err, path)
+	}
+
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(fs.baseDir, "link")))
+	_, err = fs.resolve("link/secret")
+	assert.Error(t, err)
+}
+
+func TestReadFile(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestFileSystem(t, nil)
+
+	resp, err := fs.ReadFile(ctx, &ReadFileRequest{Path: "src/main.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "line1\nline2\nline3\n", resp.Content)
+	assert.Equal(t, 3, resp.TotalLines)
+	assert.False(t, resp.Truncated)
+
+	resp, err = fs.ReadFile(ctx, &ReadFileRequest{Path: "src/main.go", Offset: 2, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "line2\n", resp.Content)
+
+	resp, err = fs.ReadFile(ctx, &ReadFileRequest{Path: "src/main.go", Offset: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "", resp.Content)
+
+	_, err = fs.ReadFile(ctx, &ReadFileRequest{Path: "src/pkg/data.bin"})
+	assert.Error(t, err)
+
+	_, err = fs.ReadFile(ctx, &ReadFileRequest{Path: "missing.txt"})
+	assert.Error(t, err)
+
+	fs = newTestFileSystem(t, &Config{MaxReadBytes: 8})
+	resp, err = fs.ReadFile(ctx, &ReadFileRequest{Path: "src/main.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "line1\nli", resp.Content)
+	assert.True(t, resp.Truncated)
+}
+
+func TestWriteFile(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestFileSystem(t, &Config{MaxWriteBytes: 10})
+
+	resp, err := fs.WriteFile(ctx, &WriteFileRequest{Path: "out/a.txt", Content: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "out/a.txt", resp.Path)
+	assert.Equal(t, 5, resp.BytesWritten)
+
+	_, err = fs.WriteFile(ctx, &WriteFileRequest{Path: "out/a.txt", Content: "!", Append: true})
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(fs.baseDir, "out", "a.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello!", string(data))
+
+	_, err = fs.WriteFile(ctx, &WriteFileRequest{Path: "out/a.txt", Content: "world", Append: true})
+	assert.Error(t, err)
+
+	_, err = fs.WriteFile(ctx, &WriteFileRequest{Path: "big.txt", Content: strings.Repeat("x", 11)})
+	assert.Error(t, err)
+
+	_, err = fs.WriteFile(ctx, &WriteFileRequest{Path: "../escape.txt", Content: "x"})
+	assert.Error(t, err)
+
+	fs = newTestFileSystem(t, &Config{ReadOnly: true})
+	_, err = fs.WriteFile(ctx, &WriteFileRequest{Path: "a.txt", Content: "x"})
+	assert.Error(t, err)
+}
+
+func TestListDir(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestFileSystem(t, nil)
+
+	resp, err := fs.ListDir(ctx, &ListDirRequest{})
+	require.NoError(t, err)
+	var paths []string
+	for _, e := range resp.Entries {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{"README.md", "src"}, paths)
+	assert.True(t, resp.Entries[1].IsDir)
+
+	resp, err = fs.ListDir(ctx, &ListDirRequest{Path: "src", Recursive: true})
+	require.NoError(t, err)
+	paths = nil
+	for _, e := range resp.Entries {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{"src/main.go", "src/pkg", "src/pkg/data.bin", "src/pkg/util.go"}, paths)
+
+	fs = newTestFileSystem(t, &Config{MaxEntries: 2})
+	resp, err = fs.ListDir(ctx, &ListDirRequest{Recursive: true})
+	require.NoError(t, err)
+	assert.Len(t, resp.Entries, 2)
+	assert.True(t, resp.Truncated)
+}
+
+func TestGlob(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestFileSystem(t, nil)
+
+	cases := []struct {
+		pattern string
+		expect  []string
+	}{
+		{"*.md", []string{"README.md"}},
+		{"**/*.go", []string{"src/main.go", "src/pkg/util.go"}},
+		{"src/*.go", []string{"src/main.go"}},
+		{"src/**", []string{"src/main.go", "src/pkg/data.bin", "src/pkg/util.go"}},
+		{"**/*.{md,bin}", []string{"README.md", "src/pkg/data.bin"}},
+		{"src/pkg/[a-z]???.go", []string{"src/pkg/util.go"}},
+		{"*.txt", []string{}},
+	}
+	for _, c := range cases {
+		resp, err := fs.Glob(ctx, &GlobRequest{Pattern: c.pattern})
+		require.NoError(t, err, c.pattern)
+		assert.Equal(t, c.expect, resp.Paths, c.pattern)
+	}
+
+	_, err := fs.Glob(ctx, &GlobRequest{Pattern: "src/{a,b"})
+	assert.Error(t, err)
+
+	_, err = fs.Glob(ctx, &GlobRequest{Pattern: "/etc/*"})
+	assert.Error(t, err)
+}
+
+func TestStat(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestFileSystem(t, nil)
+
+	info, err := fs.Stat(ctx, &StatRequest{Path: "src/main.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "src/main.go", info.Path)
+	assert.False(t, info.IsDir)
+	assert.Equal(t, int64(18), info.Size)
+
+	info, err = fs.Stat(ctx, &StatRequest{Path: "src"})
+	require.NoError(t, err)
+	assert.True(t, info.IsDir)
+
+	_, err = fs.Stat(ctx, &StatRequest{Path: "../"})
+	assert.Error(t, err)
+}
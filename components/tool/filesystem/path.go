@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// resolve resolves path relative to the base dir, and rejects paths escaping the base dir.
+// Symlinks of the existing part of the path are evaluated, so that links pointing outside the base dir are rejected.
+func (f *fileSystem) resolve(path string) (string, error) {
+	var abs string
+	if filepath.IsAbs(path) {
+		abs = filepath.Clean(path)
+	} else {
+		abs = filepath.Join(f.baseDir, path)
+	}
+	if !f.within(abs) {
+		return "", fmt.Errorf("path %q is outside the base dir", path)
+	}
+
+	// evaluate symlinks of the deepest existing ancestor
+	existing, rest := abs, ""
+	for {
+		if _, err := os.Lstat(existing); err == nil {
+			break
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			break
+		}
+		rest = filepath.Join(filepath.Base(existing), rest)
+		existing = parent
+	}
+	real, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", err
+	}
+	real = filepath.Join(real, rest)
+	if !f.within(real) {
+		return "", fmt.Errorf("path %q is outside the base dir", path)
+	}
+
+	return real, nil
+}
+
+func (f *fileSystem) within(abs string) bool {
+	return abs == f.baseDir || strings.HasPrefix(abs, f.baseDir+string(filepath.Separator))
+}
+
+// rel returns the slash separated path relative to the base dir.
+func (f *fileSystem) rel(abs string) string {
+	rel, err := filepath.Rel(f.baseDir, abs)
+	if err != nil {
+		return abs
+	}
+	return filepath.ToSlash(rel)
+}
+
+// compileGlob compiles a glob pattern to regexp, supporting "*", "?", "**" matching any directories,
+// character classes "[abc]" and alternatives "{a,b}".
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	inAlt := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob pattern %q: unclosed [", pattern)
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end
+		case '{':
+			if inAlt {
+				return nil, fmt.Errorf("invalid glob pattern %q: nested {", pattern)
+			}
+			inAlt = true
+			sb.WriteString("(?:")
+		case '}':
+			if !inAlt {
+				return nil, fmt.Errorf("invalid glob pattern %q: unopened }", pattern)
+			}
+			inAlt = false
+			sb.WriteString(")")
+		case ',':
+			if inAlt {
+				sb.WriteString("|")
+			} else {
+				sb.WriteString(",")
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if inAlt {
+		return nil, fmt.Errorf("invalid glob pattern %q: unclosed {", pattern)
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
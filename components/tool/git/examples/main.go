@@ -0,0 +1,66 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/tool/git"
+)
+
+func main() {
+	ctx := context.Background()
+
+	repoPath := os.Getenv("GIT_REPO_PATH")
+	if repoPath == "" {
+		repoPath = "."
+	}
+
+	gitTool, err := git.NewTool(ctx, &git.Config{
+		RepoPath: repoPath,
+		ReadOnly: true,
+	})
+	if err != nil {
+		log.Fatalf("NewTool of git failed, err=%v", err)
+	}
+
+	requests := []*git.Request{
+		{Action: git.ActionStatus},
+		{Action: git.ActionLog, MaxCount: 5},
+		{Action: git.ActionDiff, Ref: "HEAD~1"},
+		{Action: git.ActionBlame, Paths: []string{"README.md"}, StartLine: 1, EndLine: 10},
+		{Action: git.ActionCommit, Message: "update", All: true}, // rejected in read-only mode
+	}
+
+	for _, req := range requests {
+		args, err := json.Marshal(req)
+		if err != nil {
+			log.Fatalf("Marshal of request failed, err=%v", err)
+		}
+
+		resp, err := gitTool.InvokableRun(ctx, string(args))
+		if err != nil {
+			fmt.Printf("%s failed: %v\n", req.Action, err)
+			continue
+		}
+		fmt.Printf("%s: %s\n", req.Action, resp)
+	}
+}
@@ -0,0 +1,357 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+type Action string
+
+const (
+	// ActionStatus shows the working tree status
+	ActionStatus Action = "status"
+	// ActionDiff shows the changes of the working tree, the index or between refs
+	ActionDiff Action = "diff"
+	// ActionLog shows the commit logs
+	ActionLog Action = "log"
+	// ActionBlame shows the last commit modifying each line of a file
+	ActionBlame Action = "blame"
+	// ActionCheckout switches to a branch, optionally creating it
+	ActionCheckout Action = "checkout"
+	// ActionCommit records the changes to the repository
+	ActionCommit Action = "commit"
+)
+
+// Config represents the git tool configuration.
+type Config struct {
+	// Eino tool settings
+	ToolName string `json:"tool_name"` // optional, default is "git"
+	ToolDesc string `json:"tool_desc"` // optional, default describes the actions of the tool
+
+	// RepoPath is the path of the git repository, required.
+	RepoPath string `json:"repo_path"`
+
+	// ReadOnly rejects the checkout and commit actions.
+	// Optional, default: false
+	ReadOnly bool `json:"read_only"`
+
+	// GitBinary specifies the git executable.
+	// Optional, default: "git"
+	GitBinary string `json:"git_binary"`
+
+	// Timeout specifies the maximum duration of a single git command.
+	// Optional, default: 30 * time.Second
+	Timeout time.Duration `json:"timeout"`
+
+	// MaxOutputBytes specifies the maximum bytes of the output, longer output is truncated.
+	// Optional, default: 64KB
+	MaxOutputBytes int `json:"max_output_bytes"`
+
+	// AuthorName and AuthorEmail specify the author of the commits.
+	// Optional, default: the user configured in git
+	AuthorName  string `json:"author_name"`
+	AuthorEmail string `json:"author_email"`
+}
+
+// NewTool creates a new git tool instance.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	g, err := newGit(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create git tool: %w", err)
+	}
+
+	gitTool, err := utils.InferTool(config.ToolName, config.ToolDesc, g.Execute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return gitTool, nil
+}
+
+// validate validates the git tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "git"
+	}
+
+	if c.ToolDesc == "" {
+		if c.ReadOnly {
+			c.ToolDesc = "inspect a git repository. Supported actions: status, diff, log and blame."
+		} else {
+			c.ToolDesc = "inspect and modify a git repository. Supported actions: status, diff, log, blame, " +
+				"checkout to switch or create a branch, and commit to record changes with a message."
+		}
+	}
+
+	if c.RepoPath == "" {
+		return errors.New("git tool config is missing repo path")
+	}
+
+	if c.GitBinary == "" {
+		c.GitBinary = "git"
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = 30 * time.Second
+	}
+
+	if c.MaxOutputBytes <= 0 {
+		c.MaxOutputBytes = 64 * 1024
+	}
+
+	return nil
+}
+
+// git represents the git tool.
+type git struct {
+	config   *Config
+	repoPath string
+}
+
+// newGit creates a new git tool.
+func newGit(config *Config) (*git, error) {
+	if config == nil {
+		return nil, errors.New("git tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	repoPath, err := filepath.Abs(config.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repo path: %w", err)
+	}
+	info, err := os.Stat(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repo path: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("repo path is not a directory: %s", config.RepoPath)
+	}
+
+	return &git{config: config, repoPath: repoPath}, nil
+}
+
+type Request struct {
+	Action    Action   `json:"action" jsonschema:"enum=status,enum=diff,enum=log,enum=blame,enum=checkout,enum=commit" jsonschema_description:"The action to perform"`
+	Paths     []string `json:"paths,omitempty" jsonschema_description:"The paths to limit diff and log to, the files to stage by commit, or the single file to blame, relative to the repository root"`
+	Ref       string   `json:"ref,omitempty" jsonschema_description:"The commit, branch or range, e.g. HEAD~3 or main..feature, used by diff, log and blame"`
+	Staged    bool     `json:"staged,omitempty" jsonschema_description:"Whether to diff the staged changes instead of the unstaged ones, used by diff"`
+	MaxCount  int      `json:"max_count,omitempty" jsonschema_description:"The maximum number of commits to show, used by log, default: 20"`
+	StartLine int      `json:"start_line,omitempty" jsonschema_description:"The first line to blame, 1-based, used by blame"`
+	EndLine   int      `json:"end_line,omitempty" jsonschema_description:"The last line to blame, used by blame"`
+	Branch    string   `json:"branch,omitempty" jsonschema_description:"The branch to switch to, required by checkout"`
+	Create    bool     `json:"create,omitempty" jsonschema_description:"Whether to create the branch, used by checkout"`
+	Message   string   `json:"message,omitempty" jsonschema_description:"The commit message, required by commit"`
+	All       bool     `json:"all,omitempty" jsonschema_description:"Whether to stage all changes including untracked files before committing, used by commit"`
+}
+
+type Response struct {
+	Output    string `json:"output" jsonschema_description:"The output of the git command"`
+	Truncated bool   `json:"truncated,omitempty" jsonschema_description:"Whether the output is truncated due to the size limit"`
+}
+
+// Execute performs the action of the request.
+func (g *git) Execute(ctx context.Context, request *Request) (*Response, error) {
+	switch request.Action {
+	case ActionStatus:
+		return g.run(ctx, "status", "--short", "--branch")
+	case ActionDiff:
+		return g.diff(ctx, request)
+	case ActionLog:
+		return g.log(ctx, request)
+	case ActionBlame:
+		return g.blame(ctx, request)
+	case ActionCheckout:
+		if g.config.ReadOnly {
+			return nil, errors.New("checkout is not allowed in read-only mode")
+		}
+		return g.checkout(ctx, request)
+	case ActionCommit:
+		if g.config.ReadOnly {
+			return nil, errors.New("commit is not allowed in read-only mode")
+		}
+		return g.commit(ctx, request)
+	default:
+		return nil, fmt.Errorf("unknown action: %s", request.Action)
+	}
+}
+
+func (g *git) diff(ctx context.Context, request *Request) (*Response, error) {
+	args := []string{"diff", "--no-color", "--no-ext-diff"}
+	if request.Staged {
+		args = append(args, "--cached")
+	}
+	if request.Ref != "" {
+		if err := checkRef(request.Ref); err != nil {
+			return nil, err
+		}
+		args = append(args, request.Ref)
+	}
+	args = append(append(args, "--"), request.Paths...)
+
+	return g.run(ctx, args...)
+}
+
+func (g *git) log(ctx context.Context, request *Request) (*Response, error) {
+	maxCount := request.MaxCount
+	if maxCount <= 0 {
+		maxCount = 20
+	}
+	args := []string{"log", "--no-color", "--max-count=" + strconv.Itoa(maxCount), "--date=iso", "--pretty=format:%h %ad %an%n    %s"}
+	if request.Ref != "" {
+		if err := checkRef(request.Ref); err != nil {
+			return nil, err
+		}
+		args = append(args, request.Ref)
+	}
+	args = append(append(args, "--"), request.Paths...)
+
+	return g.run(ctx, args...)
+}
+
+func (g *git) blame(ctx context.Context, request *Request) (*Response, error) {
+	if len(request.Paths) != 1 {
+		return nil, errors.New("blame requires exactly one path")
+	}
+
+	args := []string{"blame", "--date=short"}
+	if request.StartLine > 0 || request.EndLine > 0 {
+		start, end := request.StartLine, request.EndLine
+		if start <= 0 {
+			start = 1
+		}
+		if end > 0 && end < start {
+			return nil, fmt.Errorf("invalid line range: %d-%d", start, end)
+		}
+		lines := strconv.Itoa(start) + ","
+		if end > 0 {
+			lines += strconv.Itoa(end)
+		}
+		args = append(args, "-L", lines)
+	}
+	if request.Ref != "" {
+		if err := checkRef(request.Ref); err != nil {
+			return nil, err
+		}
+		args = append(args, request.Ref)
+	}
+	args = append(args, "--", request.Paths[0])
+
+	return g.run(ctx, args...)
+}
+
+func (g *git) checkout(ctx context.Context, request *Request) (*Response, error) {
+	if request.Branch == "" {
+		return nil, errors.New("branch is required")
+	}
+	if err := checkRef(request.Branch); err != nil {
+		return nil, err
+	}
+
+	if request.Create {
+		return g.run(ctx, "checkout", "-b", request.Branch)
+	}
+	return g.run(ctx, "checkout", request.Branch, "--")
+}
+
+func (g *git) commit(ctx context.Context, request *Request) (*Response, error) {
+	if strings.TrimSpace(request.Message) == "" {
+		return nil, errors.New("message is required")
+	}
+
+	if request.All {
+		if _, err := g.run(ctx, "add", "--all"); err != nil {
+			return nil, err
+		}
+	} else if len(request.Paths) > 0 {
+		if _, err := g.run(ctx, append([]string{"add", "--"}, request.Paths...)...); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.run(ctx, "commit", "--no-verify", "-m", request.Message)
+}
+
+// run runs the git command in the repository, returning the output or an error containing the stderr.
+func (g *git) run(ctx context.Context, args ...string) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.config.Timeout)
+	defer cancel()
+
+	var global []string
+	if g.config.AuthorName != "" {
+		global = append(global, "-c", "user.name="+g.config.AuthorName)
+	}
+	if g.config.AuthorEmail != "" {
+		global = append(global, "-c", "user.email="+g.config.AuthorEmail)
+	}
+
+	cmd := exec.CommandContext(ctx, g.config.GitBinary, append(global, args...)...)
+	cmd.Dir = g.repoPath
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_PAGER=cat", "LC_ALL=C")
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("git %s timed out: %w", args[0], ctx.Err())
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = strings.TrimSpace(stdout.String())
+		}
+		return nil, fmt.Errorf("git %s failed: %w: %s", args[0], err, msg)
+	}
+
+	output, truncated := truncate(stdout.String(), g.config.MaxOutputBytes)
+	return &Response{Output: output, Truncated: truncated}, nil
+}
+
+// checkRef rejects refs which would be interpreted as options by git.
+func checkRef(ref string) error {
+	if strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("invalid ref: %s", ref)
+	}
+	return nil
+}
+
+// truncate truncates s to at most n bytes without splitting a utf-8 character.
+func truncate(s string, n int) (string, bool) {
+	if len(s) <= n {
+		return s, false
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n], true
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGit(t *testing.T, readOnly bool) *git {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	g, err := newGit(&Config{
+		RepoPath:    dir,
+		ReadOnly:    readOnly,
+		AuthorName:  "tester",
+		AuthorEmail: "tester@example.com",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = g.run(ctx, "init", "--initial-branch=main")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644))
+	_, err = g.run(ctx, "add", "--all")
+	require.NoError(t, err)
+	_, err = g.run(ctx, "commit", "-m", "initial commit")
+	require.NoError(t, err)
+
+	return g
+}
+
+func TestNewTool(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewTool(ctx, nil)
+	assert.Error(t, err)
+
+	_, err = NewTool(ctx, &Config{})
+	assert.Error(t, err)
+
+	_, err = NewTool(ctx, &Config{RepoPath: filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err)
+
+	gitTool, err := NewTool(ctx, &Config{RepoPath: t.TempDir()})
+	require.NoError(t, err)
+	info, err := gitTool.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "git", info.Name)
+}
+
+func TestReadActions(t *testing.T) {
+	ctx := context.Background()
+	g := newTestGit(t, true)
+
+	require.NoError(t, os.WriteFile(filepath.Join(g.repoPath, "main.go"), []byte("package main\n\nfunc main() { println() }\n"), 0o644))
+
+	resp, err := g.Execute(ctx, &Request{Action: ActionStatus})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Output, "## main")
+	assert.Contains(t, resp.Output, " M main.go")
+
+	resp, err = g.Execute(ctx, &Request{Action: ActionDiff})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Output, "+func main() { println() }")
+
+	resp, err = g.Execute(ctx, &Request{Action: ActionDiff, Staged: true})
+	require.NoError(t, err)
+	assert.Equal(t, "", resp.Output)
+
+	resp, err = g.Execute(ctx, &Request{Action: ActionLog, MaxCount: 1})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Output, "tester")
+	assert.Contains(t, resp.Output, "initial commit")
+
+	resp, err = g.Execute(ctx, &Request{Action: ActionBlame, Paths: []string{"main.go"}, Ref: "HEAD", StartLine: 3, EndLine: 3})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Output, "func main() {}")
+	assert.NotContains(t, resp.Output, "package main")
+
+	_, err = g.Execute(ctx, &Request{Action: ActionBlame})
+	assert.Error(t, err)
+
+	_, err = g.Execute(ctx, &Request{Action: ActionDiff, Ref: "--output=/tmp/x"})
+	assert.Error(t, err)
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCheckout, Branch: "feature", Create: true})
+	assert.Error(t, err)
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCommit, Message: "change", All: true})
+	assert.Error(t, err)
+
+	_, err = g.Execute(ctx, &Request{Action: "push"})
+	assert.Error(t, err)
+}
+
+func TestWriteActions(t *testing.T) {
+	ctx := context.Background()
+	g := newTestGit(t, false)
+
+	_, err := g.Execute(ctx, &Request{Action: ActionCheckout, Branch: "feature", Create: true})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(g.repoPath, "util.go"), []byte("package main\n"), 0o644))
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCommit, All: true})
+	assert.Error(t, err)
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCommit, Message: "add util", Paths: []string{"util.go"}})
+	require.NoError(t, err)
+
+	resp, err := g.Execute(ctx, &Request{Action: ActionLog, Ref: "main..feature"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Output, "add util")
+	assert.NotContains(t, resp.Output, "initial commit")
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCommit, Message: "nothing", All: true})
+	assert.Error(t, err)
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCheckout, Branch: "main"})
+	require.NoError(t, err)
+	resp, err = g.Execute(ctx, &Request{Action: ActionStatus})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Output, "## main")
+
+	_, err = g.Execute(ctx, &Request{Action: ActionCheckout, Branch: "missing"})
+	assert.Error(t, err)
+}
+
+func TestTruncate(t *testing.T) {
+	s, truncated := truncate("ab你", 4)
+	assert.Equal(t, "ab", s)
+	assert.True(t, truncated)
+
+	s, truncated = truncate("abc", 4)
+	assert.Equal(t, "abc", s)
+	assert.False(t, truncated)
+}
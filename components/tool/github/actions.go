@@ -0,0 +1,211 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+)
+
+type IssueType string
+
+const (
+	IssueTypeIssue       IssueType = "issue"
+	IssueTypePullRequest IssueType = "pull_request"
+)
+
+type Issue struct {
+	Repo          string   `json:"repo" jsonschema_description:"The repository of the issue, in the form owner/repo"`
+	Number        int      `json:"number" jsonschema_description:"The number of the issue or pull request"`
+	Title         string   `json:"title" jsonschema_description:"The title of the issue"`
+	State         string   `json:"state" jsonschema_description:"The state of the issue, e.g. open or closed"`
+	URL           string   `json:"url" jsonschema_description:"The web url of the issue"`
+	Author        string   `json:"author,omitempty" jsonschema_description:"The username of the author"`
+	Labels        []string `json:"labels,omitempty" jsonschema_description:"The labels of the issue"`
+	Body          string   `json:"body,omitempty" jsonschema_description:"The body of the issue"`
+	IsPullRequest bool     `json:"is_pull_request,omitempty" jsonschema_description:"Whether it is a pull request"`
+	CreatedAt     string   `json:"created_at,omitempty" jsonschema_description:"The creation time of the issue"`
+}
+
+type PullRequest struct {
+	Repo   string `json:"repo" jsonschema_description:"The repository of the pull request, in the form owner/repo"`
+	Number int    `json:"number" jsonschema_description:"The number of the pull request"`
+	Title  string `json:"title" jsonschema_description:"The title of the pull request"`
+	State  string `json:"state" jsonschema_description:"The state of the pull request"`
+	URL    string `json:"url" jsonschema_description:"The web url of the pull request"`
+	Head   string `json:"head" jsonschema_description:"The head branch of the pull request"`
+	Base   string `json:"base" jsonschema_description:"The base branch of the pull request"`
+}
+
+type CodeResult struct {
+	Repo      string   `json:"repo" jsonschema_description:"The repository of the file, in the form owner/repo"`
+	Path      string   `json:"path" jsonschema_description:"The path of the file"`
+	URL       string   `json:"url,omitempty" jsonschema_description:"The web url of the file"`
+	Fragments []string `json:"fragments,omitempty" jsonschema_description:"The matched code fragments"`
+}
+
+type SearchIssuesRequest struct {
+	Query string    `json:"query" jsonschema_description:"The keywords to search for"`
+	Repo  string    `json:"repo,omitempty" jsonschema_description:"The repository to search in, in the form owner/repo, default: the configured repository"`
+	Type  IssueType `json:"type,omitempty" jsonschema:"enum=issue,enum=pull_request" jsonschema_description:"The type of the results, default: both issues and pull requests on GitHub, issues on GitLab"`
+	State string    `json:"state,omitempty" jsonschema:"enum=open,enum=closed,enum=all" jsonschema_description:"The state of the results, default: all"`
+	Limit int       `json:"limit,omitempty" jsonschema_description:"The maximum number of results"`
+}
+
+type SearchIssuesResponse struct {
+	Issues []*Issue `json:"issues" jsonschema_description:"The matched issues and pull requests"`
+}
+
+// SearchIssues searches issues and pull requests.
+func (g *gitHub) SearchIssues(ctx context.Context, req *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	if req.Query == "" {
+		return nil, errors.New("query is required")
+	}
+	switch req.State {
+	case "", "open", "closed", "all":
+	default:
+		return nil, errors.New("state must be one of open, closed and all")
+	}
+	s, err := g.resolveSearchScope(req.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := g.platform.searchIssues(ctx, s, req, g.limit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchIssuesResponse{Issues: issues}, nil
+}
+
+type CreateIssueRequest struct {
+	Repo   string   `json:"repo,omitempty" jsonschema_description:"The repository to create the issue in, in the form owner/repo, default: the configured repository"`
+	Title  string   `json:"title" jsonschema_description:"The title of the issue"`
+	Body   string   `json:"body,omitempty" jsonschema_description:"The body of the issue in markdown"`
+	Labels []string `json:"labels,omitempty" jsonschema_description:"The labels of the issue"`
+}
+
+// CreateIssue creates an issue.
+func (g *gitHub) CreateIssue(ctx context.Context, req *CreateIssueRequest) (*Issue, error) {
+	if g.config.ReadOnly {
+		return nil, errors.New("creating issues is not allowed in read-only mode")
+	}
+	if req.Title == "" {
+		return nil, errors.New("title is required")
+	}
+	repo, err := g.requireRepo(req.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.platform.createIssue(ctx, repo, req)
+}
+
+type CreatePullRequestRequest struct {
+	Repo  string `json:"repo,omitempty" jsonschema_description:"The repository to create the pull request in, in the form owner/repo, default: the configured repository"`
+	Title string `json:"title" jsonschema_description:"The title of the pull request"`
+	Body  string `json:"body,omitempty" jsonschema_description:"The description of the pull request in markdown"`
+	Head  string `json:"head" jsonschema_description:"The branch containing the changes"`
+	Base  string `json:"base" jsonschema_description:"The branch to merge the changes into"`
+	Draft bool   `json:"draft,omitempty" jsonschema_description:"Whether to create a draft pull request"`
+}
+
+// CreatePullRequest creates a pull request, or a merge request on GitLab.
+func (g *gitHub) CreatePullRequest(ctx context.Context, req *CreatePullRequestRequest) (*PullRequest, error) {
+	if g.config.ReadOnly {
+		return nil, errors.New("creating pull requests is not allowed in read-only mode")
+	}
+	if req.Title == "" {
+		return nil, errors.New("title is required")
+	}
+	if req.Head == "" || req.Base == "" {
+		return nil, errors.New("head and base are required")
+	}
+	repo, err := g.requireRepo(req.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.platform.createPullRequest(ctx, repo, req)
+}
+
+type GetPullRequestDiffRequest struct {
+	Repo   string `json:"repo,omitempty" jsonschema_description:"The repository of the pull request, in the form owner/repo, default: the configured repository"`
+	Number int    `json:"number" jsonschema_description:"The number of the pull request"`
+}
+
+type GetPullRequestDiffResponse struct {
+	Diff      string `json:"diff" jsonschema_description:"The unified diff of the pull request"`
+	Truncated bool   `json:"truncated,omitempty" jsonschema_description:"Whether the diff is truncated due to the size limit"`
+}
+
+// GetPullRequestDiff gets the unified diff of a pull request.
+func (g *gitHub) GetPullRequestDiff(ctx context.Context, req *GetPullRequestDiffRequest) (*GetPullRequestDiffResponse, error) {
+	if req.Number <= 0 {
+		return nil, errors.New("number is required")
+	}
+	repo, err := g.requireRepo(req.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := g.platform.getPullRequestDiff(ctx, repo, req.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetPullRequestDiffResponse{Diff: diff}
+	if n := g.config.MaxDiffBytes; len(diff) > n {
+		for n > 0 && !utf8.RuneStart(diff[n]) {
+			n--
+		}
+		resp.Diff = diff[:n]
+		resp.Truncated = true
+	}
+
+	return resp, nil
+}
+
+type SearchCodeRequest struct {
+	Query string `json:"query" jsonschema_description:"The keywords to search for"`
+	Repo  string `json:"repo,omitempty" jsonschema_description:"The repository to search in, in the form owner/repo, default: the configured repository"`
+	Limit int    `json:"limit,omitempty" jsonschema_description:"The maximum number of results"`
+}
+
+type SearchCodeResponse struct {
+	Results []*CodeResult `json:"results" jsonschema_description:"The matched files"`
+}
+
+// SearchCode searches code.
+func (g *gitHub) SearchCode(ctx context.Context, req *SearchCodeRequest) (*SearchCodeResponse, error) {
+	if req.Query == "" {
+		return nil, errors.New("query is required")
+	}
+	s, err := g.resolveSearchScope(req.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := g.platform.searchCode(ctx, s, req.Query, g.limit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchCodeResponse{Results: results}, nil
+}
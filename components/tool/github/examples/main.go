@@ -0,0 +1,70 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/tool"
+
+	"github.com/cloudwego/eino-ext/components/tool/github"
+)
+
+func main() {
+	ctx := context.Background()
+
+	tools, err := github.NewTools(ctx, &github.Config{
+		Token:    os.Getenv("GITHUB_TOKEN"),
+		Owner:    "cloudwego",
+		Repo:     "eino",
+		ReadOnly: true,
+	})
+	if err != nil {
+		log.Fatalf("NewTools of github failed, err=%v", err)
+	}
+
+	invokable := make(map[string]tool.InvokableTool, len(tools))
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			log.Fatalf("Info of tool failed, err=%v", err)
+		}
+		invokable[info.Name] = t.(tool.InvokableTool)
+	}
+
+	calls := []struct {
+		name string
+		args string
+	}{
+		{"search_issues", `{"query": "stream", "type": "issue", "state": "open", "limit": 3}`},
+		{"get_pull_request_diff", `{"number": 1}`},
+		{"search_code", `{"query": "InferTool", "repo": "eino-ext", "limit": 3}`},
+		{"search_issues", `{"query": "bug", "repo": "golang/go"}`}, // rejected, not owned by cloudwego
+	}
+
+	for _, call := range calls {
+		resp, err := invokable[call.name].InvokableRun(ctx, call.args)
+		if err != nil {
+			fmt.Printf("%s failed: %v\n", call.name, err)
+			continue
+		}
+		fmt.Printf("%s: %s\n", call.name, resp)
+	}
+}
@@ -0,0 +1,271 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+type Platform string
+
+const (
+	PlatformGitHub Platform = "github"
+	PlatformGitLab Platform = "gitlab"
+)
+
+// Config represents the github tools configuration.
+type Config struct {
+	// Platform specifies the code hosting platform.
+	// Optional, default: PlatformGitHub
+	Platform Platform `json:"platform"`
+
+	// Token is the access token used to authenticate the requests.
+	// Sent as "Authorization: Bearer <token>" to GitHub, and "PRIVATE-TOKEN: <token>" to GitLab.
+	// Optional, but required by creating issues and pull requests, and by the code search of GitHub.
+	Token string `json:"token"`
+
+	// BaseURL is the base url of the REST API, used for GitHub Enterprise or self-managed GitLab.
+	// Optional, default: "https://api.github.com" for GitHub, "https://gitlab.com/api/v4" for GitLab
+	BaseURL string `json:"base_url"`
+
+	// Owner scopes the tools to the repositories of an organization, user or GitLab group.
+	// Requests for repositories of other owners are rejected, and searches without repo are limited to the owner.
+	// Optional
+	Owner string `json:"owner"`
+
+	// Repo is the default repository name, used when the request does not specify one.
+	// Optional
+	Repo string `json:"repo"`
+
+	// AllowedRepos limits the repositories the tools can access, in the form "owner/repo" or "owner/*".
+	// Optional, default: no limit
+	AllowedRepos []string `json:"allowed_repos"`
+
+	// ReadOnly omits the create_issue and create_pull_request tools.
+	// Optional, default: false
+	ReadOnly bool `json:"read_only"`
+
+	// MaxResults specifies the maximum number of search results.
+	// Optional, default: 10
+	MaxResults int `json:"max_results"`
+
+	// MaxDiffBytes specifies the maximum bytes of the pull request diff, longer diff is truncated.
+	// Optional, default: 64KB
+	MaxDiffBytes int `json:"max_diff_bytes"`
+
+	// HttpClient is the http client used to send requests.
+	// Optional, default: &http.Client{Timeout: 30 * time.Second}
+	HttpClient *http.Client `json:"-"`
+}
+
+// validate validates the github tools configuration.
+func (c *Config) validate() error {
+	switch c.Platform {
+	case "":
+		c.Platform = PlatformGitHub
+	case PlatformGitHub, PlatformGitLab:
+	default:
+		return fmt.Errorf("unsupported platform: %s", c.Platform)
+	}
+
+	if c.BaseURL == "" {
+		if c.Platform == PlatformGitLab {
+			c.BaseURL = "https://gitlab.com/api/v4"
+		} else {
+			c.BaseURL = "https://api.github.com"
+		}
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
+	c.Owner = strings.Trim(c.Owner, "/")
+	if c.Repo != "" && !strings.Contains(c.Repo, "/") && c.Owner == "" {
+		return errors.New("github tool config is missing owner of the repo")
+	}
+
+	if c.MaxResults <= 0 {
+		c.MaxResults = 10
+	}
+
+	if c.MaxDiffBytes <= 0 {
+		c.MaxDiffBytes = 64 * 1024
+	}
+
+	if c.HttpClient == nil {
+		c.HttpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return nil
+}
+
+// NewTools creates the github tools: search_issues, get_pull_request_diff, search_code,
+// and create_issue, create_pull_request unless in read-only mode.
+func NewTools(ctx context.Context, config *Config) ([]tool.BaseTool, error) {
+	gh, err := newGitHub(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create github tools: %w", err)
+	}
+
+	searchIssuesTool, err := utils.InferTool("search_issues", "search issues and pull requests by keywords", gh.SearchIssues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool search_issues: %w", err)
+	}
+
+	diffTool, err := utils.InferTool("get_pull_request_diff", "get the unified diff of a pull request", gh.GetPullRequestDiff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool get_pull_request_diff: %w", err)
+	}
+
+	searchCodeTool, err := utils.InferTool("search_code", "search code in repositories by keywords", gh.SearchCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool search_code: %w", err)
+	}
+
+	tools := []tool.BaseTool{searchIssuesTool, diffTool, searchCodeTool}
+
+	if !config.ReadOnly {
+		createIssueTool, err := utils.InferTool("create_issue", "create an issue in a repository", gh.CreateIssue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer tool create_issue: %w", err)
+		}
+
+		createPRTool, err := utils.InferTool("create_pull_request", "create a pull request from a head branch to a base branch", gh.CreatePullRequest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer tool create_pull_request: %w", err)
+		}
+
+		tools = append(tools, createIssueTool, createPRTool)
+	}
+
+	return tools, nil
+}
+
+// gitHub implements the github tools on top of a platform.
+type gitHub struct {
+	config   *Config
+	platform platform
+}
+
+// newGitHub creates the github tools implementation.
+func newGitHub(config *Config) (*gitHub, error) {
+	if config == nil {
+		return nil, errors.New("github tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	c := &client{baseURL: config.BaseURL, httpClient: config.HttpClient}
+
+	var p platform
+	if config.Platform == PlatformGitLab {
+		p = newGitLabPlatform(c, config.Token)
+	} else {
+		p = newGitHubPlatform(c, config.Token)
+	}
+
+	return &gitHub{config: config, platform: p}, nil
+}
+
+// resolveRepo resolves the repository of the request, returning "owner/repo",
+// or "" if neither the request nor the config specifies one.
+func (g *gitHub) resolveRepo(repo string) (string, error) {
+	repo = strings.Trim(repo, "/")
+	if repo == "" {
+		repo = g.config.Repo
+	}
+	if repo == "" {
+		return "", nil
+	}
+	if !strings.Contains(repo, "/") {
+		if g.config.Owner == "" {
+			return "", fmt.Errorf("repo must be in the form owner/repo: %s", repo)
+		}
+		repo = g.config.Owner + "/" + repo
+	}
+	for _, part := range strings.Split(repo, "/") {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, "?#%\\ ") {
+			return "", fmt.Errorf("invalid repo: %s", repo)
+		}
+	}
+	if g.config.Platform == PlatformGitHub && strings.Count(repo, "/") != 1 {
+		return "", fmt.Errorf("repo must be in the form owner/repo: %s", repo)
+	}
+
+	if g.config.Owner != "" && !strings.HasPrefix(repo, g.config.Owner+"/") {
+		return "", fmt.Errorf("repo %s is not owned by %s", repo, g.config.Owner)
+	}
+
+	if len(g.config.AllowedRepos) > 0 {
+		allowed := false
+		for _, r := range g.config.AllowedRepos {
+			if r == repo || (strings.HasSuffix(r, "/*") && strings.HasPrefix(repo, strings.TrimSuffix(r, "*"))) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return "", fmt.Errorf("repo %s is not allowed", repo)
+		}
+	}
+
+	return repo, nil
+}
+
+// resolveSearchScope resolves the repository to search, falling back to the owner if no repository is specified.
+func (g *gitHub) resolveSearchScope(repo string) (*scope, error) {
+	repo, err := g.resolveRepo(repo)
+	if err != nil {
+		return nil, err
+	}
+	if repo != "" {
+		return &scope{repo: repo}, nil
+	}
+	if g.config.Owner != "" {
+		return &scope{owner: g.config.Owner}, nil
+	}
+	if len(g.config.AllowedRepos) > 0 {
+		return nil, errors.New("repo is required")
+	}
+	return &scope{}, nil
+}
+
+func (g *gitHub) requireRepo(repo string) (string, error) {
+	repo, err := g.resolveRepo(repo)
+	if err != nil {
+		return "", err
+	}
+	if repo == "" {
+		return "", errors.New("repo is required")
+	}
+	return repo, nil
+}
+
+func (g *gitHub) limit(limit int) int {
+	if limit <= 0 || limit > g.config.MaxResults {
+		return g.config.MaxResults
+	}
+	return limit
+}
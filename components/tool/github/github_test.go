@@ -0,0 +1,239 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTools(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewTools(ctx, nil)
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{Platform: "bitbucket"})
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{Repo: "eino"})
+	assert.Error(t, err)
+
+	tools, err := NewTools(ctx, &Config{Owner: "cloudwego", Repo: "eino"})
+	require.NoError(t, err)
+	assert.Len(t, tools, 5)
+
+	tools, err = NewTools(ctx, &Config{ReadOnly: true})
+	require.NoError(t, err)
+	assert.Len(t, tools, 3)
+}
+
+func TestResolveRepo(t *testing.T) {
+	g, err := newGitHub(&Config{Owner: "cloudwego", Repo: "eino", AllowedRepos: []string{"cloudwego/eino", "cloudwego/eino-ext"}})
+	require.NoError(t, err)
+
+	repo, err := g.resolveRepo("")
+	require.NoError(t, err)
+	assert.Equal(t, "cloudwego/eino", repo)
+
+	repo, err = g.resolveRepo("eino-ext")
+	require.NoError(t, err)
+	assert.Equal(t, "cloudwego/eino-ext", repo)
+
+	for _, r := range []string{"other/eino", "cloudwego/kitex", "cloudwego/../admin", "cloudwego/eino?x=1", "cloudwego/a/b"} {
+		_, err = g.resolveRepo(r)
+		assert.Error(t, err, r)
+	}
+
+	g, err = newGitHub(&Config{AllowedRepos: []string{"cloudwego/*"}})
+	require.NoError(t, err)
+	repo, err = g.resolveRepo("cloudwego/kitex")
+	require.NoError(t, err)
+	assert.Equal(t, "cloudwego/kitex", repo)
+	_, err = g.resolveRepo("other/kitex")
+	assert.Error(t, err)
+	_, err = g.resolveRepo("kitex")
+	assert.Error(t, err)
+	_, err = g.resolveSearchScope("")
+	assert.Error(t, err)
+
+	g, err = newGitHub(&Config{Platform: PlatformGitLab, Owner: "group"})
+	require.NoError(t, err)
+	repo, err = g.resolveRepo("group/sub/project")
+	require.NoError(t, err)
+	assert.Equal(t, "group/sub/project", repo)
+	s, err := g.resolveSearchScope("")
+	require.NoError(t, err)
+	assert.Equal(t, &scope{owner: "group"}, s)
+}
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  map[string]string
+	header http.Header
+	body   map[string]any
+}
+
+func newTestServer(t *testing.T, handler func(r *recordedRequest) (int, string)) (*httptest.Server, *[]*recordedRequest) {
+	var requests []*recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &recordedRequest{method: r.Method, path: r.URL.EscapedPath(), query: map[string]string{}, header: r.Header}
+		for k := range r.URL.Query() {
+			rec.query[k] = r.URL.Query().Get(k)
+		}
+		data, _ := io.ReadAll(r.Body)
+		if len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &rec.body))
+		}
+		requests = append(requests, rec)
+
+		status, body := handler(rec)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGitHub(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newTestServer(t, func(r *recordedRequest) (int, string) {
+		switch r.path {
+		case "/search/issues":
+			return 200, `{"items": [{"number": 1, "title": "bug", "state": "open", "html_url": "https://github.com/cloudwego/eino/issues/1",
+				"user": {"login": "alice"}, "repository_url": "https://api.github.com/repos/cloudwego/eino", "labels": [{"name": "bug"}]},
+				{"number": 2, "title": "fix", "state": "closed", "repository_url": "https://api.github.com/repos/cloudwego/eino", "pull_request": {}}]}`
+		case "/repos/cloudwego/eino/issues":
+			return 201, `{"number": 3, "title": "new issue", "state": "open", "html_url": "https://github.com/cloudwego/eino/issues/3"}`
+		case "/repos/cloudwego/eino/pulls":
+			return 201, `{"number": 4, "title": "new pr", "state": "open", "head": {"ref": "feature"}, "base": {"ref": "main"}}`
+		case "/repos/cloudwego/eino/pulls/4":
+			return 200, "diff --git a/a.go b/a.go\n+added line\n"
+		case "/search/code":
+			return 200, `{"items": [{"path": "a.go", "repository": {"full_name": "cloudwego/eino"}, "text_matches": [{"fragment": "func A()"}]}]}`
+		case "/repos/cloudwego/eino/pulls/5":
+			return 404, `{"message": "Not Found"}`
+		}
+		return 500, ""
+	})
+
+	g, err := newGitHub(&Config{BaseURL: server.URL, Token: "token", Owner: "cloudwego", Repo: "eino", MaxDiffBytes: 10})
+	require.NoError(t, err)
+
+	issues, err := g.SearchIssues(ctx, &SearchIssuesRequest{Query: "panic", Type: IssueTypeIssue, State: "open", Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, issues.Issues, 2)
+	assert.Equal(t, &Issue{Repo: "cloudwego/eino", Number: 1, Title: "bug", State: "open", URL: "https://github.com/cloudwego/eino/issues/1",
+		Author: "alice", Labels: []string{"bug"}}, issues.Issues[0])
+	assert.True(t, issues.Issues[1].IsPullRequest)
+	req := (*requests)[0]
+	assert.Equal(t, "panic repo:cloudwego/eino is:issue state:open", req.query["q"])
+	assert.Equal(t, "10", req.query["per_page"])
+	assert.Equal(t, "Bearer token", req.header.Get("Authorization"))
+
+	_, err = g.SearchIssues(ctx, &SearchIssuesRequest{Query: "panic", State: "merged"})
+	assert.Error(t, err)
+
+	issue, err := g.CreateIssue(ctx, &CreateIssueRequest{Title: "new issue", Body: "details", Labels: []string{"bug"}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, issue.Number)
+	assert.Equal(t, "cloudwego/eino", issue.Repo)
+	req = (*requests)[1]
+	assert.Equal(t, http.MethodPost, req.method)
+	assert.Equal(t, map[string]any{"title": "new issue", "body": "details", "labels": []any{"bug"}}, req.body)
+
+	pr, err := g.CreatePullRequest(ctx, &CreatePullRequestRequest{Title: "new pr", Head: "feature", Base: "main", Draft: true})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{Repo: "cloudwego/eino", Number: 4, Title: "new pr", State: "open", Head: "feature", Base: "main"}, pr)
+	assert.Equal(t, true, (*requests)[2].body["draft"])
+
+	_, err = g.CreatePullRequest(ctx, &CreatePullRequestRequest{Title: "new pr", Head: "feature"})
+	assert.Error(t, err)
+
+	diff, err := g.GetPullRequestDiff(ctx, &GetPullRequestDiffRequest{Number: 4})
+	require.NoError(t, err)
+	assert.Equal(t, "diff --git", diff.Diff)
+	assert.True(t, diff.Truncated)
+	assert.Equal(t, "application/vnd.github.diff", (*requests)[3].header.Get("Accept"))
+
+	_, err = g.GetPullRequestDiff(ctx, &GetPullRequestDiffRequest{Number: 5})
+	assert.ErrorContains(t, err, "status code 404")
+
+	code, err := g.SearchCode(ctx, &SearchCodeRequest{Query: "func A", Repo: "eino"})
+	require.NoError(t, err)
+	assert.Equal(t, []*CodeResult{{Repo: "cloudwego/eino", Path: "a.go", Fragments: []string{"func A()"}}}, code.Results)
+
+	g, err = newGitHub(&Config{BaseURL: server.URL, Owner: "cloudwego", ReadOnly: true})
+	require.NoError(t, err)
+	_, err = g.SearchCode(ctx, &SearchCodeRequest{Query: "func A"})
+	require.NoError(t, err)
+	assert.Equal(t, "func A user:cloudwego", (*requests)[len(*requests)-1].query["q"])
+	_, err = g.CreateIssue(ctx, &CreateIssueRequest{Repo: "eino", Title: "new issue"})
+	assert.Error(t, err)
+}
+
+func TestGitLab(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newTestServer(t, func(r *recordedRequest) (int, string) {
+		switch r.path {
+		case "/groups/group/merge_requests":
+			return 200, `[{"iid": 1, "title": "mr", "state": "opened", "author": {"username": "bob"}, "references": {"full": "group/sub/project!1"}}]`
+		case "/projects/group%2Fproject/merge_requests":
+			return 201, `{"iid": 2, "title": "Draft: mr", "state": "opened", "source_branch": "feature", "target_branch": "main"}`
+		case "/projects/group%2Fproject/merge_requests/2/diffs":
+			return 200, `[{"old_path": "a.go", "new_path": "a.go", "diff": "@@ -1 +1 @@\n-old\n+new\n"},
+				{"old_path": "b.go", "new_path": "b.go", "diff": "@@ -0,0 +1 @@\n+new", "new_file": true}]`
+		case "/projects/group%2Fproject/search":
+			return 200, `[{"path": "a.go", "data": "func A()", "project_id": 7}]`
+		}
+		return 500, ""
+	})
+
+	g, err := newGitHub(&Config{Platform: PlatformGitLab, BaseURL: server.URL, Token: "token", Owner: "group"})
+	require.NoError(t, err)
+
+	issues, err := g.SearchIssues(ctx, &SearchIssuesRequest{Query: "mr", Type: IssueTypePullRequest, State: "open"})
+	require.NoError(t, err)
+	assert.Equal(t, []*Issue{{Repo: "group/sub/project", Number: 1, Title: "mr", State: "opened", Author: "bob", IsPullRequest: true}}, issues.Issues)
+	req := (*requests)[0]
+	assert.Equal(t, map[string]string{"search": "mr", "state": "opened", "per_page": "10"}, req.query)
+	assert.Equal(t, "token", req.header.Get("PRIVATE-TOKEN"))
+
+	pr, err := g.CreatePullRequest(ctx, &CreatePullRequestRequest{Repo: "project", Title: "mr", Head: "feature", Base: "main", Draft: true})
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{Repo: "group/project", Number: 2, Title: "Draft: mr", State: "opened", Head: "feature", Base: "main"}, pr)
+	assert.Equal(t, map[string]any{"title": "Draft: mr", "description": "", "source_branch": "feature", "target_branch": "main"}, (*requests)[1].body)
+
+	diff, err := g.GetPullRequestDiff(ctx, &GetPullRequestDiffRequest{Repo: "project", Number: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-old\n+new\n"+
+		"diff --git a/b.go b/b.go\n--- /dev/null\n+++ b/b.go\n@@ -0,0 +1 @@\n+new\n", diff.Diff)
+	assert.False(t, diff.Truncated)
+
+	code, err := g.SearchCode(ctx, &SearchCodeRequest{Query: "func A", Repo: "project"})
+	require.NoError(t, err)
+	assert.Equal(t, []*CodeResult{{Repo: "group/project", Path: "a.go", Fragments: []string{"func A()"}}}, code.Results)
+	assert.Equal(t, "blobs", (*requests)[3].query["scope"])
+}
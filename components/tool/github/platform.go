@@ -0,0 +1,422 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// scope is the scope of a search, a repository, an owner, or everything if both are empty.
+type scope struct {
+	repo  string
+	owner string
+}
+
+// platform is the api of a code hosting platform.
+type platform interface {
+	searchIssues(ctx context.Context, s *scope, req *SearchIssuesRequest, limit int) ([]*Issue, error)
+	createIssue(ctx context.Context, repo string, req *CreateIssueRequest) (*Issue, error)
+	createPullRequest(ctx context.Context, repo string, req *CreatePullRequestRequest) (*PullRequest, error)
+	getPullRequestDiff(ctx context.Context, repo string, number int) (string, error)
+	searchCode(ctx context.Context, s *scope, query string, limit int) ([]*CodeResult, error)
+}
+
+// gitHubPlatform implements the platform with GitHub REST API.
+type gitHubPlatform struct {
+	client *client
+}
+
+func newGitHubPlatform(c *client, token string) *gitHubPlatform {
+	c.setHeaders = func(req *http.Request) {
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	return &gitHubPlatform{client: c}
+}
+
+type gitHubUser struct {
+	Login string `json:"login"`
+}
+
+type gitHubIssue struct {
+	Number        int        `json:"number"`
+	Title         string     `json:"title"`
+	State         string     `json:"state"`
+	HTMLURL       string     `json:"html_url"`
+	User          gitHubUser `json:"user"`
+	Body          string     `json:"body"`
+	CreatedAt     string     `json:"created_at"`
+	RepositoryURL string     `json:"repository_url"`
+	Labels        []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	PullRequest *struct{} `json:"pull_request"`
+}
+
+func (i *gitHubIssue) toIssue(repo string) *Issue {
+	if repo == "" {
+		// repository_url is in the form https://api.github.com/repos/owner/repo
+		if idx := strings.Index(i.RepositoryURL, "/repos/"); idx >= 0 {
+			repo = i.RepositoryURL[idx+len("/repos/"):]
+		}
+	}
+	issue := &Issue{
+		Repo:          repo,
+		Number:        i.Number,
+		Title:         i.Title,
+		State:         i.State,
+		URL:           i.HTMLURL,
+		Author:        i.User.Login,
+		Body:          i.Body,
+		IsPullRequest: i.PullRequest != nil,
+		CreatedAt:     i.CreatedAt,
+	}
+	for _, l := range i.Labels {
+		issue.Labels = append(issue.Labels, l.Name)
+	}
+	return issue
+}
+
+func (s *scope) gitHubQualifier() string {
+	if s.repo != "" {
+		return " repo:" + s.repo
+	}
+	if s.owner != "" {
+		return " user:" + s.owner
+	}
+	return ""
+}
+
+func (p *gitHubPlatform) searchIssues(ctx context.Context, s *scope, req *SearchIssuesRequest, limit int) ([]*Issue, error) {
+	q := req.Query + s.gitHubQualifier()
+	switch req.Type {
+	case IssueTypeIssue:
+		q += " is:issue"
+	case IssueTypePullRequest:
+		q += " is:pr"
+	}
+	if req.State == "open" || req.State == "closed" {
+		q += " state:" + req.State
+	}
+
+	var result struct {
+		Items []*gitHubIssue `json:"items"`
+	}
+	query := url.Values{"q": {q}, "per_page": {strconv.Itoa(limit)}}
+	if err := p.client.doJSON(ctx, http.MethodGet, "/search/issues", query, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues := make([]*Issue, 0, len(result.Items))
+	for _, item := range result.Items {
+		issues = append(issues, item.toIssue(""))
+	}
+	return issues, nil
+}
+
+func (p *gitHubPlatform) createIssue(ctx context.Context, repo string, req *CreateIssueRequest) (*Issue, error) {
+	body := map[string]any{"title": req.Title, "body": req.Body}
+	if len(req.Labels) > 0 {
+		body["labels"] = req.Labels
+	}
+
+	var result gitHubIssue
+	if err := p.client.doJSON(ctx, http.MethodPost, "/repos/"+repo+"/issues", nil, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+	return result.toIssue(repo), nil
+}
+
+func (p *gitHubPlatform) createPullRequest(ctx context.Context, repo string, req *CreatePullRequestRequest) (*PullRequest, error) {
+	body := map[string]any{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.Head,
+		"base":  req.Base,
+		"draft": req.Draft,
+	}
+
+	var result struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+		Head    struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	}
+	if err := p.client.doJSON(ctx, http.MethodPost, "/repos/"+repo+"/pulls", nil, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	return &PullRequest{
+		Repo:   repo,
+		Number: result.Number,
+		Title:  result.Title,
+		State:  result.State,
+		URL:    result.HTMLURL,
+		Head:   result.Head.Ref,
+		Base:   result.Base.Ref,
+	}, nil
+}
+
+func (p *gitHubPlatform) getPullRequestDiff(ctx context.Context, repo string, number int) (string, error) {
+	header := http.Header{"Accept": {"application/vnd.github.diff"}}
+	data, err := p.client.do(ctx, http.MethodGet, "/repos/"+repo+"/pulls/"+strconv.Itoa(number), nil, nil, header)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pull request diff: %w", err)
+	}
+	return string(data), nil
+}
+
+func (p *gitHubPlatform) searchCode(ctx context.Context, s *scope, q string, limit int) ([]*CodeResult, error) {
+	var result struct {
+		Items []struct {
+			Path       string `json:"path"`
+			HTMLURL    string `json:"html_url"`
+			Repository struct {
+				FullName string `json:"full_name"`
+			} `json:"repository"`
+			TextMatches []struct {
+				Fragment string `json:"fragment"`
+			} `json:"text_matches"`
+		} `json:"items"`
+	}
+
+	query := url.Values{"q": {q + s.gitHubQualifier()}, "per_page": {strconv.Itoa(limit)}}
+	header := http.Header{"Accept": {"application/vnd.github.text-match+json"}}
+	data, err := p.client.do(ctx, http.MethodGet, "/search/code", query, nil, header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search code: %w", err)
+	}
+	if err = unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	results := make([]*CodeResult, 0, len(result.Items))
+	for _, item := range result.Items {
+		r := &CodeResult{Repo: item.Repository.FullName, Path: item.Path, URL: item.HTMLURL}
+		for _, m := range item.TextMatches {
+			r.Fragments = append(r.Fragments, m.Fragment)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// gitLabPlatform implements the platform with GitLab REST API, where pull requests are merge requests.
+type gitLabPlatform struct {
+	client *client
+}
+
+func newGitLabPlatform(c *client, token string) *gitLabPlatform {
+	c.setHeaders = func(req *http.Request) {
+		if token != "" {
+			req.Header.Set("PRIVATE-TOKEN", token)
+		}
+	}
+	return &gitLabPlatform{client: c}
+}
+
+type gitLabIssue struct {
+	IID         int    `json:"iid"`
+	Title       string `json:"title"`
+	State       string `json:"state"`
+	WebURL      string `json:"web_url"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	Author      struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	Labels     []string `json:"labels"`
+	References struct {
+		Full string `json:"full"`
+	} `json:"references"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+}
+
+func (i *gitLabIssue) toIssue(repo string, isMR bool) *Issue {
+	if repo == "" {
+		// full reference is in the form group/project#1, or group/project!1 for merge requests
+		repo = i.References.Full
+		if idx := strings.LastIndexAny(repo, "#!"); idx >= 0 {
+			repo = repo[:idx]
+		}
+	}
+	return &Issue{
+		Repo:          repo,
+		Number:        i.IID,
+		Title:         i.Title,
+		State:         i.State,
+		URL:           i.WebURL,
+		Author:        i.Author.Username,
+		Labels:        i.Labels,
+		Body:          i.Description,
+		IsPullRequest: isMR,
+		CreatedAt:     i.CreatedAt,
+	}
+}
+
+// gitLabPath returns the api path of the scope, the project, the group, or the instance.
+func (s *scope) gitLabPath() string {
+	if s.repo != "" {
+		return "/projects/" + url.PathEscape(s.repo)
+	}
+	if s.owner != "" {
+		return "/groups/" + url.PathEscape(s.owner)
+	}
+	return ""
+}
+
+func (p *gitLabPlatform) searchIssues(ctx context.Context, s *scope, req *SearchIssuesRequest, limit int) ([]*Issue, error) {
+	resource := "/issues"
+	isMR := req.Type == IssueTypePullRequest
+	if isMR {
+		resource = "/merge_requests"
+	}
+
+	query := url.Values{"search": {req.Query}, "per_page": {strconv.Itoa(limit)}}
+	switch req.State {
+	case "open":
+		query.Set("state", "opened")
+	case "closed":
+		query.Set("state", "closed")
+	}
+	if s.repo == "" && s.owner == "" {
+		query.Set("scope", "all")
+	}
+
+	var result []*gitLabIssue
+	if err := p.client.doJSON(ctx, http.MethodGet, s.gitLabPath()+resource, query, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	issues := make([]*Issue, 0, len(result))
+	for _, item := range result {
+		issues = append(issues, item.toIssue("", isMR))
+	}
+	return issues, nil
+}
+
+func (p *gitLabPlatform) createIssue(ctx context.Context, repo string, req *CreateIssueRequest) (*Issue, error) {
+	body := map[string]any{"title": req.Title, "description": req.Body}
+	if len(req.Labels) > 0 {
+		body["labels"] = strings.Join(req.Labels, ",")
+	}
+
+	var result gitLabIssue
+	if err := p.client.doJSON(ctx, http.MethodPost, "/projects/"+url.PathEscape(repo)+"/issues", nil, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+	return result.toIssue(repo, false), nil
+}
+
+func (p *gitLabPlatform) createPullRequest(ctx context.Context, repo string, req *CreatePullRequestRequest) (*PullRequest, error) {
+	title := req.Title
+	if req.Draft {
+		title = "Draft: " + title
+	}
+	body := map[string]any{
+		"title":         title,
+		"description":   req.Body,
+		"source_branch": req.Head,
+		"target_branch": req.Base,
+	}
+
+	var result gitLabIssue
+	if err := p.client.doJSON(ctx, http.MethodPost, "/projects/"+url.PathEscape(repo)+"/merge_requests", nil, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to create merge request: %w", err)
+	}
+
+	return &PullRequest{
+		Repo:   repo,
+		Number: result.IID,
+		Title:  result.Title,
+		State:  result.State,
+		URL:    result.WebURL,
+		Head:   result.SourceBranch,
+		Base:   result.TargetBranch,
+	}, nil
+}
+
+func (p *gitLabPlatform) getPullRequestDiff(ctx context.Context, repo string, number int) (string, error) {
+	var result []struct {
+		OldPath     string `json:"old_path"`
+		NewPath     string `json:"new_path"`
+		Diff        string `json:"diff"`
+		NewFile     bool   `json:"new_file"`
+		DeletedFile bool   `json:"deleted_file"`
+	}
+	path := "/projects/" + url.PathEscape(repo) + "/merge_requests/" + strconv.Itoa(number) + "/diffs"
+	if err := p.client.doJSON(ctx, http.MethodGet, path, url.Values{"per_page": {"100"}}, nil, &result); err != nil {
+		return "", fmt.Errorf("failed to get merge request diff: %w", err)
+	}
+
+	// assemble the unified diff, the same as the diff of GitHub
+	var sb strings.Builder
+	for _, f := range result {
+		oldPath, newPath := "a/"+f.OldPath, "b/"+f.NewPath
+		if f.NewFile {
+			oldPath = "/dev/null"
+		}
+		if f.DeletedFile {
+			newPath = "/dev/null"
+		}
+		fmt.Fprintf(&sb, "diff --git a/%s b/%s\n--- %s\n+++ %s\n", f.OldPath, f.NewPath, oldPath, newPath)
+		sb.WriteString(f.Diff)
+		if !strings.HasSuffix(f.Diff, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+func (p *gitLabPlatform) searchCode(ctx context.Context, s *scope, q string, limit int) ([]*CodeResult, error) {
+	var result []struct {
+		Path      string `json:"path"`
+		Data      string `json:"data"`
+		Ref       string `json:"ref"`
+		ProjectID int    `json:"project_id"`
+	}
+	query := url.Values{"scope": {"blobs"}, "search": {q}, "per_page": {strconv.Itoa(limit)}}
+	if err := p.client.doJSON(ctx, http.MethodGet, s.gitLabPath()+"/search", query, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to search code: %w", err)
+	}
+
+	results := make([]*CodeResult, 0, len(result))
+	for _, item := range result {
+		repo := s.repo
+		if repo == "" {
+			// blobs searched across projects only carry the project id
+			repo = strconv.Itoa(item.ProjectID)
+		}
+		results = append(results, &CodeResult{Repo: repo, Path: item.Path, Fragments: []string{item.Data}})
+	}
+	return results, nil
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/internal/allowlist"
 )
 
 type DeleteRequest struct {
@@ -29,6 +31,10 @@ type DeleteRequest struct {
 
 func (r *DeleteRequestTool) Delete(ctx context.Context, req *DeleteRequest) (string, error) {
 
+	if err := allowlist.Check(req.URL, r.config.AllowedDomains); err != nil {
+		return "", err
+	}
+
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, req.URL, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/openapi"
+)
+
+func main() {
+	spec := `
+openapi: "3.0.0"
+info:
+  title: JSONPlaceholder API
+  version: "1.0.0"
+servers:
+  - url: https://jsonplaceholder.typicode.com
+paths:
+  /posts/{id}:
+    get:
+      operationId: getPost
+      summary: Get a post by id
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: Successful response
+`
+
+	ctx := context.Background()
+
+	// Generate one tool per operation of the spec
+	tools, err := openapi.NewTools(ctx, &openapi.Config{
+		Spec: []byte(spec),
+		// Headers is optional, e.g. for authorization
+		Headers: map[string]string{
+			"User-Agent": "MyCustomAgent",
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to create tools: %v", err)
+	}
+
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			log.Fatalf("Failed to get tool info: %v", err)
+		}
+		fmt.Printf("Tool: %s, Desc: %s\n", info.Name, info.Desc)
+	}
+
+	resp, err := tools[0].InvokableRun(ctx, `{"id": 1}`)
+	if err != nil {
+		log.Fatalf("Request failed: %v", err)
+	}
+
+	fmt.Println(resp)
+}
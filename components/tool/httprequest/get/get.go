@@ -21,6 +21,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/internal/allowlist"
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/internal/response"
 )
 
 type GetRequest struct {
@@ -29,6 +32,10 @@ type GetRequest struct {
 
 func (r *GetRequestTool) Get(ctx context.Context, req *GetRequest) (string, error) {
 
+	if err := allowlist.Check(req.URL, r.config.AllowedDomains); err != nil {
+		return "", err
+	}
+
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, req.URL, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
@@ -48,6 +55,9 @@ func (r *GetRequestTool) Get(ctx context.Context, req *GetRequest) (string, erro
 	if err != nil {
 		return "", fmt.Errorf("failed to read response body: %w", err)
 	}
-	
-	return string(body), nil
+
+	return response.Format(resp.Header.Get("Content-Type"), body, &response.Options{
+		MaxSize: r.config.MaxResponseSize,
+		Raw:     r.config.RawResponse,
+	})
 }
@@ -187,3 +187,39 @@ func TestGet_WithHeaders(t *testing.T) {
 	assert.Equal(t, "Bearer token", receivedHeaders.Get("Authorization"))
 	assert.Equal(t, "test-agent", receivedHeaders.Get("User-Agent"))
 }
+
+func TestGet_ResponseFormat(t *testing.T) {
+	newTool := func(contentType, body string, config *Config) *GetRequestTool {
+		return &GetRequestTool{
+			config: config,
+			client: &http.Client{Transport: &mockTransport{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: 200,
+						Header:     http.Header{"Content-Type": []string{contentType}},
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}, nil
+				},
+			}},
+		}
+	}
+
+	result, err := newTool("application/json", `{"a":1}`, &Config{}).Get(context.Background(), &GetRequest{URL: "https://example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": 1\n}", result)
+
+	result, err = newTool("application/json", `{"a":1}`, &Config{RawResponse: true}).Get(context.Background(), &GetRequest{URL: "https://example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, result)
+
+	result, err = newTool("text/html", `<html><body><script>x()</script><p>Hello &amp; welcome</p></body></html>`, &Config{}).Get(context.Background(), &GetRequest{URL: "https://example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello & welcome", result)
+
+	result, err = newTool("text/plain", "0123456789", &Config{MaxResponseSize: 4}).Get(context.Background(), &GetRequest{URL: "https://example.com"})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result, "0123\n...[truncated"))
+
+	_, err = newTool("image/png", "\x89PNG", &Config{}).Get(context.Background(), &GetRequest{URL: "https://example.com"})
+	assert.Error(t, err)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cloudwego/eino-ext/components/tool/httprequest/delete"
 	"github.com/cloudwego/eino-ext/components/tool/httprequest/get"
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/patch"
 	"github.com/cloudwego/eino-ext/components/tool/httprequest/post"
 	"github.com/cloudwego/eino-ext/components/tool/httprequest/put"
 
@@ -35,6 +36,16 @@ type Config struct {
 	// These headers will be included in every request made by the tool.
 	Headers map[string]string `json:"headers"`
 
+	// Optional.
+	// AllowedDomains limits the domains the tools are allowed to request, e.g. []string{"example.com"}.
+	// A domain allows itself and all its subdomains. If not provided, all domains are allowed.
+	AllowedDomains []string `json:"allowed_domains"`
+
+	// Optional.
+	// MaxResponseSize is the max size of the response text of GET and POST tools in bytes,
+	// longer responses are truncated. Default: no limit.
+	MaxResponseSize int `json:"max_response_size"`
+
 	// Optional.
 	// HttpClient is the HTTP client used to perform the requests.
 	// If not provided, a default client with a 30-second timeout and a standard transport
@@ -47,6 +58,8 @@ func NewToolKit(ctx context.Context, conf *Config) ([]tool.BaseTool, error) {
 	if conf != nil {
 		getConf.Headers = conf.Headers
 		getConf.HttpClient = conf.HttpClient
+		getConf.AllowedDomains = conf.AllowedDomains
+		getConf.MaxResponseSize = conf.MaxResponseSize
 	}
 
 	getTool, err := get.NewTool(ctx, getConf)
@@ -58,6 +71,8 @@ func NewToolKit(ctx context.Context, conf *Config) ([]tool.BaseTool, error) {
 	if conf != nil {
 		postConf.Headers = conf.Headers
 		postConf.HttpClient = conf.HttpClient
+		postConf.AllowedDomains = conf.AllowedDomains
+		postConf.MaxResponseSize = conf.MaxResponseSize
 	}
 	postTool, err := post.NewTool(ctx, postConf)
 	if err != nil {
@@ -68,6 +83,7 @@ func NewToolKit(ctx context.Context, conf *Config) ([]tool.BaseTool, error) {
 	if conf != nil {
 		putConf.Headers = conf.Headers
 		putConf.HttpClient = conf.HttpClient
+		putConf.AllowedDomains = conf.AllowedDomains
 	}
 	putTool, err := put.NewTool(ctx, putConf)
 	if err != nil {
@@ -78,11 +94,23 @@ func NewToolKit(ctx context.Context, conf *Config) ([]tool.BaseTool, error) {
 	if conf != nil {
 		deleteConf.Headers = conf.Headers
 		deleteConf.HttpClient = conf.HttpClient
+		deleteConf.AllowedDomains = conf.AllowedDomains
 	}
 	deleteTool, err := delete.NewTool(ctx, deleteConf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tool DELETE: %w", err)
 	}
 
-	return []tool.BaseTool{getTool, postTool, putTool, deleteTool}, nil
+	patchConf := &patch.Config{}
+	if conf != nil {
+		patchConf.Headers = conf.Headers
+		patchConf.HttpClient = conf.HttpClient
+		patchConf.AllowedDomains = conf.AllowedDomains
+	}
+	patchTool, err := patch.NewTool(ctx, patchConf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tool PATCH: %w", err)
+	}
+
+	return []tool.BaseTool{getTool, postTool, putTool, deleteTool, patchTool}, nil
 }
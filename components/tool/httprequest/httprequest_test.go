@@ -35,7 +35,7 @@ func TestNewToolKit_Success(t *testing.T) {
 
 	tools, err := NewToolKit(ctx, conf)
 	assert.NoError(t, err)
-	assert.Len(t, tools, 4)
+	assert.Len(t, tools, 5)
 
 	var toolNames []string
 	for _, tool := range tools {
@@ -46,13 +46,14 @@ func TestNewToolKit_Success(t *testing.T) {
 	assert.Contains(t, toolNames, "requests_post")
 	assert.Contains(t, toolNames, "requests_put")
 	assert.Contains(t, toolNames, "requests_delete")
+	assert.Contains(t, toolNames, "requests_patch")
 }
 
 func TestNewToolKit_NilConfig(t *testing.T) {
 	ctx := context.Background()
 	tools, err := NewToolKit(ctx, nil)
 	assert.NoError(t, err)
-	assert.Len(t, tools, 4)
+	assert.Len(t, tools, 5)
 
 	var toolNames []string
 	for _, tool := range tools {
@@ -63,4 +64,5 @@ func TestNewToolKit_NilConfig(t *testing.T) {
 	assert.Contains(t, toolNames, "requests_post")
 	assert.Contains(t, toolNames, "requests_put")
 	assert.Contains(t, toolNames, "requests_delete")
+	assert.Contains(t, toolNames, "requests_patch")
 }
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package allowlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	domains := []string{"example.com", "*.api.org", "Docs.Test.IO"}
+
+	assert.NoError(t, Check("https://anything.net/path", nil))
+
+	assert.NoError(t, Check("https://example.com/path", domains))
+	assert.NoError(t, Check("https://sub.example.com:8080/path", domains))
+	assert.NoError(t, Check("https://v1.api.org", domains))
+	assert.NoError(t, Check("https://docs.test.io", domains))
+
+	assert.Error(t, Check("https://evilexample.com", domains))
+	assert.Error(t, Check("https://example.com.evil.net", domains))
+	assert.Error(t, Check("https://test.io", domains))
+	assert.Error(t, Check("/relative/path", domains))
+	assert.Error(t, Check("http://:invalid", domains))
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Options configures how a response body is converted to the text returned to the model.
+type Options struct {
+	// MaxSize is the max size of the returned text in bytes, longer text is truncated.
+	// No limit if MaxSize <= 0.
+	MaxSize int
+	// Raw disables JSON pretty-printing and HTML-to-text conversion.
+	Raw bool
+}
+
+// Format converts the response body to text according to its content type:
+// JSON is pretty-printed, HTML is converted to plain text, and binary content is rejected.
+// The detected content type of the body is used if contentType is empty.
+func Format(contentType string, body []byte, opts *Options) (string, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if len(body) == 0 {
+		return "", nil
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	}
+
+	if !isText(mediaType) {
+		return "", fmt.Errorf("unsupported binary content type: %s, size: %d bytes", mediaType, len(body))
+	}
+
+	text := string(body)
+	if !opts.Raw {
+		switch {
+		case isJSON(mediaType):
+			var buf bytes.Buffer
+			if err := json.Indent(&buf, body, "", "  "); err == nil {
+				text = buf.String()
+			}
+		case mediaType == "text/html" || mediaType == "application/xhtml+xml":
+			text = HTMLToText(text)
+		}
+	}
+
+	return Truncate(text, opts.MaxSize), nil
+}
+
+// Truncate truncates text to at most maxSize bytes without breaking utf-8 characters,
+// and notes the original size at the end. No limit if maxSize <= 0.
+func Truncate(text string, maxSize int) string {
+	if maxSize <= 0 || len(text) <= maxSize {
+		return text
+	}
+
+	cut := maxSize
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s\n...[truncated, %d of %d bytes shown]", text[:cut], cut, len(text))
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func isText(mediaType string) bool {
+	switch {
+	case strings.HasPrefix(mediaType, "text/"),
+		isJSON(mediaType),
+		mediaType == "application/xml", strings.HasSuffix(mediaType, "+xml"),
+		mediaType == "application/javascript", mediaType == "application/ecmascript",
+		mediaType == "application/x-www-form-urlencoded",
+		mediaType == "application/x-ndjson", mediaType == "application/yaml", mediaType == "application/x-yaml",
+		mediaType == "application/graphql":
+		return true
+	}
+	return false
+}
+
+var (
+	htmlHiddenRe  = regexp.MustCompile(`(?is)<(script|style|noscript|template|svg|head)\b.*?</(script|style|noscript|template|svg|head)>`)
+	htmlCommentRe = regexp.MustCompile(`(?s)<!--.*?-->`)
+	htmlBlockRe   = regexp.MustCompile(`(?i)</?(p|div|br|li|ul|ol|tr|table|h[1-6]|section|article|header|footer|nav|blockquote|pre|hr|title)\b[^>]*>`)
+	htmlTagRe     = regexp.MustCompile(`(?s)<[^>]*>`)
+	spacesRe      = regexp.MustCompile(`[ \t\r\f\v]+`)
+	newlinesRe    = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// HTMLToText converts html to plain text, dropping scripts, styles and tags,
+// and keeping line breaks between block elements.
+func HTMLToText(s string) string {
+	s = htmlCommentRe.ReplaceAllString(s, "")
+	s = htmlHiddenRe.ReplaceAllString(s, "")
+	s = htmlBlockRe.ReplaceAllString(s, "\n")
+	s = htmlTagRe.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, "\u00a0", " ")
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(spacesRe.ReplaceAllString(line, " "))
+	}
+	s = strings.Join(lines, "\n")
+	s = newlinesRe.ReplaceAllString(s, "\n\n")
+
+	return strings.TrimSpace(s)
+}
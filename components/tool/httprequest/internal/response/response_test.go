@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package response
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		text, err := Format("application/json; charset=utf-8", []byte(`{"a":1,"b":[true]}`), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n  \"a\": 1,\n  \"b\": [\n    true\n  ]\n}", text)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		text, err := Format("application/json", []byte(`{"a":`), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":`, text)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		text, err := Format("application/json", []byte(`{"a":1}`), &Options{Raw: true})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":1}`, text)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		body := `<html><head><title>T</title><style>p{}</style></head><body>
+<script>alert(1)</script><h1>Hello</h1><p>Tom &amp; <b>Jerry</b></p><!-- c --><ul><li>a</li><li>b</li></ul></body></html>`
+		text, err := Format("text/html", []byte(body), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello\n\nTom & Jerry\n\na\n\nb", text)
+	})
+
+	t.Run("detected content type", func(t *testing.T) {
+		text, err := Format("", []byte("plain text"), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "plain text", text)
+
+		_, err = Format("", []byte("\x89PNG\r\n\x1a\n\x00\x00"), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		_, err := Format("application/octet-stream", []byte{0x00, 0x01}, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported binary content type")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		text, err := Format("image/png", nil, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, text)
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		text, err := Format("text/plain", []byte(strings.Repeat("a", 100)), &Options{MaxSize: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Repeat("a", 10)+"\n...[truncated, 10 of 100 bytes shown]", text)
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", Truncate("abc", 0))
+	assert.Equal(t, "abc", Truncate("abc", 3))
+	assert.Equal(t, "你\n...[truncated, 3 of 6 bytes shown]", Truncate("你好", 4))
+}
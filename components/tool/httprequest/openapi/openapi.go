@@ -0,0 +1,298 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+)
+
+// bodyParam is the name of the tool parameter carrying the request body of the operation.
+const bodyParam = "body"
+
+// maxSchemaDepth limits the expansion of nested or recursive schemas.
+const maxSchemaDepth = 8
+
+type operationTool struct {
+	config  *Config
+	client  *http.Client
+	info    *schema.ToolInfo
+	method  string
+	path    string
+	baseURL string
+	params  []*openapi3.Parameter
+	hasBody bool
+	// bodyRequired reports whether the request body is required by the operation.
+	bodyRequired bool
+}
+
+func newOperationTool(config *Config, name, method, path, baseURL string, item *openapi3.PathItem, op *openapi3.Operation) (*operationTool, error) {
+	t := &operationTool{
+		config:  config,
+		client:  config.HttpClient,
+		method:  method,
+		path:    path,
+		baseURL: baseURL,
+	}
+
+	params := &openapi3.Schema{
+		Type:       openapi3.TypeObject,
+		Properties: make(openapi3.Schemas),
+	}
+
+	// parameters of the operation override the ones of the path with the same name and location
+	seen := make(map[string]bool)
+	for _, refs := range []openapi3.Parameters{op.Parameters, item.Parameters} {
+		for _, ref := range refs {
+			p := ref.Value
+			if p == nil || seen[p.In+":"+p.Name] {
+				continue
+			}
+			seen[p.In+":"+p.Name] = true
+			if p.In == openapi3.ParameterInCookie {
+				continue
+			}
+			if _, ok := params.Properties[p.Name]; ok {
+				return nil, fmt.Errorf("duplicated parameter name: %s", p.Name)
+			}
+
+			s := &openapi3.Schema{Type: openapi3.TypeString}
+			if expanded := expandSchema(p.Schema, 0); expanded != nil {
+				s = expanded.Value
+			}
+			if p.Description != "" {
+				s.Description = p.Description
+			}
+			params.Properties[p.Name] = openapi3.NewSchemaRef("", s)
+			if p.Required || p.In == openapi3.ParameterInPath {
+				params.Required = append(params.Required, p.Name)
+			}
+			t.params = append(t.params, p)
+		}
+	}
+
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		body := op.RequestBody.Value
+		media := body.Content.Get("application/json")
+		if media == nil {
+			return nil, fmt.Errorf("unsupported request body content type, only application/json is supported")
+		}
+		if _, ok := params.Properties[bodyParam]; ok {
+			return nil, fmt.Errorf("parameter name %q conflicts with request body", bodyParam)
+		}
+
+		s := &openapi3.Schema{Type: openapi3.TypeObject}
+		if expanded := expandSchema(media.Schema, 0); expanded != nil {
+			s = expanded.Value
+		}
+		if body.Description != "" {
+			s.Description = body.Description
+		}
+		params.Properties[bodyParam] = openapi3.NewSchemaRef("", s)
+		if body.Required {
+			params.Required = append(params.Required, bodyParam)
+			t.bodyRequired = true
+		}
+		t.hasBody = true
+	}
+
+	desc := strings.TrimSpace(strings.TrimSpace(op.Summary) + "\n" + strings.TrimSpace(op.Description))
+	if desc == "" {
+		desc = method + " " + path
+	}
+
+	t.info = &schema.ToolInfo{
+		Name:        name,
+		Desc:        desc,
+		ParamsOneOf: schema.NewParamsOneOfByOpenAPIV3(params),
+	}
+
+	return t, nil
+}
+
+func (t *operationTool) Info(_ context.Context) (*schema.ToolInfo, error) {
+	return t.info, nil
+}
+
+func (t *operationTool) InvokableRun(ctx context.Context, argumentsInJSON string, _ ...tool.Option) (string, error) {
+	args := make(map[string]any)
+	if strings.TrimSpace(argumentsInJSON) != "" {
+		if err := sonic.UnmarshalString(argumentsInJSON, &args); err != nil {
+			return "", fmt.Errorf("failed to unmarshal arguments: %w", err)
+		}
+	}
+
+	httpReq, err := t.buildRequest(ctx, args)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := t.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Sprintf("request failed with status code %d: %s", resp.StatusCode, string(body)), nil
+	}
+
+	return string(body), nil
+}
+
+func (t *operationTool) buildRequest(ctx context.Context, args map[string]any) (*http.Request, error) {
+	path := t.path
+	query := url.Values{}
+	headers := make(map[string]string)
+
+	for _, p := range t.params {
+		value, ok := args[p.Name]
+		if !ok || value == nil {
+			if p.In == openapi3.ParameterInPath {
+				return nil, fmt.Errorf("missing path parameter: %s", p.Name)
+			}
+			if p.Required {
+				return nil, fmt.Errorf("missing required parameter: %s", p.Name)
+			}
+			continue
+		}
+
+		switch p.In {
+		case openapi3.ParameterInPath:
+			path = strings.ReplaceAll(path, "{"+p.Name+"}", url.PathEscape(formatValue(value)))
+		case openapi3.ParameterInQuery:
+			if values, ok := value.([]any); ok {
+				for _, v := range values {
+					query.Add(p.Name, formatValue(v))
+				}
+			} else {
+				query.Set(p.Name, formatValue(value))
+			}
+		case openapi3.ParameterInHeader:
+			headers[p.Name] = formatValue(value)
+		}
+	}
+
+	var body io.Reader
+	if t.hasBody {
+		if value, ok := args[bodyParam]; ok && value != nil {
+			data, err := sonic.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal request body: %w", err)
+			}
+			body = strings.NewReader(string(data))
+		} else if t.bodyRequired {
+			return nil, fmt.Errorf("missing required parameter: %s", bodyParam)
+		}
+	}
+
+	reqURL := t.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, t.method, reqURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, value := range t.config.Headers {
+		httpReq.Header.Set(key, value)
+	}
+	for key, value := range headers {
+		httpReq.Header.Set(key, value)
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	return httpReq, nil
+}
+
+// formatValue formats a JSON decoded argument as a path, query or header value.
+func formatValue(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64, bool:
+		return fmt.Sprint(val)
+	default:
+		data, err := sonic.Marshal(val)
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(data)
+	}
+}
+
+// expandSchema copies the schema with all references resolved inline,
+// since references to the components of the spec can not be followed by chat models.
+func expandSchema(ref *openapi3.SchemaRef, depth int) *openapi3.SchemaRef {
+	if ref == nil || ref.Value == nil {
+		return nil
+	}
+
+	src := ref.Value
+	if depth >= maxSchemaDepth {
+		return openapi3.NewSchemaRef("", &openapi3.Schema{Type: src.Type, Description: src.Description})
+	}
+
+	s := *src
+	s.Items = expandSchema(src.Items, depth+1)
+	s.Not = expandSchema(src.Not, depth+1)
+	s.OneOf = expandSchemas(src.OneOf, depth+1)
+	s.AnyOf = expandSchemas(src.AnyOf, depth+1)
+	s.AllOf = expandSchemas(src.AllOf, depth+1)
+	if src.Properties != nil {
+		s.Properties = make(openapi3.Schemas, len(src.Properties))
+		for name, prop := range src.Properties {
+			if expanded := expandSchema(prop, depth+1); expanded != nil {
+				s.Properties[name] = expanded
+			}
+		}
+	}
+
+	return openapi3.NewSchemaRef("", &s)
+}
+
+func expandSchemas(refs openapi3.SchemaRefs, depth int) openapi3.SchemaRefs {
+	if refs == nil {
+		return nil
+	}
+	result := make(openapi3.SchemaRefs, 0, len(refs))
+	for _, ref := range refs {
+		if expanded := expandSchema(ref, depth); expanded != nil {
+			result = append(result, expanded)
+		}
+	}
+	return result
+}
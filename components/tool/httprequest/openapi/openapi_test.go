@@ -0,0 +1,306 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudwego/eino/components/tool"
+)
+
+const testSpec = `
+openapi: "3.0.0"
+info:
+  title: Pet Store
+  version: "1.0.0"
+servers:
+  - url: https://petstore.example.com/v1
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List pets
+      parameters:
+        - name: limit
+          in: query
+          description: Max number of pets
+          schema:
+            type: integer
+        - name: tags
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+      responses:
+        "200":
+          description: OK
+    post:
+      summary: Create a pet
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Pet"
+      responses:
+        "201":
+          description: Created
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        schema:
+          type: string
+    delete:
+      operationId: deletePet
+      description: Delete a pet
+      parameters:
+        - name: X-Request-Id
+          in: header
+          schema:
+            type: string
+      responses:
+        "204":
+          description: Deleted
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        owner:
+          $ref: "#/components/schemas/Owner"
+    Owner:
+      type: object
+      properties:
+        email:
+          type: string
+`
+
+func toolsByName(t *testing.T, tools []tool.InvokableTool) map[string]tool.InvokableTool {
+	result := make(map[string]tool.InvokableTool)
+	for _, tl := range tools {
+		info, err := tl.Info(context.Background())
+		require.NoError(t, err)
+		result[info.Name] = tl
+	}
+	return result
+}
+
+func TestNewTools(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing spec", func(t *testing.T) {
+		_, err := NewTools(ctx, &Config{})
+		assert.Error(t, err)
+		_, err = NewTools(ctx, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := NewTools(ctx, &Config{Spec: []byte("not a spec")})
+		assert.Error(t, err)
+	})
+
+	t.Run("all operations", func(t *testing.T) {
+		tools, err := NewTools(ctx, &Config{Spec: []byte(testSpec)})
+		require.NoError(t, err)
+		byName := toolsByName(t, tools)
+		assert.Len(t, byName, 3)
+		assert.Contains(t, byName, "listPets")
+		assert.Contains(t, byName, "post_pets")
+		assert.Contains(t, byName, "deletePet")
+	})
+
+	t.Run("filter and prefix", func(t *testing.T) {
+		tools, err := NewTools(ctx, &Config{
+			Spec:           []byte(testSpec),
+			Operations:     []string{"listPets", "post_pets"},
+			ToolNamePrefix: "store_",
+		})
+		require.NoError(t, err)
+		byName := toolsByName(t, tools)
+		assert.Len(t, byName, 2)
+		assert.Contains(t, byName, "store_listPets")
+		assert.Contains(t, byName, "store_post_pets")
+	})
+
+	t.Run("params schema", func(t *testing.T) {
+		tools, err := NewTools(ctx, &Config{Spec: []byte(testSpec)})
+		require.NoError(t, err)
+		byName := toolsByName(t, tools)
+
+		info, err := byName["post_pets"].Info(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Create a pet", info.Desc)
+		s, err := info.ParamsOneOf.ToOpenAPIV3()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"body"}, s.Required)
+		body := s.Properties["body"]
+		require.NotNil(t, body)
+		assert.Empty(t, body.Ref)
+		assert.Equal(t, []string{"name"}, body.Value.Required)
+		owner := body.Value.Properties["owner"]
+		require.NotNil(t, owner)
+		assert.Empty(t, owner.Ref)
+		assert.Contains(t, owner.Value.Properties, "email")
+
+		info, err = byName["deletePet"].Info(ctx)
+		require.NoError(t, err)
+		s, err = info.ParamsOneOf.ToOpenAPIV3()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"petId"}, s.Required)
+		assert.Contains(t, s.Properties, "X-Request-Id")
+	})
+
+	t.Run("spec url", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "secret", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(testSpec))
+		}))
+		defer server.Close()
+
+		tools, err := NewTools(ctx, &Config{
+			SpecURL: server.URL + "/openapi.yaml",
+			Headers: map[string]string{"Authorization": "secret"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, tools, 3)
+	})
+}
+
+func TestResolveBaseURL(t *testing.T) {
+	spec := `
+openapi: "3.0.0"
+info:
+  title: Test
+  version: "1.0.0"
+servers:
+  - url: /api/{version}
+    variables:
+      version:
+        default: v2
+paths:
+  /ping:
+    get:
+      operationId: ping
+      responses:
+        "200":
+          description: OK
+`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/spec.yaml" {
+			_, _ = w.Write([]byte(spec))
+			return
+		}
+		assert.Equal(t, "/api/v2/ping", r.URL.Path)
+		_, _ = w.Write([]byte("pong"))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	_, err := NewTools(ctx, &Config{Spec: []byte(spec)})
+	assert.Error(t, err)
+
+	tools, err := NewTools(ctx, &Config{SpecURL: server.URL + "/spec.yaml"})
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+	result, err := tools[0].InvokableRun(ctx, "{}")
+	require.NoError(t, err)
+	assert.Equal(t, "pong", result)
+}
+
+func TestInvokableRun(t *testing.T) {
+	ctx := context.Background()
+
+	var gotReq *http.Request
+	var gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		data, _ := io.ReadAll(r.Body)
+		gotBody = string(data)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("pet not found"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	tools, err := NewTools(ctx, &Config{
+		Spec:    []byte(testSpec),
+		BaseURL: server.URL + "/v1/",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	require.NoError(t, err)
+	byName := toolsByName(t, tools)
+
+	t.Run("query", func(t *testing.T) {
+		result, err := byName["listPets"].InvokableRun(ctx, `{"limit": 10, "tags": ["cat", "dog"]}`)
+		require.NoError(t, err)
+		assert.Equal(t, `{"ok":true}`, result)
+		assert.Equal(t, http.MethodGet, gotReq.Method)
+		assert.Equal(t, "/v1/pets", gotReq.URL.Path)
+		assert.Equal(t, "10", gotReq.URL.Query().Get("limit"))
+		assert.Equal(t, []string{"cat", "dog"}, gotReq.URL.Query()["tags"])
+		assert.Equal(t, "Bearer token", gotReq.Header.Get("Authorization"))
+	})
+
+	t.Run("body", func(t *testing.T) {
+		_, err := byName["post_pets"].InvokableRun(ctx, `{"body": {"name": "kitty"}}`)
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPost, gotReq.Method)
+		assert.Equal(t, "application/json", gotReq.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"name": "kitty"}`, gotBody)
+	})
+
+	t.Run("path and header", func(t *testing.T) {
+		result, err := byName["deletePet"].InvokableRun(ctx, `{"petId": "a/1", "X-Request-Id": "req-1"}`)
+		require.NoError(t, err)
+		assert.Equal(t, "request failed with status code 404: pet not found", result)
+		assert.Equal(t, http.MethodDelete, gotReq.Method)
+		assert.Equal(t, "/v1/pets/a%2F1", gotReq.URL.EscapedPath())
+		assert.Equal(t, "req-1", gotReq.Header.Get("X-Request-Id"))
+	})
+
+	t.Run("missing parameter", func(t *testing.T) {
+		_, err := byName["deletePet"].InvokableRun(ctx, `{}`)
+		assert.Error(t, err)
+		_, err = byName["post_pets"].InvokableRun(ctx, `{}`)
+		assert.Error(t, err)
+		_, err = byName["post_pets"].InvokableRun(ctx, `{`)
+		assert.Error(t, err)
+	})
+}
+
+func TestToolName(t *testing.T) {
+	assert.Equal(t, "listPets", toolName("listPets", "GET", "/pets"))
+	assert.Equal(t, "get_pets_petId", toolName("", "GET", "/pets/{petId}"))
+	assert.Equal(t, "pets_list", toolName("pets.list", "GET", "/pets"))
+}
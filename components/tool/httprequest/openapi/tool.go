@@ -0,0 +1,282 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/cloudwego/eino/components/tool"
+)
+
+type Config struct {
+	// Spec is the content of the OpenAPI 3 spec, in JSON or YAML format.
+	// One of Spec, SpecPath and SpecURL is required.
+	Spec []byte `json:"spec"`
+	// SpecPath is the local file path of the OpenAPI 3 spec.
+	SpecPath string `json:"spec_path"`
+	// SpecURL is the URL of the OpenAPI 3 spec, fetched with HttpClient and Headers.
+	SpecURL string `json:"spec_url"`
+
+	// Optional.
+	// BaseURL overrides the servers declared in the spec, e.g. "https://api.example.com/v1".
+	// If not provided, the first server of the operation, path or document is used,
+	// and relative server URLs are resolved against SpecURL.
+	BaseURL string `json:"base_url"`
+
+	// Optional.
+	// Operations limits the generated tools to the given operation ids or tool names.
+	// If not provided, one tool is generated for every operation in the spec.
+	Operations []string `json:"operations"`
+
+	// Optional.
+	// ToolNamePrefix is prepended to the name of every generated tool, e.g. "crm_".
+	ToolNamePrefix string `json:"tool_name_prefix"`
+
+	// Optional.
+	// Headers is a map of HTTP header names to their corresponding values.
+	// These headers will be included in every request made by the tools, e.g. authorization.
+	Headers map[string]string `json:"headers"`
+
+	// Optional.
+	// HttpClient is the HTTP client used to perform the requests.
+	// If not provided, a default client with a 30-second timeout and a standard transport
+	// will be initialized and used.
+	HttpClient *http.Client
+}
+
+func (c *Config) validate() error {
+	if len(c.Spec) == 0 && c.SpecPath == "" && c.SpecURL == "" {
+		return errors.New("one of spec, spec path and spec url is required")
+	}
+	if c.Headers == nil {
+		c.Headers = make(map[string]string)
+	}
+	if c.HttpClient == nil {
+		c.HttpClient = &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{},
+		}
+	}
+	return nil
+}
+
+// NewTools loads the OpenAPI 3 spec and generates one InvokableTool per operation.
+// Path, query and header parameters and the JSON request body of each operation are
+// exposed as the parameters of the tool, and bound to the HTTP request when the tool is invoked.
+func NewTools(ctx context.Context, config *Config) ([]tool.InvokableTool, error) {
+	if config == nil {
+		return nil, errors.New("openapi tool configuration is required")
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	doc, err := loadSpec(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+
+	allowed := make(map[string]bool, len(config.Operations))
+	for _, op := range config.Operations {
+		allowed[op] = true
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var tools []tool.InvokableTool
+	names := make(map[string]bool)
+	for _, path := range paths {
+		item := doc.Paths[path]
+		if item == nil {
+			continue
+		}
+		ops := item.Operations()
+		methods := make([]string, 0, len(ops))
+		for method := range ops {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+
+		for _, method := range methods {
+			op := ops[method]
+			name := toolName(op.OperationID, method, path)
+			if len(allowed) > 0 && !allowed[op.OperationID] && !allowed[name] {
+				continue
+			}
+			name = config.ToolNamePrefix + name
+			if names[name] {
+				return nil, fmt.Errorf("duplicated tool name: %s", name)
+			}
+			names[name] = true
+
+			baseURL, err := resolveBaseURL(config, doc, item, op)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve base url of %s %s: %w", method, path, err)
+			}
+
+			t, err := newOperationTool(config, name, method, path, baseURL, item, op)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create tool of %s %s: %w", method, path, err)
+			}
+			tools = append(tools, t)
+		}
+	}
+
+	if len(tools) == 0 {
+		return nil, errors.New("no operation found in openapi spec")
+	}
+
+	return tools, nil
+}
+
+func loadSpec(ctx context.Context, config *Config) (*openapi3.T, error) {
+	data := config.Spec
+	if len(data) == 0 {
+		var err error
+		if config.SpecPath != "" {
+			data, err = os.ReadFile(config.SpecPath)
+		} else {
+			data, err = fetchSpec(ctx, config)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, err
+	}
+	if err = doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	return doc, nil
+}
+
+func fetchSpec(ctx context.Context, config *Config) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.SpecURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, value := range config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := config.HttpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return body, nil
+}
+
+func resolveBaseURL(config *Config, doc *openapi3.T, item *openapi3.PathItem, op *openapi3.Operation) (string, error) {
+	if config.BaseURL != "" {
+		return strings.TrimRight(config.BaseURL, "/"), nil
+	}
+
+	var server *openapi3.Server
+	switch {
+	case op.Servers != nil && len(*op.Servers) > 0:
+		server = (*op.Servers)[0]
+	case len(item.Servers) > 0:
+		server = item.Servers[0]
+	case len(doc.Servers) > 0:
+		server = doc.Servers[0]
+	}
+	if server == nil || server.URL == "" {
+		return "", errors.New("no server declared in spec, base url is required")
+	}
+
+	serverURL := server.URL
+	for name, variable := range server.Variables {
+		if variable != nil {
+			serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", variable.Default)
+		}
+	}
+
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return "", err
+	}
+	if !u.IsAbs() {
+		if config.SpecURL == "" {
+			return "", fmt.Errorf("relative server url %q requires spec url or base url", serverURL)
+		}
+		specURL, err := url.Parse(config.SpecURL)
+		if err != nil {
+			return "", err
+		}
+		u = specURL.ResolveReference(u)
+	}
+
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// toolName returns the operation id, or a name derived from method and path, e.g. "get_users_id",
+// limited to the characters and length accepted by chat models.
+func toolName(operationID, method, path string) string {
+	raw := operationID
+	if raw == "" {
+		raw = strings.ToLower(method) + "_" + path
+	}
+
+	var sb strings.Builder
+	lastUnderscore := false
+	for _, r := range raw {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+			sb.WriteRune(r)
+			lastUnderscore = false
+		default:
+			if !lastUnderscore {
+				sb.WriteByte('_')
+				lastUnderscore = true
+			}
+		}
+	}
+
+	name := strings.Trim(sb.String(), "_")
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return name
+}
@@ -22,6 +22,9 @@ import (
 	"io"
 	"net/http"
 	"strings"
+
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/internal/allowlist"
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/internal/response"
 )
 
 type PostRequest struct {
@@ -31,6 +34,10 @@ type PostRequest struct {
 
 func (r *PostRequestTool) Post(ctx context.Context, req *PostRequest) (string, error) {
 
+	if err := allowlist.Check(req.URL, r.config.AllowedDomains); err != nil {
+		return "", err
+	}
+
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, strings.NewReader(req.Body))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
@@ -51,5 +58,8 @@ func (r *PostRequestTool) Post(ctx context.Context, req *PostRequest) (string, e
 		return "", fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	return string(body), nil
+	return response.Format(resp.Header.Get("Content-Type"), body, &response.Options{
+		MaxSize: r.config.MaxResponseSize,
+		Raw:     r.config.RawResponse,
+	})
 }
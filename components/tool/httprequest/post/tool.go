@@ -45,6 +45,21 @@ type Config struct {
 	// Headers is a map of HTTP header names to their corresponding values.
 	// These headers will be included in every request made by the tool.
 	Headers map[string]string `json:"headers"`
+	// Optional.
+	// AllowedDomains limits the domains the tool is allowed to request, e.g. []string{"example.com"}.
+	// A domain allows itself and all its subdomains. If not provided, all domains are allowed.
+	AllowedDomains []string `json:"allowed_domains"`
+
+	// Optional.
+	// MaxResponseSize is the max size of the response text returned to the model in bytes,
+	// longer responses are truncated so that they don't blow the context window. Default: no limit.
+	MaxResponseSize int `json:"max_response_size"`
+
+	// Optional.
+	// RawResponse disables the conversion of the response body, which by default
+	// pretty-prints JSON and converts HTML to plain text.
+	// Binary responses, e.g. images, are always rejected.
+	RawResponse bool `json:"raw_response"`
 
 	// Optional.
 	// HttpClient is the HTTP client used to perform the requests.
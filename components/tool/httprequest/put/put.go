@@ -22,6 +22,8 @@ import (
 	"io"
 	"net/http"
 	"strings"
+
+	"github.com/cloudwego/eino-ext/components/tool/httprequest/internal/allowlist"
 )
 
 type PutRequest struct {
@@ -31,6 +33,10 @@ type PutRequest struct {
 
 func (r *PutRequestTool) Put(ctx context.Context, req *PutRequest) (string, error) {
 
+	if err := allowlist.Check(req.URL, r.config.AllowedDomains); err != nil {
+		return "", err
+	}
+
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPut, req.URL, strings.NewReader(req.Body))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
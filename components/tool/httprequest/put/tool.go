@@ -45,6 +45,10 @@ type Config struct {
 	// Headers is a map of HTTP header names to their corresponding values.
 	// These headers will be included in every request made by the tool.
 	Headers map[string]string `json:"headers"`
+	// Optional.
+	// AllowedDomains limits the domains the tool is allowed to request, e.g. []string{"example.com"}.
+	// A domain allows itself and all its subdomains. If not provided, all domains are allowed.
+	AllowedDomains []string `json:"allowed_domains"`
 
 	// Optional.
 	// HttpClient is the HTTP client used to perform the requests.
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/tool/imagegen"
+)
+
+func main() {
+	ctx := context.Background()
+
+	imageTool, err := imagegen.NewTool(ctx, &imagegen.Config{
+		Provider: imagegen.ProviderArk,
+		APIKey:   os.Getenv("ARK_API_KEY"),
+	})
+	if err != nil {
+		log.Fatalf("NewTool failed, err=%v", err)
+	}
+
+	result, err := imageTool.InvokableRun(ctx, `{"prompt": "a cat sitting on a windowsill, watercolor"}`)
+	if err != nil {
+		log.Fatalf("InvokableRun failed, err=%v", err)
+	}
+	fmt.Println(result)
+}
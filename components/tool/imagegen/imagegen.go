@@ -0,0 +1,319 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+type Provider string
+
+const (
+	ProviderOpenAI Provider = "openai"
+	ProviderArk    Provider = "ark"
+)
+
+type ResponseFormat string
+
+const (
+	ResponseFormatURL     ResponseFormat = "url"
+	ResponseFormatB64JSON ResponseFormat = "b64_json"
+)
+
+// Config represents the image generation tool configuration.
+type Config struct {
+	ToolName string `json:"tool_name"` // optional, default is "image_generation"
+	ToolDesc string `json:"tool_desc"` // optional, default is "generate images from a text description"
+
+	// Provider specifies the image generation service.
+	// Optional, default: ProviderOpenAI
+	Provider Provider `json:"provider"`
+
+	// APIKey is the api key of the provider, sent as "Authorization: Bearer <api key>".
+	// Required
+	APIKey string `json:"api_key"`
+
+	// BaseURL is the base url of the API, used for OpenAI compatible services or other regions of Ark.
+	// Optional, default: "https://api.openai.com/v1" for OpenAI, "https://ark.cn-beijing.volces.com/api/v3" for Ark
+	BaseURL string `json:"base_url"`
+
+	// Model is the image generation model, e.g. "dall-e-3", "gpt-image-1", or the Ark model "doubao-seedream-3-0-t2i-250415".
+	// Optional, default: "dall-e-3" for OpenAI, "doubao-seedream-3-0-t2i-250415" for Ark
+	Model string `json:"model"`
+
+	// ResponseFormat specifies whether the images are returned as urls or base64 encoded json.
+	// The urls expire after a while, download the images in time. gpt-image-1 always returns base64.
+	// Optional, default: ResponseFormatURL
+	ResponseFormat ResponseFormat `json:"response_format"`
+
+	// DefaultSize is the size of the images when the request does not specify one.
+	// Optional, default: "1024x1024"
+	DefaultSize string `json:"default_size"`
+
+	// AllowedSizes limits the sizes the model can request, e.g. to control the cost.
+	// Optional, default: no limit
+	AllowedSizes []string `json:"allowed_sizes"`
+
+	// MaxImages limits the number of images of a request, only OpenAI generates multiple images in a request.
+	// Optional, default: 1
+	MaxImages int `json:"max_images"`
+
+	// Watermark adds the "AI generated" watermark to the images of Ark.
+	// Optional, default: nil, the default of Ark
+	Watermark *bool `json:"watermark"`
+
+	// HttpClient is the http client used to send requests.
+	// Optional, default: &http.Client{Timeout: 120 * time.Second}
+	HttpClient *http.Client `json:"-"`
+}
+
+// NewTool creates the image generation tool.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	g, err := newImageGen(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image generation tool: %w", err)
+	}
+
+	imageTool, err := utils.InferTool(config.ToolName, config.ToolDesc, g.Generate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return imageTool, nil
+}
+
+// validate validates the image generation tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "image_generation"
+	}
+
+	if c.ToolDesc == "" {
+		c.ToolDesc = "generate images from a text description, returns the urls or base64 data of the images"
+	}
+
+	switch c.Provider {
+	case "":
+		c.Provider = ProviderOpenAI
+	case ProviderOpenAI, ProviderArk:
+	default:
+		return fmt.Errorf("unsupported provider: %s", c.Provider)
+	}
+
+	if c.APIKey == "" {
+		return errors.New("api key is required")
+	}
+
+	if c.BaseURL == "" {
+		if c.Provider == ProviderArk {
+			c.BaseURL = "https://ark.cn-beijing.volces.com/api/v3"
+		} else {
+			c.BaseURL = "https://api.openai.com/v1"
+		}
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
+	if c.Model == "" {
+		if c.Provider == ProviderArk {
+			c.Model = "doubao-seedream-3-0-t2i-250415"
+		} else {
+			c.Model = "dall-e-3"
+		}
+	}
+
+	switch c.ResponseFormat {
+	case "":
+		c.ResponseFormat = ResponseFormatURL
+	case ResponseFormatURL, ResponseFormatB64JSON:
+	default:
+		return fmt.Errorf("unsupported response format: %s", c.ResponseFormat)
+	}
+
+	if c.DefaultSize == "" {
+		c.DefaultSize = "1024x1024"
+	}
+
+	if c.MaxImages <= 0 {
+		c.MaxImages = 1
+	}
+
+	if c.HttpClient == nil {
+		c.HttpClient = &http.Client{Timeout: 120 * time.Second}
+	}
+
+	return nil
+}
+
+type imageGen struct {
+	config *Config
+}
+
+func newImageGen(config *Config) (*imageGen, error) {
+	if config == nil {
+		return nil, errors.New("image generation tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &imageGen{config: config}, nil
+}
+
+type Request struct {
+	Prompt  string `json:"prompt" jsonschema_description:"The detailed description of the image to generate"`
+	Size    string `json:"size,omitempty" jsonschema_description:"The size of the image in WIDTHxHEIGHT, e.g. 1024x1024, 1792x1024 or 1024x1792"`
+	Quality string `json:"quality,omitempty" jsonschema_description:"The quality of the image, standard or hd for dall-e-3, low, medium or high for gpt-image-1, ignored by other models"`
+	Style   string `json:"style,omitempty" jsonschema:"enum=vivid,enum=natural" jsonschema_description:"The style of the image, vivid for hyper-real and dramatic images, natural for more natural ones, only supported by dall-e-3"`
+	N       int    `json:"n,omitempty" jsonschema_description:"The number of images to generate, default: 1"`
+	Seed    *int   `json:"seed,omitempty" jsonschema_description:"The random seed to reproduce an image, only supported by Ark"`
+}
+
+type Image struct {
+	URL           string `json:"url,omitempty" jsonschema_description:"The url of the image"`
+	B64JSON       string `json:"b64_json,omitempty" jsonschema_description:"The base64 encoded image"`
+	RevisedPrompt string `json:"revised_prompt,omitempty" jsonschema_description:"The prompt revised by the model to generate the image"`
+}
+
+type Response struct {
+	Images []*Image `json:"images" jsonschema_description:"The generated images"`
+}
+
+type generateRequest struct {
+	Model          string `json:"model"`
+	Prompt         string `json:"prompt"`
+	N              int    `json:"n,omitempty"`
+	Size           string `json:"size,omitempty"`
+	Quality        string `json:"quality,omitempty"`
+	Style          string `json:"style,omitempty"`
+	ResponseFormat string `json:"response_format,omitempty"`
+	Seed           *int   `json:"seed,omitempty"`
+	Watermark      *bool  `json:"watermark,omitempty"`
+}
+
+type generateResponse struct {
+	Data []*Image `json:"data"`
+}
+
+// Generate generates the images of the request.
+func (g *imageGen) Generate(ctx context.Context, request *Request) (*Response, error) {
+	if strings.TrimSpace(request.Prompt) == "" {
+		return nil, errors.New("prompt is required")
+	}
+
+	size := request.Size
+	if size == "" {
+		size = g.config.DefaultSize
+	}
+	if len(g.config.AllowedSizes) > 0 && !contains(g.config.AllowedSizes, size) {
+		return nil, fmt.Errorf("size %s is not allowed, allowed sizes: %s", size, strings.Join(g.config.AllowedSizes, ", "))
+	}
+
+	n := request.N
+	if n <= 0 {
+		n = 1
+	}
+	if n > g.config.MaxImages {
+		return nil, fmt.Errorf("at most %d images can be generated in a request", g.config.MaxImages)
+	}
+
+	body := &generateRequest{
+		Model:  g.config.Model,
+		Prompt: request.Prompt,
+		Size:   size,
+	}
+	switch g.config.Provider {
+	case ProviderArk:
+		if n > 1 {
+			return nil, errors.New("ark generates one image in a request")
+		}
+		body.ResponseFormat = string(g.config.ResponseFormat)
+		body.Seed = request.Seed
+		body.Watermark = g.config.Watermark
+	default:
+		body.N = n
+		body.Quality = request.Quality
+		// gpt-image-1 always returns base64, and rejects response_format and style
+		if !strings.HasPrefix(g.config.Model, "gpt-image") {
+			body.ResponseFormat = string(g.config.ResponseFormat)
+			body.Style = request.Style
+		}
+	}
+
+	result := &generateResponse{}
+	if err := g.post(ctx, "/images/generations", body, result); err != nil {
+		return nil, err
+	}
+	if len(result.Data) == 0 {
+		return nil, errors.New("no image is generated")
+	}
+
+	return &Response{Images: result.Data}, nil
+}
+
+func (g *imageGen) post(ctx context.Context, path string, body, result any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.config.BaseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.config.APIKey)
+
+	resp, err := g.config.HttpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, respBody)
+	}
+
+	if err = json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imagegen
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(t *testing.T, got *generateRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/images/generations", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		*got = generateRequest{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		if got.Prompt == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"content policy violation"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"created":1,"data":[{"url":"https://example.com/cat.png","revised_prompt":"a cute cat"}]}`))
+	}))
+}
+
+func TestGenerate(t *testing.T) {
+	ctx := context.Background()
+	var got generateRequest
+	ts := newTestServer(t, &got)
+	defer ts.Close()
+
+	t.Run("openai", func(t *testing.T) {
+		g, err := newImageGen(&Config{APIKey: "test-key", BaseURL: ts.URL, MaxImages: 2})
+		assert.NoError(t, err)
+
+		resp, err := g.Generate(ctx, &Request{Prompt: "a cat", Quality: "hd", Style: "vivid", N: 2, Seed: new(int)})
+		assert.NoError(t, err)
+		assert.Equal(t, []*Image{{URL: "https://example.com/cat.png", RevisedPrompt: "a cute cat"}}, resp.Images)
+		assert.Equal(t, generateRequest{
+			Model: "dall-e-3", Prompt: "a cat", N: 2, Size: "1024x1024", Quality: "hd", Style: "vivid", ResponseFormat: "url",
+		}, got)
+
+		_, err = g.Generate(ctx, &Request{Prompt: "a cat", N: 3})
+		assert.EqualError(t, err, "at most 2 images can be generated in a request")
+
+		_, err = g.Generate(ctx, &Request{Prompt: "bad"})
+		assert.EqualError(t, err, `request failed with status code 400: {"error":{"message":"content policy violation"}}`)
+
+		_, err = g.Generate(ctx, &Request{})
+		assert.EqualError(t, err, "prompt is required")
+	})
+
+	t.Run("gpt-image", func(t *testing.T) {
+		g, err := newImageGen(&Config{APIKey: "test-key", BaseURL: ts.URL, Model: "gpt-image-1"})
+		assert.NoError(t, err)
+
+		_, err = g.Generate(ctx, &Request{Prompt: "a cat", Style: "vivid", Quality: "high"})
+		assert.NoError(t, err)
+		assert.Equal(t, generateRequest{Model: "gpt-image-1", Prompt: "a cat", N: 1, Size: "1024x1024", Quality: "high"}, got)
+	})
+
+	t.Run("ark", func(t *testing.T) {
+		watermark := false
+		g, err := newImageGen(&Config{
+			Provider:       ProviderArk,
+			APIKey:         "test-key",
+			BaseURL:        ts.URL,
+			ResponseFormat: ResponseFormatB64JSON,
+			AllowedSizes:   []string{"1024x1024", "864x1152"},
+			Watermark:      &watermark,
+		})
+		assert.NoError(t, err)
+
+		seed := 42
+		_, err = g.Generate(ctx, &Request{Prompt: "a cat", Size: "864x1152", Quality: "hd", Seed: &seed})
+		assert.NoError(t, err)
+		assert.Equal(t, generateRequest{
+			Model: "doubao-seedream-3-0-t2i-250415", Prompt: "a cat", Size: "864x1152", ResponseFormat: "b64_json", Seed: &seed, Watermark: &watermark,
+		}, got)
+
+		_, err = g.Generate(ctx, &Request{Prompt: "a cat", Size: "2048x2048"})
+		assert.EqualError(t, err, "size 2048x2048 is not allowed, allowed sizes: 1024x1024, 864x1152")
+	})
+}
+
+func TestNewImageGen(t *testing.T) {
+	_, err := newImageGen(nil)
+	assert.EqualError(t, err, "image generation tool config is required")
+
+	_, err = newImageGen(&Config{})
+	assert.EqualError(t, err, "api key is required")
+
+	_, err = newImageGen(&Config{APIKey: "key", Provider: "midjourney"})
+	assert.EqualError(t, err, "unsupported provider: midjourney")
+
+	_, err = newImageGen(&Config{APIKey: "key", ResponseFormat: "png"})
+	assert.EqualError(t, err, "unsupported response format: png")
+
+	g, err := newImageGen(&Config{APIKey: "key", Provider: ProviderArk})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ark.cn-beijing.volces.com/api/v3", g.config.BaseURL)
+	assert.Equal(t, "image_generation", g.config.ToolName)
+}
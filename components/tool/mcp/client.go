@@ -0,0 +1,121 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Transport is the transport used to connect to the MCP server.
+type Transport string
+
+const (
+	// TransportStdio starts the MCP server as a subprocess and talks to it over stdin/stdout.
+	TransportStdio Transport = "stdio"
+	// TransportSSE connects to a remote MCP server over HTTP with Server-Sent Events.
+	TransportSSE Transport = "sse"
+)
+
+const (
+	defaultClientName    = "eino"
+	defaultClientVersion = "1.0.0"
+)
+
+type ClientConfig struct {
+	// Transport is the transport to connect to the MCP server, TransportStdio or TransportSSE.
+	// Required.
+	Transport Transport
+
+	// Command is the command to start the MCP server, e.g. "npx", required for TransportStdio.
+	Command string
+	// Args are the arguments of the command, e.g. []string{"-y", "@modelcontextprotocol/server-filesystem", "/tmp"}.
+	Args []string
+	// Env is the environment of the command in the form "KEY=value".
+	Env []string
+
+	// URL is the SSE endpoint of the MCP server, e.g. "http://localhost:8080/sse", required for TransportSSE.
+	URL string
+	// Headers are sent with every request to the MCP server, e.g. an Authorization header.
+	Headers map[string]string
+
+	// ClientName and ClientVersion identify the client to the MCP server.
+	// Optional. Default: "eino" and "1.0.0".
+	ClientName    string
+	ClientVersion string
+}
+
+// NewClient connects to the MCP server with the transport of the config and initializes the session,
+// the returned client can be used as Config.Cli of GetTools, and should be closed after use.
+func NewClient(ctx context.Context, conf *ClientConfig) (*client.Client, error) {
+	if conf == nil {
+		return nil, fmt.Errorf("mcp client config is required")
+	}
+
+	var (
+		cli *client.Client
+		err error
+	)
+	switch conf.Transport {
+	case TransportStdio:
+		if conf.Command == "" {
+			return nil, fmt.Errorf("command is required for stdio transport")
+		}
+		// the stdio client starts the command on creation
+		cli, err = client.NewStdioMCPClient(conf.Command, conf.Env, conf.Args...)
+		if err != nil {
+			return nil, fmt.Errorf("start mcp server fail: %w", err)
+		}
+	case TransportSSE:
+		if conf.URL == "" {
+			return nil, fmt.Errorf("url is required for sse transport")
+		}
+		cli, err = client.NewSSEMCPClient(conf.URL, client.WithHeaders(conf.Headers))
+		if err != nil {
+			return nil, fmt.Errorf("create mcp sse client fail: %w", err)
+		}
+		if err = cli.Start(ctx); err != nil {
+			_ = cli.Close()
+			return nil, fmt.Errorf("connect mcp server fail: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported mcp transport: %q", conf.Transport)
+	}
+
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{
+		Name:    defaultIfEmpty(conf.ClientName, defaultClientName),
+		Version: defaultIfEmpty(conf.ClientVersion, defaultClientVersion),
+	}
+	if _, err = cli.Initialize(ctx, initRequest); err != nil {
+		_ = cli.Close()
+		return nil, fmt.Errorf("initialize mcp session fail: %w", err)
+	}
+
+	return cli, nil
+}
+
+func defaultIfEmpty(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -23,7 +23,6 @@ import (
 	"time"
 
 	"github.com/cloudwego/eino/components/tool"
-	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
@@ -55,23 +54,11 @@ func main() {
 }
 
 func getMCPTool(ctx context.Context) []tool.BaseTool {
-	cli, err := client.NewSSEMCPClient("http://localhost:12345/sse")
-	if err != nil {
-		log.Fatal(err)
-	}
-	err = cli.Start(ctx)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	initRequest := mcp.InitializeRequest{}
-	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
-	initRequest.Params.ClientInfo = mcp.Implementation{
-		Name:    "example-client",
-		Version: "1.0.0",
-	}
-
-	_, err = cli.Initialize(ctx, initRequest)
+	cli, err := mcpp.NewClient(ctx, &mcpp.ClientConfig{
+		Transport:  mcpp.TransportSSE,
+		URL:        "http://localhost:12345/sse",
+		ClientName: "example-client",
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -30,7 +30,7 @@ import (
 
 type Config struct {
 	// Cli is the MCP (Model Control Protocol) client, ref: https://github.com/mark3labs/mcp-go?tab=readme-ov-file#tools
-	// Notice: should Initialize with server before use
+	// Notice: should Initialize with server before use, NewClient returns an initialized client of stdio or SSE transport
 	Cli client.MCPClient
 	// ToolNameList specifies which tools to fetch from MCP server
 	// If empty, all available tools will be fetched
@@ -38,6 +38,10 @@ type Config struct {
 }
 
 func GetTools(ctx context.Context, conf *Config) ([]tool.BaseTool, error) {
+	if conf == nil || conf.Cli == nil {
+		return nil, fmt.Errorf("mcp client is required")
+	}
+
 	listResults, err := conf.Cli.ListTools(ctx, mcp.ListToolsRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("list mcp tools fail: %w", err)
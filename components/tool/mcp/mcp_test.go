@@ -22,6 +22,7 @@ import (
 
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -149,3 +150,48 @@ func (m *mockMCPClient) Close() error {
 func (m *mockMCPClient) OnNotification(handler func(notification mcp.JSONRPCNotification)) {
 	panic("implement me")
 }
+
+func TestNewClient(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewClient(ctx, nil)
+	assert.EqualError(t, err, "mcp client config is required")
+	_, err = NewClient(ctx, &ClientConfig{Transport: TransportStdio})
+	assert.EqualError(t, err, "command is required for stdio transport")
+	_, err = NewClient(ctx, &ClientConfig{Transport: TransportSSE})
+	assert.EqualError(t, err, "url is required for sse transport")
+	_, err = NewClient(ctx, &ClientConfig{Transport: "ws"})
+	assert.EqualError(t, err, `unsupported mcp transport: "ws"`)
+
+	_, err = GetTools(ctx, &Config{})
+	assert.EqualError(t, err, "mcp client is required")
+}
+
+func TestNewSSEClient(t *testing.T) {
+	svr := server.NewMCPServer("test", "1.0.0")
+	svr.AddTool(mcp.NewTool("echo",
+		mcp.WithDescription("echo the input"),
+		mcp.WithString("input", mcp.Required()),
+	), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arg := request.Params.Arguments.(map[string]any)
+		return mcp.NewToolResultText(arg["input"].(string)), nil
+	})
+	ts := server.NewTestServer(svr)
+	defer ts.Close()
+
+	ctx := context.Background()
+	cli, err := NewClient(ctx, &ClientConfig{Transport: TransportSSE, URL: ts.URL + "/sse"})
+	assert.NoError(t, err)
+	defer cli.Close()
+
+	tools, err := GetTools(ctx, &Config{Cli: cli})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tools))
+	info, err := tools[0].Info(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo", info.Name)
+
+	result, err := tools[0].(tool.InvokableTool).InvokableRun(ctx, `{"input": "hello"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"content":[{"type":"text","text":"hello"}]}`, result)
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+	"github.com/cloudwego/eino/schema"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultServerName    = "eino"
+	defaultServerVersion = "1.0.0"
+	defaultRetrieveTopK  = 5
+)
+
+type ServerConfig struct {
+	// Name and Version identify the server to the MCP clients.
+	// Optional. Default: "eino" and "1.0.0".
+	Name    string
+	Version string
+
+	// Tools are served as MCP tools, each tool must be a tool.InvokableTool or a tool.StreamableTool.
+	Tools []tool.BaseTool
+	// Retrievers are served as MCP tools, which take a query and return the retrieved documents.
+	Retrievers []*RetrieverTool
+}
+
+// RetrieverTool serves a retriever as an MCP tool.
+type RetrieverTool struct {
+	// Name is the tool name of the retriever. Required.
+	Name string
+	// Desc tells the model what the retriever searches, e.g. "search the product manuals". Required.
+	Desc string
+	// Retriever is the retriever to serve. Required.
+	Retriever retriever.Retriever
+	// TopK is the number of documents returned if the client doesn't specify one.
+	// Optional. Default: 5.
+	TopK int
+}
+
+type retrieveRequest struct {
+	Query string `json:"query" jsonschema_description:"the query to retrieve documents"`
+	TopK  int    `json:"top_k,omitempty" jsonschema_description:"the max number of documents to return"`
+}
+
+type retrieveResponse struct {
+	Documents []*retrievedDocument `json:"documents"`
+}
+
+type retrievedDocument struct {
+	ID       string         `json:"id,omitempty"`
+	Content  string         `json:"content"`
+	Score    float64        `json:"score,omitempty"`
+	MetaData map[string]any `json:"metadata,omitempty"`
+}
+
+// NewServer creates an MCP server serving the tools and retrievers of the config.
+// Use ServeStdio or NewSSEServer to serve it, or any transport of github.com/mark3labs/mcp-go/server.
+func NewServer(ctx context.Context, conf *ServerConfig) (*server.MCPServer, error) {
+	if conf == nil {
+		return nil, fmt.Errorf("mcp server config is required")
+	}
+
+	tools := make([]tool.BaseTool, 0, len(conf.Tools)+len(conf.Retrievers))
+	tools = append(tools, conf.Tools...)
+	for _, r := range conf.Retrievers {
+		t, err := newRetrieverTool(r)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, t)
+	}
+
+	svr := server.NewMCPServer(
+		defaultIfEmpty(conf.Name, defaultServerName),
+		defaultIfEmpty(conf.Version, defaultServerVersion),
+		server.WithToolCapabilities(false),
+	)
+
+	names := make(map[string]struct{}, len(tools))
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get tool info fail: %w", err)
+		}
+		if _, ok := names[info.Name]; ok {
+			return nil, fmt.Errorf("duplicate tool name: %s", info.Name)
+		}
+		names[info.Name] = struct{}{}
+
+		switch t.(type) {
+		case tool.InvokableTool, tool.StreamableTool:
+		default:
+			return nil, fmt.Errorf("tool %s is neither invokable nor streamable", info.Name)
+		}
+
+		inputSchema, err := toInputSchema(info)
+		if err != nil {
+			return nil, fmt.Errorf("conv tool input schema fail: %w, tool name: %s", err, info.Name)
+		}
+		svr.AddTool(mcp.NewToolWithRawSchema(info.Name, info.Desc, inputSchema), toolHandler(t))
+	}
+
+	return svr, nil
+}
+
+// ServeStdio serves the tools and retrievers of the config over stdin/stdout until stdin is closed,
+// e.g. as a local MCP server of Claude Desktop or an IDE.
+func ServeStdio(ctx context.Context, conf *ServerConfig) error {
+	svr, err := NewServer(ctx, conf)
+	if err != nil {
+		return err
+	}
+	return server.ServeStdio(svr)
+}
+
+// NewSSEServer creates an HTTP server serving the tools and retrievers of the config with Server-Sent Events,
+// which can be started with Start(addr) or mounted as an http.Handler.
+func NewSSEServer(ctx context.Context, conf *ServerConfig, opts ...server.SSEOption) (*server.SSEServer, error) {
+	svr, err := NewServer(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	return server.NewSSEServer(svr, opts...), nil
+}
+
+// toInputSchema converts the params of the tool to the JSON schema of the MCP tool input,
+// which must be an object.
+func toInputSchema(info *schema.ToolInfo) (json.RawMessage, error) {
+	s := &openapi3.Schema{}
+	if info.ParamsOneOf != nil {
+		var err error
+		s, err = info.ParamsOneOf.ToOpenAPIV3()
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			s = &openapi3.Schema{}
+		}
+	}
+	if s.Type == "" {
+		s.Type = openapi3.TypeObject
+	}
+	if s.Properties == nil {
+		s.Properties = openapi3.Schemas{}
+	}
+
+	return sonic.Marshal(s)
+}
+
+func toolHandler(t tool.BaseTool) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := "{}"
+		if request.Params.Arguments != nil {
+			var err error
+			arguments, err = sonic.MarshalString(request.Params.Arguments)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("marshal arguments fail: %v", err)), nil
+			}
+		}
+
+		output, err := runTool(ctx, t, arguments)
+		if err != nil {
+			// a tool error is reported to the model instead of failing the request
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText(output), nil
+	}
+}
+
+func runTool(ctx context.Context, t tool.BaseTool, arguments string) (string, error) {
+	if it, ok := t.(tool.InvokableTool); ok {
+		return it.InvokableRun(ctx, arguments)
+	}
+
+	sr, err := t.(tool.StreamableTool).StreamableRun(ctx, arguments)
+	if err != nil {
+		return "", err
+	}
+	defer sr.Close()
+
+	var sb strings.Builder
+	for {
+		chunk, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			return sb.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(chunk)
+	}
+}
+
+func newRetrieverTool(r *RetrieverTool) (tool.InvokableTool, error) {
+	if r == nil || r.Retriever == nil {
+		return nil, fmt.Errorf("retriever is required")
+	}
+	if r.Name == "" || r.Desc == "" {
+		return nil, fmt.Errorf("name and desc are required for retriever tool")
+	}
+	topK := r.TopK
+	if topK <= 0 {
+		topK = defaultRetrieveTopK
+	}
+
+	return utils.InferTool(r.Name, r.Desc, func(ctx context.Context, req *retrieveRequest) (*retrieveResponse, error) {
+		if req.Query == "" {
+			return nil, fmt.Errorf("query is required")
+		}
+		k := topK
+		if req.TopK > 0 {
+			k = req.TopK
+		}
+
+		docs, err := r.Retriever.Retrieve(ctx, req.Query, retriever.WithTopK(k))
+		if err != nil {
+			return nil, fmt.Errorf("retrieve fail: %w", err)
+		}
+
+		resp := &retrieveResponse{Documents: make([]*retrievedDocument, 0, len(docs))}
+		for _, doc := range docs {
+			resp.Documents = append(resp.Documents, &retrievedDocument{
+				ID:       doc.ID,
+				Content:  doc.Content,
+				Score:    doc.Score(),
+				MetaData: doc.MetaData,
+			})
+		}
+		return resp, nil
+	})
+}
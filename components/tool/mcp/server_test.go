@@ -0,0 +1,130 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/eino/components/retriever"
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+	"github.com/cloudwego/eino/schema"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+)
+
+type echoRequest struct {
+	Input string `json:"input" jsonschema_description:"the input to echo"`
+}
+
+type mockRetriever struct{}
+
+func (m *mockRetriever) Retrieve(ctx context.Context, query string, opts ...retriever.Option) ([]*schema.Document, error) {
+	o := retriever.GetCommonOptions(&retriever.Options{}, opts...)
+	docs := make([]*schema.Document, 0, *o.TopK)
+	for i := 0; i < *o.TopK; i++ {
+		docs = append(docs, &schema.Document{ID: fmt.Sprintf("%d", i), Content: query})
+	}
+	return docs, nil
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	echo, err := utils.InferTool("echo", "echo the input", func(ctx context.Context, req *echoRequest) (string, error) {
+		if req.Input == "" {
+			return "", fmt.Errorf("input is required")
+		}
+		return req.Input, nil
+	})
+	assert.NoError(t, err)
+
+	svr, err := NewServer(ctx, &ServerConfig{
+		Tools: []tool.BaseTool{echo},
+		Retrievers: []*RetrieverTool{
+			{Name: "search_docs", Desc: "search the docs", Retriever: &mockRetriever{}, TopK: 2},
+		},
+	})
+	assert.NoError(t, err)
+	ts := server.NewTestServer(svr)
+	defer ts.Close()
+
+	cli, err := NewClient(ctx, &ClientConfig{Transport: TransportSSE, URL: ts.URL + "/sse"})
+	assert.NoError(t, err)
+	defer cli.Close()
+
+	tools, err := GetTools(ctx, &Config{Cli: cli})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tools))
+
+	byName := make(map[string]tool.InvokableTool)
+	for _, tl := range tools {
+		info, err := tl.Info(ctx)
+		assert.NoError(t, err)
+		byName[info.Name] = tl.(tool.InvokableTool)
+	}
+
+	result, err := byName["echo"].InvokableRun(ctx, `{"input": "hello"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"content":[{"type":"text","text":"\"hello\""}]}`, result)
+
+	// tool errors are returned as error results
+	_, err = byName["echo"].InvokableRun(ctx, `{}`)
+	assert.ErrorContains(t, err, "input is required")
+
+	result, err = byName["search_docs"].InvokableRun(ctx, `{"query": "eino"}`)
+	assert.NoError(t, err)
+	assert.Contains(t, result, `{\"id\":\"1\",\"content\":\"eino\"}`)
+	assert.NotContains(t, result, `\"id\":\"2\"`)
+}
+
+func TestNewServer(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewServer(ctx, nil)
+	assert.EqualError(t, err, "mcp server config is required")
+
+	_, err = NewServer(ctx, &ServerConfig{Retrievers: []*RetrieverTool{{Name: "search"}}})
+	assert.EqualError(t, err, "retriever is required")
+
+	_, err = NewServer(ctx, &ServerConfig{Retrievers: []*RetrieverTool{{Retriever: &mockRetriever{}}}})
+	assert.EqualError(t, err, "name and desc are required for retriever tool")
+
+	echo, err := utils.InferTool("echo", "echo the input", func(ctx context.Context, req *echoRequest) (string, error) {
+		return req.Input, nil
+	})
+	assert.NoError(t, err)
+	_, err = NewServer(ctx, &ServerConfig{Tools: []tool.BaseTool{echo, echo}})
+	assert.EqualError(t, err, "duplicate tool name: echo")
+}
+
+func TestToInputSchema(t *testing.T) {
+	s, err := toInputSchema(&schema.ToolInfo{Name: "no_params"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"object","properties":{}}`, string(s))
+
+	s, err = toInputSchema(&schema.ToolInfo{
+		Name: "params",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"query": {Type: schema.String, Desc: "the query", Required: true},
+		}),
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"object","properties":{"query":{"type":"string","description":"the query"}},"required":["query"]}`, string(s))
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+type Message struct {
+	ID       string `json:"id" jsonschema_description:"The id of the message, the ts on Slack and the message id on Feishu"`
+	Channel  string `json:"channel,omitempty" jsonschema_description:"The channel of the message"`
+	Sender   string `json:"sender,omitempty" jsonschema_description:"The id of the user or bot who sent the message"`
+	IsBot    bool   `json:"is_bot,omitempty" jsonschema_description:"Whether the message is sent by a bot"`
+	Type     string `json:"type,omitempty" jsonschema_description:"The type of the message, e.g. text or interactive"`
+	Text     string `json:"text" jsonschema_description:"The text of the message, or the raw content if it is not a text message"`
+	ThreadID string `json:"thread_id,omitempty" jsonschema_description:"The id of the thread the message belongs to"`
+	Time     string `json:"time,omitempty" jsonschema_description:"The time the message was sent, in RFC3339"`
+}
+
+type SendMessageRequest struct {
+	Channel  string `json:"channel,omitempty" jsonschema_description:"The channel to send the message to, default: the configured default channel"`
+	User     string `json:"user,omitempty" jsonschema_description:"The user to send a direct message to, exclusive with channel"`
+	Text     string `json:"text" jsonschema_description:"The text of the message"`
+	ThreadID string `json:"thread_id,omitempty" jsonschema_description:"The id of the message to reply to in its thread"`
+}
+
+// SendMessage sends a plain text message to a channel or a user.
+func (m *messenger) SendMessage(ctx context.Context, req *SendMessageRequest) (*Message, error) {
+	if m.config.ReadOnly {
+		return nil, errors.New("sending messages is not allowed in read-only mode")
+	}
+	if req.Text == "" {
+		return nil, errors.New("text is required")
+	}
+	t, err := m.resolveTarget(req.Channel, req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.provider.sendMessage(ctx, t, &outgoingMessage{text: req.Text, threadID: req.ThreadID})
+}
+
+type PostRichMessageRequest struct {
+	Channel  string `json:"channel,omitempty" jsonschema_description:"The channel to post the message to, default: the configured default channel"`
+	User     string `json:"user,omitempty" jsonschema_description:"The user to post a direct message to, exclusive with channel"`
+	Content  string `json:"content" jsonschema_description:"The rich content in json, Block Kit blocks on Slack and an interactive card on Feishu"`
+	Text     string `json:"text,omitempty" jsonschema_description:"The fallback text shown in notifications, only used by Slack"`
+	ThreadID string `json:"thread_id,omitempty" jsonschema_description:"The id of the message to reply to in its thread"`
+}
+
+// PostRichMessage posts a message of Slack blocks or a Feishu card to a channel or a user.
+func (m *messenger) PostRichMessage(ctx context.Context, req *PostRichMessageRequest) (*Message, error) {
+	if m.config.ReadOnly {
+		return nil, errors.New("posting messages is not allowed in read-only mode")
+	}
+	if req.Content == "" {
+		return nil, errors.New("content is required")
+	}
+	if !json.Valid([]byte(req.Content)) {
+		return nil, errors.New("content must be valid json")
+	}
+	t, err := m.resolveTarget(req.Channel, req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.provider.sendMessage(ctx, t, &outgoingMessage{text: req.Text, rich: json.RawMessage(req.Content), threadID: req.ThreadID})
+}
+
+type ReadHistoryRequest struct {
+	Channel string `json:"channel,omitempty" jsonschema_description:"The channel to read, default: the configured default channel"`
+	Limit   int    `json:"limit,omitempty" jsonschema_description:"The maximum number of messages"`
+	Cursor  string `json:"cursor,omitempty" jsonschema_description:"The next_cursor of the previous call, to read older messages"`
+}
+
+type ReadHistoryResponse struct {
+	Messages   []*Message `json:"messages" jsonschema_description:"The messages, newest first"`
+	HasMore    bool       `json:"has_more,omitempty" jsonschema_description:"Whether there are older messages"`
+	NextCursor string     `json:"next_cursor,omitempty" jsonschema_description:"The cursor to read older messages"`
+}
+
+// ReadHistory reads the recent messages of a channel.
+func (m *messenger) ReadHistory(ctx context.Context, req *ReadHistoryRequest) (*ReadHistoryResponse, error) {
+	channel, err := m.resolveChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.provider.readHistory(ctx, channel, m.limit(req.Limit), req.Cursor)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/components/tool"
+
+	"github.com/cloudwego/eino-ext/components/tool/messaging"
+)
+
+func main() {
+	ctx := context.Background()
+
+	channel := os.Getenv("SLACK_CHANNEL_ID")
+	tools, err := messaging.NewTools(ctx, &messaging.Config{
+		Provider: messaging.ProviderSlack,
+		Token:    os.Getenv("SLACK_BOT_TOKEN"),
+		Workspace: messaging.WorkspaceConfig{
+			DefaultChannel:        channel,
+			AllowedChannels:       []string{channel},
+			DisableDirectMessages: true,
+		},
+	})
+	if err != nil {
+		log.Fatalf("NewTools of messaging failed, err=%v", err)
+	}
+
+	invokable := make(map[string]tool.InvokableTool, len(tools))
+	for _, t := range tools {
+		info, err := t.Info(ctx)
+		if err != nil {
+			log.Fatalf("Info of tool failed, err=%v", err)
+		}
+		invokable[info.Name] = t.(tool.InvokableTool)
+	}
+
+	calls := []struct {
+		name string
+		args string
+	}{
+		{"send_message", `{"text": "Deployment of service-a finished"}`},
+		{"post_rich_message", `{"text": "Deployment report", "content": "[{\"type\": \"section\", \"text\": {\"type\": \"mrkdwn\", \"text\": \"*service-a* deployed :rocket:\"}}]"}`},
+		{"read_history", `{"limit": 5}`},
+		{"send_message", `{"user": "U012345", "text": "hi"}`}, // rejected, direct messages are disabled
+	}
+
+	for _, call := range calls {
+		resp, err := invokable[call.name].InvokableRun(ctx, call.args)
+		if err != nil {
+			fmt.Printf("%s failed: %v\n", call.name, err)
+			continue
+		}
+		fmt.Printf("%s: %s\n", call.name, resp)
+	}
+}
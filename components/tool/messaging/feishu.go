@@ -0,0 +1,269 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// feishuProvider implements the provider with Feishu and Lark open api.
+type feishuProvider struct {
+	client *client
+	tokens *feishuTokenSource
+}
+
+func newFeishuProvider(c *client, appID, appSecret string) *feishuProvider {
+	tokens := &feishuTokenSource{
+		client:    &client{baseURL: c.baseURL, httpClient: c.httpClient},
+		appID:     appID,
+		appSecret: appSecret,
+	}
+	c.setHeaders = func(ctx context.Context, req *http.Request) error {
+		token, err := tokens.get(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+	return &feishuProvider{client: c, tokens: tokens}
+}
+
+// feishuResponse is the envelope of Feishu open api responses, which report errors with a non-zero code.
+type feishuResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (r *feishuResponse) err() error {
+	if r.Code != 0 {
+		return fmt.Errorf("feishu api error %d: %s", r.Code, r.Msg)
+	}
+	return nil
+}
+
+// feishuTokenSource obtains and caches the tenant access token of the app.
+type feishuTokenSource struct {
+	client    *client
+	appID     string
+	appSecret string
+
+	mu       sync.Mutex
+	token    string
+	expireAt time.Time
+}
+
+func (s *feishuTokenSource) get(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// refresh a few minutes ahead, the token is valid for two hours
+	if s.token != "" && time.Now().Add(5*time.Minute).Before(s.expireAt) {
+		return s.token, nil
+	}
+
+	var result struct {
+		feishuResponse
+		TenantAccessToken string `json:"tenant_access_token"`
+		Expire            int    `json:"expire"`
+	}
+	body := map[string]string{"app_id": s.appID, "app_secret": s.appSecret}
+	if err := s.client.doJSON(ctx, http.MethodPost, "/auth/v3/tenant_access_token/internal", nil, body, &result); err != nil {
+		return "", fmt.Errorf("failed to get tenant access token: %w", err)
+	}
+	if err := result.err(); err != nil {
+		return "", fmt.Errorf("failed to get tenant access token: %w", err)
+	}
+
+	s.token = result.TenantAccessToken
+	s.expireAt = time.Now().Add(time.Duration(result.Expire) * time.Second)
+	return s.token, nil
+}
+
+type feishuMessage struct {
+	MessageID  string `json:"message_id"`
+	ChatID     string `json:"chat_id"`
+	MsgType    string `json:"msg_type"`
+	CreateTime string `json:"create_time"`
+	ParentID   string `json:"parent_id"`
+	RootID     string `json:"root_id"`
+	Sender     struct {
+		ID         string `json:"id"`
+		SenderType string `json:"sender_type"`
+	} `json:"sender"`
+	Body struct {
+		Content string `json:"content"`
+	} `json:"body"`
+}
+
+func (m *feishuMessage) toMessage() *Message {
+	msg := &Message{
+		ID:       m.MessageID,
+		Channel:  m.ChatID,
+		Sender:   m.Sender.ID,
+		IsBot:    m.Sender.SenderType == "app",
+		Type:     m.MsgType,
+		Text:     m.Body.Content,
+		ThreadID: m.RootID,
+	}
+	if m.MsgType == "text" {
+		var content struct {
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal([]byte(m.Body.Content), &content); err == nil {
+			msg.Text = content.Text
+		}
+	}
+	// create_time is in milliseconds
+	if ms, err := strconv.ParseInt(m.CreateTime, 10, 64); err == nil {
+		msg.Time = time.UnixMilli(ms).UTC().Format(time.RFC3339)
+	}
+	return msg
+}
+
+func (p *feishuProvider) sendMessage(ctx context.Context, t *target, msg *outgoingMessage) (*Message, error) {
+	msgType := "text"
+	var content string
+	if msg.rich != nil {
+		if !strings.HasPrefix(strings.TrimSpace(string(msg.rich)), "{") {
+			return nil, errors.New("content must be a json object of a card on feishu")
+		}
+		msgType = "interactive"
+		content = string(msg.rich)
+	} else {
+		data, err := json.Marshal(map[string]string{"text": msg.text})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal message content: %w", err)
+		}
+		content = string(data)
+	}
+
+	var result struct {
+		feishuResponse
+		Data feishuMessage `json:"data"`
+	}
+
+	if msg.threadID != "" {
+		// the reply goes to the chat of the replied message, so make sure it is the target channel
+		if t.channel == "" {
+			return nil, errors.New("thread_id is only supported for channels on feishu")
+		}
+		parent, err := p.getMessage(ctx, msg.threadID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ChatID != t.channel {
+			return nil, fmt.Errorf("message %s is not in channel %s", msg.threadID, t.channel)
+		}
+
+		body := map[string]string{"msg_type": msgType, "content": content}
+		path := "/im/v1/messages/" + url.PathEscape(msg.threadID) + "/reply"
+		if err = p.client.doJSON(ctx, http.MethodPost, path, nil, body, &result); err != nil {
+			return nil, fmt.Errorf("failed to reply message: %w", err)
+		}
+		if err = result.err(); err != nil {
+			return nil, fmt.Errorf("failed to reply message: %w", err)
+		}
+		return result.Data.toMessage(), nil
+	}
+
+	receiveIDType, receiveID := "chat_id", t.channel
+	if t.user != "" {
+		receiveIDType, receiveID = "open_id", t.user
+		if strings.Contains(t.user, "@") {
+			receiveIDType = "email"
+		}
+	}
+
+	body := map[string]string{"receive_id": receiveID, "msg_type": msgType, "content": content}
+	query := url.Values{"receive_id_type": {receiveIDType}}
+	if err := p.client.doJSON(ctx, http.MethodPost, "/im/v1/messages", query, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+	if err := result.err(); err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+	return result.Data.toMessage(), nil
+}
+
+func (p *feishuProvider) getMessage(ctx context.Context, messageID string) (*feishuMessage, error) {
+	var result struct {
+		feishuResponse
+		Data struct {
+			Items []*feishuMessage `json:"items"`
+		} `json:"data"`
+	}
+	if err := p.client.doJSON(ctx, http.MethodGet, "/im/v1/messages/"+url.PathEscape(messageID), nil, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	if err := result.err(); err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	if len(result.Data.Items) == 0 {
+		return nil, fmt.Errorf("message %s not found", messageID)
+	}
+	return result.Data.Items[0], nil
+}
+
+func (p *feishuProvider) readHistory(ctx context.Context, channel string, limit int, cursor string) (*ReadHistoryResponse, error) {
+	// page_size is at most 50
+	if limit > 50 {
+		limit = 50
+	}
+	query := url.Values{
+		"container_id_type": {"chat"},
+		"container_id":      {channel},
+		"sort_type":         {"ByCreateTimeDesc"},
+		"page_size":         {strconv.Itoa(limit)},
+	}
+	if cursor != "" {
+		query.Set("page_token", cursor)
+	}
+
+	var result struct {
+		feishuResponse
+		Data struct {
+			HasMore   bool             `json:"has_more"`
+			PageToken string           `json:"page_token"`
+			Items     []*feishuMessage `json:"items"`
+		} `json:"data"`
+	}
+	if err := p.client.doJSON(ctx, http.MethodGet, "/im/v1/messages", query, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	if err := result.err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	resp := &ReadHistoryResponse{Messages: make([]*Message, 0, len(result.Data.Items)), HasMore: result.Data.HasMore}
+	for _, m := range result.Data.Items {
+		resp.Messages = append(resp.Messages, m.toMessage())
+	}
+	if result.Data.HasMore {
+		resp.NextCursor = result.Data.PageToken
+	}
+	return resp, nil
+}
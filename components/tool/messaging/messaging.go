@@ -0,0 +1,260 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+type Provider string
+
+const (
+	ProviderSlack  Provider = "slack"
+	ProviderFeishu Provider = "feishu"
+	// ProviderLark is the international version of Feishu, sharing the same api on a different host.
+	ProviderLark Provider = "lark"
+)
+
+// Config represents the messaging tools configuration.
+type Config struct {
+	// Provider specifies the messaging platform of the bot.
+	// Required.
+	Provider Provider `json:"provider"`
+
+	// Token is the bot token of Slack, e.g. "xoxb-...".
+	// Required by ProviderSlack.
+	Token string `json:"token"`
+
+	// AppID and AppSecret are the credentials of the Feishu or Lark app, used to obtain the tenant access token.
+	// Required by ProviderFeishu and ProviderLark.
+	AppID     string `json:"app_id"`
+	AppSecret string `json:"app_secret"`
+
+	// BaseURL is the base url of the bot api.
+	// Optional, default: "https://slack.com/api" for Slack, "https://open.feishu.cn/open-apis" for Feishu,
+	// and "https://open.larksuite.com/open-apis" for Lark
+	BaseURL string `json:"base_url"`
+
+	// Workspace scopes the tools to the conversations of the bot's workspace, see WorkspaceConfig.
+	// Optional, default: no limit
+	Workspace WorkspaceConfig `json:"workspace"`
+
+	// ReadOnly omits the send_message and post_rich_message tools.
+	// Optional, default: false
+	ReadOnly bool `json:"read_only"`
+
+	// MaxHistory specifies the maximum number of messages returned by a read_history call.
+	// Optional, default: 20
+	MaxHistory int `json:"max_history"`
+
+	// HttpClient is the http client used to send requests.
+	// Optional, default: &http.Client{Timeout: 30 * time.Second}
+	HttpClient *http.Client `json:"-"`
+}
+
+// WorkspaceConfig limits the conversations the tools can access.
+type WorkspaceConfig struct {
+	// DefaultChannel is the channel used when the request specifies neither a channel nor a user.
+	// It is a channel id on Slack, and a chat id on Feishu.
+	// Optional
+	DefaultChannel string `json:"default_channel"`
+
+	// AllowedChannels limits the channels the tools can send messages to and read history from.
+	// Optional, default: no limit
+	AllowedChannels []string `json:"allowed_channels"`
+
+	// AllowedUsers limits the users the tools can send direct messages to.
+	// They are user ids on Slack, and open ids or emails on Feishu.
+	// Optional, default: no limit
+	AllowedUsers []string `json:"allowed_users"`
+
+	// DisableDirectMessages rejects sending messages to users.
+	// Optional, default: false
+	DisableDirectMessages bool `json:"disable_direct_messages"`
+}
+
+// validate validates the messaging tools configuration.
+func (c *Config) validate() error {
+	switch c.Provider {
+	case ProviderSlack:
+		if c.Token == "" {
+			return errors.New("token is required by slack")
+		}
+	case ProviderFeishu, ProviderLark:
+		if c.AppID == "" || c.AppSecret == "" {
+			return fmt.Errorf("app id and app secret are required by %s", c.Provider)
+		}
+	case "":
+		return errors.New("provider is required")
+	default:
+		return fmt.Errorf("unsupported provider: %s", c.Provider)
+	}
+
+	if c.BaseURL == "" {
+		switch c.Provider {
+		case ProviderSlack:
+			c.BaseURL = "https://slack.com/api"
+		case ProviderFeishu:
+			c.BaseURL = "https://open.feishu.cn/open-apis"
+		case ProviderLark:
+			c.BaseURL = "https://open.larksuite.com/open-apis"
+		}
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
+	if c.MaxHistory <= 0 {
+		c.MaxHistory = 20
+	}
+
+	if c.HttpClient == nil {
+		c.HttpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return nil
+}
+
+// NewTools creates the messaging tools: read_history,
+// and send_message, post_rich_message unless in read-only mode.
+func NewTools(ctx context.Context, config *Config) ([]tool.BaseTool, error) {
+	m, err := newMessenger(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create messaging tools: %w", err)
+	}
+
+	historyTool, err := utils.InferTool("read_history", "read recent messages of a channel, newest first, paginated by cursor", m.ReadHistory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool read_history: %w", err)
+	}
+
+	tools := []tool.BaseTool{historyTool}
+
+	if !config.ReadOnly {
+		sendTool, err := utils.InferTool("send_message", "send a plain text message to a channel or a user", m.SendMessage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer tool send_message: %w", err)
+		}
+
+		richTool, err := utils.InferTool("post_rich_message", richMessageDesc(config.Provider), m.PostRichMessage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to infer tool post_rich_message: %w", err)
+		}
+
+		tools = append(tools, sendTool, richTool)
+	}
+
+	return tools, nil
+}
+
+func richMessageDesc(p Provider) string {
+	if p == ProviderSlack {
+		return "post a rich message to a channel or a user, the content is a json array of Slack Block Kit blocks"
+	}
+	return "post a rich message to a channel or a user, the content is a json object of a Feishu interactive card"
+}
+
+// messenger implements the messaging tools on top of a provider.
+type messenger struct {
+	config   *Config
+	provider provider
+}
+
+// newMessenger creates the messaging tools implementation.
+func newMessenger(config *Config) (*messenger, error) {
+	if config == nil {
+		return nil, errors.New("messaging tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	c := &client{baseURL: config.BaseURL, httpClient: config.HttpClient}
+
+	var p provider
+	if config.Provider == ProviderSlack {
+		p = newSlackProvider(c, config.Token)
+	} else {
+		p = newFeishuProvider(c, config.AppID, config.AppSecret)
+	}
+
+	return &messenger{config: config, provider: p}, nil
+}
+
+// resolveTarget resolves the conversation a message is sent to, falling back to the default channel.
+func (m *messenger) resolveTarget(channel, user string) (*target, error) {
+	channel, user = strings.TrimSpace(channel), strings.TrimSpace(user)
+	if channel != "" && user != "" {
+		return nil, errors.New("only one of channel and user can be specified")
+	}
+
+	if user != "" {
+		ws := m.config.Workspace
+		if ws.DisableDirectMessages {
+			return nil, errors.New("sending messages to users is not allowed")
+		}
+		if len(ws.AllowedUsers) > 0 && !contains(ws.AllowedUsers, user) {
+			return nil, fmt.Errorf("user %s is not allowed", user)
+		}
+		return &target{user: user}, nil
+	}
+
+	channel, err := m.resolveChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+	return &target{channel: channel}, nil
+}
+
+// resolveChannel resolves the channel of the request, falling back to the default channel.
+func (m *messenger) resolveChannel(channel string) (string, error) {
+	ws := m.config.Workspace
+	channel = strings.TrimSpace(channel)
+	if channel == "" {
+		channel = ws.DefaultChannel
+	}
+	if channel == "" {
+		return "", errors.New("channel is required")
+	}
+	if len(ws.AllowedChannels) > 0 && !contains(ws.AllowedChannels, channel) {
+		return "", fmt.Errorf("channel %s is not allowed", channel)
+	}
+	return channel, nil
+}
+
+func (m *messenger) limit(limit int) int {
+	if limit <= 0 || limit > m.config.MaxHistory {
+		return m.config.MaxHistory
+	}
+	return limit
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTools(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewTools(ctx, nil)
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{})
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{Provider: "teams"})
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{Provider: ProviderSlack})
+	assert.Error(t, err)
+
+	_, err = NewTools(ctx, &Config{Provider: ProviderFeishu, AppID: "app"})
+	assert.Error(t, err)
+
+	tools, err := NewTools(ctx, &Config{Provider: ProviderSlack, Token: "xoxb-token"})
+	require.NoError(t, err)
+	assert.Len(t, tools, 3)
+
+	tools, err = NewTools(ctx, &Config{Provider: ProviderLark, AppID: "app", AppSecret: "secret", ReadOnly: true})
+	require.NoError(t, err)
+	assert.Len(t, tools, 1)
+}
+
+func TestResolveTarget(t *testing.T) {
+	m, err := newMessenger(&Config{Provider: ProviderSlack, Token: "xoxb-token", Workspace: WorkspaceConfig{
+		DefaultChannel:  "C1",
+		AllowedChannels: []string{"C1", "C2"},
+		AllowedUsers:    []string{"U1"},
+	}})
+	require.NoError(t, err)
+
+	tg, err := m.resolveTarget("", "")
+	require.NoError(t, err)
+	assert.Equal(t, &target{channel: "C1"}, tg)
+
+	tg, err = m.resolveTarget("C2", "")
+	require.NoError(t, err)
+	assert.Equal(t, &target{channel: "C2"}, tg)
+
+	tg, err = m.resolveTarget("", "U1")
+	require.NoError(t, err)
+	assert.Equal(t, &target{user: "U1"}, tg)
+
+	for _, c := range [][2]string{{"C3", ""}, {"", "U2"}, {"C1", "U1"}} {
+		_, err = m.resolveTarget(c[0], c[1])
+		assert.Error(t, err, c)
+	}
+
+	m.config.Workspace.DisableDirectMessages = true
+	_, err = m.resolveTarget("", "U1")
+	assert.Error(t, err)
+
+	m.config.Workspace = WorkspaceConfig{}
+	_, err = m.resolveChannel("")
+	assert.Error(t, err)
+	channel, err := m.resolveChannel("C3")
+	require.NoError(t, err)
+	assert.Equal(t, "C3", channel)
+}
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  map[string]string
+	header http.Header
+	body   map[string]any
+}
+
+func newTestServer(t *testing.T, handler func(r *recordedRequest) (int, string)) (*httptest.Server, *[]*recordedRequest) {
+	var requests []*recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &recordedRequest{method: r.Method, path: r.URL.EscapedPath(), query: map[string]string{}, header: r.Header}
+		for k := range r.URL.Query() {
+			rec.query[k] = r.URL.Query().Get(k)
+		}
+		data, _ := io.ReadAll(r.Body)
+		if len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &rec.body))
+		}
+		requests = append(requests, rec)
+
+		status, body := handler(rec)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestSlack(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newTestServer(t, func(r *recordedRequest) (int, string) {
+		switch r.path {
+		case "/chat.postMessage":
+			if r.body["channel"] == "C404" {
+				return 200, `{"ok": false, "error": "channel_not_found"}`
+			}
+			return 200, `{"ok": true, "channel": "C1", "ts": "1700000000.000100", "message": {"type": "message", "bot_id": "B1", "text": "hello"}}`
+		case "/conversations.history":
+			return 200, `{"ok": true, "messages": [{"type": "message", "user": "U1", "text": "hi", "ts": "1700000001.000200", "thread_ts": "1700000000.000100"}],
+				"has_more": true, "response_metadata": {"next_cursor": "next"}}`
+		}
+		return 500, ""
+	})
+
+	m, err := newMessenger(&Config{Provider: ProviderSlack, Token: "xoxb-token", BaseURL: server.URL, MaxHistory: 10,
+		Workspace: WorkspaceConfig{DefaultChannel: "C1", AllowedChannels: []string{"C1", "C404"}}})
+	require.NoError(t, err)
+
+	msg, err := m.SendMessage(ctx, &SendMessageRequest{Text: "hello", ThreadID: "1699999999.000100"})
+	require.NoError(t, err)
+	assert.Equal(t, &Message{ID: "1700000000.000100", Channel: "C1", Sender: "B1", IsBot: true, Type: "message", Text: "hello",
+		Time: "2023-11-14T22:13:20Z"}, msg)
+	req := (*requests)[0]
+	assert.Equal(t, map[string]any{"channel": "C1", "text": "hello", "thread_ts": "1699999999.000100"}, req.body)
+	assert.Equal(t, "Bearer xoxb-token", req.header.Get("Authorization"))
+	assert.Equal(t, "application/json; charset=utf-8", req.header.Get("Content-Type"))
+
+	_, err = m.PostRichMessage(ctx, &PostRichMessageRequest{Content: `[{"type": "section", "text": {"type": "mrkdwn", "text": "*hello*"}}]`, Text: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"type": "section", "text": map[string]any{"type": "mrkdwn", "text": "*hello*"}}}, (*requests)[1].body["blocks"])
+
+	_, err = m.PostRichMessage(ctx, &PostRichMessageRequest{Content: `{"type": "section"}`})
+	assert.Error(t, err)
+	_, err = m.PostRichMessage(ctx, &PostRichMessageRequest{Content: `[{`})
+	assert.Error(t, err)
+
+	_, err = m.SendMessage(ctx, &SendMessageRequest{Channel: "C404", Text: "hello"})
+	assert.ErrorContains(t, err, "channel_not_found")
+
+	history, err := m.ReadHistory(ctx, &ReadHistoryRequest{Limit: 50, Cursor: "cursor"})
+	require.NoError(t, err)
+	assert.Equal(t, &ReadHistoryResponse{
+		Messages: []*Message{{ID: "1700000001.000200", Channel: "C1", Sender: "U1", Type: "message", Text: "hi",
+			ThreadID: "1700000000.000100", Time: "2023-11-14T22:13:21Z"}},
+		HasMore:    true,
+		NextCursor: "next",
+	}, history)
+	assert.Equal(t, map[string]string{"channel": "C1", "limit": "10", "cursor": "cursor"}, (*requests)[len(*requests)-1].query)
+
+	_, err = m.ReadHistory(ctx, &ReadHistoryRequest{Channel: "C2"})
+	assert.Error(t, err)
+
+	m.config.ReadOnly = true
+	_, err = m.SendMessage(ctx, &SendMessageRequest{Text: "hello"})
+	assert.Error(t, err)
+}
+
+func TestFeishu(t *testing.T) {
+	ctx := context.Background()
+	server, requests := newTestServer(t, func(r *recordedRequest) (int, string) {
+		switch r.path {
+		case "/auth/v3/tenant_access_token/internal":
+			return 200, `{"code": 0, "tenant_access_token": "t-token", "expire": 7200}`
+		case "/im/v1/messages":
+			if r.method == http.MethodPost {
+				return 200, `{"code": 0, "data": {"message_id": "om_1", "chat_id": "oc_1", "msg_type": "text", "create_time": "1700000000000",
+					"sender": {"id": "cli_1", "sender_type": "app"}, "body": {"content": "{\"text\":\"hello\"}"}}}`
+			}
+			return 200, `{"code": 0, "data": {"has_more": false, "page_token": "next", "items": [{"message_id": "om_2", "chat_id": "oc_1",
+				"msg_type": "interactive", "create_time": "1700000001000", "root_id": "om_1", "sender": {"id": "ou_1", "sender_type": "user"},
+				"body": {"content": "{\"elements\":[]}"}}]}}`
+		case "/im/v1/messages/om_1":
+			return 200, `{"code": 0, "data": {"items": [{"message_id": "om_1", "chat_id": "oc_1"}]}}`
+		case "/im/v1/messages/om_1/reply":
+			return 200, `{"code": 0, "data": {"message_id": "om_3", "chat_id": "oc_1", "msg_type": "interactive", "root_id": "om_1"}}`
+		case "/im/v1/messages/om_9":
+			return 400, `{"code": 230001, "msg": "message not found"}`
+		}
+		return 500, ""
+	})
+
+	m, err := newMessenger(&Config{Provider: ProviderFeishu, AppID: "app", AppSecret: "secret", BaseURL: server.URL,
+		Workspace: WorkspaceConfig{DefaultChannel: "oc_1"}})
+	require.NoError(t, err)
+
+	msg, err := m.SendMessage(ctx, &SendMessageRequest{Text: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, &Message{ID: "om_1", Channel: "oc_1", Sender: "cli_1", IsBot: true, Type: "text", Text: "hello", Time: "2023-11-14T22:13:20Z"}, msg)
+	assert.Equal(t, map[string]any{"app_id": "app", "app_secret": "secret"}, (*requests)[0].body)
+	req := (*requests)[1]
+	assert.Equal(t, "Bearer t-token", req.header.Get("Authorization"))
+	assert.Equal(t, map[string]string{"receive_id_type": "chat_id"}, req.query)
+	assert.Equal(t, map[string]any{"receive_id": "oc_1", "msg_type": "text", "content": `{"text":"hello"}`}, req.body)
+
+	_, err = m.SendMessage(ctx, &SendMessageRequest{User: "alice@example.com", Text: "hello"})
+	require.NoError(t, err)
+	req = (*requests)[2]
+	assert.Equal(t, "email", req.query["receive_id_type"])
+	assert.Equal(t, "alice@example.com", req.body["receive_id"])
+
+	msg, err = m.PostRichMessage(ctx, &PostRichMessageRequest{Content: `{"elements": []}`, ThreadID: "om_1"})
+	require.NoError(t, err)
+	assert.Equal(t, "om_3", msg.ID)
+	assert.Equal(t, "om_1", msg.ThreadID)
+	req = (*requests)[4]
+	assert.Equal(t, "/im/v1/messages/om_1/reply", req.path)
+	assert.Equal(t, map[string]any{"msg_type": "interactive", "content": `{"elements": []}`}, req.body)
+
+	_, err = m.PostRichMessage(ctx, &PostRichMessageRequest{Content: `[]`})
+	assert.Error(t, err)
+	_, err = m.SendMessage(ctx, &SendMessageRequest{Text: "hello", ThreadID: "om_9"})
+	assert.ErrorContains(t, err, "status code 400")
+	_, err = m.SendMessage(ctx, &SendMessageRequest{Channel: "oc_2", Text: "hello", ThreadID: "om_1"})
+	assert.ErrorContains(t, err, "is not in channel")
+
+	history, err := m.ReadHistory(ctx, &ReadHistoryRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &ReadHistoryResponse{Messages: []*Message{{ID: "om_2", Channel: "oc_1", Sender: "ou_1", Type: "interactive",
+		Text: `{"elements":[]}`, ThreadID: "om_1", Time: "2023-11-14T22:13:21Z"}}}, history)
+	req = (*requests)[len(*requests)-1]
+	assert.Equal(t, map[string]string{"container_id_type": "chat", "container_id": "oc_1", "sort_type": "ByCreateTimeDesc", "page_size": "20"}, req.query)
+
+	// the tenant access token is cached
+	tokenRequests := 0
+	for _, r := range *requests {
+		if r.path == "/auth/v3/tenant_access_token/internal" {
+			tokenRequests++
+		}
+	}
+	assert.Equal(t, 1, tokenRequests)
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// target is the conversation a message is sent to, either a channel or a user.
+type target struct {
+	channel string
+	user    string
+}
+
+// outgoingMessage is a message to send, rich content takes precedence over text if present.
+type outgoingMessage struct {
+	text     string
+	rich     json.RawMessage
+	threadID string
+}
+
+// provider is the api of a messaging platform.
+type provider interface {
+	sendMessage(ctx context.Context, t *target, msg *outgoingMessage) (*Message, error)
+	readHistory(ctx context.Context, channel string, limit int, cursor string) (*ReadHistoryResponse, error)
+}
+
+// slackProvider implements the provider with Slack Web API.
+type slackProvider struct {
+	client *client
+}
+
+func newSlackProvider(c *client, token string) *slackProvider {
+	c.setHeaders = func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		if req.Header.Get("Content-Type") != "" {
+			req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		}
+		return nil
+	}
+	return &slackProvider{client: c}
+}
+
+// slackResponse is the envelope of Slack Web API responses, which report errors with ok=false and http status 200.
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+func (r *slackResponse) err() error {
+	if !r.OK {
+		return fmt.Errorf("slack api error: %s", r.Error)
+	}
+	return nil
+}
+
+type slackMessage struct {
+	Type     string `json:"type"`
+	User     string `json:"user"`
+	BotID    string `json:"bot_id"`
+	Text     string `json:"text"`
+	TS       string `json:"ts"`
+	ThreadTS string `json:"thread_ts"`
+}
+
+func (m *slackMessage) toMessage(channel string) *Message {
+	msg := &Message{
+		ID:      m.TS,
+		Channel: channel,
+		Sender:  m.User,
+		Type:    m.Type,
+		Text:    m.Text,
+		Time:    slackTime(m.TS),
+	}
+	if m.BotID != "" {
+		msg.IsBot = true
+		if msg.Sender == "" {
+			msg.Sender = m.BotID
+		}
+	}
+	if m.ThreadTS != "" && m.ThreadTS != m.TS {
+		msg.ThreadID = m.ThreadTS
+	}
+	return msg
+}
+
+// slackTime converts a ts in the form "1700000000.000100" to RFC3339.
+func slackTime(ts string) string {
+	sec, _, _ := strings.Cut(ts, ".")
+	n, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return ""
+	}
+	return time.Unix(n, 0).UTC().Format(time.RFC3339)
+}
+
+func (p *slackProvider) sendMessage(ctx context.Context, t *target, msg *outgoingMessage) (*Message, error) {
+	channel := t.channel
+	if channel == "" {
+		// posting to a user id opens the direct message conversation with the bot
+		channel = t.user
+	}
+
+	body := map[string]any{"channel": channel}
+	if msg.text != "" {
+		body["text"] = msg.text
+	}
+	if msg.rich != nil {
+		if !strings.HasPrefix(strings.TrimSpace(string(msg.rich)), "[") {
+			return nil, errors.New("content must be a json array of blocks on slack")
+		}
+		body["blocks"] = msg.rich
+	}
+	if msg.threadID != "" {
+		body["thread_ts"] = msg.threadID
+	}
+
+	var result struct {
+		slackResponse
+		Channel string       `json:"channel"`
+		TS      string       `json:"ts"`
+		Message slackMessage `json:"message"`
+	}
+	if err := p.client.doJSON(ctx, http.MethodPost, "/chat.postMessage", nil, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+	if err := result.err(); err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	result.Message.TS = result.TS
+	return result.Message.toMessage(result.Channel), nil
+}
+
+func (p *slackProvider) readHistory(ctx context.Context, channel string, limit int, cursor string) (*ReadHistoryResponse, error) {
+	query := url.Values{"channel": {channel}, "limit": {strconv.Itoa(limit)}}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	var result struct {
+		slackResponse
+		Messages         []*slackMessage `json:"messages"`
+		HasMore          bool            `json:"has_more"`
+		ResponseMetadata struct {
+			NextCursor string `json:"next_cursor"`
+		} `json:"response_metadata"`
+	}
+	if err := p.client.doJSON(ctx, http.MethodGet, "/conversations.history", query, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	if err := result.err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	resp := &ReadHistoryResponse{Messages: make([]*Message, 0, len(result.Messages)), HasMore: result.HasMore}
+	for _, m := range result.Messages {
+		resp.Messages = append(resp.Messages, m.toMessage(channel))
+	}
+	if result.HasMore {
+		resp.NextCursor = result.ResponseMetadata.NextCursor
+	}
+	return resp, nil
+}
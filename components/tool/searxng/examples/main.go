@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/tool/searxng"
+)
+
+func main() {
+	ctx := context.Background()
+
+	baseURL := os.Getenv("SEARXNG_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8888"
+	}
+
+	// Create configuration
+	config := &searxng.Config{
+		BaseURL:    baseURL,
+		Engines:    []string{"google", "bing", "duckduckgo"},
+		Categories: []string{"general"},
+		Language:   "en",
+		MaxResults: 5,
+		Timeout:    10 * time.Second,
+	}
+
+	// Create search tool
+	searchTool, err := searxng.NewTool(ctx, config)
+	if err != nil {
+		log.Fatalf("NewTool of searxng failed, err=%v", err)
+	}
+
+	// Create search request
+	searchReq := &searxng.SearchRequest{
+		Query: "Golang programming development",
+		Page:  1,
+	}
+
+	jsonReq, err := json.Marshal(searchReq)
+	if err != nil {
+		log.Fatalf("Marshal of search request failed, err=%v", err)
+	}
+
+	// Execute search
+	resp, err := searchTool.InvokableRun(ctx, string(jsonReq))
+	if err != nil {
+		log.Fatalf("Search of searxng failed, err=%v", err)
+	}
+
+	var searchResp searxng.SearchResponse
+	if err := json.Unmarshal([]byte(resp), &searchResp); err != nil {
+		log.Fatalf("Unmarshal of search response failed, err=%v", err)
+	}
+
+	// Print results
+	fmt.Println("Search Results:")
+	fmt.Println("==============")
+	for i, result := range searchResp.Results {
+		fmt.Printf("\n%d. Title: %s\n", i+1, result.Title)
+		fmt.Printf("   Link: %s\n", result.Link)
+		fmt.Printf("   Description: %s\n", result.Description)
+	}
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package searxng
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+type SafeSearch int
+type TimeRange string
+
+const (
+	// SafeSearch settings
+	SafeSearchOff      SafeSearch = 0
+	SafeSearchModerate SafeSearch = 1
+	SafeSearchStrict   SafeSearch = 2
+
+	// TimeRange settings
+	TimeRangeDay   TimeRange = "day"
+	TimeRangeMonth TimeRange = "month"
+	TimeRangeYear  TimeRange = "year"
+)
+
+// Config represents the SearxNG search tool configuration.
+type Config struct {
+	// Eino tool settings
+	ToolName string `json:"tool_name"` // optional, default is "searxng_search"
+	ToolDesc string `json:"tool_desc"` // optional, default is "search web for information by searxng"
+
+	// BaseURL is the address of the self-hosted SearxNG instance, which must enable json format in settings.yml.
+	// Required. Example: "http://localhost:8888"
+	BaseURL string `json:"base_url"`
+
+	// Engines specifies the search engines to use, e.g. []string{"google", "bing", "duckduckgo"}.
+	// Optional, default: engines enabled by the instance
+	Engines []string `json:"engines"`
+
+	// Categories specifies the search categories, e.g. []string{"general", "news", "science"}.
+	// Optional, default: "general"
+	Categories []string `json:"categories"`
+
+	// Language specifies the search language, e.g. "en", "zh-CN".
+	// Optional, default: language of the instance
+	Language string `json:"language"`
+
+	// SafeSearch specifies the safe search level.
+	// Optional, default: SafeSearchOff
+	SafeSearch SafeSearch `json:"safe_search"`
+
+	// TimeRange limits results to a specific time period, only supported by some engines.
+	// Optional, default: ""
+	TimeRange TimeRange `json:"time_range"`
+
+	// MaxResults specifies the maximum number of search results returned per page.
+	// Optional, default: 10
+	MaxResults int `json:"max_results"`
+
+	// Headers specifies custom HTTP headers to be sent with each request, e.g. authorization of the instance.
+	// Optional, default: map[string]string{}
+	Headers map[string]string `json:"headers"`
+
+	// Timeout specifies the maximum duration for a single request.
+	// Optional, default: 30 * time.Second
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send requests, Timeout is ignored if provided.
+	// Optional, default: &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"-"`
+}
+
+// NewTool creates a new SearxNG search tool instance.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	s, err := newSearxngSearch(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create searxng search tool: %w", err)
+	}
+
+	searchTool, err := utils.InferTool(config.ToolName, config.ToolDesc, s.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return searchTool, nil
+}
+
+// validate validates the SearxNG search tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "searxng_search"
+	}
+
+	if c.ToolDesc == "" {
+		c.ToolDesc = "search web for information by searxng"
+	}
+
+	if c.BaseURL == "" {
+		return errors.New("searxng search tool config is missing base url")
+	}
+
+	if c.SafeSearch < SafeSearchOff || c.SafeSearch > SafeSearchStrict {
+		return fmt.Errorf("invalid safe search: %d", c.SafeSearch)
+	}
+
+	if c.MaxResults <= 0 {
+		c.MaxResults = 10
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = 30 * time.Second
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: c.Timeout}
+	}
+
+	return nil
+}
+
+// searxngSearch represents the SearxNG search tool.
+type searxngSearch struct {
+	config   *Config
+	endpoint string
+}
+
+// newSearxngSearch creates a new SearxNG search client.
+func newSearxngSearch(config *Config) (*searxngSearch, error) {
+	if config == nil {
+		return nil, errors.New("searxng search tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &searxngSearch{
+		config:   config,
+		endpoint: strings.TrimRight(config.BaseURL, "/") + "/search",
+	}, nil
+}
+
+type SearchRequest struct {
+	Query string `json:"query" jsonschema_description:"The query to search the web for"`
+	Page  int    `json:"page" jsonschema_description:"The page number to search for, default: 1"`
+}
+
+type SearchResult struct {
+	Title       string `json:"title" jsonschema_description:"The title of the search result"`
+	Description string `json:"description" jsonschema_description:"The description of the search result"`
+	Link        string `json:"link" jsonschema_description:"The link of the search result"`
+}
+
+type SearchResponse struct {
+	Results []*SearchResult `json:"results" jsonschema_description:"The results of the search"`
+}
+
+// searxngResponse is the json response of SearxNG search api.
+type searxngResponse struct {
+	Results []struct {
+		URL     string `json:"url"`
+		Title   string `json:"title"`
+		Content string `json:"content"`
+	} `json:"results"`
+}
+
+// Search searches the web for information.
+func (s *searxngSearch) Search(ctx context.Context, request *SearchRequest) (*SearchResponse, error) {
+	if request.Query == "" {
+		return nil, errors.New("query is required")
+	}
+
+	params := url.Values{}
+	params.Set("q", request.Query)
+	params.Set("format", "json")
+	params.Set("safesearch", strconv.Itoa(int(s.config.SafeSearch)))
+	if request.Page > 1 {
+		params.Set("pageno", strconv.Itoa(request.Page))
+	}
+	if len(s.config.Engines) > 0 {
+		params.Set("engines", strings.Join(s.config.Engines, ","))
+	}
+	if len(s.config.Categories) > 0 {
+		params.Set("categories", strings.Join(s.config.Categories, ","))
+	}
+	if s.config.Language != "" {
+		params.Set("language", s.config.Language)
+	}
+	if s.config.TimeRange != "" {
+		params.Set("time_range", string(s.config.TimeRange))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range s.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// 403 usually means json format is not enabled in settings.yml of the instance
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var sr searxngResponse
+	if err = json.Unmarshal(body, &sr); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	results := make([]*SearchResult, 0, len(sr.Results))
+	for _, r := range sr.Results {
+		if len(results) >= s.config.MaxResults {
+			break
+		}
+		results = append(results, &SearchResult{
+			Title:       r.Title,
+			Description: r.Content,
+			Link:        r.URL,
+		})
+	}
+
+	return &SearchResponse{
+		Results: results,
+	}, nil
+}
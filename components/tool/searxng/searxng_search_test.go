@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package searxng
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_validate(t *testing.T) {
+	conf := &Config{BaseURL: "http://localhost:8888"}
+	assert.NoError(t, conf.validate())
+	assert.Equal(t, "searxng_search", conf.ToolName)
+	assert.Equal(t, "search web for information by searxng", conf.ToolDesc)
+	assert.Equal(t, 10, conf.MaxResults)
+	assert.NotNil(t, conf.HTTPClient)
+
+	assert.Error(t, (&Config{}).validate())
+	assert.Error(t, (&Config{BaseURL: "http://localhost:8888", SafeSearch: 3}).validate())
+
+	_, err := NewTool(context.Background(), &Config{BaseURL: "http://localhost:8888"})
+	assert.NoError(t, err)
+}
+
+func TestSearxngSearch_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+
+		q := r.URL.Query()
+		assert.Equal(t, "eino", q.Get("q"))
+		assert.Equal(t, "json", q.Get("format"))
+		assert.Equal(t, "google,bing", q.Get("engines"))
+		assert.Equal(t, "general,news", q.Get("categories"))
+		assert.Equal(t, "zh-CN", q.Get("language"))
+		assert.Equal(t, "1", q.Get("safesearch"))
+		assert.Equal(t, "month", q.Get("time_range"))
+		assert.Equal(t, "2", q.Get("pageno"))
+
+		w.Write([]byte(`{
+			"query": "eino",
+			"number_of_results": 3,
+			"results": [
+				{"url": "https://github.com/cloudwego/eino", "title": "Eino", "content": "LLM application development framework", "engines": ["google"], "score": 2.0},
+				{"url": "https://www.cloudwego.io", "title": "CloudWeGo", "content": "microservice frameworks", "engines": ["bing"], "score": 1.0},
+				{"url": "https://example.com", "title": "Example", "content": "example"}
+			],
+			"answers": [],
+			"unresponsive_engines": []
+		}`))
+	}))
+	defer server.Close()
+
+	s, err := newSearxngSearch(&Config{
+		BaseURL:    server.URL + "/",
+		Engines:    []string{"google", "bing"},
+		Categories: []string{"general", "news"},
+		Language:   "zh-CN",
+		SafeSearch: SafeSearchModerate,
+		TimeRange:  TimeRangeMonth,
+		MaxResults: 2,
+		Headers:    map[string]string{"Authorization": "secret"},
+	})
+	assert.NoError(t, err)
+
+	resp, err := s.Search(context.Background(), &SearchRequest{Query: "eino", Page: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []*SearchResult{
+		{Title: "Eino", Description: "LLM application development framework", Link: "https://github.com/cloudwego/eino"},
+		{Title: "CloudWeGo", Description: "microservice frameworks", Link: "https://www.cloudwego.io"},
+	}, resp.Results)
+
+	_, err = s.Search(context.Background(), &SearchRequest{})
+	assert.Error(t, err)
+}
+
+func TestSearxngSearch_SearchError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	s, err := newSearxngSearch(&Config{BaseURL: server.URL})
+	assert.NoError(t, err)
+
+	_, err = s.Search(context.Background(), &SearchRequest{Query: "eino"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sequentialthinking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultRedisKeyPrefix = "eino:sequentialthinking:"
+
+// RedisStoreConfig is the config of RedisStore.
+type RedisStoreConfig struct {
+	// Client is the redis client used to store snapshots.
+	// Required.
+	Client redis.UniversalClient
+	// KeyPrefix is prepended to session ids to make the redis keys.
+	// Optional. Default: "eino:sequentialthinking:".
+	KeyPrefix string
+	// Expiration is the ttl of a snapshot, refreshed on every save.
+	// Optional. Default: 0, never expires.
+	Expiration time.Duration
+}
+
+// NewRedisStore creates a StateStore keeping the snapshot of each session as a json string in redis.
+func NewRedisStore(config *RedisStoreConfig) (*RedisStore, error) {
+	if config == nil || config.Client == nil {
+		return nil, errors.New("redis client is required")
+	}
+
+	prefix := config.KeyPrefix
+	if prefix == "" {
+		prefix = defaultRedisKeyPrefix
+	}
+
+	return &RedisStore{
+		client:     config.Client,
+		keyPrefix:  prefix,
+		expiration: config.Expiration,
+	}, nil
+}
+
+type RedisStore struct {
+	client     redis.UniversalClient
+	keyPrefix  string
+	expiration time.Duration
+}
+
+func (s *RedisStore) Load(ctx context.Context, sessionID string) (*Snapshot, bool, error) {
+	data, err := s.client.Get(ctx, s.keyPrefix+sessionID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+
+	snapshot := &Snapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return snapshot, true, nil
+}
+
+func (s *RedisStore) Save(ctx context.Context, sessionID string, snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if err = s.client.Set(ctx, s.keyPrefix+sessionID, data, s.expiration).Err(); err != nil {
+		return fmt.Errorf("failed to set snapshot: %w", err)
+	}
+	return nil
+}
@@ -18,9 +18,12 @@ package sequentialthinking
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	
 	"github.com/cloudwego/eino/components/tool"
 	"github.com/cloudwego/eino/components/tool/utils"
@@ -71,6 +74,11 @@ Parameters explained:
 - branch_from_thought: If branching, which thought number is the branching point
 - branch_id: Identifier for the current branch (if any)
 - needs_more_thoughts: If reaching end but realizing more thoughts needed
+- action: What to do, think by default. Besides think, the thoughts of this session can be reviewed by:
+* list_branches: List the branches made so far
+* revisit_branch: Return the thoughts of the branch given by branch_id to continue from
+* export_tree: Return the full thought tree as JSON
+Other parameters are ignored by the actions other than think
 
 You should:
 1. Start with an initial estimate of needed thoughts, but be ready to adjust
@@ -99,8 +107,17 @@ type ThoughtRequest struct {
 	BranchID          string `json:"branch_id,omitempty" jsonschema:"description=Branch identifier"`
 	NeedsMoreThoughts bool   `json:"needs_more_thoughts,omitempty" jsonschema:"description=If more thoughts are needed"`
 	NextThoughtNeeded bool   `json:"next_thought_needed" jsonschema:"required,description=Whether another thought step is needed"`
+	Action            string `json:"action,omitempty" jsonschema:"description=What to do: think by default or list_branches or revisit_branch or export_tree,enum=think,enum=list_branches,enum=revisit_branch,enum=export_tree"`
 }
 
+// Actions of the tool, ActionThink adds a thought, the others review the thoughts of the session.
+const (
+	ActionThink         = "think"
+	ActionListBranches  = "list_branches"
+	ActionRevisitBranch = "revisit_branch"
+	ActionExportTree    = "export_tree"
+)
+
 // ThoughtResult represents the formatted output of processing a thought.
 // It contains the content to display and metadata about the thinking state.
 type ThoughtResult struct {
@@ -110,6 +127,8 @@ type ThoughtResult struct {
 	NextThoughtNeeded    bool     `json:"next_thought_needed" jsonschema:"required,description=Which thought is needed"`
 	Branches             []string `json:"branches" jsonschema:"description=Branch identifier"`
 	ThoughtHistoryLength int      `json:"thought_history_length" jsonschema:"description=Length of thoughts history needed"`
+	// Thoughts are the thoughts of the revisited branch.
+	Thoughts []*ThoughtRequest `json:"thoughts,omitempty"`
 }
 
 // thinkingServer maintains the state of the sequential thinking process.
@@ -218,17 +237,209 @@ func (t *thinkingServer) processThought(_ context.Context, td *ThoughtRequest) (
 	}, nil
 }
 
+// listBranches returns the branches made so far, sorted by branch id.
+func (t *thinkingServer) listBranches() *ThoughtResult {
+	branches := getKeys(t.branches)
+	sort.Strings(branches)
+	
+	lines := make([]string, 0, len(branches)+1)
+	lines = append(lines, fmt.Sprintf("%d branches", len(branches)))
+	for _, id := range branches {
+		thoughts := t.branches[id]
+		lines = append(lines, fmt.Sprintf("- %s: from thought %d, %d thoughts", id, thoughts[0].BranchFromThought, len(thoughts)))
+	}
+	
+	return t.result(strings.Join(lines, "\n"))
+}
+
+// revisitBranch returns the thoughts of the branch, so that thinking can continue from its last thought.
+func (t *thinkingServer) revisitBranch(branchID string) *ThoughtResult {
+	thoughts, ok := t.branches[branchID]
+	if !ok {
+		return t.result(fmt.Sprintf("Branch %q not found", branchID))
+	}
+	
+	formatted := make([]string, 0, len(thoughts))
+	for _, thought := range thoughts {
+		formatted = append(formatted, t.formatThought(thought))
+	}
+	
+	res := t.result(strings.Join(formatted, "\n"))
+	last := thoughts[len(thoughts)-1]
+	res.ThoughtNumber = last.ThoughtNumber
+	res.TotalThoughts = last.TotalThoughts
+	res.NextThoughtNeeded = last.NextThoughtNeeded
+	res.Thoughts = thoughts
+	return res
+}
+
+// exportTree returns the full thought tree as json.
+func (t *thinkingServer) exportTree(sessionID string) (*ThoughtResult, error) {
+	tree, err := json.Marshal(buildThoughtTree(sessionID, t.thoughtHistory))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal thought tree: %w", err)
+	}
+	return t.result(string(tree)), nil
+}
+
+// result creates a result reflecting the current thinking state without adding a thought.
+func (t *thinkingServer) result(content string) *ThoughtResult {
+	res := &ThoughtResult{
+		Content:              content,
+		Branches:             getKeys(t.branches),
+		ThoughtHistoryLength: len(t.thoughtHistory),
+	}
+	if len(t.thoughtHistory) > 0 {
+		last := t.thoughtHistory[len(t.thoughtHistory)-1]
+		res.ThoughtNumber = last.ThoughtNumber
+		res.TotalThoughts = last.TotalThoughts
+		res.NextThoughtNeeded = last.NextThoughtNeeded
+	}
+	return res
+}
+
+// snapshot returns the thinking state to be saved.
+func (t *thinkingServer) snapshot() *Snapshot {
+	return &Snapshot{Thoughts: t.thoughtHistory}
+}
+
+// restore replaces the thinking state with the snapshot, rebuilding branches from the thoughts.
+func (t *thinkingServer) restore(snapshot *Snapshot) {
+	t.thoughtHistory = make([]*ThoughtRequest, 0, len(snapshot.Thoughts))
+	t.branches = make(map[string][]*ThoughtRequest)
+	for _, thought := range snapshot.Thoughts {
+		t.thoughtHistory = append(t.thoughtHistory, thought)
+		if thought.BranchID != "" {
+			t.branches[thought.BranchID] = append(t.branches[thought.BranchID], thought)
+		}
+	}
+}
+
+const defaultSessionID = "default"
+
+type sessionIDKey struct{}
+
+// WithSessionID sets the session of the thoughts made by the tool running with ctx,
+// thoughts of different sessions are kept apart. Thoughts are made in the "default" session without it.
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+func getSessionID(ctx context.Context) string {
+	if sessionID, ok := ctx.Value(sessionIDKey{}).(string); ok && sessionID != "" {
+		return sessionID
+	}
+	return defaultSessionID
+}
+
+type options struct {
+	store StateStore
+}
+
+// Option configures the tool created by NewTool.
+type Option func(o *options)
+
+// WithStateStore saves the thinking state of each session to store after every thought, and restores it
+// before handling each call, so that the thought history outlives the process.
+// Without it, the thinking state is kept in process memory only.
+func WithStateStore(store StateStore) Option {
+	return func(o *options) {
+		o.store = store
+	}
+}
+
+// thinkingTool dispatches the calls of the tool to the thinking server of the session.
+type thinkingTool struct {
+	mu       sync.Mutex
+	store    StateStore
+	sessions map[string]*thinkingServer
+}
+
+func (t *thinkingTool) run(ctx context.Context, req *ThoughtRequest) (*ThoughtResult, error) {
+	sessionID := getSessionID(ctx)
+	
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	
+	server, err := t.session(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	
+	switch req.Action {
+	case "", ActionThink:
+	case ActionListBranches:
+		return server.listBranches(), nil
+	case ActionRevisitBranch:
+		return server.revisitBranch(req.BranchID), nil
+	case ActionExportTree:
+		return server.exportTree(sessionID)
+	default:
+		return server.result(fmt.Sprintf("Unknown action %q, should be one of %s, %s, %s, %s",
+			req.Action, ActionThink, ActionListBranches, ActionRevisitBranch, ActionExportTree)), nil
+	}
+	
+	req.Action = ""
+	result, err := server.processThought(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	
+	if t.store != nil {
+		if err = t.store.Save(ctx, sessionID, server.snapshot()); err != nil {
+			return nil, fmt.Errorf("failed to save thinking state: %w", err)
+		}
+	}
+	
+	return result, nil
+}
+
+// session returns the thinking server of the session, restored from the store if any,
+// otherwise kept in memory.
+func (t *thinkingTool) session(ctx context.Context, sessionID string) (*thinkingServer, error) {
+	if t.store == nil {
+		server, ok := t.sessions[sessionID]
+		if !ok {
+			server = newThinkingServer()
+			t.sessions[sessionID] = server
+		}
+		return server, nil
+	}
+	
+	server := newThinkingServer()
+	snapshot, ok, err := t.store.Load(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load thinking state: %w", err)
+	}
+	if ok {
+		server.restore(snapshot)
+	}
+	return server, nil
+}
+
 // NewTool creates a new sequential thinking tool instance.
+// Parameters:
+//   - opts: Options of the tool, e.g. WithStateStore
 // Returns:
 //   - tool: An invokable tool interface
 //   - err: An error if tool creation fails
-func NewTool() (tool.InvokableTool, error) {
+func NewTool(opts ...Option) (tool.InvokableTool, error) {
 	thinking := newThinkingServer()
 	if thinking == nil {
 		return nil, errors.New("failed to create thinking server")
 	}
 	
-	thinkingTool, err := utils.InferTool(toolName, toolDesc, thinking.processThought)
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	
+	t := &thinkingTool{
+		store:    o.store,
+		sessions: map[string]*thinkingServer{defaultSessionID: thinking},
+	}
+	
+	thinkingTool, err := utils.InferTool(toolName, toolDesc, t.run)
 	if err != nil {
 		return nil, fmt.Errorf("failed to infer tool: %w", err)
 	}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sequentialthinking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	_ StateStore = (*InMemoryStore)(nil)
+	_ StateStore = (*FileStore)(nil)
+	_ StateStore = (*RedisStore)(nil)
+)
+
+// Snapshot is the thinking state of a session, which is saved to and restored from a StateStore.
+// Branches are rebuilt from the branch ids of the thoughts when restored.
+type Snapshot struct {
+	Thoughts []*ThoughtRequest `json:"thoughts"`
+}
+
+// StateStore persists the thinking state per session, so that the thought history outlives the process
+// and can be shared among processes.
+type StateStore interface {
+	// Load returns the snapshot of the session, exist is false if nothing is saved for the session.
+	Load(ctx context.Context, sessionID string) (snapshot *Snapshot, exist bool, err error)
+	// Save replaces the snapshot of the session.
+	Save(ctx context.Context, sessionID string, snapshot *Snapshot) error
+}
+
+// NewInMemoryStore creates a StateStore keeping snapshots in process memory.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{snapshots: make(map[string][]byte)}
+}
+
+// InMemoryStore keeps snapshots in process memory, marshalled so that later changes to the thoughts do not leak in.
+type InMemoryStore struct {
+	mu        sync.RWMutex
+	snapshots map[string][]byte
+}
+
+func (s *InMemoryStore) Load(_ context.Context, sessionID string) (*Snapshot, bool, error) {
+	s.mu.RLock()
+	data, ok := s.snapshots[sessionID]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, false, nil
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return snapshot, true, nil
+}
+
+func (s *InMemoryStore) Save(_ context.Context, sessionID string, snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	s.mu.Lock()
+	s.snapshots[sessionID] = data
+	s.mu.Unlock()
+	return nil
+}
+
+// NewFileStore creates a StateStore keeping the snapshot of each session in a json file under dir.
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("dir is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dir: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// FileStore keeps the snapshot of each session in a json file named after the escaped session id.
+type FileStore struct {
+	dir string
+}
+
+func (s *FileStore) Load(_ context.Context, sessionID string) (*Snapshot, bool, error) {
+	data, err := os.ReadFile(s.path(sessionID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	snapshot := &Snapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return snapshot, true, nil
+}
+
+// Save writes the snapshot to a temp file first and renames it, so a crash never leaves a partial snapshot.
+func (s *FileStore) Save(_ context.Context, sessionID string, snapshot *Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	f, err := os.CreateTemp(s.dir, ".snapshot-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err = os.Rename(f.Name(), s.path(sessionID)); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) path(sessionID string) string {
+	return filepath.Join(s.dir, url.PathEscape(sessionID)+".json")
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sequentialthinking
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestStateStores(t *testing.T) {
+	ctx := context.Background()
+	fileStore, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, store := range map[string]StateStore{
+		"in memory": NewInMemoryStore(),
+		"file":      fileStore,
+	} {
+		convey.Convey("Test "+name+" store", t, func() {
+			_, ok, err := store.Load(ctx, "session/1")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeFalse)
+
+			snapshot := &Snapshot{Thoughts: []*ThoughtRequest{{Thought: "First thought", ThoughtNumber: 1, TotalThoughts: 2}}}
+			convey.So(store.Save(ctx, "session/1", snapshot), convey.ShouldBeNil)
+			snapshot.Thoughts[0].Thought = "changed"
+
+			loaded, ok, err := store.Load(ctx, "session/1")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(len(loaded.Thoughts), convey.ShouldEqual, 1)
+			convey.So(loaded.Thoughts[0].Thought, convey.ShouldEqual, "First thought")
+		})
+	}
+}
+
+func TestToolWithStateStore(t *testing.T) {
+	convey.Convey("Test tool restores sessions from the store", t, func() {
+		store := NewInMemoryStore()
+		ctx := WithSessionID(context.Background(), "s1")
+
+		run := func(req *ThoughtRequest) *ThoughtResult {
+			tl, err := NewTool(WithStateStore(store))
+			convey.So(err, convey.ShouldBeNil)
+			args, _ := json.Marshal(req)
+			out, err := tl.InvokableRun(ctx, string(args))
+			convey.So(err, convey.ShouldBeNil)
+			res := &ThoughtResult{}
+			convey.So(json.Unmarshal([]byte(out), res), convey.ShouldBeNil)
+			return res
+		}
+
+		run(&ThoughtRequest{Thought: "First thought", ThoughtNumber: 1, TotalThoughts: 3, NextThoughtNeeded: true})
+		run(&ThoughtRequest{Thought: "Branch thought", ThoughtNumber: 2, TotalThoughts: 3, BranchFromThought: 1,
+			BranchID: "b1", NextThoughtNeeded: true})
+		res := run(&ThoughtRequest{Thought: "Second thought", ThoughtNumber: 2, TotalThoughts: 3, NextThoughtNeeded: true})
+		convey.So(res.ThoughtHistoryLength, convey.ShouldEqual, 3)
+
+		res = run(&ThoughtRequest{Action: ActionListBranches})
+		convey.So(res.Branches, convey.ShouldResemble, []string{"b1"})
+		convey.So(res.Content, convey.ShouldContainSubstring, "b1: from thought 1, 1 thoughts")
+
+		res = run(&ThoughtRequest{Action: ActionRevisitBranch, BranchID: "b1"})
+		convey.So(len(res.Thoughts), convey.ShouldEqual, 1)
+		convey.So(res.Content, convey.ShouldContainSubstring, "Branch thought")
+
+		res = run(&ThoughtRequest{Action: ActionRevisitBranch, BranchID: "b2"})
+		convey.So(res.Content, convey.ShouldContainSubstring, "not found")
+
+		res = run(&ThoughtRequest{Action: ActionExportTree})
+		tree := &ThoughtTree{}
+		convey.So(json.Unmarshal([]byte(res.Content), tree), convey.ShouldBeNil)
+		convey.So(tree.SessionID, convey.ShouldEqual, "s1")
+		convey.So(len(tree.Thoughts), convey.ShouldEqual, 2)
+		convey.So(len(tree.Thoughts[0].Branches), convey.ShouldEqual, 1)
+		convey.So(tree.Thoughts[0].Branches[0].Thoughts[0].Thought, convey.ShouldEqual, "Branch thought")
+
+		exported, err := ExportThoughtTree(context.Background(), store, "s1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(exported, convey.ShouldResemble, tree)
+
+		// other sessions are kept apart
+		exported, err = ExportThoughtTree(context.Background(), store, "s2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(exported.Thoughts), convey.ShouldEqual, 0)
+	})
+}
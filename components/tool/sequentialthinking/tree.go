@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sequentialthinking
+
+import (
+	"context"
+	"fmt"
+)
+
+// ThoughtTree is the full thought history of a session, with branches attached to the thoughts they branch from.
+type ThoughtTree struct {
+	SessionID string `json:"session_id"`
+	// Thoughts are the thoughts of the main line, including revisions, in the order they were made.
+	Thoughts []*ThoughtNode `json:"thoughts"`
+	// Detached are the branches whose branching point is not found in the history.
+	Detached []*ThoughtBranch `json:"detached,omitempty"`
+}
+
+type ThoughtNode struct {
+	ThoughtRequest
+	Branches []*ThoughtBranch `json:"branches,omitempty"`
+}
+
+type ThoughtBranch struct {
+	BranchID string         `json:"branch_id"`
+	Thoughts []*ThoughtNode `json:"thoughts"`
+}
+
+// ExportThoughtTree builds the thought tree of the session saved in store, e.g. for audit after the agent finishes.
+func ExportThoughtTree(ctx context.Context, store StateStore, sessionID string) (*ThoughtTree, error) {
+	snapshot, ok, err := store.Load(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot: %w", err)
+	}
+	if !ok {
+		snapshot = &Snapshot{}
+	}
+	return buildThoughtTree(sessionID, snapshot.Thoughts), nil
+}
+
+func buildThoughtTree(sessionID string, thoughts []*ThoughtRequest) *ThoughtTree {
+	tree := &ThoughtTree{
+		SessionID: sessionID,
+		Thoughts:  make([]*ThoughtNode, 0, len(thoughts)),
+	}
+
+	branches := make(map[string]*ThoughtBranch)
+	// branchIDs keeps the order branches are made.
+	branchIDs := make([]string, 0)
+	// byNumber is the latest node of each thought number, the branching points of branches.
+	byNumber := make(map[int]*ThoughtNode)
+	for _, thought := range thoughts {
+		node := &ThoughtNode{ThoughtRequest: *thought}
+		if thought.BranchID == "" {
+			tree.Thoughts = append(tree.Thoughts, node)
+			byNumber[thought.ThoughtNumber] = node
+			continue
+		}
+
+		branch, ok := branches[thought.BranchID]
+		if !ok {
+			branch = &ThoughtBranch{BranchID: thought.BranchID}
+			branches[thought.BranchID] = branch
+			branchIDs = append(branchIDs, thought.BranchID)
+		}
+		branch.Thoughts = append(branch.Thoughts, node)
+	}
+
+	for _, id := range branchIDs {
+		branch := branches[id]
+		from, ok := byNumber[branch.Thoughts[0].BranchFromThought]
+		if !ok {
+			tree.Detached = append(tree.Detached, branch)
+			continue
+		}
+		from.Branches = append(from.Branches, branch)
+	}
+
+	return tree
+}
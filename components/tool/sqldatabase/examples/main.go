@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/cloudwego/eino-ext/components/tool/sqldatabase"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Connect with a read-only database user, e.g. "reader:password@tcp(127.0.0.1:3306)/shop"
+	db, err := sql.Open("mysql", os.Getenv("MYSQL_DSN"))
+	if err != nil {
+		log.Fatalf("Open database failed, err=%v", err)
+	}
+	defer db.Close()
+
+	sqlTool, err := sqldatabase.NewTool(ctx, &sqldatabase.Config{
+		DB:           db,
+		Dialect:      sqldatabase.DialectMySQL,
+		MaxRows:      20,
+		QueryTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("NewTool of sqldatabase failed, err=%v", err)
+	}
+
+	requests := []*sqldatabase.Request{
+		{Action: sqldatabase.ActionListTables},
+		{Action: sqldatabase.ActionDescribeTable, Table: "orders"},
+		{Action: sqldatabase.ActionQuery, Query: "SELECT status, COUNT(*) AS cnt FROM orders GROUP BY status"},
+		{Action: sqldatabase.ActionQuery, Query: "DELETE FROM orders"}, // rejected by the deny-list
+	}
+
+	for _, req := range requests {
+		args, err := json.Marshal(req)
+		if err != nil {
+			log.Fatalf("Marshal of request failed, err=%v", err)
+		}
+
+		resp, err := sqlTool.InvokableRun(ctx, string(args))
+		if err != nil {
+			fmt.Printf("%s failed: %v\n", req.Action, err)
+			continue
+		}
+		fmt.Printf("%s: %s\n", req.Action, resp)
+	}
+}
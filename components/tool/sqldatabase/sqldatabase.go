@@ -0,0 +1,385 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sqldatabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectMySQL    Dialect = "mysql"
+	DialectPostgres Dialect = "postgres"
+)
+
+type Action string
+
+const (
+	// ActionQuery executes a read-only sql query
+	ActionQuery Action = "query"
+	// ActionListTables lists the tables and views in the database
+	ActionListTables Action = "list_tables"
+	// ActionDescribeTable describes the columns of a table
+	ActionDescribeTable Action = "describe_table"
+)
+
+// Config represents the sql database tool configuration.
+type Config struct {
+	// Eino tool settings
+	ToolName string `json:"tool_name"` // optional, default is "sql_database"
+	ToolDesc string `json:"tool_desc"` // optional, default describes the actions of the tool
+
+	// DB is the database connection to query, required.
+	// Connecting with a read-only database user is strongly recommended.
+	DB *sql.DB `json:"-"`
+
+	// Dialect specifies the sql dialect of DB, used by the introspection actions, required.
+	Dialect Dialect `json:"dialect"`
+
+	// MaxRows specifies the maximum number of rows returned by a query, rows beyond are dropped.
+	// Optional, default: 100
+	MaxRows int `json:"max_rows"`
+
+	// QueryTimeout specifies the maximum duration of a single query.
+	// Optional, default: 30 * time.Second
+	QueryTimeout time.Duration `json:"query_timeout"`
+
+	// DeniedStatements specifies the keywords rejected in queries, case-insensitive.
+	// Multiple statements in a single query are always rejected.
+	// Optional, default: DefaultDeniedStatements
+	DeniedStatements []string `json:"denied_statements"`
+}
+
+// NewTool creates a new sql database tool instance.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	db, err := newSQLDatabase(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sql database tool: %w", err)
+	}
+
+	sqlTool, err := utils.InferTool(config.ToolName, config.ToolDesc, db.Execute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return sqlTool, nil
+}
+
+// validate validates the sql database tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "sql_database"
+	}
+
+	if c.ToolDesc == "" {
+		c.ToolDesc = fmt.Sprintf("query a %s database with read-only sql. "+
+			"Use list_tables to find the tables, describe_table to get the columns of a table, then query to execute a SELECT statement.", c.Dialect)
+	}
+
+	if c.DB == nil {
+		return errors.New("sql database tool config is missing db")
+	}
+
+	switch c.Dialect {
+	case DialectSQLite, DialectMySQL, DialectPostgres:
+	case "":
+		return errors.New("sql database tool config is missing dialect")
+	default:
+		return fmt.Errorf("unsupported dialect: %s", c.Dialect)
+	}
+
+	if c.MaxRows <= 0 {
+		c.MaxRows = 100
+	}
+
+	if c.QueryTimeout <= 0 {
+		c.QueryTimeout = 30 * time.Second
+	}
+
+	if c.DeniedStatements == nil {
+		c.DeniedStatements = DefaultDeniedStatements
+	}
+
+	return nil
+}
+
+// sqlDatabase represents the sql database tool.
+type sqlDatabase struct {
+	config *Config
+}
+
+// newSQLDatabase creates a new sql database tool.
+func newSQLDatabase(config *Config) (*sqlDatabase, error) {
+	if config == nil {
+		return nil, errors.New("sql database tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &sqlDatabase{config: config}, nil
+}
+
+type Request struct {
+	Action Action `json:"action" jsonschema:"enum=query,enum=list_tables,enum=describe_table" jsonschema_description:"The action to perform, default: query"`
+	Query  string `json:"query,omitempty" jsonschema_description:"The read-only sql query to execute, required by query action"`
+	Table  string `json:"table,omitempty" jsonschema_description:"The name of the table, required by describe_table action"`
+}
+
+type Column struct {
+	Name     string `json:"name" jsonschema_description:"The name of the column"`
+	Type     string `json:"type" jsonschema_description:"The data type of the column"`
+	Nullable bool   `json:"nullable" jsonschema_description:"Whether the column is nullable"`
+	Default  string `json:"default,omitempty" jsonschema_description:"The default value of the column"`
+}
+
+type Response struct {
+	Tables       []string  `json:"tables,omitempty" jsonschema_description:"The tables in the database, returned by list_tables action"`
+	TableColumns []*Column `json:"table_columns,omitempty" jsonschema_description:"The columns of the table, returned by describe_table action"`
+	Columns      []string  `json:"columns,omitempty" jsonschema_description:"The columns of the query result"`
+	Rows         [][]any   `json:"rows,omitempty" jsonschema_description:"The rows of the query result"`
+	Truncated    bool      `json:"truncated,omitempty" jsonschema_description:"Whether the rows are truncated by the row limit"`
+}
+
+// Execute performs the action of the request.
+func (s *sqlDatabase) Execute(ctx context.Context, request *Request) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.QueryTimeout)
+	defer cancel()
+
+	switch request.Action {
+	case ActionQuery, "":
+		return s.query(ctx, request.Query)
+	case ActionListTables:
+		tables, err := s.listTables(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Response{Tables: tables}, nil
+	case ActionDescribeTable:
+		columns, err := s.describeTable(ctx, request.Table)
+		if err != nil {
+			return nil, err
+		}
+		return &Response{TableColumns: columns}, nil
+	default:
+		return nil, fmt.Errorf("unknown action: %s", request.Action)
+	}
+}
+
+func (s *sqlDatabase) query(ctx context.Context, query string) (*Response, error) {
+	if err := checkStatement(query, s.config.DeniedStatements); err != nil {
+		return nil, err
+	}
+
+	columns, rows, truncated, err := s.queryRows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{Columns: columns, Rows: rows, Truncated: truncated}, nil
+}
+
+func (s *sqlDatabase) listTables(ctx context.Context) ([]string, error) {
+	var query string
+	switch s.config.Dialect {
+	case DialectSQLite:
+		query = "SELECT name FROM sqlite_master WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite_%' ORDER BY name"
+	case DialectMySQL:
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() ORDER BY table_name"
+	case DialectPostgres:
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() ORDER BY table_name"
+	}
+
+	rows, err := s.config.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		tables = append(tables, table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+func (s *sqlDatabase) describeTable(ctx context.Context, table string) ([]*Column, error) {
+	if table == "" {
+		return nil, errors.New("table is required")
+	}
+
+	if s.config.Dialect == DialectSQLite {
+		return s.describeSQLiteTable(ctx, table)
+	}
+
+	query := "SELECT column_name, data_type, is_nullable, column_default FROM information_schema.columns " +
+		"WHERE table_schema = DATABASE() AND table_name = ? ORDER BY ordinal_position"
+	if s.config.Dialect == DialectPostgres {
+		query = "SELECT column_name, data_type, is_nullable, column_default FROM information_schema.columns " +
+			"WHERE table_schema = current_schema() AND table_name = $1 ORDER BY ordinal_position"
+	}
+
+	rows, err := s.config.DB.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe table: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []*Column
+	for rows.Next() {
+		var (
+			name, dataType, nullable string
+			defaultValue             sql.NullString
+		)
+		if err := rows.Scan(&name, &dataType, &nullable, &defaultValue); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+		columns = append(columns, &Column{
+			Name:     name,
+			Type:     dataType,
+			Nullable: strings.EqualFold(nullable, "YES"),
+			Default:  defaultValue.String,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to describe table: %w", err)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table not found: %s", table)
+	}
+
+	return columns, nil
+}
+
+func (s *sqlDatabase) describeSQLiteTable(ctx context.Context, table string) ([]*Column, error) {
+	// pragma does not support placeholders, so the table name is checked against the existing tables
+	tables, err := s.listTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, t := range tables {
+		if t == table {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("table not found: %s", table)
+	}
+
+	rows, err := s.config.DB.QueryContext(ctx, fmt.Sprintf(`PRAGMA table_info("%s")`, strings.ReplaceAll(table, `"`, `""`)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe table: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []*Column
+	for rows.Next() {
+		var (
+			cid, notNull, pk int
+			name, dataType   string
+			defaultValue     sql.NullString
+		)
+		if err := rows.Scan(&cid, &name, &dataType, &notNull, &defaultValue, &pk); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+		columns = append(columns, &Column{
+			Name:     name,
+			Type:     dataType,
+			Nullable: notNull == 0 && pk == 0,
+			Default:  defaultValue.String,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to describe table: %w", err)
+	}
+
+	return columns, nil
+}
+
+// queryRows executes the query, and returns at most MaxRows rows.
+func (s *sqlDatabase) queryRows(ctx context.Context, query string) ([]string, [][]any, bool, error) {
+	rows, err := s.config.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get columns: %w", err)
+	}
+
+	result := make([][]any, 0)
+	truncated := false
+	for rows.Next() {
+		if len(result) >= s.config.MaxRows {
+			truncated = true
+			break
+		}
+
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, nil, false, fmt.Errorf("failed to scan row: %w", err)
+		}
+		for i, v := range values {
+			values[i] = convertValue(v)
+		}
+		result = append(result, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, false, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	return columns, result, truncated, nil
+}
+
+// convertValue converts the scanned value to a json friendly value.
+func convertValue(v any) any {
+	switch val := v.(type) {
+	case []byte:
+		return string(val)
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	default:
+		return val
+	}
+}
@@ -0,0 +1,276 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sqldatabase
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResult is the result of a query of fake driver.
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+// fakeDriver returns the result whose key is contained in the query.
+type fakeDriver struct {
+	mu      sync.Mutex
+	results map[string]*fakeResult
+	queries []string
+	args    [][]driver.NamedValue
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.queries = append(c.d.queries, query)
+	c.d.args = append(c.d.args, args)
+	for key, result := range c.d.results {
+		if strings.Contains(query, key) {
+			return &fakeRows{result: result}, nil
+		}
+	}
+	return nil, errors.New("no such table")
+}
+
+type fakeRows struct {
+	result *fakeResult
+	idx    int
+}
+
+func (r *fakeRows) Columns() []string { return r.result.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.idx >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.idx])
+	r.idx++
+	return nil
+}
+
+var (
+	fakeDriverMu  sync.Mutex
+	fakeDriverSeq int
+)
+
+func newFakeDB(t *testing.T, results map[string]*fakeResult) (*sql.DB, *fakeDriver) {
+	fakeDriverMu.Lock()
+	fakeDriverSeq++
+	name := "fakesql" + strings.Repeat("_", fakeDriverSeq)
+	fakeDriverMu.Unlock()
+
+	d := &fakeDriver{results: results}
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db, d
+}
+
+func TestConfigValidate(t *testing.T) {
+	db, _ := newFakeDB(t, nil)
+
+	_, err := newSQLDatabase(nil)
+	assert.Error(t, err)
+
+	_, err = newSQLDatabase(&Config{Dialect: DialectSQLite})
+	assert.Error(t, err)
+
+	_, err = newSQLDatabase(&Config{DB: db})
+	assert.Error(t, err)
+
+	_, err = newSQLDatabase(&Config{DB: db, Dialect: "oracle"})
+	assert.Error(t, err)
+
+	conf := &Config{DB: db, Dialect: DialectMySQL}
+	_, err = newSQLDatabase(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, "sql_database", conf.ToolName)
+	assert.NotEmpty(t, conf.ToolDesc)
+	assert.Equal(t, 100, conf.MaxRows)
+	assert.Equal(t, 30*time.Second, conf.QueryTimeout)
+	assert.Equal(t, DefaultDeniedStatements, conf.DeniedStatements)
+
+	_, err = NewTool(context.Background(), &Config{DB: db, Dialect: DialectPostgres})
+	assert.NoError(t, err)
+}
+
+func TestCheckStatement(t *testing.T) {
+	allowed := []string{
+		"SELECT * FROM users",
+		"select id from users;",
+		"WITH t AS (SELECT 1) SELECT * FROM t",
+		"SELECT 'drop table users' AS s",
+		`SELECT "update" FROM t`,
+		"SELECT 1 -- delete everything\n",
+		"SELECT /* insert */ 1",
+		"SELECT REPLACE(name, 'a', 'b') FROM users",
+		"SELECT 'it''s; fine'",
+		"SELECT 'C:\\path'",
+	}
+	for _, q := range allowed {
+		assert.NoError(t, checkStatement(q, DefaultDeniedStatements), q)
+	}
+
+	denied := []string{
+		"",
+		"  ;  ",
+		"DROP TABLE users",
+		"delete from users",
+		"SELECT 1; DROP TABLE users",
+		"SELECT * INTO backup FROM users",
+		"WITH d AS (DELETE FROM users RETURNING *) SELECT * FROM d",
+		"REPLACE INTO users VALUES (1)",
+		"SELECT 'a\\'; drop table users",
+		"SELECT 'a\\' ; DROP TABLE t; SELECT '",
+		"SELECT 'unterminated",
+		"SELECT 1 /* unterminated",
+	}
+	for _, q := range denied {
+		assert.Error(t, checkStatement(q, DefaultDeniedStatements), q)
+	}
+
+	assert.NoError(t, checkStatement("DELETE FROM users", []string{}))
+	assert.Error(t, checkStatement("SELECT * FROM users", []string{"select"}))
+}
+
+func TestExecute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	db, d := newFakeDB(t, map[string]*fakeResult{
+		"FROM users": {
+			columns: []string{"id", "name", "created_at"},
+			rows: [][]driver.Value{
+				{int64(1), []byte("alice"), now},
+				{int64(2), "bob", nil},
+				{int64(3), "carol", nil},
+			},
+		},
+		"sqlite_master": {
+			columns: []string{"name"},
+			rows:    [][]driver.Value{{"orders"}, {"users"}},
+		},
+		"PRAGMA table_info": {
+			columns: []string{"cid", "name", "type", "notnull", "dflt_value", "pk"},
+			rows: [][]driver.Value{
+				{int64(0), "id", "INTEGER", int64(0), nil, int64(1)},
+				{int64(1), "name", "TEXT", int64(1), "'anonymous'", int64(0)},
+				{int64(2), "email", "TEXT", int64(0), nil, int64(0)},
+			},
+		},
+	})
+
+	s, err := newSQLDatabase(&Config{DB: db, Dialect: DialectSQLite, MaxRows: 2})
+	require.NoError(t, err)
+
+	t.Run("query", func(t *testing.T) {
+		resp, err := s.Execute(ctx, &Request{Query: "SELECT * FROM users"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"id", "name", "created_at"}, resp.Columns)
+		assert.Equal(t, [][]any{
+			{int64(1), "alice", "2025-01-02T03:04:05Z"},
+			{int64(2), "bob", nil},
+		}, resp.Rows)
+		assert.True(t, resp.Truncated)
+	})
+
+	t.Run("denied query", func(t *testing.T) {
+		_, err := s.Execute(ctx, &Request{Action: ActionQuery, Query: "DELETE FROM users"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "DELETE is not allowed")
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		_, err := s.Execute(ctx, &Request{Query: "SELECT * FROM missing"})
+		assert.Error(t, err)
+	})
+
+	t.Run("list tables", func(t *testing.T) {
+		resp, err := s.Execute(ctx, &Request{Action: ActionListTables})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"orders", "users"}, resp.Tables)
+	})
+
+	t.Run("describe table", func(t *testing.T) {
+		resp, err := s.Execute(ctx, &Request{Action: ActionDescribeTable, Table: "users"})
+		require.NoError(t, err)
+		assert.Equal(t, []*Column{
+			{Name: "id", Type: "INTEGER", Nullable: false},
+			{Name: "name", Type: "TEXT", Nullable: false, Default: "'anonymous'"},
+			{Name: "email", Type: "TEXT", Nullable: true},
+		}, resp.TableColumns)
+		assert.Equal(t, `PRAGMA table_info("users")`, d.queries[len(d.queries)-1])
+
+		_, err = s.Execute(ctx, &Request{Action: ActionDescribeTable, Table: "missing"})
+		assert.Error(t, err)
+
+		_, err = s.Execute(ctx, &Request{Action: ActionDescribeTable})
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown action", func(t *testing.T) {
+		_, err := s.Execute(ctx, &Request{Action: "drop"})
+		assert.Error(t, err)
+	})
+}
+
+func TestDescribeTableInformationSchema(t *testing.T) {
+	ctx := context.Background()
+	db, d := newFakeDB(t, map[string]*fakeResult{
+		"information_schema.columns": {
+			columns: []string{"column_name", "data_type", "is_nullable", "column_default"},
+			rows: [][]driver.Value{
+				{"id", "bigint", "NO", nil},
+				{"status", "varchar", "YES", "active"},
+			},
+		},
+	})
+
+	s, err := newSQLDatabase(&Config{DB: db, Dialect: DialectPostgres})
+	require.NoError(t, err)
+
+	columns, err := s.describeTable(ctx, "users")
+	require.NoError(t, err)
+	assert.Equal(t, []*Column{
+		{Name: "id", Type: "bigint", Nullable: false},
+		{Name: "status", Type: "varchar", Nullable: true, Default: "active"},
+	}, columns)
+	assert.Contains(t, d.queries[0], "$1")
+	assert.Equal(t, "users", d.args[0][0].Value)
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sqldatabase
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultDeniedStatements are the keywords rejected by default, which modify data, schema or permissions.
+var DefaultDeniedStatements = []string{
+	"INSERT", "UPDATE", "DELETE", "MERGE", "UPSERT", "REPLACE", "INTO",
+	"CREATE", "ALTER", "DROP", "TRUNCATE", "RENAME",
+	"GRANT", "REVOKE", "ATTACH", "DETACH", "COPY", "LOAD",
+	"CALL", "EXEC", "EXECUTE", "DO", "LOCK", "VACUUM", "REINDEX",
+}
+
+var wordRe = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// checkStatement returns an error if query contains more than one statement,
+// or any keyword in denied outside of comments, string literals and quoted identifiers.
+// Words directly followed by "(" are function calls rather than keywords, e.g. REPLACE(s, 'a', 'b').
+// Since whether backslash escapes quotes depends on the database, the query must pass with both conventions.
+func checkStatement(query string, denied []string) error {
+	deniedSet := make(map[string]bool, len(denied))
+	for _, d := range denied {
+		deniedSet[strings.ToUpper(strings.TrimSpace(d))] = true
+	}
+
+	for _, backslashEscapes := range []bool{false, true} {
+		stripped, err := stripLiterals(query, backslashEscapes)
+		if err != nil {
+			return err
+		}
+		if err = checkStripped(stripped, deniedSet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkStripped(stripped string, denied map[string]bool) error {
+	stripped = strings.TrimSpace(strings.TrimRight(stripped, "; \t\r\n"))
+	if stripped == "" {
+		return fmt.Errorf("query is empty")
+	}
+	if strings.Contains(stripped, ";") {
+		return fmt.Errorf("multiple statements are not allowed")
+	}
+
+	for _, loc := range wordRe.FindAllStringIndex(stripped, -1) {
+		if loc[1] < len(stripped) && stripped[loc[1]] == '(' {
+			continue
+		}
+		word := strings.ToUpper(stripped[loc[0]:loc[1]])
+		if denied[word] {
+			return fmt.Errorf("statement %s is not allowed, only read-only queries are supported", word)
+		}
+	}
+
+	return nil
+}
+
+// stripLiterals replaces comments, string literals and quoted identifiers in query with spaces.
+func stripLiterals(query string, backslashEscapes bool) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			sb.WriteByte(' ')
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return "", fmt.Errorf("unterminated comment")
+			}
+			i += end + 3
+			sb.WriteByte(' ')
+		case c == '\'' || c == '"' || c == '`':
+			closed := false
+			for i++; i < len(query); i++ {
+				if backslashEscapes && query[i] == '\\' {
+					i++
+					continue
+				}
+				if query[i] == c {
+					// doubled quote is an escaped quote
+					if i+1 < len(query) && query[i+1] == c {
+						i++
+						continue
+					}
+					closed = true
+					break
+				}
+			}
+			if !closed {
+				return "", fmt.Errorf("unterminated quoted string")
+			}
+			sb.WriteByte(' ')
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String(), nil
+}
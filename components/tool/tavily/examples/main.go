@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/tool/tavily"
+)
+
+func main() {
+	ctx := context.Background()
+
+	// Create search tool
+	searchTool, err := tavily.NewTool(ctx, &tavily.Config{
+		APIKey:         os.Getenv("TAVILY_API_KEY"),
+		SearchDepth:    tavily.SearchDepthAdvanced,
+		MaxResults:     5,
+		IncludeAnswer:  true,
+		ExcludeDomains: []string{"pinterest.com"},
+	})
+	if err != nil {
+		log.Fatalf("NewTool of tavily failed, err=%v", err)
+	}
+
+	// Create search request
+	searchReq := &tavily.SearchRequest{
+		Query: "What is the latest release of cloudwego eino?",
+		Topic: tavily.TopicGeneral,
+	}
+
+	jsonReq, err := json.Marshal(searchReq)
+	if err != nil {
+		log.Fatalf("Marshal of search request failed, err=%v", err)
+	}
+
+	// Execute search
+	resp, err := searchTool.InvokableRun(ctx, string(jsonReq))
+	if err != nil {
+		log.Fatalf("Search of tavily failed, err=%v", err)
+	}
+
+	var searchResp tavily.SearchResponse
+	if err := json.Unmarshal([]byte(resp), &searchResp); err != nil {
+		log.Fatalf("Unmarshal of search response failed, err=%v", err)
+	}
+
+	// Print answer and results
+	fmt.Printf("Answer: %s\n", searchResp.Answer)
+	fmt.Println("Search Results:")
+	fmt.Println("==============")
+	for i, result := range searchResp.Results {
+		fmt.Printf("\n%d. Title: %s\n", i+1, result.Title)
+		fmt.Printf("   Link: %s\n", result.Link)
+		fmt.Printf("   Score: %.2f\n", result.Score)
+		fmt.Printf("   Description: %s\n", result.Description)
+	}
+}
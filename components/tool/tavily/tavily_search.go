@@ -0,0 +1,295 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+const defaultBaseURL = "https://api.tavily.com"
+
+type SearchDepth string
+type Topic string
+
+const (
+	// SearchDepth settings
+	SearchDepthBasic    SearchDepth = "basic"
+	SearchDepthAdvanced SearchDepth = "advanced"
+
+	// Topic settings
+	TopicGeneral Topic = "general"
+	TopicNews    Topic = "news"
+	TopicFinance Topic = "finance"
+)
+
+// Config represents the Tavily search tool configuration.
+type Config struct {
+	// Eino tool settings
+	ToolName string `json:"tool_name"` // optional, default is "tavily_search"
+	ToolDesc string `json:"tool_desc"` // optional, default is "search web for information by tavily, returns results and a synthesized answer"
+
+	// APIKey is required to access the Tavily Search API.
+	APIKey string `json:"api_key"`
+
+	// BaseURL specifies the address of the Tavily API.
+	// Optional, default: "https://api.tavily.com"
+	BaseURL string `json:"base_url"`
+
+	// SearchDepth specifies the depth of the search, advanced search costs more credits but returns more relevant content.
+	// Optional, default: SearchDepthBasic
+	SearchDepth SearchDepth `json:"search_depth"`
+
+	// Topic specifies the default category of the search, which can be overridden by the agent in each request.
+	// Optional, default: TopicGeneral
+	Topic Topic `json:"topic"`
+
+	// Days limits news results to the number of days back from now, only used with TopicNews.
+	// Optional, default: 0 (decided by Tavily)
+	Days int `json:"days"`
+
+	// MaxResults specifies the maximum number of search results to return.
+	// Optional, default: 5
+	MaxResults int `json:"max_results"`
+
+	// IncludeAnswer enables the synthesized answer of the query in response.
+	// Optional, default: false
+	IncludeAnswer bool `json:"include_answer"`
+
+	// IncludeRawContent includes the cleaned HTML content of each result.
+	// Optional, default: false
+	IncludeRawContent bool `json:"include_raw_content"`
+
+	// IncludeDomains limits results to the domains.
+	// Optional, default: nil
+	IncludeDomains []string `json:"include_domains"`
+
+	// ExcludeDomains excludes results of the domains.
+	// Optional, default: nil
+	ExcludeDomains []string `json:"exclude_domains"`
+
+	// Timeout specifies the maximum duration for a single request.
+	// Optional, default: 30 * time.Second
+	Timeout time.Duration `json:"timeout"`
+
+	// HTTPClient specifies the client to send requests, Timeout is ignored if provided.
+	// Optional, default: &http.Client{Timeout: Timeout}
+	HTTPClient *http.Client `json:"-"`
+}
+
+// NewTool creates a new Tavily search tool instance.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	t, err := newTavilySearch(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tavily search tool: %w", err)
+	}
+
+	searchTool, err := utils.InferTool(config.ToolName, config.ToolDesc, t.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return searchTool, nil
+}
+
+// validate validates the Tavily search tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "tavily_search"
+	}
+
+	if c.ToolDesc == "" {
+		c.ToolDesc = "search web for information by tavily, returns results and a synthesized answer"
+	}
+
+	if c.APIKey == "" {
+		return errors.New("tavily search tool config is missing API key")
+	}
+
+	if c.BaseURL == "" {
+		c.BaseURL = defaultBaseURL
+	}
+
+	if c.SearchDepth == "" {
+		c.SearchDepth = SearchDepthBasic
+	}
+
+	if c.Topic == "" {
+		c.Topic = TopicGeneral
+	}
+
+	if c.MaxResults <= 0 {
+		c.MaxResults = 5
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = 30 * time.Second
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: c.Timeout}
+	}
+
+	return nil
+}
+
+// tavilySearch represents the Tavily search tool.
+type tavilySearch struct {
+	config *Config
+}
+
+// newTavilySearch creates a new Tavily search client.
+func newTavilySearch(config *Config) (*tavilySearch, error) {
+	if config == nil {
+		return nil, errors.New("tavily search tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return &tavilySearch{config: config}, nil
+}
+
+type SearchRequest struct {
+	Query string `json:"query" jsonschema_description:"The query to search the web for"`
+	Topic Topic  `json:"topic,omitempty" jsonschema:"enum=general,enum=news,enum=finance" jsonschema_description:"The category of the search, use news for recent events"`
+}
+
+type SearchResult struct {
+	Title         string  `json:"title" jsonschema_description:"The title of the search result"`
+	Description   string  `json:"description" jsonschema_description:"The most query-related content of the search result"`
+	Link          string  `json:"link" jsonschema_description:"The link of the search result"`
+	Score         float64 `json:"score" jsonschema_description:"The relevance score of the search result"`
+	PublishedDate string  `json:"published_date,omitempty" jsonschema_description:"The publish date of the news"`
+	RawContent    string  `json:"raw_content,omitempty" jsonschema_description:"The cleaned content of the page"`
+}
+
+type SearchResponse struct {
+	Answer  string          `json:"answer,omitempty" jsonschema_description:"The synthesized answer of the query"`
+	Results []*SearchResult `json:"results" jsonschema_description:"The results of the search"`
+}
+
+// tavilyRequest is the json request of Tavily search api.
+type tavilyRequest struct {
+	Query             string      `json:"query"`
+	SearchDepth       SearchDepth `json:"search_depth"`
+	Topic             Topic       `json:"topic"`
+	Days              int         `json:"days,omitempty"`
+	MaxResults        int         `json:"max_results"`
+	IncludeAnswer     bool        `json:"include_answer"`
+	IncludeRawContent bool        `json:"include_raw_content"`
+	IncludeDomains    []string    `json:"include_domains,omitempty"`
+	ExcludeDomains    []string    `json:"exclude_domains,omitempty"`
+}
+
+// tavilyResponse is the json response of Tavily search api.
+type tavilyResponse struct {
+	Answer  string `json:"answer"`
+	Results []struct {
+		Title         string  `json:"title"`
+		URL           string  `json:"url"`
+		Content       string  `json:"content"`
+		Score         float64 `json:"score"`
+		PublishedDate string  `json:"published_date"`
+		RawContent    string  `json:"raw_content"`
+	} `json:"results"`
+}
+
+// Search searches the web for information.
+func (t *tavilySearch) Search(ctx context.Context, request *SearchRequest) (*SearchResponse, error) {
+	if request.Query == "" {
+		return nil, errors.New("query is required")
+	}
+
+	topic := t.config.Topic
+	if request.Topic != "" {
+		topic = request.Topic
+	}
+
+	tr := &tavilyRequest{
+		Query:             request.Query,
+		SearchDepth:       t.config.SearchDepth,
+		Topic:             topic,
+		MaxResults:        t.config.MaxResults,
+		IncludeAnswer:     t.config.IncludeAnswer,
+		IncludeRawContent: t.config.IncludeRawContent,
+		IncludeDomains:    t.config.IncludeDomains,
+		ExcludeDomains:    t.config.ExcludeDomains,
+	}
+	if topic == TopicNews {
+		tr.Days = t.config.Days
+	}
+
+	reqBody, err := json.Marshal(tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.config.BaseURL+"/search", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.config.APIKey)
+
+	resp, err := t.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var tResp tavilyResponse
+	if err = json.Unmarshal(body, &tResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	results := make([]*SearchResult, 0, len(tResp.Results))
+	for _, r := range tResp.Results {
+		results = append(results, &SearchResult{
+			Title:         r.Title,
+			Description:   r.Content,
+			Link:          r.URL,
+			Score:         r.Score,
+			PublishedDate: r.PublishedDate,
+			RawContent:    r.RawContent,
+		})
+	}
+
+	return &SearchResponse{
+		Answer:  tResp.Answer,
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_validate(t *testing.T) {
+	conf := &Config{APIKey: "tvly-test"}
+	assert.NoError(t, conf.validate())
+	assert.Equal(t, "tavily_search", conf.ToolName)
+	assert.Equal(t, defaultBaseURL, conf.BaseURL)
+	assert.Equal(t, SearchDepthBasic, conf.SearchDepth)
+	assert.Equal(t, TopicGeneral, conf.Topic)
+	assert.Equal(t, 5, conf.MaxResults)
+
+	assert.Error(t, (&Config{}).validate())
+
+	_, err := NewTool(context.Background(), &Config{APIKey: "tvly-test"})
+	assert.NoError(t, err)
+}
+
+func TestTavilySearch_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "Bearer tvly-test", r.Header.Get("Authorization"))
+
+		var req tavilyRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, tavilyRequest{
+			Query:          "eino release",
+			SearchDepth:    SearchDepthAdvanced,
+			Topic:          TopicNews,
+			Days:           3,
+			MaxResults:     2,
+			IncludeAnswer:  true,
+			IncludeDomains: []string{"github.com"},
+			ExcludeDomains: []string{"example.com"},
+		}, req)
+
+		w.Write([]byte(`{
+			"query": "eino release",
+			"answer": "Eino is released on GitHub.",
+			"results": [
+				{"title": "Eino", "url": "https://github.com/cloudwego/eino", "content": "LLM application development framework", "score": 0.9, "published_date": "Mon, 01 Jan 2025 00:00:00 GMT"}
+			],
+			"response_time": 1.2
+		}`))
+	}))
+	defer server.Close()
+
+	s, err := newTavilySearch(&Config{
+		APIKey:         "tvly-test",
+		BaseURL:        server.URL,
+		SearchDepth:    SearchDepthAdvanced,
+		Days:           3,
+		MaxResults:     2,
+		IncludeAnswer:  true,
+		IncludeDomains: []string{"github.com"},
+		ExcludeDomains: []string{"example.com"},
+	})
+	assert.NoError(t, err)
+
+	resp, err := s.Search(context.Background(), &SearchRequest{Query: "eino release", Topic: TopicNews})
+	assert.NoError(t, err)
+	assert.Equal(t, &SearchResponse{
+		Answer: "Eino is released on GitHub.",
+		Results: []*SearchResult{{
+			Title:         "Eino",
+			Description:   "LLM application development framework",
+			Link:          "https://github.com/cloudwego/eino",
+			Score:         0.9,
+			PublishedDate: "Mon, 01 Jan 2025 00:00:00 GMT",
+		}},
+	}, resp)
+
+	_, err = s.Search(context.Background(), &SearchRequest{})
+	assert.Error(t, err)
+}
+
+func TestTavilySearch_SearchError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"detail": {"error": "Unauthorized: missing or invalid API key."}}`))
+	}))
+	defer server.Close()
+
+	s, err := newTavilySearch(&Config{APIKey: "tvly-test", BaseURL: server.URL})
+	assert.NoError(t, err)
+
+	_, err = s.Search(context.Background(), &SearchRequest{Query: "eino"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cloudwego/eino-ext/components/tool/duckduckgo"
+
+	"github.com/cloudwego/eino-ext/components/tool/websearch"
+)
+
+func main() {
+	ctx := context.Background()
+
+	ddgTool, err := duckduckgo.NewTool(ctx, &duckduckgo.Config{MaxResults: 5})
+	if err != nil {
+		log.Fatalf("NewTool of duckduckgo failed, err=%v", err)
+	}
+
+	// Create facade with providers tried in order
+	searchTool, err := websearch.NewTool(ctx, &websearch.Config{
+		Providers: []*websearch.Provider{
+			{
+				Name:        "duckduckgo",
+				Tool:        ddgTool, // any search tool, e.g. bingsearch, googlesearch, bravesearch, searxng
+				MaxRequests: 30,
+				Period:      time.Minute,
+			},
+			{
+				Name: "static",
+				Search: func(ctx context.Context, request *websearch.SearchRequest) ([]*websearch.SearchResult, error) {
+					return []*websearch.SearchResult{{
+						Title:       "Eino",
+						Link:        "https://github.com/cloudwego/eino",
+						Description: "The ultimate LLM application development framework in Golang.",
+					}}, nil
+				},
+			},
+		},
+		Cooldown:   5 * time.Minute,
+		MaxResults: 5,
+	})
+	if err != nil {
+		log.Fatalf("NewTool of websearch failed, err=%v", err)
+	}
+
+	jsonReq, err := json.Marshal(&websearch.SearchRequest{Query: "cloudwego eino"})
+	if err != nil {
+		log.Fatalf("Marshal of search request failed, err=%v", err)
+	}
+
+	resp, err := searchTool.InvokableRun(ctx, string(jsonReq))
+	if err != nil {
+		log.Fatalf("Search of websearch failed, err=%v", err)
+	}
+
+	var searchResp websearch.SearchResponse
+	if err := json.Unmarshal([]byte(resp), &searchResp); err != nil {
+		log.Fatalf("Unmarshal of search response failed, err=%v", err)
+	}
+
+	fmt.Printf("Search Results from %s:\n", searchResp.Provider)
+	for i, result := range searchResp.Results {
+		fmt.Printf("\n%d. Title: %s\n", i+1, result.Title)
+		fmt.Printf("   Link: %s\n", result.Link)
+		fmt.Printf("   Description: %s\n", result.Description)
+	}
+}
@@ -0,0 +1,350 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/components/tool/utils"
+)
+
+// ErrBudgetExhausted is returned when the rate budget of a provider is used up in the current period.
+var ErrBudgetExhausted = errors.New("provider rate budget exhausted")
+
+// SearchFunc searches the web with a provider.
+type SearchFunc func(ctx context.Context, request *SearchRequest) ([]*SearchResult, error)
+
+// Provider is a web search backend of the facade.
+type Provider struct {
+	// Name identifies the provider in responses and errors, e.g. "bing", "duckduckgo".
+	// Required.
+	Name string
+
+	// Tool is any search tool, e.g. bingsearch, googlesearch, duckduckgo, bravesearch or searxng tool.
+	// It's called with {"query": "...", "page": n}, and results are read from "results" or "items" of its output,
+	// with title, link (or url) and description (or snippet, content) fields.
+	// Required if Search is not provided.
+	Tool tool.InvokableTool
+
+	// Search customizes how the provider is called, Tool is ignored if provided.
+	// Optional.
+	Search SearchFunc
+
+	// MaxRequests limits requests sent to the provider in each Period, the provider is skipped when exceeded.
+	// Optional, default: 0 (unlimited)
+	MaxRequests int
+
+	// Period is the time window of MaxRequests.
+	// Optional, default: time.Minute
+	Period time.Duration
+}
+
+// Config represents the websearch facade tool configuration.
+type Config struct {
+	// Eino tool settings
+	ToolName string `json:"tool_name"` // optional, default is "web_search"
+	ToolDesc string `json:"tool_desc"` // optional, default is "search web for information"
+
+	// Providers are tried in order until one of them succeeds.
+	// Required.
+	Providers []*Provider `json:"-"`
+
+	// ShouldFallback decides whether to try the next provider on error.
+	// Optional, default: fallback on any error except context cancellation
+	ShouldFallback func(err error) bool `json:"-"`
+
+	// Cooldown skips a provider for a while after it returns a quota or rate limit error, see IsQuotaError.
+	// Optional, default: time.Minute
+	Cooldown time.Duration `json:"cooldown"`
+
+	// MaxResults limits the number of results returned.
+	// Optional, default: 0 (all results of the provider)
+	MaxResults int `json:"max_results"`
+}
+
+// NewTool creates a new websearch facade tool instance.
+func NewTool(ctx context.Context, config *Config) (tool.InvokableTool, error) {
+	ws, err := newWebSearch(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create websearch tool: %w", err)
+	}
+
+	searchTool, err := utils.InferTool(config.ToolName, config.ToolDesc, ws.Search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+
+	return searchTool, nil
+}
+
+// validate validates the websearch facade tool configuration.
+func (c *Config) validate() error {
+	if c.ToolName == "" {
+		c.ToolName = "web_search"
+	}
+
+	if c.ToolDesc == "" {
+		c.ToolDesc = "search web for information"
+	}
+
+	if len(c.Providers) == 0 {
+		return errors.New("websearch tool config is missing providers")
+	}
+
+	for i, p := range c.Providers {
+		if p == nil || p.Name == "" {
+			return fmt.Errorf("provider name is required, index=%d", i)
+		}
+		if p.Tool == nil && p.Search == nil {
+			return fmt.Errorf("provider tool or search is required, name=%s", p.Name)
+		}
+	}
+
+	if c.ShouldFallback == nil {
+		c.ShouldFallback = defaultShouldFallback
+	}
+
+	if c.Cooldown <= 0 {
+		c.Cooldown = time.Minute
+	}
+
+	return nil
+}
+
+func defaultShouldFallback(err error) bool {
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// IsQuotaError reports whether err is a quota or rate limit error, e.g. HTTP 429 of search APIs.
+func IsQuotaError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrBudgetExhausted) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"429", "rate limit", "too many requests", "quota"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// providerState tracks rate budget and cooldown of a provider.
+type providerState struct {
+	*Provider
+
+	mu            sync.Mutex
+	windowStart   time.Time
+	requests      int
+	cooldownUntil time.Time
+}
+
+// acquire takes one request from the budget of the provider.
+func (p *providerState) acquire(now time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now.Before(p.cooldownUntil) {
+		return fmt.Errorf("provider is cooling down until %s: %w", p.cooldownUntil.Format(time.RFC3339), ErrBudgetExhausted)
+	}
+
+	if p.MaxRequests <= 0 {
+		return nil
+	}
+
+	period := p.Period
+	if period <= 0 {
+		period = time.Minute
+	}
+	if now.Sub(p.windowStart) >= period {
+		p.windowStart = now
+		p.requests = 0
+	}
+	if p.requests >= p.MaxRequests {
+		return ErrBudgetExhausted
+	}
+	p.requests++
+
+	return nil
+}
+
+func (p *providerState) coolDown(until time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cooldownUntil = until
+}
+
+// webSearch represents the websearch facade tool.
+type webSearch struct {
+	config    *Config
+	providers []*providerState
+	now       func() time.Time
+}
+
+// newWebSearch creates a new websearch facade.
+func newWebSearch(config *Config) (*webSearch, error) {
+	if config == nil {
+		return nil, errors.New("websearch tool config is required")
+	}
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	providers := make([]*providerState, len(config.Providers))
+	for i, p := range config.Providers {
+		providers[i] = &providerState{Provider: p}
+	}
+
+	return &webSearch{
+		config:    config,
+		providers: providers,
+		now:       time.Now,
+	}, nil
+}
+
+type SearchRequest struct {
+	Query string `json:"query" jsonschema_description:"The query to search the web for"`
+	Page  int    `json:"page" jsonschema_description:"The page number to search for, default: 1"`
+}
+
+type SearchResult struct {
+	Title       string `json:"title" jsonschema_description:"The title of the search result"`
+	Link        string `json:"link" jsonschema_description:"The link of the search result"`
+	Description string `json:"description" jsonschema_description:"The description of the search result"`
+}
+
+type SearchResponse struct {
+	Provider string          `json:"provider" jsonschema_description:"The provider serving the search"`
+	Results  []*SearchResult `json:"results" jsonschema_description:"The results of the search"`
+}
+
+// Search searches the web with providers in order, and fails over to the next provider on error.
+func (w *webSearch) Search(ctx context.Context, request *SearchRequest) (*SearchResponse, error) {
+	if request.Query == "" {
+		return nil, errors.New("query is required")
+	}
+
+	var errMsgs []string
+	for _, p := range w.providers {
+		results, err := w.searchWithProvider(ctx, p, request)
+		if err == nil {
+			if w.config.MaxResults > 0 && len(results) > w.config.MaxResults {
+				results = results[:w.config.MaxResults]
+			}
+			return &SearchResponse{
+				Provider: p.Name,
+				Results:  results,
+			}, nil
+		}
+
+		errMsgs = append(errMsgs, fmt.Sprintf("%s: %v", p.Name, err))
+		if !errors.Is(err, ErrBudgetExhausted) && !w.config.ShouldFallback(err) {
+			return nil, fmt.Errorf("search with provider %s failed: %w", p.Name, err)
+		}
+	}
+
+	return nil, fmt.Errorf("all providers failed: [%s]", strings.Join(errMsgs, "; "))
+}
+
+func (w *webSearch) searchWithProvider(ctx context.Context, p *providerState, request *SearchRequest) ([]*SearchResult, error) {
+	if err := p.acquire(w.now()); err != nil {
+		return nil, err
+	}
+
+	var (
+		results []*SearchResult
+		err     error
+	)
+	if p.Search != nil {
+		results, err = p.Search(ctx, request)
+	} else {
+		results, err = searchWithTool(ctx, p.Tool, request)
+	}
+	if err != nil {
+		if IsQuotaError(err) {
+			p.coolDown(w.now().Add(w.config.Cooldown))
+		}
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// searchWithTool calls a search tool, and converts its output into results.
+func searchWithTool(ctx context.Context, t tool.InvokableTool, request *SearchRequest) ([]*SearchResult, error) {
+	args, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := t.InvokableRun(ctx, string(args))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseToolOutput(output)
+}
+
+// parseToolOutput reads results from common output shapes of search tools.
+func parseToolOutput(output string) ([]*SearchResult, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(output), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse search tool output: %w", err)
+	}
+
+	var items []map[string]any
+	for _, key := range []string{"results", "items"} {
+		if v, ok := raw[key]; ok {
+			if err := json.Unmarshal(v, &items); err != nil {
+				return nil, fmt.Errorf("failed to parse search tool output: %w", err)
+			}
+			break
+		}
+	}
+
+	results := make([]*SearchResult, 0, len(items))
+	for _, item := range items {
+		results = append(results, &SearchResult{
+			Title:       firstString(item, "title"),
+			Link:        firstString(item, "link", "url"),
+			Description: firstString(item, "description", "snippet", "content", "desc"),
+		})
+	}
+
+	return results, nil
+}
+
+func firstString(item map[string]any, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := item[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
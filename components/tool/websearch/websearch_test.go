@@ -0,0 +1,191 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package websearch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/tool"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTool struct {
+	output string
+	err    error
+	args   string
+}
+
+func (m *mockTool) Info(ctx context.Context) (*schema.ToolInfo, error) {
+	return &schema.ToolInfo{Name: "mock_search"}, nil
+}
+
+func (m *mockTool) InvokableRun(ctx context.Context, argumentsInJSON string, opts ...tool.Option) (string, error) {
+	m.args = argumentsInJSON
+	return m.output, m.err
+}
+
+func staticSearch(results []*SearchResult, err error, calls *int) SearchFunc {
+	return func(ctx context.Context, request *SearchRequest) ([]*SearchResult, error) {
+		*calls++
+		return results, err
+	}
+}
+
+func TestConfig_validate(t *testing.T) {
+	assert.Error(t, (&Config{}).validate())
+	assert.Error(t, (&Config{Providers: []*Provider{{Tool: &mockTool{}}}}).validate())
+	assert.Error(t, (&Config{Providers: []*Provider{{Name: "bing"}}}).validate())
+
+	conf := &Config{Providers: []*Provider{{Name: "bing", Tool: &mockTool{}}}}
+	assert.NoError(t, conf.validate())
+	assert.Equal(t, "web_search", conf.ToolName)
+	assert.Equal(t, time.Minute, conf.Cooldown)
+
+	_, err := NewTool(context.Background(), &Config{Providers: []*Provider{{Name: "bing", Tool: &mockTool{}}}})
+	assert.NoError(t, err)
+}
+
+func TestParseToolOutput(t *testing.T) {
+	// bingsearch
+	results, err := parseToolOutput(`{"results": [{"title": "a", "url": "https://a", "description": "desc a"}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*SearchResult{{Title: "a", Link: "https://a", Description: "desc a"}}, results)
+
+	// googlesearch
+	results, err = parseToolOutput(`{"query": "q", "items": [{"link": "https://b", "title": "b", "snippet": "snippet b"}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*SearchResult{{Title: "b", Link: "https://b", Description: "snippet b"}}, results)
+
+	results, err = parseToolOutput(`{}`)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+
+	_, err = parseToolOutput(`not json`)
+	assert.Error(t, err)
+}
+
+func TestWebSearch_Fallback(t *testing.T) {
+	ctx := context.Background()
+
+	var bingCalls, braveCalls int
+	ddg := &mockTool{output: `{"results": [{"title": "ddg", "link": "https://ddg", "description": "from ddg"}, {"title": "ddg2", "link": "https://ddg2"}]}`}
+	ws, err := newWebSearch(&Config{
+		Providers: []*Provider{
+			{Name: "bing", Search: staticSearch(nil, errors.New("unexpected status code: 429"), &bingCalls)},
+			{Name: "brave", Search: staticSearch(nil, errors.New("connection reset"), &braveCalls)},
+			{Name: "duckduckgo", Tool: ddg},
+		},
+		MaxResults: 1,
+		Cooldown:   time.Minute,
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	ws.now = func() time.Time { return now }
+
+	resp, err := ws.Search(ctx, &SearchRequest{Query: "eino", Page: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "duckduckgo", resp.Provider)
+	assert.Equal(t, []*SearchResult{{Title: "ddg", Link: "https://ddg", Description: "from ddg"}}, resp.Results)
+	assert.JSONEq(t, `{"query": "eino", "page": 2}`, ddg.args)
+	assert.Equal(t, 1, bingCalls)
+	assert.Equal(t, 1, braveCalls)
+
+	// bing is cooling down after quota error, brave is not
+	_, err = ws.Search(ctx, &SearchRequest{Query: "eino"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, bingCalls)
+	assert.Equal(t, 2, braveCalls)
+
+	// cooldown expired
+	now = now.Add(2 * time.Minute)
+	_, err = ws.Search(ctx, &SearchRequest{Query: "eino"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, bingCalls)
+}
+
+func TestWebSearch_Budget(t *testing.T) {
+	ctx := context.Background()
+
+	var bingCalls, ddgCalls int
+	ws, err := newWebSearch(&Config{
+		Providers: []*Provider{
+			{Name: "bing", Search: staticSearch([]*SearchResult{{Title: "bing"}}, nil, &bingCalls), MaxRequests: 2, Period: time.Hour},
+			{Name: "duckduckgo", Search: staticSearch([]*SearchResult{{Title: "ddg"}}, nil, &ddgCalls)},
+		},
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	ws.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		_, err = ws.Search(ctx, &SearchRequest{Query: "eino"})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, bingCalls)
+	assert.Equal(t, 1, ddgCalls)
+
+	// new period
+	now = now.Add(time.Hour)
+	resp, err := ws.Search(ctx, &SearchRequest{Query: "eino"})
+	assert.NoError(t, err)
+	assert.Equal(t, "bing", resp.Provider)
+}
+
+func TestWebSearch_Error(t *testing.T) {
+	ctx := context.Background()
+
+	var bingCalls, ddgCalls int
+	ws, err := newWebSearch(&Config{
+		Providers: []*Provider{
+			{Name: "bing", Search: staticSearch(nil, errors.New("invalid api key"), &bingCalls)},
+			{Name: "duckduckgo", Search: staticSearch(nil, errors.New("rate limit exceeded"), &ddgCalls)},
+		},
+		ShouldFallback: IsQuotaError,
+	})
+	assert.NoError(t, err)
+
+	// no fallback on non-quota error
+	_, err = ws.Search(ctx, &SearchRequest{Query: "eino"})
+	assert.Error(t, err)
+	assert.Equal(t, 0, ddgCalls)
+
+	_, err = ws.Search(ctx, &SearchRequest{})
+	assert.Error(t, err)
+
+	ws, err = newWebSearch(&Config{
+		Providers: []*Provider{
+			{Name: "duckduckgo", Search: staticSearch(nil, errors.New("rate limit exceeded"), &ddgCalls)},
+		},
+	})
+	assert.NoError(t, err)
+	_, err = ws.Search(ctx, &SearchRequest{Query: "eino"})
+	assert.ErrorContains(t, err, "all providers failed")
+}
+
+func TestIsQuotaError(t *testing.T) {
+	assert.False(t, IsQuotaError(nil))
+	assert.False(t, IsQuotaError(errors.New("connection reset")))
+	assert.True(t, IsQuotaError(errors.New("unexpected status code: 429")))
+	assert.True(t, IsQuotaError(errors.New("Daily Quota exceeded")))
+	assert.True(t, IsQuotaError(ErrBudgetExhausted))
+}
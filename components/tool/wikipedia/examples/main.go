@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -74,4 +75,34 @@ func main() {
 	fmt.Println("")
 	fmt.Println("==============")
 
+	// Create get page tool, falling back to the english wikipedia
+	getPageTool, err := wikipedia.NewGetPageTool(ctx, &wikipedia.Config{
+		UserAgent:         "eino",
+		Language:          "zh",
+		FallbackLanguages: []string{"en"},
+	})
+	if err != nil {
+		log.Fatal("Failed to create get page tool:", err)
+	}
+
+	m, err = sonic.MarshalString(wikipedia.GetPageRequest{Title: "ByteDance", SplitSections: true})
+	if err != nil {
+		log.Fatal("Failed to marshal get page request:", err)
+	}
+
+	resp, err = getPageTool.InvokableRun(ctx, m)
+	if err != nil {
+		log.Fatal("Get page failed:", err)
+	}
+
+	var pageResponse wikipedia.GetPageResponse
+	if err = sonic.Unmarshal([]byte(resp), &pageResponse); err != nil {
+		log.Fatal("Failed to unmarshal get page response:", err)
+	}
+
+	fmt.Printf("Page: %s (%s)\n", pageResponse.Title, pageResponse.Language)
+	for _, section := range pageResponse.Sections {
+		fmt.Printf("%s %s: %d chars\n", strings.Repeat("#", section.Level+1), section.Title, len(section.Content))
+	}
+	fmt.Printf("Categories: %v\n", pageResponse.Categories)
 }
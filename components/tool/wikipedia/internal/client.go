@@ -153,6 +153,104 @@ func (c *WikipediaClient) GetPage(ctx context.Context, title string) (*Page, err
 	return nil, ErrPageNotFound
 }
 
+// GetPageDetail retrieves the full Wikipedia page by title, along with its categories and links.
+// Section headings are kept in the content in wiki format, e.g. "== History ==", see ParseSections.
+func (c *WikipediaClient) GetPageDetail(ctx context.Context, title string, maxLinks int) (*PageDetail, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, ErrInvalidParameters
+	}
+	if maxLinks <= 0 || maxLinks > 500 {
+		maxLinks = 500
+	}
+
+	params := url.Values{
+		"action":          []string{"query"},
+		"prop":            []string{"extracts|revisions|categories|links"},
+		"titles":          []string{title},
+		"redirects":       []string{"1"},
+		"exlimit":         []string{"1"},
+		"explaintext":     []string{"1"},
+		"exsectionformat": []string{"wiki"},
+		"rvprop":          []string{"timestamp"},
+		"cllimit":         []string{"max"},
+		"clshow":          []string{"!hidden"},
+		"pllimit":         []string{fmt.Sprintf("%d", maxLinks)},
+		"plnamespace":     []string{"0"},
+		"format":          []string{"json"},
+	}
+
+	var response struct {
+		Query struct {
+			Pages map[string]struct {
+				PageID    int    `json:"pageid"`
+				Title     string `json:"title"`
+				Extract   string `json:"extract"`
+				Revisions []struct {
+					Timestamp time.Time `json:"timestamp"`
+				} `json:"revisions"`
+				Categories []struct {
+					Title string `json:"title"`
+				} `json:"categories"`
+				Links []struct {
+					Title string `json:"title"`
+				} `json:"links"`
+			} `json:"pages"`
+		} `json:"query"`
+		Error *APIError `json:"error"`
+	}
+
+	if err := c.makeRequest(ctx, params, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil {
+		return nil, response.Error
+	}
+
+	for _, page := range response.Query.Pages {
+		if page.PageID == 0 {
+			return nil, ErrPageNotFound
+		}
+
+		var lastUpdated time.Time
+		if len(page.Revisions) > 0 {
+			lastUpdated = page.Revisions[0].Timestamp
+		}
+
+		detail := &PageDetail{
+			Page: Page{
+				Title:       page.Title,
+				PageID:      page.PageID,
+				Content:     page.Extract,
+				URL:         c.buildPageURL(page.Title),
+				LastUpdated: lastUpdated,
+			},
+			Categories: make([]string, 0, len(page.Categories)),
+			Links:      make([]string, 0, len(page.Links)),
+		}
+		for _, category := range page.Categories {
+			// category titles are prefixed by the localized namespace, e.g. "Category:" or "分类:"
+			name := category.Title
+			if idx := strings.Index(name, ":"); idx >= 0 {
+				name = name[idx+1:]
+			}
+			detail.Categories = append(detail.Categories, name)
+		}
+		for _, link := range page.Links {
+			detail.Links = append(detail.Links, link.Title)
+		}
+
+		return detail, nil
+	}
+
+	return nil, ErrPageNotFound
+}
+
+// Language returns the language of the client.
+func (c *WikipediaClient) Language() string {
+	return c.language
+}
+
 // buildPageURL builds the URL for the Wikipedia page.
 func (c *WikipediaClient) buildPageURL(title string) string {
 	return fmt.Sprintf("https://%s.wikipedia.org/wiki/%s",
@@ -200,3 +298,41 @@ func cleanBasicHTML(snippet string) string {
 		"&nbsp;", " ",
 	).Replace(snippet)
 }
+
+// ParseSections splits the plain text content of a page into sections by the wiki format headings,
+// e.g. "== History ==" starts a section of level 1. The lead section has an empty title and level 0,
+// and is omitted if empty.
+func ParseSections(content string) []Section {
+	var (
+		sections []Section
+		current  = Section{}
+		body     strings.Builder
+	)
+	flush := func() {
+		current.Content = strings.TrimSpace(body.String())
+		if current.Title != "" || current.Content != "" {
+			sections = append(sections, current)
+		}
+		body.Reset()
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		level := 0
+		for level < len(trimmed)/2 && trimmed[level] == '=' && trimmed[len(trimmed)-1-level] == '=' {
+			level++
+		}
+		if level >= 2 {
+			if title := strings.TrimSpace(trimmed[level : len(trimmed)-level]); title != "" {
+				flush()
+				current = Section{Title: title, Level: level - 1}
+				continue
+			}
+		}
+		body.WriteString(line)
+		body.WriteString("\n")
+	}
+	flush()
+
+	return sections
+}
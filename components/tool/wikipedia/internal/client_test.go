@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -81,3 +82,47 @@ func TestSearchAndGetPage(t *testing.T) {
 	assert.Nil(t, pr)
 
 }
+
+func TestParseSections(t *testing.T) {
+	content := "Lead paragraph.\n\n== History ==\nEarly days.\n\n=== Founding ===\nFounded in 2012.\n\n== See also ==\n\n"
+	assert.Equal(t, []Section{
+		{Title: "", Level: 0, Content: "Lead paragraph."},
+		{Title: "History", Level: 1, Content: "Early days."},
+		{Title: "Founding", Level: 2, Content: "Founded in 2012."},
+		{Title: "See also", Level: 1, Content: ""},
+	}, ParseSections(content))
+
+	assert.Equal(t, []Section{{Title: "History", Level: 1, Content: "a == b"}}, ParseSections("== History ==\na == b"))
+	assert.Nil(t, ParseSections(""))
+}
+
+func TestGetPageDetail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "extracts|revisions|categories|links", r.URL.Query().Get("prop"))
+		if r.URL.Query().Get("titles") == "missing" {
+			_, _ = w.Write([]byte(`{"query": {"pages": {"-1": {"title": "missing", "missing": ""}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"query": {"pages": {"42": {"pageid": 42, "title": "Go", "extract": "Go is a language.",
+			"revisions": [{"timestamp": "2025-01-02T03:04:05Z"}],
+			"categories": [{"title": "Category:Programming languages"}], "links": [{"title": "Google"}]}}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithLanguage("en"), WithHTTPClient(server.Client()))
+
+	detail, err := c.GetPageDetail(context.Background(), "Go", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "Go", detail.Title)
+	assert.Equal(t, 42, detail.PageID)
+	assert.Equal(t, "Go is a language.", detail.Content)
+	assert.Equal(t, "https://en.wikipedia.org/wiki/Go", detail.URL)
+	assert.Equal(t, []string{"Programming languages"}, detail.Categories)
+	assert.Equal(t, []string{"Google"}, detail.Links)
+
+	_, err = c.GetPageDetail(context.Background(), "missing", 10)
+	assert.ErrorIs(t, err, ErrPageNotFound)
+
+	_, err = c.GetPageDetail(context.Background(), "", 10)
+	assert.ErrorIs(t, err, ErrInvalidParameters)
+}
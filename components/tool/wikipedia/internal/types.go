@@ -36,3 +36,19 @@ type Page struct {
 	URL         string    `json:"url"`
 	LastUpdated time.Time `json:"last_updated"`
 }
+
+// PageDetail represents a full Wikipedia page with its metadata.
+type PageDetail struct {
+	Page
+	// Categories are the visible categories of the page, without the namespace prefix.
+	Categories []string `json:"categories"`
+	// Links are the titles of the articles linked from the page.
+	Links []string `json:"links"`
+}
+
+// Section represents a section of a Wikipedia page.
+type Section struct {
+	Title   string `json:"title"`
+	Level   int    `json:"level"`
+	Content string `json:"content"`
+}
@@ -18,9 +18,12 @@ package wikipedia
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/cloudwego/eino-ext/components/tool/wikipedia/internal"
 
@@ -58,9 +61,23 @@ type Config struct {
 	// Language is the language to use for the wikipedia search.
 	// Optional. Default: "en".
 	Language string `json:"language"`
+	// FallbackLanguages are the languages tried in order when nothing is found in Language, e.g. ["en"] for Language "zh".
+	// The api url of a fallback language is derived by replacing the language subdomain of BaseURL.
+	// Optional. Default: no fallback.
+	FallbackLanguages []string `json:"fallback_languages"`
+	// PageMaxChars is the maximum number of characters of the content returned by the get page tool.
+	// If the content is longer than this, it will be truncated.
+	// Optional. Default: 20000.
+	PageMaxChars int `json:"page_max_chars"`
+	// MaxLinks is the maximum number of links returned by the get page tool.
+	// Optional. Default: 100.
+	MaxLinks int `json:"max_links"`
 
 	ToolName string `json:"tool_name"` // Optional. Default: "wikipedia_search".
 	ToolDesc string `json:"tool_desc"` // Optional. Default: "this tool provides quick and efficient access to information from the Wikipedia"
+
+	GetPageToolName string `json:"get_page_tool_name"` // Optional. Default: "wikipedia_get_page".
+	GetPageToolDesc string `json:"get_page_tool_desc"` // Optional. Default: "this tool fetches the full content of a Wikipedia article, with its categories and links"
 }
 
 // NewTool creates a new wikipedia search tool.
@@ -80,6 +97,23 @@ func NewTool(ctx context.Context, conf *Config) (tool.InvokableTool, error) {
 	return t, nil
 }
 
+// NewGetPageTool creates a new wikipedia get page tool, which fetches the full article content.
+func NewGetPageTool(ctx context.Context, conf *Config) (tool.InvokableTool, error) {
+	err := conf.validate()
+	if err != nil {
+		return nil, err
+	}
+	w, err := newWikipedia(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wikipedia get page tool: %w", err)
+	}
+	t, err := utils.InferTool(conf.GetPageToolName, conf.GetPageToolDesc, w.GetPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to infer tool: %w", err)
+	}
+	return t, nil
+}
+
 // validate validates the configuration and sets default values if not provided.
 func (conf *Config) validate() error {
 	if conf == nil {
@@ -112,66 +146,155 @@ func (conf *Config) validate() error {
 	if conf.BaseURL == "" {
 		conf.BaseURL = fmt.Sprintf("https://%s.wikipedia.org/w/api.php", conf.Language)
 	}
+	if conf.PageMaxChars <= 0 {
+		conf.PageMaxChars = 20000
+	}
+	if conf.MaxLinks <= 0 {
+		conf.MaxLinks = 100
+	}
+	if conf.GetPageToolName == "" {
+		conf.GetPageToolName = "wikipedia_get_page"
+	}
+	if conf.GetPageToolDesc == "" {
+		conf.GetPageToolDesc = "this tool fetches the full content of a Wikipedia article, with its categories and links"
+	}
 	return nil
 }
 
-// newWikipedia creates a new wikipedia search tool.
+// newWikipedia creates a new wikipedia tool.
 func newWikipedia(_ context.Context, conf *Config) (*wikipedia, error) {
-	c := internal.NewClient(
-		internal.WithBaseURL(conf.BaseURL),
-		internal.WithUserAgent(conf.UserAgent),
-		internal.WithTopK(conf.TopK),
-		internal.WithLanguage(conf.Language),
-		internal.WithHTTPClient(
-			&http.Client{
-				Timeout: conf.Timeout,
-				CheckRedirect: func(req *http.Request, via []*http.Request) error {
-					if len(via) >= conf.MaxRedirect {
-						return internal.ErrTooManyRedirects
-					}
-					return nil
-				}}),
-	)
+	httpClient := &http.Client{
+		Timeout: conf.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= conf.MaxRedirect {
+				return internal.ErrTooManyRedirects
+			}
+			return nil
+		}}
+
+	languages := append([]string{conf.Language}, conf.FallbackLanguages...)
+	clients := make([]*internal.WikipediaClient, 0, len(languages))
+	seen := make(map[string]bool, len(languages))
+	for _, lang := range languages {
+		if seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		clients = append(clients, internal.NewClient(
+			internal.WithBaseURL(languageBaseURL(conf.BaseURL, conf.Language, lang)),
+			internal.WithUserAgent(conf.UserAgent),
+			internal.WithTopK(conf.TopK),
+			internal.WithLanguage(lang),
+			internal.WithHTTPClient(httpClient),
+		))
+	}
 	return &wikipedia{
-		conf:   conf,
-		client: c,
+		conf:    conf,
+		clients: clients,
 	}, nil
 }
 
+// languageBaseURL derives the api url of the language from the base url of the default language,
+// e.g. https://zh.wikipedia.org/w/api.php to https://en.wikipedia.org/w/api.php.
+func languageBaseURL(baseURL, from, to string) string {
+	return strings.Replace(baseURL, "//"+from+".", "//"+to+".", 1)
+}
+
 // Search searches the web for the query and returns the search results.
+// The fallback languages are tried in order if nothing is found.
 func (w *wikipedia) Search(ctx context.Context, query SearchRequest) (*SearchResponse, error) {
-	sr, err := w.client.Search(ctx, query.Query)
-	if err != nil {
-		return nil, err
-	}
-	if len(sr) == 0 {
-		return nil, internal.ErrPageNotFound
+	for _, client := range w.clients {
+		sr, err := client.Search(ctx, query.Query)
+		if err != nil {
+			return nil, err
+		}
+		if len(sr) == 0 {
+			continue
+		}
+		res := make([]*Result, 0, len(sr))
+		for _, search := range sr {
+			pr, err := client.GetPage(ctx, search.Title)
+			if err != nil {
+				return nil, err
+			}
+			extract := ""
+			if len(pr.Content) > w.conf.DocMaxChars {
+				extract = pr.Content[:w.conf.DocMaxChars]
+			} else {
+				extract = pr.Content
+			}
+			res = append(res, &Result{
+				Title:    pr.Title,
+				URL:      pr.URL,
+				Extract:  extract,
+				Snippet:  search.Snippet,
+				Language: client.Language(),
+			})
+		}
+		return &SearchResponse{Results: res}, nil
 	}
-	res := make([]*Result, 0, len(sr))
-	for _, search := range sr {
-		pr, err := w.client.GetPage(ctx, search.Title)
+	return nil, internal.ErrPageNotFound
+}
+
+// GetPage fetches the full content of the page, optionally split into sections.
+// The fallback languages are tried in order if the page is not found.
+func (w *wikipedia) GetPage(ctx context.Context, req GetPageRequest) (*GetPageResponse, error) {
+	for _, client := range w.clients {
+		detail, err := client.GetPageDetail(ctx, req.Title, w.conf.MaxLinks)
+		if errors.Is(err, internal.ErrPageNotFound) {
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
-		extract := ""
-		if len(pr.Content) > w.conf.DocMaxChars {
-			extract = pr.Content[:w.conf.DocMaxChars]
-		} else {
-			extract = pr.Content
+
+		resp := &GetPageResponse{
+			Title:       detail.Title,
+			URL:         detail.URL,
+			Language:    client.Language(),
+			Categories:  detail.Categories,
+			Links:       detail.Links,
+			LastUpdated: detail.LastUpdated,
+		}
+
+		if !req.SplitSections && req.Section == "" {
+			resp.Content, resp.Truncated = truncateChars(detail.Content, w.conf.PageMaxChars)
+			return resp, nil
 		}
-		res = append(res, &Result{
-			Title:   pr.Title,
-			URL:     pr.URL,
-			Extract: extract,
-			Snippet: search.Snippet,
-		})
+
+		remaining := w.conf.PageMaxChars
+		for _, section := range internal.ParseSections(detail.Content) {
+			if req.Section != "" && !strings.EqualFold(section.Title, req.Section) {
+				continue
+			}
+			if remaining <= 0 {
+				resp.Truncated = true
+				break
+			}
+			content, truncated := truncateChars(section.Content, remaining)
+			remaining -= utf8.RuneCountInString(content)
+			resp.Truncated = resp.Truncated || truncated
+			resp.Sections = append(resp.Sections, &Section{Title: section.Title, Level: section.Level, Content: content})
+		}
+		if req.Section != "" && len(resp.Sections) == 0 {
+			return nil, fmt.Errorf("section not found: %s", req.Section)
+		}
+		return resp, nil
+	}
+	return nil, internal.ErrPageNotFound
+}
+
+// truncateChars truncates s to at most n characters.
+func truncateChars(s string, n int) (string, bool) {
+	if utf8.RuneCountInString(s) <= n {
+		return s, false
 	}
-	return &SearchResponse{Results: res}, nil
+	return string([]rune(s)[:n]), true
 }
 
 type wikipedia struct {
-	conf   *Config
-	client *internal.WikipediaClient
+	conf    *Config
+	clients []*internal.WikipediaClient
 }
 
 // Result is the page search result.
@@ -180,6 +303,8 @@ type Result struct {
 	URL     string `json:"url" jsonschema_description:"The url of the search result"`
 	Extract string `json:"extract" jsonschema_description:"The extract of the search result"`
 	Snippet string `json:"snippet" jsonschema_description:"The snippet of the search result"`
+	// Language is the language of the wikipedia the result comes from, which differs from the configured language on fallback.
+	Language string `json:"language,omitempty" jsonschema_description:"The language of the search result"`
 }
 
 // SearchRequest is the search request.
@@ -191,3 +316,30 @@ type SearchRequest struct {
 type SearchResponse struct {
 	Results []*Result `json:"results" jsonschema_description:"The results of the search"`
 }
+
+// GetPageRequest is the get page request.
+type GetPageRequest struct {
+	Title         string `json:"title" jsonschema_description:"The title of the article, e.g. a title from the search results"`
+	SplitSections bool   `json:"split_sections,omitempty" jsonschema_description:"Whether to return the content split into sections"`
+	Section       string `json:"section,omitempty" jsonschema_description:"The title of the only section to return, e.g. History"`
+}
+
+// Section is a section of the page.
+type Section struct {
+	Title   string `json:"title" jsonschema_description:"The title of the section, empty for the lead section"`
+	Level   int    `json:"level" jsonschema_description:"The level of the section, 0 for the lead section, 1 for top level sections"`
+	Content string `json:"content" jsonschema_description:"The content of the section"`
+}
+
+// GetPageResponse is the get page response.
+type GetPageResponse struct {
+	Title       string     `json:"title" jsonschema_description:"The title of the article"`
+	URL         string     `json:"url" jsonschema_description:"The url of the article"`
+	Language    string     `json:"language" jsonschema_description:"The language of the article"`
+	Content     string     `json:"content,omitempty" jsonschema_description:"The full content of the article"`
+	Sections    []*Section `json:"sections,omitempty" jsonschema_description:"The sections of the article, returned if split_sections or section is set"`
+	Truncated   bool       `json:"truncated,omitempty" jsonschema_description:"Whether the content is truncated due to the size limit"`
+	Categories  []string   `json:"categories" jsonschema_description:"The categories of the article"`
+	Links       []string   `json:"links" jsonschema_description:"The titles of the articles linked from the article"`
+	LastUpdated time.Time  `json:"last_updated" jsonschema_description:"The last update time of the article"`
+}
@@ -19,6 +19,8 @@ package wikipedia
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/bytedance/sonic"
@@ -58,3 +60,83 @@ func TestWikipedia_Search(t *testing.T) {
 		})
 	}
 }
+
+func newTestWikipedia(t *testing.T, conf *Config, handlers map[string]http.HandlerFunc) *wikipedia {
+	assert.NoError(t, conf.validate())
+	languages := append([]string{conf.Language}, conf.FallbackLanguages...)
+	w := &wikipedia{conf: conf}
+	for _, lang := range languages {
+		server := httptest.NewServer(handlers[lang])
+		t.Cleanup(server.Close)
+		w.clients = append(w.clients, internal.NewClient(
+			internal.WithBaseURL(server.URL),
+			internal.WithLanguage(lang),
+			internal.WithTopK(conf.TopK),
+			internal.WithHTTPClient(server.Client()),
+		))
+	}
+	return w
+}
+
+func TestWikipedia_GetPage(t *testing.T) {
+	ctx := context.Background()
+	content := "Lead.\n\n== History ==\nFounded in 2012.\n\n== Products ==\nTikTok."
+	w := newTestWikipedia(t, &Config{Language: "zh", FallbackLanguages: []string{"en"}, PageMaxChars: 12}, map[string]http.HandlerFunc{
+		"zh": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"query": {"pages": {"-1": {"title": "ByteDance", "missing": ""}}}}`))
+		},
+		"en": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"query": {"pages": {"1": {"pageid": 1, "title": "ByteDance", "extract": %q,
+				"categories": [{"title": "Category:Companies"}], "links": [{"title": "TikTok"}]}}}}`, content)))
+		},
+	})
+
+	resp, err := w.GetPage(ctx, GetPageRequest{Title: "ByteDance"})
+	assert.NoError(t, err)
+	assert.Equal(t, "en", resp.Language)
+	assert.Equal(t, "Lead.\n\n== Hi", resp.Content)
+	assert.True(t, resp.Truncated)
+	assert.Equal(t, []string{"Companies"}, resp.Categories)
+	assert.Equal(t, []string{"TikTok"}, resp.Links)
+
+	resp, err = w.GetPage(ctx, GetPageRequest{Title: "ByteDance", Section: "history"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Section{{Title: "History", Level: 1, Content: "Founded in 2"}}, resp.Sections)
+	assert.True(t, resp.Truncated)
+
+	resp, err = w.GetPage(ctx, GetPageRequest{Title: "ByteDance", SplitSections: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Section{
+		{Title: "", Level: 0, Content: "Lead."},
+		{Title: "History", Level: 1, Content: "Founded"},
+	}, resp.Sections)
+	assert.True(t, resp.Truncated)
+
+	_, err = w.GetPage(ctx, GetPageRequest{Title: "ByteDance", Section: "Awards"})
+	assert.Error(t, err)
+}
+
+func TestWikipedia_SearchFallback(t *testing.T) {
+	ctx := context.Background()
+	w := newTestWikipedia(t, &Config{Language: "zh", FallbackLanguages: []string{"en"}}, map[string]http.HandlerFunc{
+		"zh": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"query": {"search": []}}`))
+		},
+		"en": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("list") == "search" {
+				_, _ = w.Write([]byte(`{"query": {"search": [{"title": "Go", "pageid": 1, "snippet": "Go language"}]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"query": {"pages": {"1": {"pageid": 1, "title": "Go", "extract": "Go is a language."}}}}`))
+		},
+	})
+
+	resp, err := w.Search(ctx, SearchRequest{Query: "golang"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Result{{Title: "Go", URL: "https://en.wikipedia.org/wiki/Go", Extract: "Go is a language.", Snippet: "Go language", Language: "en"}}, resp.Results)
+}
+
+func TestLanguageBaseURL(t *testing.T) {
+	assert.Equal(t, "https://en.wikipedia.org/w/api.php", languageBaseURL("https://zh.wikipedia.org/w/api.php", "zh", "en"))
+	assert.Equal(t, "https://mirror.example.com/w/api.php", languageBaseURL("https://mirror.example.com/w/api.php", "zh", "en"))
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino-ext/components/tts"
+	"github.com/cloudwego/eino-ext/components/tts/openai"
+)
+
+func main() {
+	ctx := context.Background()
+
+	synthesizer, err := openai.NewSynthesizer(ctx, &openai.Config{
+		APIKey: os.Getenv("OPENAI_API_KEY"),
+		Model:  "tts-1",
+	})
+	if err != nil {
+		log.Fatalf("NewSynthesizer failed, err=%v", err)
+	}
+
+	audio, err := synthesizer.Synthesize(ctx, "Hello from eino!", tts.WithVoice("nova"))
+	if err != nil {
+		log.Fatalf("Synthesize failed, err=%v", err)
+	}
+
+	if err = os.WriteFile("hello.mp3", audio.Data, 0o644); err != nil {
+		log.Fatalf("WriteFile failed, err=%v", err)
+	}
+	log.Printf("saved %d bytes to hello.mp3", len(audio.Data))
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/components/tts"
+	"github.com/cloudwego/eino-ext/components/tts/volc"
+)
+
+func main() {
+	ctx := context.Background()
+
+	synthesizer, err := volc.NewSynthesizer(ctx, &volc.Config{
+		AppID:       os.Getenv("VOLC_TTS_APP_ID"),
+		AccessToken: os.Getenv("VOLC_TTS_ACCESS_TOKEN"),
+	})
+	if err != nil {
+		log.Fatalf("NewSynthesizer failed, err=%v", err)
+	}
+
+	// the text may come from the stream of a chat model
+	text := schema.StreamReaderFromArray([]string{"你好，", "我是 eino。", "很高兴", "认识你！"})
+	audioStream := tts.SynthesizeStream(ctx, synthesizer, text)
+	defer audioStream.Close()
+
+	for i := 0; ; i++ {
+		audio, err := audioStream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Recv failed, err=%v", err)
+		}
+
+		name := fmt.Sprintf("sentence_%d.mp3", i)
+		if err = os.WriteFile(name, audio.Data, 0o644); err != nil {
+			log.Fatalf("WriteFile failed, err=%v", err)
+		}
+		log.Printf("saved '%s' to %s", audio.Text, name)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+
+	"github.com/cloudwego/eino-ext/components/tts"
+)
+
+const (
+	defaultBaseURL = "https://api.openai.com/v1"
+	defaultModel   = "tts-1"
+	defaultVoice   = "alloy"
+	defaultFormat  = "mp3"
+
+	// maxInputChars is the max length of the input of the speech API.
+	maxInputChars = 4096
+)
+
+type Config struct {
+	// APIKey is the OpenAI API key.
+	// Required.
+	APIKey string
+	// BaseURL is the base url of the OpenAI compatible API.
+	// Optional. Default: "https://api.openai.com/v1".
+	BaseURL string
+	// Model is the speech model, e.g. "tts-1", "tts-1-hd", "gpt-4o-mini-tts".
+	// Optional. Default: "tts-1".
+	Model string
+	// Voice is the default voice, e.g. "alloy", "echo", "fable", "onyx", "nova", "shimmer".
+	// Optional. Default: "alloy".
+	Voice string
+	// Speed is the default speed ratio from 0.25 to 4.0.
+	// Optional. Default: 1.0.
+	Speed float64
+	// Format is the default encoding, one of "mp3", "opus", "aac", "flac", "wav", "pcm".
+	// Optional. Default: "mp3".
+	Format string
+	// Instructions control the tone of the speech, only supported by gpt-4o-mini-tts.
+	// Optional.
+	Instructions string
+
+	// Timeout specifies the duration to wait before timing out a request.
+	// Optional. Default: 0 (no timeout).
+	Timeout time.Duration
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: &http.Client{Timeout: Timeout}.
+	HTTPClient *http.Client
+}
+
+type options struct {
+	Instructions *string
+}
+
+// WithInstructions sets the instructions of the speech, only supported by gpt-4o-mini-tts.
+func WithInstructions(instructions string) tts.Option {
+	return tts.WrapImplSpecificOptFn(func(o *options) {
+		o.Instructions = &instructions
+	})
+}
+
+var _ tts.Synthesizer = (*Synthesizer)(nil)
+
+// Synthesizer synthesizes speech with the OpenAI audio/speech API.
+type Synthesizer struct {
+	conf *Config
+	cli  *http.Client
+}
+
+func NewSynthesizer(_ context.Context, config *Config) (*Synthesizer, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+	if config.APIKey == "" {
+		return nil, errors.New("api key is required")
+	}
+
+	conf := *config
+	if conf.BaseURL == "" {
+		conf.BaseURL = defaultBaseURL
+	}
+	conf.BaseURL = strings.TrimSuffix(conf.BaseURL, "/")
+	if conf.Model == "" {
+		conf.Model = defaultModel
+	}
+	if conf.Voice == "" {
+		conf.Voice = defaultVoice
+	}
+	if conf.Speed == 0 {
+		conf.Speed = 1.0
+	}
+	if conf.Format == "" {
+		conf.Format = defaultFormat
+	}
+
+	cli := conf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: conf.Timeout}
+	}
+
+	return &Synthesizer{conf: &conf, cli: cli}, nil
+}
+
+type speechRequest struct {
+	Model          string  `json:"model"`
+	Input          string  `json:"input"`
+	Voice          string  `json:"voice"`
+	ResponseFormat string  `json:"response_format"`
+	Speed          float64 `json:"speed"`
+	Instructions   string  `json:"instructions,omitempty"`
+}
+
+func (s *Synthesizer) Synthesize(ctx context.Context, text string, opts ...tts.Option) (audio *tts.Audio, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, s.GetType(), tts.ComponentOfTTS)
+
+	o := tts.GetCommonOptions(&tts.Options{
+		Voice:  &s.conf.Voice,
+		Speed:  &s.conf.Speed,
+		Format: &s.conf.Format,
+	}, opts...)
+	specOpts := tts.GetImplSpecificOptions(&options{Instructions: &s.conf.Instructions}, opts...)
+
+	ctx = callbacks.OnStart(ctx, &tts.CallbackInput{
+		Text:   text,
+		Voice:  *o.Voice,
+		Speed:  *o.Speed,
+		Format: *o.Format,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if text == "" {
+		return nil, errors.New("text is required")
+	}
+	if n := len([]rune(text)); n > maxInputChars {
+		return nil, fmt.Errorf("text is too long, %d chars exceeds the limit %d", n, maxInputChars)
+	}
+
+	body, err := json.Marshal(&speechRequest{
+		Model:          s.conf.Model,
+		Input:          text,
+		Voice:          *o.Voice,
+		ResponseFormat: *o.Format,
+		Speed:          *o.Speed,
+		Instructions:   *specOpts.Instructions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.conf.BaseURL+"/audio/speech", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.conf.APIKey)
+
+	resp, err := s.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, data)
+	}
+
+	audio = &tts.Audio{
+		Data:   data,
+		Format: *o.Format,
+		Text:   text,
+	}
+	callbacks.OnEnd(ctx, &tts.CallbackOutput{Audio: audio})
+
+	return audio, nil
+}
+
+const typ = "OpenAI"
+
+func (s *Synthesizer) GetType() string {
+	return typ
+}
+
+func (s *Synthesizer) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/components/tts"
+)
+
+func TestSynthesize(t *testing.T) {
+	var got speechRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/audio/speech", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		if got.Input == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"message":"bad input"}}`))
+			return
+		}
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	s, err := NewSynthesizer(ctx, &Config{APIKey: "test-key", BaseURL: ts.URL + "/"})
+	assert.NoError(t, err)
+
+	audio, err := s.Synthesize(ctx, "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("audio"), audio.Data)
+	assert.Equal(t, "mp3", audio.Format)
+	assert.Equal(t, speechRequest{Model: "tts-1", Input: "hello", Voice: "alloy", ResponseFormat: "mp3", Speed: 1.0}, got)
+
+	audio, err = s.Synthesize(ctx, "hello", tts.WithVoice("nova"), tts.WithSpeed(1.5), tts.WithFormat("wav"), WithInstructions("cheerful"))
+	assert.NoError(t, err)
+	assert.Equal(t, "wav", audio.Format)
+	assert.Equal(t, speechRequest{Model: "tts-1", Input: "hello", Voice: "nova", ResponseFormat: "wav", Speed: 1.5, Instructions: "cheerful"}, got)
+
+	_, err = s.Synthesize(ctx, "bad")
+	assert.EqualError(t, err, `request failed with status code 400: {"error":{"message":"bad input"}}`)
+
+	_, err = s.Synthesize(ctx, "")
+	assert.EqualError(t, err, "text is required")
+}
+
+func TestNewSynthesizer(t *testing.T) {
+	_, err := NewSynthesizer(context.Background(), nil)
+	assert.EqualError(t, err, "config is required")
+	_, err = NewSynthesizer(context.Background(), &Config{})
+	assert.EqualError(t, err, "api key is required")
+}
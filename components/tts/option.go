@@ -0,0 +1,98 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tts
+
+// Options are the common options of synthesizers.
+type Options struct {
+	// Voice is the voice of the speech, e.g. "alloy" of OpenAI or "BV001_streaming" of Volcengine.
+	Voice *string
+	// Speed is the speed ratio of the speech, 1.0 is the normal speed.
+	Speed *float64
+	// Format is the encoding of the audio, e.g. "mp3", "wav", "pcm".
+	Format *string
+}
+
+// Option is the call option of synthesizers.
+type Option struct {
+	apply func(opts *Options)
+
+	implSpecificOptFn any
+}
+
+// WithVoice sets the voice of the speech.
+func WithVoice(voice string) Option {
+	return Option{
+		apply: func(opts *Options) {
+			opts.Voice = &voice
+		},
+	}
+}
+
+// WithSpeed sets the speed ratio of the speech.
+func WithSpeed(speed float64) Option {
+	return Option{
+		apply: func(opts *Options) {
+			opts.Speed = &speed
+		},
+	}
+}
+
+// WithFormat sets the encoding of the audio.
+func WithFormat(format string) Option {
+	return Option{
+		apply: func(opts *Options) {
+			opts.Format = &format
+		},
+	}
+}
+
+// GetCommonOptions extracts the common options from opts, with the default values in base.
+func GetCommonOptions(base *Options, opts ...Option) *Options {
+	if base == nil {
+		base = &Options{}
+	}
+
+	for i := range opts {
+		if opts[i].apply != nil {
+			opts[i].apply(base)
+		}
+	}
+
+	return base
+}
+
+// WrapImplSpecificOptFn wraps an implementation specific option function into an Option.
+func WrapImplSpecificOptFn[T any](optFn func(*T)) Option {
+	return Option{
+		implSpecificOptFn: optFn,
+	}
+}
+
+// GetImplSpecificOptions extracts the implementation specific options from opts, with the default values in base.
+func GetImplSpecificOptions[T any](base *T, opts ...Option) *T {
+	if base == nil {
+		base = new(T)
+	}
+
+	for i := range opts {
+		if optFn, ok := opts[i].implSpecificOptFn.(func(*T)); ok {
+			optFn(base)
+		}
+	}
+
+	return base
+}
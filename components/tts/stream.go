@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// SynthesizeStream synthesizes streaming text sentence by sentence, so the audio of the first sentence
+// is available before the text is complete, e.g. to speak the output of a chat model while it is generated.
+// Each chunk of the returned stream is the audio of one sentence, both streams are closed when done.
+func SynthesizeStream(ctx context.Context, s Synthesizer, text *schema.StreamReader[string], opts ...Option) *schema.StreamReader[*Audio] {
+	sr, sw := schema.Pipe[*Audio](1)
+
+	go func() {
+		defer text.Close()
+		defer sw.Close()
+
+		synthesize := func(sentence string) bool {
+			sentence = strings.TrimSpace(sentence)
+			if sentence == "" {
+				return true
+			}
+			audio, err := s.Synthesize(ctx, sentence, opts...)
+			if err != nil {
+				sw.Send(nil, err)
+				return false
+			}
+			return !sw.Send(audio, nil)
+		}
+
+		var buf strings.Builder
+		for {
+			chunk, err := text.Recv()
+			if errors.Is(err, io.EOF) {
+				synthesize(buf.String())
+				return
+			}
+			if err != nil {
+				sw.Send(nil, err)
+				return
+			}
+
+			buf.WriteString(chunk)
+			sentences, rest := splitSentences(buf.String())
+			buf.Reset()
+			buf.WriteString(rest)
+			for _, sentence := range sentences {
+				if !synthesize(sentence) {
+					return
+				}
+			}
+		}
+	}()
+
+	return sr
+}
+
+// splitSentences splits the complete sentences from text, rest is the incomplete tail.
+// CJK punctuations and line breaks end a sentence immediately, while '.', '!', '?' and ';' end a sentence
+// only if followed by a space, so that numbers like 3.14 are not split.
+func splitSentences(text string) (sentences []string, rest string) {
+	runes := []rune(text)
+	start := 0
+	for i, r := range runes {
+		end := false
+		switch r {
+		case '。', '！', '？', '；', '\n':
+			end = true
+		case '.', '!', '?', ';':
+			end = i+1 < len(runes) && unicode.IsSpace(runes[i+1])
+		}
+		if end {
+			sentences = append(sentences, string(runes[start:i+1]))
+			start = i + 1
+		}
+	}
+	return sentences, string(runes[start:])
+}
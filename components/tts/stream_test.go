@@ -0,0 +1,113 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSynthesizer struct {
+	failOn string
+}
+
+func (m *mockSynthesizer) Synthesize(ctx context.Context, text string, opts ...Option) (*Audio, error) {
+	if text == m.failOn {
+		return nil, errors.New("synthesize fail")
+	}
+	o := GetCommonOptions(&Options{Format: new(string)}, opts...)
+	return &Audio{Data: []byte(text), Format: *o.Format, Text: text}, nil
+}
+
+func TestSplitSentences(t *testing.T) {
+	sentences, rest := splitSentences("你好。今天天气")
+	assert.Equal(t, []string{"你好。"}, sentences)
+	assert.Equal(t, "今天天气", rest)
+
+	sentences, rest = splitSentences("Pi is 3.14. It is")
+	assert.Equal(t, []string{"Pi is 3.14."}, sentences)
+	assert.Equal(t, " It is", rest)
+
+	// the end of a sentence is unknown until the next chunk
+	sentences, rest = splitSentences("Hello world.")
+	assert.Empty(t, sentences)
+	assert.Equal(t, "Hello world.", rest)
+
+	sentences, rest = splitSentences("line one\nline two\n")
+	assert.Equal(t, []string{"line one\n", "line two\n"}, sentences)
+	assert.Equal(t, "", rest)
+}
+
+func TestSynthesizeStream(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sentences", func(t *testing.T) {
+		text := schema.StreamReaderFromArray([]string{"Hello ", "world. How", " are you?", " 很好。", "Bye"})
+		sr := SynthesizeStream(ctx, &mockSynthesizer{}, text, WithFormat("mp3"))
+		defer sr.Close()
+
+		var texts []string
+		for {
+			audio, err := sr.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "mp3", audio.Format)
+			texts = append(texts, audio.Text)
+		}
+		assert.Equal(t, []string{"Hello world.", "How are you?", "很好。", "Bye"}, texts)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		text := schema.StreamReaderFromArray([]string{"ok。", "fail。", "never。"})
+		sr := SynthesizeStream(ctx, &mockSynthesizer{failOn: "fail。"}, text)
+		defer sr.Close()
+
+		audio, err := sr.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "ok。", audio.Text)
+		_, err = sr.Recv()
+		assert.EqualError(t, err, "synthesize fail")
+		_, err = sr.Recv()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestOptions(t *testing.T) {
+	type implOptions struct {
+		Emotion string
+	}
+	withEmotion := func(emotion string) Option {
+		return WrapImplSpecificOptFn(func(o *implOptions) {
+			o.Emotion = emotion
+		})
+	}
+
+	voice := "default"
+	opts := []Option{WithSpeed(1.5), WithFormat("wav"), withEmotion("happy")}
+	o := GetCommonOptions(&Options{Voice: &voice}, opts...)
+	assert.Equal(t, "default", *o.Voice)
+	assert.Equal(t, 1.5, *o.Speed)
+	assert.Equal(t, "wav", *o.Format)
+	assert.Equal(t, "happy", GetImplSpecificOptions(&implOptions{}, opts...).Emotion)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tts
+
+import (
+	"context"
+
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components"
+)
+
+// ComponentOfTTS is the component type of text-to-speech synthesizers in callbacks.
+const ComponentOfTTS components.Component = "TTS"
+
+// Synthesizer converts text to speech.
+// Use SynthesizeStream to synthesize streaming text, e.g. the output of a chat model.
+type Synthesizer interface {
+	Synthesize(ctx context.Context, text string, opts ...Option) (*Audio, error)
+}
+
+// Audio is the synthesized speech.
+type Audio struct {
+	// Data is the encoded audio.
+	Data []byte
+	// Format is the encoding of Data, e.g. "mp3", "wav", "pcm".
+	Format string
+	// Text is the text synthesized to the audio.
+	Text string
+	// Extra carries the implementation specific information, e.g. the duration of the audio.
+	Extra map[string]any
+}
+
+// CallbackInput is the input of the TTS callback.
+type CallbackInput struct {
+	Text  string
+	Voice string
+	Speed float64
+	// Format is the requested encoding of the audio.
+	Format string
+	Extra  map[string]any
+}
+
+// CallbackOutput is the output of the TTS callback.
+type CallbackOutput struct {
+	Audio *Audio
+	Extra map[string]any
+}
+
+// ConvCallbackInput converts the callback input to the TTS callback input.
+func ConvCallbackInput(src callbacks.CallbackInput) *CallbackInput {
+	switch t := src.(type) {
+	case *CallbackInput:
+		return t
+	case string:
+		return &CallbackInput{Text: t}
+	default:
+		return nil
+	}
+}
+
+// ConvCallbackOutput converts the callback output to the TTS callback output.
+func ConvCallbackOutput(src callbacks.CallbackOutput) *CallbackOutput {
+	switch t := src.(type) {
+	case *CallbackOutput:
+		return t
+	case *Audio:
+		return &CallbackOutput{Audio: t}
+	default:
+		return nil
+	}
+}
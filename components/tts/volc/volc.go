@@ -0,0 +1,311 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/eino/callbacks"
+
+	"github.com/cloudwego/eino-ext/components/tts"
+)
+
+const (
+	defaultBaseURL   = "https://openspeech.bytedance.com/api/v1/tts"
+	defaultCluster   = "volcano_tts"
+	defaultVoiceType = "BV001_streaming"
+	defaultEncoding  = "mp3"
+	defaultUID       = "eino"
+
+	// codeSuccess is the code of a successful synthesis.
+	codeSuccess = 3000
+	// maxTextBytes is the max utf-8 length of the text of a request.
+	maxTextBytes = 1024
+)
+
+type Config struct {
+	// AppID is the app id of the Volcengine speech service.
+	// Required.
+	AppID string
+	// AccessToken is the access token of the app.
+	// Required.
+	AccessToken string
+	// Cluster is the cluster of the service.
+	// Optional. Default: "volcano_tts".
+	Cluster string
+	// BaseURL is the url of the TTS http API.
+	// Optional. Default: "https://openspeech.bytedance.com/api/v1/tts".
+	BaseURL string
+	// UID identifies the end user.
+	// Optional. Default: "eino".
+	UID string
+
+	// VoiceType is the default voice, e.g. "BV001_streaming", "BV700_streaming".
+	// Optional. Default: "BV001_streaming".
+	VoiceType string
+	// Speed is the default speed ratio from 0.2 to 3.0.
+	// Optional. Default: 1.0.
+	Speed float64
+	// Volume is the volume ratio from 0.1 to 3.0.
+	// Optional. Default: 1.0.
+	Volume float64
+	// Pitch is the pitch ratio from 0.1 to 3.0.
+	// Optional. Default: 1.0.
+	Pitch float64
+	// Encoding is the default encoding, one of "mp3", "wav", "pcm", "ogg_opus".
+	// Optional. Default: "mp3".
+	Encoding string
+	// Emotion is the emotion of the voice, only supported by some voices, e.g. "happy".
+	// Optional.
+	Emotion string
+
+	// Timeout specifies the duration to wait before timing out a request.
+	// Optional. Default: 0 (no timeout).
+	Timeout time.Duration
+	// HTTPClient specifies the client to send HTTP requests.
+	// If HTTPClient is set, Timeout will not be used.
+	// Optional. Default: &http.Client{Timeout: Timeout}.
+	HTTPClient *http.Client
+}
+
+type options struct {
+	Emotion *string
+}
+
+// WithEmotion sets the emotion of the voice.
+func WithEmotion(emotion string) tts.Option {
+	return tts.WrapImplSpecificOptFn(func(o *options) {
+		o.Emotion = &emotion
+	})
+}
+
+var _ tts.Synthesizer = (*Synthesizer)(nil)
+
+// Synthesizer synthesizes speech with the Volcengine TTS http API.
+type Synthesizer struct {
+	conf *Config
+	cli  *http.Client
+}
+
+func NewSynthesizer(_ context.Context, config *Config) (*Synthesizer, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
+	if config.AppID == "" || config.AccessToken == "" {
+		return nil, errors.New("app id and access token are required")
+	}
+
+	conf := *config
+	if conf.Cluster == "" {
+		conf.Cluster = defaultCluster
+	}
+	if conf.BaseURL == "" {
+		conf.BaseURL = defaultBaseURL
+	}
+	if conf.UID == "" {
+		conf.UID = defaultUID
+	}
+	if conf.VoiceType == "" {
+		conf.VoiceType = defaultVoiceType
+	}
+	if conf.Speed == 0 {
+		conf.Speed = 1.0
+	}
+	if conf.Volume == 0 {
+		conf.Volume = 1.0
+	}
+	if conf.Pitch == 0 {
+		conf.Pitch = 1.0
+	}
+	if conf.Encoding == "" {
+		conf.Encoding = defaultEncoding
+	}
+
+	cli := conf.HTTPClient
+	if cli == nil {
+		cli = &http.Client{Timeout: conf.Timeout}
+	}
+
+	return &Synthesizer{conf: &conf, cli: cli}, nil
+}
+
+type ttsRequest struct {
+	App struct {
+		AppID   string `json:"appid"`
+		Token   string `json:"token"`
+		Cluster string `json:"cluster"`
+	} `json:"app"`
+	User struct {
+		UID string `json:"uid"`
+	} `json:"user"`
+	Audio struct {
+		VoiceType   string  `json:"voice_type"`
+		Encoding    string  `json:"encoding"`
+		SpeedRatio  float64 `json:"speed_ratio"`
+		VolumeRatio float64 `json:"volume_ratio"`
+		PitchRatio  float64 `json:"pitch_ratio"`
+		Emotion     string  `json:"emotion,omitempty"`
+	} `json:"audio"`
+	Request struct {
+		ReqID     string `json:"reqid"`
+		Text      string `json:"text"`
+		TextType  string `json:"text_type"`
+		Operation string `json:"operation"`
+	} `json:"request"`
+}
+
+type ttsResponse struct {
+	ReqID    string `json:"reqid"`
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	Data     string `json:"data"`
+	Addition struct {
+		Duration string `json:"duration"`
+	} `json:"addition"`
+}
+
+func (s *Synthesizer) Synthesize(ctx context.Context, text string, opts ...tts.Option) (audio *tts.Audio, err error) {
+	ctx = callbacks.EnsureRunInfo(ctx, s.GetType(), tts.ComponentOfTTS)
+
+	o := tts.GetCommonOptions(&tts.Options{
+		Voice:  &s.conf.VoiceType,
+		Speed:  &s.conf.Speed,
+		Format: &s.conf.Encoding,
+	}, opts...)
+	specOpts := tts.GetImplSpecificOptions(&options{Emotion: &s.conf.Emotion}, opts...)
+
+	ctx = callbacks.OnStart(ctx, &tts.CallbackInput{
+		Text:   text,
+		Voice:  *o.Voice,
+		Speed:  *o.Speed,
+		Format: *o.Format,
+	})
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	if text == "" {
+		return nil, errors.New("text is required")
+	}
+	if len(text) > maxTextBytes {
+		return nil, fmt.Errorf("text is too long, %d bytes exceeds the limit %d", len(text), maxTextBytes)
+	}
+
+	reqID, err := newReqID()
+	if err != nil {
+		return nil, fmt.Errorf("generate request id fail: %w", err)
+	}
+
+	r := &ttsRequest{}
+	r.App.AppID = s.conf.AppID
+	r.App.Token = s.conf.AccessToken
+	r.App.Cluster = s.conf.Cluster
+	r.User.UID = s.conf.UID
+	r.Audio.VoiceType = *o.Voice
+	r.Audio.Encoding = *o.Format
+	r.Audio.SpeedRatio = *o.Speed
+	r.Audio.VolumeRatio = s.conf.Volume
+	r.Audio.PitchRatio = s.conf.Pitch
+	r.Audio.Emotion = *specOpts.Emotion
+	r.Request.ReqID = reqID
+	r.Request.Text = text
+	r.Request.TextType = "plain"
+	r.Request.Operation = "query"
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request fail: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.conf.BaseURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request fail: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// the token is separated by ';' instead of a space, as required by the API
+	req.Header.Set("Authorization", "Bearer;"+s.conf.AccessToken)
+
+	resp, err := s.cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request fail: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response fail: %w", err)
+	}
+
+	result := &ttsResponse{}
+	if err = json.Unmarshal(data, result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("request failed with status code %d: %s", resp.StatusCode, data)
+		}
+		return nil, fmt.Errorf("unmarshal response fail: %w", err)
+	}
+	if result.Code != codeSuccess {
+		return nil, fmt.Errorf("synthesize fail, code: %d, message: %s, reqid: %s", result.Code, result.Message, result.ReqID)
+	}
+
+	audioData, err := base64.StdEncoding.DecodeString(result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode audio fail: %w", err)
+	}
+
+	audio = &tts.Audio{
+		Data:   audioData,
+		Format: *o.Format,
+		Text:   text,
+		Extra: map[string]any{
+			"reqid":       result.ReqID,
+			"duration_ms": result.Addition.Duration,
+		},
+	}
+	callbacks.OnEnd(ctx, &tts.CallbackOutput{Audio: audio})
+
+	return audio, nil
+}
+
+func newReqID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+const typ = "Volcengine"
+
+func (s *Synthesizer) GetType() string {
+	return typ
+}
+
+func (s *Synthesizer) IsCallbacksEnabled() bool {
+	return true
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package volc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/components/tts"
+)
+
+func TestSynthesize(t *testing.T) {
+	var got ttsRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer;test-token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		if got.Request.Text == "bad" {
+			_, _ = w.Write([]byte(`{"reqid":"1","code":3010,"message":"text too long"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"reqid":"1","code":3000,"message":"Success","data":"` +
+			base64.StdEncoding.EncodeToString([]byte("audio")) + `","addition":{"duration":"1960"}}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	s, err := NewSynthesizer(ctx, &Config{AppID: "app", AccessToken: "test-token", BaseURL: ts.URL})
+	assert.NoError(t, err)
+
+	audio, err := s.Synthesize(ctx, "你好", tts.WithSpeed(1.2), WithEmotion("happy"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("audio"), audio.Data)
+	assert.Equal(t, "mp3", audio.Format)
+	assert.Equal(t, "1960", audio.Extra["duration_ms"])
+
+	assert.Equal(t, "app", got.App.AppID)
+	assert.Equal(t, "volcano_tts", got.App.Cluster)
+	assert.Equal(t, "BV001_streaming", got.Audio.VoiceType)
+	assert.Equal(t, 1.2, got.Audio.SpeedRatio)
+	assert.Equal(t, "happy", got.Audio.Emotion)
+	assert.Equal(t, "你好", got.Request.Text)
+	assert.Len(t, got.Request.ReqID, 32)
+
+	_, err = s.Synthesize(ctx, "bad")
+	assert.EqualError(t, err, "synthesize fail, code: 3010, message: text too long, reqid: 1")
+}
+
+func TestNewSynthesizer(t *testing.T) {
+	_, err := NewSynthesizer(context.Background(), nil)
+	assert.EqualError(t, err, "config is required")
+	_, err = NewSynthesizer(context.Background(), &Config{AppID: "app"})
+	assert.EqualError(t, err, "app id and access token are required")
+}
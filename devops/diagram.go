@@ -0,0 +1,42 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devops
+
+import (
+	"github.com/cloudwego/eino-ext/devops/internal/model"
+	"github.com/cloudwego/eino/compose"
+)
+
+// RenderMermaid renders the compiled graph, including subgraphs and branches, as Mermaid flowchart text.
+// graphInfo is obtained from a compose.GraphCompileCallback, e.g.
+//
+//	type diagramCallback struct{ mermaid string }
+//
+//	func (c *diagramCallback) OnFinish(ctx context.Context, info *compose.GraphInfo) {
+//		c.mermaid, _ = devops.RenderMermaid(info)
+//	}
+//
+//	_, err := g.Compile(ctx, compose.WithGraphCompileCallbacks(&diagramCallback{}))
+func RenderMermaid(graphInfo *compose.GraphInfo) (string, error) {
+	return model.GraphInfo{GraphInfo: graphInfo}.RenderDiagram(graphInfo.Name, model.DiagramFormatMermaid)
+}
+
+// RenderDOT renders the compiled graph, including subgraphs and branches, as Graphviz DOT text.
+// graphInfo is obtained from a compose.GraphCompileCallback, see RenderMermaid.
+func RenderDOT(graphInfo *compose.GraphInfo) (string, error) {
+	return model.GraphInfo{GraphInfo: graphInfo}.RenderDiagram(graphInfo.Name, model.DiagramFormatDOT)
+}
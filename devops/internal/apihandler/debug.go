@@ -33,7 +33,7 @@ import (
 
 func InitDebug(opt *model.DevOpt) {
 	compose.InitGraphCompileCallbacks([]compose.GraphCompileCallback{
-		service.NewGlobalDevGraphCompileCallback(),
+		service.NewGlobalDevGraphCompileCallback(opt.AutoRegisterTypes),
 	})
 	for _, rt := range opt.GoTypes {
 		model.RegisterType(rt.Type)
@@ -71,6 +71,33 @@ func GetCanvasInfo(res http.ResponseWriter, req *http.Request) {
 	newHTTPResp(resp).doResp(res)
 }
 
+// GetGraphDiagram renders the graph as Mermaid flowchart or Graphviz DOT text, selected by the format query, default mermaid.
+func GetGraphDiagram(res http.ResponseWriter, req *http.Request) {
+	graphID := getPathParam(req, "graph_id")
+	if len(graphID) == 0 {
+		newHTTPResp(newBizError(http.StatusBadRequest, fmt.Errorf("graph_id is empty")), newBaseResp(http.StatusBadRequest, "")).doResp(res)
+		return
+	}
+
+	format := model.DiagramFormat(getReqQuery(req, "format"))
+	if len(format) == 0 {
+		format = model.DiagramFormatMermaid
+	}
+
+	diagram, err := service.ContainerSVC.RenderDiagram(graphID, format)
+	if err != nil {
+		newHTTPResp(newBizError(http.StatusBadRequest, err), newBaseResp(http.StatusBadRequest, "")).doResp(res)
+		return
+	}
+
+	resp := &types.GetGraphDiagramResponse{
+		Format:  string(format),
+		Diagram: diagram,
+	}
+
+	newHTTPResp(resp).doResp(res)
+}
+
 // CreateDebugThread create thread_id.
 func CreateDebugThread(res http.ResponseWriter, req *http.Request) {
 	err := validateCreateDebugThreadRequest(req)
@@ -131,9 +158,12 @@ func StreamDebugRun(res http.ResponseWriter, req *http.Request) {
 	ctx = context.WithValue(ctx, "K_LOGID", rs.LogID)
 
 	m := &model.DebugRunMeta{
-		GraphID:  graphID,
-		ThreadID: threadID,
-		FromNode: rs.FromNode,
+		GraphID:     graphID,
+		ThreadID:    threadID,
+		FromNode:    rs.FromNode,
+		Mode:        model.DebugRunMode(rs.Mode),
+		MockNodes:   rs.MockNodes,
+		Breakpoints: rs.Breakpoints,
 	}
 
 	debugID, stateCh, errCh, err := service.DebugSVC.DebugRun(ctx, m, rs.Input)
@@ -158,6 +188,18 @@ func StreamDebugRun(res http.ResponseWriter, req *http.Request) {
 					return
 				}
 
+				if state.Paused {
+					evt := types.DebugRunPausedEVT(debugID, state)
+					sseStreamResponseChan <- NewStreamResponse(string(evt.Type), string(evt.JsonBytes()))
+					continue
+				}
+
+				if state.IsChunk() {
+					evt := types.DebugRunChunkEVT(debugID, state)
+					sseStreamResponseChan <- NewStreamResponse(string(evt.Type), string(evt.JsonBytes()))
+					continue
+				}
+
 				evt := types.DebugRunDataEVT(debugID, state)
 				if err != nil {
 					errEvt := types.DebugRunErrEVT(debugID, err.Error())
@@ -201,6 +243,14 @@ func validateDebugRunRequest(req *http.Request) (*types.DebugRunRequest, error)
 		return nil, fmt.Errorf("from_node is empty")
 	}
 
+	switch model.DebugRunMode(r.Mode) {
+	case "":
+		r.Mode = string(model.DebugRunModeInvoke)
+	case model.DebugRunModeInvoke, model.DebugRunModeStream:
+	default:
+		return nil, fmt.Errorf("unsupported mode: %s", r.Mode)
+	}
+
 	return r, nil
 }
 
@@ -210,3 +260,99 @@ func ListInputTypes(res http.ResponseWriter, req *http.Request) {
 	}
 	newHTTPResp(resp).doResp(res)
 }
+
+// ListDebugRuns list the traces of the latest debug runs of the graph, latest first.
+func ListDebugRuns(res http.ResponseWriter, req *http.Request) {
+	graphID := getPathParam(req, "graph_id")
+	if len(graphID) == 0 {
+		newHTTPResp(newBizError(http.StatusBadRequest, fmt.Errorf("graph_id is empty")), newBaseResp(http.StatusBadRequest, "")).doResp(res)
+		return
+	}
+
+	runs := service.DebugSVC.ListDebugRuns(req.Context(), graphID)
+	resp := &types.ListDebugRunsResponse{
+		Runs: make([]*types.DebugRunSummary, 0, len(runs)),
+	}
+	for _, r := range runs {
+		resp.Runs = append(resp.Runs, types.NewDebugRunSummary(r))
+	}
+
+	newHTTPResp(resp).doResp(res)
+}
+
+// GetDebugRun get the trace of a debug run, with the node timeline.
+func GetDebugRun(res http.ResponseWriter, req *http.Request) {
+	var (
+		graphID = getPathParam(req, "graph_id")
+		debugID = getPathParam(req, "debug_id")
+	)
+	if len(debugID) == 0 {
+		newHTTPResp(newBizError(http.StatusBadRequest, fmt.Errorf("debug_id is empty")), newBaseResp(http.StatusBadRequest, "")).doResp(res)
+		return
+	}
+
+	run, ok := service.DebugSVC.GetDebugRun(req.Context(), debugID)
+	if !ok || run.GraphID != graphID {
+		newHTTPResp(newBizError(http.StatusNotFound, fmt.Errorf("debug run=%s not exist", debugID)), newBaseResp(http.StatusNotFound, "")).doResp(res)
+		return
+	}
+
+	resp := &types.GetDebugRunResponse{
+		Run: types.NewDebugRunDetail(run),
+	}
+
+	newHTTPResp(resp).doResp(res)
+}
+
+// ResumeDebugRun resumes a node of the debug run paused at a breakpoint, optionally with edited input.
+func ResumeDebugRun(res http.ResponseWriter, req *http.Request) {
+	rs, err := validateResumeDebugRunRequest(req)
+	if err != nil {
+		newHTTPResp(newBizError(http.StatusBadRequest, err), newBaseResp(http.StatusBadRequest, "")).doResp(res)
+		return
+	}
+
+	var (
+		graphID = getPathParam(req, "graph_id")
+		debugID = getPathParam(req, "debug_id")
+	)
+	run, ok := service.DebugSVC.GetDebugRun(req.Context(), debugID)
+	if !ok || run.GraphID != graphID {
+		newHTTPResp(newBizError(http.StatusNotFound, fmt.Errorf("debug run=%s not exist", debugID)), newBaseResp(http.StatusNotFound, "")).doResp(res)
+		return
+	}
+
+	err = service.DebugSVC.ResumeDebugRun(req.Context(), debugID, &model.DebugRunResume{
+		NodeKey: rs.NodeKey,
+		Action:  model.DebugRunResumeAction(rs.Action),
+		Input:   rs.Input,
+	})
+	if err != nil {
+		newHTTPResp(newBizError(http.StatusBadRequest, err), newBaseResp(http.StatusBadRequest, "")).doResp(res)
+		return
+	}
+
+	newHTTPResp(&types.ResumeDebugRunResponse{}).doResp(res)
+}
+
+func validateResumeDebugRunRequest(req *http.Request) (*types.ResumeDebugRunRequest, error) {
+	debugID := getPathParam(req, "debug_id")
+	if debugID == "" {
+		return nil, fmt.Errorf("debug_id is empty")
+	}
+
+	r, err := getReqFromBody[types.ResumeDebugRunRequest](req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch model.DebugRunResumeAction(r.Action) {
+	case "":
+		r.Action = string(model.DebugRunResumeContinue)
+	case model.DebugRunResumeContinue, model.DebugRunResumeStep:
+	default:
+		return nil, fmt.Errorf("unsupported action: %s", r.Action)
+	}
+
+	return r, nil
+}
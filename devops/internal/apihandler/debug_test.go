@@ -205,6 +205,17 @@ func (d *debugTestSuite) Test_validateDebugRunRequest() {
 	r, err := validateDebugRunRequest(req)
 	assert.Nil(d.t, err)
 	assert.Equal(d.t, "start", r.FromNode)
+	assert.Equal(d.t, "invoke", r.Mode)
+
+	reader = strings.NewReader(`{"from_node":"start","mode":"batch"}`)
+	req, err = http.NewRequest(http.MethodPost, "", reader)
+	assert.Nil(d.t, err)
+	req = mux.SetURLVars(req, map[string]string{
+		"graph_id":  "mock_graph_id",
+		"thread_id": "mock_thread_id",
+	})
+	_, err = validateDebugRunRequest(req)
+	assert.NotNil(d.t, err)
 }
 
 func (d *debugTestSuite) Test_ListInputTypes() {
@@ -223,3 +234,139 @@ func (d *debugTestSuite) Test_ListInputTypes() {
 	assert.Nil(d.t, err)
 	assert.Greater(d.t, len(data.Types), 0)
 }
+
+func (d *debugTestSuite) Test_ListDebugRuns() {
+	mockey.PatchConvey("", d.t, func() {
+		mockGraphID := "mock_graph"
+		mockey.Mock(getPathParam).Return(mockGraphID).Build()
+		d.mockDebugSVC.EXPECT().ListDebugRuns(gomock.Any(), mockGraphID).Return([]*model.DebugRunRecord{
+			{
+				DebugID:     "mock_debug_id",
+				GraphID:     mockGraphID,
+				Status:      model.DebugRunSuccess,
+				StartTimeMS: 100,
+				EndTimeMS:   150,
+				Nodes:       []*model.NodeDebugState{{NodeKey: "node1"}},
+			},
+		}).Times(1)
+
+		req, err := http.NewRequest(http.MethodGet, "", nil)
+		assert.Nil(d.t, err)
+		res := &mockResponseWriter{}
+		ListDebugRuns(res, req)
+
+		resp := &HTTPResp{}
+		err = json.Unmarshal(res.body, &resp)
+		assert.Nil(d.t, err)
+		b, err := json.Marshal(resp.Data)
+		assert.Nil(d.t, err)
+		var data *types.ListDebugRunsResponse
+		err = json.Unmarshal(b, &data)
+		assert.Nil(d.t, err)
+		assert.Len(d.t, data.Runs, 1)
+		assert.Equal(d.t, "mock_debug_id", data.Runs[0].DebugID)
+		assert.Equal(d.t, "success", data.Runs[0].Status)
+		assert.Equal(d.t, int64(50), data.Runs[0].DurationMS)
+		assert.Equal(d.t, 1, data.Runs[0].NodeCount)
+	})
+}
+
+func (d *debugTestSuite) Test_GetDebugRun() {
+	mockey.PatchConvey("", d.t, func() {
+		mockGraphID := "mock_graph"
+		mockDebugID := "mock_debug_id"
+		mockey.Mock(getPathParam).To(func(req *http.Request, key string) string {
+			if key == "graph_id" {
+				return mockGraphID
+			}
+			return mockDebugID
+		}).Build()
+		d.mockDebugSVC.EXPECT().GetDebugRun(gomock.Any(), mockDebugID).Return(&model.DebugRunRecord{
+			DebugID: mockDebugID,
+			GraphID: mockGraphID,
+			Status:  model.DebugRunFailed,
+			Error:   "mock error",
+			Nodes: []*model.NodeDebugState{
+				{
+					NodeKey: "node1",
+					Metrics: model.NodeDebugMetrics{InvokeTimeMS: 100, CompletionTimeMS: 120},
+				},
+			},
+		}, true).Times(1)
+
+		req, err := http.NewRequest(http.MethodGet, "", nil)
+		assert.Nil(d.t, err)
+		res := &mockResponseWriter{}
+		GetDebugRun(res, req)
+
+		resp := &HTTPResp{}
+		err = json.Unmarshal(res.body, &resp)
+		assert.Nil(d.t, err)
+		b, err := json.Marshal(resp.Data)
+		assert.Nil(d.t, err)
+		var data *types.GetDebugRunResponse
+		err = json.Unmarshal(b, &data)
+		assert.Nil(d.t, err)
+		assert.Equal(d.t, "failed", data.Run.Status)
+		assert.Equal(d.t, "mock error", data.Run.Error)
+		assert.Len(d.t, data.Run.Nodes, 1)
+		assert.Equal(d.t, int64(20), data.Run.Nodes[0].Metrics.DurationMS)
+	})
+}
+
+func (d *debugTestSuite) Test_ResumeDebugRun() {
+	mockey.PatchConvey("", d.t, func() {
+		mockGraphID := "mock_graph"
+		mockDebugID := "mock_debug_id"
+		mockey.Mock(getPathParam).To(func(req *http.Request, key string) string {
+			if key == "graph_id" {
+				return mockGraphID
+			}
+			return mockDebugID
+		}).Build()
+		d.mockDebugSVC.EXPECT().GetDebugRun(gomock.Any(), mockDebugID).Return(&model.DebugRunRecord{
+			DebugID: mockDebugID,
+			GraphID: mockGraphID,
+			Status:  model.DebugRunPaused,
+		}, true).Times(1)
+		d.mockDebugSVC.EXPECT().ResumeDebugRun(gomock.Any(), mockDebugID, &model.DebugRunResume{
+			NodeKey: "node1",
+			Action:  model.DebugRunResumeStep,
+			Input:   `"edited"`,
+		}).Return(nil).Times(1)
+
+		reader := strings.NewReader(`{"node_key":"node1","action":"step","input":"\"edited\""}`)
+		req, err := http.NewRequest(http.MethodPost, "", reader)
+		assert.Nil(d.t, err)
+		res := &mockResponseWriter{}
+		ResumeDebugRun(res, req)
+
+		resp := &HTTPResp{}
+		err = json.Unmarshal(res.body, &resp)
+		assert.Nil(d.t, err)
+		assert.Equal(d.t, 0, resp.Code)
+	})
+}
+
+func (d *debugTestSuite) Test_validateResumeDebugRunRequest() {
+	reader := strings.NewReader(`{}`)
+	req, err := http.NewRequest(http.MethodPost, "", reader)
+	assert.Nil(d.t, err)
+	req = mux.SetURLVars(req, map[string]string{
+		"graph_id": "mock_graph_id",
+		"debug_id": "mock_debug_id",
+	})
+	r, err := validateResumeDebugRunRequest(req)
+	assert.Nil(d.t, err)
+	assert.Equal(d.t, "continue", r.Action)
+
+	reader = strings.NewReader(`{"action":"skip"}`)
+	req, err = http.NewRequest(http.MethodPost, "", reader)
+	assert.Nil(d.t, err)
+	req = mux.SetURLVars(req, map[string]string{
+		"graph_id": "mock_graph_id",
+		"debug_id": "mock_debug_id",
+	})
+	_, err = validateResumeDebugRunRequest(req)
+	assert.NotNil(d.t, err)
+}
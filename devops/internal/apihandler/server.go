@@ -69,8 +69,12 @@ func registerRoutes(r *mux.Router) {
 	debugR.Path("/input_types").HandlerFunc(ListInputTypes).Methods(http.MethodGet)
 	debugR.Path("/graphs").HandlerFunc(ListGraphs).Methods(http.MethodGet)
 	debugR.Path("/graphs/{graph_id}/canvas").HandlerFunc(GetCanvasInfo).Methods(http.MethodGet)
+	debugR.Path("/graphs/{graph_id}/diagram").HandlerFunc(GetGraphDiagram).Methods(http.MethodGet)
 	debugR.Path("/graphs/{graph_id}/threads").HandlerFunc(CreateDebugThread).Methods(http.MethodPost)
 	debugR.Path("/graphs/{graph_id}/threads/{thread_id}/stream").HandlerFunc(StreamDebugRun).Methods(http.MethodPost)
+	debugR.Path("/graphs/{graph_id}/runs").HandlerFunc(ListDebugRuns).Methods(http.MethodGet)
+	debugR.Path("/graphs/{graph_id}/runs/{debug_id}").HandlerFunc(GetDebugRun).Methods(http.MethodGet)
+	debugR.Path("/graphs/{graph_id}/runs/{debug_id}/resume").HandlerFunc(ResumeDebugRun).Methods(http.MethodPost)
 }
 
 type HTTPResp struct {
@@ -27,6 +27,11 @@ type GetCanvasInfoResponse struct {
 	CanvasInfo devmodel.CanvasInfo `json:"canvas_info,omitempty"`
 }
 
+type GetGraphDiagramResponse struct {
+	Format  string `json:"format"`
+	Diagram string `json:"diagram"`
+}
+
 type CreateDebugThreadResponse struct {
 	ThreadID string `json:"thread_id,omitempty"`
 }
@@ -35,12 +40,27 @@ type DebugRunRequest struct {
 	FromNode string `json:"from_node"`
 	Input    string `json:"input"` // mock input data after json marshal
 	LogID    string `json:"log_id"`
+	Mode     string `json:"mode,omitempty"` // invoke or stream, default invoke
+	// MockNodes node key vs mock output data after json marshal, the mocked nodes return it without being executed.
+	MockNodes map[string]string `json:"mock_nodes,omitempty"`
+	// Breakpoints keys of the nodes to pause before executing, resumed by ResumeDebugRunRequest.
+	Breakpoints []string `json:"breakpoints,omitempty"`
 }
 
+type ResumeDebugRunRequest struct {
+	NodeKey string `json:"node_key,omitempty"` // can be empty if there is only one paused node
+	Action  string `json:"action,omitempty"`   // continue or step, default continue
+	Input   string `json:"input,omitempty"`    // edited input data after json marshal, empty to keep the pending input
+}
+
+type ResumeDebugRunResponse struct{}
+
 type DebugRunEventType string
 
 const (
 	debugRunEventOfData   DebugRunEventType = "data"
+	debugRunEventOfChunk  DebugRunEventType = "chunk"
+	debugRunEventOfPaused DebugRunEventType = "paused"
 	debugRunEventOfFinish DebugRunEventType = "finish"
 	debugRunEventOfError  DebugRunEventType = "error"
 )
@@ -61,6 +81,9 @@ type NodeDebugState struct {
 	ErrorType string `json:"error_type,omitempty"`
 
 	Metrics NodeDebugMetrics `json:"metrics,omitempty"`
+
+	Chunk      string `json:"chunk,omitempty"`
+	ChunkIndex int    `json:"chunk_index,omitempty"`
 }
 
 type NodeDebugMetrics struct {
@@ -69,24 +92,58 @@ type NodeDebugMetrics struct {
 
 	InvokeTimeMS     int64 `json:"invoke_time_ms,omitempty"`
 	CompletionTimeMS int64 `json:"completion_time_ms,omitempty"`
+	DurationMS       int64 `json:"duration_ms,omitempty"`
+}
+
+func convNodeDebugState(state *model.NodeDebugState) *NodeDebugState {
+	s := &NodeDebugState{
+		NodeKey:   state.NodeKey,
+		Input:     state.Input,
+		Output:    state.Output,
+		Error:     state.Error,
+		ErrorType: string(state.ErrorType),
+		Metrics: NodeDebugMetrics{
+			PromptTokens:     state.Metrics.PromptTokens,
+			CompletionTokens: state.Metrics.CompletionTokens,
+			InvokeTimeMS:     state.Metrics.InvokeTimeMS,
+			CompletionTimeMS: state.Metrics.CompletionTimeMS,
+		},
+	}
+	if state.Metrics.InvokeTimeMS > 0 && state.Metrics.CompletionTimeMS >= state.Metrics.InvokeTimeMS {
+		s.Metrics.DurationMS = state.Metrics.CompletionTimeMS - state.Metrics.InvokeTimeMS
+	}
+	return s
 }
 
 func DebugRunDataEVT(debugID string, state *model.NodeDebugState) (s DebugRunEventMsg) {
 	return DebugRunEventMsg{
 		Type:    debugRunEventOfData,
 		DebugID: debugID,
+		Content: convNodeDebugState(state),
+	}
+}
+
+// DebugRunChunkEVT is a chunk of the streaming output of a node, or of the graph output with the end node key.
+func DebugRunChunkEVT(debugID string, state *model.NodeDebugState) (s DebugRunEventMsg) {
+	return DebugRunEventMsg{
+		Type:    debugRunEventOfChunk,
+		DebugID: debugID,
 		Content: &NodeDebugState{
-			NodeKey:   state.NodeKey,
-			Input:     state.Input,
-			Output:    state.Output,
-			Error:     state.Error,
-			ErrorType: string(state.ErrorType),
-			Metrics: NodeDebugMetrics{
-				PromptTokens:     state.Metrics.PromptTokens,
-				CompletionTokens: state.Metrics.CompletionTokens,
-				InvokeTimeMS:     state.Metrics.InvokeTimeMS,
-				CompletionTimeMS: state.Metrics.CompletionTimeMS,
-			},
+			NodeKey:    state.NodeKey,
+			Chunk:      state.Chunk,
+			ChunkIndex: state.ChunkIndex,
+		},
+	}
+}
+
+// DebugRunPausedEVT is sent when a node is paused at a breakpoint, with the pending input of the node.
+func DebugRunPausedEVT(debugID string, state *model.NodeDebugState) (s DebugRunEventMsg) {
+	return DebugRunEventMsg{
+		Type:    debugRunEventOfPaused,
+		DebugID: debugID,
+		Content: &NodeDebugState{
+			NodeKey: state.NodeKey,
+			Input:   state.Input,
 		},
 	}
 }
@@ -114,3 +171,66 @@ func (d DebugRunEventMsg) JsonBytes() []byte {
 type ListInputTypesResponse struct {
 	Types []*devmodel.JsonSchema `json:"types,omitempty"`
 }
+
+type ListDebugRunsResponse struct {
+	Runs []*DebugRunSummary `json:"runs,omitempty"`
+}
+
+type DebugRunSummary struct {
+	DebugID     string   `json:"debug_id"`
+	ThreadID    string   `json:"thread_id"`
+	FromNode    string   `json:"from_node"`
+	Mode        string   `json:"mode,omitempty"`
+	MockNodes   []string `json:"mock_nodes,omitempty"`
+	Breakpoints []string `json:"breakpoints,omitempty"`
+	Status      string   `json:"status"`
+	Error       string   `json:"error,omitempty"`
+
+	StartTimeMS int64 `json:"start_time_ms,omitempty"`
+	EndTimeMS   int64 `json:"end_time_ms,omitempty"`
+	DurationMS  int64 `json:"duration_ms,omitempty"`
+	NodeCount   int   `json:"node_count"`
+}
+
+type GetDebugRunResponse struct {
+	Run *DebugRunDetail `json:"run,omitempty"`
+}
+
+type DebugRunDetail struct {
+	DebugRunSummary
+	Input string `json:"input,omitempty"`
+	// Nodes is the node timeline of the run, in the order the nodes finish.
+	Nodes []*NodeDebugState `json:"nodes,omitempty"`
+}
+
+func NewDebugRunSummary(r *model.DebugRunRecord) *DebugRunSummary {
+	s := &DebugRunSummary{
+		DebugID:     r.DebugID,
+		ThreadID:    r.ThreadID,
+		FromNode:    r.FromNode,
+		Mode:        string(r.Mode),
+		MockNodes:   r.MockNodes,
+		Breakpoints: r.Breakpoints,
+		Status:      string(r.Status),
+		Error:       r.Error,
+		StartTimeMS: r.StartTimeMS,
+		EndTimeMS:   r.EndTimeMS,
+		NodeCount:   len(r.Nodes),
+	}
+	if r.EndTimeMS >= r.StartTimeMS && r.EndTimeMS > 0 {
+		s.DurationMS = r.EndTimeMS - r.StartTimeMS
+	}
+	return s
+}
+
+func NewDebugRunDetail(r *model.DebugRunRecord) *DebugRunDetail {
+	d := &DebugRunDetail{
+		DebugRunSummary: *NewDebugRunSummary(r),
+		Input:           r.Input,
+		Nodes:           make([]*NodeDebugState, 0, len(r.Nodes)),
+	}
+	for _, state := range r.Nodes {
+		d.Nodes = append(d.Nodes, convNodeDebugState(state))
+	}
+	return d
+}
@@ -100,6 +100,21 @@ func (mr *MockContainerServiceMockRecorder) CreateDevGraph(graphID, fromNode any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevGraph", reflect.TypeOf((*MockContainerService)(nil).CreateDevGraph), graphID, fromNode)
 }
 
+// CreateMockedDevGraph mocks base method.
+func (m *MockContainerService) CreateMockedDevGraph(graphID, fromNode string, mockNodes map[string]string) (*model.Graph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMockedDevGraph", graphID, fromNode, mockNodes)
+	ret0, _ := ret[0].(*model.Graph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMockedDevGraph indicates an expected call of CreateMockedDevGraph.
+func (mr *MockContainerServiceMockRecorder) CreateMockedDevGraph(graphID, fromNode, mockNodes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMockedDevGraph", reflect.TypeOf((*MockContainerService)(nil).CreateMockedDevGraph), graphID, fromNode, mockNodes)
+}
+
 // GetCanvas mocks base method.
 func (m *MockContainerService) GetCanvas(graphID string) (model0.CanvasInfo, bool) {
 	m.ctrl.T.Helper()
@@ -143,3 +158,18 @@ func (mr *MockContainerServiceMockRecorder) ListGraphs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGraphs", reflect.TypeOf((*MockContainerService)(nil).ListGraphs))
 }
+
+// RenderDiagram mocks base method.
+func (m *MockContainerService) RenderDiagram(graphID string, format model.DiagramFormat) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderDiagram", graphID, format)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderDiagram indicates an expected call of RenderDiagram.
+func (mr *MockContainerServiceMockRecorder) RenderDiagram(graphID, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderDiagram", reflect.TypeOf((*MockContainerService)(nil).RenderDiagram), graphID, format)
+}
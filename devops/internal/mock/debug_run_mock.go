@@ -87,3 +87,46 @@ func (mr *MockDebugServiceMockRecorder) DebugRun(ctx, m, userInput any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugRun", reflect.TypeOf((*MockDebugService)(nil).DebugRun), ctx, m, userInput)
 }
+
+// GetDebugRun mocks base method.
+func (m *MockDebugService) GetDebugRun(ctx context.Context, debugID string) (*model.DebugRunRecord, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDebugRun", ctx, debugID)
+	ret0, _ := ret[0].(*model.DebugRunRecord)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDebugRun indicates an expected call of GetDebugRun.
+func (mr *MockDebugServiceMockRecorder) GetDebugRun(ctx, debugID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDebugRun", reflect.TypeOf((*MockDebugService)(nil).GetDebugRun), ctx, debugID)
+}
+
+// ListDebugRuns mocks base method.
+func (m *MockDebugService) ListDebugRuns(ctx context.Context, graphID string) []*model.DebugRunRecord {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDebugRuns", ctx, graphID)
+	ret0, _ := ret[0].([]*model.DebugRunRecord)
+	return ret0
+}
+
+// ListDebugRuns indicates an expected call of ListDebugRuns.
+func (mr *MockDebugServiceMockRecorder) ListDebugRuns(ctx, graphID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDebugRuns", reflect.TypeOf((*MockDebugService)(nil).ListDebugRuns), ctx, graphID)
+}
+
+// ResumeDebugRun mocks base method.
+func (m *MockDebugService) ResumeDebugRun(ctx context.Context, debugID string, cmd *model.DebugRunResume) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeDebugRun", ctx, debugID, cmd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeDebugRun indicates an expected call of ResumeDebugRun.
+func (mr *MockDebugServiceMockRecorder) ResumeDebugRun(ctx, debugID, cmd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDebugRun", reflect.TypeOf((*MockDebugService)(nil).ResumeDebugRun), ctx, debugID, cmd)
+}
@@ -76,11 +76,35 @@ func initGraphInfo(gi *GraphInfo) *GraphInfo {
 	}
 }
 
-func BuildDevGraph(gi *GraphInfo, fromNode string) (g *Graph, err error) {
+type buildDevGraphOptions struct {
+	mockNodes map[string]string
+}
+
+type BuildDevGraphOption func(*buildDevGraphOptions)
+
+// WithMockNodes substitutes nodes of the dev graph with mocks returning canned output.
+// mockNodes: NodeKey vs mock output of the node, json marshal string of the node output type.
+func WithMockNodes(mockNodes map[string]string) BuildDevGraphOption {
+	return func(o *buildDevGraphOptions) {
+		o.mockNodes = mockNodes
+	}
+}
+
+func BuildDevGraph(gi *GraphInfo, fromNode string, opts ...BuildDevGraphOption) (g *Graph, err error) {
 	if fromNode == compose.END {
 		return nil, fmt.Errorf("can not start from end node")
 	}
 
+	o := &buildDevGraphOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	for key := range o.mockNodes {
+		if _, ok := gi.Nodes[key]; !ok {
+			return nil, fmt.Errorf("mock node=%s not found", key)
+		}
+	}
+
 	g = &Graph{Graph: compose.NewGraph[any, any](gi.NewGraphOptions...)}
 
 	var (
@@ -98,7 +122,7 @@ func BuildDevGraph(gi *GraphInfo, fromNode string) (g *Graph, err error) {
 
 		if fn != compose.START && !addNodes[fn] {
 			node := gi.Nodes[fn]
-			if err = g.addNode(fn, node); err != nil {
+			if err = g.addDevNode(fn, node, o.mockNodes); err != nil {
 				return nil, err
 			}
 			newGI.Nodes[fn] = node
@@ -108,7 +132,7 @@ func BuildDevGraph(gi *GraphInfo, fromNode string) (g *Graph, err error) {
 		for _, tn := range gi.Edges[fn] {
 			if !addNodes[tn] && tn != compose.END {
 				node := gi.Nodes[tn]
-				if err = g.addNode(tn, node); err != nil {
+				if err = g.addDevNode(tn, node, o.mockNodes); err != nil {
 					return nil, err
 				}
 				newGI.Nodes[tn] = node
@@ -126,7 +150,7 @@ func BuildDevGraph(gi *GraphInfo, fromNode string) (g *Graph, err error) {
 			for tn := range bt.GetEndNode() {
 				if !addNodes[tn] && tn != compose.END {
 					node := gi.Nodes[tn]
-					if err = g.addNode(tn, node); err != nil {
+					if err = g.addDevNode(tn, node, o.mockNodes); err != nil {
 						return nil, err
 					}
 					newGI.Nodes[tn] = node
@@ -373,6 +397,36 @@ func (g *Graph) Compile() (Runnable, error) {
 	return Runnable{r: r}, err
 }
 
+// addDevNode adds the node to the dev graph, or its mock if the node is in mockNodes.
+func (g *Graph) addDevNode(node string, gni compose.GraphNodeInfo, mockNodes map[string]string) error {
+	mockOutput, ok := mockNodes[node]
+	if !ok {
+		return g.addNode(node, gni)
+	}
+	return g.addMockNode(node, gni, mockOutput)
+}
+
+// addMockNode adds a lambda node in place of the node, which ignores the input and returns mockOutput
+// unmarshalled to the output type of the node, so that downstream nodes receive what the node would return.
+func (g *Graph) addMockNode(node string, gni compose.GraphNodeInfo, mockOutput string) error {
+	if gni.OutputType == nil {
+		return fmt.Errorf("output type of mock node=%s is unknown", node)
+	}
+	if _, err := UnmarshalJson([]byte(mockOutput), gni.OutputType); err != nil {
+		return fmt.Errorf("invalid mock output of node=%s, err=%w", node, err)
+	}
+
+	mock := compose.InvokableLambda(func(ctx context.Context, _ any) (output any, err error) {
+		val, err := UnmarshalJson([]byte(mockOutput), gni.OutputType)
+		if err != nil {
+			return nil, err
+		}
+		return val.Interface(), nil
+	})
+
+	return g.AddLambdaNode(node, mock, gni.GraphAddNodeOpts...)
+}
+
 func (g *Graph) addNode(node string, gni compose.GraphNodeInfo, opts ...compose.GraphAddNodeOpt) error {
 	newOpts := append(gni.GraphAddNodeOpts, opts...)
 	switch gni.Component {
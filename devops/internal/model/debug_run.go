@@ -48,6 +48,20 @@ type NodeDebugState struct {
 	ErrorType ErrorType
 
 	Metrics NodeDebugMetrics
+
+	// Chunk: a chunk of the streaming output of the node, json marshal string, only set in stream mode.
+	// Chunks are sent as they arrive, before the state with the whole output of the node.
+	Chunk string
+	// ChunkIndex: the index of Chunk in the streaming output of the node.
+	ChunkIndex int
+
+	// Paused: the node is paused at a breakpoint before being executed, Input is the pending input of the node.
+	Paused bool
+}
+
+// IsChunk tells whether the state carries a chunk of streaming output, rather than the result of the node.
+func (s *NodeDebugState) IsChunk() bool {
+	return len(s.Chunk) > 0
 }
 
 type NodeDebugMetrics struct {
@@ -62,11 +76,80 @@ type DebugRunMeta struct {
 	GraphID  string
 	ThreadID string
 	FromNode string
+	Mode     DebugRunMode
+	// MockNodes: NodeKey vs mock output of the node, json marshal string.
+	// The mocked nodes return the mock output instead of being executed.
+	MockNodes map[string]string
+	// Breakpoints: keys of the nodes to pause before executing, until the run is resumed.
+	Breakpoints []string
+}
+
+type DebugRunResumeAction string
+
+const (
+	// DebugRunResumeContinue resumes the run until the next breakpoint.
+	DebugRunResumeContinue DebugRunResumeAction = "continue"
+	// DebugRunResumeStep resumes the run and pauses before the next node to execute.
+	DebugRunResumeStep DebugRunResumeAction = "step"
+)
+
+// DebugRunResume is the command to resume a node paused at a breakpoint.
+type DebugRunResume struct {
+	// NodeKey: the paused node to resume, can be empty if there is only one paused node.
+	NodeKey string
+	Action  DebugRunResumeAction
+	// Input: the edited input of the node, json marshal string, empty to keep the pending input.
+	Input string
 }
 
+type DebugRunMode string
+
+const (
+	// DebugRunModeInvoke runs the graph with Invoke, the default.
+	DebugRunModeInvoke DebugRunMode = "invoke"
+	// DebugRunModeStream runs the graph with Stream, the chunks of streaming node outputs, e.g. partial
+	// tool call deltas of chat models, and the chunks of the graph output are sent as they arrive.
+	DebugRunModeStream DebugRunMode = "stream"
+)
+
 type ErrorType string
 
 const (
 	NodeError   ErrorType = "NodeError"
 	SystemError ErrorType = "SystemError"
 )
+
+type DebugRunStatus string
+
+const (
+	DebugRunRunning DebugRunStatus = "running"
+	DebugRunPaused  DebugRunStatus = "paused"
+	DebugRunSuccess DebugRunStatus = "success"
+	DebugRunFailed  DebugRunStatus = "failed"
+)
+
+// DebugRunRecord is the trace of a debug run, kept for the IDE plugin to show the execution timeline.
+type DebugRunRecord struct {
+	DebugID  string
+	GraphID  string
+	ThreadID string
+	FromNode string
+	// Input: the mock input of the run, json marshal string.
+	Input string
+
+	Status DebugRunStatus
+	// Error: the error of the run, plain text.
+	Error string
+
+	Mode DebugRunMode
+	// MockNodes: keys of the nodes mocked in the run.
+	MockNodes []string
+	// Breakpoints: keys of the nodes the run pauses before.
+	Breakpoints []string
+
+	StartTimeMS int64
+	EndTimeMS   int64
+
+	// Nodes: the states of the nodes in the order they finish, without chunks of streaming output.
+	Nodes []*NodeDebugState
+}
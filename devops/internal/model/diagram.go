@@ -0,0 +1,234 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/eino/compose"
+)
+
+type DiagramFormat string
+
+const (
+	DiagramFormatMermaid DiagramFormat = "mermaid"
+	DiagramFormatDOT     DiagramFormat = "dot"
+)
+
+// RenderDiagram renders the graph, including subgraphs and branches, as Mermaid flowchart or Graphviz DOT text.
+func (gi GraphInfo) RenderDiagram(graphName string, format DiagramFormat) (string, error) {
+	d := newDiagram(gi.GraphInfo, "")
+	switch format {
+	case DiagramFormatMermaid:
+		return d.mermaid(graphName), nil
+	case DiagramFormatDOT:
+		return d.dot(graphName), nil
+	default:
+		return "", fmt.Errorf("unsupported diagram format=%s", format)
+	}
+}
+
+type diagramNodeShape int
+
+const (
+	diagramNodeShapeBox diagramNodeShape = iota
+	diagramNodeShapeTerminal
+	diagramNodeShapeBranch
+)
+
+type diagramNode struct {
+	id    string
+	label string
+	shape diagramNodeShape
+	// sub is the diagram of the subgraph node.
+	sub *diagram
+}
+
+type diagramEdge struct {
+	from, to string
+	// branch marks edges from a branch to its end nodes.
+	branch bool
+}
+
+// diagram is the graph prepared for rendering, node ids are unique among subgraphs by prefixing the id of the parent,
+// and the ids of start and end nodes are upper case since "end" is a keyword of Mermaid.
+type diagram struct {
+	nodes []*diagramNode
+	edges []*diagramEdge
+	byID  map[string]*diagramNode
+}
+
+func newDiagram(gi *compose.GraphInfo, prefix string) *diagram {
+	d := &diagram{byID: make(map[string]*diagramNode, len(gi.Nodes)+2)}
+	ids := make(map[string]string, len(gi.Nodes)+2)
+
+	addNode := func(n *diagramNode) {
+		d.nodes = append(d.nodes, n)
+		d.byID[n.id] = n
+	}
+
+	ids[compose.START] = prefix + "START"
+	addNode(&diagramNode{id: ids[compose.START], label: compose.START, shape: diagramNodeShapeTerminal})
+
+	for i, key := range sortedKeys(gi.Nodes) {
+		node := gi.Nodes[key]
+		id := fmt.Sprintf("%sn%d", prefix, i)
+		ids[key] = id
+
+		n := &diagramNode{id: id, label: key}
+		if len(node.Component) > 0 {
+			n.label = fmt.Sprintf("%s\n%s", key, node.Component)
+		}
+		if node.GraphInfo != nil {
+			n.sub = newDiagram(node.GraphInfo, id+"_")
+		}
+		addNode(n)
+	}
+
+	ids[compose.END] = prefix + "END"
+	addNode(&diagramNode{id: ids[compose.END], label: compose.END, shape: diagramNodeShapeTerminal})
+
+	for _, from := range sortedKeys(gi.Edges) {
+		for _, to := range gi.Edges[from] {
+			d.edges = append(d.edges, &diagramEdge{from: ids[from], to: ids[to]})
+		}
+	}
+
+	branchID := 0
+	for _, from := range sortedKeys(gi.Branches) {
+		for _, branch := range gi.Branches[from] {
+			bn := &diagramNode{id: fmt.Sprintf("%sb%d", prefix, branchID), label: "branch", shape: diagramNodeShapeBranch}
+			branchID++
+			addNode(bn)
+			d.edges = append(d.edges, &diagramEdge{from: ids[from], to: bn.id})
+			for _, to := range sortedKeys(branch.GetEndNode()) {
+				d.edges = append(d.edges, &diagramEdge{from: bn.id, to: ids[to], branch: true})
+			}
+		}
+	}
+
+	return d
+}
+
+func (d *diagram) mermaid(graphName string) string {
+	sb := &strings.Builder{}
+	if len(graphName) > 0 {
+		fmt.Fprintf(sb, "---\ntitle: %s\n---\n", graphName)
+	}
+	sb.WriteString("flowchart TD\n")
+	d.writeMermaid(sb, "    ")
+	return sb.String()
+}
+
+func (d *diagram) writeMermaid(sb *strings.Builder, indent string) {
+	for _, n := range d.nodes {
+		label := mermaidLabel(n.label)
+		switch {
+		case n.sub != nil:
+			fmt.Fprintf(sb, "%ssubgraph %s[\"%s\"]\n", indent, n.id, label)
+			n.sub.writeMermaid(sb, indent+"    ")
+			fmt.Fprintf(sb, "%send\n", indent)
+		case n.shape == diagramNodeShapeTerminal:
+			fmt.Fprintf(sb, "%s%s([\"%s\"])\n", indent, n.id, label)
+		case n.shape == diagramNodeShapeBranch:
+			fmt.Fprintf(sb, "%s%s{\"%s\"}\n", indent, n.id, label)
+		default:
+			fmt.Fprintf(sb, "%s%s[\"%s\"]\n", indent, n.id, label)
+		}
+	}
+
+	for _, e := range d.edges {
+		arrow := "-->"
+		if e.branch {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(sb, "%s%s %s %s\n", indent, e.from, arrow, e.to)
+	}
+}
+
+func mermaidLabel(label string) string {
+	label = strings.ReplaceAll(label, `"`, "#quot;")
+	return strings.ReplaceAll(label, "\n", "<br/>")
+}
+
+func (d *diagram) dot(graphName string) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "digraph %s {\n", dotQuote(graphName))
+	sb.WriteString("    compound=true;\n")
+	sb.WriteString("    node [shape=box];\n")
+	d.writeDOT(sb, "    ")
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func (d *diagram) writeDOT(sb *strings.Builder, indent string) {
+	for _, n := range d.nodes {
+		switch {
+		case n.sub != nil:
+			fmt.Fprintf(sb, "%ssubgraph %s {\n", indent, dotQuote("cluster_"+n.id))
+			fmt.Fprintf(sb, "%s    label=%s;\n", indent, dotQuote(n.label))
+			n.sub.writeDOT(sb, indent+"    ")
+			fmt.Fprintf(sb, "%s}\n", indent)
+		case n.shape == diagramNodeShapeTerminal:
+			fmt.Fprintf(sb, "%s%s [label=%s, shape=oval];\n", indent, dotQuote(n.id), dotQuote(n.label))
+		case n.shape == diagramNodeShapeBranch:
+			fmt.Fprintf(sb, "%s%s [label=%s, shape=diamond];\n", indent, dotQuote(n.id), dotQuote(n.label))
+		default:
+			fmt.Fprintf(sb, "%s%s [label=%s];\n", indent, dotQuote(n.id), dotQuote(n.label))
+		}
+	}
+
+	for _, e := range d.edges {
+		// edges of subgraph nodes are drawn from the end node, or to the start node, of the cluster
+		from, to := e.from, e.to
+		attrs := make([]string, 0, 3)
+		if n := d.byID[from]; n != nil && n.sub != nil {
+			from = from + "_END"
+			attrs = append(attrs, "ltail="+dotQuote("cluster_"+n.id))
+		}
+		if n := d.byID[to]; n != nil && n.sub != nil {
+			to = to + "_START"
+			attrs = append(attrs, "lhead="+dotQuote("cluster_"+n.id))
+		}
+		if e.branch {
+			attrs = append(attrs, "style=dashed")
+		}
+
+		if len(attrs) == 0 {
+			fmt.Fprintf(sb, "%s%s -> %s;\n", indent, dotQuote(from), dotQuote(to))
+			continue
+		}
+		fmt.Fprintf(sb, "%s%s -> %s [%s];\n", indent, dotQuote(from), dotQuote(to), strings.Join(attrs, ", "))
+	}
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
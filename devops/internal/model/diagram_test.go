@@ -0,0 +1,102 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino/compose"
+)
+
+func Test_GraphInfo_RenderDiagram(t *testing.T) {
+	tc := &mockRunnableCallback{}
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, mockRunnableCtxKey{}, tc)
+
+	sg := compose.NewGraph[string, string]()
+	err := sg.AddLambdaNode("sub_A", compose.InvokableLambda(func(ctx context.Context, input string) (string, error) {
+		return input, nil
+	}))
+	assert.NoError(t, err)
+	err = sg.AddEdge(compose.START, "sub_A")
+	assert.NoError(t, err)
+	err = sg.AddEdge("sub_A", compose.END)
+	assert.NoError(t, err)
+
+	g := compose.NewGraph[string, string]()
+	err = g.AddLambdaNode("A", compose.InvokableLambda(func(ctx context.Context, input string) (string, error) {
+		return input, nil
+	}))
+	assert.NoError(t, err)
+	err = g.AddGraphNode("B", sg)
+	assert.NoError(t, err)
+	err = g.AddEdge(compose.START, "A")
+	assert.NoError(t, err)
+	err = g.AddBranch("A", compose.NewGraphBranch(func(ctx context.Context, in string) (string, error) {
+		return "B", nil
+	}, map[string]bool{"B": true, compose.END: true}))
+	assert.NoError(t, err)
+	err = g.AddEdge("B", compose.END)
+	assert.NoError(t, err)
+
+	_, err = g.Compile(ctx, compose.WithGraphCompileCallbacks(tc))
+	assert.NoError(t, err)
+
+	t.Run("mermaid", func(t *testing.T) {
+		diagram, err := tc.gi.RenderDiagram("my_graph", DiagramFormatMermaid)
+		assert.NoError(t, err)
+		assert.Equal(t, `---
+title: my_graph
+---
+flowchart TD
+    START(["start"])
+    n0["A<br/>Lambda"]
+    subgraph n1["B<br/>Graph"]
+        n1_START(["start"])
+        n1_n0["sub_A<br/>Lambda"]
+        n1_END(["end"])
+        n1_START --> n1_n0
+        n1_n0 --> n1_END
+    end
+    END(["end"])
+    b0{"branch"}
+    n1 --> END
+    START --> n0
+    n0 --> b0
+    b0 -.-> n1
+    b0 -.-> END
+`, diagram)
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		diagram, err := tc.gi.RenderDiagram("my_graph", DiagramFormatDOT)
+		assert.NoError(t, err)
+		assert.Contains(t, diagram, `digraph "my_graph" {`)
+		assert.Contains(t, diagram, `subgraph "cluster_n1" {`)
+		assert.Contains(t, diagram, `"n1_END" -> "END" [ltail="cluster_n1"];`)
+		assert.Contains(t, diagram, `"b0" -> "n1_START" [lhead="cluster_n1", style=dashed];`)
+		assert.Contains(t, diagram, `"n0" [label="A\nLambda"];`)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := tc.gi.RenderDiagram("my_graph", "svg")
+		assert.Error(t, err)
+	})
+}
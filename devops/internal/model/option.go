@@ -23,6 +23,8 @@ const (
 type DevOpt struct {
 	DevServerPort string
 	GoTypes       []RegisteredType
+	// AutoRegisterTypes registers the types discovered from the compiled graphs.
+	AutoRegisterTypes bool
 }
 
 type DevOption func(*DevOpt)
@@ -22,6 +22,7 @@ import (
 	"reflect"
 
 	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
 )
 
 type Runnable struct {
@@ -46,6 +47,24 @@ func (dr Runnable) Invoke(ctx context.Context, input reflect.Value, opts ...comp
 	return res[0].Interface(), nil
 }
 
+func (dr Runnable) Stream(ctx context.Context, input reflect.Value, opts ...compose.Option) (output *schema.StreamReader[any], err error) {
+	callArgs := make([]reflect.Value, 0, len(opts))
+	callArgs = append(callArgs, reflect.ValueOf(ctx), input)
+	for _, opt := range opts {
+		callArgs = append(callArgs, reflect.ValueOf(opt))
+	}
+
+	res := reflect.ValueOf(dr.r).MethodByName("Stream").Call(callArgs)
+	if !res[1].IsNil() {
+		return nil, res[1].Interface().(error)
+	}
+	if res[0].IsNil() {
+		return nil, fmt.Errorf("output stream is nil")
+	}
+
+	return res[0].Interface().(*schema.StreamReader[any]), nil
+}
+
 func getPtrValue(typ reflect.Value, level int) reflect.Value {
 	for i := 0; i < level; i++ {
 		newInput := reflect.New(typ.Type())
@@ -1293,3 +1293,54 @@ func Test_GraphInfo_InferGraphInputType(t *testing.T) {
 		})
 	})
 }
+
+func Test_Graph_MockNodes(t *testing.T) {
+	tc := &mockRunnableCallback{}
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, mockRunnableCtxKey{}, tc)
+	g := compose.NewGraph[string, string]()
+
+	err := g.AddLambdaNode("A", compose.InvokableLambda(func(ctx context.Context, input string) (*mockRunnableImpl, error) {
+		return nil, fmt.Errorf("should not be called")
+	}))
+	assert.NoError(t, err)
+
+	err = g.AddLambdaNode("B", compose.InvokableLambda(func(ctx context.Context, input *mockRunnableImpl) (string, error) {
+		return "B:" + input.NN, nil
+	}))
+	assert.NoError(t, err)
+
+	err = g.AddEdge(compose.START, "A")
+	assert.NoError(t, err)
+	err = g.AddEdge("A", "B")
+	assert.NoError(t, err)
+	err = g.AddEdge("B", compose.END)
+	assert.NoError(t, err)
+
+	_, err = g.Compile(ctx, compose.WithGraphCompileCallbacks(tc))
+	assert.NoError(t, err)
+
+	t.Run("mock output", func(t *testing.T) {
+		dg, err := BuildDevGraph(tc.gi, compose.START, WithMockNodes(map[string]string{"A": `{"nn":"mocked"}`}))
+		assert.NoError(t, err)
+		assert.Contains(t, dg.GraphInfo.Nodes, "A")
+		r, err := dg.Compile()
+		assert.NoError(t, err)
+
+		input, err := UnmarshalJson([]byte(`"hello"`), tc.gi.InputType)
+		assert.NoError(t, err)
+		resp, err := r.Invoke(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "B:mocked", resp)
+	})
+
+	t.Run("invalid mock output", func(t *testing.T) {
+		_, err := BuildDevGraph(tc.gi, compose.START, WithMockNodes(map[string]string{"A": `"mocked"`}))
+		assert.Error(t, err)
+	})
+
+	t.Run("mock node not found", func(t *testing.T) {
+		_, err := BuildDevGraph(tc.gi, compose.START, WithMockNodes(map[string]string{"C": `{}`}))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/cloudwego/eino/compose"
+)
+
+// RegisterGraphTypes registers the named types discovered from the graph, so that they can be chosen as
+// implementations of interfaces in mock input without calling RegisterType manually.
+// The input and output types of the graph and its nodes, including subgraphs, are walked through recursively,
+// along with the exported fields of structs and the elements of pointers, slices, arrays and maps.
+// For a named non-pointer type T, *T is registered as well, since interfaces are often implemented by pointers.
+func RegisterGraphTypes(gi *compose.GraphInfo) (registered []reflect.Type) {
+	visited := make(map[reflect.Type]bool)
+	var walkGraph func(gi *compose.GraphInfo)
+	walkGraph = func(gi *compose.GraphInfo) {
+		registered = append(registered, discoverTypes(gi.InputType, visited)...)
+		registered = append(registered, discoverTypes(gi.OutputType, visited)...)
+		for _, key := range sortedKeys(gi.Nodes) {
+			node := gi.Nodes[key]
+			registered = append(registered, discoverTypes(node.InputType, visited)...)
+			registered = append(registered, discoverTypes(node.OutputType, visited)...)
+			if node.GraphInfo != nil {
+				walkGraph(node.GraphInfo)
+			}
+		}
+	}
+	walkGraph(gi)
+
+	for _, rt := range registered {
+		RegisterType(rt)
+	}
+
+	return registered
+}
+
+func discoverTypes(rt reflect.Type, visited map[reflect.Type]bool) (types []reflect.Type) {
+	if rt == nil || visited[rt] {
+		return nil
+	}
+	visited[rt] = true
+	if isStdType(rt) {
+		return nil
+	}
+
+	switch rt.Kind() {
+	case reflect.Interface, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	case reflect.Ptr:
+		types = discoverTypes(rt.Elem(), visited)
+		if isNamedType(rt.Elem()) {
+			types = append(types, rt)
+		}
+		return types
+	case reflect.Slice, reflect.Array:
+		types = discoverTypes(rt.Elem(), visited)
+	case reflect.Map:
+		types = append(discoverTypes(rt.Key(), visited), discoverTypes(rt.Elem(), visited)...)
+	case reflect.Struct:
+		for i := 0; i < rt.NumField(); i++ {
+			if field := rt.Field(i); field.IsExported() {
+				types = append(types, discoverTypes(field.Type, visited)...)
+			}
+		}
+	}
+
+	if isNamedType(rt) {
+		types = append(types, rt)
+		types = append(types, discoverTypes(reflect.PtrTo(rt), visited)...)
+	}
+
+	return types
+}
+
+// isNamedType tells whether the type is a defined type out of the builtin ones, e.g. string, int, error.
+func isNamedType(rt reflect.Type) bool {
+	return rt.Name() != "" && rt.PkgPath() != ""
+}
+
+// isStdType tells whether the named type is from the standard library, e.g. time.Time, which is neither registered
+// nor walked through. Like go tooling, packages whose first path element has no dot, except main, are deemed standard.
+func isStdType(rt reflect.Type) bool {
+	pkgPath := rt.PkgPath()
+	if pkgPath == "" || pkgPath == "main" {
+		return false
+	}
+	if i := strings.Index(pkgPath, "/"); i >= 0 {
+		pkgPath = pkgPath[:i]
+	}
+	return !strings.Contains(pkgPath, ".")
+}
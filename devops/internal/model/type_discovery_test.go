@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/devops/internal/utils/generic"
+	"github.com/cloudwego/eino/compose"
+)
+
+type discoveryNamer interface {
+	Name() string
+}
+
+type discoveryInput struct {
+	Items []*discoveryItem       `json:"items"`
+	Meta  map[string]discoveryID `json:"meta"`
+	Namer discoveryNamer         `json:"namer"`
+}
+
+type discoveryItem struct {
+	ID discoveryID `json:"id"`
+}
+
+type discoveryID string
+
+func (d discoveryID) Name() string {
+	return string(d)
+}
+
+func Test_RegisterGraphTypes(t *testing.T) {
+	tc := &mockRunnableCallback{}
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, mockRunnableCtxKey{}, tc)
+
+	g := compose.NewGraph[*discoveryInput, string]()
+	err := g.AddLambdaNode("A", compose.InvokableLambda(func(ctx context.Context, input *discoveryInput) (string, error) {
+		return input.Namer.Name(), nil
+	}))
+	assert.NoError(t, err)
+	err = g.AddEdge(compose.START, "A")
+	assert.NoError(t, err)
+	err = g.AddEdge("A", compose.END)
+	assert.NoError(t, err)
+	_, err = g.Compile(ctx, compose.WithGraphCompileCallbacks(tc))
+	assert.NoError(t, err)
+
+	userInput := `{"namer":{"_eino_go_type":"model.discoveryID","_value":"foo"}}`
+	_, err = UnmarshalJson([]byte(userInput), tc.gi.InputType)
+	assert.ErrorContains(t, err, "unregistered type `model.discoveryID`")
+
+	registered := RegisterGraphTypes(tc.gi.GraphInfo)
+	assert.ElementsMatch(t, []reflect.Type{
+		generic.TypeOf[discoveryInput](),
+		generic.TypeOf[*discoveryInput](),
+		generic.TypeOf[discoveryItem](),
+		generic.TypeOf[*discoveryItem](),
+		generic.TypeOf[discoveryID](),
+		generic.TypeOf[*discoveryID](),
+	}, registered)
+
+	val, err := UnmarshalJson([]byte(userInput), tc.gi.InputType)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", val.Interface().(*discoveryInput).Namer.Name())
+}
@@ -20,6 +20,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/cloudwego/eino-ext/devops/internal/utils/generic"
 	"github.com/cloudwego/eino-ext/devops/model"
@@ -32,7 +35,12 @@ const (
 	einoValue  = "_value"
 )
 
-var registeredTypeMap = make(map[string]reflect.Type)
+var (
+	// registeredTypesMu guards registeredTypes and registeredTypeMap, which grow at graph compile time
+	// when types are auto registered.
+	registeredTypesMu sync.RWMutex
+	registeredTypeMap = make(map[string]reflect.Type)
+)
 
 func init() {
 	for i, rt := range registeredTypes {
@@ -90,6 +98,9 @@ var registeredTypes = []RegisteredType{
 }
 
 func RegisterType(rt reflect.Type) {
+	registeredTypesMu.Lock()
+	defer registeredTypesMu.Unlock()
+
 	if _, ok := registeredTypeMap[rt.String()]; ok {
 		return
 	}
@@ -121,7 +132,7 @@ func UnmarshalJson(b []byte, rt reflect.Type) (val reflect.Value, err error) {
 	case reflect.Struct:
 		return unmarshalStructInput(b, rt)
 	case reflect.Interface:
-		return unmarshalInterfaceInput(b)
+		return unmarshalInterfaceInput(b, rt)
 	default:
 		return val, fmt.Errorf("unsupported type=%s", rt.String())
 	}
@@ -180,7 +191,7 @@ func unmarshalMapInput(b []byte, rt reflect.Type) (val reflect.Value, err error)
 	return mapIns, nil
 }
 
-func unmarshalInterfaceInput(b []byte) (val reflect.Value, err error) {
+func unmarshalInterfaceInput(b []byte, rt reflect.Type) (val reflect.Value, err error) {
 	var rawMsg map[string]json.RawMessage
 	if err = json.Unmarshal(b, &rawMsg); err != nil {
 		return val, fmt.Errorf("unmarshal failed, err=%v, str=%s", err.Error(), string(b))
@@ -197,9 +208,16 @@ func unmarshalInterfaceInput(b []byte) (val reflect.Value, err error) {
 		return val, fmt.Errorf("unmarshal failed, err=%v, str=%s", err.Error(), string(b))
 	}
 
+	registeredTypesMu.RLock()
 	implType, ok := registeredTypeMap[goType]
+	registeredTypesMu.RUnlock()
 	if !ok {
-		return val, fmt.Errorf("unregistered type `%s` for interface, str=%s", goType, string(b))
+		hint := "register it by devops.AppendType, or enable devops.WithAutoRegisterTypes if it is used by the graph"
+		if rt.NumMethod() > 0 {
+			hint += fmt.Sprintf(", registered implementations of `%s`=[%s]", rt.String(),
+				strings.Join(registeredImplementations(rt), ", "))
+		}
+		return val, fmt.Errorf("unregistered type `%s` for interface, %s, str=%s", goType, hint, string(b))
 	}
 
 	if implType.String() != goType {
@@ -255,7 +273,25 @@ func unmarshalStructInput(b []byte, rt reflect.Type) (val reflect.Value, err err
 	return ins, nil
 }
 
+// registeredImplementations returns the identifiers of the registered types implementing the interface.
+func registeredImplementations(rt reflect.Type) []string {
+	registeredTypesMu.RLock()
+	defer registeredTypesMu.RUnlock()
+
+	impls := make([]string, 0)
+	for id, t := range registeredTypeMap {
+		if t.Implements(rt) {
+			impls = append(impls, id)
+		}
+	}
+	sort.Strings(impls)
+	return impls
+}
+
 func GetRegisteredTypeJsonSchema() []*model.JsonSchema {
+	registeredTypesMu.RLock()
+	defer registeredTypesMu.RUnlock()
+
 	schemas := make([]*model.JsonSchema, 0, len(registeredTypes))
 	for _, rt := range registeredTypes {
 		schemas = append(schemas, rt.Schema)
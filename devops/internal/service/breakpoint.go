@@ -0,0 +1,201 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/cloudwego/eino-ext/devops/internal/model"
+)
+
+// breakpoints pauses the nodes of a debug run before they are executed, at the breakpoint nodes,
+// or at every node while stepping, until they are resumed.
+type breakpoints struct {
+	mu       sync.Mutex
+	nodes    map[string]bool
+	stepping bool
+	// paused: NodeKey vs the paused node.
+	paused map[string]*pausedNode
+
+	// onPause is called when the first node is paused, onResume when no node is paused anymore.
+	onPause  func()
+	onResume func()
+}
+
+type pausedNode struct {
+	resumeCh chan *resumeRequest
+	// done is closed once the node leaves the breakpoint.
+	done chan struct{}
+}
+
+type resumeRequest struct {
+	cmd   *model.DebugRunResume
+	errCh chan error
+}
+
+func newBreakpoints(nodes []string, onPause, onResume func()) *breakpoints {
+	b := &breakpoints{
+		nodes:    make(map[string]bool, len(nodes)),
+		paused:   make(map[string]*pausedNode),
+		onPause:  onPause,
+		onResume: onResume,
+	}
+	for _, node := range nodes {
+		b.nodes[node] = true
+	}
+	return b
+}
+
+// pause blocks the node until it is resumed or ctx is done, if the node is a breakpoint or the run is stepping.
+// The paused state with the pending input is sent to stateCh, and edit applies the edited input of the resume command.
+func (b *breakpoints) pause(ctx context.Context, nodeKey, input string, stateCh chan *model.NodeDebugState,
+	edit func(input string) error) {
+	b.mu.Lock()
+	if !b.nodes[nodeKey] && !b.stepping {
+		b.mu.Unlock()
+		return
+	}
+	pn := &pausedNode{
+		resumeCh: make(chan *resumeRequest),
+		done:     make(chan struct{}),
+	}
+	b.paused[nodeKey] = pn
+	if len(b.paused) == 1 && b.onPause != nil {
+		b.onPause()
+	}
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.paused, nodeKey)
+		close(pn.done)
+		if len(b.paused) == 0 && b.onResume != nil {
+			b.onResume()
+		}
+		b.mu.Unlock()
+	}()
+
+	stateCh <- &model.NodeDebugState{
+		NodeKey: nodeKey,
+		Input:   input,
+		Paused:  true,
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-pn.resumeCh:
+			if len(req.cmd.Input) > 0 {
+				if err := edit(req.cmd.Input); err != nil {
+					req.errCh <- fmt.Errorf("edit input of node=%s failed, err=%w", nodeKey, err)
+					continue
+				}
+			}
+
+			b.mu.Lock()
+			b.stepping = req.cmd.Action == model.DebugRunResumeStep
+			b.mu.Unlock()
+
+			req.errCh <- nil
+			return
+		}
+	}
+}
+
+// resume resumes the paused node, and returns after the edited input, if any, is applied.
+func (b *breakpoints) resume(ctx context.Context, cmd *model.DebugRunResume) error {
+	b.mu.Lock()
+	nodeKey := cmd.NodeKey
+	if len(nodeKey) == 0 {
+		if len(b.paused) != 1 {
+			b.mu.Unlock()
+			return fmt.Errorf("node_key is required when %d nodes are paused", len(b.paused))
+		}
+		for key := range b.paused {
+			nodeKey = key
+		}
+	}
+	pn, ok := b.paused[nodeKey]
+	b.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("node=%s is not paused", nodeKey)
+	}
+
+	req := &resumeRequest{
+		cmd:   cmd,
+		errCh: make(chan error, 1),
+	}
+	select {
+	case pn.resumeCh <- req:
+	case <-pn.done:
+		return fmt.Errorf("node=%s is not paused", nodeKey)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return <-req.errCh
+}
+
+// editInputInPlace decodes the edited input into the pending input, which must be a pointer, a map,
+// or a slice with the same length, so that the node sees the edited input.
+func editInputInPlace(editedInput []byte, input any) error {
+	v := reflect.ValueOf(input)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return fmt.Errorf("pending input is nil")
+		}
+		newV := reflect.New(v.Type().Elem())
+		if err := json.Unmarshal(editedInput, newV.Interface()); err != nil {
+			return err
+		}
+		v.Elem().Set(newV.Elem())
+	case reflect.Map:
+		if v.IsNil() {
+			return fmt.Errorf("pending input is nil")
+		}
+		newV := reflect.New(v.Type())
+		if err := json.Unmarshal(editedInput, newV.Interface()); err != nil {
+			return err
+		}
+		for _, key := range v.MapKeys() {
+			v.SetMapIndex(key, reflect.Value{})
+		}
+		iter := newV.Elem().MapRange()
+		for iter.Next() {
+			v.SetMapIndex(iter.Key(), iter.Value())
+		}
+	case reflect.Slice:
+		newV := reflect.New(v.Type())
+		if err := json.Unmarshal(editedInput, newV.Interface()); err != nil {
+			return err
+		}
+		if newV.Elem().Len() != v.Len() {
+			return fmt.Errorf("edited input must have %d elements, but got %d", v.Len(), newV.Elem().Len())
+		}
+		reflect.Copy(v, newV.Elem())
+	default:
+		return fmt.Errorf("pending input of kind=%s can not be edited", v.Kind())
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2024 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cloudwego/eino-ext/devops/internal/model"
+)
+
+type mockBreakpointInput struct {
+	Query string `json:"query"`
+}
+
+func Test_breakpoints(t *testing.T) {
+	ctx := context.Background()
+	var statuses []model.DebugRunStatus
+	bps := newBreakpoints([]string{"node1"},
+		func() { statuses = append(statuses, model.DebugRunPaused) },
+		func() { statuses = append(statuses, model.DebugRunRunning) })
+	stateCh := make(chan *model.NodeDebugState, 10)
+
+	// not a breakpoint, not paused
+	bps.pause(ctx, "node2", `{}`, stateCh, nil)
+	assert.Len(t, stateCh, 0)
+
+	err := bps.resume(ctx, &model.DebugRunResume{})
+	assert.NotNil(t, err)
+
+	input := &mockBreakpointInput{Query: "hello"}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bps.pause(ctx, "node1", `{"query":"hello"}`, stateCh, func(editedInput string) error {
+			return editInputInPlace([]byte(editedInput), input)
+		})
+	}()
+
+	state := <-stateCh
+	assert.True(t, state.Paused)
+	assert.Equal(t, "node1", state.NodeKey)
+	assert.Equal(t, `{"query":"hello"}`, state.Input)
+
+	err = bps.resume(ctx, &model.DebugRunResume{NodeKey: "node2"})
+	assert.NotNil(t, err)
+	err = bps.resume(ctx, &model.DebugRunResume{Input: `[]`})
+	assert.NotNil(t, err)
+	err = bps.resume(ctx, &model.DebugRunResume{Action: model.DebugRunResumeStep, Input: `{"query":"edited"}`})
+	assert.Nil(t, err)
+	<-done
+	assert.Equal(t, "edited", input.Query)
+	assert.Equal(t, []model.DebugRunStatus{model.DebugRunPaused, model.DebugRunRunning}, statuses)
+
+	// stepping pauses the next node
+	go bps.pause(ctx, "node2", `{}`, stateCh, nil)
+	state = <-stateCh
+	assert.Equal(t, "node2", state.NodeKey)
+	err = bps.resume(ctx, &model.DebugRunResume{Action: model.DebugRunResumeContinue})
+	assert.Nil(t, err)
+}
+
+func Test_editInputInPlace(t *testing.T) {
+	m := map[string]any{"a": 1, "b": 2}
+	err := editInputInPlace([]byte(`{"c":3}`), m)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"c": float64(3)}, m)
+
+	s := []string{"a", "b"}
+	err = editInputInPlace([]byte(`["c","d"]`), s)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"c", "d"}, s)
+	err = editInputInPlace([]byte(`["c"]`), s)
+	assert.NotNil(t, err)
+
+	err = editInputInPlace([]byte(`"c"`), "a")
+	assert.NotNil(t, err)
+}
@@ -36,12 +36,15 @@ import (
 	"github.com/cloudwego/eino/schema"
 )
 
-func newCallbackOption(nodeKey, threadID string, node compose.GraphNodeInfo, stateCh chan *model.NodeDebugState) compose.Option {
+func newCallbackOption(nodeKey, threadID string, node compose.GraphNodeInfo, stateCh chan *model.NodeDebugState, streamChunks bool,
+	bps *breakpoints) compose.Option {
 	cb := &callbackHandler{
-		nodeKey:  nodeKey,
-		threadID: threadID,
-		stateCh:  stateCh,
-		node:     node,
+		nodeKey:      nodeKey,
+		threadID:     threadID,
+		stateCh:      stateCh,
+		node:         node,
+		streamChunks: streamChunks,
+		breakpoints:  bps,
 	}
 	op := compose.WithCallbacks(cb).DesignateNode(nodeKey)
 	return op
@@ -52,6 +55,10 @@ type callbackHandler struct {
 	stateCh  chan *model.NodeDebugState
 	threadID string
 	node     compose.GraphNodeInfo
+	// streamChunks sends every chunk of streaming output as it arrives.
+	streamChunks bool
+	// breakpoints pauses the node before it is executed, nil if the run has no breakpoints.
+	breakpoints *breakpoints
 }
 
 func (c *callbackHandler) OnStart(ctx context.Context, info *callbacks.RunInfo, input callbacks.CallbackInput) context.Context {
@@ -82,6 +89,17 @@ func (c *callbackHandler) OnStart(ctx context.Context, info *callbacks.RunInfo,
 		return ctx
 	}
 
+	if c.breakpoints != nil {
+		c.breakpoints.pause(ctx, c.nodeKey, string(jsonInput), c.stateCh, func(editedInput string) error {
+			if err := c.editInput(input, editedInput); err != nil {
+				return err
+			}
+			jsonInput = []byte(editedInput)
+			return nil
+		})
+		invokeTime = time.Now().UnixMilli()
+	}
+
 	return setNodeDebugStateCtx(ctx, &nodeDebugStateCtxValue{
 		invokeTimeMS:  invokeTime,
 		callbackInput: string(jsonInput),
@@ -276,6 +294,9 @@ func (c *callbackHandler) parseDefaultStreamOutput(ctx context.Context, output *
 		if len(c.node.OutputKey) > 0 {
 			callbackOutput = map[string]any{c.node.OutputKey: callbackOutput}
 		}
+		if c.streamChunks {
+			c.sendChunk(callbackOutput, len(chunks))
+		}
 		chunks = append(chunks, callbackOutput)
 	}
 	jsonData, err := json.Marshal(chunks)
@@ -301,6 +322,19 @@ func (c *callbackHandler) ConvCallbackOutput(src callbacks.CallbackOutput) *eino
 	}
 }
 
+func (c *callbackHandler) sendChunk(chunk any, index int) {
+	jsonChunk, err := json.Marshal(chunk)
+	if err != nil {
+		log.Errorf("error serializing output chunk to JSON, err=%v", err)
+		return
+	}
+	c.stateCh <- &model.NodeDebugState{
+		NodeKey:    c.nodeKey,
+		Chunk:      string(jsonChunk),
+		ChunkIndex: index,
+	}
+}
+
 func (c *callbackHandler) systemErrorProcess(errorStr string, invokeTime, completionTime int64) {
 	log.Errorf(errorStr)
 	state := &model.NodeDebugState{
@@ -339,6 +373,23 @@ func getNodeDebugStateCtx(ctx context.Context) (*nodeDebugStateCtxValue, bool) {
 	return val, ok
 }
 
+// editInput applies the edited input, in the same json form as the input of the node state, to the pending input.
+func (c *callbackHandler) editInput(input callbacks.CallbackInput, editedInput string) error {
+	b := []byte(editedInput)
+	if len(c.node.InputKey) > 0 {
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(b, &m); err != nil {
+			return err
+		}
+		var ok bool
+		if b, ok = m[c.node.InputKey]; !ok {
+			return fmt.Errorf("input key=%s not found in edited input", c.node.InputKey)
+		}
+	}
+
+	return editInputInPlace(b, c.convCallbackInput(input))
+}
+
 func (c *callbackHandler) convCallbackInput(input callbacks.CallbackInput) any {
 	switch t := input.(type) {
 	case *einomodel.CallbackInput:
@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/cloudwego/eino-ext/devops/internal/model"
 	"github.com/cloudwego/eino-ext/devops/internal/utils/log"
 	"github.com/cloudwego/eino/compose"
 )
@@ -37,7 +38,9 @@ type globalDevGraphCompileCallback struct {
 	onFinish func(ctx context.Context, graphInfo *compose.GraphInfo)
 }
 
-func NewGlobalDevGraphCompileCallback() compose.GraphCompileCallback {
+// NewGlobalDevGraphCompileCallback collects the compiled graphs, autoRegisterTypes registers the types discovered
+// from the graphs, see model.RegisterGraphTypes.
+func NewGlobalDevGraphCompileCallback(autoRegisterTypes bool) compose.GraphCompileCallback {
 	onFinish := func(ctx context.Context, graphInfo *compose.GraphInfo) {
 		if graphInfo == nil {
 			return
@@ -48,6 +51,10 @@ func NewGlobalDevGraphCompileCallback() compose.GraphCompileCallback {
 			return
 		}
 
+		if autoRegisterTypes {
+			model.RegisterGraphTypes(graphInfo)
+		}
+
 		graphName := graphInfo.Name
 		if graphName == "" {
 			graphName = genGraphName(frame)
@@ -61,7 +61,7 @@ func (c *callbackTestSuite) buildCallbackGraph() {
 	}))
 	_ = g.AddEdge(compose.START, "node")
 	_ = g.AddEdge("node", compose.END)
-	_, err := g.Compile(context.Background(), compose.WithGraphCompileCallbacks(NewGlobalDevGraphCompileCallback()))
+	_, err := g.Compile(context.Background(), compose.WithGraphCompileCallbacks(NewGlobalDevGraphCompileCallback(false)))
 	assert.NoError(c.T(), err)
 }
 
@@ -70,7 +70,7 @@ func (c *callbackTestSuite) buildCallbackChain() {
 	_ = cn.AppendLambda(compose.InvokableLambda(func(ctx context.Context, input string) (output string, err error) {
 		return input, nil
 	}))
-	_, err := cn.Compile(context.Background(), compose.WithGraphCompileCallbacks(NewGlobalDevGraphCompileCallback()))
+	_, err := cn.Compile(context.Background(), compose.WithGraphCompileCallbacks(NewGlobalDevGraphCompileCallback(false)))
 	assert.NoError(c.T(), err)
 }
 
@@ -34,9 +34,11 @@ type ContainerService interface {
 	AddGraphInfo(graphName string, graphInfo *compose.GraphInfo) (graphID string, err error)
 	ListGraphs() (graphNameToID map[string]string)
 	CreateDevGraph(graphID, fromNode string) (devGraph *model.Graph, err error)
+	CreateMockedDevGraph(graphID, fromNode string, mockNodes map[string]string) (devGraph *model.Graph, err error)
 	GetDevGraph(graphID, fromNode string) (devGraph *model.Graph, exist bool)
 	CreateCanvas(graphID string) (canvas devmodel.CanvasInfo, err error)
 	GetCanvas(graphID string) (canvas devmodel.CanvasInfo, exist bool)
+	RenderDiagram(graphID string, format model.DiagramFormat) (diagram string, err error)
 }
 
 const maxGraphNum = 100
@@ -186,6 +188,24 @@ func (s *containerServiceImpl) CreateDevGraph(graphID, fromNode string) (devGrap
 	return graph, nil
 }
 
+// CreateMockedDevGraph builds a dev graph with mockNodes substituted by mocks, the graph is not cached
+// since the mocks are specified per debug run.
+func (s *containerServiceImpl) CreateMockedDevGraph(graphID, fromNode string, mockNodes map[string]string) (devGraph *model.Graph, err error) {
+	s.mu.RLock()
+	c := s.container[graphID]
+	s.mu.RUnlock()
+	if c == nil {
+		return devGraph, fmt.Errorf("must add graph info first")
+	}
+
+	graph, err := model.BuildDevGraph(c.GraphInfo, fromNode, model.WithMockNodes(mockNodes))
+	if err != nil {
+		return devGraph, fmt.Errorf("build mocked dev graph failed, err=%w", err)
+	}
+
+	return graph, nil
+}
+
 func (s *containerServiceImpl) GetDevGraph(graphID, fromNode string) (devGraph *model.Graph, exist bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -236,3 +256,19 @@ func (s *containerServiceImpl) GetCanvas(graphID string) (canvasInfo devmodel.Ca
 
 	return *c.CanvasInfo, true
 }
+
+func (s *containerServiceImpl) RenderDiagram(graphID string, format model.DiagramFormat) (diagram string, err error) {
+	s.mu.RLock()
+	c := s.container[graphID]
+	s.mu.RUnlock()
+	if c == nil || c.GraphInfo == nil {
+		return "", fmt.Errorf("must add graph first")
+	}
+
+	diagram, err = c.GraphInfo.RenderDiagram(c.Name, format)
+	if err != nil {
+		return "", fmt.Errorf("render diagram failed, err=%w", err)
+	}
+
+	return diagram, nil
+}
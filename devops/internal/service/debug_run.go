@@ -18,8 +18,13 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/matoous/go-nanoid"
 
@@ -27,6 +32,7 @@ import (
 	"github.com/cloudwego/eino-ext/devops/internal/utils/log"
 	"github.com/cloudwego/eino-ext/devops/internal/utils/safego"
 	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
 )
 
 // TODO@liujian: implement debug run service
@@ -37,18 +43,32 @@ var _ DebugService = &debugServiceImpl{}
 type DebugService interface {
 	CreateDebugThread(ctx context.Context, graphID string) (threadID string, err error)
 	DebugRun(ctx context.Context, m *model.DebugRunMeta, userInput string) (debugID string, stateCh chan *model.NodeDebugState, errCh chan error, err error)
+	ListDebugRuns(ctx context.Context, graphID string) (runs []*model.DebugRunRecord)
+	GetDebugRun(ctx context.Context, debugID string) (run *model.DebugRunRecord, exist bool)
+	ResumeDebugRun(ctx context.Context, debugID string, cmd *model.DebugRunResume) (err error)
 }
 
+// maxDebugRuns is the number of the latest debug runs whose traces are kept.
+const maxDebugRuns = 100
+
 type debugServiceImpl struct {
 	mu sync.RWMutex
 	// debugGraphs: graphID vs DebugGraph
 	debugGraphs map[string]*model.DebugGraph
+
+	runsMu sync.RWMutex
+	// debugRuns: traces of the latest debug runs, oldest first.
+	debugRuns []*model.DebugRunRecord
+	// breakpoints: debugID vs breakpoints of the running debug runs with breakpoints.
+	breakpoints map[string]*breakpoints
 }
 
 func newDebugService() DebugService {
 	return &debugServiceImpl{
 		mu:          sync.RWMutex{},
 		debugGraphs: make(map[string]*model.DebugGraph, 10),
+		debugRuns:   make([]*model.DebugRunRecord, 0, maxDebugRuns),
+		breakpoints: make(map[string]*breakpoints),
 	}
 }
 
@@ -88,11 +108,19 @@ func (d *debugServiceImpl) DebugRun(ctx context.Context, rm *model.DebugRunMeta,
 
 	debugID = gonanoid.MustID(6)
 
-	devGraph, ok := ContainerSVC.GetDevGraph(rm.GraphID, rm.FromNode)
-	if !ok {
-		devGraph, err = ContainerSVC.CreateDevGraph(rm.GraphID, rm.FromNode)
+	var devGraph *model.Graph
+	if len(rm.MockNodes) > 0 {
+		devGraph, err = ContainerSVC.CreateMockedDevGraph(rm.GraphID, rm.FromNode, rm.MockNodes)
 		if err != nil {
-			return "", nil, nil, fmt.Errorf("create runnable failed, err=%w", err)
+			return "", nil, nil, fmt.Errorf("create mocked runnable failed, err=%w", err)
+		}
+	} else {
+		devGraph, ok = ContainerSVC.GetDevGraph(rm.GraphID, rm.FromNode)
+		if !ok {
+			devGraph, err = ContainerSVC.CreateDevGraph(rm.GraphID, rm.FromNode)
+			if err != nil {
+				return "", nil, nil, fmt.Errorf("create runnable failed, err=%w", err)
+			}
 		}
 	}
 
@@ -110,27 +138,86 @@ func (d *debugServiceImpl) DebugRun(ctx context.Context, rm *model.DebugRunMeta,
 		return "", nil, nil, err
 	}
 
-	stateCh = make(chan *model.NodeDebugState, 100)
+	var bps *breakpoints
+	if len(rm.Breakpoints) > 0 {
+		for _, node := range rm.Breakpoints {
+			if _, ok := devGraph.GraphInfo.Nodes[node]; !ok {
+				return "", nil, nil, fmt.Errorf("breakpoint node %s not found", node)
+			}
+		}
+		bps = newBreakpoints(rm.Breakpoints,
+			func() { d.setDebugRunStatus(debugID, model.DebugRunPaused) },
+			func() { d.setDebugRunStatus(debugID, model.DebugRunRunning) })
+	}
+
+	// node states are recorded to the trace of the run before being sent to stateCh
+	nodeStateCh := make(chan *model.NodeDebugState, 100)
 
-	opts, err := d.getInvokeOptions(devGraph.GraphInfo, rm.ThreadID, stateCh)
+	streamMode := rm.Mode == model.DebugRunModeStream
+	opts, err := d.getInvokeOptions(devGraph.GraphInfo, rm.ThreadID, nodeStateCh, streamMode, bps)
 	if err != nil {
-		close(stateCh)
+		close(nodeStateCh)
 		return "", nil, nil, fmt.Errorf("get invoke option failed, err=%w", err)
 	}
 
-	errCh = make(chan error, 1)
+	d.addDebugRun(&model.DebugRunRecord{
+		DebugID:     debugID,
+		GraphID:     rm.GraphID,
+		ThreadID:    rm.ThreadID,
+		FromNode:    rm.FromNode,
+		Input:       userInput,
+		Mode:        rm.Mode,
+		MockNodes:   mockNodeKeys(rm.MockNodes),
+		Breakpoints: rm.Breakpoints,
+		Status:      model.DebugRunRunning,
+		StartTimeMS: time.Now().UnixMilli(),
+	})
+
+	stateCh = make(chan *model.NodeDebugState, 100)
 	safego.Go(ctx, func() {
 		defer close(stateCh)
+		for state := range nodeStateCh {
+			if !state.IsChunk() && !state.Paused {
+				d.addNodeState(debugID, state)
+			}
+			stateCh <- state
+		}
+	})
+
+	if bps != nil {
+		d.runsMu.Lock()
+		d.breakpoints[debugID] = bps
+		d.runsMu.Unlock()
+	}
+
+	errCh = make(chan error, 1)
+	safego.Go(ctx, func() {
+		defer close(nodeStateCh)
 		defer close(errCh)
+		defer func() {
+			d.runsMu.Lock()
+			delete(d.breakpoints, debugID)
+			d.runsMu.Unlock()
+		}()
 
 		r, e := devGraph.Compile()
 		if e != nil {
+			d.finishDebugRun(debugID, e)
 			errCh <- e
 			log.Errorf("Compile failed, fromNode=%s\nerr=%s", rm.FromNode, e)
 			return
 		}
 
-		_, e = r.Invoke(ctx, input, opts...)
+		if streamMode {
+			var sr *schema.StreamReader[any]
+			sr, e = r.Stream(ctx, input, opts...)
+			if e == nil {
+				e = sendOutputChunks(sr, nodeStateCh)
+			}
+		} else {
+			_, e = r.Invoke(ctx, input, opts...)
+		}
+		d.finishDebugRun(debugID, e)
 		if e != nil {
 			errCh <- e
 			log.Errorf("invoke failed, userInput=%s\nerr=%s", userInput, e)
@@ -141,11 +228,144 @@ func (d *debugServiceImpl) DebugRun(ctx context.Context, rm *model.DebugRunMeta,
 	return debugID, stateCh, errCh, nil
 }
 
-func (d *debugServiceImpl) getInvokeOptions(gi *model.GraphInfo, threadID string, stateCh chan *model.NodeDebugState) (opts []compose.Option, err error) {
+func (d *debugServiceImpl) getInvokeOptions(gi *model.GraphInfo, threadID string, stateCh chan *model.NodeDebugState,
+	streamChunks bool, bps *breakpoints) (opts []compose.Option, err error) {
 	opts = make([]compose.Option, 0, len(gi.Nodes))
 	for key, node := range gi.Nodes {
-		opts = append(opts, newCallbackOption(key, threadID, node, stateCh))
+		opts = append(opts, newCallbackOption(key, threadID, node, stateCh, streamChunks, bps))
 	}
 
 	return opts, nil
 }
+
+func mockNodeKeys(mockNodes map[string]string) []string {
+	if len(mockNodes) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(mockNodes))
+	for key := range mockNodes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sendOutputChunks sends the chunks of the graph output as chunks of the end node.
+func sendOutputChunks(sr *schema.StreamReader[any], stateCh chan *model.NodeDebugState) error {
+	defer sr.Close()
+	for i := 0; ; i++ {
+		chunk, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		jsonChunk, err := json.Marshal(chunk)
+		if err != nil {
+			return fmt.Errorf("marshal output chunk failed, err=%w", err)
+		}
+		stateCh <- &model.NodeDebugState{
+			NodeKey:    compose.END,
+			Chunk:      string(jsonChunk),
+			ChunkIndex: i,
+		}
+	}
+}
+
+func (d *debugServiceImpl) ListDebugRuns(ctx context.Context, graphID string) (runs []*model.DebugRunRecord) {
+	d.runsMu.RLock()
+	defer d.runsMu.RUnlock()
+
+	runs = make([]*model.DebugRunRecord, 0, len(d.debugRuns))
+	// latest first
+	for i := len(d.debugRuns) - 1; i >= 0; i-- {
+		if d.debugRuns[i].GraphID == graphID {
+			runs = append(runs, copyDebugRun(d.debugRuns[i]))
+		}
+	}
+	return runs
+}
+
+func (d *debugServiceImpl) GetDebugRun(ctx context.Context, debugID string) (run *model.DebugRunRecord, exist bool) {
+	d.runsMu.RLock()
+	defer d.runsMu.RUnlock()
+
+	if r := d.findDebugRun(debugID); r != nil {
+		return copyDebugRun(r), true
+	}
+	return nil, false
+}
+
+func (d *debugServiceImpl) addDebugRun(run *model.DebugRunRecord) {
+	d.runsMu.Lock()
+	defer d.runsMu.Unlock()
+
+	if len(d.debugRuns) >= maxDebugRuns {
+		d.debugRuns = append(d.debugRuns[:0], d.debugRuns[len(d.debugRuns)-maxDebugRuns+1:]...)
+	}
+	d.debugRuns = append(d.debugRuns, run)
+}
+
+func (d *debugServiceImpl) ResumeDebugRun(ctx context.Context, debugID string, cmd *model.DebugRunResume) (err error) {
+	d.runsMu.RLock()
+	bps := d.breakpoints[debugID]
+	d.runsMu.RUnlock()
+	if bps == nil {
+		return fmt.Errorf("debug run=%s is not running with breakpoints", debugID)
+	}
+
+	return bps.resume(ctx, cmd)
+}
+
+func (d *debugServiceImpl) addNodeState(debugID string, state *model.NodeDebugState) {
+	d.runsMu.Lock()
+	defer d.runsMu.Unlock()
+
+	if r := d.findDebugRun(debugID); r != nil {
+		r.Nodes = append(r.Nodes, state)
+	}
+}
+
+func (d *debugServiceImpl) finishDebugRun(debugID string, err error) {
+	d.runsMu.Lock()
+	defer d.runsMu.Unlock()
+
+	r := d.findDebugRun(debugID)
+	if r == nil {
+		return
+	}
+	r.EndTimeMS = time.Now().UnixMilli()
+	r.Status = model.DebugRunSuccess
+	if err != nil {
+		r.Status = model.DebugRunFailed
+		r.Error = err.Error()
+	}
+}
+
+func (d *debugServiceImpl) setDebugRunStatus(debugID string, status model.DebugRunStatus) {
+	d.runsMu.Lock()
+	defer d.runsMu.Unlock()
+
+	if r := d.findDebugRun(debugID); r != nil {
+		r.Status = status
+	}
+}
+
+// findDebugRun must be called with runsMu held.
+func (d *debugServiceImpl) findDebugRun(debugID string) *model.DebugRunRecord {
+	for i := len(d.debugRuns) - 1; i >= 0; i-- {
+		if d.debugRuns[i].DebugID == debugID {
+			return d.debugRuns[i]
+		}
+	}
+	return nil
+}
+
+func copyDebugRun(r *model.DebugRunRecord) *model.DebugRunRecord {
+	cp := *r
+	cp.Nodes = make([]*model.NodeDebugState, len(r.Nodes))
+	copy(cp.Nodes, r.Nodes)
+	return &cp
+}
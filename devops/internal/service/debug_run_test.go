@@ -17,10 +17,14 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/cloudwego/eino-ext/devops/internal/model"
 	"github.com/cloudwego/eino/compose"
+	"github.com/cloudwego/eino/schema"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -45,7 +49,75 @@ func Test_debugServiceImpl_getInvokeOptions(t *testing.T) {
 	svc := newDebugService()
 	impl, ok := svc.(*debugServiceImpl)
 	assert.True(t, ok)
-	opts, err := impl.getInvokeOptions(gi, "t1", nil)
+	opts, err := impl.getInvokeOptions(gi, "t1", nil, false, nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, opts)
 }
+
+func Test_debugServiceImpl_debugRuns(t *testing.T) {
+	ctx := context.Background()
+	svc := newDebugService()
+	impl, ok := svc.(*debugServiceImpl)
+	assert.True(t, ok)
+
+	impl.addDebugRun(&model.DebugRunRecord{DebugID: "d1", GraphID: "g1", Status: model.DebugRunRunning, StartTimeMS: 1})
+	impl.addDebugRun(&model.DebugRunRecord{DebugID: "d2", GraphID: "g1", Status: model.DebugRunRunning, StartTimeMS: 2})
+	impl.addDebugRun(&model.DebugRunRecord{DebugID: "d3", GraphID: "g2", Status: model.DebugRunRunning, StartTimeMS: 3})
+
+	impl.addNodeState("d1", &model.NodeDebugState{NodeKey: "node1"})
+	impl.addNodeState("d1", &model.NodeDebugState{NodeKey: "node2"})
+	impl.finishDebugRun("d1", nil)
+	impl.finishDebugRun("d2", errors.New("mock error"))
+
+	runs := svc.ListDebugRuns(ctx, "g1")
+	assert.Len(t, runs, 2)
+	assert.Equal(t, "d2", runs[0].DebugID)
+	assert.Equal(t, model.DebugRunFailed, runs[0].Status)
+	assert.Equal(t, "mock error", runs[0].Error)
+
+	run, ok := svc.GetDebugRun(ctx, "d1")
+	assert.True(t, ok)
+	assert.Equal(t, model.DebugRunSuccess, run.Status)
+	assert.Len(t, run.Nodes, 2)
+	assert.Equal(t, "node2", run.Nodes[1].NodeKey)
+	assert.Greater(t, run.EndTimeMS, int64(0))
+
+	// the returned run is a copy
+	run.Nodes = append(run.Nodes, &model.NodeDebugState{})
+	run, _ = svc.GetDebugRun(ctx, "d1")
+	assert.Len(t, run.Nodes, 2)
+
+	_, ok = svc.GetDebugRun(ctx, "d4")
+	assert.False(t, ok)
+
+	for i := 0; i < maxDebugRuns; i++ {
+		impl.addDebugRun(&model.DebugRunRecord{DebugID: fmt.Sprintf("r%d", i), GraphID: "g3"})
+	}
+	assert.Len(t, impl.debugRuns, maxDebugRuns)
+	_, ok = svc.GetDebugRun(ctx, "d1")
+	assert.False(t, ok)
+	assert.Len(t, svc.ListDebugRuns(ctx, "g3"), maxDebugRuns)
+}
+
+func Test_sendOutputChunks(t *testing.T) {
+	stateCh := make(chan *model.NodeDebugState, 10)
+	sr := schema.StreamReaderFromArray([]any{"a", "b"})
+	err := sendOutputChunks(sr, stateCh)
+	assert.Nil(t, err)
+	close(stateCh)
+
+	var states []*model.NodeDebugState
+	for state := range stateCh {
+		states = append(states, state)
+	}
+	assert.Len(t, states, 2)
+	assert.True(t, states[0].IsChunk())
+	assert.Equal(t, compose.END, states[1].NodeKey)
+	assert.Equal(t, `"b"`, states[1].Chunk)
+	assert.Equal(t, 1, states[1].ChunkIndex)
+}
+
+func Test_mockNodeKeys(t *testing.T) {
+	assert.Nil(t, mockNodeKeys(nil))
+	assert.Equal(t, []string{"a", "b"}, mockNodeKeys(map[string]string{"b": `"b"`, "a": `"a"`}))
+}
@@ -47,3 +47,13 @@ func AppendType(value any) model.DevOption {
 		})
 	}
 }
+
+// WithAutoRegisterTypes registers the types discovered from the input and output types of every compiled graph and its nodes,
+// walking through struct fields recursively, as if they were registered by AppendType, default to false.
+// Interface types are never registered, since only concrete types can be chosen as implementations,
+// so implementations not referenced by any graph still need AppendType.
+func WithAutoRegisterTypes(enabled bool) model.DevOption {
+	return func(o *model.DevOpt) {
+		o.AutoRegisterTypes = enabled
+	}
+}
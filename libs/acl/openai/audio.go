@@ -0,0 +1,109 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"github.com/cloudwego/eino/schema"
+	"github.com/meguminnnnnnnnn/go-openai"
+)
+
+type Modality string
+
+const (
+	ModalityText  Modality = "text"
+	ModalityAudio Modality = "audio"
+)
+
+// AudioOutput configures the audio generated by the model when ModalityAudio is requested.
+type AudioOutput struct {
+	// Voice the model responds with, e.g. "alloy"
+	Voice string `json:"voice"`
+	// Format of the output audio, one of "wav", "mp3", "flac", "opus" and "pcm16".
+	// Streaming only supports "pcm16".
+	Format string `json:"format"`
+}
+
+const (
+	// ExtraKeyAudioID is the key of the id of the generated audio in schema.ChatMessageAudioURL.Extra.
+	ExtraKeyAudioID = "openai_audio_id"
+	// ExtraKeyAudioExpiresAt is the key of the unix timestamp after which the generated audio
+	// can no longer be referred to by its id, in schema.ChatMessageAudioURL.Extra.
+	ExtraKeyAudioExpiresAt = "openai_audio_expires_at"
+)
+
+func audioMIMEType(format string) string {
+	switch format {
+	case "mp3":
+		return "audio/mpeg"
+	case "pcm16":
+		return "audio/pcm"
+	default:
+		return "audio/" + format
+	}
+}
+
+// setResponseAudio maps the audio of the response to an audio part of msg, the audio data is kept
+// as a base64 data url, and the transcript becomes the content of msg.
+func setResponseAudio(msg *schema.Message, audio *openai.Audio, format string) {
+	if msg == nil || audio == nil {
+		return
+	}
+
+	if msg.Content == "" {
+		msg.Content = audio.Transcript
+	}
+
+	if audio.ID == "" && audio.Data == "" {
+		return
+	}
+
+	mimeType := audioMIMEType(format)
+	audioURL := &schema.ChatMessageAudioURL{
+		MIMEType: mimeType,
+		Extra:    make(map[string]any),
+	}
+	if audio.Data != "" {
+		audioURL.URL = "data:" + mimeType + ";base64," + audio.Data
+	}
+	if audio.ID != "" {
+		audioURL.Extra[ExtraKeyAudioID] = audio.ID
+	}
+	if audio.ExpiresAt != 0 {
+		audioURL.Extra[ExtraKeyAudioExpiresAt] = audio.ExpiresAt
+	}
+
+	msg.MultiContent = append(msg.MultiContent, schema.ChatMessagePart{
+		Type:     schema.ChatMessagePartTypeAudioURL,
+		AudioURL: audioURL,
+	})
+}
+
+// dropOutputAudio removes audio parts from assistant messages, since audio generated by the model
+// can't be sent back as input audio, the transcript in Content is sent instead.
+func dropOutputAudio(msg *schema.Message) []schema.ChatMessagePart {
+	if msg.Role != schema.Assistant {
+		return msg.MultiContent
+	}
+
+	var parts []schema.ChatMessagePart
+	for _, part := range msg.MultiContent {
+		if part.Type != schema.ChatMessagePartTypeAudioURL {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudioOutput(t *testing.T) {
+	t.Run("request", func(t *testing.T) {
+		var gotBody map[string]any
+		cli := newChatClient(t, &Config{
+			Model:      "gpt-4o-audio-preview",
+			Modalities: []Modality{ModalityText, ModalityAudio},
+			Audio:      &AudioOutput{Voice: "alloy", Format: "wav"},
+		}, func(req *http.Request, body map[string]any) *http.Response {
+			gotBody = body
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(strings.NewReader(`{"choices":[{"index":0,"message":{"role":"assistant","content":null,` +
+					`"audio":{"id":"audio_1","data":"UklGRg==","expires_at":1729018505,"transcript":"hello"}}}]}`)),
+			}
+		})
+
+		msg, err := cli.Generate(context.Background(), []*schema.Message{schema.UserMessage("hi")})
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"text", "audio"}, gotBody["modalities"])
+		assert.Equal(t, map[string]any{"voice": "alloy", "format": "wav"}, gotBody["audio"])
+
+		assert.Equal(t, "hello", msg.Content)
+		assert.Equal(t, []schema.ChatMessagePart{{
+			Type: schema.ChatMessagePartTypeAudioURL,
+			AudioURL: &schema.ChatMessageAudioURL{
+				URL:      "data:audio/wav;base64,UklGRg==",
+				MIMEType: "audio/wav",
+				Extra: map[string]any{
+					ExtraKeyAudioID:        "audio_1",
+					ExtraKeyAudioExpiresAt: int64(1729018505),
+				},
+			},
+		}}, msg.MultiContent)
+
+		// audio is not sent back as input audio
+		msg.MultiContent = append(msg.MultiContent, schema.ChatMessagePart{Type: schema.ChatMessagePartTypeText, Text: "hello"})
+		assert.Equal(t, []schema.ChatMessagePart{{Type: schema.ChatMessagePartTypeText, Text: "hello"}}, dropOutputAudio(msg))
+		user := &schema.Message{Role: schema.User, MultiContent: msg.MultiContent}
+		assert.Equal(t, msg.MultiContent, dropOutputAudio(user))
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		events := "data: {\"choices\":[{\"index\":0,\"delta\":{\"role\":\"assistant\",\"audio\":{\"id\":\"audio_1\",\"transcript\":\"he\"}}}]}\n\n" +
+			": keep-alive\n\n" +
+			"data: {\"choices\":[{\"index\":0,\"delta\":{\"audio\":{\"data\":\"AAAA\",\"transcript\":\"llo\"}}}]}\n\n" +
+			"data: {\"choices\":[],\"usage\":{\"total_tokens\":10}}\n\n" +
+			"data: [DONE]\n\n"
+		cli := newChatClient(t, &Config{
+			Model:      "gpt-4o-audio-preview",
+			Modalities: []Modality{ModalityText, ModalityAudio},
+			Audio:      &AudioOutput{Voice: "alloy", Format: "pcm16"},
+		}, func(req *http.Request, body map[string]any) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+				Body:       io.NopCloser(iotest.OneByteReader(strings.NewReader(events))),
+			}
+		})
+
+		sr, err := cli.Stream(context.Background(), []*schema.Message{schema.UserMessage("hi")})
+		assert.NoError(t, err)
+		var msgs []*schema.Message
+		for {
+			msg, err := sr.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			assert.NoError(t, err)
+			msgs = append(msgs, msg)
+		}
+
+		assert.Len(t, msgs, 3)
+		assert.Equal(t, "he", msgs[0].Content)
+		assert.Equal(t, "audio_1", msgs[0].MultiContent[0].AudioURL.Extra[ExtraKeyAudioID])
+		assert.Equal(t, "llo", msgs[1].Content)
+		assert.Equal(t, "data:audio/pcm;base64,AAAA", msgs[1].MultiContent[0].AudioURL.URL)
+		assert.Empty(t, msgs[2].MultiContent)
+	})
+}
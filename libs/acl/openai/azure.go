@@ -0,0 +1,324 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAzureAuthorityHost = "https://login.microsoftonline.com"
+	defaultAzureResource      = "https://cognitiveservices.azure.com"
+	defaultAzureScope         = defaultAzureResource + "/.default"
+	defaultAzureIMDSEndpoint  = "http://169.254.169.254/metadata/identity/oauth2/token"
+
+	// azureTokenRefreshWindow is how long before expiry a token is refreshed
+	azureTokenRefreshWindow = 5 * time.Minute
+)
+
+// AzureAccessToken is an Azure AD (Microsoft Entra ID) access token.
+type AzureAccessToken struct {
+	Token string
+	// ExpiresOn is the expiry of the token, the token is requested on every call if it's zero
+	ExpiresOn time.Time
+}
+
+// AzureTokenCredential provides Azure AD access tokens of the Azure OpenAI Service scope,
+// i.e. "https://cognitiveservices.azure.com/.default". Tokens are cached by the client and refreshed
+// 5 minutes before they expire, so GetToken is only called when a new token is needed.
+type AzureTokenCredential interface {
+	GetToken(ctx context.Context) (*AzureAccessToken, error)
+}
+
+// AzureTokenCredentialFunc adapts a function to AzureTokenCredential, e.g. to use the credentials of azidentity:
+//
+//	cred, _ := azidentity.NewDefaultAzureCredential(nil)
+//	openai.AzureTokenCredentialFunc(func(ctx context.Context) (*openai.AzureAccessToken, error) {
+//		token, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{"https://cognitiveservices.azure.com/.default"}})
+//		if err != nil {
+//			return nil, err
+//		}
+//		return &openai.AzureAccessToken{Token: token.Token, ExpiresOn: token.ExpiresOn}, nil
+//	})
+type AzureTokenCredentialFunc func(ctx context.Context) (*AzureAccessToken, error)
+
+func (f AzureTokenCredentialFunc) GetToken(ctx context.Context) (*AzureAccessToken, error) {
+	return f(ctx)
+}
+
+type AzureClientSecretCredentialConfig struct {
+	// TenantID is the directory (tenant) id of the app registration
+	// Required
+	TenantID string
+	// ClientID is the application (client) id of the app registration
+	// Required
+	ClientID string
+	// ClientSecret is a client secret of the app registration
+	// Required
+	ClientSecret string
+
+	// AuthorityHost is the host of Azure AD, e.g. "https://login.microsoftonline.us" for Azure Government
+	// Optional. Default: "https://login.microsoftonline.com"
+	AuthorityHost string
+	// Scope is the scope of the requested tokens
+	// Optional. Default: "https://cognitiveservices.azure.com/.default"
+	Scope string
+	// HTTPClient is used to request tokens
+	// Optional. Default: http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// NewAzureClientSecretCredential creates a credential of the OAuth 2.0 client credentials flow of a service principal.
+func NewAzureClientSecretCredential(config *AzureClientSecretCredentialConfig) (AzureTokenCredential, error) {
+	if config == nil || config.TenantID == "" || config.ClientID == "" || config.ClientSecret == "" {
+		return nil, fmt.Errorf("tenant id, client id and client secret are required for azure client secret credential")
+	}
+
+	conf := *config
+	if conf.AuthorityHost == "" {
+		conf.AuthorityHost = defaultAzureAuthorityHost
+	}
+	if conf.Scope == "" {
+		conf.Scope = defaultAzureScope
+	}
+	if conf.HTTPClient == nil {
+		conf.HTTPClient = http.DefaultClient
+	}
+	return &azureClientSecretCredential{config: &conf}, nil
+}
+
+type azureClientSecretCredential struct {
+	config *AzureClientSecretCredentialConfig
+}
+
+func (c *azureClientSecretCredential) GetToken(ctx context.Context) (*AzureAccessToken, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.config.ClientID},
+		"client_secret": {c.config.ClientSecret},
+		"scope":         {c.config.Scope},
+	}
+	tokenURL := strings.TrimSuffix(c.config.AuthorityHost, "/") + "/" + url.PathEscape(c.config.TenantID) + "/oauth2/v2.0/token"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doAzureTokenRequest(c.config.HTTPClient, req)
+}
+
+type AzureManagedIdentityCredentialConfig struct {
+	// ClientID is the client id of a user-assigned managed identity
+	// Optional. Default: the system-assigned managed identity
+	ClientID string
+	// Resource is the resource of the requested tokens
+	// Optional. Default: "https://cognitiveservices.azure.com"
+	Resource string
+	// HTTPClient is used to request tokens
+	// Optional. Default: http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// NewAzureManagedIdentityCredential creates a credential of the managed identity of the Azure host.
+// Tokens are requested from the identity endpoint of App Service, Functions and Container Apps
+// if IDENTITY_ENDPOINT and IDENTITY_HEADER are set, otherwise from the instance metadata service of
+// virtual machines and AKS. config can be nil for the system-assigned managed identity.
+func NewAzureManagedIdentityCredential(config *AzureManagedIdentityCredentialConfig) (AzureTokenCredential, error) {
+	conf := AzureManagedIdentityCredentialConfig{}
+	if config != nil {
+		conf = *config
+	}
+	if conf.Resource == "" {
+		conf.Resource = defaultAzureResource
+	}
+	if conf.HTTPClient == nil {
+		conf.HTTPClient = http.DefaultClient
+	}
+	return &azureManagedIdentityCredential{config: &conf}, nil
+}
+
+type azureManagedIdentityCredential struct {
+	config *AzureManagedIdentityCredentialConfig
+}
+
+func (c *azureManagedIdentityCredential) GetToken(ctx context.Context) (*AzureAccessToken, error) {
+	endpoint, header := os.Getenv("IDENTITY_ENDPOINT"), os.Getenv("IDENTITY_HEADER")
+	byIdentityEndpoint := endpoint != "" && header != ""
+
+	query := url.Values{"resource": {c.config.Resource}}
+	if c.config.ClientID != "" {
+		query.Set("client_id", c.config.ClientID)
+	}
+	if byIdentityEndpoint {
+		query.Set("api-version", "2019-08-01")
+	} else {
+		endpoint = defaultAzureIMDSEndpoint
+		query.Set("api-version", "2018-02-01")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	if byIdentityEndpoint {
+		req.Header.Set("X-IDENTITY-HEADER", header)
+	} else {
+		req.Header.Set("Metadata", "true")
+	}
+
+	return doAzureTokenRequest(c.config.HTTPClient, req)
+}
+
+type azureTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	// ExpiresIn is a number of Azure AD, and a string of managed identity endpoints
+	ExpiresIn json.RawMessage `json:"expires_in"`
+	// ExpiresOn is the unix time of the expiry, only returned by managed identity endpoints
+	ExpiresOn json.RawMessage `json:"expires_on"`
+}
+
+func doAzureTokenRequest(cli *http.Client, req *http.Request) (*AzureAccessToken, error) {
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request azure token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read azure token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request azure token, status=%d, body=%s", resp.StatusCode, body)
+	}
+
+	tokenResp := &azureTokenResponse{}
+	if err = json.Unmarshal(body, tokenResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal azure token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("empty access token in azure token response")
+	}
+
+	token := &AzureAccessToken{Token: tokenResp.AccessToken}
+	if expiresOn, ok := parseJSONInt(tokenResp.ExpiresOn); ok {
+		token.ExpiresOn = time.Unix(expiresOn, 0)
+	} else if expiresIn, ok := parseJSONInt(tokenResp.ExpiresIn); ok {
+		token.ExpiresOn = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// parseJSONInt parses an integer which may be encoded as a json number or string.
+func parseJSONInt(raw json.RawMessage) (int64, bool) {
+	s := strings.Trim(string(raw), `"`)
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// azureTokenCache caches the token of a credential until it's about to expire.
+type azureTokenCache struct {
+	cred AzureTokenCredential
+
+	mu    sync.Mutex
+	token *AzureAccessToken
+	now   func() time.Time
+}
+
+func newAzureTokenCache(cred AzureTokenCredential) *azureTokenCache {
+	return &azureTokenCache{cred: cred, now: time.Now}
+}
+
+func (c *azureTokenCache) get(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if c.token != nil && now.Add(azureTokenRefreshWindow).Before(c.token.ExpiresOn) {
+		return c.token.Token, nil
+	}
+
+	token, err := c.cred.GetToken(ctx)
+	if err != nil {
+		// the cached token is still usable if it fails to refresh before the expiry
+		if c.token != nil && now.Before(c.token.ExpiresOn) {
+			return c.token.Token, nil
+		}
+		return "", err
+	}
+	if token == nil || token.Token == "" {
+		return "", fmt.Errorf("empty azure access token")
+	}
+
+	if !token.ExpiresOn.IsZero() {
+		c.token = token
+	}
+	return token.Token, nil
+}
+
+// azureADTransport authenticates requests with the Azure AD token instead of the api key.
+type azureADTransport struct {
+	base   http.RoundTripper
+	tokens *azureTokenCache
+}
+
+func (t *azureADTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	token, err := t.tokens.get(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get azure ad token: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Del("api-key")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return base.RoundTrip(req)
+}
+
+// azureModelMapper maps models to the deployments, models not in deployments are mapped by fallback.
+func azureModelMapper(deployments map[string]string, fallback func(model string) string) func(model string) string {
+	return func(model string) string {
+		if deployment, ok := deployments[model]; ok {
+			return deployment
+		}
+		if fallback == nil {
+			return model
+		}
+		return fallback(model)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureTokenCache(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	var (
+		calls int
+		err   error
+	)
+	cache := newAzureTokenCache(AzureTokenCredentialFunc(func(ctx context.Context) (*AzureAccessToken, error) {
+		calls++
+		if err != nil {
+			return nil, err
+		}
+		return &AzureAccessToken{Token: fmt.Sprintf("token%d", calls), ExpiresOn: now.Add(time.Hour)}, nil
+	}))
+	cache.now = func() time.Time { return now }
+
+	token, e := cache.get(context.Background())
+	assert.NoError(t, e)
+	assert.Equal(t, "token1", token)
+
+	// cached before the refresh window
+	now = now.Add(50 * time.Minute)
+	token, e = cache.get(context.Background())
+	assert.NoError(t, e)
+	assert.Equal(t, "token1", token)
+	assert.Equal(t, 1, calls)
+
+	// the cached token is used if it fails to refresh before the expiry
+	now = now.Add(6 * time.Minute)
+	err = errors.New("unavailable")
+	token, e = cache.get(context.Background())
+	assert.NoError(t, e)
+	assert.Equal(t, "token1", token)
+
+	now = now.Add(5 * time.Minute)
+	_, e = cache.get(context.Background())
+	assert.Error(t, e)
+
+	err = nil
+	token, e = cache.get(context.Background())
+	assert.NoError(t, e)
+	assert.Equal(t, "token4", token)
+
+	// tokens without expiry are not cached
+	cache = newAzureTokenCache(AzureTokenCredentialFunc(func(ctx context.Context) (*AzureAccessToken, error) {
+		calls++
+		return &AzureAccessToken{Token: "static"}, nil
+	}))
+	calls = 0
+	_, _ = cache.get(context.Background())
+	_, _ = cache.get(context.Background())
+	assert.Equal(t, 2, calls)
+}
+
+func TestAzureClientSecretCredential(t *testing.T) {
+	_, err := NewAzureClientSecretCredential(&AzureClientSecretCredentialConfig{TenantID: "tenant"})
+	assert.Error(t, err)
+
+	cred, err := NewAzureClientSecretCredential(&AzureClientSecretCredentialConfig{
+		TenantID:     "tenant",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://login.microsoftonline.com/tenant/oauth2/v2.0/token", req.URL.String())
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			form, err := url.ParseQuery(string(body))
+			assert.NoError(t, err)
+			assert.Equal(t, "client_credentials", form.Get("grant_type"))
+			assert.Equal(t, "client", form.Get("client_id"))
+			assert.Equal(t, "secret", form.Get("client_secret"))
+			assert.Equal(t, "https://cognitiveservices.azure.com/.default", form.Get("scope"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"token_type":"Bearer","expires_in":3599,"access_token":"aad_token"}`)),
+			}, nil
+		})},
+	})
+	assert.NoError(t, err)
+
+	token, err := cred.GetToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "aad_token", token.Token)
+	assert.WithinDuration(t, time.Now().Add(3599*time.Second), token.ExpiresOn, time.Minute)
+}
+
+func TestAzureManagedIdentityCredential(t *testing.T) {
+	t.Run("imds", func(t *testing.T) {
+		t.Setenv("IDENTITY_ENDPOINT", "")
+		t.Setenv("IDENTITY_HEADER", "")
+
+		cred, err := NewAzureManagedIdentityCredential(&AzureManagedIdentityCredentialConfig{
+			ClientID: "client",
+			HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "169.254.169.254", req.URL.Host)
+				assert.Equal(t, "true", req.Header.Get("Metadata"))
+				assert.Equal(t, "https://cognitiveservices.azure.com", req.URL.Query().Get("resource"))
+				assert.Equal(t, "client", req.URL.Query().Get("client_id"))
+				assert.Equal(t, "2018-02-01", req.URL.Query().Get("api-version"))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"access_token":"mi_token","expires_in":"86399","expires_on":"1700086400"}`)),
+				}, nil
+			})},
+		})
+		assert.NoError(t, err)
+
+		token, err := cred.GetToken(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, &AzureAccessToken{Token: "mi_token", ExpiresOn: time.Unix(1700086400, 0)}, token)
+	})
+
+	t.Run("identity endpoint", func(t *testing.T) {
+		t.Setenv("IDENTITY_ENDPOINT", "http://localhost:42356/msi/token")
+		t.Setenv("IDENTITY_HEADER", "secret_header")
+
+		cred, err := NewAzureManagedIdentityCredential(&AzureManagedIdentityCredentialConfig{
+			HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "localhost:42356", req.URL.Host)
+				assert.Equal(t, "secret_header", req.Header.Get("X-IDENTITY-HEADER"))
+				assert.Equal(t, "2019-08-01", req.URL.Query().Get("api-version"))
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(strings.NewReader(`{"error":"invalid_request"}`)),
+				}, nil
+			})},
+		})
+		assert.NoError(t, err)
+
+		_, err = cred.GetToken(context.Background())
+		assert.ErrorContains(t, err, "status=400")
+	})
+}
+
+func TestAzureADClient(t *testing.T) {
+	var tokenCalls int
+	cli, err := NewClient(context.Background(), &Config{
+		ByAzure:    true,
+		BaseURL:    "https://my-resource.openai.azure.com",
+		APIVersion: "2024-06-01",
+		AzureTokenCredential: AzureTokenCredentialFunc(func(ctx context.Context) (*AzureAccessToken, error) {
+			tokenCalls++
+			return &AzureAccessToken{Token: "aad_token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+		}),
+		AzureDeployments: map[string]string{"gpt-4o": "prod-gpt-4o"},
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "Bearer aad_token", req.Header.Get("Authorization"))
+			assert.Empty(t, req.Header.Get("api-key"))
+			assert.Equal(t, "2024-06-01", req.URL.Query().Get("api-version"))
+			deployment := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/openai/deployments/"), "/chat/completions")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body: io.NopCloser(strings.NewReader(`{"id":"1","choices":[{"index":0,"finish_reason":"stop",` +
+					`"message":{"role":"assistant","content":"` + deployment + `"}}]}`)),
+			}, nil
+		})},
+		Model: "gpt-4o",
+	})
+	assert.NoError(t, err)
+
+	msg, err := cli.Generate(context.Background(), []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	assert.Equal(t, "prod-gpt-4o", msg.Content)
+
+	// models not in the deployments are mapped by default
+	msg, err = cli.Generate(context.Background(), []*schema.Message{schema.UserMessage("hi")}, model.WithModel("gpt-3.5-turbo"))
+	assert.NoError(t, err)
+	assert.Equal(t, "gpt-35-turbo", msg.Content)
+	assert.Equal(t, 1, tokenCalls)
+
+	_, err = NewClient(context.Background(), &Config{
+		AzureTokenCredential: AzureTokenCredentialFunc(func(ctx context.Context) (*AzureAccessToken, error) {
+			return nil, nil
+		}),
+	})
+	assert.Error(t, err)
+}
@@ -23,7 +23,9 @@ import (
 	"io"
 	"net/http"
 	"runtime/debug"
+	"strings"
 
+	"github.com/cloudwego/eino-ext/libs/acl/toolargs"
 	"github.com/cloudwego/eino/callbacks"
 	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/schema"
@@ -60,7 +62,7 @@ type ChatCompletionResponseFormatJSONSchema struct {
 type Config struct {
 	// APIKey is your authentication key
 	// Use OpenAI API key or Azure API key depending on the service
-	// Required
+	// Required, unless AzureTokenCredential is set
 	APIKey string `json:"api_key"`
 
 	// HTTPClient is used to send HTTP requests
@@ -83,6 +85,17 @@ type Config struct {
 	// Required for Azure
 	APIVersion string `json:"api_version"`
 
+	// AzureTokenCredential authenticates with Azure AD (Microsoft Entra ID) tokens instead of APIKey, e.g. by
+	// NewAzureManagedIdentityCredential or NewAzureClientSecretCredential. Tokens are refreshed before they expire.
+	// Optional for Azure
+	AzureTokenCredential AzureTokenCredential `json:"-"`
+
+	// AzureDeployments maps models to the names of the Azure deployments, e.g. {"gpt-4o": "my-gpt-4o"},
+	// models not in the map are mapped to deployments by removing "." and ":", e.g. "gpt-3.5-turbo" to "gpt-35-turbo".
+	// It also applies to models of model.WithModel.
+	// Optional for Azure
+	AzureDeployments map[string]string `json:"azure_deployments,omitempty"`
+
 	// The following fields correspond to OpenAI's chat completion API parameters
 	// Ref: https://platform.openai.com/docs/api-reference/chat/create
 
@@ -141,12 +154,59 @@ type Config struct {
 
 	// TopLogProbs specifies the number of most likely tokens to return at each token position, each with an associated log probability.
 	TopLogProbs int `json:"top_log_probs"`
+
+	// ParallelToolCalls specifies whether the model may call multiple tools in one response.
+	// Set it to false to get at most one tool call per response. Only sent when tools are provided.
+	// Optional. Default: decided by the model service, true for OpenAI
+	ParallelToolCalls *bool `json:"parallel_tool_calls,omitempty"`
+
+	// StrictTools sends all tools with strict: true, so that arguments of tool calls always match the parameters schema.
+	// Strict schemas require all fields to be required and no additional properties.
+	// Ref: https://platform.openai.com/docs/guides/function-calling#strict-mode
+	// Optional. Default: false
+	StrictTools bool `json:"strict_tools"`
+
+	// Modalities are the types of output the model generates, e.g. []Modality{ModalityText, ModalityAudio}
+	// for audio output of gpt-4o-audio-preview. The generated audio is returned as an audio part of
+	// MultiContent, and its transcript as Content.
+	// Optional. Default: text only
+	Modalities []Modality `json:"modalities,omitempty"`
+
+	// Audio configures the voice and format of the output audio.
+	// Required when Modalities contains ModalityAudio.
+	Audio *AudioOutput `json:"audio,omitempty"`
+
+	// UseResponsesAPI sends requests to the Responses API instead of chat completions, which supports built-in tools
+	// and reasoning summaries. Stop, Seed, PresencePenalty, FrequencyPenalty, LogitBias, LogProbs, TopLogProbs,
+	// Modalities and Audio are not supported by Responses API and ignored. Azure OpenAI Service is not supported.
+	// Ref: https://platform.openai.com/docs/api-reference/responses
+	// Optional. Default: false
+	UseResponsesAPI bool `json:"use_responses_api"`
+
+	// BuiltInTools are the tools run by OpenAI, e.g. web_search_preview and file_search, sent along with the bound tools.
+	// Only for UseResponsesAPI.
+	// Optional.
+	BuiltInTools []*BuiltInTool `json:"built_in_tools,omitempty"`
+
+	// Reasoning configures the effort and summary of reasoning models.
+	// Only for UseResponsesAPI.
+	// Optional.
+	Reasoning *Reasoning `json:"reasoning,omitempty"`
+
+	// RepairToolCallArguments repairs the arguments of tool calls in Stream, so that the concatenated arguments are
+	// valid json even if the model streams malformed fragments, e.g. trailing garbage or unclosed objects.
+	// Optional. Default: false, tool calls are streamed as they are
+	RepairToolCallArguments bool `json:"repair_tool_call_arguments,omitempty"`
 }
 
 type Client struct {
 	cli    *openai.Client
 	config *Config
 
+	// httpClient and responsesBaseURL are used to send requests of Responses API, which go-openai doesn't support yet
+	httpClient       *http.Client
+	responsesBaseURL string
+
 	tools      []tool
 	rawTools   []*schema.ToolInfo
 	toolChoice *schema.ToolChoice
@@ -156,6 +216,9 @@ func NewClient(ctx context.Context, config *Config) (*Client, error) {
 	if config == nil {
 		return nil, fmt.Errorf("OpenAI client config cannot be nil")
 	}
+	if config.UseResponsesAPI && config.ByAzure {
+		return nil, fmt.Errorf("responses api is not supported for Azure OpenAI Service")
+	}
 
 	var clientConf openai.ClientConfig
 
@@ -164,21 +227,41 @@ func NewClient(ctx context.Context, config *Config) (*Client, error) {
 		if config.APIVersion != "" {
 			clientConf.APIVersion = config.APIVersion
 		}
+		if config.AzureTokenCredential != nil {
+			clientConf.APIType = openai.APITypeAzureAD
+		}
+		if len(config.AzureDeployments) > 0 {
+			clientConf.AzureModelMapperFunc = azureModelMapper(config.AzureDeployments, clientConf.AzureModelMapperFunc)
+		}
 	} else {
+		if config.AzureTokenCredential != nil {
+			return nil, fmt.Errorf("azure token credential is only supported for Azure OpenAI Service")
+		}
 		clientConf = openai.DefaultConfig(config.APIKey)
 		if len(config.BaseURL) > 0 {
 			clientConf.BaseURL = config.BaseURL
 		}
 	}
 
-	clientConf.HTTPClient = config.HTTPClient
-	if clientConf.HTTPClient == nil {
-		clientConf.HTTPClient = http.DefaultClient
+	httpClient := http.Client{}
+	if config.HTTPClient != nil {
+		httpClient = *config.HTTPClient
+	}
+	if config.AzureTokenCredential != nil {
+		httpClient.Transport = &azureADTransport{base: httpClient.Transport, tokens: newAzureTokenCache(config.AzureTokenCredential)}
+	}
+	clientConf.HTTPClient = &httpClient
+
+	responsesBaseURL := defaultResponsesBaseURL
+	if len(config.BaseURL) > 0 {
+		responsesBaseURL = strings.TrimSuffix(config.BaseURL, "/")
 	}
 
 	return &Client{
-		cli:    openai.NewClientWithConfig(clientConf),
-		config: config,
+		cli:              openai.NewClientWithConfig(clientConf),
+		config:           config,
+		httpClient:       &httpClient,
+		responsesBaseURL: responsesBaseURL,
 	}, nil
 }
 
@@ -321,6 +404,12 @@ func (c *Client) genRequest(in []*schema.Message, opts ...model.Option) (*openai
 		Tools:       nil,
 		ToolChoice:  c.toolChoice,
 	}, opts...)
+	specOptions := model.GetImplSpecificOptions(&openaiOptions{
+		ResponseFormat:    c.config.ResponseFormat,
+		ParallelToolCalls: c.config.ParallelToolCalls,
+		Modalities:        c.config.Modalities,
+		Audio:             c.config.Audio,
+	}, opts...)
 
 	req := &openai.ChatCompletionRequest{
 		Model:            *options.Model,
@@ -336,6 +425,9 @@ func (c *Client) genRequest(in []*schema.Message, opts ...model.Option) (*openai
 		LogProbs:         c.config.LogProbs,
 		TopLogProbs:      c.config.TopLogProbs,
 	}
+	if fields := requestExtraFields(specOptions); len(fields) > 0 {
+		req.SetExtraFields(fields)
+	}
 
 	cbInput := &model.CallbackInput{
 		Messages: in,
@@ -369,9 +461,14 @@ func (c *Client) genRequest(in []*schema.Message, opts ...model.Option) (*openai
 					Name:        t.Function.Name,
 					Description: t.Function.Description,
 					Parameters:  t.Function.Parameters,
+					Strict:      c.config.StrictTools || specOptions.StrictTools[t.Function.Name],
 				},
 			}
 		}
+
+		if specOptions.ParallelToolCalls != nil {
+			req.ParallelToolCalls = *specOptions.ParallelToolCalls
+		}
 	}
 
 	if options.ToolChoice != nil {
@@ -413,7 +510,7 @@ func (c *Client) genRequest(in []*schema.Message, opts ...model.Option) (*openai
 
 	msgs := make([]openai.ChatCompletionMessage, 0, len(in))
 	for _, inMsg := range in {
-		mc, e := toOpenAIMultiContent(inMsg.MultiContent)
+		mc, e := toOpenAIMultiContent(dropOutputAudio(inMsg))
 		if e != nil {
 			return nil, nil, e
 		}
@@ -431,16 +528,16 @@ func (c *Client) genRequest(in []*schema.Message, opts ...model.Option) (*openai
 
 	req.Messages = msgs
 
-	if c.config.ResponseFormat != nil {
+	if rf := specOptions.ResponseFormat; rf != nil {
 		req.ResponseFormat = &openai.ChatCompletionResponseFormat{
-			Type: openai.ChatCompletionResponseFormatType(c.config.ResponseFormat.Type),
+			Type: openai.ChatCompletionResponseFormatType(rf.Type),
 		}
-		if c.config.ResponseFormat.JSONSchema != nil {
+		if rf.JSONSchema != nil {
 			req.ResponseFormat.JSONSchema = &openai.ChatCompletionResponseFormatJSONSchema{
-				Name:        c.config.ResponseFormat.JSONSchema.Name,
-				Description: c.config.ResponseFormat.JSONSchema.Description,
-				Schema:      c.config.ResponseFormat.JSONSchema.Schema,
-				Strict:      c.config.ResponseFormat.JSONSchema.Strict,
+				Name:        rf.JSONSchema.Name,
+				Description: rf.JSONSchema.Description,
+				Schema:      rf.JSONSchema.Schema,
+				Strict:      rf.JSONSchema.Strict,
 			}
 		}
 	}
@@ -451,6 +548,10 @@ func (c *Client) genRequest(in []*schema.Message, opts ...model.Option) (*openai
 func (c *Client) Generate(ctx context.Context, in []*schema.Message, opts ...model.Option) (
 	outMsg *schema.Message, err error) {
 
+	if c.config.UseResponsesAPI {
+		return c.generateResponses(ctx, in, opts...)
+	}
+
 	req, cbInput, err := c.genRequest(in, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create chat completion request: %w", err)
@@ -463,7 +564,7 @@ func (c *Client) Generate(ctx context.Context, in []*schema.Message, opts ...mod
 		}
 	}()
 
-	resp, err := c.cli.CreateChatCompletion(ctx, *req)
+	resp, err := c.cli.CreateChatCompletion(ctx, *req, chatRequestOptions(opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create chat completion: %w", err)
 	}
@@ -490,6 +591,8 @@ func (c *Client) Generate(ctx context.Context, in []*schema.Message, opts ...mod
 				LogProbs:     toLogProbs(choice.LogProbs),
 			},
 		}
+		setCachedTokens(outMsg, &resp.Usage)
+		setResponseAudio(outMsg, msg.Audio, c.audioFormat(opts...))
 
 		break
 	}
@@ -508,6 +611,7 @@ func (c *Client) Generate(ctx context.Context, in []*schema.Message, opts ...mod
 		Message:    outMsg,
 		Config:     cbInput.Config,
 		TokenUsage: usage,
+		Extra:      toCallbackExtra(outMsg),
 	})
 
 	return outMsg, nil
@@ -516,6 +620,10 @@ func (c *Client) Generate(ctx context.Context, in []*schema.Message, opts ...mod
 func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 	opts ...model.Option) (outStream *schema.StreamReader[*schema.Message], err error) {
 
+	if c.config.UseResponsesAPI {
+		return c.streamResponses(ctx, in, opts...)
+	}
+
 	defer func() {
 		if err != nil {
 			callbacks.OnError(ctx, err)
@@ -532,10 +640,11 @@ func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 
 	ctx = callbacks.OnStart(ctx, cbInput)
 
-	stream, err := c.cli.CreateChatCompletionStream(ctx, *req)
+	stream, err := c.cli.CreateChatCompletionStream(ctx, *req, chatRequestOptions(opts...)...)
 	if err != nil {
 		return nil, err
 	}
+	audioFormat := c.audioFormat(opts...)
 
 	sr, sw := schema.Pipe[*model.CallbackOutput](1)
 	go func() {
@@ -551,15 +660,28 @@ func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 		}()
 
 		var lastEmptyMsg *schema.Message
+		var repairer *toolargs.StreamRepairer
+		if c.config.RepairToolCallArguments {
+			repairer = toolargs.NewStreamRepairer()
+		}
 
 		for {
 			chunk, chunkErr := stream.Recv()
 			if errors.Is(chunkErr, io.EOF) {
+				if repairer != nil {
+					if fin := repairer.Finish(); fin != nil {
+						sw.Send(&model.CallbackOutput{
+							Message: fin,
+							Config:  cbInput.Config,
+						}, nil)
+					}
+				}
 				if lastEmptyMsg != nil {
 					sw.Send(&model.CallbackOutput{
 						Message:    lastEmptyMsg,
 						Config:     cbInput.Config,
 						TokenUsage: toModelCallbackUsage(lastEmptyMsg.ResponseMeta),
+						Extra:      toCallbackExtra(lastEmptyMsg),
 					}, nil)
 				}
 				return
@@ -573,10 +695,14 @@ func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 			// stream usage return in last chunk without message content, then
 			// last message received from callback output stream: Message == nil and TokenUsage != nil
 			// last message received from outStream: Message != nil
-			msg, found := resolveStreamResponse(chunk)
+			msg, found := resolveStreamResponse(chunk, audioFormat)
 			if !found {
 				continue
 			}
+			// fragmented arguments can't be concatenated into valid json, e.g. with trailing garbage
+			if repairer != nil {
+				repairer.Process(msg)
+			}
 
 			// skip empty message
 			// when openai return parallel tool calls, first frame can be empty
@@ -591,7 +717,7 @@ func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 				msg = cMsg
 			}
 
-			if msg.Content == "" && len(msg.ToolCalls) == 0 {
+			if msg.Content == "" && len(msg.ToolCalls) == 0 && len(msg.MultiContent) == 0 {
 				lastEmptyMsg = msg
 				continue
 			}
@@ -602,6 +728,7 @@ func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 				Message:    msg,
 				Config:     cbInput.Config,
 				TokenUsage: toModelCallbackUsage(msg.ResponseMeta),
+				Extra:      toCallbackExtra(msg),
 			}, nil)
 
 			if closed {
@@ -630,6 +757,15 @@ func (c *Client) Stream(ctx context.Context, in []*schema.Message,
 	return outStream, nil
 }
 
+// audioFormat returns the format of the output audio, which is not carried by the audio of the response.
+func (c *Client) audioFormat(opts ...model.Option) string {
+	specOptions := model.GetImplSpecificOptions(&openaiOptions{Audio: c.config.Audio}, opts...)
+	if specOptions.Audio == nil {
+		return ""
+	}
+	return specOptions.Audio.Format
+}
+
 func toStreamProbs(probs *openai.ChatCompletionStreamChoiceLogprobs) *schema.LogProbs {
 	if probs == nil {
 		return nil
@@ -696,7 +832,7 @@ func byteSlice2int64(in []byte) []int64 {
 	return ret
 }
 
-func resolveStreamResponse(resp openai.ChatCompletionStreamResponse) (msg *schema.Message, found bool) {
+func resolveStreamResponse(resp openai.ChatCompletionStreamResponse, audioFormat string) (msg *schema.Message, found bool) {
 	for _, choice := range resp.Choices {
 		// take 0 index as response, rewrite if needed
 		if choice.Index != 0 {
@@ -714,6 +850,7 @@ func resolveStreamResponse(resp openai.ChatCompletionStreamResponse) (msg *schem
 				LogProbs:     toStreamProbs(choice.Logprobs),
 			},
 		}
+		setResponseAudio(msg, choice.Delta.Audio, audioFormat)
 
 		break
 	}
@@ -727,6 +864,10 @@ func resolveStreamResponse(resp openai.ChatCompletionStreamResponse) (msg *schem
 		found = true
 	}
 
+	if found {
+		setCachedTokens(msg, resp.Usage)
+	}
+
 	return msg, found
 }
 
@@ -17,12 +17,18 @@
 package openai
 
 import (
+	"context"
+	"errors"
+	"io"
 	"math/rand"
+	"net/http"
+	"strings"
 	"testing"
 
 	goopenai "github.com/meguminnnnnnnnn/go-openai"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/cloudwego/eino/components/model"
 	"github.com/cloudwego/eino/schema"
 )
 
@@ -134,6 +140,40 @@ func TestWithTools(t *testing.T) {
 	assert.Equal(t, "test tool name", ncm.rawTools[0].Name)
 }
 
+func TestToolCallOptions(t *testing.T) {
+	tools := []*schema.ToolInfo{{Name: "a"}, {Name: "b"}}
+	cli, err := (&Client{config: &Config{Model: "test model"}}).WithToolsForClient(tools)
+	assert.Nil(t, err)
+
+	req, _, err := cli.genRequest([]*schema.Message{schema.UserMessage("hi")})
+	assert.Nil(t, err)
+	assert.Nil(t, req.ParallelToolCalls)
+	assert.False(t, req.Tools[0].Function.Strict)
+	assert.False(t, req.Tools[1].Function.Strict)
+
+	req, _, err = cli.genRequest([]*schema.Message{schema.UserMessage("hi")},
+		WithParallelToolCalls(false), WithStrictTools("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, false, req.ParallelToolCalls)
+	assert.False(t, req.Tools[0].Function.Strict)
+	assert.True(t, req.Tools[1].Function.Strict)
+
+	parallel := true
+	cli.config.ParallelToolCalls = &parallel
+	cli.config.StrictTools = true
+	req, _, err = cli.genRequest([]*schema.Message{schema.UserMessage("hi")})
+	assert.Nil(t, err)
+	assert.Equal(t, true, req.ParallelToolCalls)
+	assert.True(t, req.Tools[0].Function.Strict)
+	assert.True(t, req.Tools[1].Function.Strict)
+
+	// parallel_tool_calls is only allowed when tools are provided
+	req, _, err = (&Client{config: cli.config}).genRequest([]*schema.Message{schema.UserMessage("hi")},
+		model.WithTools([]*schema.ToolInfo{}))
+	assert.Nil(t, err)
+	assert.Nil(t, req.ParallelToolCalls)
+}
+
 func TestLogProbs(t *testing.T) {
 	assert.Equal(t, &schema.LogProbs{Content: []schema.LogProb{
 		{
@@ -163,3 +203,38 @@ func TestLogProbs(t *testing.T) {
 		},
 	}}))
 }
+
+func TestStreamRepairToolCallArguments(t *testing.T) {
+	events := "data: {\"choices\":[{\"index\":0,\"delta\":{\"role\":\"assistant\",\"tool_calls\":[{\"index\":0,\"id\":\"call_1\",\"type\":\"function\",\"function\":{\"name\":\"get_weather\",\"arguments\":\"{\\\"city\\\":\"}}]}}]}\n\n" +
+		"data: {\"choices\":[{\"index\":0,\"delta\":{\"tool_calls\":[{\"index\":0,\"function\":{\"arguments\":\"\\\"Paris\\\"\"}}]},\"finish_reason\":\"tool_calls\"}]}\n\n" +
+		"data: [DONE]\n\n"
+
+	stream := func(repair bool) *schema.Message {
+		cli := newChatClient(t, &Config{Model: "gpt-4o", RepairToolCallArguments: repair},
+			func(req *http.Request, body map[string]any) *http.Response {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+					Body:       io.NopCloser(strings.NewReader(events)),
+				}
+			})
+		sr, err := cli.Stream(context.Background(), []*schema.Message{schema.UserMessage("weather of Paris?")})
+		assert.NoError(t, err)
+
+		var msgs []*schema.Message
+		for {
+			msg, err := sr.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			assert.NoError(t, err)
+			msgs = append(msgs, msg)
+		}
+		msg, err := schema.ConcatMessages(msgs)
+		assert.NoError(t, err)
+		return msg
+	}
+
+	assert.Equal(t, `{"city":"Paris"`, stream(false).ToolCalls[0].Function.Arguments)
+	assert.Equal(t, `{"city":"Paris"}`, stream(true).ToolCalls[0].Function.Arguments)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"github.com/cloudwego/eino/components/model"
+)
+
+type openaiOptions struct {
+	ResponseFormat    *ChatCompletionResponseFormat
+	PromptCacheKey    *string
+	ExtraHeaders      map[string]string
+	ParallelToolCalls *bool
+	StrictTools       map[string]bool
+	Modalities        []Modality
+	Audio             *AudioOutput
+}
+
+// WithResponseFormat overrides Config.ResponseFormat for a single request.
+func WithResponseFormat(format *ChatCompletionResponseFormat) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		o.ResponseFormat = format
+	})
+}
+
+// WithPromptCacheKey sets prompt_cache_key of the request, which helps providers
+// supporting prompt caching to route requests sharing long prefixes to the same cache.
+// see: https://platform.openai.com/docs/guides/prompt-caching
+func WithPromptCacheKey(key string) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		o.PromptCacheKey = &key
+	})
+}
+
+// WithExtraHeaders adds http headers to the request, e.g. cache control headers
+// required by some openai compatible services to enable prompt caching.
+func WithExtraHeaders(headers map[string]string) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		o.ExtraHeaders = headers
+	})
+}
+
+// WithParallelToolCalls overrides Config.ParallelToolCalls for a single request,
+// set it to false to get at most one tool call in the response.
+func WithParallelToolCalls(parallel bool) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		o.ParallelToolCalls = &parallel
+	})
+}
+
+// WithStrictTools sends the tools of the given names with strict: true for a single request,
+// in addition to all tools being strict when Config.StrictTools is enabled.
+func WithStrictTools(toolNames ...string) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		if o.StrictTools == nil {
+			o.StrictTools = make(map[string]bool, len(toolNames))
+		}
+		for _, name := range toolNames {
+			o.StrictTools[name] = true
+		}
+	})
+}
+
+// WithModalities overrides Config.Modalities for a single request.
+func WithModalities(modalities ...Modality) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		o.Modalities = modalities
+	})
+}
+
+// WithAudioOutput overrides Config.Audio for a single request.
+func WithAudioOutput(audio *AudioOutput) model.Option {
+	return model.WrapImplSpecificOptFn(func(o *openaiOptions) {
+		o.Audio = audio
+	})
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/meguminnnnnnnnn/go-openai"
+)
+
+// ExtraKeyCachedTokens is the key of cache-hit prompt tokens in schema.Message.Extra and model.CallbackOutput.Extra.
+const ExtraKeyCachedTokens = "openai_cached_tokens"
+
+// GetCachedTokens returns the number of prompt tokens served from the prompt cache.
+func GetCachedTokens(msg *schema.Message) (int, bool) {
+	if msg == nil || msg.Extra == nil {
+		return 0, false
+	}
+
+	tokens, ok := msg.Extra[ExtraKeyCachedTokens].(int)
+	return tokens, ok
+}
+
+func setCachedTokens(msg *schema.Message, usage *openai.Usage) {
+	if msg == nil || usage == nil || usage.PromptTokensDetails == nil || usage.PromptTokensDetails.CachedTokens == 0 {
+		return
+	}
+
+	if msg.Extra == nil {
+		msg.Extra = make(map[string]any)
+	}
+	msg.Extra[ExtraKeyCachedTokens] = usage.PromptTokensDetails.CachedTokens
+}
+
+func toCallbackExtra(msg *schema.Message) map[string]any {
+	tokens, ok := GetCachedTokens(msg)
+	if !ok {
+		return nil
+	}
+
+	return map[string]any{ExtraKeyCachedTokens: tokens}
+}
+
+// requestExtraFields returns the request fields go-openai doesn't define, which are sent as extra fields of the request.
+func requestExtraFields(specOptions *openaiOptions) map[string]any {
+	fields := make(map[string]any)
+	if specOptions.PromptCacheKey != nil {
+		fields["prompt_cache_key"] = *specOptions.PromptCacheKey
+	}
+	if len(specOptions.Modalities) > 0 {
+		fields["modalities"] = specOptions.Modalities
+	}
+	if specOptions.Audio != nil {
+		fields["audio"] = specOptions.Audio
+	}
+	return fields
+}
+
+func chatRequestOptions(opts ...model.Option) []openai.ChatCompletionRequestOption {
+	specOptions := model.GetImplSpecificOptions(&openaiOptions{}, opts...)
+	if len(specOptions.ExtraHeaders) == 0 {
+		return nil
+	}
+	return []openai.ChatCompletionRequestOption{openai.WithExtraHeader(specOptions.ExtraHeaders)}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	goopenai "github.com/meguminnnnnnnnn/go-openai"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newChatClient(t *testing.T, config *Config, handler func(req *http.Request, body map[string]any) *http.Response) *Client {
+	config.APIKey = "test"
+	config.BaseURL = "http://localhost/v1"
+	config.HTTPClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return handler(req, body), nil
+	})}
+	cli, err := NewClient(context.Background(), config)
+	assert.NoError(t, err)
+	return cli
+}
+
+func TestPromptCache(t *testing.T) {
+	var (
+		gotHeader string
+		gotBody   map[string]any
+	)
+	cli := newChatClient(t, &Config{Model: "gpt-4o"}, func(req *http.Request, body map[string]any) *http.Response {
+		gotHeader = req.Header.Get("X-Cache-Control")
+		gotBody = body
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body: io.NopCloser(strings.NewReader(`{"choices":[{"index":0,"message":{"role":"assistant","content":"hi"}}],` +
+				`"usage":{"prompt_tokens":100,"total_tokens":101,"prompt_tokens_details":{"cached_tokens":80}}}`)),
+		}
+	})
+
+	msg, err := cli.Generate(context.Background(), []*schema.Message{schema.UserMessage("hi")},
+		WithPromptCacheKey("cache_key"),
+		WithExtraHeaders(map[string]string{"X-Cache-Control": "ephemeral"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "ephemeral", gotHeader)
+	assert.Equal(t, "cache_key", gotBody["prompt_cache_key"])
+	tokens, ok := GetCachedTokens(msg)
+	assert.True(t, ok)
+	assert.Equal(t, 80, tokens)
+
+	// no extra options, nothing extra is sent
+	_, err = cli.Generate(context.Background(), []*schema.Message{schema.UserMessage("hi")})
+	assert.NoError(t, err)
+	assert.Empty(t, gotHeader)
+	assert.NotContains(t, gotBody, "prompt_cache_key")
+}
+
+func TestCachedTokens(t *testing.T) {
+	msg := &schema.Message{}
+	setCachedTokens(msg, &goopenai.Usage{PromptTokens: 100})
+	_, ok := GetCachedTokens(msg)
+	assert.False(t, ok)
+	assert.Nil(t, toCallbackExtra(msg))
+
+	setCachedTokens(msg, &goopenai.Usage{
+		PromptTokens:        100,
+		PromptTokensDetails: &goopenai.PromptTokensDetails{CachedTokens: 80},
+	})
+	tokens, ok := GetCachedTokens(msg)
+	assert.True(t, ok)
+	assert.Equal(t, 80, tokens)
+	assert.Equal(t, map[string]any{ExtraKeyCachedTokens: 80}, toCallbackExtra(msg))
+}
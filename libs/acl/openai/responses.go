@@ -0,0 +1,706 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	"github.com/cloudwego/eino-ext/libs/acl/toolargs"
+	"github.com/cloudwego/eino/callbacks"
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/meguminnnnnnnnn/go-openai"
+)
+
+const defaultResponsesBaseURL = "https://api.openai.com/v1"
+
+var sseDataPrefix = []byte("data: ")
+
+// BuiltInTool is a tool run by OpenAI in the Responses API, whose results are used by the model directly
+// instead of being returned as tool calls.
+// Ref: https://platform.openai.com/docs/guides/tools
+type BuiltInTool struct {
+	// Type of the tool, e.g. "web_search_preview", "file_search" or "code_interpreter"
+	Type string
+	// Params are the other fields of the tool, e.g. {"vector_store_ids": ["vs_123"]} for file_search
+	Params map[string]any
+}
+
+func (t *BuiltInTool) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(t.Params)+1)
+	for k, v := range t.Params {
+		m[k] = v
+	}
+	m["type"] = t.Type
+	return json.Marshal(m)
+}
+
+// Reasoning configures reasoning models in the Responses API.
+type Reasoning struct {
+	// Effort constrains effort on reasoning, one of "low", "medium" and "high"
+	Effort string `json:"effort,omitempty"`
+	// Summary requests a summary of the reasoning, one of "auto", "concise" and "detailed",
+	// which is returned as reasoning content.
+	Summary string `json:"summary,omitempty"`
+}
+
+const (
+	// ExtraKeyReasoningContent is the key of the reasoning summary in schema.Message.Extra, only for Responses API.
+	ExtraKeyReasoningContent = "openai_reasoning_content"
+	// ExtraKeyResponseID is the key of the id of the response in schema.Message.Extra, only for Responses API.
+	ExtraKeyResponseID = "openai_response_id"
+)
+
+// GetReasoningContent returns the reasoning summary of the message generated by Responses API.
+func GetReasoningContent(msg *schema.Message) (string, bool) {
+	if msg == nil || msg.Extra == nil {
+		return "", false
+	}
+	content, ok := msg.Extra[ExtraKeyReasoningContent].(string)
+	return content, ok
+}
+
+// GetResponseID returns the id of the response of the message generated by Responses API.
+func GetResponseID(msg *schema.Message) (string, bool) {
+	if msg == nil || msg.Extra == nil {
+		return "", false
+	}
+	id, ok := msg.Extra[ExtraKeyResponseID].(string)
+	return id, ok
+}
+
+type responsesRequest struct {
+	Model             string     `json:"model"`
+	Input             []any      `json:"input"`
+	MaxOutputTokens   *int       `json:"max_output_tokens,omitempty"`
+	Temperature       *float32   `json:"temperature,omitempty"`
+	TopP              *float32   `json:"top_p,omitempty"`
+	Tools             []any      `json:"tools,omitempty"`
+	ToolChoice        any        `json:"tool_choice,omitempty"`
+	ParallelToolCalls *bool      `json:"parallel_tool_calls,omitempty"`
+	Reasoning         *Reasoning `json:"reasoning,omitempty"`
+	Text              any        `json:"text,omitempty"`
+	User              string     `json:"user,omitempty"`
+	PromptCacheKey    string     `json:"prompt_cache_key,omitempty"`
+	Stream            bool       `json:"stream,omitempty"`
+}
+
+type responsesFunctionTool struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Parameters  any    `json:"parameters,omitempty"`
+	Strict      bool   `json:"strict"`
+}
+
+type responsesMessage struct {
+	Type    string `json:"type"`
+	Role    string `json:"role"`
+	Content any    `json:"content"`
+}
+
+type responsesContentPart struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+type responsesFunctionCall struct {
+	Type      string `json:"type"`
+	CallID    string `json:"call_id"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+type responsesFunctionCallOutput struct {
+	Type   string `json:"type"`
+	CallID string `json:"call_id"`
+	Output string `json:"output"`
+}
+
+type responsesResponse struct {
+	ID                string                `json:"id"`
+	Status            string                `json:"status"`
+	Error             *responsesError       `json:"error"`
+	IncompleteDetails *responsesIncomplete  `json:"incomplete_details"`
+	Output            []responsesOutputItem `json:"output"`
+	Usage             *responsesUsage       `json:"usage"`
+}
+
+type responsesError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type responsesIncomplete struct {
+	Reason string `json:"reason"`
+}
+
+type responsesOutputItem struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Role      string `json:"role"`
+	CallID    string `json:"call_id"`
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+	Content   []struct {
+		Type    string `json:"type"`
+		Text    string `json:"text"`
+		Refusal string `json:"refusal"`
+	} `json:"content"`
+	Summary []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"summary"`
+}
+
+type responsesUsage struct {
+	InputTokens        int `json:"input_tokens"`
+	OutputTokens       int `json:"output_tokens"`
+	TotalTokens        int `json:"total_tokens"`
+	InputTokensDetails struct {
+		CachedTokens int `json:"cached_tokens"`
+	} `json:"input_tokens_details"`
+}
+
+type responsesStreamEvent struct {
+	Type        string               `json:"type"`
+	OutputIndex int                  `json:"output_index"`
+	Delta       string               `json:"delta"`
+	Item        *responsesOutputItem `json:"item"`
+	Response    *responsesResponse   `json:"response"`
+	Code        string               `json:"code"`
+	Message     string               `json:"message"`
+}
+
+// genResponsesRequest builds the request of Responses API, fields of Config not supported by
+// Responses API, e.g. Stop, Seed and LogProbs, are ignored.
+func (c *Client) genResponsesRequest(in []*schema.Message, opts ...model.Option) (*responsesRequest, *model.CallbackInput, error) {
+	options := model.GetCommonOptions(&model.Options{
+		Temperature: c.config.Temperature,
+		MaxTokens:   c.config.MaxTokens,
+		Model:       &c.config.Model,
+		TopP:        c.config.TopP,
+		ToolChoice:  c.toolChoice,
+	}, opts...)
+	specOptions := model.GetImplSpecificOptions(&openaiOptions{
+		ResponseFormat:    c.config.ResponseFormat,
+		ParallelToolCalls: c.config.ParallelToolCalls,
+	}, opts...)
+
+	req := &responsesRequest{
+		Model:           *options.Model,
+		MaxOutputTokens: options.MaxTokens,
+		Temperature:     options.Temperature,
+		TopP:            options.TopP,
+		Reasoning:       c.config.Reasoning,
+		User:            dereferenceOrZero(c.config.User),
+		PromptCacheKey:  dereferenceOrZero(specOptions.PromptCacheKey),
+	}
+
+	cbInput := &model.CallbackInput{
+		Messages: in,
+		Tools:    c.rawTools,
+		Config: &model.Config{
+			Model:       req.Model,
+			MaxTokens:   dereferenceOrZero(req.MaxOutputTokens),
+			Temperature: dereferenceOrZero(req.Temperature),
+			TopP:        dereferenceOrZero(req.TopP),
+		},
+	}
+
+	tools := c.tools
+	if options.Tools != nil {
+		var err error
+		if tools, err = toTools(options.Tools); err != nil {
+			return nil, nil, err
+		}
+		cbInput.Tools = options.Tools
+	}
+
+	for _, t := range tools {
+		ft := &responsesFunctionTool{
+			Type:        string(openai.ToolTypeFunction),
+			Name:        t.Function.Name,
+			Description: t.Function.Description,
+			Strict:      c.config.StrictTools || specOptions.StrictTools[t.Function.Name],
+		}
+		// a nil *openapi3.Schema would be sent as null
+		if t.Function.Parameters != nil {
+			ft.Parameters = t.Function.Parameters
+		}
+		req.Tools = append(req.Tools, ft)
+	}
+	if len(req.Tools) > 0 {
+		req.ParallelToolCalls = specOptions.ParallelToolCalls
+	}
+	for _, t := range c.config.BuiltInTools {
+		req.Tools = append(req.Tools, t)
+	}
+
+	if options.ToolChoice != nil {
+		switch *options.ToolChoice {
+		case schema.ToolChoiceForbidden:
+			req.ToolChoice = toolChoiceNone
+		case schema.ToolChoiceAllowed:
+			req.ToolChoice = toolChoiceAuto
+		case schema.ToolChoiceForced:
+			if len(tools) == 0 {
+				return nil, nil, fmt.Errorf("tool choice is forced but tool is not provided")
+			} else if len(tools) > 1 {
+				req.ToolChoice = toolChoiceRequired
+			} else {
+				req.ToolChoice = map[string]any{"type": string(openai.ToolTypeFunction), "name": tools[0].Function.Name}
+			}
+		default:
+			return nil, nil, fmt.Errorf("tool choice=%s not support", *options.ToolChoice)
+		}
+	}
+
+	for _, inMsg := range in {
+		items, err := toResponsesInput(inMsg)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Input = append(req.Input, items...)
+	}
+
+	if rf := specOptions.ResponseFormat; rf != nil {
+		format := map[string]any{"type": string(rf.Type)}
+		if rf.JSONSchema != nil {
+			format["name"] = rf.JSONSchema.Name
+			format["schema"] = rf.JSONSchema.Schema
+			format["strict"] = rf.JSONSchema.Strict
+			if rf.JSONSchema.Description != "" {
+				format["description"] = rf.JSONSchema.Description
+			}
+		}
+		req.Text = map[string]any{"format": format}
+	}
+
+	return req, cbInput, nil
+}
+
+func toResponsesInput(msg *schema.Message) ([]any, error) {
+	switch msg.Role {
+	case schema.Tool:
+		return []any{&responsesFunctionCallOutput{
+			Type:   "function_call_output",
+			CallID: msg.ToolCallID,
+			Output: msg.Content,
+		}}, nil
+	case schema.Assistant:
+		var items []any
+		if msg.Content != "" {
+			items = append(items, &responsesMessage{
+				Type:    "message",
+				Role:    string(schema.Assistant),
+				Content: []*responsesContentPart{{Type: "output_text", Text: msg.Content}},
+			})
+		}
+		for _, tc := range msg.ToolCalls {
+			items = append(items, &responsesFunctionCall{
+				Type:      "function_call",
+				CallID:    tc.ID,
+				Name:      tc.Function.Name,
+				Arguments: tc.Function.Arguments,
+			})
+		}
+		return items, nil
+	}
+
+	if len(msg.MultiContent) == 0 {
+		return []any{&responsesMessage{Type: "message", Role: toOpenAIRole(msg.Role), Content: msg.Content}}, nil
+	}
+
+	parts := make([]*responsesContentPart, 0, len(msg.MultiContent))
+	for _, part := range msg.MultiContent {
+		switch part.Type {
+		case schema.ChatMessagePartTypeText:
+			parts = append(parts, &responsesContentPart{Type: "input_text", Text: part.Text})
+		case schema.ChatMessagePartTypeImageURL:
+			if part.ImageURL == nil {
+				return nil, fmt.Errorf("ImageURL field must not be nil when Type is ChatMessagePartTypeImageURL")
+			}
+			detail := string(part.ImageURL.Detail)
+			if detail == "" {
+				detail = string(schema.ImageURLDetailAuto)
+			}
+			parts = append(parts, &responsesContentPart{Type: "input_image", ImageURL: part.ImageURL.URL, Detail: detail})
+		default:
+			return nil, fmt.Errorf("unsupported chat message part type of responses api: %s", part.Type)
+		}
+	}
+
+	return []any{&responsesMessage{Type: "message", Role: toOpenAIRole(msg.Role), Content: parts}}, nil
+}
+
+func toResponsesMessage(resp *responsesResponse) *schema.Message {
+	msg := &schema.Message{
+		Role:  schema.Assistant,
+		Extra: map[string]any{ExtraKeyResponseID: resp.ID},
+	}
+
+	var content, reasoning strings.Builder
+	for _, item := range resp.Output {
+		switch item.Type {
+		case "message":
+			for _, c := range item.Content {
+				content.WriteString(c.Text)
+				content.WriteString(c.Refusal)
+			}
+		case "function_call":
+			msg.ToolCalls = append(msg.ToolCalls, schema.ToolCall{
+				ID:   item.CallID,
+				Type: string(openai.ToolTypeFunction),
+				Function: schema.FunctionCall{
+					Name:      item.Name,
+					Arguments: item.Arguments,
+				},
+			})
+		case "reasoning":
+			for _, s := range item.Summary {
+				reasoning.WriteString(s.Text)
+			}
+		}
+	}
+
+	msg.Content = content.String()
+	if reasoning.Len() > 0 {
+		msg.Extra[ExtraKeyReasoningContent] = reasoning.String()
+	}
+	msg.ResponseMeta = &schema.ResponseMeta{
+		FinishReason: toResponsesFinishReason(resp, len(msg.ToolCalls) > 0),
+		Usage:        toResponsesUsage(resp.Usage),
+	}
+	setResponsesCachedTokens(msg, resp.Usage)
+
+	return msg
+}
+
+// toResponsesFinishReason maps status of the response to the finish reason of chat completions.
+func toResponsesFinishReason(resp *responsesResponse, hasToolCalls bool) string {
+	if resp.Status == "incomplete" && resp.IncompleteDetails != nil {
+		if resp.IncompleteDetails.Reason == "max_output_tokens" {
+			return string(openai.FinishReasonLength)
+		}
+		return resp.IncompleteDetails.Reason
+	}
+	if hasToolCalls {
+		return string(openai.FinishReasonToolCalls)
+	}
+	return string(openai.FinishReasonStop)
+}
+
+func toResponsesUsage(usage *responsesUsage) *schema.TokenUsage {
+	if usage == nil {
+		return nil
+	}
+	return &schema.TokenUsage{
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      usage.TotalTokens,
+	}
+}
+
+func setResponsesCachedTokens(msg *schema.Message, usage *responsesUsage) {
+	if usage == nil {
+		return
+	}
+	setCachedTokens(msg, &openai.Usage{
+		PromptTokensDetails: &openai.PromptTokensDetails{CachedTokens: usage.InputTokensDetails.CachedTokens},
+	})
+}
+
+func (c *Client) createResponse(ctx context.Context, req *responsesRequest, opts ...model.Option) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal responses request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.responsesBaseURL+"/responses", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	if req.Stream {
+		httpReq.Header.Set("Accept", "text/event-stream")
+	}
+	for k, v := range model.GetImplSpecificOptions(&openaiOptions{}, opts...).ExtraHeaders {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		errBody, _ := io.ReadAll(resp.Body)
+		apiErr := &openai.APIError{HTTPStatusCode: resp.StatusCode, Message: string(errBody)}
+		var errResp struct {
+			Error *openai.APIError `json:"error"`
+		}
+		if json.Unmarshal(errBody, &errResp) == nil && errResp.Error != nil {
+			apiErr = errResp.Error
+			apiErr.HTTPStatusCode = resp.StatusCode
+		}
+		return nil, apiErr
+	}
+
+	return resp, nil
+}
+
+func (c *Client) generateResponses(ctx context.Context, in []*schema.Message, opts ...model.Option) (
+	outMsg *schema.Message, err error) {
+
+	req, cbInput, err := c.genResponsesRequest(in, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create responses request: %w", err)
+	}
+
+	ctx = callbacks.OnStart(ctx, cbInput)
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	httpResp, err := c.createResponse(ctx, req, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create response: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	resp := &responsesResponse{}
+	if err = json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if resp.Status == "failed" && resp.Error != nil {
+		return nil, fmt.Errorf("response failed, code: %s, message: %s", resp.Error.Code, resp.Error.Message)
+	}
+
+	outMsg = toResponsesMessage(resp)
+
+	callbacks.OnEnd(ctx, &model.CallbackOutput{
+		Message:    outMsg,
+		Config:     cbInput.Config,
+		TokenUsage: toModelCallbackUsage(outMsg.ResponseMeta),
+		Extra:      toCallbackExtra(outMsg),
+	})
+
+	return outMsg, nil
+}
+
+func (c *Client) streamResponses(ctx context.Context, in []*schema.Message, opts ...model.Option) (
+	outStream *schema.StreamReader[*schema.Message], err error) {
+
+	defer func() {
+		if err != nil {
+			callbacks.OnError(ctx, err)
+		}
+	}()
+
+	req, cbInput, err := c.genResponsesRequest(in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	req.Stream = true
+
+	ctx = callbacks.OnStart(ctx, cbInput)
+
+	httpResp, err := c.createResponse(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	sr, sw := schema.Pipe[*model.CallbackOutput](1)
+	go func() {
+		defer func() {
+			panicErr := recover()
+			_ = httpResp.Body.Close()
+
+			if panicErr != nil {
+				_ = sw.Send(nil, newPanicErr(panicErr, debug.Stack()))
+			}
+
+			sw.Close()
+		}()
+
+		reader := &responsesStreamReader{reader: bufio.NewReader(httpResp.Body), toolCallIndex: make(map[int]int)}
+		var repairer *toolargs.StreamRepairer
+		if c.config.RepairToolCallArguments {
+			repairer = toolargs.NewStreamRepairer()
+		}
+		for {
+			msg, recvErr := reader.recv()
+			if errors.Is(recvErr, io.EOF) {
+				if repairer != nil {
+					if fin := repairer.Finish(); fin != nil {
+						sw.Send(&model.CallbackOutput{
+							Message: fin,
+							Config:  cbInput.Config,
+						}, nil)
+					}
+				}
+				return
+			}
+			if recvErr != nil {
+				_ = sw.Send(nil, fmt.Errorf("failed to receive stream chunk from OpenAI: %w", recvErr))
+				return
+			}
+			if repairer != nil {
+				repairer.Process(msg)
+			}
+
+			closed := sw.Send(&model.CallbackOutput{
+				Message:    msg,
+				Config:     cbInput.Config,
+				TokenUsage: toModelCallbackUsage(msg.ResponseMeta),
+				Extra:      toCallbackExtra(msg),
+			}, nil)
+			if closed {
+				return
+			}
+		}
+	}()
+
+	ctx, nsr := callbacks.OnEndWithStreamOutput(ctx, schema.StreamReaderWithConvert(sr,
+		func(src *model.CallbackOutput) (callbacks.CallbackOutput, error) {
+			return src, nil
+		}))
+
+	outStream = schema.StreamReaderWithConvert(nsr,
+		func(src callbacks.CallbackOutput) (*schema.Message, error) {
+			s := src.(*model.CallbackOutput)
+			if s.Message == nil {
+				return nil, schema.ErrNoValue
+			}
+
+			return s.Message, nil
+		},
+	)
+
+	return outStream, nil
+}
+
+// responsesStreamReader converts server-sent events of Responses API to message chunks.
+type responsesStreamReader struct {
+	reader *bufio.Reader
+	// toolCallIndex maps the output index of function calls to the index of tool calls
+	toolCallIndex map[int]int
+	hasToolCalls  bool
+	done          bool
+}
+
+func (r *responsesStreamReader) recv() (*schema.Message, error) {
+	for {
+		if r.done {
+			return nil, io.EOF
+		}
+
+		line, err := r.reader.ReadBytes('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || len(line) == 0) {
+			return nil, err
+		}
+
+		line = bytes.TrimSpace(line)
+		if !bytes.HasPrefix(line, sseDataPrefix) {
+			continue
+		}
+
+		event := &responsesStreamEvent{}
+		if err = json.Unmarshal(bytes.TrimPrefix(line, sseDataPrefix), event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal stream event: %w", err)
+		}
+
+		msg, err := r.toMessage(event)
+		if err != nil || msg != nil {
+			return msg, err
+		}
+	}
+}
+
+// toMessage returns the chunk of the event, or nil if the event doesn't carry output.
+func (r *responsesStreamReader) toMessage(event *responsesStreamEvent) (*schema.Message, error) {
+	switch event.Type {
+	case "response.output_text.delta", "response.refusal.delta":
+		return &schema.Message{Role: schema.Assistant, Content: event.Delta}, nil
+	case "response.reasoning_summary_text.delta":
+		return &schema.Message{Role: schema.Assistant, Extra: map[string]any{ExtraKeyReasoningContent: event.Delta}}, nil
+	case "response.output_item.added":
+		if event.Item == nil || event.Item.Type != "function_call" {
+			return nil, nil
+		}
+		index := len(r.toolCallIndex)
+		r.toolCallIndex[event.OutputIndex] = index
+		r.hasToolCalls = true
+		return &schema.Message{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{
+			Index:    &index,
+			ID:       event.Item.CallID,
+			Type:     string(openai.ToolTypeFunction),
+			Function: schema.FunctionCall{Name: event.Item.Name, Arguments: event.Item.Arguments},
+		}}}, nil
+	case "response.function_call_arguments.delta":
+		index, ok := r.toolCallIndex[event.OutputIndex]
+		if !ok {
+			return nil, fmt.Errorf("arguments of unknown function call, output index: %d", event.OutputIndex)
+		}
+		return &schema.Message{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{
+			Index:    &index,
+			Function: schema.FunctionCall{Arguments: event.Delta},
+		}}}, nil
+	case "response.completed", "response.incomplete":
+		r.done = true
+		if event.Response == nil {
+			return nil, io.EOF
+		}
+		msg := &schema.Message{
+			Role:  schema.Assistant,
+			Extra: map[string]any{ExtraKeyResponseID: event.Response.ID},
+			ResponseMeta: &schema.ResponseMeta{
+				FinishReason: toResponsesFinishReason(event.Response, r.hasToolCalls),
+				Usage:        toResponsesUsage(event.Response.Usage),
+			},
+		}
+		setResponsesCachedTokens(msg, event.Response.Usage)
+		return msg, nil
+	case "response.failed":
+		r.done = true
+		if event.Response != nil && event.Response.Error != nil {
+			return nil, fmt.Errorf("response failed, code: %s, message: %s", event.Response.Error.Code, event.Response.Error.Message)
+		}
+		return nil, errors.New("response failed")
+	case "error":
+		r.done = true
+		return nil, fmt.Errorf("stream error, code: %s, message: %s", event.Code, event.Message)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	goopenai "github.com/meguminnnnnnnnn/go-openai"
+	"github.com/stretchr/testify/assert"
+)
+
+func newResponsesClient(t *testing.T, handler func(body map[string]any) *http.Response) *Client {
+	cli, err := NewClient(context.Background(), &Config{
+		APIKey:  "test",
+		BaseURL: "http://localhost/v1/",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "http://localhost/v1/responses", req.URL.String())
+			assert.Equal(t, "Bearer test", req.Header.Get("Authorization"))
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			return handler(body), nil
+		})},
+		Model:           "gpt-4.1",
+		UseResponsesAPI: true,
+		BuiltInTools:    []*BuiltInTool{{Type: "file_search", Params: map[string]any{"vector_store_ids": []string{"vs_1"}}}},
+		Reasoning:       &Reasoning{Summary: "auto"},
+	})
+	assert.NoError(t, err)
+	return cli
+}
+
+func TestResponsesGenerate(t *testing.T) {
+	var gotBody map[string]any
+	cli := newResponsesClient(t, func(body map[string]any) *http.Response {
+		gotBody = body
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`{"id":"resp_1","status":"completed","output":[` +
+				`{"type":"reasoning","summary":[{"type":"summary_text","text":"think"}]},` +
+				`{"type":"file_search_call","status":"completed"},` +
+				`{"type":"message","role":"assistant","content":[{"type":"output_text","text":"it's sunny"}]},` +
+				`{"type":"function_call","call_id":"call_2","name":"get_weather","arguments":"{\"city\":\"Beijing\"}"}],` +
+				`"usage":{"input_tokens":10,"output_tokens":5,"total_tokens":15,"input_tokens_details":{"cached_tokens":8}}}`)),
+		}
+	})
+
+	cli, err := cli.WithToolsForClient([]*schema.ToolInfo{{Name: "get_weather", Desc: "get weather"}})
+	assert.NoError(t, err)
+
+	msg, err := cli.Generate(context.Background(), []*schema.Message{
+		schema.SystemMessage("you are a helpful assistant"),
+		{Role: schema.User, MultiContent: []schema.ChatMessagePart{
+			{Type: schema.ChatMessagePartTypeText, Text: "weather?"},
+			{Type: schema.ChatMessagePartTypeImageURL, ImageURL: &schema.ChatMessageImageURL{URL: "https://img"}},
+		}},
+		{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{ID: "call_1", Function: schema.FunctionCall{Name: "get_weather", Arguments: "{}"}}}},
+		{Role: schema.Tool, ToolCallID: "call_1", Content: "sunny"},
+	}, WithParallelToolCalls(false))
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"model": "gpt-4.1",
+		"input": []any{
+			map[string]any{"type": "message", "role": "system", "content": "you are a helpful assistant"},
+			map[string]any{"type": "message", "role": "user", "content": []any{
+				map[string]any{"type": "input_text", "text": "weather?"},
+				map[string]any{"type": "input_image", "image_url": "https://img", "detail": "auto"},
+			}},
+			map[string]any{"type": "function_call", "call_id": "call_1", "name": "get_weather", "arguments": "{}"},
+			map[string]any{"type": "function_call_output", "call_id": "call_1", "output": "sunny"},
+		},
+		"tools": []any{
+			map[string]any{"type": "function", "name": "get_weather", "description": "get weather", "strict": false},
+			map[string]any{"type": "file_search", "vector_store_ids": []any{"vs_1"}},
+		},
+		"tool_choice":         "auto",
+		"parallel_tool_calls": false,
+		"reasoning":           map[string]any{"summary": "auto"},
+	}, gotBody)
+
+	assert.Equal(t, "it's sunny", msg.Content)
+	assert.Equal(t, []schema.ToolCall{{
+		ID:       "call_2",
+		Type:     "function",
+		Function: schema.FunctionCall{Name: "get_weather", Arguments: `{"city":"Beijing"}`},
+	}}, msg.ToolCalls)
+	reasoning, ok := GetReasoningContent(msg)
+	assert.True(t, ok)
+	assert.Equal(t, "think", reasoning)
+	id, ok := GetResponseID(msg)
+	assert.True(t, ok)
+	assert.Equal(t, "resp_1", id)
+	cached, ok := GetCachedTokens(msg)
+	assert.True(t, ok)
+	assert.Equal(t, 8, cached)
+	assert.Equal(t, &schema.ResponseMeta{
+		FinishReason: "tool_calls",
+		Usage:        &schema.TokenUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+	}, msg.ResponseMeta)
+
+	t.Run("api error", func(t *testing.T) {
+		cli := newResponsesClient(t, func(body map[string]any) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Body:       io.NopCloser(strings.NewReader(`{"error":{"message":"rate limited","type":"requests"}}`)),
+			}
+		})
+		_, err := cli.Generate(context.Background(), []*schema.Message{schema.UserMessage("hi")})
+		var apiErr *goopenai.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusTooManyRequests, apiErr.HTTPStatusCode)
+		assert.Equal(t, "rate limited", apiErr.Message)
+	})
+}
+
+func TestResponsesStream(t *testing.T) {
+	events := []string{
+		`{"type":"response.created","response":{"id":"resp_1","status":"in_progress"}}`,
+		`{"type":"response.reasoning_summary_text.delta","output_index":0,"delta":"thi"}`,
+		`{"type":"response.reasoning_summary_text.delta","output_index":0,"delta":"nk"}`,
+		`{"type":"response.output_text.delta","output_index":1,"delta":"let me "}`,
+		`{"type":"response.output_text.delta","output_index":1,"delta":"check"}`,
+		`{"type":"response.output_item.added","output_index":2,"item":{"type":"function_call","call_id":"call_1","name":"get_weather","arguments":""}}`,
+		`{"type":"response.function_call_arguments.delta","output_index":2,"delta":"{\"city\":"}`,
+		`{"type":"response.function_call_arguments.delta","output_index":2,"delta":"\"Beijing\"}"}`,
+		`{"type":"response.completed","response":{"id":"resp_1","status":"completed","usage":{"input_tokens":10,"output_tokens":5,"total_tokens":15}}}`,
+	}
+	sb := strings.Builder{}
+	for _, e := range events {
+		sb.WriteString("event: " + strings.Split(e, `"`)[3] + "\ndata: " + e + "\n\n")
+	}
+
+	cli := newResponsesClient(t, func(body map[string]any) *http.Response {
+		assert.Equal(t, true, body["stream"])
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+			Body:       io.NopCloser(strings.NewReader(sb.String())),
+		}
+	})
+
+	sr, err := cli.Stream(context.Background(), []*schema.Message{schema.UserMessage("weather?")})
+	assert.NoError(t, err)
+	defer sr.Close()
+
+	var msgs []*schema.Message
+	for {
+		msg, err := sr.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	assert.Len(t, msgs, 8)
+
+	msg, err := schema.ConcatMessages(msgs)
+	assert.NoError(t, err)
+	assert.Equal(t, "let me check", msg.Content)
+	assert.Len(t, msg.ToolCalls, 1)
+	assert.Equal(t, "call_1", msg.ToolCalls[0].ID)
+	assert.Equal(t, `{"city":"Beijing"}`, msg.ToolCalls[0].Function.Arguments)
+	reasoning, _ := GetReasoningContent(msg)
+	assert.Equal(t, "think", reasoning)
+	id, _ := GetResponseID(msg)
+	assert.Equal(t, "resp_1", id)
+	assert.Equal(t, "tool_calls", msg.ResponseMeta.FinishReason)
+	assert.Equal(t, 15, msg.ResponseMeta.Usage.TotalTokens)
+
+	t.Run("failed", func(t *testing.T) {
+		cli := newResponsesClient(t, func(body map[string]any) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`data: {"type":"response.output_text.delta","delta":"hi"}` + "\n\n" +
+					`data: {"type":"response.failed","response":{"status":"failed","error":{"code":"server_error","message":"oops"}}}` + "\n\n")),
+			}
+		})
+		sr, err := cli.Stream(context.Background(), []*schema.Message{schema.UserMessage("hi")})
+		assert.NoError(t, err)
+		defer sr.Close()
+
+		msg, err := sr.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "hi", msg.Content)
+		_, err = sr.Recv()
+		assert.ErrorContains(t, err, "oops")
+	})
+}
+
+func TestNewClientResponsesAzure(t *testing.T) {
+	_, err := NewClient(context.Background(), &Config{ByAzure: true, UseResponsesAPI: true})
+	assert.Error(t, err)
+}
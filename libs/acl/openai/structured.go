@@ -0,0 +1,179 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cloudwego/eino/components/model"
+	"github.com/cloudwego/eino/schema"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+)
+
+const (
+	defaultStructuredOutputName        = "output"
+	defaultStructuredOutputMaxAttempts = 3
+)
+
+type StructuredOutputConfig struct {
+	// Name of the response format json schema
+	// Optional. Default: "output"
+	Name string
+	// Description of the response format json schema
+	// Optional.
+	Description string
+	// Strict enables strict schema adherence.
+	// The derived schema is normalized to what strict mode accepts:
+	// every property is required and additional properties are disallowed, for nested objects as well.
+	// Optional. Default: false
+	Strict bool
+	// MaxAttempts is the max number of generations, the model is asked to fix its output
+	// when the response can't be unmarshalled or fails schema validation.
+	// Optional. Default: 3
+	MaxAttempts int
+}
+
+// NewJSONSchemaResponseFormat derives a json_schema response format from Go struct T,
+// using json tags as property names.
+func NewJSONSchemaResponseFormat[T any](name, description string, strict bool) (*ChatCompletionResponseFormat, error) {
+	var v T
+	ref, err := openapi3gen.NewSchemaRefForValue(v, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate json schema from %T: %w", v, err)
+	}
+
+	if strict {
+		normalizeStrictSchema(ref.Value, map[*openapi3.Schema]bool{})
+	}
+
+	return &ChatCompletionResponseFormat{
+		Type: ChatCompletionResponseFormatTypeJSONSchema,
+		JSONSchema: &ChatCompletionResponseFormatJSONSchema{
+			Name:        name,
+			Description: description,
+			Schema:      ref.Value,
+			Strict:      strict,
+		},
+	}, nil
+}
+
+// GenerateStructured generates a response with response_format=json_schema derived from T,
+// then validates and unmarshals the response into T.
+// When the response is invalid, the invalid output and the validation error are sent back to model
+// to regenerate, until config.MaxAttempts is reached.
+func GenerateStructured[T any](ctx context.Context, c *Client, in []*schema.Message, config *StructuredOutputConfig,
+	opts ...model.Option) (*T, *schema.Message, error) {
+
+	if config == nil {
+		config = &StructuredOutputConfig{}
+	}
+
+	name := config.Name
+	if name == "" {
+		name = defaultStructuredOutputName
+	}
+
+	maxAttempts := config.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultStructuredOutputMaxAttempts
+	}
+
+	format, err := NewJSONSchemaResponseFormat[T](name, config.Description, config.Strict)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts = append(opts, WithResponseFormat(format))
+	msgs := make([]*schema.Message, len(in), len(in)+2*maxAttempts)
+	copy(msgs, in)
+
+	var lastErr error
+	for i := 0; i < maxAttempts; i++ {
+		outMsg, err := c.Generate(ctx, msgs, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result, err := parseStructuredOutput[T](format.JSONSchema.Schema, outMsg.Content)
+		if err == nil {
+			return result, outMsg, nil
+		}
+
+		lastErr = err
+		msgs = append(msgs, outMsg, schema.UserMessage(fmt.Sprintf(
+			"The previous response is invalid: %v. Please respond again with JSON that strictly matches the required schema.", err)))
+	}
+
+	return nil, nil, fmt.Errorf("failed to generate structured output after %d attempts: %w", maxAttempts, lastErr)
+}
+
+func parseStructuredOutput[T any](s *openapi3.Schema, content string) (*T, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, fmt.Errorf("response is not valid json: %w", err)
+	}
+
+	if err := s.VisitJSON(raw); err != nil {
+		return nil, fmt.Errorf("response does not match json schema: %w", err)
+	}
+
+	result := new(T)
+	if err := json.Unmarshal([]byte(content), result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return result, nil
+}
+
+// normalizeStrictSchema makes every object schema in s require all of its properties
+// and reject additional properties, as strict mode of json_schema response format demands.
+func normalizeStrictSchema(s *openapi3.Schema, visited map[*openapi3.Schema]bool) {
+	if s == nil || visited[s] {
+		return
+	}
+	visited[s] = true
+
+	if s.Type == openapi3.TypeObject || len(s.Properties) > 0 {
+		required := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			required = append(required, name)
+		}
+		sort.Strings(required)
+		s.Required = required
+		s.AdditionalProperties = openapi3.AdditionalProperties{Has: openapi3.BoolPtr(false)}
+	}
+
+	for _, prop := range s.Properties {
+		if prop != nil {
+			normalizeStrictSchema(prop.Value, visited)
+		}
+	}
+	if s.Items != nil {
+		normalizeStrictSchema(s.Items.Value, visited)
+	}
+	for _, refs := range []openapi3.SchemaRefs{s.AllOf, s.AnyOf, s.OneOf} {
+		for _, ref := range refs {
+			if ref != nil {
+				normalizeStrictSchema(ref.Value, visited)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/cloudwego/eino/schema"
+	goopenai "github.com/meguminnnnnnnnn/go-openai"
+	"github.com/stretchr/testify/assert"
+)
+
+type weather struct {
+	City        string  `json:"city"`
+	Temperature float64 `json:"temperature"`
+}
+
+func TestNewJSONSchemaResponseFormat(t *testing.T) {
+	format, err := NewJSONSchemaResponseFormat[weather]("weather", "weather info", true)
+	assert.NoError(t, err)
+	assert.Equal(t, ChatCompletionResponseFormatTypeJSONSchema, format.Type)
+	assert.Equal(t, "weather", format.JSONSchema.Name)
+	assert.True(t, format.JSONSchema.Strict)
+	assert.Equal(t, "string", format.JSONSchema.Schema.Properties["city"].Value.Type)
+	assert.Equal(t, "number", format.JSONSchema.Schema.Properties["temperature"].Value.Type)
+	assert.Equal(t, []string{"city", "temperature"}, format.JSONSchema.Schema.Required)
+	assert.False(t, *format.JSONSchema.Schema.AdditionalProperties.Has)
+
+	type forecast struct {
+		Days []weather `json:"days"`
+		Note *string   `json:"note,omitempty"`
+	}
+	format, err = NewJSONSchemaResponseFormat[forecast]("forecast", "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"days", "note"}, format.JSONSchema.Schema.Required)
+	assert.False(t, *format.JSONSchema.Schema.AdditionalProperties.Has)
+	item := format.JSONSchema.Schema.Properties["days"].Value.Items.Value
+	assert.Equal(t, []string{"city", "temperature"}, item.Required)
+	assert.False(t, *item.AdditionalProperties.Has)
+
+	format, err = NewJSONSchemaResponseFormat[weather]("weather", "", false)
+	assert.NoError(t, err)
+	assert.Empty(t, format.JSONSchema.Schema.Required)
+	assert.Nil(t, format.JSONSchema.Schema.AdditionalProperties.Has)
+}
+
+func TestGenerateStructured(t *testing.T) {
+	ctx := context.Background()
+	cli, err := NewClient(ctx, &Config{APIKey: "mock", Model: "gpt-4o"})
+	assert.NoError(t, err)
+
+	mockey.PatchConvey("retry on invalid output", t, func() {
+		var reqs []goopenai.ChatCompletionRequest
+		contents := []string{`{"city": 1}`, `{"city": "Beijing", "temperature": 21.5}`}
+		mockey.Mock((*goopenai.Client).CreateChatCompletion).To(func(ctx context.Context, req goopenai.ChatCompletionRequest, opts ...goopenai.ChatCompletionRequestOption) (goopenai.ChatCompletionResponse, error) {
+			reqs = append(reqs, req)
+			return goopenai.ChatCompletionResponse{
+				Choices: []goopenai.ChatCompletionChoice{{
+					Message: goopenai.ChatCompletionMessage{
+						Role:    goopenai.ChatMessageRoleAssistant,
+						Content: contents[len(reqs)-1],
+					},
+				}},
+			}, nil
+		}).Build()
+
+		result, msg, err := GenerateStructured[weather](ctx, cli, []*schema.Message{schema.UserMessage("weather of Beijing")}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, &weather{City: "Beijing", Temperature: 21.5}, result)
+		assert.Equal(t, contents[1], msg.Content)
+		assert.Len(t, reqs, 2)
+		assert.Equal(t, goopenai.ChatCompletionResponseFormatTypeJSONSchema, reqs[0].ResponseFormat.Type)
+		assert.Equal(t, defaultStructuredOutputName, reqs[0].ResponseFormat.JSONSchema.Name)
+		assert.Len(t, reqs[1].Messages, 3)
+	})
+
+	mockey.PatchConvey("exceed max attempts", t, func() {
+		mockey.Mock((*goopenai.Client).CreateChatCompletion).Return(goopenai.ChatCompletionResponse{
+			Choices: []goopenai.ChatCompletionChoice{{
+				Message: goopenai.ChatCompletionMessage{Role: goopenai.ChatMessageRoleAssistant, Content: "not json"},
+			}},
+		}, nil).Build()
+
+		result, _, err := GenerateStructured[weather](ctx, cli, []*schema.Message{schema.UserMessage("weather of Beijing")},
+			&StructuredOutputConfig{MaxAttempts: 2})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
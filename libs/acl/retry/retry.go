@@ -0,0 +1,311 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package retry retries the requests to model services on rate limits and transient failures, with jittered
+// exponential backoff. It's shared by the chat models and embedders, either as an http.RoundTripper, which honors
+// the Retry-After header, or by Do for the calls of SDKs.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = 500 * time.Millisecond
+	defaultMaxDelay    = 30 * time.Second
+	defaultJitter      = 0.2
+
+	// maxDrainBytes is the max bytes of the body of a retried response read to reuse the connection
+	maxDrainBytes = 4 << 10
+)
+
+// Config configures the retries. The zero value retries 429, 5xx and connection resets twice.
+type Config struct {
+	// MaxAttempts is the max number of attempts including the first one, 1 disables retries
+	// Optional. Default: 3
+	MaxAttempts int `json:"max_attempts,omitempty"`
+
+	// BaseDelay is the delay before the first retry, doubled on each retry
+	// Optional. Default: 500ms
+	BaseDelay time.Duration `json:"base_delay,omitempty"`
+
+	// MaxDelay caps the delay before a retry. A response asking to retry after a longer delay
+	// by Retry-After is returned without retry.
+	// Optional. Default: 30s
+	MaxDelay time.Duration `json:"max_delay,omitempty"`
+
+	// Jitter randomizes the delay by the fraction, e.g. delays of 0.2 are in [0.8, 1.2] times of the backoff,
+	// so that clients rate limited at the same time don't retry at the same time
+	// Optional. Range: [0, 1]. Default: 0.2
+	Jitter *float64 `json:"jitter,omitempty"`
+
+	// Classifier decides whether a failed attempt is retried, resp is nil if err is not nil.
+	// Optional. Default: DefaultClassifier
+	Classifier func(resp *http.Response, err error) bool `json:"-"`
+
+	// IgnoreRetryAfter ignores the Retry-After header of responses, the delay is always the backoff
+	// Optional. Default: false
+	IgnoreRetryAfter bool `json:"ignore_retry_after,omitempty"`
+}
+
+// DefaultClassifier retries rate limits (429), server errors (5xx except 501), and connections reset or refused
+// before the response is received. Canceled and timed out requests are not retried.
+// Errors of SDKs carrying the http status, e.g. the API errors of go-openai, are classified by the status.
+func DefaultClassifier(resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		if status, ok := StatusCode(err); ok {
+			return retryableStatus(status)
+		}
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF) ||
+			strings.Contains(err.Error(), "connection reset by peer")
+	}
+	if resp == nil {
+		return false
+	}
+
+	return retryableStatus(resp.StatusCode)
+}
+
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests ||
+		(status >= http.StatusInternalServerError && status != http.StatusNotImplemented)
+}
+
+// StatusCode returns the http status carried by err or any error it wraps, which is either returned by
+// a StatusCode or HTTPStatusCode method, or kept in an int field named StatusCode or HTTPStatusCode,
+// as the errors of most model SDKs do.
+func StatusCode(err error) (int, bool) {
+	for err != nil {
+		if status, ok := statusCodeOf(err); ok {
+			return status, true
+		}
+
+		switch u := err.(type) {
+		case interface{ Unwrap() error }:
+			err = u.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, e := range u.Unwrap() {
+				if status, ok := StatusCode(e); ok {
+					return status, true
+				}
+			}
+			return 0, false
+		default:
+			return 0, false
+		}
+	}
+
+	return 0, false
+}
+
+func statusCodeOf(err error) (int, bool) {
+	switch e := err.(type) {
+	case interface{ StatusCode() int }:
+		return e.StatusCode(), true
+	case interface{ HTTPStatusCode() int }:
+		return e.HTTPStatusCode(), true
+	}
+
+	v := reflect.ValueOf(err)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	for _, name := range []string{"HTTPStatusCode", "StatusCode"} {
+		f := v.FieldByName(name)
+		if f.IsValid() && f.CanInt() && f.Int() > 0 {
+			return int(f.Int()), true
+		}
+	}
+
+	return 0, false
+}
+
+// policy is the Config with defaults.
+type policy struct {
+	maxAttempts      int
+	baseDelay        time.Duration
+	maxDelay         time.Duration
+	jitter           float64
+	classifier       func(resp *http.Response, err error) bool
+	ignoreRetryAfter bool
+}
+
+func newPolicy(config *Config) *policy {
+	p := &policy{
+		maxAttempts: defaultMaxAttempts,
+		baseDelay:   defaultBaseDelay,
+		maxDelay:    defaultMaxDelay,
+		jitter:      defaultJitter,
+		classifier:  DefaultClassifier,
+	}
+	if config == nil {
+		return p
+	}
+
+	if config.MaxAttempts > 0 {
+		p.maxAttempts = config.MaxAttempts
+	}
+	if config.BaseDelay > 0 {
+		p.baseDelay = config.BaseDelay
+	}
+	if config.MaxDelay > 0 {
+		p.maxDelay = config.MaxDelay
+	}
+	if config.Jitter != nil {
+		p.jitter = *config.Jitter
+		if p.jitter < 0 {
+			p.jitter = 0
+		} else if p.jitter > 1 {
+			p.jitter = 1
+		}
+	}
+	if config.Classifier != nil {
+		p.classifier = config.Classifier
+	}
+	p.ignoreRetryAfter = config.IgnoreRetryAfter
+
+	return p
+}
+
+var (
+	randMu sync.Mutex
+	// seeded explicitly, as the global source of math/rand is deterministic before go 1.20
+	randSrc = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoff returns the delay after the failed attempt, attempts start from 1.
+func (p *policy) backoff(attempt int) time.Duration {
+	delay := p.baseDelay
+	for i := 1; i < attempt && delay < p.maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+
+	if p.jitter > 0 {
+		randMu.Lock()
+		r := randSrc.Float64()
+		randMu.Unlock()
+		delay = time.Duration(float64(delay) * (1 + p.jitter*(2*r-1)))
+		if delay > p.maxDelay {
+			delay = p.maxDelay
+		}
+	}
+
+	return delay
+}
+
+// retryAfter returns the delay of the Retry-After header, in seconds or an HTTP date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	v := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for d, it returns the error of ctx if ctx is done before.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Do calls fn until it succeeds, the error is not retryable, or the attempts are used up, and returns the result of
+// the last call. Only the error is passed to Config.Classifier, with a nil response.
+// If ctx is done while waiting to retry, the returned error wraps both the error of ctx and the last error.
+func Do[T any](ctx context.Context, config *Config, fn func(ctx context.Context) (T, error)) (T, error) {
+	p := newPolicy(config)
+	for attempt := 1; ; attempt++ {
+		result, err := fn(ctx)
+		if err == nil || attempt >= p.maxAttempts || !p.classifier(nil, err) {
+			return result, err
+		}
+
+		if sErr := sleep(ctx, p.backoff(attempt)); sErr != nil {
+			return result, &interruptedError{ctxErr: sErr, lastErr: err}
+		}
+	}
+}
+
+// interruptedError is returned by Do when ctx is done before the retry.
+type interruptedError struct {
+	ctxErr  error
+	lastErr error
+}
+
+func (e *interruptedError) Error() string {
+	return fmt.Sprintf("retry interrupted: %v, last error: %v", e.ctxErr, e.lastErr)
+}
+
+func (e *interruptedError) Unwrap() []error {
+	return []error{e.ctxErr, e.lastErr}
+}
+
+// Is and As make both errors reachable by errors.Is and errors.As before go 1.20,
+// which doesn't follow Unwrap() []error.
+func (e *interruptedError) Is(target error) bool {
+	return errors.Is(e.ctxErr, target) || errors.Is(e.lastErr, target)
+}
+
+func (e *interruptedError) As(target any) bool {
+	return errors.As(e.ctxErr, target) || errors.As(e.lastErr, target)
+}
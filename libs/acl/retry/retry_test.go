@@ -0,0 +1,198 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultClassifier(t *testing.T) {
+	statuses := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusBadRequest:          false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusNotImplemented:      false,
+		http.StatusServiceUnavailable:  true,
+	}
+	for status, expected := range statuses {
+		assert.Equal(t, expected, DefaultClassifier(&http.Response{StatusCode: status}, nil), status)
+	}
+
+	assert.True(t, DefaultClassifier(nil, fmt.Errorf("read: %w", syscall.ECONNRESET)))
+	assert.True(t, DefaultClassifier(nil, io.ErrUnexpectedEOF))
+	assert.True(t, DefaultClassifier(nil, errors.New("read tcp: connection reset by peer")))
+	assert.False(t, DefaultClassifier(nil, fmt.Errorf("post: %w", context.DeadlineExceeded)))
+	assert.False(t, DefaultClassifier(nil, errors.New("invalid request")))
+	assert.False(t, DefaultClassifier(nil, nil))
+
+	// errors of SDKs carrying the http status
+	assert.True(t, DefaultClassifier(nil, fmt.Errorf("create: %w", &apiError{HTTPStatusCode: http.StatusTooManyRequests})))
+	assert.True(t, DefaultClassifier(nil, statusError{StatusCode: http.StatusBadGateway}))
+	assert.True(t, DefaultClassifier(nil, methodError(http.StatusServiceUnavailable)))
+	assert.False(t, DefaultClassifier(nil, &apiError{HTTPStatusCode: http.StatusBadRequest}))
+	assert.False(t, DefaultClassifier(nil, fmt.Errorf("create: %w", methodError(http.StatusUnauthorized))))
+}
+
+type apiError struct {
+	HTTPStatusCode int
+}
+
+func (e *apiError) Error() string { return fmt.Sprintf("status %d", e.HTTPStatusCode) }
+
+type statusError struct {
+	StatusCode int
+}
+
+func (e statusError) Error() string { return fmt.Sprintf("status %d", e.StatusCode) }
+
+type methodError int
+
+func (e methodError) Error() string   { return fmt.Sprintf("status %d", int(e)) }
+func (e methodError) StatusCode() int { return int(e) }
+
+func TestStatusCode(t *testing.T) {
+	status, ok := StatusCode(fmt.Errorf("a: %w", fmt.Errorf("b: %w", &apiError{HTTPStatusCode: 429})))
+	assert.True(t, ok)
+	assert.Equal(t, 429, status)
+
+	status, ok = StatusCode(&interruptedError{ctxErr: context.Canceled, lastErr: statusError{StatusCode: 503}})
+	assert.True(t, ok)
+	assert.Equal(t, 503, status)
+
+	_, ok = StatusCode(errors.New("invalid"))
+	assert.False(t, ok)
+	_, ok = StatusCode((*apiError)(nil))
+	assert.False(t, ok)
+	_, ok = StatusCode(nil)
+	assert.False(t, ok)
+}
+
+func TestBackoff(t *testing.T) {
+	jitter := 0.0
+	p := newPolicy(&Config{BaseDelay: time.Second, MaxDelay: 5 * time.Second, Jitter: &jitter})
+	assert.Equal(t, time.Second, p.backoff(1))
+	assert.Equal(t, 2*time.Second, p.backoff(2))
+	assert.Equal(t, 4*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(4))
+	assert.Equal(t, 5*time.Second, p.backoff(100))
+
+	p = newPolicy(&Config{BaseDelay: time.Second})
+	for i := 0; i < 100; i++ {
+		d := p.backoff(2)
+		assert.True(t, d >= 1600*time.Millisecond && d <= 2400*time.Millisecond, d)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := func(v string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{v}}}
+	}
+
+	d, ok := retryAfter(resp("3"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	d, ok = retryAfter(resp(now.Add(10*time.Second).Format(http.TimeFormat)), now)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, d)
+
+	_, ok = retryAfter(resp("soon"), now)
+	assert.False(t, ok)
+	_, ok = retryAfter(&http.Response{Header: http.Header{}}, now)
+	assert.False(t, ok)
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	errTransient := errors.New("transient")
+	config := &Config{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		Classifier: func(resp *http.Response, err error) bool {
+			return errors.Is(err, errTransient)
+		},
+	}
+
+	t.Run("success after retries", func(t *testing.T) {
+		calls := 0
+		result, err := Do(ctx, config, func(ctx context.Context) (int, error) {
+			calls++
+			if calls < 3 {
+				return 0, errTransient
+			}
+			return 42, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 42, result)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("attempts used up", func(t *testing.T) {
+		calls := 0
+		_, err := Do(ctx, config, func(ctx context.Context) (int, error) {
+			calls++
+			return 0, errTransient
+		})
+		assert.ErrorIs(t, err, errTransient)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		calls := 0
+		_, err := Do(ctx, config, func(ctx context.Context) (int, error) {
+			calls++
+			return 0, errors.New("invalid")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cctx, cancel := context.WithCancel(ctx)
+		calls := 0
+		_, err := Do(cctx, &Config{BaseDelay: time.Hour, Classifier: config.Classifier}, func(ctx context.Context) (int, error) {
+			calls++
+			cancel()
+			return 0, errTransient
+		})
+		assert.ErrorIs(t, err, errTransient)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("default classifier with sdk errors", func(t *testing.T) {
+		calls := 0
+		_, err := Do(ctx, &Config{BaseDelay: time.Millisecond}, func(ctx context.Context) (int, error) {
+			calls++
+			return 0, &apiError{HTTPStatusCode: http.StatusTooManyRequests}
+		})
+		var apiErr *apiError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, defaultMaxAttempts, calls)
+	})
+}
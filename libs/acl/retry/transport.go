@@ -0,0 +1,91 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// NewTransport returns an http.RoundTripper retrying the requests sent by base, http.DefaultTransport if nil.
+//
+// A request is retried when Config.Classifier decides so, after the Retry-After delay of the response, or the
+// backoff. Requests are retried before the body of the response is read, so a stream is never retried once
+// received. Requests with a body which can't be rewound, i.e. without http.Request.GetBody, are not retried.
+func NewTransport(base http.RoundTripper, config *Config) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, policy: newPolicy(config)}
+}
+
+// WrapHTTPClient returns a copy of client, http.DefaultClient if nil, whose transport retries requests.
+func WrapHTTPClient(client *http.Client, config *Config) *http.Client {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	c.Transport = NewTransport(c.Transport, config)
+	return &c
+}
+
+type transport struct {
+	base   http.RoundTripper
+	policy *policy
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if attempt >= t.policy.maxAttempts || !t.policy.classifier(resp, err) {
+			return resp, err
+		}
+
+		delay := t.policy.backoff(attempt)
+		if resp != nil && !t.policy.ignoreRetryAfter {
+			if d, ok := retryAfter(resp, time.Now()); ok {
+				if d > t.policy.maxDelay {
+					return resp, nil
+				}
+				delay = d
+			}
+		}
+		if resp != nil {
+			_, _ = io.CopyN(io.Discard, resp.Body, maxDrainBytes)
+			_ = resp.Body.Close()
+		}
+
+		if sErr := sleep(req.Context(), delay); sErr != nil {
+			return nil, sErr
+		}
+	}
+}
@@ -0,0 +1,334 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package toolargs repairs the arguments of tool calls generated by chat models, which are sometimes invalid json,
+// e.g. truncated, with trailing garbage or concatenated twice, when the fragments of streams are concatenated.
+package toolargs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	objectKey   = iota // expects a key or '}'
+	objectColon        // expects ':' after the key
+	objectValue        // expects the value after ':'
+	objectAfter        // expects ',' or '}' after the value
+	arrayValue         // expects a value or ']'
+	arrayAfter         // expects ',' or ']' after the value
+)
+
+type frame struct {
+	closer byte
+	state  int
+}
+
+// Scanner incrementally validates and repairs the arguments of a tool call, which are written fragment by fragment.
+// Write returns the part of the fragment kept in the arguments, and Close returns the suffix completing them,
+// so that the kept parts followed by the suffix are a valid json object, or array:
+//   - text before the first '{' or '[' and after the closer of the top level value is trimmed,
+//     e.g. markdown code fences, or the arguments generated again
+//   - unclosed strings, objects and arrays are closed, keys without values get null values,
+//     and incomplete literals are completed, e.g. "tr" to "true"
+//   - trailing commas and characters invalid at their position are dropped, missing commas are inserted,
+//     and control characters in strings are escaped
+//
+// Bytes which may be dropped later, e.g. commas, are held back until the next fragment decides.
+type Scanner struct {
+	started bool
+	done    bool
+	stack   []frame
+
+	inString    bool
+	stringIsKey bool
+	escape      string // held back escape sequence in a string
+
+	literal string // literal being written, e.g. number, true
+
+	pending string // held back comma and the following spaces
+}
+
+// NewScanner creates a scanner of the arguments of a tool call.
+func NewScanner() *Scanner {
+	return &Scanner{}
+}
+
+// Write scans the fragment and returns the part kept in the arguments.
+func (s *Scanner) Write(fragment string) string {
+	var out strings.Builder
+	for i := 0; i < len(fragment); i++ {
+		s.scan(fragment[i], &out)
+	}
+	return out.String()
+}
+
+// Close returns the suffix completing the arguments written. It returns "{}" if no object or array is written,
+// as tools without parameters get empty arguments from some models.
+func (s *Scanner) Close() string {
+	if !s.started {
+		s.started, s.done = true, true
+		return "{}"
+	}
+	if s.done {
+		return ""
+	}
+
+	var out strings.Builder
+	s.pending = ""
+	if s.inString {
+		s.escape = ""
+		s.inString = false
+		out.WriteByte('"')
+	} else if s.literal != "" {
+		out.WriteString(completeLiteral(s.literal))
+		s.literal = ""
+	}
+
+	for len(s.stack) > 0 {
+		top := &s.stack[len(s.stack)-1]
+		switch top.state {
+		case objectColon:
+			out.WriteString(":null")
+		case objectValue:
+			out.WriteString("null")
+		}
+		out.WriteByte(top.closer)
+		s.stack = s.stack[:len(s.stack)-1]
+	}
+	s.done = true
+	return out.String()
+}
+
+func (s *Scanner) scan(c byte, out *strings.Builder) {
+	if s.done {
+		return
+	}
+	if !s.started {
+		if c == '{' || c == '[' {
+			s.started = true
+			s.push(c, out)
+		}
+		return
+	}
+	if s.inString {
+		s.scanString(c, out)
+		return
+	}
+
+	if s.literal != "" {
+		if isLiteralByte(c) {
+			s.literal += string(c)
+			out.WriteByte(c)
+			return
+		}
+		out.WriteString(completeLiteral(s.literal))
+		s.literal = ""
+	}
+
+	top := &s.stack[len(s.stack)-1]
+	switch {
+	case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		if s.pending != "" {
+			s.pending += string(c)
+		} else {
+			out.WriteByte(c)
+		}
+	case c == ',':
+		switch top.state {
+		case objectAfter:
+			top.state = objectKey
+			s.pending = ","
+		case arrayAfter:
+			top.state = arrayValue
+			s.pending = ","
+		}
+	case c == ':':
+		if top.state == objectColon {
+			top.state = objectValue
+			out.WriteByte(c)
+		}
+	case c == '}' || c == ']':
+		s.pending = ""
+		switch top.state {
+		case objectColon:
+			out.WriteString(":null")
+		case objectValue:
+			out.WriteString("null")
+		}
+		out.WriteByte(top.closer)
+		s.stack = s.stack[:len(s.stack)-1]
+		if len(s.stack) == 0 {
+			s.done = true
+		}
+	case c == '"':
+		switch top.state {
+		case objectAfter:
+			top.state = objectKey
+			s.pending = ","
+			fallthrough
+		case objectKey:
+			s.flushPending(out)
+			top.state = objectColon
+			s.inString, s.stringIsKey = true, true
+			out.WriteByte(c)
+		default:
+			if s.startValue(top, out) {
+				s.inString, s.stringIsKey = true, false
+				out.WriteByte(c)
+			}
+		}
+	case c == '{' || c == '[':
+		if s.startValue(top, out) {
+			s.push(c, out)
+		}
+	case c == '-' || (c >= '0' && c <= '9') || c == 't' || c == 'f' || c == 'n':
+		if s.startValue(top, out) {
+			s.literal = string(c)
+			out.WriteByte(c)
+		}
+	}
+}
+
+// startValue reports whether a value can start in the frame, and moves the frame to the state after the value.
+func (s *Scanner) startValue(top *frame, out *strings.Builder) bool {
+	switch top.state {
+	case arrayAfter:
+		s.pending = ","
+		fallthrough
+	case arrayValue:
+		top.state = arrayAfter
+	case objectValue:
+		top.state = objectAfter
+	default:
+		return false
+	}
+	s.flushPending(out)
+	return true
+}
+
+func (s *Scanner) push(c byte, out *strings.Builder) {
+	if c == '{' {
+		s.stack = append(s.stack, frame{closer: '}', state: objectKey})
+	} else {
+		s.stack = append(s.stack, frame{closer: ']', state: arrayValue})
+	}
+	out.WriteByte(c)
+}
+
+func (s *Scanner) flushPending(out *strings.Builder) {
+	out.WriteString(s.pending)
+	s.pending = ""
+}
+
+func (s *Scanner) scanString(c byte, out *strings.Builder) {
+	if s.escape != "" {
+		s.escape += string(c)
+		if len(s.escape) == 2 {
+			if c == 'u' {
+				return
+			}
+			if strings.IndexByte(`"\/bfnrt`, c) >= 0 {
+				out.WriteString(s.escape)
+				s.escape = ""
+				return
+			}
+		} else if isHexByte(c) {
+			if len(s.escape) == 6 {
+				out.WriteString(s.escape)
+				s.escape = ""
+			}
+			return
+		}
+
+		// invalid escape sequence, the backslash is kept as a character
+		out.WriteString(`\\` + s.escape[1:len(s.escape)-1])
+		s.escape = ""
+		s.scanString(c, out)
+		return
+	}
+
+	switch {
+	case c == '\\':
+		s.escape = `\`
+	case c == '"':
+		s.inString = false
+		out.WriteByte(c)
+	case c == '\n':
+		out.WriteString(`\n`)
+	case c == '\r':
+		out.WriteString(`\r`)
+	case c == '\t':
+		out.WriteString(`\t`)
+	case c < 0x20:
+		out.WriteString(fmt.Sprintf(`\u%04x`, c))
+	default:
+		out.WriteByte(c)
+	}
+}
+
+func isLiteralByte(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || c == '-' || c == '+' || c == '.' || c == 'E'
+}
+
+func isHexByte(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func completeLiteral(literal string) string {
+	for _, word := range []string{"true", "false", "null"} {
+		if strings.HasPrefix(word, literal) {
+			return word[len(literal):]
+		}
+	}
+	switch literal[len(literal)-1] {
+	case '-', '+', '.', 'e', 'E':
+		return "0"
+	}
+	return ""
+}
+
+// Repair returns the arguments repaired to a valid json object, or array, and whether the result is valid.
+// Valid arguments are returned as they are, empty arguments are repaired to "{}", and arguments encoded as a json
+// string are decoded. The arguments are returned as they are if they can't be repaired.
+func Repair(args string) (string, bool) {
+	trimmed := strings.TrimSpace(args)
+	if trimmed == "" {
+		return "{}", true
+	}
+	if json.Valid([]byte(trimmed)) {
+		switch trimmed[0] {
+		case '{', '[':
+			return args, true
+		case '"':
+			var decoded string
+			if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+				if repaired, ok := Repair(decoded); ok {
+					return repaired, true
+				}
+			}
+		}
+		return args, false
+	}
+
+	s := NewScanner()
+	repaired := s.Write(args) + s.Close()
+	if !json.Valid([]byte(repaired)) {
+		return args, false
+	}
+	return repaired, true
+}
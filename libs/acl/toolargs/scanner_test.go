@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolargs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepair(t *testing.T) {
+	cases := []struct {
+		name string
+		args string
+		want string
+		ok   bool
+	}{
+		{"valid", `{"city": "Paris"}`, `{"city": "Paris"}`, true},
+		{"empty", " ", `{}`, true},
+		{"unclosed object", `{"city":"Paris"`, `{"city":"Paris"}`, true},
+		{"unclosed string", `{"city":"Par`, `{"city":"Par"}`, true},
+		{"unclosed key", `{"ci`, `{"ci":null}`, true},
+		{"missing value", `{"city":`, `{"city":null}`, true},
+		{"missing colon", `{"city"}`, `{"city":null}`, true},
+		{"nested", `{"a":{"b":[1,2`, `{"a":{"b":[1,2]}}`, true},
+		{"trailing comma", `{"a":1,}`, `{"a":1}`, true},
+		{"trailing comma at end", `{"a":[1,`, `{"a":[1]}`, true},
+		{"missing comma", `{"a":1 "b":2}`, `{"a":1 ,"b":2}`, true},
+		{"incomplete literal", `{"a":tr`, `{"a":true}`, true},
+		{"incomplete literal before closer", `{"a":nul}`, `{"a":null}`, true},
+		{"incomplete number", `{"a":1.`, `{"a":1.0}`, true},
+		{"concatenated twice", `{"a":1}{"a":1}`, `{"a":1}`, true},
+		{"trailing garbage", "{\"a\":1}\n```", `{"a":1}`, true},
+		{"code fence", "```json\n{\"a\":1}\n```", `{"a":1}`, true},
+		{"raw newline", "{\"code\":\"a\nb\"}", `{"code":"a\nb"}`, true},
+		{"escapes", `{"a":"\"é\\"}`, `{"a":"\"é\\"}`, true},
+		{"invalid escape", `{"a":"\x"}`, `{"a":"\\x"}`, true},
+		{"extra closer", `{"a":1}}`, `{"a":1}`, true},
+		{"json string", `"{\"a\":1}"`, `{"a":1}`, true},
+		{"not json", `hello`, `{}`, true},
+		{"number", `1`, `1`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := Repair(c.args)
+			assert.Equal(t, c.ok, ok)
+			assert.Equal(t, c.want, got)
+			if ok {
+				assert.True(t, json.Valid([]byte(got)))
+			}
+		})
+	}
+}
+
+func TestScanner(t *testing.T) {
+	s := NewScanner()
+	fragments := []string{`{"ci`, `ty": "Par`, `is", `, `"days": [1, `, `2,`, ` 3]`, `,`, "\n"}
+
+	var got string
+	for _, fragment := range fragments {
+		got += s.Write(fragment)
+	}
+	// the trailing comma is held back
+	assert.Equal(t, `{"city": "Paris", "days": [1, 2, 3]`, got)
+
+	got += s.Close()
+	assert.Equal(t, `{"city": "Paris", "days": [1, 2, 3]}`, got)
+	assert.Equal(t, "", s.Close())
+
+	// escape sequences split across fragments
+	s = NewScanner()
+	got = s.Write(`{"a":"\`) + s.Write(`u00`) + s.Write(`e9\`) + s.Write(`"`) + s.Write(`"}`) + s.Close()
+	assert.Equal(t, `{"a":"\u00e9\""}`, got)
+}
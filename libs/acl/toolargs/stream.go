@@ -0,0 +1,108 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolargs
+
+import (
+	"strings"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// StreamRepairer repairs the arguments of the tool calls of a message stream. Process rewrites the arguments of each
+// message, and Finish returns the message completing the arguments, which is sent after the last message, so that the
+// arguments of the concatenated message are valid json.
+//
+// Tool calls are identified by their indexes. If a tool call without index is received, the tool calls can't be
+// concatenated reliably, and the rest of the stream is passed through. Arguments without any json object or array,
+// e.g. plain text, are held back and returned by Finish as they are.
+type StreamRepairer struct {
+	calls    map[int]*streamToolCall
+	indexes  []int
+	disabled bool
+}
+
+type streamToolCall struct {
+	scanner *Scanner
+	skipped strings.Builder // arguments written before the json starts
+}
+
+// NewStreamRepairer creates a repairer of a message stream.
+func NewStreamRepairer() *StreamRepairer {
+	return &StreamRepairer{calls: make(map[int]*streamToolCall)}
+}
+
+// Process repairs the arguments of the tool calls of msg in place.
+func (r *StreamRepairer) Process(msg *schema.Message) {
+	if r.disabled || msg == nil {
+		return
+	}
+
+	for i := range msg.ToolCalls {
+		if msg.ToolCalls[i].Index == nil {
+			r.disabled = true
+			return
+		}
+	}
+
+	for i := range msg.ToolCalls {
+		tc := &msg.ToolCalls[i]
+		call, ok := r.calls[*tc.Index]
+		if !ok {
+			call = &streamToolCall{scanner: NewScanner()}
+			r.calls[*tc.Index] = call
+			r.indexes = append(r.indexes, *tc.Index)
+		}
+
+		args := tc.Function.Arguments
+		tc.Function.Arguments = call.scanner.Write(args)
+		if !call.scanner.started {
+			call.skipped.WriteString(args)
+		}
+	}
+}
+
+// Finish returns the message completing the arguments of the tool calls, or nil if all of them are complete.
+func (r *StreamRepairer) Finish() *schema.Message {
+	if r.disabled {
+		return nil
+	}
+
+	var toolCalls []schema.ToolCall
+	for _, index := range r.indexes {
+		call := r.calls[index]
+		suffix := call.skipped.String()
+		if call.scanner.started || strings.TrimSpace(suffix) == "" {
+			suffix = call.scanner.Close()
+		}
+		if suffix == "" {
+			continue
+		}
+		index := index
+		toolCalls = append(toolCalls, schema.ToolCall{
+			Index:    &index,
+			Function: schema.FunctionCall{Arguments: suffix},
+		})
+	}
+	if len(toolCalls) == 0 {
+		return nil
+	}
+
+	return &schema.Message{
+		Role:      schema.Assistant,
+		ToolCalls: toolCalls,
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package toolargs
+
+import (
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func toolCallChunk(index int, id, name, args string) *schema.Message {
+	return &schema.Message{
+		Role: schema.Assistant,
+		ToolCalls: []schema.ToolCall{{
+			Index:    &index,
+			ID:       id,
+			Function: schema.FunctionCall{Name: name, Arguments: args},
+		}},
+	}
+}
+
+func TestStreamRepairer(t *testing.T) {
+	chunks := []*schema.Message{
+		toolCallChunk(0, "call_1", "get_weather", ""),
+		toolCallChunk(0, "", "", `{"city":`),
+		toolCallChunk(0, "", "", `"Paris"}`),
+		// arguments generated again in the last chunk
+		toolCallChunk(0, "", "", `{"city":"Paris"}`),
+		toolCallChunk(1, "call_2", "get_time", `{"zone":"Europe/Par`),
+		toolCallChunk(2, "call_3", "get_date", ""),
+	}
+
+	r := NewStreamRepairer()
+	for _, chunk := range chunks {
+		r.Process(chunk)
+	}
+	last := r.Finish()
+	assert.NotNil(t, last)
+
+	msg, err := schema.ConcatMessages(append(chunks, last))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(msg.ToolCalls))
+	assert.Equal(t, "call_1", msg.ToolCalls[0].ID)
+	assert.Equal(t, `{"city":"Paris"}`, msg.ToolCalls[0].Function.Arguments)
+	assert.Equal(t, `{"zone":"Europe/Par"}`, msg.ToolCalls[1].Function.Arguments)
+	assert.Equal(t, `{}`, msg.ToolCalls[2].Function.Arguments)
+
+	t.Run("complete", func(t *testing.T) {
+		r := NewStreamRepairer()
+		r.Process(toolCallChunk(0, "call_1", "get_weather", `{"city":"Paris"}`))
+		r.Process(&schema.Message{Role: schema.Assistant, Content: "done"})
+		assert.Nil(t, r.Finish())
+	})
+
+	t.Run("plain text", func(t *testing.T) {
+		r := NewStreamRepairer()
+		chunk := toolCallChunk(0, "call_1", "echo", "hello")
+		r.Process(chunk)
+		assert.Equal(t, "", chunk.ToolCalls[0].Function.Arguments)
+		last := r.Finish()
+		assert.NotNil(t, last)
+		assert.Equal(t, "hello", last.ToolCalls[0].Function.Arguments)
+	})
+
+	t.Run("without index", func(t *testing.T) {
+		r := NewStreamRepairer()
+		r.Process(toolCallChunk(0, "call_1", "get_weather", `{"city":`))
+		chunk := &schema.Message{Role: schema.Assistant, ToolCalls: []schema.ToolCall{{Function: schema.FunctionCall{Arguments: `"Paris"}}`}}}}
+		r.Process(chunk)
+		assert.Equal(t, `"Paris"}}`, chunk.ToolCalls[0].Function.Arguments)
+		assert.Nil(t, r.Finish())
+	})
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package embedproc post-processes the vectors of embedders, so that they match the expectations of vector stores:
+// dimension truncation of Matryoshka (MRL) models, L2 normalization, and float32 precision.
+// It's shared by the embedders in components/embedding, which accept a Config, and WithConfig per call.
+package embedproc
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cloudwego/eino/components/embedding"
+)
+
+// Config configures the post-processing, which is applied in order: truncation, normalization, float32 conversion.
+type Config struct {
+	// Dimensions truncates vectors to their first Dimensions values, e.g. for Matryoshka (MRL) models
+	// whose prefixes are valid embeddings, to fit the dimension of the vector store.
+	// Optional. Default: 0, not truncated.
+	Dimensions int `json:"dimensions,omitempty"`
+	// Normalize scales vectors to unit L2 norm, so that inner product equals cosine similarity.
+	// It's applied after truncation, as truncated vectors are not normalized. Zero vectors are kept.
+	// Optional. Default: false.
+	Normalize bool `json:"normalize,omitempty"`
+	// Float32 rounds values to float32 precision, the precision of most vector stores,
+	// so that stored vectors equal the returned ones.
+	// Optional. Default: false.
+	Float32 bool `json:"float32,omitempty"`
+}
+
+// Enabled reports whether the config changes vectors.
+func (c *Config) Enabled() bool {
+	return c != nil && (c.Dimensions > 0 || c.Normalize || c.Float32)
+}
+
+// Apply post-processes the vectors in place, and returns them. A nil config returns the vectors as is.
+func Apply(vectors [][]float64, config *Config) ([][]float64, error) {
+	if !config.Enabled() {
+		return vectors, nil
+	}
+
+	for i, v := range vectors {
+		if config.Dimensions > 0 {
+			if len(v) < config.Dimensions {
+				return nil, fmt.Errorf("[embedproc] vector[%d] has %d dimensions, less than %d", i, len(v), config.Dimensions)
+			}
+			v = v[:config.Dimensions:config.Dimensions]
+		}
+
+		if config.Normalize {
+			sum := 0.0
+			for _, x := range v {
+				sum += x * x
+			}
+			if norm := math.Sqrt(sum); norm > 0 {
+				for j := range v {
+					v[j] /= norm
+				}
+			}
+		}
+
+		if config.Float32 {
+			for j := range v {
+				v[j] = float64(float32(v[j]))
+			}
+		}
+
+		vectors[i] = v
+	}
+
+	return vectors, nil
+}
+
+type options struct {
+	config *Config
+}
+
+// WithConfig overrides the post-processing config of the embedder for a call, nil disables it.
+func WithConfig(config *Config) embedding.Option {
+	return embedding.WrapImplSpecificOptFn(func(o *options) {
+		o.config = config
+	})
+}
+
+// GetConfig returns the config of WithConfig in opts, or base if not set.
+func GetConfig(base *Config, opts ...embedding.Option) *Config {
+	return embedding.GetImplSpecificOptions(&options{config: base}, opts...).config
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package embedproc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApply(t *testing.T) {
+	vectors := [][]float64{{3, 4, 12}, {0, 0, 1}}
+	result, err := Apply(vectors, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float64{{3, 4, 12}, {0, 0, 1}}, result)
+	assert.False(t, (&Config{}).Enabled())
+
+	result, err = Apply([][]float64{{3, 4, 12}, {0, 0, 1}}, &Config{Dimensions: 2, Normalize: true})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float64{{0.6, 0.8}, {0, 0}}, result)
+
+	result, err = Apply([][]float64{{3, 4, 12}}, &Config{Normalize: true})
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, math.Sqrt(result[0][0]*result[0][0]+result[0][1]*result[0][1]+result[0][2]*result[0][2]), 1e-12)
+
+	result, err = Apply([][]float64{{0.1, 1.5}}, &Config{Float32: true})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(float32(0.1)), result[0][0])
+	assert.Equal(t, 1.5, result[0][1])
+
+	_, err = Apply([][]float64{{1, 2}}, &Config{Dimensions: 3})
+	assert.Error(t, err)
+}
+
+func TestGetConfig(t *testing.T) {
+	base := &Config{Normalize: true}
+	assert.Equal(t, base, GetConfig(base))
+	assert.Equal(t, base, GetConfig(base, embedding.WithModel("m")))
+
+	override := &Config{Dimensions: 256}
+	assert.Equal(t, override, GetConfig(base, WithConfig(override)))
+	assert.Nil(t, GetConfig(base, WithConfig(nil)))
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filter
+
+// ToES translates the expression into an Elasticsearch query, e.g. to be used as the filter of a bool query,
+// or marshaled and unmarshaled into types.Query of the official client.
+// Eq and in are translated to term and terms queries, which match keyword fields exactly,
+// map text fields to their keyword sub fields with WithFieldMapper.
+func ToES(e *Expr, opts ...Option) (map[string]any, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	o := getOptions(&options{}, opts...)
+
+	return toES(e, o), nil
+}
+
+func toES(e *Expr, o *options) map[string]any {
+	switch e.Op {
+	case OpEq:
+		return map[string]any{"term": map[string]any{o.fieldMapper(e.Field): e.Value}}
+	case OpIn:
+		return map[string]any{"terms": map[string]any{o.fieldMapper(e.Field): e.Values}}
+	case OpRange:
+		bounds := make(map[string]any)
+		for _, b := range e.bounds() {
+			bounds[b.op] = b.value
+		}
+		return map[string]any{"range": map[string]any{o.fieldMapper(e.Field): bounds}}
+	}
+
+	queries := make([]any, len(e.Exprs))
+	for i, sub := range e.Exprs {
+		queries[i] = toES(sub, o)
+	}
+	switch e.Op {
+	case OpAnd:
+		return map[string]any{"bool": map[string]any{"filter": queries}}
+	case OpOr:
+		return map[string]any{"bool": map[string]any{"should": queries, "minimum_should_match": 1}}
+	default:
+		return map[string]any{"bool": map[string]any{"must_not": queries}}
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino-ext/libs/filter"
+)
+
+func main() {
+	// go documents since 2020 which are not drafts
+	expr := filter.And(
+		filter.Eq("lang", "go"),
+		filter.In("tag", "tutorial", "guide"),
+		filter.Gte("year", 2020),
+		filter.Not(filter.Eq("draft", true)),
+	)
+
+	dsl, err := filter.ToVikingDB(expr)
+	if err != nil {
+		log.Fatalf("ToVikingDB failed, err=%v", err)
+	}
+	printJSON("vikingdb", dsl)
+
+	query, err := filter.ToES(expr)
+	if err != nil {
+		log.Fatalf("ToES failed, err=%v", err)
+	}
+	printJSON("es", query)
+
+	cond, args, err := filter.ToPgvector(expr)
+	if err != nil {
+		log.Fatalf("ToPgvector failed, err=%v", err)
+	}
+	fmt.Printf("pgvector: %s, args: %v\n", cond, args)
+
+	milvusExpr, err := filter.ToMilvus(expr)
+	if err != nil {
+		log.Fatalf("ToMilvus failed, err=%v", err)
+	}
+	fmt.Printf("milvus: %s\n", milvusExpr)
+}
+
+func printJSON(name string, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Fatalf("json.Marshal failed, err=%v", err)
+	}
+	fmt.Printf("%s: %s\n", name, data)
+}
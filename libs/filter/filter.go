@@ -0,0 +1,287 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package filter defines a store-agnostic metadata filter expression, and translates it
+// into the filter syntax of vector stores: VikingDB FilterDSL, Elasticsearch query DSL,
+// pgvector sql and Milvus boolean expressions.
+package filter
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Op string
+
+const (
+	OpEq    Op = "eq"
+	OpIn    Op = "in"
+	OpRange Op = "range"
+	OpAnd   Op = "and"
+	OpOr    Op = "or"
+	OpNot   Op = "not"
+)
+
+// Expr is a filter expression, build it with Eq, In, Gt, Gte, Lt, Lte, Between, And, Or and Not.
+// It's json serializable, so that it can be kept in configs or graph state.
+type Expr struct {
+	Op    Op     `json:"op"`
+	Field string `json:"field,omitempty"`
+	// Value is the value of OpEq.
+	Value any `json:"value,omitempty"`
+	// Values are the values of OpIn.
+	Values []any `json:"values,omitempty"`
+	// Gt, Gte, Lt and Lte are the bounds of OpRange, at least one of them is set.
+	Gt  any `json:"gt,omitempty"`
+	Gte any `json:"gte,omitempty"`
+	Lt  any `json:"lt,omitempty"`
+	Lte any `json:"lte,omitempty"`
+	// Exprs are the operands of OpAnd and OpOr, or the single operand of OpNot.
+	Exprs []*Expr `json:"exprs,omitempty"`
+}
+
+// Eq matches documents whose field equals value.
+func Eq(field string, value any) *Expr {
+	return &Expr{Op: OpEq, Field: field, Value: value}
+}
+
+// In matches documents whose field equals any of values.
+func In(field string, values ...any) *Expr {
+	return &Expr{Op: OpIn, Field: field, Values: values}
+}
+
+// Gt matches documents whose field is greater than value.
+func Gt(field string, value any) *Expr {
+	return &Expr{Op: OpRange, Field: field, Gt: value}
+}
+
+// Gte matches documents whose field is greater than or equal to value.
+func Gte(field string, value any) *Expr {
+	return &Expr{Op: OpRange, Field: field, Gte: value}
+}
+
+// Lt matches documents whose field is less than value.
+func Lt(field string, value any) *Expr {
+	return &Expr{Op: OpRange, Field: field, Lt: value}
+}
+
+// Lte matches documents whose field is less than or equal to value.
+func Lte(field string, value any) *Expr {
+	return &Expr{Op: OpRange, Field: field, Lte: value}
+}
+
+// Between matches documents whose field is in [lower, upper].
+func Between(field string, lower, upper any) *Expr {
+	return &Expr{Op: OpRange, Field: field, Gte: lower, Lte: upper}
+}
+
+// And matches documents matching all of exprs.
+func And(exprs ...*Expr) *Expr {
+	return &Expr{Op: OpAnd, Exprs: exprs}
+}
+
+// Or matches documents matching any of exprs.
+func Or(exprs ...*Expr) *Expr {
+	return &Expr{Op: OpOr, Exprs: exprs}
+}
+
+// Not matches documents not matching expr.
+func Not(expr *Expr) *Expr {
+	return &Expr{Op: OpNot, Exprs: []*Expr{expr}}
+}
+
+// Validate checks the expression recursively, translators validate the expression before translating.
+func (e *Expr) Validate() error {
+	if e == nil {
+		return errors.New("filter expression is nil")
+	}
+
+	switch e.Op {
+	case OpEq, OpIn, OpRange:
+		if e.Field == "" {
+			return fmt.Errorf("field of %s is empty", e.Op)
+		}
+	}
+
+	switch e.Op {
+	case OpEq:
+		return checkValue(e.Field, e.Value)
+	case OpIn:
+		if len(e.Values) == 0 {
+			return fmt.Errorf("values of in %s are empty", e.Field)
+		}
+		for _, v := range e.Values {
+			if err := checkValue(e.Field, v); err != nil {
+				return err
+			}
+		}
+	case OpRange:
+		if e.Gt == nil && e.Gte == nil && e.Lt == nil && e.Lte == nil {
+			return fmt.Errorf("bounds of range %s are empty", e.Field)
+		}
+		if (e.Gt != nil && e.Gte != nil) || (e.Lt != nil && e.Lte != nil) {
+			return fmt.Errorf("range %s has both exclusive and inclusive bounds on the same side", e.Field)
+		}
+		for _, b := range e.bounds() {
+			if err := checkValue(e.Field, b.value); err != nil {
+				return err
+			}
+			if kind, _ := kindOf(b.value); kind == kindBool {
+				return fmt.Errorf("bounds of range %s must be numbers or strings", e.Field)
+			}
+		}
+	case OpAnd, OpOr:
+		if len(e.Exprs) == 0 {
+			return fmt.Errorf("operands of %s are empty", e.Op)
+		}
+		for _, sub := range e.Exprs {
+			if err := sub.Validate(); err != nil {
+				return err
+			}
+		}
+	case OpNot:
+		if len(e.Exprs) != 1 {
+			return fmt.Errorf("not requires exactly one operand, got %d", len(e.Exprs))
+		}
+		return e.Exprs[0].Validate()
+	default:
+		return fmt.Errorf("unknown filter op: %s", e.Op)
+	}
+
+	return nil
+}
+
+// bound is a single side of a range.
+type bound struct {
+	// op is one of gt, gte, lt and lte
+	op    string
+	value any
+}
+
+func (e *Expr) bounds() []bound {
+	var bs []bound
+	if e.Gt != nil {
+		bs = append(bs, bound{op: "gt", value: e.Gt})
+	}
+	if e.Gte != nil {
+		bs = append(bs, bound{op: "gte", value: e.Gte})
+	}
+	if e.Lt != nil {
+		bs = append(bs, bound{op: "lt", value: e.Lt})
+	}
+	if e.Lte != nil {
+		bs = append(bs, bound{op: "lte", value: e.Lte})
+	}
+	return bs
+}
+
+// negations of the bound ops, used to push down not
+var negatedBoundOps = map[string]string{"gt": "lte", "gte": "lt", "lt": "gte", "lte": "gt"}
+
+// pushDownNot rewrites the expression so that not only applies to eq and in, with De Morgan's laws.
+// A negated range becomes the or of its negated bounds.
+func pushDownNot(e *Expr, negate bool) *Expr {
+	switch e.Op {
+	case OpEq, OpIn:
+		if negate {
+			return Not(e)
+		}
+		return e
+	case OpRange:
+		if !negate {
+			return e
+		}
+		var exprs []*Expr
+		for _, b := range e.bounds() {
+			exprs = append(exprs, rangeOf(e.Field, negatedBoundOps[b.op], b.value))
+		}
+		if len(exprs) == 1 {
+			return exprs[0]
+		}
+		return Or(exprs...)
+	case OpAnd, OpOr:
+		op := e.Op
+		if negate {
+			if op == OpAnd {
+				op = OpOr
+			} else {
+				op = OpAnd
+			}
+		}
+		exprs := make([]*Expr, len(e.Exprs))
+		for i, sub := range e.Exprs {
+			exprs[i] = pushDownNot(sub, negate)
+		}
+		return &Expr{Op: op, Exprs: exprs}
+	case OpNot:
+		return pushDownNot(e.Exprs[0], !negate)
+	}
+	return e
+}
+
+func rangeOf(field, op string, value any) *Expr {
+	switch op {
+	case "gt":
+		return Gt(field, value)
+	case "gte":
+		return Gte(field, value)
+	case "lt":
+		return Lt(field, value)
+	default:
+		return Lte(field, value)
+	}
+}
+
+// Option configures the translation.
+type Option func(o *options)
+
+type options struct {
+	fieldMapper    func(field string) string
+	paramOffset    int
+	metadataColumn string
+}
+
+// WithFieldMapper maps the fields of expressions to the fields of the store, e.g. "lang" to "lang.keyword" for Elasticsearch.
+// For Milvus, the mapped field is used in the expression as is, the default maps "lang" to `metadata["lang"]`.
+func WithFieldMapper(fn func(field string) string) Option {
+	return func(o *options) {
+		o.fieldMapper = fn
+	}
+}
+
+// WithParamOffset sets the index of the first sql parameter of ToPgvector, default 2, as $1 is the query vector of pgvector retriever.
+func WithParamOffset(offset int) Option {
+	return func(o *options) {
+		o.paramOffset = offset
+	}
+}
+
+// WithMetadataColumn sets the jsonb column of ToPgvector, default "metadata", the same as pgvector indexer.
+func WithMetadataColumn(column string) Option {
+	return func(o *options) {
+		o.metadataColumn = column
+	}
+}
+
+func getOptions(base *options, opts ...Option) *options {
+	for _, opt := range opts {
+		opt(base)
+	}
+	if base.fieldMapper == nil {
+		base.fieldMapper = func(field string) string { return field }
+	}
+	return base
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testExpr() *Expr {
+	return And(
+		Eq("lang", "go"),
+		In("tag", "a", "b"),
+		Between("year", 2020, 2024),
+		Not(Or(Eq("draft", true), Gt("score", 0.5))),
+	)
+}
+
+func toJSON(t *testing.T, v any) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, testExpr().Validate())
+
+	for _, e := range []*Expr{
+		nil,
+		{Op: "like", Field: "a"},
+		Eq("", "go"),
+		Eq("lang", nil),
+		Eq("lang", []string{"go"}),
+		In("tag"),
+		{Op: OpRange, Field: "year"},
+		{Op: OpRange, Field: "year", Gt: 1, Gte: 2},
+		Gt("draft", true),
+		And(),
+		Or(Eq("lang", "go"), nil),
+		{Op: OpNot, Exprs: []*Expr{Eq("a", 1), Eq("b", 2)}},
+	} {
+		assert.Error(t, e.Validate(), toJSON(t, e))
+	}
+
+	// expressions are json serializable
+	var e *Expr
+	require.NoError(t, json.Unmarshal([]byte(toJSON(t, testExpr())), &e))
+	assert.NoError(t, e.Validate())
+}
+
+func TestToVikingDB(t *testing.T) {
+	dsl, err := ToVikingDB(testExpr())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"op": "and", "conds": [
+		{"op": "must", "field": "lang", "conds": ["go"]},
+		{"op": "must", "field": "tag", "conds": ["a", "b"]},
+		{"op": "range", "field": "year", "gte": 2020, "lte": 2024},
+		{"op": "and", "conds": [
+			{"op": "must_not", "field": "draft", "conds": [true]},
+			{"op": "range", "field": "score", "lte": 0.5}
+		]}
+	]}`, toJSON(t, dsl))
+
+	dsl, err = ToVikingDB(Not(Not(Not(Between("year", 2020, 2024)))), WithFieldMapper(func(f string) string { return "meta_" + f }))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"op": "or", "conds": [
+		{"op": "range", "field": "meta_year", "lt": 2020},
+		{"op": "range", "field": "meta_year", "gt": 2024}
+	]}`, toJSON(t, dsl))
+
+	_, err = ToVikingDB(In("tag"))
+	assert.Error(t, err)
+}
+
+func TestToES(t *testing.T) {
+	query, err := ToES(testExpr(), WithFieldMapper(func(f string) string {
+		if f == "lang" {
+			return "lang.keyword"
+		}
+		return f
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"bool": {"filter": [
+		{"term": {"lang.keyword": "go"}},
+		{"terms": {"tag": ["a", "b"]}},
+		{"range": {"year": {"gte": 2020, "lte": 2024}}},
+		{"bool": {"must_not": [
+			{"bool": {"should": [{"term": {"draft": true}}, {"range": {"score": {"gt": 0.5}}}], "minimum_should_match": 1}}
+		]}}
+	]}}`, toJSON(t, query))
+}
+
+func TestToPgvector(t *testing.T) {
+	cond, args, err := ToPgvector(testExpr())
+	require.NoError(t, err)
+	assert.Equal(t, `(metadata @> $2::jsonb) AND (metadata->'tag' <@ $3::jsonb) `+
+		`AND ((metadata->>'year')::numeric >= $4 AND (metadata->>'year')::numeric <= $5) `+
+		`AND (NOT ((metadata @> $6::jsonb) OR ((metadata->>'score')::numeric > $7)))`, cond)
+	assert.Equal(t, []any{`{"lang":"go"}`, `["a","b"]`, 2020, 2024, `{"draft":true}`, 0.5}, args)
+
+	cond, args, err = ToPgvector(Lt("it's", "2024-01-01T00:00:00Z"), WithParamOffset(1), WithMetadataColumn("meta"))
+	require.NoError(t, err)
+	assert.Equal(t, `meta->>'it''s' < $1`, cond)
+	assert.Equal(t, []any{"2024-01-01T00:00:00Z"}, args)
+}
+
+func TestToMilvus(t *testing.T) {
+	expr, err := ToMilvus(testExpr())
+	require.NoError(t, err)
+	assert.Equal(t, `(metadata["lang"] == "go") and (metadata["tag"] in ["a", "b"]) `+
+		`and (metadata["year"] >= 2020 and metadata["year"] <= 2024) `+
+		`and (not ((metadata["draft"] == true) or (metadata["score"] > 0.5)))`, expr)
+
+	expr, err = ToMilvus(Eq("title", `say "hi"`), WithFieldMapper(func(f string) string { return f }))
+	require.NoError(t, err)
+	assert.Equal(t, `title == "say \"hi\""`, expr)
+}
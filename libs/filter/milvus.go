@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToMilvus translates the expression into a Milvus boolean expression, see https://milvus.io/docs/boolean.md.
+// The result can be passed by milvus.WithFilter of milvus retriever.
+// Fields are mapped to the keys of the json field "metadata" by default, the same as milvus indexer,
+// use WithFieldMapper for scalar fields or dynamic fields.
+func ToMilvus(e *Expr, opts ...Option) (string, error) {
+	if err := e.Validate(); err != nil {
+		return "", err
+	}
+	o := getOptions(&options{fieldMapper: func(field string) string {
+		return fmt.Sprintf("metadata[%q]", field)
+	}}, opts...)
+
+	return toMilvus(e, o), nil
+}
+
+var milvusBoundOps = map[string]string{"gt": ">", "gte": ">=", "lt": "<", "lte": "<="}
+
+func toMilvus(e *Expr, o *options) string {
+	switch e.Op {
+	case OpEq:
+		return fmt.Sprintf("%s == %s", o.fieldMapper(e.Field), literal(e.Value))
+	case OpIn:
+		values := make([]string, len(e.Values))
+		for i, v := range e.Values {
+			values[i] = literal(v)
+		}
+		return fmt.Sprintf("%s in [%s]", o.fieldMapper(e.Field), strings.Join(values, ", "))
+	case OpRange:
+		field := o.fieldMapper(e.Field)
+		conds := make([]string, 0, 2)
+		for _, b := range e.bounds() {
+			conds = append(conds, fmt.Sprintf("%s %s %s", field, milvusBoundOps[b.op], literal(b.value)))
+		}
+		return strings.Join(conds, " and ")
+	case OpNot:
+		return fmt.Sprintf("not (%s)", toMilvus(e.Exprs[0], o))
+	}
+
+	conds := make([]string, len(e.Exprs))
+	for i, sub := range e.Exprs {
+		conds[i] = "(" + toMilvus(sub, o) + ")"
+	}
+	return strings.Join(conds, " "+string(e.Op)+" ")
+}
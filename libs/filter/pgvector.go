@@ -0,0 +1,114 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ToPgvector translates the expression into a sql condition on the jsonb metadata column, and its parameters.
+// The result can be passed by pgvector.WithFilter of pgvector retriever, parameters start from $2 by default,
+// as $1 is the query vector, see WithParamOffset.
+// Eq and in use jsonb containment, which can be accelerated by a GIN index on the column,
+// ranges compare numbers as numeric, and strings as text, e.g. RFC3339 times.
+func ToPgvector(e *Expr, opts ...Option) (string, []any, error) {
+	if err := e.Validate(); err != nil {
+		return "", nil, err
+	}
+	o := getOptions(&options{paramOffset: 2, metadataColumn: "metadata"}, opts...)
+
+	b := &pgBuilder{o: o}
+	cond, err := b.build(e)
+	if err != nil {
+		return "", nil, err
+	}
+	return cond, b.args, nil
+}
+
+type pgBuilder struct {
+	o    *options
+	args []any
+}
+
+// param adds an argument, and returns its placeholder.
+func (b *pgBuilder) param(v any) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", b.o.paramOffset+len(b.args)-1)
+}
+
+// jsonParam adds an argument encoded as json, and returns its placeholder cast to jsonb.
+func (b *pgBuilder) jsonParam(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal filter value failed: %w", err)
+	}
+	return b.param(string(data)) + "::jsonb", nil
+}
+
+// key quotes the field as a sql string literal, to be used as a json key.
+func (b *pgBuilder) key(field string) string {
+	return "'" + strings.ReplaceAll(b.o.fieldMapper(field), "'", "''") + "'"
+}
+
+var pgBoundOps = map[string]string{"gt": ">", "gte": ">=", "lt": "<", "lte": "<="}
+
+func (b *pgBuilder) build(e *Expr) (string, error) {
+	column := b.o.metadataColumn
+	switch e.Op {
+	case OpEq:
+		p, err := b.jsonParam(map[string]any{b.o.fieldMapper(e.Field): e.Value})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s @> %s", column, p), nil
+	case OpIn:
+		// a json array contains a primitive value equal to any of its elements
+		p, err := b.jsonParam(e.Values)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s->%s <@ %s", column, b.key(e.Field), p), nil
+	case OpRange:
+		conds := make([]string, 0, 2)
+		for _, bd := range e.bounds() {
+			lhs := fmt.Sprintf("%s->>%s", column, b.key(e.Field))
+			if kind, _ := kindOf(bd.value); kind == kindNumber {
+				lhs = "(" + lhs + ")::numeric"
+			}
+			conds = append(conds, fmt.Sprintf("%s %s %s", lhs, pgBoundOps[bd.op], b.param(bd.value)))
+		}
+		return strings.Join(conds, " AND "), nil
+	case OpNot:
+		cond, err := b.build(e.Exprs[0])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("NOT (%s)", cond), nil
+	}
+
+	conds := make([]string, len(e.Exprs))
+	for i, sub := range e.Exprs {
+		cond, err := b.build(sub)
+		if err != nil {
+			return "", err
+		}
+		conds[i] = "(" + cond + ")"
+	}
+	return strings.Join(conds, " "+strings.ToUpper(string(e.Op))+" "), nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindNumber
+	kindBool
+)
+
+// kindOf returns the kind of a filter value, only strings, numbers and bools are supported by all the stores.
+func kindOf(v any) (valueKind, bool) {
+	switch v.(type) {
+	case string:
+		return kindString, true
+	case bool:
+		return kindBool, true
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
+		return kindNumber, true
+	}
+	return 0, false
+}
+
+func checkValue(field string, v any) error {
+	if v == nil {
+		return fmt.Errorf("value of %s is nil", field)
+	}
+	if _, ok := kindOf(v); !ok {
+		return fmt.Errorf("value of %s has unsupported type %T, only strings, numbers and bools are supported", field, v)
+	}
+	return nil
+}
+
+// literal formats a value as a literal of Milvus expressions, strings are double quoted.
+func literal(v any) string {
+	switch val := v.(type) {
+	case string:
+		return strconv.Quote(val)
+	case json.Number:
+		return val.String()
+	case float32:
+		return strconv.FormatFloat(float64(val), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
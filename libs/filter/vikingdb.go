@@ -0,0 +1,55 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filter
+
+// ToVikingDB translates the expression into VikingDB FilterDSL, see https://www.volcengine.com/docs/84313/1254609.
+// The result can be set to RetrieverConfig.FilterDSL of volc_vikingdb retriever, or passed by retriever.WithDSLInfo.
+// As VikingDB only negates eq and in with must_not, not is pushed down to them, and a negated range becomes the or of its negated bounds.
+func ToVikingDB(e *Expr, opts ...Option) (map[string]any, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	o := getOptions(&options{}, opts...)
+
+	return toVikingDB(pushDownNot(e, false), o), nil
+}
+
+func toVikingDB(e *Expr, o *options) map[string]any {
+	switch e.Op {
+	case OpEq:
+		return map[string]any{"op": "must", "field": o.fieldMapper(e.Field), "conds": []any{e.Value}}
+	case OpIn:
+		return map[string]any{"op": "must", "field": o.fieldMapper(e.Field), "conds": e.Values}
+	case OpRange:
+		dsl := map[string]any{"op": "range", "field": o.fieldMapper(e.Field)}
+		for _, b := range e.bounds() {
+			dsl[b.op] = b.value
+		}
+		return dsl
+	case OpNot:
+		// pushed down, the operand is eq or in
+		dsl := toVikingDB(e.Exprs[0], o)
+		dsl["op"] = "must_not"
+		return dsl
+	default:
+		conds := make([]any, len(e.Exprs))
+		for i, sub := range e.Exprs {
+			conds[i] = toVikingDB(sub, o)
+		}
+		return map[string]any{"op": string(e.Op), "conds": conds}
+	}
+}
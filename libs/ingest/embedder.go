@@ -0,0 +1,61 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ingest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/embedding"
+)
+
+// precomputedEmbedder serves the vectors computed by the pipeline to the indexer,
+// texts which were not embedded by the pipeline, e.g. stringified fields, are embedded by fallback.
+type precomputedEmbedder struct {
+	vectors  map[string][]float64
+	fallback embedding.Embedder
+}
+
+func (e *precomputedEmbedder) EmbedStrings(ctx context.Context, texts []string, opts ...embedding.Option) ([][]float64, error) {
+	result := make([][]float64, len(texts))
+
+	var missing []string
+	var missingIdx []int
+	for i, text := range texts {
+		if v, ok := e.vectors[text]; ok {
+			result[i] = v
+			continue
+		}
+		missing = append(missing, text)
+		missingIdx = append(missingIdx, i)
+	}
+
+	if len(missing) > 0 {
+		vectors, err := e.fallback.EmbedStrings(ctx, missing, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if len(vectors) != len(missing) {
+			return nil, fmt.Errorf("invalid vectors length, expected=%d, got=%d", len(missing), len(vectors))
+		}
+		for j, v := range vectors {
+			result[missingIdx[j]] = v
+		}
+	}
+
+	return result, nil
+}
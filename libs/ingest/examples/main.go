@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/libs/ingest"
+)
+
+func main() {
+	ctx := context.Background()
+
+	manifest, err := ingest.NewFileManifest(filepath.Join(os.TempDir(), "eino-ingest-example.json"))
+	if err != nil {
+		log.Fatalf("NewFileManifest failed, err=%v", err)
+	}
+
+	p, err := ingest.NewPipeline(ctx, &ingest.Config{
+		Loader:       &fileLoader{},                                // any loader, e.g. file or url loader
+		Transformers: []document.Transformer{&paragraphSplitter{}}, // any transformers, e.g. recursive splitter
+		Indexer:      &printIndexer{},                              // any indexer, e.g. volc_vikingdb indexer
+		Manifest:     manifest,
+		OnProgress: func(ctx context.Context, p *ingest.Progress) {
+			log.Printf("[%d/%d] %s %s, chunks=%d, err=%v", p.Done, p.Total, p.Status, p.URI, p.Chunks, p.Err)
+		},
+	})
+	if err != nil {
+		log.Fatalf("NewPipeline failed, err=%v", err)
+	}
+
+	files, err := filepath.Glob("*.md")
+	if err != nil {
+		log.Fatalf("Glob failed, err=%v", err)
+	}
+	sources := make([]document.Source, len(files))
+	for i, f := range files {
+		sources[i] = document.Source{URI: f}
+	}
+
+	// run the example again, unchanged files are skipped with the manifest
+	report, err := p.Run(ctx, sources)
+	if err != nil {
+		log.Fatalf("Run failed, err=%v", err)
+	}
+	log.Printf("indexed=%d, skipped=%d, failed=%d, chunks=%d", report.Indexed, report.Skipped, report.Failed, report.Chunks)
+}
+
+type fileLoader struct{}
+
+func (l *fileLoader) Load(ctx context.Context, src document.Source, opts ...document.LoaderOption) ([]*schema.Document, error) {
+	data, err := os.ReadFile(src.URI)
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.Document{{Content: string(data)}}, nil
+}
+
+type paragraphSplitter struct{}
+
+func (s *paragraphSplitter) Transform(ctx context.Context, src []*schema.Document, opts ...document.TransformerOption) ([]*schema.Document, error) {
+	var result []*schema.Document
+	for _, doc := range src {
+		for _, p := range strings.Split(doc.Content, "\n\n") {
+			if strings.TrimSpace(p) != "" {
+				result = append(result, &schema.Document{Content: p, MetaData: doc.MetaData})
+			}
+		}
+	}
+	return result, nil
+}
+
+type printIndexer struct{}
+
+func (p *printIndexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) ([]string, error) {
+	ids := make([]string, len(docs))
+	for i, doc := range docs {
+		log.Printf("index chunk id=%s, source=%v, length=%d", doc.ID, doc.MetaData["_source"], len(doc.Content))
+		ids[i] = doc.ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,462 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ingest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+)
+
+const defaultSourceKey = "_source"
+
+type Config struct {
+	// Loader loads documents of a source.
+	// Required.
+	Loader document.Loader
+	// Parser parses the content of loaded documents, e.g. extracting text from html fetched by an url loader.
+	// Optional. Default: the loaded documents are used as is
+	Parser parser.Parser
+	// Transformers are applied to the documents of a source in order, e.g. a splitter followed by a metadata extractor.
+	// Optional.
+	Transformers []document.Transformer
+	// Embedder embeds the chunks in batches with retries before indexing. The vectors are attached to chunks
+	// with WithDenseVector, and handed to the indexer by indexer.WithEmbedding, so that they are not computed again.
+	// Optional. Default: the indexer embeds chunks with its own embedder
+	Embedder embedding.Embedder
+	// Indexer stores the chunks.
+	// Required.
+	Indexer indexer.Indexer
+	// Manifest records the content hash of indexed sources, sources whose hash is unchanged are skipped,
+	// so that an interrupted run can be resumed by running again. See NewInMemoryManifest and NewFileManifest.
+	// Sync requires it to implement StateStore, which both of them do.
+	// Optional. Default: every source is indexed
+	Manifest Manifest
+	// Deleter deletes stale chunks from the store by ids, required by Sync.
+	// Optional. Default: Indexer if it implements Deleter, e.g. volc_vikingdb indexer
+	Deleter Deleter
+
+	// Workers is the number of sources processed concurrently.
+	// Optional. Default: 4
+	Workers int
+	// MaxRetries is the number of retries of loading, embedding and indexing calls, negative disables retries.
+	// Optional. Default: 2
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled for each following retry.
+	// Optional. Default: 1s
+	RetryBackoff time.Duration
+	// EmbedBatchSize is the number of chunks embedded in a request.
+	// Optional. Default: 16
+	EmbedBatchSize int
+
+	// SourceKey is the metadata key of the source uri in chunks.
+	// Optional. Default: "_source", the same as the file loader
+	SourceKey string
+	// ChunkIDGenerator generates ids of the chunks of a source. Ids should be stable across runs,
+	// so that re-indexing a changed source overwrites its chunks.
+	// Optional. Default: {sha256(uri)[:16]}_{index}
+	ChunkIDGenerator func(ctx context.Context, uri string, num int) ([]string, error)
+
+	// OnProgress is called after each source is processed, calls are serialized.
+	// Optional.
+	OnProgress func(ctx context.Context, p *Progress)
+}
+
+type Status string
+
+const (
+	StatusIndexed Status = "indexed"
+	StatusSkipped Status = "skipped"
+	StatusFailed  Status = "failed"
+)
+
+// Progress reports a processed source.
+type Progress struct {
+	URI    string
+	Status Status
+	// Chunks is the number of chunks indexed for the source.
+	Chunks int
+	// Deleted is the number of stale chunks deleted for the source by Sync.
+	Deleted int
+	// Err is the error of a failed source.
+	Err error
+	// Done is the number of processed sources, including this one.
+	Done  int
+	Total int
+}
+
+// Failure is a source which failed to be indexed.
+type Failure struct {
+	URI string
+	Err error
+}
+
+// Report summarizes a run.
+type Report struct {
+	Total   int
+	Indexed int
+	Skipped int
+	Failed  int
+	Chunks  int
+	// Deleted is the number of chunks deleted by Sync, including the chunks of removed sources.
+	Deleted int
+	// Removed is the number of sources removed by Sync, which are in the manifest but not in the sources.
+	Removed  int
+	Failures []*Failure
+}
+
+// Pipeline indexes sources with loader -> parser -> transformers -> embedder -> indexer.
+type Pipeline struct {
+	config *Config
+}
+
+func NewPipeline(_ context.Context, config *Config) (*Pipeline, error) {
+	if config == nil || config.Loader == nil {
+		return nil, errors.New("[NewPipeline] loader not provided")
+	}
+	if config.Indexer == nil {
+		return nil, errors.New("[NewPipeline] indexer not provided")
+	}
+
+	nConf := *config
+	if nConf.Workers <= 0 {
+		nConf.Workers = 4
+	}
+	if nConf.MaxRetries < 0 {
+		nConf.MaxRetries = 0
+	} else if nConf.MaxRetries == 0 {
+		nConf.MaxRetries = 2
+	}
+	if nConf.RetryBackoff <= 0 {
+		nConf.RetryBackoff = time.Second
+	}
+	if nConf.EmbedBatchSize <= 0 {
+		nConf.EmbedBatchSize = 16
+	}
+	if nConf.SourceKey == "" {
+		nConf.SourceKey = defaultSourceKey
+	}
+	if nConf.ChunkIDGenerator == nil {
+		nConf.ChunkIDGenerator = defaultChunkIDGenerator
+	}
+	if nConf.Deleter == nil {
+		nConf.Deleter, _ = nConf.Indexer.(Deleter)
+	}
+
+	return &Pipeline{config: &nConf}, nil
+}
+
+// Run indexes the sources. A failed source doesn't stop the run, it's reported in Report.Failures and
+// retried by the next run as the manifest isn't updated. The error is only returned when ctx is done,
+// along with the report of the processed sources.
+func (p *Pipeline) Run(ctx context.Context, sources []document.Source) (*Report, error) {
+	return p.run(ctx, sources, nil)
+}
+
+// sourceResult is the result of processing a source.
+type sourceResult struct {
+	status Status
+	// chunks is the number of stored chunks
+	chunks int
+	// deleted is the number of deleted stale chunks
+	deleted int
+	err     error
+}
+
+// run processes the sources concurrently, incrementally with the deleter if it's not nil, see Sync.
+func (p *Pipeline) run(ctx context.Context, sources []document.Source, deleter Deleter) (*Report, error) {
+	report := &Report{Total: len(sources)}
+
+	var mu sync.Mutex
+	done := 0
+	record := func(uri string, res *sourceResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		done++
+		switch res.status {
+		case StatusIndexed:
+			report.Indexed++
+			report.Chunks += res.chunks
+			report.Deleted += res.deleted
+		case StatusSkipped:
+			report.Skipped++
+		case StatusFailed:
+			report.Failed++
+			report.Failures = append(report.Failures, &Failure{URI: uri, Err: res.err})
+		}
+		if p.config.OnProgress != nil {
+			p.config.OnProgress(ctx, &Progress{URI: uri, Status: res.status, Chunks: res.chunks, Deleted: res.deleted,
+				Err: res.err, Done: done, Total: len(sources)})
+		}
+	}
+
+	ch := make(chan document.Source)
+	var wg sync.WaitGroup
+	for i := 0; i < p.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for src := range ch {
+				res := p.processSource(ctx, src, deleter)
+				if ctx.Err() != nil {
+					// the source is interrupted, it's neither indexed nor failed
+					continue
+				}
+				record(src.URI, res)
+			}
+		}()
+	}
+
+dispatch:
+	for _, src := range sources {
+		select {
+		case ch <- src:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(ch)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+func (p *Pipeline) processSource(ctx context.Context, src document.Source, deleter Deleter) *sourceResult {
+	failed := func(err error) *sourceResult {
+		return &sourceResult{status: StatusFailed, err: err}
+	}
+
+	var docs []*schema.Document
+	err := p.retry(ctx, func() (err error) {
+		docs, err = p.config.Loader.Load(ctx, src)
+		return err
+	})
+	if err != nil {
+		return failed(fmt.Errorf("[ingest] load failed, uri=%s: %w", src.URI, err))
+	}
+
+	hash := hashDocuments(docs...)
+	var prev *ManifestEntry
+	if p.config.Manifest != nil {
+		entry, ok, err := p.config.Manifest.Get(ctx, src.URI)
+		if err != nil {
+			return failed(fmt.Errorf("[ingest] get manifest failed, uri=%s: %w", src.URI, err))
+		}
+		if ok && entry.Hash == hash {
+			return &sourceResult{status: StatusSkipped}
+		}
+		if ok {
+			prev = entry
+		}
+	}
+
+	chunks, err := p.prepare(ctx, src.URI, docs)
+	if err != nil {
+		return failed(err)
+	}
+
+	entry := &ManifestEntry{Hash: hash, ChunkIDs: make([]string, len(chunks)), ChunkHashes: make(map[string]string, len(chunks))}
+	for i, chunk := range chunks {
+		entry.ChunkIDs[i] = chunk.ID
+		entry.ChunkHashes[chunk.ID] = hashDocuments(chunk)
+	}
+
+	toStore := chunks
+	var stale []string
+	if deleter != nil && prev != nil {
+		toStore, stale = diffChunks(prev, entry, chunks)
+	}
+
+	if len(toStore) > 0 {
+		if err = p.store(ctx, src.URI, toStore); err != nil {
+			return failed(err)
+		}
+	}
+	if len(stale) > 0 {
+		if err = p.delete(ctx, deleter, src.URI, stale); err != nil {
+			return failed(err)
+		}
+	}
+
+	if p.config.Manifest != nil {
+		entry.IndexedAt = time.Now()
+		if err = p.config.Manifest.Set(ctx, src.URI, entry); err != nil {
+			return failed(fmt.Errorf("[ingest] set manifest failed, uri=%s: %w", src.URI, err))
+		}
+	}
+
+	return &sourceResult{status: StatusIndexed, chunks: len(toStore), deleted: len(stale)}
+}
+
+// prepare parses and transforms the documents of a source into chunks with ids and the source uri.
+func (p *Pipeline) prepare(ctx context.Context, uri string, docs []*schema.Document) ([]*schema.Document, error) {
+	if p.config.Parser != nil {
+		var parsed []*schema.Document
+		for _, doc := range docs {
+			ds, err := p.config.Parser.Parse(ctx, strings.NewReader(doc.Content), parser.WithURI(uri), parser.WithExtraMeta(doc.MetaData))
+			if err != nil {
+				return nil, fmt.Errorf("[ingest] parse failed, uri=%s: %w", uri, err)
+			}
+			parsed = append(parsed, ds...)
+		}
+		docs = parsed
+	}
+
+	for _, t := range p.config.Transformers {
+		var err error
+		if docs, err = t.Transform(ctx, docs); err != nil {
+			return nil, fmt.Errorf("[ingest] transform failed, uri=%s: %w", uri, err)
+		}
+	}
+
+	ids, err := p.config.ChunkIDGenerator(ctx, uri, len(docs))
+	if err != nil {
+		return nil, fmt.Errorf("[ingest] generate chunk ids failed, uri=%s: %w", uri, err)
+	}
+	if len(ids) != len(docs) {
+		return nil, fmt.Errorf("[ingest] invalid chunk ids length, expected=%d, got=%d", len(docs), len(ids))
+	}
+
+	chunks := make([]*schema.Document, len(docs))
+	for i, doc := range docs {
+		metaData := make(map[string]any, len(doc.MetaData)+1)
+		for k, v := range doc.MetaData {
+			metaData[k] = v
+		}
+		metaData[p.config.SourceKey] = uri
+
+		chunks[i] = &schema.Document{ID: ids[i], Content: doc.Content, MetaData: metaData}
+	}
+	return chunks, nil
+}
+
+// store embeds the chunks if an embedder is configured, then indexes them.
+func (p *Pipeline) store(ctx context.Context, uri string, chunks []*schema.Document) error {
+	var opts []indexer.Option
+	if p.config.Embedder != nil {
+		emb, err := p.embed(ctx, chunks)
+		if err != nil {
+			return fmt.Errorf("[ingest] embed failed, uri=%s: %w", uri, err)
+		}
+		opts = append(opts, indexer.WithEmbedding(emb))
+	}
+
+	err := p.retry(ctx, func() error {
+		_, err := p.config.Indexer.Store(ctx, chunks, opts...)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("[ingest] store failed, uri=%s: %w", uri, err)
+	}
+	return nil
+}
+
+// embed embeds the chunks in batches, and returns an embedder serving the computed vectors to the indexer.
+func (p *Pipeline) embed(ctx context.Context, chunks []*schema.Document) (embedding.Embedder, error) {
+	emb := &precomputedEmbedder{vectors: make(map[string][]float64, len(chunks)), fallback: p.config.Embedder}
+	for start := 0; start < len(chunks); start += p.config.EmbedBatchSize {
+		end := start + p.config.EmbedBatchSize
+		if end > len(chunks) {
+			end = len(chunks)
+		}
+		batch := chunks[start:end]
+
+		texts := make([]string, len(batch))
+		for i, chunk := range batch {
+			texts[i] = chunk.Content
+		}
+
+		var vectors [][]float64
+		err := p.retry(ctx, func() (err error) {
+			vectors, err = p.config.Embedder.EmbedStrings(ctx, texts)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(vectors) != len(batch) {
+			return nil, fmt.Errorf("invalid vectors length, expected=%d, got=%d", len(batch), len(vectors))
+		}
+
+		for i, chunk := range batch {
+			chunk.WithDenseVector(vectors[i])
+			emb.vectors[chunk.Content] = vectors[i]
+		}
+	}
+	return emb, nil
+}
+
+// retry calls fn until it succeeds, or MaxRetries is reached, with exponential backoff.
+func (p *Pipeline) retry(ctx context.Context, fn func() error) error {
+	backoff := p.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= p.config.MaxRetries {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// hashDocuments returns the content hash of the documents, e.g. the loaded documents of a source, or a chunk.
+func hashDocuments(docs ...*schema.Document) string {
+	h := sha256.New()
+	for _, doc := range docs {
+		h.Write([]byte(doc.Content))
+		h.Write([]byte{0})
+		// json encodes map keys in order, metadata which can't be encoded is left out
+		if meta, err := json.Marshal(doc.MetaData); err == nil {
+			h.Write(meta)
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func defaultChunkIDGenerator(_ context.Context, uri string, num int) ([]string, error) {
+	sum := sha256.Sum256([]byte(uri))
+	prefix := hex.EncodeToString(sum[:8])
+	ids := make([]string, num)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%s_%d", prefix, i)
+	}
+	return ids, nil
+}
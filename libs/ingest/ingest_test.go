@@ -0,0 +1,339 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ingest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/components/document/parser"
+	"github.com/cloudwego/eino/components/embedding"
+	"github.com/cloudwego/eino/components/indexer"
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLoader struct {
+	mu       sync.Mutex
+	contents map[string]string
+	// fails is the number of failed loads of a source before it succeeds
+	fails map[string]int
+}
+
+func (l *mockLoader) Load(_ context.Context, src document.Source, _ ...document.LoaderOption) ([]*schema.Document, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.fails[src.URI] > 0 {
+		l.fails[src.URI]--
+		return nil, errors.New("load failed")
+	}
+	content, ok := l.contents[src.URI]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return []*schema.Document{{Content: content, MetaData: map[string]any{"uri": src.URI}}}, nil
+}
+
+type upperParser struct{}
+
+func (upperParser) Parse(_ context.Context, reader io.Reader, opts ...parser.Option) ([]*schema.Document, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	o := parser.GetCommonOptions(&parser.Options{}, opts...)
+	return []*schema.Document{{Content: strings.ToUpper(string(data)), MetaData: o.ExtraMeta}}, nil
+}
+
+// lineSplitter splits documents by lines, keeping the id of the document like most splitters.
+type lineSplitter struct{}
+
+func (lineSplitter) Transform(_ context.Context, src []*schema.Document, _ ...document.TransformerOption) ([]*schema.Document, error) {
+	var docs []*schema.Document
+	for _, doc := range src {
+		for _, line := range strings.Split(doc.Content, "\n") {
+			docs = append(docs, &schema.Document{ID: doc.ID, Content: line, MetaData: doc.MetaData})
+		}
+	}
+	return docs, nil
+}
+
+type mockEmbedder struct {
+	mu    sync.Mutex
+	calls [][]string
+}
+
+func (e *mockEmbedder) EmbedStrings(_ context.Context, texts []string, _ ...embedding.Option) ([][]float64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.calls = append(e.calls, texts)
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = []float64{float64(len(text))}
+	}
+	return vectors, nil
+}
+
+type mockIndexer struct {
+	mu      sync.Mutex
+	docs    map[string]*schema.Document
+	vectors map[string][]float64
+	// failContent fails storing chunks with the content
+	failContent string
+}
+
+func (i *mockIndexer) Store(ctx context.Context, docs []*schema.Document, opts ...indexer.Option) ([]string, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	ids := make([]string, len(docs))
+	texts := make([]string, len(docs))
+	for j, doc := range docs {
+		if i.failContent != "" && doc.Content == i.failContent {
+			return nil, errors.New("store failed")
+		}
+		ids[j] = doc.ID
+		texts[j] = doc.Content
+	}
+
+	o := indexer.GetCommonOptions(&indexer.Options{}, opts...)
+	if o.Embedding != nil {
+		vectors, err := o.Embedding.EmbedStrings(ctx, texts)
+		if err != nil {
+			return nil, err
+		}
+		for j, id := range ids {
+			i.vectors[id] = vectors[j]
+		}
+	}
+
+	for _, doc := range docs {
+		i.docs[doc.ID] = doc
+	}
+	return ids, nil
+}
+
+func (i *mockIndexer) Delete(_ context.Context, ids []string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, id := range ids {
+		delete(i.docs, id)
+	}
+	return nil
+}
+
+func newMockIndexer() *mockIndexer {
+	return &mockIndexer{docs: map[string]*schema.Document{}, vectors: map[string][]float64{}}
+}
+
+func TestNewPipeline(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewPipeline(ctx, nil)
+	assert.Error(t, err)
+
+	_, err = NewPipeline(ctx, &Config{Loader: &mockLoader{}})
+	assert.Error(t, err)
+
+	p, err := NewPipeline(ctx, &Config{Loader: &mockLoader{}, Indexer: newMockIndexer(), MaxRetries: -1})
+	require.NoError(t, err)
+	assert.Equal(t, 4, p.config.Workers)
+	assert.Equal(t, 0, p.config.MaxRetries)
+	assert.Equal(t, defaultSourceKey, p.config.SourceKey)
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	loader := &mockLoader{
+		contents: map[string]string{"a.md": "a1\na2", "b.md": "b1", "c.md": "c1\nc2\nc3"},
+		fails:    map[string]int{"a.md": 1},
+	}
+	emb := &mockEmbedder{}
+	idx := newMockIndexer()
+	manifest := NewInMemoryManifest()
+
+	var progress []*Progress
+	p, err := NewPipeline(ctx, &Config{
+		Loader:         loader,
+		Parser:         upperParser{},
+		Transformers:   []document.Transformer{lineSplitter{}},
+		Embedder:       emb,
+		Indexer:        idx,
+		Manifest:       manifest,
+		Workers:        2,
+		RetryBackoff:   1,
+		EmbedBatchSize: 2,
+		OnProgress: func(_ context.Context, pg *Progress) {
+			progress = append(progress, pg)
+		},
+	})
+	require.NoError(t, err)
+
+	sources := []document.Source{{URI: "a.md"}, {URI: "b.md"}, {URI: "c.md"}, {URI: "d.md"}}
+	report, err := p.Run(ctx, sources)
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.Total)
+	assert.Equal(t, 3, report.Indexed)
+	assert.Equal(t, 6, report.Chunks)
+	assert.Equal(t, 1, report.Failed)
+	require.Len(t, report.Failures, 1)
+	assert.Equal(t, "d.md", report.Failures[0].URI)
+	assert.ErrorContains(t, report.Failures[0].Err, "load failed, uri=d.md")
+
+	require.Len(t, progress, 4)
+	assert.Equal(t, 4, progress[3].Done)
+	assert.Equal(t, 4, progress[3].Total)
+
+	ids, _ := defaultChunkIDGenerator(ctx, "c.md", 3)
+	require.Len(t, idx.docs, 6)
+	chunk := idx.docs[ids[2]]
+	require.NotNil(t, chunk)
+	assert.Equal(t, "C3", chunk.Content)
+	assert.Equal(t, []float64{2}, chunk.DenseVector())
+	assert.Equal(t, "c.md", chunk.MetaData["uri"])
+	assert.Equal(t, "c.md", chunk.MetaData["_source"])
+	assert.Equal(t, []float64{2}, idx.vectors[ids[2]])
+	for _, call := range emb.calls {
+		assert.LessOrEqual(t, len(call), 2)
+	}
+
+	entry, ok, err := manifest.Get(ctx, "c.md")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, ids, entry.ChunkIDs)
+	_, ok, _ = manifest.Get(ctx, "d.md")
+	assert.False(t, ok)
+
+	// unchanged sources are skipped, the changed and the failed ones are indexed
+	loader.contents["b.md"] = "b1\nb2"
+	loader.contents["d.md"] = "d1"
+	emb.calls = nil
+	report, err = p.Run(ctx, sources)
+	require.NoError(t, err)
+	assert.Equal(t, &Report{Total: 4, Indexed: 2, Skipped: 2, Chunks: 3}, report)
+	assert.Len(t, emb.calls, 2)
+}
+
+func TestPipelineStoreFailure(t *testing.T) {
+	ctx := context.Background()
+	loader := &mockLoader{contents: map[string]string{"a.md": "bad"}}
+	idx := newMockIndexer()
+	idx.failContent = "bad"
+	manifest := NewInMemoryManifest()
+
+	p, err := NewPipeline(ctx, &Config{Loader: loader, Indexer: idx, Manifest: manifest, RetryBackoff: 1})
+	require.NoError(t, err)
+
+	report, err := p.Run(ctx, []document.Source{{URI: "a.md"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Failed)
+	assert.ErrorContains(t, report.Failures[0].Err, "store failed, uri=a.md")
+	_, ok, _ := manifest.Get(ctx, "a.md")
+	assert.False(t, ok)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	report, err = p.Run(cctx, []document.Source{{URI: "a.md"}})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, report.Failed)
+}
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	loader := &mockLoader{contents: map[string]string{"a.md": "a1\na2\na3", "b.md": "b1"}}
+	emb := &mockEmbedder{}
+	idx := newMockIndexer()
+	manifest := NewInMemoryManifest()
+
+	p, err := NewPipeline(ctx, &Config{Loader: loader, Transformers: []document.Transformer{lineSplitter{}}, Indexer: idx})
+	require.NoError(t, err)
+	_, err = p.Sync(ctx, nil)
+	assert.ErrorContains(t, err, "StateStore")
+
+	p, err = NewPipeline(ctx, &Config{Loader: loader, Transformers: []document.Transformer{lineSplitter{}}, Embedder: emb, Indexer: idx, Manifest: manifest})
+	require.NoError(t, err)
+
+	report, err := p.Sync(ctx, []document.Source{{URI: "a.md"}, {URI: "b.md"}})
+	require.NoError(t, err)
+	assert.Equal(t, &Report{Total: 2, Indexed: 2, Chunks: 4}, report)
+	assert.Len(t, idx.docs, 4)
+
+	// a2 is changed and a3 is removed from a.md, b.md is removed
+	loader.contents["a.md"] = "a1\na2x"
+	emb.calls = nil
+	report, err = p.Sync(ctx, []document.Source{{URI: "a.md"}})
+	require.NoError(t, err)
+	assert.Equal(t, &Report{Total: 1, Indexed: 1, Chunks: 1, Deleted: 2, Removed: 1}, report)
+	assert.Equal(t, [][]string{{"a2x"}}, emb.calls)
+
+	aIDs, _ := defaultChunkIDGenerator(ctx, "a.md", 2)
+	require.Len(t, idx.docs, 2)
+	assert.Equal(t, "a1", idx.docs[aIDs[0]].Content)
+	assert.Equal(t, "a2x", idx.docs[aIDs[1]].Content)
+
+	uris, err := manifest.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.md"}, uris)
+	entry, _, _ := manifest.Get(ctx, "a.md")
+	assert.Equal(t, aIDs, entry.ChunkIDs)
+}
+
+func TestFileManifest(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "manifest", "manifest.json")
+
+	_, err := NewFileManifest("")
+	assert.Error(t, err)
+
+	m, err := NewFileManifest(path)
+	require.NoError(t, err)
+	_, ok, err := m.Get(ctx, "a.md")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, m.Set(ctx, "a.md", &ManifestEntry{Hash: "h1", ChunkIDs: []string{"a_0"}}))
+
+	m, err = NewFileManifest(path)
+	require.NoError(t, err)
+	entry, ok, err := m.Get(ctx, "a.md")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "h1", entry.Hash)
+	assert.Equal(t, []string{"a_0"}, entry.ChunkIDs)
+
+	require.NoError(t, m.Set(ctx, "b.md", &ManifestEntry{Hash: "h2"}))
+	uris, err := m.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.md", "b.md"}, uris)
+
+	require.NoError(t, m.Delete(ctx, "a.md"))
+	m, err = NewFileManifest(path)
+	require.NoError(t, err)
+	uris, err = m.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b.md"}, uris)
+}
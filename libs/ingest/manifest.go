@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ManifestEntry records an indexed source.
+type ManifestEntry struct {
+	// Hash is the content hash of the loaded documents of the source.
+	Hash string `json:"hash"`
+	// ChunkIDs are the ids of the indexed chunks of the source.
+	ChunkIDs []string `json:"chunk_ids,omitempty"`
+	// ChunkHashes are the content hashes of the chunks by id, used by Sync to skip unchanged chunks.
+	ChunkHashes map[string]string `json:"chunk_hashes,omitempty"`
+	IndexedAt   time.Time         `json:"indexed_at"`
+}
+
+// Manifest records the indexed sources by uri, it's the checkpoint of the pipeline.
+type Manifest interface {
+	Get(ctx context.Context, uri string) (*ManifestEntry, bool, error)
+	Set(ctx context.Context, uri string, entry *ManifestEntry) error
+}
+
+// StateStore is a Manifest which can list and delete the indexed sources, it's required by Sync
+// to find the sources which are removed since the last sync.
+type StateStore interface {
+	Manifest
+	Delete(ctx context.Context, uri string) error
+	// List returns the uris of all the indexed sources.
+	List(ctx context.Context) ([]string, error)
+}
+
+var (
+	_ StateStore = (*InMemoryManifest)(nil)
+	_ StateStore = (*FileManifest)(nil)
+)
+
+// InMemoryManifest keeps the manifest in memory, e.g. for tests or a long-running process re-indexing periodically.
+type InMemoryManifest struct {
+	mu      sync.RWMutex
+	entries map[string]*ManifestEntry
+}
+
+func NewInMemoryManifest() *InMemoryManifest {
+	return &InMemoryManifest{entries: make(map[string]*ManifestEntry)}
+}
+
+func (m *InMemoryManifest) Get(_ context.Context, uri string) (*ManifestEntry, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[uri]
+	return entry, ok, nil
+}
+
+func (m *InMemoryManifest) Set(_ context.Context, uri string, entry *ManifestEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[uri] = entry
+	return nil
+}
+
+func (m *InMemoryManifest) Delete(_ context.Context, uri string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, uri)
+	return nil
+}
+
+func (m *InMemoryManifest) List(_ context.Context) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return sortedURIs(m.entries), nil
+}
+
+// FileManifest keeps the manifest in a json file, so that an interrupted run is resumed by the next process.
+// The whole manifest is written to a temporary file and renamed on every Set, so that a crash never leaves a partial manifest.
+type FileManifest struct {
+	path string
+
+	mu      sync.RWMutex
+	entries map[string]*ManifestEntry
+}
+
+// NewFileManifest loads the manifest from path, an absent file is an empty manifest.
+func NewFileManifest(path string) (*FileManifest, error) {
+	if len(path) == 0 {
+		return nil, errors.New("[NewFileManifest] path not provided")
+	}
+
+	entries := make(map[string]*ManifestEntry)
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("[NewFileManifest] read manifest failed: %w", err)
+	}
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &entries); err != nil {
+			return nil, fmt.Errorf("[NewFileManifest] unmarshal manifest failed: %w", err)
+		}
+	}
+
+	return &FileManifest{path: path, entries: entries}, nil
+}
+
+func (m *FileManifest) Get(_ context.Context, uri string) (*ManifestEntry, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[uri]
+	return entry, ok, nil
+}
+
+func (m *FileManifest) Set(_ context.Context, uri string, entry *ManifestEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	prev, existed := m.entries[uri]
+	m.entries[uri] = entry
+	if err := m.flush(); err != nil {
+		if existed {
+			m.entries[uri] = prev
+		} else {
+			delete(m.entries, uri)
+		}
+		return err
+	}
+	return nil
+}
+
+func (m *FileManifest) Delete(_ context.Context, uri string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	prev, existed := m.entries[uri]
+	if !existed {
+		return nil
+	}
+	delete(m.entries, uri)
+	if err := m.flush(); err != nil {
+		m.entries[uri] = prev
+		return err
+	}
+	return nil
+}
+
+func (m *FileManifest) List(_ context.Context) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return sortedURIs(m.entries), nil
+}
+
+func (m *FileManifest) flush() error {
+	data, err := json.Marshal(m.entries)
+	if err != nil {
+		return fmt.Errorf("[FileManifest] marshal manifest failed: %w", err)
+	}
+
+	dir := filepath.Dir(m.path)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("[FileManifest] create dir failed: %w", err)
+	}
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("[FileManifest] create temp file failed: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("[FileManifest] write manifest failed: %w", err)
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("[FileManifest] sync manifest failed: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("[FileManifest] close manifest failed: %w", err)
+	}
+	if err = os.Rename(f.Name(), m.path); err != nil {
+		return fmt.Errorf("[FileManifest] rename manifest failed: %w", err)
+	}
+	return nil
+}
+
+func sortedURIs(entries map[string]*ManifestEntry) []string {
+	uris := make([]string, 0, len(entries))
+	for uri := range entries {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+	return uris
+}
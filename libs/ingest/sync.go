@@ -0,0 +1,128 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/components/document"
+	"github.com/cloudwego/eino/schema"
+)
+
+// Deleter deletes chunks from the store by ids.
+type Deleter interface {
+	Delete(ctx context.Context, ids []string) error
+}
+
+// Sync keeps the store in sync with the sources incrementally, it's Run with change detection at the chunk level:
+//   - unchanged sources are skipped with the content hash, like Run
+//   - of a changed source, only the chunks whose content hash changed are embedded and stored,
+//     and the chunks which no longer exist are deleted
+//   - the sources in the manifest but not in sources are removed, with all their chunks
+//
+// Chunks are matched by id, with the default ChunkIDGenerator an insertion in the middle of a source
+// changes the ids of all following chunks, which are stored again.
+// Sources are only removed when all of them are processed, a failed source is kept, so pass all the sources
+// of the corpus, not a part of it. Sync requires the Manifest to implement StateStore, and a Deleter.
+func (p *Pipeline) Sync(ctx context.Context, sources []document.Source) (*Report, error) {
+	store, ok := p.config.Manifest.(StateStore)
+	if !ok {
+		return nil, errors.New("[ingest] sync requires manifest implementing StateStore")
+	}
+	if p.config.Deleter == nil {
+		return nil, errors.New("[ingest] sync requires deleter")
+	}
+
+	report, err := p.run(ctx, sources, p.config.Deleter)
+	if err != nil {
+		return report, err
+	}
+
+	uris, err := store.List(ctx)
+	if err != nil {
+		return report, fmt.Errorf("[ingest] list manifest failed: %w", err)
+	}
+
+	current := make(map[string]bool, len(sources))
+	for _, src := range sources {
+		current[src.URI] = true
+	}
+	for _, uri := range uris {
+		if current[uri] {
+			continue
+		}
+		deleted, err := p.removeSource(ctx, store, uri)
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			report.Failed++
+			report.Failures = append(report.Failures, &Failure{URI: uri, Err: err})
+			continue
+		}
+		report.Removed++
+		report.Deleted += deleted
+	}
+
+	return report, nil
+}
+
+// removeSource deletes all the chunks of a removed source, then the source from the state store.
+func (p *Pipeline) removeSource(ctx context.Context, store StateStore, uri string) (int, error) {
+	entry, ok, err := store.Get(ctx, uri)
+	if err != nil {
+		return 0, fmt.Errorf("[ingest] get manifest failed, uri=%s: %w", uri, err)
+	}
+	deleted := 0
+	if ok && len(entry.ChunkIDs) > 0 {
+		if err = p.delete(ctx, p.config.Deleter, uri, entry.ChunkIDs); err != nil {
+			return 0, err
+		}
+		deleted = len(entry.ChunkIDs)
+	}
+	if err = store.Delete(ctx, uri); err != nil {
+		return 0, fmt.Errorf("[ingest] delete manifest failed, uri=%s: %w", uri, err)
+	}
+	return deleted, nil
+}
+
+func (p *Pipeline) delete(ctx context.Context, deleter Deleter, uri string, ids []string) error {
+	err := p.retry(ctx, func() error {
+		return deleter.Delete(ctx, ids)
+	})
+	if err != nil {
+		return fmt.Errorf("[ingest] delete failed, uri=%s: %w", uri, err)
+	}
+	return nil
+}
+
+// diffChunks returns the chunks which are new or changed since prev, and the ids of the chunks which no longer exist.
+func diffChunks(prev, entry *ManifestEntry, chunks []*schema.Document) (changed []*schema.Document, stale []string) {
+	for _, chunk := range chunks {
+		if h, ok := prev.ChunkHashes[chunk.ID]; !ok || h != entry.ChunkHashes[chunk.ID] {
+			changed = append(changed, chunk)
+		}
+	}
+	for _, id := range prev.ChunkIDs {
+		if _, ok := entry.ChunkHashes[id]; !ok {
+			stale = append(stale, id)
+		}
+	}
+	return changed, stale
+}
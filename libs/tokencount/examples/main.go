@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/cloudwego/eino/schema"
+
+	"github.com/cloudwego/eino-ext/libs/tokencount"
+)
+
+func main() {
+	input := []*schema.Message{
+		schema.SystemMessage("You are a helpful assistant."),
+		schema.UserMessage("用一句话介绍一下 Eino 框架。"),
+	}
+
+	// DeepSeek models are estimated by character count, nothing is downloaded
+	counter, err := tokencount.NewCounter(&tokencount.Config{Model: "deepseek-chat"})
+	if err != nil {
+		log.Fatalf("NewCounter failed, err=%v", err)
+	}
+
+	prompt, err := counter.CheckContextWindow(input, nil, 8192)
+	if errors.Is(err, tokencount.ErrContextWindowExceeded) {
+		log.Fatalf("prompt is too long, err=%v", err)
+	}
+	if err != nil {
+		log.Fatalf("CheckContextWindow failed, err=%v", err)
+	}
+
+	fmt.Printf("estimated prompt tokens: %d, context window: %d\n", prompt, counter.ContextWindow())
+}
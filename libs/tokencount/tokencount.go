@@ -0,0 +1,179 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokencount
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/eino/schema"
+)
+
+// ErrContextWindowExceeded is returned, wrapped with details, when the prompt and the requested
+// completion don't fit in the context window of the model.
+var ErrContextWindowExceeded = errors.New("context window exceeded")
+
+const (
+	// tokensPerMessage is the overhead of the role and delimiters of a message in chat format
+	tokensPerMessage = 3
+	// tokensPerName is the overhead of the name of a message
+	tokensPerName = 1
+	// tokensReplyPriming is the overhead of priming the reply of assistant
+	tokensReplyPriming = 3
+	// tokensPerMediaPart is a rough estimation of an image, audio, video or file part,
+	// whose tokens depend on its size and the model.
+	tokensPerMediaPart = 85
+)
+
+type Config struct {
+	// Model is the name of the model, selecting the tokenizer and the context window.
+	// Models of DeepSeek, Ark (doubao or endpoint id "ep-...") and Gemini are estimated by character count,
+	// the others are taken as OpenAI compatible ones, and counted by tiktoken.
+	// Required.
+	Model string
+	// Tokenizer overrides the tokenizer selected by Model.
+	// Optional.
+	Tokenizer Tokenizer
+	// ContextWindow overrides the context window of Model in tokens, required by CheckContextWindow
+	// when the context window of Model isn't known.
+	// Optional. Default: ContextWindow(Model)
+	ContextWindow int
+}
+
+// Counter estimates the prompt tokens of requests to a model.
+// Counts are estimations: chat format overheads differ between providers, and media parts are counted roughly.
+type Counter struct {
+	model         string
+	tokenizer     Tokenizer
+	contextWindow int
+}
+
+func NewCounter(config *Config) (*Counter, error) {
+	if config == nil || len(config.Model) == 0 {
+		return nil, errors.New("[NewCounter] model not provided")
+	}
+	if config.ContextWindow < 0 {
+		return nil, errors.New("[NewCounter] context window must not be negative")
+	}
+
+	tokenizer := config.Tokenizer
+	if tokenizer == nil {
+		var err error
+		if tokenizer, err = tokenizerForModel(config.Model); err != nil {
+			return nil, fmt.Errorf("[NewCounter] %w", err)
+		}
+	}
+
+	contextWindow := config.ContextWindow
+	if contextWindow == 0 {
+		contextWindow, _ = ContextWindow(config.Model)
+	}
+
+	return &Counter{
+		model:         config.Model,
+		tokenizer:     tokenizer,
+		contextWindow: contextWindow,
+	}, nil
+}
+
+// CountText returns the tokens of text.
+func (c *Counter) CountText(text string) int {
+	return c.tokenizer.CountTokens(text)
+}
+
+// CountMessages returns the prompt tokens of input, including the overheads of chat format.
+// It fits ratelimit.Config.EstimateTokens and budget.Config.EstimatePromptTokens.
+func (c *Counter) CountMessages(input []*schema.Message) int {
+	var tokens int
+	for _, msg := range input {
+		if msg == nil {
+			continue
+		}
+		tokens += tokensPerMessage
+		tokens += c.CountText(string(msg.Role))
+		tokens += c.CountText(msg.Content)
+		if len(msg.Name) > 0 {
+			tokens += tokensPerName + c.CountText(msg.Name)
+		}
+		for _, part := range msg.MultiContent {
+			if part.Type == schema.ChatMessagePartTypeText {
+				tokens += c.CountText(part.Text)
+			} else {
+				tokens += tokensPerMediaPart
+			}
+		}
+		for _, call := range msg.ToolCalls {
+			tokens += c.CountText(call.Function.Name) + c.CountText(call.Function.Arguments)
+		}
+	}
+	if tokens > 0 {
+		tokens += tokensReplyPriming
+	}
+	return tokens
+}
+
+// CountTools returns the tokens of the definitions of tools sent along with the prompt.
+func (c *Counter) CountTools(tools []*schema.ToolInfo) (int, error) {
+	var tokens int
+	for _, tool := range tools {
+		if tool == nil {
+			continue
+		}
+		tokens += c.CountText(tool.Name) + c.CountText(tool.Desc)
+
+		params, err := tool.ParamsOneOf.ToOpenAPIV3()
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert parameters of tool %s: %w", tool.Name, err)
+		}
+		if params == nil {
+			continue
+		}
+		b, err := json.Marshal(params)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal parameters of tool %s: %w", tool.Name, err)
+		}
+		tokens += c.CountText(string(b))
+	}
+	return tokens, nil
+}
+
+// ContextWindow returns the context window of the model in tokens, 0 if unknown.
+func (c *Counter) ContextWindow() int {
+	return c.contextWindow
+}
+
+// CheckContextWindow returns an error wrapping ErrContextWindowExceeded if input, tools and maxTokens
+// of completion don't fit in the context window, so that a request doomed to fail isn't sent.
+// It returns the estimated prompt tokens, which are returned even if the check fails.
+func (c *Counter) CheckContextWindow(input []*schema.Message, tools []*schema.ToolInfo, maxTokens int) (int, error) {
+	if c.contextWindow == 0 {
+		return 0, fmt.Errorf("context window of model %s is unknown, set Config.ContextWindow", c.model)
+	}
+
+	toolTokens, err := c.CountTools(tools)
+	if err != nil {
+		return 0, err
+	}
+	prompt := c.CountMessages(input) + toolTokens
+
+	if prompt+maxTokens > c.contextWindow {
+		return prompt, fmt.Errorf("%w: model=%s, prompt tokens %d + max tokens %d > context window %d",
+			ErrContextWindowExceeded, c.model, prompt, maxTokens, c.contextWindow)
+	}
+	return prompt, nil
+}
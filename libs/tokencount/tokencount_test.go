@@ -0,0 +1,119 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokencount
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/eino/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// wordTokenizer counts a token per word, to make counts easy to follow.
+type wordTokenizer struct{}
+
+func (wordTokenizer) CountTokens(text string) int {
+	return len(strings.Fields(text))
+}
+
+func TestApproximateTokenizer(t *testing.T) {
+	assert.Equal(t, 0, DeepSeekTokenizer.CountTokens(""))
+	assert.Equal(t, 2, DeepSeekTokenizer.CountTokens("hello"))
+	assert.Equal(t, 2, DeepSeekTokenizer.CountTokens("你好吗"))
+	assert.Equal(t, 3, DeepSeekTokenizer.CountTokens("0123456789"))
+	assert.Equal(t, 7, GeminiTokenizer.CountTokens("こんにちは world"))
+
+	for model, tokenizer := range map[string]Tokenizer{
+		"deepseek-chat":           DeepSeekTokenizer,
+		"deepseek-ai/DeepSeek-V3": DeepSeekTokenizer,
+		"doubao-1.5-pro-32k":      ArkTokenizer,
+		"ep-20250101-abcde":       ArkTokenizer,
+		"gemini-2.0-flash":        GeminiTokenizer,
+	} {
+		got, err := tokenizerForModel(model)
+		assert.NoError(t, err)
+		assert.Equal(t, tokenizer, got, model)
+	}
+}
+
+func TestContextWindow(t *testing.T) {
+	for model, want := range map[string]int{
+		"gpt-4o-2024-08-06":   128000,
+		"openai/gpt-4o-mini":  128000,
+		"gpt-4-32k":           32768,
+		"gpt-4":               8192,
+		"o3-mini":             200000,
+		"doubao-1.5-pro-256k": 262144,
+		"deepseek-reasoner":   65536,
+		"gemini-1.5-pro-002":  2097152,
+	} {
+		got, ok := ContextWindow(model)
+		assert.True(t, ok, model)
+		assert.Equal(t, want, got, model)
+	}
+
+	_, ok := ContextWindow("my-model")
+	assert.False(t, ok)
+}
+
+func TestCounter(t *testing.T) {
+	_, err := NewCounter(nil)
+	assert.Error(t, err)
+	_, err = NewCounter(&Config{Model: "gpt-4o", ContextWindow: -1})
+	assert.Error(t, err)
+
+	counter, err := NewCounter(&Config{Model: "my-model", Tokenizer: wordTokenizer{}})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, counter.ContextWindow())
+
+	assert.Equal(t, 0, counter.CountMessages(nil))
+	input := []*schema.Message{
+		schema.SystemMessage("you are a helpful assistant"),
+		{Role: schema.User, Name: "bob", MultiContent: []schema.ChatMessagePart{
+			{Type: schema.ChatMessagePartTypeText, Text: "what is it"},
+			{Type: schema.ChatMessagePartTypeImageURL, ImageURL: &schema.ChatMessageImageURL{URL: "https://img"}},
+		}},
+		schema.AssistantMessage("", []schema.ToolCall{{Function: schema.FunctionCall{Name: "search", Arguments: `{"q": "it"}`}}}),
+	}
+	// system: 3 + 1 + 5, user: 3 + 1 + 1 + 1 + 3 + 85, assistant: 3 + 1 + 1 + 2, reply priming: 3
+	assert.Equal(t, 113, counter.CountMessages(input))
+
+	tools := []*schema.ToolInfo{{
+		Name: "search",
+		Desc: "search the web",
+		ParamsOneOf: schema.NewParamsOneOfByParams(map[string]*schema.ParameterInfo{
+			"q": {Type: schema.String},
+		}),
+	}}
+	toolTokens, err := counter.CountTools(tools)
+	assert.NoError(t, err)
+	assert.Greater(t, toolTokens, 4)
+
+	_, err = counter.CheckContextWindow(input, tools, 100)
+	assert.Error(t, err)
+
+	counter, err = NewCounter(&Config{Model: "my-model", Tokenizer: wordTokenizer{}, ContextWindow: 200})
+	assert.NoError(t, err)
+	prompt, err := counter.CheckContextWindow(input, tools, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 113+toolTokens, prompt)
+
+	_, err = counter.CheckContextWindow(input, tools, 100)
+	assert.True(t, errors.Is(err, ErrContextWindowExceeded))
+}
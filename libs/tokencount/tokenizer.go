@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokencount
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/pkoukk/tiktoken-go"
+)
+
+// Tokenizer counts the tokens of text.
+type Tokenizer interface {
+	CountTokens(text string) int
+}
+
+// ApproximateTokenizer estimates tokens by the number of characters, for models whose tokenizer isn't public.
+type ApproximateTokenizer struct {
+	// CJKTokensPerRune is the tokens of a Chinese, Japanese or Korean character.
+	CJKTokensPerRune float64
+	// TokensPerRune is the tokens of any other character.
+	TokensPerRune float64
+}
+
+func (t *ApproximateTokenizer) CountTokens(text string) int {
+	var cjk, other int
+	for _, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			cjk++
+		} else {
+			other++
+		}
+	}
+	tokens := t.CJKTokensPerRune*float64(cjk) + t.TokensPerRune*float64(other)
+	// round up, a non-empty text is at least one token, ignoring floating point errors
+	return int(math.Ceil(tokens - 1e-9))
+}
+
+var (
+	// DeepSeekTokenizer follows the estimation of DeepSeek docs: an English character is about 0.3 tokens,
+	// and a Chinese character is about 0.6 tokens.
+	DeepSeekTokenizer = &ApproximateTokenizer{CJKTokensPerRune: 0.6, TokensPerRune: 0.3}
+	// ArkTokenizer estimates tokens of Doubao models on Ark, which count a Chinese word or an English word as a token.
+	ArkTokenizer = &ApproximateTokenizer{CJKTokensPerRune: 0.7, TokensPerRune: 0.3}
+	// GeminiTokenizer follows the estimation of Gemini docs: a token is about 4 characters.
+	// Chinese characters are counted as a token each, erring on the side of overestimation.
+	GeminiTokenizer = &ApproximateTokenizer{CJKTokensPerRune: 1, TokensPerRune: 0.25}
+)
+
+type tiktokenTokenizer struct {
+	encoding *tiktoken.Tiktoken
+}
+
+func (t *tiktokenTokenizer) CountTokens(text string) int {
+	if len(text) == 0 {
+		return 0
+	}
+	return len(t.encoding.Encode(text, nil, nil))
+}
+
+// NewTiktokenTokenizer returns the Tokenizer of OpenAI encoding, e.g. "o200k_base" or "cl100k_base".
+// The encoding is downloaded on first use and cached in the directory of TIKTOKEN_CACHE_DIR,
+// call tiktoken.SetBpeLoader to load it offline.
+func NewTiktokenTokenizer(encoding string) (Tokenizer, error) {
+	enc, err := tiktoken.GetEncoding(encoding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tiktoken encoding %s: %w", encoding, err)
+	}
+	return &tiktokenTokenizer{encoding: enc}, nil
+}
+
+// tiktokenEncodingPrefixes are checked in order, so longer prefixes go first.
+var tiktokenEncodingPrefixes = []struct {
+	prefix   string
+	encoding string
+}{
+	{"gpt-4o", "o200k_base"},
+	{"chatgpt-4o", "o200k_base"},
+	{"gpt-4.1", "o200k_base"},
+	{"gpt-4.5", "o200k_base"},
+	{"o1", "o200k_base"},
+	{"o3", "o200k_base"},
+	{"o4", "o200k_base"},
+	{"gpt-4", "cl100k_base"},
+	{"gpt-3.5", "cl100k_base"},
+	{"text-embedding-", "cl100k_base"},
+}
+
+// tokenizerForModel picks the tokenizer of model, models not known to have their own tokenizer are
+// taken as OpenAI compatible ones.
+func tokenizerForModel(model string) (Tokenizer, error) {
+	name := normalizeModel(model)
+	switch {
+	case strings.Contains(name, "deepseek"):
+		return DeepSeekTokenizer, nil
+	case strings.HasPrefix(name, "doubao"), strings.HasPrefix(name, "ep-"):
+		return ArkTokenizer, nil
+	case strings.Contains(name, "gemini"):
+		return GeminiTokenizer, nil
+	}
+
+	encoding := "cl100k_base"
+	for _, p := range tiktokenEncodingPrefixes {
+		if strings.HasPrefix(name, p.prefix) {
+			encoding = p.encoding
+			break
+		}
+	}
+	return NewTiktokenTokenizer(encoding)
+}
+
+// normalizeModel lowercases model and removes the provider prefix, e.g. "openai/gpt-4o" becomes "gpt-4o".
+func normalizeModel(model string) string {
+	name := strings.ToLower(strings.TrimSpace(model))
+	if i := strings.LastIndexByte(name, '/'); i >= 0 && i+1 < len(name) {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokencount
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// contextWindows are the context windows of known models by name prefix, checked in order,
+// so longer prefixes go first.
+var contextWindows = []struct {
+	prefix string
+	tokens int
+}{
+	{"gpt-4o", 128000},
+	{"chatgpt-4o", 128000},
+	{"gpt-4.1", 1047576},
+	{"gpt-4.5", 128000},
+	{"gpt-4-turbo", 128000},
+	{"gpt-4", 8192},
+	{"gpt-3.5-turbo", 16385},
+	{"o1-mini", 128000},
+	{"o1", 200000},
+	{"o3", 200000},
+	{"o4-mini", 200000},
+	{"deepseek-chat", 65536},
+	{"deepseek-reasoner", 65536},
+	{"gemini-1.5-pro", 2097152},
+	{"gemini-1.5-flash", 1048576},
+	{"gemini-2.0-flash", 1048576},
+	{"gemini-2.5", 1048576},
+}
+
+// windowSuffix matches the context window in model names like "doubao-pro-32k" or "moonshot-v1-128k".
+var windowSuffix = regexp.MustCompile(`-(\d+)k(?:-|$)`)
+
+// ContextWindow returns the context window of model in tokens, if known.
+// The window in model name, e.g. 32k of "doubao-pro-32k", takes precedence over the known ones.
+func ContextWindow(model string) (int, bool) {
+	name := normalizeModel(model)
+	if m := windowSuffix.FindStringSubmatch(name); m != nil {
+		if k, err := strconv.Atoi(m[1]); err == nil && k > 0 {
+			return k * 1024, true
+		}
+	}
+
+	for _, w := range contextWindows {
+		if strings.HasPrefix(name, w.prefix) {
+			return w.tokens, true
+		}
+	}
+	return 0, false
+}